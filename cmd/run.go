@@ -30,6 +30,9 @@ func Run(args []string, versionInfo string) int {
 		return ExitCodeFromError(err)
 	}
 
+	shared.SetOutputDiffKey(args)
+	shared.ApplyRedactOverride()
+
 	// Validate CI report flags after parsing
 	if err := shared.ValidateReportFlags(); err != nil {
 		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
@@ -67,13 +70,14 @@ func Run(args []string, versionInfo string) int {
 		}
 	}
 
+	// Get command name (full subcommand path)
+	commandName := getCommandName(root, args)
+	shared.SetMessageTitle(commandName)
+
 	start := time.Now()
 	runErr := root.Run(context.Background())
 	elapsed := time.Since(start)
 
-	// Get command name (full subcommand path)
-	commandName := getCommandName(root, args)
-
 	// Write JUnit report if requested
 	if shared.ReportFormat() == shared.ReportFormatJUnit && shared.ReportFile() != "" {
 		reportErr := writeJUnitReport(commandName, runErr, elapsed)
@@ -86,6 +90,10 @@ func Run(args []string, versionInfo string) int {
 		}
 	}
 
+	if runErr != nil && !errors.Is(runErr, flag.ErrHelp) && shared.InGitHubActions() {
+		shared.WriteGitHubErrorAnnotation(commandName, runErr)
+	}
+
 	if runErr != nil {
 		var reported ReportedError
 		if errors.As(runErr, &reported) {
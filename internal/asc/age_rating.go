@@ -24,6 +24,17 @@ type AgeRatingDeclarationAttributes struct {
 	ViolenceRealistic                           *string `json:"violenceRealistic,omitempty"`
 	ViolenceRealisticProlongedGraphicOrSadistic *string `json:"violenceRealisticProlongedGraphicOrSadistic,omitempty"`
 	KidsAgeBand                                 *string `json:"kidsAgeBand,omitempty"`
+	Advertising                                 *bool   `json:"advertising,omitempty"`
+	AgeAssurance                                *bool   `json:"ageAssurance,omitempty"`
+	HealthOrWellnessTopics                      *bool   `json:"healthOrWellnessTopics,omitempty"`
+	LootBox                                     *bool   `json:"lootBox,omitempty"`
+	MessagingAndChat                            *bool   `json:"messagingAndChat,omitempty"`
+	ParentalControls                            *bool   `json:"parentalControls,omitempty"`
+	UserGeneratedContent                        *bool   `json:"userGeneratedContent,omitempty"`
+	GunsOrOtherWeapons                          *string `json:"gunsOrOtherWeapons,omitempty"`
+	AgeRatingOverrideV2                         *string `json:"ageRatingOverrideV2,omitempty"`
+	KoreaAgeRatingOverride                      *string `json:"koreaAgeRatingOverride,omitempty"`
+	DeveloperAgeRatingInfoURL                   *string `json:"developerAgeRatingInfoUrl,omitempty"`
 }
 
 // AppStoreAgeRating represents an App Store age rating value.
@@ -18,6 +18,7 @@ const (
 	SalesReportTypeNewsstand         SalesReportType = "NEWSSTAND"
 	SalesReportTypeSubscription      SalesReportType = "SUBSCRIPTION"
 	SalesReportTypeSubscriptionEvent SalesReportType = "SUBSCRIPTION_EVENT"
+	SalesReportTypeSubscriber        SalesReportType = "SUBSCRIBER"
 )
 
 // SalesReportSubType represents the report detail level.
@@ -44,6 +45,8 @@ type SalesReportVersion string
 const (
 	SalesReportVersion1_0 SalesReportVersion = "1_0"
 	SalesReportVersion1_1 SalesReportVersion = "1_1"
+	SalesReportVersion1_2 SalesReportVersion = "1_2"
+	SalesReportVersion1_3 SalesReportVersion = "1_3"
 )
 
 // AnalyticsAccessType represents analytics report access types.
@@ -1,6 +1,9 @@
 package asc
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 // SalesReportResult represents CLI output for sales report downloads.
 type SalesReportResult struct {
@@ -15,6 +18,9 @@ type SalesReportResult struct {
 	Decompressed     bool   `json:"decompressed"`
 	DecompressedPath string `json:"decompressedPath,omitempty"`
 	DecompressedSize int64  `json:"decompressedSize,omitempty"`
+	SQLitePath       string `json:"sqlitePath,omitempty"`
+	ParquetPath      string `json:"parquetPath,omitempty"`
+	ExportedRows     int    `json:"exportedRows,omitempty"`
 }
 
 // AnalyticsReportRequestResult represents CLI output for created requests.
@@ -44,6 +50,15 @@ type AnalyticsReportDownloadResult struct {
 	DecompressedSize int64  `json:"decompressedSize,omitempty"`
 }
 
+// AnalyticsReportDownloadAllResult represents CLI output for downloading every
+// segment of an analytics report instance.
+type AnalyticsReportDownloadAllResult struct {
+	RequestID  string                          `json:"requestId"`
+	InstanceID string                          `json:"instanceId"`
+	OutputDir  string                          `json:"outputDir"`
+	Segments   []AnalyticsReportDownloadResult `json:"segments"`
+}
+
 // AnalyticsReportGetResult represents CLI output for report metadata with instances.
 type AnalyticsReportGetResult struct {
 	RequestID string                     `json:"requestId"`
@@ -80,6 +95,58 @@ type AnalyticsReportGetSegment struct {
 	URLExpirationDate string `json:"urlExpirationDate,omitempty"`
 }
 
+// SalesSummaryGroup is the aggregated units and proceeds for one group.
+type SalesSummaryGroup struct {
+	Group    map[string]string `json:"group"`
+	Currency string            `json:"currency"`
+	Units    int64             `json:"units"`
+	Proceeds float64           `json:"proceeds"`
+}
+
+// SalesSummaryResult is the output of reports summarize.
+type SalesSummaryResult struct {
+	Dir          string              `json:"dir"`
+	GroupBy      []string            `json:"groupBy"`
+	Files        int                 `json:"files"`
+	SkippedFiles []string            `json:"skippedFiles,omitempty"`
+	Records      int                 `json:"records"`
+	Groups       []SalesSummaryGroup `json:"groups"`
+	SQLitePath   string              `json:"sqlitePath,omitempty"`
+	ParquetPath  string              `json:"parquetPath,omitempty"`
+}
+
+// SalesSummaryColumns maps reports summarize --group-by keys to sales report
+// columns.
+var SalesSummaryColumns = map[string]string{
+	"country":      "Country Code",
+	"sku":          "SKU",
+	"title":        "Title",
+	"apple-id":     "Apple Identifier",
+	"product-type": "Product Type Identifier",
+	"version":      "Version",
+	"device":       "Device",
+	"date":         "Begin Date",
+}
+
+// ProceedsTotal is the converted proceeds of one app or region.
+type ProceedsTotal struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name,omitempty"`
+	Proceeds float64 `json:"proceeds"`
+}
+
+// ProceedsSummaryResult is the output of reports proceeds.
+type ProceedsSummaryResult struct {
+	Dir          string          `json:"dir"`
+	Currency     string          `json:"currency"`
+	Files        int             `json:"files"`
+	SkippedFiles []string        `json:"skippedFiles,omitempty"`
+	Records      int             `json:"records"`
+	Total        float64         `json:"total"`
+	Apps         []ProceedsTotal `json:"apps"`
+	Regions      []ProceedsTotal `json:"regions"`
+}
+
 func salesReportResultRows(result *SalesReportResult) ([]string, [][]string) {
 	headers := []string{"Vendor", "Type", "Subtype", "Frequency", "Date", "Version", "Compressed File", "Compressed Size", "Decompressed File", "Decompressed Size"}
 	rows := [][]string{{
@@ -142,6 +209,16 @@ func analyticsReportDownloadResultRows(result *AnalyticsReportDownloadResult) ([
 	return headers, rows
 }
 
+func analyticsReportDownloadAllResultRows(result *AnalyticsReportDownloadAllResult) ([]string, [][]string) {
+	headers := []string{"Request ID", "Instance ID", "Segment ID", "Compressed File", "Compressed Size", "Decompressed File", "Decompressed Size"}
+	rows := make([][]string, 0, len(result.Segments))
+	for _, segment := range result.Segments {
+		_, segmentRows := analyticsReportDownloadResultRows(&segment)
+		rows = append(rows, segmentRows...)
+	}
+	return headers, rows
+}
+
 func analyticsReportGetResultRows(result *AnalyticsReportGetResult) ([]string, [][]string) {
 	headers := []string{"Report ID", "Name", "Category", "Granularity", "Instances", "Segments"}
 	rows := make([][]string, 0, len(result.Data))
@@ -216,3 +293,35 @@ func countSegments(instances []AnalyticsReportGetInstance) int {
 	}
 	return total
 }
+
+func salesSummaryResultRows(result *SalesSummaryResult) ([]string, [][]string) {
+	headers := make([]string, 0, len(result.GroupBy)+3)
+	for _, key := range result.GroupBy {
+		headers = append(headers, SalesSummaryColumns[key])
+	}
+	headers = append(headers, "Currency", "Units", "Proceeds")
+
+	rows := make([][]string, 0, len(result.Groups))
+	for _, group := range result.Groups {
+		row := make([]string, 0, len(headers))
+		for _, key := range result.GroupBy {
+			row = append(row, group.Group[key])
+		}
+		row = append(row, group.Currency, strconv.FormatInt(group.Units, 10), strconv.FormatFloat(group.Proceeds, 'f', 2, 64))
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
+
+func proceedsSummaryResultRows(result *ProceedsSummaryResult) ([]string, [][]string) {
+	headers := []string{"Kind", "ID", "Name", "Proceeds (" + result.Currency + ")"}
+	rows := make([][]string, 0, len(result.Apps)+len(result.Regions)+1)
+	for _, app := range result.Apps {
+		rows = append(rows, []string{"app", app.ID, app.Name, strconv.FormatFloat(app.Proceeds, 'f', 2, 64)})
+	}
+	for _, region := range result.Regions {
+		rows = append(rows, []string{"region", region.ID, "", strconv.FormatFloat(region.Proceeds, 'f', 2, 64)})
+	}
+	rows = append(rows, []string{"total", "", "", strconv.FormatFloat(result.Total, 'f', 2, 64)})
+	return headers, rows
+}
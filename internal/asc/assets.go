@@ -1,5 +1,10 @@
 package asc
 
+import (
+	"strconv"
+	"strings"
+)
+
 // AppScreenshotSetAttributes describes a screenshot set resource.
 type AppScreenshotSetAttributes struct {
 	ScreenshotDisplayType string `json:"screenshotDisplayType"`
@@ -24,6 +29,16 @@ type ImageAsset struct {
 	Height      int    `json:"height"`
 }
 
+// URL returns the image URL at the asset's full size in format (e.g. "png"),
+// filling the {w}, {h}, and {f} placeholders of the template URL.
+func (a ImageAsset) URL(format string) string {
+	return strings.NewReplacer(
+		"{w}", strconv.Itoa(a.Width),
+		"{h}", strconv.Itoa(a.Height),
+		"{f}", format,
+	).Replace(a.TemplateURL)
+}
+
 // AssetDeliveryState describes the delivery state of an asset.
 type AssetDeliveryState struct {
 	State  string        `json:"state"`
@@ -36,7 +36,7 @@ type AssetUploadResultItem struct {
 
 // AppScreenshotUploadResult represents screenshot upload output.
 type AppScreenshotUploadResult struct {
-	VersionLocalizationID string                  `json:"versionLocalizationId"`
+	VersionLocalizationID string                  `json:"versionLocalizationId,omitempty"`
 	SetID                 string                  `json:"setId"`
 	DisplayType           string                  `json:"displayType"`
 	Results               []AssetUploadResultItem `json:"results"`
@@ -44,9 +44,10 @@ type AppScreenshotUploadResult struct {
 
 // AppPreviewUploadResult represents preview upload output.
 type AppPreviewUploadResult struct {
-	VersionLocalizationID string                  `json:"versionLocalizationId"`
+	VersionLocalizationID string                  `json:"versionLocalizationId,omitempty"`
 	SetID                 string                  `json:"setId"`
 	PreviewType           string                  `json:"previewType"`
+	PreviewFrameTimeCode  string                  `json:"previewFrameTimeCode,omitempty"`
 	Results               []AssetUploadResultItem `json:"results"`
 }
 
@@ -56,6 +57,100 @@ type AssetDeleteResult struct {
 	Deleted bool   `json:"deleted"`
 }
 
+// ScreenshotSyncItem is one screenshot in a sync plan.
+type ScreenshotSyncItem struct {
+	Locale       string `json:"locale"`
+	DisplayType  string `json:"displayType"`
+	FileName     string `json:"fileName"`
+	Action       string `json:"action"`
+	ScreenshotID string `json:"screenshotId,omitempty"`
+}
+
+// ScreenshotSyncResult is the result of a screenshot sync.
+type ScreenshotSyncResult struct {
+	VersionID string               `json:"versionId"`
+	Dir       string               `json:"dir"`
+	DryRun    bool                 `json:"dryRun"`
+	Items     []ScreenshotSyncItem `json:"items"`
+	Kept      int                  `json:"kept"`
+	Uploaded  int                  `json:"uploaded"`
+	Deleted   int                  `json:"deleted"`
+	Reordered []string             `json:"reordered,omitempty"`
+}
+
+// ScreenshotDownloadItem is one downloaded screenshot.
+type ScreenshotDownloadItem struct {
+	Locale       string `json:"locale"`
+	DisplayType  string `json:"displayType"`
+	ScreenshotID string `json:"screenshotId"`
+	FilePath     string `json:"filePath"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	BytesWritten int64  `json:"bytesWritten"`
+}
+
+// ScreenshotDownloadResult is the result of a screenshot download.
+type ScreenshotDownloadResult struct {
+	VersionID string                   `json:"versionId"`
+	Dir       string                   `json:"dir"`
+	Files     []ScreenshotDownloadItem `json:"files"`
+	Skipped   []string                 `json:"skipped,omitempty"`
+}
+
+// ScreenshotReorderResult is the result of a screenshot reorder.
+type ScreenshotReorderResult struct {
+	SetID     string   `json:"setId"`
+	Order     []string `json:"order"`
+	FileNames []string `json:"fileNames"`
+	Changed   bool     `json:"changed"`
+}
+
+// ScreenshotValidationIssue is one problem found in a screenshots directory.
+type ScreenshotValidationIssue struct {
+	Locale      string `json:"locale"`
+	DisplayType string `json:"displayType"`
+	File        string `json:"file,omitempty"`
+	Rule        string `json:"rule"`
+	Message     string `json:"message"`
+}
+
+// ScreenshotValidateResult is the result of a screenshot validation.
+type ScreenshotValidateResult struct {
+	Dir    string                      `json:"dir"`
+	Sets   int                         `json:"sets"`
+	Files  int                         `json:"files"`
+	Issues []ScreenshotValidationIssue `json:"issues"`
+	Valid  bool                        `json:"valid"`
+}
+
+// GitHubAnnotations reports each issue as a workflow annotation.
+// ScreenshotSetDeleteItem is one deleted screenshot set.
+type ScreenshotSetDeleteItem struct {
+	Locale      string `json:"locale"`
+	DisplayType string `json:"displayType"`
+	SetID       string `json:"setId"`
+}
+
+// ScreenshotSetsDeleteResult is the result of deleting the screenshot sets of
+// a version's locales.
+type ScreenshotSetsDeleteResult struct {
+	VersionID string                    `json:"versionId"`
+	Locales   []string                  `json:"locales"`
+	Sets      []ScreenshotSetDeleteItem `json:"sets"`
+}
+
+func (r *ScreenshotValidateResult) GitHubAnnotations() []GitHubAnnotation {
+	annotations := make([]GitHubAnnotation, 0, len(r.Issues))
+	for _, issue := range r.Issues {
+		annotations = append(annotations, GitHubAnnotation{
+			Level:   "error",
+			Title:   issue.Locale + " " + issue.DisplayType,
+			Message: issue.Message,
+		})
+	}
+	return annotations
+}
+
 func appScreenshotSetsRows(resp *AppScreenshotSetsResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Display Type"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -171,8 +266,8 @@ func appScreenshotUploadResultMainRows(result *AppScreenshotUploadResult) ([]str
 }
 
 func appPreviewUploadResultMainRows(result *AppPreviewUploadResult) ([]string, [][]string) {
-	headers := []string{"Localization ID", "Set ID", "Preview Type"}
-	rows := [][]string{{result.VersionLocalizationID, result.SetID, result.PreviewType}}
+	headers := []string{"Localization ID", "Set ID", "Preview Type", "Poster Frame"}
+	rows := [][]string{{result.VersionLocalizationID, result.SetID, result.PreviewType, result.PreviewFrameTimeCode}}
 	return headers, rows
 }
 
@@ -190,3 +285,81 @@ func assetDeleteResultRows(result *AssetDeleteResult) ([]string, [][]string) {
 	rows := [][]string{{result.ID, fmt.Sprintf("%t", result.Deleted)}}
 	return headers, rows
 }
+
+func screenshotSyncResultMainRows(result *ScreenshotSyncResult) ([]string, [][]string) {
+	headers := []string{"Version ID", "Dry Run", "Kept", "Uploaded", "Deleted", "Reordered"}
+	rows := [][]string{{
+		result.VersionID,
+		fmt.Sprintf("%t", result.DryRun),
+		fmt.Sprintf("%d", result.Kept),
+		fmt.Sprintf("%d", result.Uploaded),
+		fmt.Sprintf("%d", result.Deleted),
+		fmt.Sprintf("%d", len(result.Reordered)),
+	}}
+	return headers, rows
+}
+
+func screenshotSyncItemRows(items []ScreenshotSyncItem) ([]string, [][]string) {
+	headers := []string{"Locale", "Display Type", "File", "Action", "Screenshot ID"}
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, []string{item.Locale, item.DisplayType, item.FileName, item.Action, item.ScreenshotID})
+	}
+	return headers, rows
+}
+
+func screenshotDownloadResultRows(result *ScreenshotDownloadResult) ([]string, [][]string) {
+	headers := []string{"Locale", "Display Type", "Screenshot ID", "Size", "Path"}
+	rows := make([][]string, 0, len(result.Files))
+	for _, item := range result.Files {
+		size := fmt.Sprintf("%dx%d", item.Width, item.Height)
+		rows = append(rows, []string{item.Locale, item.DisplayType, item.ScreenshotID, size, item.FilePath})
+	}
+	return headers, rows
+}
+
+func screenshotReorderResultRows(result *ScreenshotReorderResult) ([]string, [][]string) {
+	headers := []string{"Position", "Screenshot ID", "File Name"}
+	rows := make([][]string, 0, len(result.Order))
+	for i, id := range result.Order {
+		fileName := ""
+		if i < len(result.FileNames) {
+			fileName = result.FileNames[i]
+		}
+		rows = append(rows, []string{fmt.Sprintf("%d", i+1), id, fileName})
+	}
+	return headers, rows
+}
+
+func screenshotValidateResultMainRows(result *ScreenshotValidateResult) ([]string, [][]string) {
+	status := "VALID"
+	if !result.Valid {
+		status = "INVALID"
+	}
+	headers := []string{"Result", "Sets", "Files", "Issues"}
+	rows := [][]string{{
+		status,
+		fmt.Sprintf("%d", result.Sets),
+		fmt.Sprintf("%d", result.Files),
+		fmt.Sprintf("%d", len(result.Issues)),
+	}}
+	return headers, rows
+}
+
+func screenshotValidationIssueRows(issues []ScreenshotValidationIssue) ([]string, [][]string) {
+	headers := []string{"Locale", "Display Type", "File", "Rule", "Message"}
+	rows := make([][]string, 0, len(issues))
+	for _, issue := range issues {
+		rows = append(rows, []string{issue.Locale, issue.DisplayType, issue.File, issue.Rule, issue.Message})
+	}
+	return headers, rows
+}
+
+func screenshotSetsDeleteResultRows(result *ScreenshotSetsDeleteResult) ([]string, [][]string) {
+	headers := []string{"Locale", "Display Type", "Set ID", "Deleted"}
+	rows := make([][]string, 0, len(result.Sets))
+	for _, item := range result.Sets {
+		rows = append(rows, []string{item.Locale, item.DisplayType, item.SetID, "true"})
+	}
+	return headers, rows
+}
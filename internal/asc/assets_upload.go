@@ -32,16 +32,33 @@ func UploadAsset(ctx context.Context, filePath string, operations []UploadOperat
 }
 
 // UploadAssetFromFile uploads a file using the provided upload operations.
-func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, operations []UploadOperation) error {
+// Each chunk is retried on network errors, 429, and 503 responses according
+// to the uploads endpoint policy, so a transient failure does not abandon the
+// reservation. WithUploadState is honored to resume an interrupted upload;
+// other options are ignored.
+func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, operations []UploadOperation, opts ...UploadOption) error {
 	if len(operations) == 0 {
 		return fmt.Errorf("no upload operations provided")
 	}
 
-	client := &http.Client{Timeout: ResolveTimeout()}
+	policy := ResolveEndpointPolicy(EndpointClassUploads)
+	timeout := ResolveTimeout()
+	if policy.Timeout > 0 {
+		timeout = policy.Timeout
+	}
+	uploadOpts := UploadOptions{
+		Client:           &http.Client{Timeout: timeout},
+		RetryOpts:        policy.Retry,
+		RetryOnRateLimit: policy.RetryOnRateLimit,
+	}
+	var requested UploadOptions
+	for _, opt := range opts {
+		opt(&requested)
+	}
+	uploadOpts.State = requested.State
 
 	for i, op := range operations {
-		method := strings.ToUpper(strings.TrimSpace(op.Method))
-		if method == "" {
+		if strings.TrimSpace(op.Method) == "" {
 			return fmt.Errorf("upload operation %d missing method", i)
 		}
 		if strings.TrimSpace(op.URL) == "" {
@@ -53,26 +70,14 @@ func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, ope
 		if op.Offset+op.Length > fileSize {
 			return fmt.Errorf("upload operation %d exceeds file size", i)
 		}
+	}
 
-		reader := io.NewSectionReader(file, op.Offset, op.Length)
-		req, err := http.NewRequestWithContext(ctx, method, op.URL, reader)
-		if err != nil {
-			return fmt.Errorf("upload operation %d: %w", i, err)
-		}
-		req.ContentLength = op.Length
-		for _, header := range op.RequestHeaders {
-			req.Header.Set(header.Name, header.Value)
-		}
-
-		resp, err := client.Do(req)
-		if err != nil {
-			return fmt.Errorf("upload operation %d failed: %w", i, err)
+	for i, op := range operations {
+		if uploadOpts.State != nil && uploadOpts.State.IsCompleted(i) {
+			continue
 		}
-		_, _ = io.Copy(io.Discard, resp.Body)
-		_ = resp.Body.Close()
-
-		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			return fmt.Errorf("upload operation %d failed with status %d", i, resp.StatusCode)
+		if err := executeUploadOperation(ctx, file, uploadTask{index: i, op: op}, uploadOpts); err != nil {
+			return err
 		}
 	}
 
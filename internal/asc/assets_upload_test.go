@@ -127,3 +127,51 @@ func TestUploadAssetFromFileUploadsChunks(t *testing.T) {
 		t.Fatalf("expected 2 upload calls, got %d", call)
 	}
 }
+
+func TestUploadAssetFromFileRetriesFailedChunk(t *testing.T) {
+	t.Setenv("ASC_BASE_DELAY", "1ms")
+	t.Setenv("ASC_MAX_DELAY", "1ms")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "asset.bin")
+	if err := os.WriteFile(path, []byte("abcdef"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open file: %v", err)
+	}
+	defer file.Close()
+
+	var part2Calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		if r.URL.Path == "/part2" {
+			if string(body) != "def" {
+				t.Fatalf("expected part2 body 'def', got %q", string(body))
+			}
+			if atomic.AddInt32(&part2Calls, 1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ops := []UploadOperation{
+		{Method: "PUT", URL: server.URL + "/part1", Length: 3, Offset: 0},
+		{Method: "PUT", URL: server.URL + "/part2", Length: 3, Offset: 3},
+	}
+
+	if err := UploadAssetFromFile(context.Background(), file, 6, ops); err != nil {
+		t.Fatalf("UploadAssetFromFile() error: %v", err)
+	}
+	if got := atomic.LoadInt32(&part2Calls); got != 2 {
+		t.Fatalf("expected failed chunk to be retried once, got %d calls", got)
+	}
+}
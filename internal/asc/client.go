@@ -1377,6 +1377,22 @@ func (c *Client) GetAppInfoLocalizations(ctx context.Context, appInfoID string,
 	return &response, nil
 }
 
+// GetAppInfoLocalization retrieves a single app info localization by ID.
+func (c *Client) GetAppInfoLocalization(ctx context.Context, localizationID string) (*AppInfoLocalizationResponse, error) {
+	path := fmt.Sprintf("/v1/appInfoLocalizations/%s", localizationID)
+	data, err := c.do(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AppInfoLocalizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
 // CreateAppInfoLocalization creates a localization for an app info resource.
 func (c *Client) CreateAppInfoLocalization(ctx context.Context, appInfoID string, attributes AppInfoLocalizationAttributes) (*AppInfoLocalizationResponse, error) {
 	payload := AppInfoLocalizationCreateRequest{
@@ -234,6 +234,39 @@ func TestGetAppStoreVersionLocalizationScreenshotSets_SendsRequestWithLimit(t *t
 	}
 }
 
+func TestGetAppStoreVersionLocalizationScreenshotSets_FiltersByDisplayType(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if got := req.URL.Query().Get("filter[screenshotDisplayType]"); got != "APP_IPHONE_67,APP_IPAD_PRO_3GEN_129" {
+			t.Fatalf("expected display type filter, got %q", got)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	opt := WithAppStoreVersionLocalizationScreenshotSetsDisplayTypes([]string{"app_iphone_67", "APP_IPAD_PRO_3GEN_129"})
+	if _, err := client.GetAppStoreVersionLocalizationScreenshotSets(context.Background(), "loc-1", opt); err != nil {
+		t.Fatalf("GetAppStoreVersionLocalizationScreenshotSets() error: %v", err)
+	}
+}
+
+func TestGetAppStoreVersionLocalizationPreviewSets_FiltersByPreviewType(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/appStoreVersionLocalizations/loc-1/appPreviewSets" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("filter[previewType]"); got != "IPHONE_67,IPAD_PRO_3GEN_129" {
+			t.Fatalf("expected preview type filter, got %q", got)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	opt := WithAppStoreVersionLocalizationPreviewSetsPreviewTypes([]string{"iphone_67", "IPAD_PRO_3GEN_129"})
+	if _, err := client.GetAppStoreVersionLocalizationPreviewSets(context.Background(), "loc-1", opt); err != nil {
+		t.Fatalf("GetAppStoreVersionLocalizationPreviewSets() error: %v", err)
+	}
+}
+
 func TestGetAppStoreVersionLocalizationScreenshotSetsRelationships_UsesNextURL(t *testing.T) {
 	next := "https://api.appstoreconnect.apple.com/v1/appStoreVersionLocalizations/loc-1/relationships/appScreenshotSets?cursor=abc"
 	response := jsonResponse(http.StatusOK, `{"data":[]}`)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
 )
 
 // AppScreenshotSetRelationships describes relationships for screenshot sets.
@@ -94,8 +96,9 @@ type AppPreviewCreateRequest struct {
 
 // AppPreviewUpdateAttributes describes preview update attributes.
 type AppPreviewUpdateAttributes struct {
-	SourceFileChecksum *string `json:"sourceFileChecksum,omitempty"`
-	Uploaded           *bool   `json:"uploaded,omitempty"`
+	SourceFileChecksum   *string `json:"sourceFileChecksum,omitempty"`
+	Uploaded             *bool   `json:"uploaded,omitempty"`
+	PreviewFrameTimeCode *string `json:"previewFrameTimeCode,omitempty"`
 }
 
 // AppPreviewUpdateData is the data portion of a preview update request.
@@ -184,6 +187,29 @@ func (c *Client) DeleteAppScreenshotSet(ctx context.Context, setID string) error
 	return err
 }
 
+// ReplaceAppScreenshotSetScreenshots sets the screenshots of a set, in order.
+// App Store Connect displays the screenshots in the order given.
+func (c *Client) ReplaceAppScreenshotSetScreenshots(ctx context.Context, setID string, screenshotIDs []string) error {
+	payload := RelationshipRequest{
+		Data: make([]RelationshipData, 0, len(screenshotIDs)),
+	}
+	for _, screenshotID := range screenshotIDs {
+		payload.Data = append(payload.Data, RelationshipData{
+			Type: ResourceTypeAppScreenshots,
+			ID:   screenshotID,
+		})
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/v1/appScreenshotSets/%s/relationships/appScreenshots", setID)
+	_, err = c.do(ctx, "PATCH", path, body)
+	return err
+}
+
 // GetAppScreenshots retrieves screenshots for a set.
 func (c *Client) GetAppScreenshots(ctx context.Context, setID string) (*AppScreenshotsResponse, error) {
 	path := fmt.Sprintf("/v1/appScreenshotSets/%s/appScreenshots", setID)
@@ -366,6 +392,29 @@ func (c *Client) DeleteAppPreviewSet(ctx context.Context, setID string) error {
 	return err
 }
 
+// ReplaceAppPreviewSetPreviews sets the previews of a set, in order.
+// App Store Connect displays the previews in the order given.
+func (c *Client) ReplaceAppPreviewSetPreviews(ctx context.Context, setID string, previewIDs []string) error {
+	payload := RelationshipRequest{
+		Data: make([]RelationshipData, 0, len(previewIDs)),
+	}
+	for _, previewID := range previewIDs {
+		payload.Data = append(payload.Data, RelationshipData{
+			Type: ResourceTypeAppPreviews,
+			ID:   previewID,
+		})
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/v1/appPreviewSets/%s/relationships/appPreviews", setID)
+	_, err = c.do(ctx, "PATCH", path, body)
+	return err
+}
+
 // GetAppPreviews retrieves previews for a set.
 func (c *Client) GetAppPreviews(ctx context.Context, setID string) (*AppPreviewsResponse, error) {
 	path := fmt.Sprintf("/v1/appPreviewSets/%s/appPreviews", setID)
@@ -468,9 +517,74 @@ func (c *Client) UpdateAppPreview(ctx context.Context, previewID string, uploade
 	return &response, nil
 }
 
+// UpdateAppPreviewFrameTimeCode sets the poster frame of a preview.
+func (c *Client) UpdateAppPreviewFrameTimeCode(ctx context.Context, previewID string, timeCode string) (*AppPreviewResponse, error) {
+	payload := AppPreviewUpdateRequest{
+		Data: AppPreviewUpdateData{
+			Type: ResourceTypeAppPreviews,
+			ID:   previewID,
+			Attributes: &AppPreviewUpdateAttributes{
+				PreviewFrameTimeCode: &timeCode,
+			},
+		},
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, "PATCH", fmt.Sprintf("/v1/appPreviews/%s", previewID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AppPreviewResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
 // DeleteAppPreview deletes a preview by ID.
 func (c *Client) DeleteAppPreview(ctx context.Context, previewID string) error {
 	path := fmt.Sprintf("/v1/appPreviews/%s", previewID)
 	_, err := c.do(ctx, "DELETE", path, nil)
 	return err
 }
+
+// DownloadImageAsset downloads an image asset, such as a screenshot, at its
+// full size in format (e.g. "png").
+func (c *Client) DownloadImageAsset(ctx context.Context, asset *ImageAsset, format string) (*ReportDownload, error) {
+	if asset == nil || strings.TrimSpace(asset.TemplateURL) == "" {
+		return nil, fmt.Errorf("image asset download: asset has no template URL")
+	}
+	downloadURL := asset.URL(format)
+	if err := validateImageAssetURL(downloadURL); err != nil {
+		return nil, fmt.Errorf("image asset download: %w", err)
+	}
+
+	resp, err := c.doStreamNoAuth(ctx, "GET", downloadURL, "image/*")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReportDownload{Body: resp.Body, ContentLength: resp.ContentLength}, nil
+}
+
+// validateImageAssetURL allows only HTTPS URLs on Apple's image CDN.
+func validateImageAssetURL(downloadURL string) error {
+	parsedURL, err := url.Parse(downloadURL)
+	if err != nil {
+		return fmt.Errorf("invalid image URL: %w", err)
+	}
+	if parsedURL.Scheme != "https" {
+		return fmt.Errorf("rejected image URL with insecure scheme %q (expected https)", parsedURL.Scheme)
+	}
+	host := strings.ToLower(parsedURL.Hostname())
+	if host == "mzstatic.com" || strings.HasSuffix(host, ".mzstatic.com") {
+		return nil
+	}
+	return fmt.Errorf("rejected image URL from untrusted host %q", parsedURL.Host)
+}
@@ -189,6 +189,7 @@ func envValue(name string) (string, bool) {
 type RetryableError struct {
 	Err        error
 	RetryAfter time.Duration
+	StatusCode int
 }
 
 func (e *RetryableError) Error() string {
@@ -372,15 +373,20 @@ func ResolveTimeout() time.Duration {
 }
 
 // ResolveUploadTimeout returns the upload timeout, optionally overridden by config/env.
+// endpoint_policies.uploads.timeout applies when upload_timeout is not set.
 func ResolveUploadTimeout() time.Duration {
 	cfg := loadConfig()
+	defaultTimeout := DefaultUploadTimeout
 	var uploadTimeout config.DurationValue
 	var uploadTimeoutSeconds config.DurationValue
 	if cfg != nil {
 		uploadTimeout = cfg.UploadTimeout
 		uploadTimeoutSeconds = cfg.UploadTimeoutSeconds
+		if policyTimeout, ok := cfg.EndpointPolicies[config.EndpointClassUploads].Timeout.Value(); ok {
+			defaultTimeout = policyTimeout
+		}
 	}
-	return resolveTimeoutWithDefaultAndEnv(DefaultUploadTimeout, "ASC_UPLOAD_TIMEOUT", "ASC_UPLOAD_TIMEOUT_SECONDS", uploadTimeout, uploadTimeoutSeconds)
+	return resolveTimeoutWithDefaultAndEnv(defaultTimeout, "ASC_UPLOAD_TIMEOUT", "ASC_UPLOAD_TIMEOUT_SECONDS", uploadTimeout, uploadTimeoutSeconds)
 }
 
 // ResolveTimeoutWithDefault returns the request timeout using a custom default.
@@ -431,6 +437,41 @@ type Client struct {
 	notaryBaseURL string // override for testing; empty uses NotaryBaseURL constant
 }
 
+// apiMaxIdleConnsPerHost keeps enough idle connections to App Store Connect
+// for paginated and concurrent calls to reuse instead of redialing.
+const apiMaxIdleConnsPerHost = 16
+
+var sharedAPITransport struct {
+	mu    sync.Mutex
+	base  *http.Transport
+	tuned *http.Transport
+}
+
+// apiTransport returns the transport shared by API clients. It is derived
+// from http.DefaultTransport with HTTP/2 forced on and a larger idle pool, so
+// every client in the process reuses the same connections. Go's transport
+// requests gzip and decompresses responses transparently. If
+// http.DefaultTransport has been replaced (for example, in tests), it is used as is.
+func apiTransport() http.RoundTripper {
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return http.DefaultTransport
+	}
+	sharedAPITransport.mu.Lock()
+	defer sharedAPITransport.mu.Unlock()
+	if sharedAPITransport.base != base {
+		tuned := base.Clone()
+		tuned.ForceAttemptHTTP2 = true
+		tuned.DisableCompression = false
+		if tuned.MaxIdleConnsPerHost < apiMaxIdleConnsPerHost {
+			tuned.MaxIdleConnsPerHost = apiMaxIdleConnsPerHost
+		}
+		sharedAPITransport.base = base
+		sharedAPITransport.tuned = tuned
+	}
+	return sharedAPITransport.tuned
+}
+
 // NewClient creates a new ASC client
 func NewClient(keyID, issuerID, privateKeyPath string) (*Client, error) {
 	if err := auth.ValidateKeyFile(privateKeyPath); err != nil {
@@ -444,7 +485,8 @@ func NewClient(keyID, issuerID, privateKeyPath string) (*Client, error) {
 
 	return &Client{
 		httpClient: &http.Client{
-			Timeout: ResolveTimeout(),
+			Timeout:   ResolveTimeout(),
+			Transport: apiTransport(),
 		},
 		keyID:      keyID,
 		issuerID:   issuerID,
@@ -69,7 +69,7 @@ func GenerateJWT(keyID, issuerID string, privateKey *ecdsa.PrivateKey) (string,
 }
 
 // do performs an HTTP request and returns the response.
-// GET/HEAD requests use retry logic for rate limiting by default.
+// GET/HEAD requests follow the lists endpoint policy and retry rate limits by default.
 func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
 	var bodyBytes []byte
 	if body != nil {
@@ -80,20 +80,26 @@ func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([
 		}
 	}
 
-	request := func() ([]byte, error) {
+	request := func(ctx context.Context, client *Client) ([]byte, error) {
 		var reader io.Reader
 		if bodyBytes != nil {
 			reader = bytes.NewReader(bodyBytes)
 		}
-		return c.doOnce(ctx, method, path, reader)
+		return client.doOnce(ctx, method, path, reader)
 	}
 
 	if shouldRetryMethod(method) {
-		retryOpts := ResolveRetryOptions()
-		return WithRetry(ctx, request, retryOpts)
+		policy := ResolveEndpointPolicy(EndpointClassLists)
+		requestCtx, cancel := policy.detach(ctx)
+		defer cancel()
+		client := c.withTimeout(policy.Timeout)
+		return WithRetry(requestCtx, func() ([]byte, error) {
+			data, err := request(requestCtx, client)
+			return data, policy.classifyError(err)
+		}, policy.Retry)
 	}
 
-	return request()
+	return request(ctx, c)
 }
 
 func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
@@ -146,6 +152,7 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 			return nil, &RetryableError{
 				Err:        buildRetryableError(resp.StatusCode, retryAfter, respBody),
 				RetryAfter: retryAfter,
+				StatusCode: resp.StatusCode,
 			}
 		}
 
@@ -425,7 +432,49 @@ func validateAnalyticsDownloadURL(downloadURL string) error {
 	return fmt.Errorf("rejected analytics download URL from untrusted host %q", parsedURL.Host)
 }
 
+// doStream performs a report download request. Timeouts and retries follow
+// the reports endpoint policy.
 func (c *Client) doStream(ctx context.Context, method, path string, body io.Reader, accept string) (*http.Response, error) {
+	policy := ResolveEndpointPolicy(EndpointClassReports)
+	if body != nil || !policy.RetryOnNotReady {
+		policy.Retry.MaxRetries = 0
+	}
+	requestCtx, cancel := policy.detach(ctx)
+	client := c.withTimeout(policy.Timeout)
+	resp, err := WithRetry(requestCtx, func() (*http.Response, error) {
+		resp, err := client.doStreamOnce(requestCtx, method, path, body, accept)
+		return resp, policy.classifyError(err)
+	}, policy.Retry)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// setStreamAcceptEncoding asks for an uncompressed transfer when the payload
+// is already gzip, so the transport does not spend time compressing it again.
+// Other payloads (JSON, CSV) keep the transport's transparent gzip.
+func setStreamAcceptEncoding(req *http.Request, accept string) {
+	if strings.Contains(strings.ToLower(accept), "gzip") {
+		req.Header.Set("Accept-Encoding", "identity")
+	}
+}
+
+// cancelOnCloseBody releases the request context once the body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func (c *Client) doStreamOnce(ctx context.Context, method, path string, body io.Reader, accept string) (*http.Response, error) {
 	req, err := c.newRequest(ctx, method, path, body)
 	if err != nil {
 		return nil, err
@@ -433,6 +482,7 @@ func (c *Client) doStream(ctx context.Context, method, path string, body io.Read
 	if strings.TrimSpace(accept) != "" {
 		req.Header.Set("Accept", accept)
 	}
+	setStreamAcceptEncoding(req, accept)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -457,6 +507,7 @@ func (c *Client) doStreamNoAuth(ctx context.Context, method, rawURL, accept stri
 	if strings.TrimSpace(accept) != "" {
 		req.Header.Set("Accept", accept)
 	}
+	setStreamAcceptEncoding(req, accept)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -2633,6 +2633,27 @@ func TestGetAppInfoLocalizations_WithFilters(t *testing.T) {
 	}
 }
 
+func TestGetAppInfoLocalization(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"appInfoLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Demo App"}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/appInfoLocalizations/loc-1" {
+			t.Fatalf("expected path /v1/appInfoLocalizations/loc-1, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	resp, err := client.GetAppInfoLocalization(context.Background(), "loc-1")
+	if err != nil {
+		t.Fatalf("GetAppInfoLocalization() error: %v", err)
+	}
+	if resp.Data.Attributes.Name != "Demo App" {
+		t.Fatalf("expected name Demo App, got %q", resp.Data.Attributes.Name)
+	}
+}
+
 func TestCreateAppInfoLocalization_SendsRequest(t *testing.T) {
 	response := jsonResponse(http.StatusCreated, `{"data":{"type":"appInfoLocalizations","id":"loc-1","attributes":{"locale":"en-US"}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -3566,6 +3587,30 @@ func TestGetAppScreenshotSets(t *testing.T) {
 	}
 }
 
+func TestReplaceAppScreenshotSetScreenshots(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, "")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/appScreenshotSets/SET_123/relationships/appScreenshots" {
+			t.Fatalf("expected relationships path, got %s", req.URL.Path)
+		}
+		var payload RelationshipRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if len(payload.Data) != 2 || payload.Data[0].ID != "SHOT_2" || payload.Data[1].ID != "SHOT_1" || payload.Data[0].Type != ResourceTypeAppScreenshots {
+			t.Fatalf("unexpected payload: %+v", payload)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.ReplaceAppScreenshotSetScreenshots(context.Background(), "SET_123", []string{"SHOT_2", "SHOT_1"}); err != nil {
+		t.Fatalf("ReplaceAppScreenshotSetScreenshots() error: %v", err)
+	}
+}
+
 func TestGetAppScreenshotSet(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":{"type":"appScreenshotSets","id":"SET_123","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -3807,6 +3852,30 @@ func TestDeleteAppPreviewSet(t *testing.T) {
 	}
 }
 
+func TestReplaceAppPreviewSetPreviews(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, "")
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/appPreviewSets/SET_123/relationships/appPreviews" {
+			t.Fatalf("expected relationships path, got %s", req.URL.Path)
+		}
+		var payload RelationshipRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if len(payload.Data) != 2 || payload.Data[0].ID != "PREVIEW_2" || payload.Data[1].ID != "PREVIEW_1" || payload.Data[0].Type != ResourceTypeAppPreviews {
+			t.Fatalf("unexpected payload: %+v", payload)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.ReplaceAppPreviewSetPreviews(context.Background(), "SET_123", []string{"PREVIEW_2", "PREVIEW_1"}); err != nil {
+		t.Fatalf("ReplaceAppPreviewSetPreviews() error: %v", err)
+	}
+}
+
 func TestGetAppPreviews(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"appPreviews","id":"PREVIEW_123","attributes":{"fileName":"preview.mov","fileSize":2048}}]}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -3892,6 +3961,34 @@ func TestUpdateAppPreview(t *testing.T) {
 	}
 }
 
+func TestUpdateAppPreviewFrameTimeCode(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"appPreviews","id":"PREVIEW_123","attributes":{"previewFrameTimeCode":"00:00:05:00"}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/appPreviews/PREVIEW_123" {
+			t.Fatalf("expected path /v1/appPreviews/PREVIEW_123, got %s", req.URL.Path)
+		}
+		var payload struct {
+			Data struct {
+				Attributes map[string]any `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if payload.Data.Attributes["previewFrameTimeCode"] != "00:00:05:00" || len(payload.Data.Attributes) != 1 {
+			t.Fatalf("unexpected attributes: %v", payload.Data.Attributes)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.UpdateAppPreviewFrameTimeCode(context.Background(), "PREVIEW_123", "00:00:05:00"); err != nil {
+		t.Fatalf("UpdateAppPreviewFrameTimeCode() error: %v", err)
+	}
+}
+
 func TestDeleteAppPreview(t *testing.T) {
 	response := jsonResponse(http.StatusNoContent, "")
 	client := newTestClient(t, func(req *http.Request) {
@@ -5095,6 +5192,56 @@ func TestResolveGitReferenceByName_NoMatch(t *testing.T) {
 	}
 }
 
+func TestResolveGitReferenceOfKind_TagDoesNotMatchBranch(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"scmGitReferences","id":"ref-1","attributes":{"name":"v1.2.3","canonicalName":"refs/heads/v1.2.3","kind":"BRANCH"}},{"type":"scmGitReferences","id":"ref-2","attributes":{"name":"v1.2.3","canonicalName":"refs/tags/v1.2.3","kind":"TAG"}}]}`)
+	client := newTestClient(t, nil, response)
+
+	ref, err := client.ResolveGitReferenceOfKind(context.Background(), "repo-1", "TAG", "v1.2.3")
+	if err != nil {
+		t.Fatalf("ResolveGitReferenceOfKind() error: %v", err)
+	}
+	if ref.ID != "ref-2" {
+		t.Fatalf("expected git reference ID ref-2, got %q", ref.ID)
+	}
+}
+
+func TestResolveGitReferenceOfKind_NoMatch(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"scmGitReferences","id":"ref-1","attributes":{"name":"main","canonicalName":"refs/heads/main","kind":"BRANCH"}}]}`)
+	client := newTestClient(t, nil, response)
+
+	_, err := client.ResolveGitReferenceOfKind(context.Background(), "repo-1", "TAG", "main")
+	if err == nil || !strings.Contains(err.Error(), `no tag named "main"`) {
+		t.Fatalf("expected no tag error, got %v", err)
+	}
+}
+
+func TestResolveScmPullRequestByNumber(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"scmPullRequests","id":"pr-41","attributes":{"number":41}},{"type":"scmPullRequests","id":"pr-42","attributes":{"number":42,"title":"Fix"}}]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/scmRepositories/repo-1/pullRequests" {
+			t.Fatalf("expected path /v1/scmRepositories/repo-1/pullRequests, got %s", req.URL.Path)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	pr, err := client.ResolveScmPullRequestByNumber(context.Background(), "repo-1", 42)
+	if err != nil {
+		t.Fatalf("ResolveScmPullRequestByNumber() error: %v", err)
+	}
+	if pr.ID != "pr-42" {
+		t.Fatalf("expected pull request ID pr-42, got %q", pr.ID)
+	}
+}
+
+func TestResolveScmPullRequestByNumber_Closed(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[{"type":"scmPullRequests","id":"pr-42","attributes":{"number":42,"isClosed":true}}]}`)
+	client := newTestClient(t, nil, response)
+
+	if _, err := client.ResolveScmPullRequestByNumber(context.Background(), "repo-1", 42); err == nil || !strings.Contains(err.Error(), "is closed") {
+		t.Fatalf("expected closed error, got %v", err)
+	}
+}
+
 func TestGetBundleIDs_WithIdentifierFilter(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"bundleIds","id":"bid-1","attributes":{"identifier":"com.example.app"}}]}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -5670,6 +5817,41 @@ func TestCreateBundleIDCapability_SendsRequest(t *testing.T) {
 	}
 }
 
+func TestUpdateBundleIDCapability_SendsRequest(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"bundleIdCapabilities","id":"cap1","attributes":{"capabilityType":"ICLOUD"}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/bundleIdCapabilities/cap1" {
+			t.Fatalf("expected path /v1/bundleIdCapabilities/cap1, got %s", req.URL.Path)
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body error: %v", err)
+		}
+		var payload BundleIDCapabilityUpdateRequest
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("decode body error: %v", err)
+		}
+		if payload.Data.Type != ResourceTypeBundleIdCapabilities || payload.Data.ID != "cap1" {
+			t.Fatalf("unexpected data: %+v", payload.Data)
+		}
+		if payload.Data.Attributes == nil || len(payload.Data.Attributes.Settings) != 1 || payload.Data.Attributes.Settings[0].Key != "ICLOUD_VERSION" {
+			t.Fatalf("unexpected attributes: %+v", payload.Data.Attributes)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	attrs := BundleIDCapabilityUpdateAttributes{
+		CapabilityType: "ICLOUD",
+		Settings:       []CapabilitySetting{{Key: "ICLOUD_VERSION"}},
+	}
+	if _, err := client.UpdateBundleIDCapability(context.Background(), "cap1", attrs); err != nil {
+		t.Fatalf("UpdateBundleIDCapability() error: %v", err)
+	}
+}
+
 func TestDeleteBundleIDCapability_SendsRequest(t *testing.T) {
 	response := jsonResponse(http.StatusNoContent, ``)
 	client := newTestClient(t, func(req *http.Request) {
@@ -5764,6 +5946,38 @@ func TestCreateCertificate_SendsRequest(t *testing.T) {
 	}
 }
 
+func TestCreateCertificateWithRelationships_SendsPassTypeID(t *testing.T) {
+	response := jsonResponse(http.StatusCreated, `{"data":{"type":"certificates","id":"c1","attributes":{"name":"Pass","certificateType":"PASS_TYPE_ID"}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("read body error: %v", err)
+		}
+		if strings.Contains(string(body), "merchantId") {
+			t.Fatalf("expected merchantId to be omitted, got %s", body)
+		}
+		var payload CertificateCreateRequest
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("decode body error: %v", err)
+		}
+		relationships := payload.Data.Relationships
+		if relationships == nil || relationships.PassTypeID == nil {
+			t.Fatalf("expected passTypeId relationship, got %s", body)
+		}
+		if relationships.PassTypeID.Data.Type != ResourceTypePassTypeIds || relationships.PassTypeID.Data.ID != "pass-1" {
+			t.Fatalf("unexpected passTypeId relationship: %+v", relationships.PassTypeID.Data)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	relationships := &CertificateCreateRelationships{
+		PassTypeID: &Relationship{Data: ResourceData{Type: ResourceTypePassTypeIds, ID: "pass-1"}},
+	}
+	if _, err := client.CreateCertificateWithRelationships(context.Background(), "CSR_CONTENT", "PASS_TYPE_ID", relationships); err != nil {
+		t.Fatalf("CreateCertificateWithRelationships() error: %v", err)
+	}
+}
+
 func TestUpdateCertificate_SendsRequest(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":{"type":"certificates","id":"c1","attributes":{"name":"Cert","certificateType":"IOS_DISTRIBUTION","activated":true}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -5960,6 +6174,29 @@ func TestGetProfiles_WithFilter(t *testing.T) {
 	}
 }
 
+func TestGetProfiles_WithIncludeLimits(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		values := req.URL.Query()
+		if values.Get("include") != "certificates,devices" {
+			t.Fatalf("expected include=certificates,devices, got %q", values.Get("include"))
+		}
+		if values.Get("limit[certificates]") != "50" || values.Get("limit[devices]") != "50" {
+			t.Fatalf("expected include limits of 50, got %q and %q", values.Get("limit[certificates]"), values.Get("limit[devices]"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetProfiles(
+		context.Background(),
+		WithProfilesInclude([]string{"certificates", "devices"}),
+		WithProfilesCertificatesLimit(50),
+		WithProfilesDevicesLimit(50),
+	); err != nil {
+		t.Fatalf("GetProfiles() error: %v", err)
+	}
+}
+
 func TestGetProfiles_UsesNextURL(t *testing.T) {
 	next := "https://api.appstoreconnect.apple.com/v1/profiles?cursor=abc"
 	response := jsonResponse(http.StatusOK, `{"data":[]}`)
@@ -6561,6 +6798,23 @@ func TestGetUsers_WithFiltersAndLimit(t *testing.T) {
 	}
 }
 
+func TestGetUserInvitations_WithEmailFilter(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/userInvitations" {
+			t.Fatalf("expected path /v1/userInvitations, got %s", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("filter[email]"); got != "user@example.com" {
+			t.Fatalf("expected filter[email]=user@example.com, got %q", got)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetUserInvitations(context.Background(), WithUserInvitationsEmail(" user@example.com ")); err != nil {
+		t.Fatalf("GetUserInvitations() error: %v", err)
+	}
+}
+
 func TestGetUsers_UsesNextURL(t *testing.T) {
 	next := "https://api.appstoreconnect.apple.com/v1/users?cursor=abc"
 	response := jsonResponse(http.StatusOK, `{"data":[]}`)
@@ -8705,6 +8959,23 @@ func TestGetPromotedPurchase_SendsRequest(t *testing.T) {
 	}
 }
 
+func TestGetPromotedPurchase_WithInclude(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"promotedPurchases","id":"promo-1","relationships":{"subscription":{"data":{"type":"subscriptions","id":"sub-1"}}}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/promotedPurchases/promo-1" {
+			t.Fatalf("expected path /v1/promotedPurchases/promo-1, got %s", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("include"); got != "inAppPurchaseV2,subscription" {
+			t.Fatalf("expected include=inAppPurchaseV2,subscription, got %q", got)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetPromotedPurchase(context.Background(), "promo-1", WithPromotedPurchasesInclude([]string{"inAppPurchaseV2", "subscription"})); err != nil {
+		t.Fatalf("GetPromotedPurchase() error: %v", err)
+	}
+}
+
 func TestCreatePromotedPurchase_Subscription_SendsRequest(t *testing.T) {
 	response := jsonResponse(http.StatusCreated, `{"data":{"type":"promotedPurchases","id":"promo-1"}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -8848,3 +9119,41 @@ func TestDeletePromotedPurchase_SendsRequest(t *testing.T) {
 		t.Fatalf("DeletePromotedPurchase() error: %v", err)
 	}
 }
+
+func TestDownloadImageAsset_FillsTemplate(t *testing.T) {
+	asset := &ImageAsset{
+		TemplateURL: "https://is1-ssl.mzstatic.com/image/thumb/Purple/shot.png/{w}x{h}bb.{f}",
+		Width:       1290,
+		Height:      2796,
+	}
+	response := rawResponse(http.StatusOK, "png-data")
+	client := newTestClient(t, func(req *http.Request) {
+		want := "https://is1-ssl.mzstatic.com/image/thumb/Purple/shot.png/1290x2796bb.png"
+		if req.URL.String() != want {
+			t.Fatalf("expected URL %q, got %q", want, req.URL.String())
+		}
+		if req.Header.Get("Authorization") != "" {
+			t.Fatalf("expected no Authorization header")
+		}
+	}, response)
+
+	download, err := client.DownloadImageAsset(context.Background(), asset, "png")
+	if err != nil {
+		t.Fatalf("DownloadImageAsset() error: %v", err)
+	}
+	_ = download.Body.Close()
+}
+
+func TestDownloadImageAsset_UntrustedHost(t *testing.T) {
+	client := newTestClient(t, nil, nil)
+	for _, templateURL := range []string{
+		"https://images.example.com/{w}x{h}.{f}",
+		"http://is1-ssl.mzstatic.com/{w}x{h}.{f}",
+		"",
+	} {
+		asset := &ImageAsset{TemplateURL: templateURL, Width: 1, Height: 1}
+		if _, err := client.DownloadImageAsset(context.Background(), asset, "png"); err == nil {
+			t.Fatalf("expected error for %q", templateURL)
+		}
+	}
+}
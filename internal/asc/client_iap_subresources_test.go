@@ -460,6 +460,36 @@ func TestGetInAppPurchasePricePointEqualizations(t *testing.T) {
 	}
 }
 
+func TestGetInAppPurchasePricePointEqualizations_WithQuery(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/inAppPurchasePricePoints/price-1/equalizations" {
+			t.Fatalf("expected path /v1/inAppPurchasePricePoints/price-1/equalizations, got %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("filter[territory]") != "FRA,DEU" {
+			t.Fatalf("expected filter[territory]=FRA,DEU, got %q", values.Get("filter[territory]"))
+		}
+		if values.Get("include") != "territory" {
+			t.Fatalf("expected include=territory, got %q", values.Get("include"))
+		}
+		if values.Get("limit") != "200" {
+			t.Fatalf("expected limit=200, got %q", values.Get("limit"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetInAppPurchasePricePointEqualizations(
+		context.Background(),
+		"price-1",
+		WithIAPPricePointsTerritory("FRA,DEU"),
+		WithIAPPricePointsInclude([]string{"territory"}),
+		WithIAPPricePointsLimit(200),
+	); err != nil {
+		t.Fatalf("GetInAppPurchasePricePointEqualizations() error: %v", err)
+	}
+}
+
 func TestGetInAppPurchasePriceScheduleManualPrices_WithLimit(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[]}`)
 	client := newTestClient(t, func(req *http.Request) {
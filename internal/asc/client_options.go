@@ -275,6 +275,9 @@ type LinkagesOption func(*linkagesQuery)
 // PricePointsOption is a functional option for GetAppPricePoints.
 type PricePointsOption func(*pricePointsQuery)
 
+// AppPricesOption is a functional option for app price schedule prices.
+type AppPricesOption func(*appPricesQuery)
+
 // AccessibilityDeclarationsOption is a functional option for accessibility declarations.
 type AccessibilityDeclarationsOption func(*accessibilityDeclarationsQuery)
 
@@ -1037,6 +1040,20 @@ func WithReviewSort(sort string) ReviewOption {
 	}
 }
 
+// WithReviewPublishedResponse filters reviews by whether they have a published response.
+func WithReviewPublishedResponse(exists bool) ReviewOption {
+	return func(r *reviewQuery) {
+		r.publishedResponse = &exists
+	}
+}
+
+// WithReviewInclude includes related resources (e.g. response) with reviews.
+func WithReviewInclude(include []string) ReviewOption {
+	return func(r *reviewQuery) {
+		r.include = normalizeList(include)
+	}
+}
+
 // WithLimit sets the max number of reviews to return.
 func WithLimit(limit int) ReviewOption {
 	return func(r *reviewQuery) {
@@ -1949,6 +1966,13 @@ func WithPromotedPurchasesNextURL(next string) PromotedPurchasesOption {
 	}
 }
 
+// WithPromotedPurchasesInclude sets include for promoted purchase responses.
+func WithPromotedPurchasesInclude(include []string) PromotedPurchasesOption {
+	return func(q *promotedPurchasesQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithMerchantIDsLimit sets the max number of merchant IDs to return.
 func WithMerchantIDsLimit(limit int) MerchantIDsOption {
 	return func(q *merchantIDsQuery) {
@@ -2400,6 +2424,24 @@ func WithProfilesInclude(include []string) ProfilesOption {
 	}
 }
 
+// WithProfilesCertificatesLimit sets limit[certificates] for included certificates.
+func WithProfilesCertificatesLimit(limit int) ProfilesOption {
+	return func(q *profilesQuery) {
+		if limit > 0 {
+			q.certificatesLimit = limit
+		}
+	}
+}
+
+// WithProfilesDevicesLimit sets limit[devices] for included devices.
+func WithProfilesDevicesLimit(limit int) ProfilesOption {
+	return func(q *profilesQuery) {
+		if limit > 0 {
+			q.devicesLimit = limit
+		}
+	}
+}
+
 // WithProfilesFilterBundleID filters profiles by bundle ID.
 func WithProfilesFilterBundleID(bundleID string) ProfilesOption {
 	return func(q *profilesQuery) {
@@ -2688,6 +2730,13 @@ func WithUserInvitationsNextURL(next string) UserInvitationsOption {
 	}
 }
 
+// WithUserInvitationsEmail filters invitations by email.
+func WithUserInvitationsEmail(email string) UserInvitationsOption {
+	return func(q *userInvitationsQuery) {
+		q.email = strings.TrimSpace(email)
+	}
+}
+
 // WithBetaAppReviewDetailsLimit sets the max number of review detail records to return.
 func WithBetaAppReviewDetailsLimit(limit int) BetaAppReviewDetailsOption {
 	return func(q *betaAppReviewDetailsQuery) {
@@ -3148,6 +3197,38 @@ func WithPricePointsTerritory(territory string) PricePointsOption {
 	}
 }
 
+// WithAppPricesLimit sets the max number of app prices to return.
+func WithAppPricesLimit(limit int) AppPricesOption {
+	return func(q *appPricesQuery) {
+		if limit > 0 {
+			q.limit = limit
+		}
+	}
+}
+
+// WithAppPricesNextURL uses a next page URL directly.
+func WithAppPricesNextURL(next string) AppPricesOption {
+	return func(q *appPricesQuery) {
+		if strings.TrimSpace(next) != "" {
+			q.nextURL = strings.TrimSpace(next)
+		}
+	}
+}
+
+// WithAppPricesTerritories filters app prices by territory.
+func WithAppPricesTerritories(territories []string) AppPricesOption {
+	return func(q *appPricesQuery) {
+		q.territories = normalizeUpperList(territories)
+	}
+}
+
+// WithPricePointsInclude sets include for app price point responses.
+func WithPricePointsInclude(include []string) PricePointsOption {
+	return func(q *pricePointsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithAppCustomProductPagesLimit sets the max number of custom product pages to return.
 func WithAppCustomProductPagesLimit(limit int) AppCustomProductPagesOption {
 	return func(q *appCustomProductPagesQuery) {
@@ -3256,6 +3337,13 @@ func WithAppStoreVersionLocalizationPreviewSetsNextURL(next string) AppStoreVers
 	}
 }
 
+// WithAppStoreVersionLocalizationPreviewSetsPreviewTypes filters preview sets by preview type.
+func WithAppStoreVersionLocalizationPreviewSetsPreviewTypes(previewTypes []string) AppStoreVersionLocalizationPreviewSetsOption {
+	return func(q *appStoreVersionLocalizationPreviewSetsQuery) {
+		q.previewTypes = normalizeUpperList(previewTypes)
+	}
+}
+
 // WithAppStoreVersionLocalizationScreenshotSetsLimit sets the max number of screenshot sets to return.
 func WithAppStoreVersionLocalizationScreenshotSetsLimit(limit int) AppStoreVersionLocalizationScreenshotSetsOption {
 	return func(q *appStoreVersionLocalizationScreenshotSetsQuery) {
@@ -3274,6 +3362,13 @@ func WithAppStoreVersionLocalizationScreenshotSetsNextURL(next string) AppStoreV
 	}
 }
 
+// WithAppStoreVersionLocalizationScreenshotSetsDisplayTypes filters screenshot sets by display type.
+func WithAppStoreVersionLocalizationScreenshotSetsDisplayTypes(displayTypes []string) AppStoreVersionLocalizationScreenshotSetsOption {
+	return func(q *appStoreVersionLocalizationScreenshotSetsQuery) {
+		q.displayTypes = normalizeUpperList(displayTypes)
+	}
+}
+
 // WithAppStoreVersionExperimentTreatmentLocalizationPreviewSetsLimit sets the max number of preview sets to return.
 func WithAppStoreVersionExperimentTreatmentLocalizationPreviewSetsLimit(limit int) AppStoreVersionExperimentTreatmentLocalizationPreviewSetsOption {
 	return func(q *appStoreVersionExperimentTreatmentLocalizationPreviewSetsQuery) {
@@ -8,7 +8,10 @@ import (
 	"strings"
 )
 
-const appPriceScheduleManualPriceID = "${local-manual-price-1}"
+const (
+	appPriceScheduleManualPriceID  = "${local-manual-price-1}"
+	appPriceScheduleCurrentPriceID = "${local-manual-price-2}"
+)
 
 // GetTerritories retrieves available territories.
 func (c *Client) GetTerritories(ctx context.Context, opts ...TerritoriesOption) (*TerritoriesResponse, error) {
@@ -97,9 +100,23 @@ func (c *Client) GetAppPricePoint(ctx context.Context, pricePointID string) (*Ap
 }
 
 // GetAppPricePointEqualizations retrieves equalized price points for a price point.
-func (c *Client) GetAppPricePointEqualizations(ctx context.Context, pricePointID string) (*AppPricePointsV3Response, error) {
+func (c *Client) GetAppPricePointEqualizations(ctx context.Context, pricePointID string, opts ...PricePointsOption) (*AppPricePointsV3Response, error) {
+	query := &pricePointsQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
 	pricePointID = strings.TrimSpace(pricePointID)
 	path := fmt.Sprintf("/v3/appPricePoints/%s/equalizations", pricePointID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("appPricePointEqualizations: %w", err)
+		}
+		path = query.nextURL
+	} else if queryString := buildPricePointsQuery(query); queryString != "" {
+		path += "?" + queryString
+	}
 
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
@@ -215,6 +232,54 @@ func (c *Client) CreateAppPriceSchedule(ctx context.Context, appID string, attrs
 		},
 	}
 
+	if currentPricePointID := strings.TrimSpace(attrs.CurrentPricePointID); currentPricePointID != "" {
+		payload.Data.Relationships.ManualPrices.Data = append(payload.Data.Relationships.ManualPrices.Data, ResourceData{
+			Type: ResourceTypeAppPrices,
+			ID:   appPriceScheduleCurrentPriceID,
+		})
+		payload.Included = append(payload.Included, AppPriceCreateResource{
+			Type:       ResourceTypeAppPrices,
+			ID:         appPriceScheduleCurrentPriceID,
+			Attributes: AppPriceAttributes{EndDate: startDate},
+			Relationships: AppPriceRelationships{
+				AppPricePoint: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeAppPricePoints,
+						ID:   currentPricePointID,
+					},
+				},
+			},
+		})
+	}
+
+	for _, price := range attrs.AdditionalPrices {
+		pricePointID := strings.TrimSpace(price.PricePointID)
+		if pricePointID == "" {
+			return nil, fmt.Errorf("price point ID is required")
+		}
+		resourceID := fmt.Sprintf("${local-manual-price-%d}", len(payload.Included)+1)
+		payload.Data.Relationships.ManualPrices.Data = append(payload.Data.Relationships.ManualPrices.Data, ResourceData{
+			Type: ResourceTypeAppPrices,
+			ID:   resourceID,
+		})
+		payload.Included = append(payload.Included, AppPriceCreateResource{
+			Type: ResourceTypeAppPrices,
+			ID:   resourceID,
+			Attributes: AppPriceAttributes{
+				StartDate: strings.TrimSpace(price.StartDate),
+				EndDate:   strings.TrimSpace(price.EndDate),
+			},
+			Relationships: AppPriceRelationships{
+				AppPricePoint: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeAppPricePoints,
+						ID:   pricePointID,
+					},
+				},
+			},
+		})
+	}
+
 	body, err := BuildRequestBody(payload)
 	if err != nil {
 		return nil, err
@@ -254,7 +319,7 @@ func (c *Client) GetAppPriceScheduleBaseTerritory(ctx context.Context, scheduleI
 // GetAppPriceScheduleManualPrices retrieves manual prices for a schedule.
 func (c *Client) GetAppPriceScheduleManualPrices(ctx context.Context, scheduleID string) (*AppPricesResponse, error) {
 	scheduleID = strings.TrimSpace(scheduleID)
-	path := fmt.Sprintf("/v1/appPriceSchedules/%s/manualPrices", scheduleID)
+	path := fmt.Sprintf("/v1/appPriceSchedules/%s/manualPrices?include=appPricePoint,territory&limit=200", scheduleID)
 
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
@@ -270,9 +335,23 @@ func (c *Client) GetAppPriceScheduleManualPrices(ctx context.Context, scheduleID
 }
 
 // GetAppPriceScheduleAutomaticPrices retrieves automatic prices for a schedule.
-func (c *Client) GetAppPriceScheduleAutomaticPrices(ctx context.Context, scheduleID string) (*AppPricesResponse, error) {
+func (c *Client) GetAppPriceScheduleAutomaticPrices(ctx context.Context, scheduleID string, opts ...AppPricesOption) (*AppPricesResponse, error) {
+	query := &appPricesQuery{}
+	for _, opt := range opts {
+		opt(query)
+	}
+
 	scheduleID = strings.TrimSpace(scheduleID)
 	path := fmt.Sprintf("/v1/appPriceSchedules/%s/automaticPrices", scheduleID)
+	if query.nextURL != "" {
+		// Validate nextURL to prevent credential exfiltration
+		if err := validateNextURL(query.nextURL); err != nil {
+			return nil, fmt.Errorf("automaticPrices: %w", err)
+		}
+		path = query.nextURL
+	} else {
+		path += "?" + buildAppPricesQuery(query)
+	}
 
 	data, err := c.do(ctx, "GET", path, nil)
 	if err != nil {
@@ -39,9 +39,11 @@ type crashQuery struct {
 
 type reviewQuery struct {
 	listQuery
-	rating    int
-	territory string
-	sort      string
+	rating            int
+	territory         string
+	sort              string
+	include           []string
+	publishedResponse *bool
 }
 
 type appsQuery struct {
@@ -322,10 +324,12 @@ type appCustomProductPageLocalizationScreenshotSetsQuery struct {
 
 type appStoreVersionLocalizationPreviewSetsQuery struct {
 	listQuery
+	previewTypes []string
 }
 
 type appStoreVersionLocalizationScreenshotSetsQuery struct {
 	listQuery
+	displayTypes []string
 }
 
 type appStoreVersionExperimentsQuery struct {
@@ -380,6 +384,7 @@ type bundleIDsQuery struct {
 
 type promotedPurchasesQuery struct {
 	listQuery
+	include []string
 }
 
 type merchantIDsQuery struct {
@@ -448,9 +453,11 @@ type merchantIDCertificatesQuery struct {
 
 type profilesQuery struct {
 	listQuery
-	bundleID     string
-	profileTypes []string
-	include      []string
+	bundleID          string
+	profileTypes      []string
+	include           []string
+	certificatesLimit int
+	devicesLimit      int
 }
 
 type usersQuery struct {
@@ -499,6 +506,7 @@ type devicesQuery struct {
 
 type userInvitationsQuery struct {
 	listQuery
+	email string
 }
 
 type endUserLicenseAgreementTerritoriesQuery struct {
@@ -542,6 +550,12 @@ type linkagesQuery struct {
 type pricePointsQuery struct {
 	listQuery
 	territory string
+	include   []string
+}
+
+type appPricesQuery struct {
+	listQuery
+	territories []string
 }
 
 type accessibilityDeclarationsQuery struct {
@@ -600,9 +614,13 @@ func buildReviewQuery(opts []ReviewOption) string {
 	if query.rating >= 1 && query.rating <= 5 {
 		values.Set("filter[rating]", fmt.Sprintf("%d", query.rating))
 	}
+	if query.publishedResponse != nil {
+		values.Set("exists[publishedResponse]", fmt.Sprintf("%t", *query.publishedResponse))
+	}
 	if query.sort != "" {
 		values.Set("sort", query.sort)
 	}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 
 	return values.Encode()
@@ -811,6 +829,7 @@ func buildBundleIDsQuery(query *bundleIDsQuery) string {
 
 func buildPromotedPurchasesQuery(query *promotedPurchasesQuery) string {
 	values := url.Values{}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -931,6 +950,12 @@ func buildProfilesQuery(query *profilesQuery) string {
 	}
 	addCSV(values, "filter[profileType]", query.profileTypes)
 	addCSV(values, "include", query.include)
+	if query.certificatesLimit > 0 {
+		values.Set("limit[certificates]", strconv.Itoa(query.certificatesLimit))
+	}
+	if query.devicesLimit > 0 {
+		values.Set("limit[devices]", strconv.Itoa(query.devicesLimit))
+	}
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -1061,6 +1086,9 @@ func buildAppEncryptionDeclarationDocumentFieldsQuery(fields []string) string {
 
 func buildUserInvitationsQuery(query *userInvitationsQuery) string {
 	values := url.Values{}
+	if strings.TrimSpace(query.email) != "" {
+		values.Set("filter[email]", strings.TrimSpace(query.email))
+	}
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -1414,12 +1442,14 @@ func buildAppCustomProductPageLocalizationScreenshotSetsQuery(query *appCustomPr
 
 func buildAppStoreVersionLocalizationPreviewSetsQuery(query *appStoreVersionLocalizationPreviewSetsQuery) string {
 	values := url.Values{}
+	addCSV(values, "filter[previewType]", query.previewTypes)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
 
 func buildAppStoreVersionLocalizationScreenshotSetsQuery(query *appStoreVersionLocalizationScreenshotSetsQuery) string {
 	values := url.Values{}
+	addCSV(values, "filter[screenshotDisplayType]", query.displayTypes)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -1522,11 +1552,20 @@ func buildLinkagesQuery(query *linkagesQuery) string {
 	return values.Encode()
 }
 
+func buildAppPricesQuery(query *appPricesQuery) string {
+	values := url.Values{}
+	values.Set("include", "appPricePoint,territory")
+	addCSV(values, "filter[territory]", query.territories)
+	addLimit(values, query.limit)
+	return values.Encode()
+}
+
 func buildPricePointsQuery(query *pricePointsQuery) string {
 	values := url.Values{}
 	if strings.TrimSpace(query.territory) != "" {
 		values.Set("filter[territory]", strings.TrimSpace(query.territory))
 	}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
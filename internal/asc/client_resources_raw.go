@@ -0,0 +1,32 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GetResourceJSON retrieves a single resource by API type and ID and returns
+// the raw response body. apiPath is the collection path, e.g. "/v1/builds".
+func (c *Client) GetResourceJSON(ctx context.Context, apiPath, id string) (json.RawMessage, error) {
+	apiPath = strings.TrimRight(strings.TrimSpace(apiPath), "/")
+	if !strings.HasPrefix(apiPath, "/v") {
+		return nil, fmt.Errorf("apiPath must start with an API version (e.g. /v1)")
+	}
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	data, err := c.do(ctx, http.MethodGet, apiPath+"/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("failed to parse response: invalid JSON")
+	}
+	return json.RawMessage(data), nil
+}
@@ -0,0 +1,39 @@
+package asc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetResourceJSON(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"build 1","attributes":{"processingState":"VALID"}}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.EscapedPath() != "/v1/builds/build%201" {
+			t.Fatalf("unexpected path: %s", req.URL.EscapedPath())
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	data, err := client.GetResourceJSON(context.Background(), "/v1/builds/", "build 1")
+	if err != nil {
+		t.Fatalf("GetResourceJSON() error: %v", err)
+	}
+	if !strings.Contains(string(data), `"processingState":"VALID"`) {
+		t.Fatalf("unexpected body: %s", data)
+	}
+}
+
+func TestGetResourceJSON_ValidatesInput(t *testing.T) {
+	client := &Client{}
+	if _, err := client.GetResourceJSON(context.Background(), "builds", "1"); err == nil {
+		t.Fatal("expected error for path without API version")
+	}
+	if _, err := client.GetResourceJSON(context.Background(), "/v1/builds", " "); err == nil {
+		t.Fatal("expected error for empty id")
+	}
+}
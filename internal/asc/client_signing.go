@@ -187,6 +187,35 @@ func (c *Client) CreateBundleIDCapability(ctx context.Context, bundleID string,
 	return &response, nil
 }
 
+// UpdateBundleIDCapability updates the settings of a bundle ID capability.
+func (c *Client) UpdateBundleIDCapability(ctx context.Context, capabilityID string, attrs BundleIDCapabilityUpdateAttributes) (*BundleIDCapabilityResponse, error) {
+	capabilityID = strings.TrimSpace(capabilityID)
+	request := BundleIDCapabilityUpdateRequest{
+		Data: BundleIDCapabilityUpdateData{
+			Type:       ResourceTypeBundleIdCapabilities,
+			ID:         capabilityID,
+			Attributes: &attrs,
+		},
+	}
+
+	body, err := BuildRequestBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, "PATCH", fmt.Sprintf("/v1/bundleIdCapabilities/%s", capabilityID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response BundleIDCapabilityResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &response, nil
+}
+
 // DeleteBundleIDCapability deletes a bundle ID capability by ID.
 func (c *Client) DeleteBundleIDCapability(ctx context.Context, capabilityID string) error {
 	capabilityID = strings.TrimSpace(capabilityID)
@@ -253,6 +282,12 @@ func (c *Client) GetCertificate(ctx context.Context, id string, opts ...Certific
 
 // CreateCertificate creates a new certificate.
 func (c *Client) CreateCertificate(ctx context.Context, csrContent string, certType string) (*CertificateResponse, error) {
+	return c.CreateCertificateWithRelationships(ctx, csrContent, certType, nil)
+}
+
+// CreateCertificateWithRelationships creates a certificate linked to a pass
+// type ID or merchant ID.
+func (c *Client) CreateCertificateWithRelationships(ctx context.Context, csrContent string, certType string, relationships *CertificateCreateRelationships) (*CertificateResponse, error) {
 	request := CertificateCreateRequest{
 		Data: CertificateCreateData{
 			Type: ResourceTypeCertificates,
@@ -260,6 +295,7 @@ func (c *Client) CreateCertificate(ctx context.Context, csrContent string, certT
 				CertificateType: strings.TrimSpace(certType),
 				CSRContent:      strings.TrimSpace(csrContent),
 			},
+			Relationships: relationships,
 		},
 	}
 
@@ -484,6 +484,87 @@ func (c *Client) CreateSubscriptionPromotionalOffer(ctx context.Context, subscri
 	return &response, nil
 }
 
+// CreateSubscriptionPromotionalOfferWithPrices creates a promotional offer
+// along with its territory prices.
+func (c *Client) CreateSubscriptionPromotionalOfferWithPrices(ctx context.Context, subscriptionID string, attrs SubscriptionPromotionalOfferCreateAttributes, prices []SubscriptionPromotionalOfferPrice) (*SubscriptionPromotionalOfferResponse, error) {
+	subscriptionID = strings.TrimSpace(subscriptionID)
+	if subscriptionID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("at least one price is required")
+	}
+
+	included := make([]SubscriptionPromotionalOfferPriceInlineCreate, 0, len(prices))
+	priceData := make([]ResourceData, 0, len(prices))
+	for idx, price := range prices {
+		territoryID := strings.ToUpper(strings.TrimSpace(price.TerritoryID))
+		pricePointID := strings.TrimSpace(price.PricePointID)
+		if territoryID == "" {
+			return nil, fmt.Errorf("territory ID is required")
+		}
+		if pricePointID == "" {
+			return nil, fmt.Errorf("price point ID is required")
+		}
+		resourceID := fmt.Sprintf("${local-price-%d}", idx+1)
+		priceData = append(priceData, ResourceData{
+			Type: ResourceTypeSubscriptionPromotionalOfferPrices,
+			ID:   resourceID,
+		})
+		included = append(included, SubscriptionPromotionalOfferPriceInlineCreate{
+			Type: ResourceTypeSubscriptionPromotionalOfferPrices,
+			ID:   resourceID,
+			Relationships: SubscriptionPromotionalOfferPriceRelationships{
+				Territory: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeTerritories,
+						ID:   territoryID,
+					},
+				},
+				SubscriptionPricePoint: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptionPricePoints,
+						ID:   pricePointID,
+					},
+				},
+			},
+		})
+	}
+
+	payload := SubscriptionPromotionalOfferCreateRequest{
+		Data: SubscriptionPromotionalOfferCreateData{
+			Type:       ResourceTypeSubscriptionPromotionalOffers,
+			Attributes: attrs,
+			Relationships: SubscriptionPromotionalOfferRelationships{
+				Subscription: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptions,
+						ID:   subscriptionID,
+					},
+				},
+				Prices: RelationshipList{Data: priceData},
+			},
+		},
+		Included: included,
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionPromotionalOffers", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPromotionalOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &response, nil
+}
+
 // UpdateSubscriptionPromotionalOffer updates a promotional offer.
 func (c *Client) UpdateSubscriptionPromotionalOffer(ctx context.Context, offerID string, priceIDs []string) (*SubscriptionPromotionalOfferResponse, error) {
 	priceIDs = normalizeList(priceIDs)
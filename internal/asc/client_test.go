@@ -19,6 +19,8 @@ func TestBuildReviewQuery(t *testing.T) {
 		WithTerritory("us"),
 		WithLimit(25),
 		WithReviewSort("-createdDate"),
+		WithReviewInclude([]string{"response"}),
+		WithReviewPublishedResponse(false),
 	})
 
 	values, err := url.ParseQuery(query)
@@ -41,6 +43,14 @@ func TestBuildReviewQuery(t *testing.T) {
 	if got := values.Get("sort"); got != "-createdDate" {
 		t.Fatalf("expected sort=-createdDate, got %q", got)
 	}
+
+	if got := values.Get("exists[publishedResponse]"); got != "false" {
+		t.Fatalf("expected exists[publishedResponse]=false, got %q", got)
+	}
+
+	if got := values.Get("include"); got != "response" {
+		t.Fatalf("expected include=response, got %q", got)
+	}
 }
 
 func TestBuildReviewQuery_InvalidRating(t *testing.T) {
@@ -0,0 +1,106 @@
+package asc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPITransportIsSharedAndTuned(t *testing.T) {
+	transport, ok := apiTransport().(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, got %T", apiTransport())
+	}
+	if !transport.ForceAttemptHTTP2 {
+		t.Fatal("expected HTTP/2 to be forced on")
+	}
+	if transport.DisableCompression {
+		t.Fatal("expected transparent gzip to stay enabled")
+	}
+	if transport.MaxIdleConnsPerHost < apiMaxIdleConnsPerHost {
+		t.Fatalf("expected at least %d idle conns per host, got %d", apiMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	}
+	if apiTransport() != transport {
+		t.Fatal("expected the transport to be shared across clients")
+	}
+}
+
+func TestAPITransportUsesReplacedDefaultTransport(t *testing.T) {
+	original := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = original })
+
+	replaced := roundTripFunc(func(*http.Request) (*http.Response, error) { return nil, nil })
+	http.DefaultTransport = replaced
+	if _, ok := apiTransport().(roundTripFunc); !ok {
+		t.Fatalf("expected replaced transport, got %T", apiTransport())
+	}
+}
+
+func newTransportTestClient(t *testing.T) *Client {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	return &Client{
+		httpClient: &http.Client{Transport: apiTransport()},
+		keyID:      "KEY123",
+		issuerID:   "ISS456",
+		privateKey: key,
+	}
+}
+
+func TestDo_RequestsAndDecodesGzip(t *testing.T) {
+	var acceptEncoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		_, _ = gz.Write([]byte(`{"data":[]}`))
+		_ = gz.Close()
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(buf.Bytes())
+	}))
+	defer server.Close()
+
+	data, err := newTransportTestClient(t).do(context.Background(), http.MethodGet, server.URL+"/v1/apps", nil)
+	if err != nil {
+		t.Fatalf("do() error: %v", err)
+	}
+	if acceptEncoding != "gzip" {
+		t.Fatalf("expected Accept-Encoding gzip, got %q", acceptEncoding)
+	}
+	if string(data) != `{"data":[]}` {
+		t.Fatalf("expected decoded body, got %q", data)
+	}
+}
+
+func TestDoStream_GzipReportsSkipTransferCompression(t *testing.T) {
+	var acceptEncoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		w.Header().Set("Content-Type", "application/a-gzip")
+		_, _ = w.Write([]byte("report"))
+	}))
+	defer server.Close()
+
+	resp, err := newTransportTestClient(t).doStream(context.Background(), http.MethodGet, server.URL+"/v1/financeReports", nil, "application/a-gzip")
+	if err != nil {
+		t.Fatalf("doStream() error: %v", err)
+	}
+	defer resp.Body.Close()
+	if _, err := io.ReadAll(resp.Body); err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if acceptEncoding != "identity" {
+		t.Fatalf("expected Accept-Encoding identity, got %q", acceptEncoding)
+	}
+}
@@ -1,11 +1,38 @@
 package asc
 
+import "fmt"
+
 // DeviceLocalUDIDResult represents CLI output for local device UDID lookup.
 type DeviceLocalUDIDResult struct {
 	UDID     string `json:"udid"`
 	Platform string `json:"platform"`
 }
 
+// DeviceAuditItem describes one device in the audit report.
+type DeviceAuditItem struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	UDID               string `json:"udid"`
+	Platform           string `json:"platform"`
+	Status             string `json:"status"`
+	DeviceClass        string `json:"deviceClass,omitempty"`
+	Model              string `json:"model,omitempty"`
+	AddedDate          string `json:"addedDate,omitempty"`
+	ProfileCount       int    `json:"profileCount"`
+	LastProfileID      string `json:"lastProfileId,omitempty"`
+	LastProfileName    string `json:"lastProfileName,omitempty"`
+	LastProfileCreated string `json:"lastProfileCreatedDate,omitempty"`
+}
+
+// DeviceAuditResult is the output of devices audit.
+type DeviceAuditResult struct {
+	Total           int               `json:"total"`
+	EnabledCount    int               `json:"enabledCount"`
+	DisabledCount   int               `json:"disabledCount"`
+	UnassignedCount int               `json:"unassignedCount"`
+	Devices         []DeviceAuditItem `json:"devices"`
+}
+
 func deviceLocalUDIDRows(result *DeviceLocalUDIDResult) ([]string, [][]string) {
 	headers := []string{"UDID", "Platform"}
 	rows := [][]string{{result.UDID, result.Platform}}
@@ -29,3 +56,78 @@ func devicesRows(resp *DevicesResponse) ([]string, [][]string) {
 	}
 	return headers, rows
 }
+
+// DeviceRegisterBulkItem represents one device from a bulk registration file.
+type DeviceRegisterBulkItem struct {
+	Line     int    `json:"line"`
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	UDID     string `json:"udid"`
+	Platform string `json:"platform"`
+	Status   string `json:"status"`
+}
+
+// DeviceRegisterBulkFailure represents a device that failed to register.
+type DeviceRegisterBulkFailure struct {
+	Line  int    `json:"line"`
+	UDID  string `json:"udid"`
+	Error string `json:"error"`
+}
+
+// DeviceRegisterBulkResult represents CLI output for bulk device registration.
+type DeviceRegisterBulkResult struct {
+	File            string                      `json:"file"`
+	DryRun          bool                        `json:"dryRun"`
+	Total           int                         `json:"total"`
+	RegisteredCount int                         `json:"registeredCount"`
+	SkippedCount    int                         `json:"skippedCount"`
+	Devices         []DeviceRegisterBulkItem    `json:"devices"`
+	Failures        []DeviceRegisterBulkFailure `json:"failures,omitempty"`
+}
+
+func deviceRegisterBulkResultRows(result *DeviceRegisterBulkResult) ([]string, [][]string) {
+	headers := []string{"Line", "ID", "Name", "UDID", "Platform", "Status"}
+	rows := make([][]string, 0, len(result.Devices)+len(result.Failures))
+	for _, item := range result.Devices {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", item.Line),
+			item.ID,
+			compactWhitespace(item.Name),
+			item.UDID,
+			item.Platform,
+			item.Status,
+		})
+	}
+	for _, failure := range result.Failures {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", failure.Line),
+			"",
+			"",
+			failure.UDID,
+			"",
+			"failed: " + compactWhitespace(failure.Error),
+		})
+	}
+	return headers, rows
+}
+
+func deviceAuditResultRows(result *DeviceAuditResult) ([]string, [][]string) {
+	headers := []string{"ID", "Name", "UDID", "Platform", "Status", "Class", "Model", "Added", "Profiles", "Last Profile", "Last Profile Created"}
+	rows := make([][]string, 0, len(result.Devices))
+	for _, item := range result.Devices {
+		rows = append(rows, []string{
+			item.ID,
+			item.Name,
+			item.UDID,
+			item.Platform,
+			item.Status,
+			item.DeviceClass,
+			item.Model,
+			item.AddedDate,
+			fmt.Sprintf("%d", item.ProfileCount),
+			item.LastProfileName,
+			item.LastProfileCreated,
+		})
+	}
+	return headers, rows
+}
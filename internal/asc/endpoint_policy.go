@@ -0,0 +1,122 @@
+package asc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// EndpointClass groups endpoints that share timeout and retry behavior.
+type EndpointClass string
+
+const (
+	// EndpointClassUploads covers asset and build file uploads.
+	EndpointClassUploads EndpointClass = config.EndpointClassUploads
+	// EndpointClassLists covers GET requests against the API.
+	EndpointClassLists EndpointClass = config.EndpointClassLists
+	// EndpointClassReports covers report downloads (sales, finance, metrics, logs).
+	EndpointClassReports EndpointClass = config.EndpointClassReports
+)
+
+// EndpointPolicy is the resolved timeout and retry behavior for an endpoint class.
+type EndpointPolicy struct {
+	// Timeout bounds each request attempt. Zero keeps the default request timeout.
+	Timeout time.Duration
+	Retry   RetryOptions
+	// RetryOnRateLimit retries rate-limited (429) responses.
+	RetryOnRateLimit bool
+	// RetryOnNotReady retries 404 responses from reports that are not generated yet.
+	RetryOnNotReady bool
+}
+
+// ResolveEndpointPolicy returns the policy for an endpoint class.
+// Every class starts from the global retry settings with rate-limit retries
+// on; endpoint_policies in config.json override them per class.
+// ASC_MAX_RETRIES still overrides max_retries.
+func ResolveEndpointPolicy(class EndpointClass) EndpointPolicy {
+	policy := EndpointPolicy{
+		Retry:            ResolveRetryOptions(),
+		RetryOnRateLimit: true,
+	}
+
+	cfg := loadConfig()
+	if cfg == nil {
+		return policy
+	}
+	override, ok := cfg.EndpointPolicies[string(class)]
+	if !ok {
+		return policy
+	}
+
+	if timeout, ok := override.Timeout.Value(); ok {
+		policy.Timeout = timeout
+	}
+	if _, ok := envValue("ASC_MAX_RETRIES"); !ok {
+		if raw := strings.TrimSpace(override.MaxRetries); raw != "" {
+			if parsed, err := strconv.Atoi(raw); err == nil && parsed >= 0 {
+				policy.Retry.MaxRetries = parsed
+			}
+		}
+	}
+	if override.RetryOnRateLimit != nil {
+		policy.RetryOnRateLimit = *override.RetryOnRateLimit
+	}
+	if override.RetryOnNotReady != nil {
+		policy.RetryOnNotReady = *override.RetryOnNotReady
+	}
+	return policy
+}
+
+// classifyError adjusts whether err is retried under the policy.
+func (p EndpointPolicy) classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var retryable *RetryableError
+	if errors.As(err, &retryable) {
+		if retryable.StatusCode == http.StatusTooManyRequests && !p.RetryOnRateLimit {
+			return retryable.Err
+		}
+		return err
+	}
+	if p.RetryOnNotReady && IsNotFound(err) {
+		return &RetryableError{Err: err}
+	}
+	return err
+}
+
+// detach removes the caller's deadline when the policy sets its own timeout,
+// so a class timeout longer than the command timeout takes effect. Explicit
+// cancellation (for example, Ctrl-C) still propagates.
+func (p EndpointPolicy) detach(ctx context.Context) (context.Context, context.CancelFunc) {
+	if p.Timeout <= 0 {
+		return ctx, func() {}
+	}
+	detached, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(ctx, func() {
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			cancel()
+		}
+	})
+	return detached, func() {
+		stop()
+		cancel()
+	}
+}
+
+// withTimeout returns a copy of the client whose HTTP requests use timeout.
+func (c *Client) withTimeout(timeout time.Duration) *Client {
+	if timeout <= 0 || c.httpClient == nil {
+		return c
+	}
+	httpClient := *c.httpClient
+	httpClient.Timeout = timeout
+	clone := *c
+	clone.httpClient = &httpClient
+	return &clone
+}
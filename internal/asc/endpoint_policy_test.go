@@ -0,0 +1,191 @@
+package asc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeEndpointPolicyConfig(t *testing.T, body string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	t.Setenv("ASC_CONFIG_PATH", path)
+	for _, name := range []string{"ASC_MAX_RETRIES", "ASC_BASE_DELAY", "ASC_MAX_DELAY", "ASC_UPLOAD_TIMEOUT", "ASC_UPLOAD_TIMEOUT_SECONDS"} {
+		t.Setenv(name, "")
+		os.Unsetenv(name)
+	}
+}
+
+func newSequenceClient(t *testing.T, responses ...*http.Response) (*Client, *int) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	calls := 0
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if calls >= len(responses) {
+			t.Fatalf("unexpected request %d: %s %s", calls+1, req.Method, req.URL.String())
+		}
+		resp := responses[calls]
+		calls++
+		return resp, nil
+	})
+	return &Client{
+		httpClient: &http.Client{Transport: transport},
+		keyID:      "KEY123",
+		issuerID:   "ISS456",
+		privateKey: key,
+	}, &calls
+}
+
+func TestResolveEndpointPolicy_Defaults(t *testing.T) {
+	writeEndpointPolicyConfig(t, `{}`)
+
+	policy := ResolveEndpointPolicy(EndpointClassLists)
+	if policy.Timeout != 0 {
+		t.Fatalf("expected no class timeout, got %s", policy.Timeout)
+	}
+	if policy.Retry.MaxRetries != DefaultMaxRetries {
+		t.Fatalf("expected default retries, got %d", policy.Retry.MaxRetries)
+	}
+	if !policy.RetryOnRateLimit || policy.RetryOnNotReady {
+		t.Fatalf("unexpected default flags: %+v", policy)
+	}
+}
+
+func TestResolveEndpointPolicy_ConfigOverrides(t *testing.T) {
+	writeEndpointPolicyConfig(t, `{
+		"max_retries": "1",
+		"endpoint_policies": {
+			"uploads": {"timeout": "30m", "max_retries": "0", "retry_on_rate_limit": false},
+			"lists": {"timeout": "60s", "max_retries": "3"},
+			"reports": {"timeout": "5m", "retry_on_not_ready": true}
+		}
+	}`)
+
+	uploads := ResolveEndpointPolicy(EndpointClassUploads)
+	if uploads.Timeout != 30*time.Minute || uploads.Retry.MaxRetries != 0 || uploads.RetryOnRateLimit {
+		t.Fatalf("unexpected uploads policy: %+v", uploads)
+	}
+	lists := ResolveEndpointPolicy(EndpointClassLists)
+	if lists.Timeout != 60*time.Second || lists.Retry.MaxRetries != 3 || !lists.RetryOnRateLimit {
+		t.Fatalf("unexpected lists policy: %+v", lists)
+	}
+	reports := ResolveEndpointPolicy(EndpointClassReports)
+	if reports.Timeout != 5*time.Minute || reports.Retry.MaxRetries != 1 || !reports.RetryOnNotReady {
+		t.Fatalf("unexpected reports policy: %+v", reports)
+	}
+	if got := ResolveUploadTimeout(); got != 30*time.Minute {
+		t.Fatalf("expected upload timeout from policy, got %s", got)
+	}
+
+	t.Setenv("ASC_MAX_RETRIES", "5")
+	if got := ResolveEndpointPolicy(EndpointClassLists).Retry.MaxRetries; got != 5 {
+		t.Fatalf("expected ASC_MAX_RETRIES to win, got %d", got)
+	}
+}
+
+func TestDo_ListsPolicyDisablesRateLimitRetry(t *testing.T) {
+	writeEndpointPolicyConfig(t, `{
+		"base_delay": "1ms",
+		"max_delay": "1ms",
+		"endpoint_policies": {"lists": {"retry_on_rate_limit": false}}
+	}`)
+
+	client, calls := newSequenceClient(t,
+		jsonResponse(http.StatusTooManyRequests, `{"errors":[{"title":"Rate limit","detail":"Too many requests"}]}`),
+	)
+	_, err := client.GetApps(context.Background())
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if IsRetryable(err) {
+		t.Fatalf("expected non-retryable error, got %v", err)
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 request, got %d", *calls)
+	}
+}
+
+func TestDoStream_ReportsPolicyRetriesNotReady(t *testing.T) {
+	writeEndpointPolicyConfig(t, `{
+		"base_delay": "1ms",
+		"max_delay": "1ms",
+		"endpoint_policies": {"reports": {"retry_on_not_ready": true}}
+	}`)
+
+	ready := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/a-gzip"}},
+		Body:       io.NopCloser(strings.NewReader("gzip-bytes")),
+	}
+	client, calls := newSequenceClient(t,
+		jsonResponse(http.StatusNotFound, `{"errors":[{"code":"NOT_FOUND","title":"Report not available","detail":"not yet generated"}]}`),
+		ready,
+	)
+
+	download, err := client.DownloadFinanceReport(context.Background(), FinanceReportParams{})
+	if err != nil {
+		t.Fatalf("DownloadFinanceReport() error: %v", err)
+	}
+	defer download.Body.Close()
+	data, err := io.ReadAll(download.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if string(data) != "gzip-bytes" {
+		t.Fatalf("unexpected body %q", data)
+	}
+	if *calls != 2 {
+		t.Fatalf("expected 2 requests, got %d", *calls)
+	}
+}
+
+func TestDoStream_NotReadyFailsWithoutPolicy(t *testing.T) {
+	writeEndpointPolicyConfig(t, `{}`)
+
+	client, calls := newSequenceClient(t,
+		jsonResponse(http.StatusNotFound, `{"errors":[{"code":"NOT_FOUND","title":"Report not available","detail":"not yet generated"}]}`),
+	)
+	if _, err := client.DownloadFinanceReport(context.Background(), FinanceReportParams{}); !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 request, got %d", *calls)
+	}
+}
+
+func TestEndpointPolicyDetach(t *testing.T) {
+	policy := EndpointPolicy{Timeout: time.Minute}
+
+	expired, cancelExpired := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancelExpired()
+	<-expired.Done()
+	detached, release := policy.detach(expired)
+	if detached.Err() != nil {
+		t.Fatalf("expected detached context to ignore parent deadline, got %v", detached.Err())
+	}
+	release()
+
+	parent, cancelParent := context.WithCancel(context.Background())
+	detached, release = policy.detach(parent)
+	defer release()
+	cancelParent()
+	select {
+	case <-detached.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected parent cancellation to propagate")
+	}
+}
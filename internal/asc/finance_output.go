@@ -13,6 +13,9 @@ type FinanceReportResult struct {
 	Decompressed      bool   `json:"decompressed"`
 	DecompressedPath  string `json:"decompressedPath,omitempty"`
 	DecompressedBytes int64  `json:"decompressedSize,omitempty"`
+	SQLitePath        string `json:"sqlitePath,omitempty"`
+	ParquetPath       string `json:"parquetPath,omitempty"`
+	ExportedRows      int    `json:"exportedRows,omitempty"`
 }
 
 func financeReportResultRows(result *FinanceReportResult) ([]string, [][]string) {
@@ -11,6 +11,58 @@ type InAppPurchaseDeleteResult struct {
 	Deleted bool   `json:"deleted"`
 }
 
+// IAPTaxTreatmentResult represents CLI output comparing an in-app purchase
+// price point's proceeds across territories.
+type IAPTaxTreatmentResult struct {
+	PricePointID string                     `json:"pricePointId"`
+	Territories  []IAPTerritoryTaxTreatment `json:"territories"`
+}
+
+// IAPTerritoryTaxTreatment is one territory of an IAPTaxTreatmentResult.
+type IAPTerritoryTaxTreatment struct {
+	Territory     string `json:"territory"`
+	Currency      string `json:"currency,omitempty"`
+	PricePointID  string `json:"pricePointId"`
+	CustomerPrice string `json:"customerPrice"`
+	Proceeds      string `json:"proceeds"`
+	// ProceedsRate is proceeds as a percentage of the customer price, after
+	// Apple's commission and any taxes Apple withholds in the territory.
+	ProceedsRate string `json:"proceedsRate,omitempty"`
+}
+
+// IAPLocalizationImportItem is one locale of an IAP localization import.
+type IAPLocalizationImportItem struct {
+	Locale         string `json:"locale"`
+	Action         string `json:"action"`
+	LocalizationID string `json:"localizationId,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+}
+
+// IAPLocalizationImportResult is the result of an IAP localization import.
+type IAPLocalizationImportResult struct {
+	IAPID   string                      `json:"iapId"`
+	Dir     string                      `json:"dir"`
+	DryRun  bool                        `json:"dryRun"`
+	Locales []IAPLocalizationImportItem `json:"locales"`
+}
+
+// IAPSubmitCheck is one requirement checked before submitting an IAP.
+type IAPSubmitCheck struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Detail string `json:"detail"`
+}
+
+// IAPSubmitPreflightResult reports whether an IAP has everything App Review
+// needs.
+type IAPSubmitPreflightResult struct {
+	IAPID   string           `json:"iapId"`
+	Ready   bool             `json:"ready"`
+	Missing []string         `json:"missing"`
+	Checks  []IAPSubmitCheck `json:"checks"`
+}
+
 func inAppPurchasesRows(resp *InAppPurchasesV2Response) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Product ID", "Type", "State"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -241,3 +293,41 @@ func inAppPurchaseReviewScreenshotRows(resp *InAppPurchaseAppStoreReviewScreensh
 	}}
 	return headers, rows
 }
+
+func iapTaxTreatmentResultRows(result *IAPTaxTreatmentResult) ([]string, [][]string) {
+	headers := []string{"Territory", "Currency", "Customer Price", "Proceeds", "Proceeds %", "Price Point"}
+	rows := make([][]string, 0, len(result.Territories))
+	for _, item := range result.Territories {
+		rows = append(rows, []string{
+			item.Territory,
+			item.Currency,
+			item.CustomerPrice,
+			item.Proceeds,
+			item.ProceedsRate,
+			item.PricePointID,
+		})
+	}
+	return headers, rows
+}
+
+func iapLocalizationImportResultRows(result *IAPLocalizationImportResult) ([]string, [][]string) {
+	headers := []string{"Locale", "Action", "Localization ID", "Name", "Description"}
+	rows := make([][]string, 0, len(result.Locales))
+	for _, item := range result.Locales {
+		rows = append(rows, []string{item.Locale, item.Action, item.LocalizationID, item.Name, item.Description})
+	}
+	return headers, rows
+}
+
+func iapSubmitPreflightResultRows(result *IAPSubmitPreflightResult) ([]string, [][]string) {
+	headers := []string{"Check", "Status", "Detail"}
+	rows := make([][]string, 0, len(result.Checks))
+	for _, check := range result.Checks {
+		status := "ok"
+		if !check.Passed {
+			status = "missing"
+		}
+		rows = append(rows, []string{check.Name, status, check.Detail})
+	}
+	return headers, rows
+}
@@ -265,3 +265,44 @@ func TestPrintMarkdown_InAppPurchaseOfferCodes(t *testing.T) {
 		t.Fatalf("expected name in output, got: %s", output)
 	}
 }
+
+func TestPrintTable_IAPTaxTreatmentResult(t *testing.T) {
+	result := &IAPTaxTreatmentResult{
+		PricePointID: "pp-1",
+		Territories: []IAPTerritoryTaxTreatment{
+			{Territory: "FRA", Currency: "EUR", PricePointID: "pp-fra", CustomerPrice: "0.99", Proceeds: "0.69", ProceedsRate: "69.70"},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	if !strings.Contains(output, "Proceeds %") || !strings.Contains(output, "Price Point") {
+		t.Fatalf("expected header in output, got: %s", output)
+	}
+	if !strings.Contains(output, "FRA") || !strings.Contains(output, "69.70") {
+		t.Fatalf("expected territory row in output, got: %s", output)
+	}
+}
+
+func TestPrintMarkdown_IAPLocalizationImportResult(t *testing.T) {
+	result := &IAPLocalizationImportResult{
+		IAPID: "iap-1",
+		Dir:   "./localizations",
+		Locales: []IAPLocalizationImportItem{
+			{Locale: "de-DE", Action: "create", Name: "Münzen", Description: "Ein Haufen"},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintMarkdown(result)
+	})
+
+	if !strings.Contains(output, "Localization ID") {
+		t.Fatalf("expected markdown header, got: %s", output)
+	}
+	if !strings.Contains(output, "Münzen") || !strings.Contains(output, "Ein Haufen") {
+		t.Fatalf("expected locale row in output, got: %s", output)
+	}
+}
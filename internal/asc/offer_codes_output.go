@@ -5,6 +5,35 @@ import (
 	"strings"
 )
 
+// SubscriptionOfferCodesGenerateResult describes a generated one-time use code batch.
+type SubscriptionOfferCodesGenerateResult struct {
+	OfferCodeID    string `json:"offerCodeId"`
+	BatchID        string `json:"batchId"`
+	Count          int    `json:"count"`
+	ExpirationDate string `json:"expirationDate"`
+	File           string `json:"file,omitempty"`
+	Written        int    `json:"written"`
+}
+
+// SubscriptionOfferCodeCodeItem is a custom code or one-time use code batch of an offer code.
+type SubscriptionOfferCodeCodeItem struct {
+	OfferCodeID    string `json:"offerCodeId"`
+	OfferCodeName  string `json:"offerCodeName,omitempty"`
+	Kind           string `json:"kind"`
+	ID             string `json:"id"`
+	Code           string `json:"code,omitempty"`
+	NumberOfCodes  int    `json:"numberOfCodes"`
+	CreatedDate    string `json:"createdDate,omitempty"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+	Active         bool   `json:"active"`
+}
+
+// SubscriptionOfferCodeCodesResult lists the redemption codes of a subscription's offer codes.
+type SubscriptionOfferCodeCodesResult struct {
+	SubscriptionID string                          `json:"subscriptionId"`
+	Codes          []SubscriptionOfferCodeCodeItem `json:"codes"`
+}
+
 func offerCodesRows(resp *SubscriptionOfferCodeOneTimeUseCodesResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Codes", "Expires", "Created", "Active"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -51,3 +80,34 @@ func formatOfferCodeCustomerEligibilities(values []SubscriptionCustomerEligibili
 	}
 	return strings.Join(labels, ", ")
 }
+
+func subscriptionOfferCodesGenerateResultRows(result *SubscriptionOfferCodesGenerateResult) ([]string, [][]string) {
+	headers := []string{"Offer Code ID", "Batch ID", "Count", "Expiration Date", "File", "Written"}
+	rows := [][]string{{
+		result.OfferCodeID,
+		result.BatchID,
+		fmt.Sprintf("%d", result.Count),
+		result.ExpirationDate,
+		result.File,
+		fmt.Sprintf("%d", result.Written),
+	}}
+	return headers, rows
+}
+
+func subscriptionOfferCodeCodesResultRows(result *SubscriptionOfferCodeCodesResult) ([]string, [][]string) {
+	headers := []string{"Offer Code", "Kind", "ID", "Code", "Number Of Codes", "Created", "Expires", "Active"}
+	rows := make([][]string, 0, len(result.Codes))
+	for _, item := range result.Codes {
+		rows = append(rows, []string{
+			compactWhitespace(item.OfferCodeName),
+			item.Kind,
+			item.ID,
+			item.Code,
+			fmt.Sprintf("%d", item.NumberOfCodes),
+			item.CreatedDate,
+			item.ExpirationDate,
+			fmt.Sprintf("%t", item.Active),
+		})
+	}
+	return headers, rows
+}
@@ -0,0 +1,37 @@
+package asc
+
+import "strings"
+
+// ApplyChange describes a single change planned or made by asc apply.
+type ApplyChange struct {
+	Resource string   `json:"resource"`
+	Action   string   `json:"action"`
+	Target   string   `json:"target"`
+	Fields   []string `json:"fields,omitempty"`
+	Status   string   `json:"status"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// ApplyResult represents CLI output for asc apply.
+type ApplyResult struct {
+	File    string        `json:"file"`
+	AppID   string        `json:"appId"`
+	Applied bool          `json:"applied"`
+	Changes []ApplyChange `json:"changes"`
+}
+
+func applyResultRows(result *ApplyResult) ([]string, [][]string) {
+	headers := []string{"Resource", "Action", "Target", "Fields", "Status", "Error"}
+	rows := make([][]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		rows = append(rows, []string{
+			change.Resource,
+			change.Action,
+			change.Target,
+			strings.Join(change.Fields, ", "),
+			change.Status,
+			change.Error,
+		})
+	}
+	return headers, rows
+}
@@ -48,6 +48,30 @@ type AppBetaTestersUpdateResult struct {
 	Action    string   `json:"action"`
 }
 
+// BetaTesterSyncChange describes a single group membership change made or planned by a roster sync.
+type BetaTesterSyncChange struct {
+	Action   string `json:"action"`
+	GroupID  string `json:"groupId"`
+	Group    string `json:"group"`
+	Email    string `json:"email"`
+	TesterID string `json:"testerId,omitempty"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+// BetaTesterSyncResult represents CLI output for roster-based beta tester syncs.
+type BetaTesterSyncResult struct {
+	AppID         string                 `json:"appId"`
+	Source        string                 `json:"source"`
+	DryRun        bool                   `json:"dryRun"`
+	RemoveMissing bool                   `json:"removeMissing"`
+	Added         int                    `json:"added"`
+	Removed       int                    `json:"removed"`
+	Unchanged     int                    `json:"unchanged"`
+	Failed        int                    `json:"failed"`
+	Changes       []BetaTesterSyncChange `json:"changes"`
+}
+
 // BetaFeedbackSubmissionDeleteResult represents CLI output for beta feedback deletions.
 type BetaFeedbackSubmissionDeleteResult struct {
 	ID      string `json:"id"`
@@ -129,6 +153,22 @@ func appBetaTestersUpdateResultRows(result *AppBetaTestersUpdateResult) ([]strin
 	return headers, rows
 }
 
+func betaTesterSyncResultRows(result *BetaTesterSyncResult) ([]string, [][]string) {
+	headers := []string{"Action", "Group", "Email", "Tester ID", "Status", "Error"}
+	rows := make([][]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		rows = append(rows, []string{
+			change.Action,
+			change.Group,
+			change.Email,
+			change.TesterID,
+			change.Status,
+			change.Error,
+		})
+	}
+	return headers, rows
+}
+
 func betaFeedbackSubmissionDeleteResultRows(result *BetaFeedbackSubmissionDeleteResult) ([]string, [][]string) {
 	headers := []string{"ID", "Deleted"}
 	rows := [][]string{{result.ID, fmt.Sprintf("%t", result.Deleted)}}
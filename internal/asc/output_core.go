@@ -2,8 +2,10 @@ package asc
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 )
 
@@ -22,11 +24,77 @@ func PrintMarkdown(data interface{}) error {
 	return renderByRegistry(data, RenderMarkdown)
 }
 
+// WriteMarkdown writes data as Markdown tables to w. It returns false without
+// writing anything when the type has no registered table rendering.
+func WriteMarkdown(w io.Writer, data interface{}) (bool, error) {
+	return renderRegistered(data, func(headers []string, rows [][]string) {
+		renderMarkdownTo(w, headers, rows)
+	})
+}
+
+// OutputTable holds the headers and rows of one rendered table.
+type OutputTable struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// GitHubAnnotation is a workflow annotation shown on the run summary.
+// Results that carry validation findings return them from a
+// GitHubAnnotations method for the gha output format.
+type GitHubAnnotation struct {
+	// Level is "error", "warning", or "notice".
+	Level   string
+	Title   string
+	Message string
+}
+
+// CollectTables returns the tables data renders to, redacted when redaction is
+// enabled. It returns false when the type has no registered table rendering.
+func CollectTables(data interface{}) ([]OutputTable, bool, error) {
+	var tables []OutputTable
+	rendered, err := renderRegistered(data, func(headers []string, rows [][]string) {
+		if ResolveRedactEnabled() {
+			rows = redactRows(headers, rows)
+		}
+		tables = append(tables, OutputTable{Headers: headers, Rows: rows})
+	})
+	return tables, rendered, err
+}
+
 // PrintTable prints data as a formatted table.
 func PrintTable(data interface{}) error {
 	return renderByRegistry(data, RenderTable)
 }
 
+// PrintCSV prints data's table rows as CSV, with a blank line between tables
+// of multi-table types. It fails for types without a registered table
+// rendering.
+func PrintCSV(data interface{}) error {
+	tables, rendered, err := CollectTables(data)
+	if err != nil {
+		return err
+	}
+	if !rendered {
+		return fmt.Errorf("csv output is not supported for %T", data)
+	}
+	writer := csv.NewWriter(os.Stdout)
+	for i, table := range tables {
+		if i > 0 {
+			if err := writer.Write(nil); err != nil {
+				return err
+			}
+		}
+		if err := writer.Write(table.Headers); err != nil {
+			return err
+		}
+		if err := writer.WriteAll(table.Rows); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
 // PrintJSON prints data as minified JSON (best for AI agents).
 func PrintJSON(data interface{}) error {
 	enc := json.NewEncoder(os.Stdout)
@@ -0,0 +1,35 @@
+package asc
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// OutputDiffItem describes a single item that changed between two runs of a command.
+type OutputDiffItem struct {
+	Change string          `json:"change"`
+	Type   string          `json:"type,omitempty"`
+	ID     string          `json:"id,omitempty"`
+	Fields []string        `json:"fields,omitempty"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// OutputDiffResult represents CLI output for --diff-since-last.
+type OutputDiffResult struct {
+	Command       string           `json:"command"`
+	PreviousRunAt string           `json:"previousRunAt"`
+	Added         int              `json:"added"`
+	Removed       int              `json:"removed"`
+	Changed       int              `json:"changed"`
+	Items         []OutputDiffItem `json:"items"`
+}
+
+func outputDiffResultRows(result *OutputDiffResult) ([]string, [][]string) {
+	headers := []string{"Change", "Type", "ID", "Fields"}
+	rows := make([][]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		rows = append(rows, []string{item.Change, item.Type, item.ID, strings.Join(item.Fields, ", ")})
+	}
+	return headers, rows
+}
@@ -0,0 +1,25 @@
+package asc
+
+// AppStoreLink describes a single shareable App Store URL.
+type AppStoreLink struct {
+	Kind       string `json:"kind"`
+	Locale     string `json:"locale,omitempty"`
+	Storefront string `json:"storefront,omitempty"`
+	Name       string `json:"name,omitempty"`
+	URL        string `json:"url"`
+}
+
+// AppStoreLinksResult represents CLI output for generated App Store links.
+type AppStoreLinksResult struct {
+	AppID string         `json:"appId"`
+	Links []AppStoreLink `json:"links"`
+}
+
+func appStoreLinksResultRows(result *AppStoreLinksResult) ([]string, [][]string) {
+	headers := []string{"Kind", "Locale", "Storefront", "Name", "URL"}
+	rows := make([][]string, 0, len(result.Links))
+	for _, link := range result.Links {
+		rows = append(rows, []string{link.Kind, link.Locale, link.Storefront, link.Name, link.URL})
+	}
+	return headers, rows
+}
@@ -45,12 +45,13 @@ type LocalizationUploadLocaleResult struct {
 
 // LocalizationUploadResult represents CLI output for localization uploads.
 type LocalizationUploadResult struct {
-	Type      string                           `json:"type"`
-	VersionID string                           `json:"versionId,omitempty"`
-	AppID     string                           `json:"appId,omitempty"`
-	AppInfoID string                           `json:"appInfoId,omitempty"`
-	DryRun    bool                             `json:"dryRun"`
-	Results   []LocalizationUploadLocaleResult `json:"results"`
+	Type            string                           `json:"type"`
+	SourceVersionID string                           `json:"sourceVersionId,omitempty"`
+	VersionID       string                           `json:"versionId,omitempty"`
+	AppID           string                           `json:"appId,omitempty"`
+	AppInfoID       string                           `json:"appInfoId,omitempty"`
+	DryRun          bool                             `json:"dryRun"`
+	Results         []LocalizationUploadLocaleResult `json:"results"`
 }
 
 func appStoreVersionLocalizationsRows(resp *AppStoreVersionLocalizationsResponse) ([]string, [][]string) {
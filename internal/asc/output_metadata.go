@@ -0,0 +1,173 @@
+package asc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// metadataDiffValueWidth is the maximum characters of a value shown in
+// metadata diff tables.
+const metadataDiffValueWidth = 60
+
+// MetadataDiffChange is one field that differs between local and remote metadata.
+type MetadataDiffChange struct {
+	Locale string `json:"locale"`
+	Field  string `json:"field"`
+	Change string `json:"change"`
+	Local  string `json:"local,omitempty"`
+	Remote string `json:"remote,omitempty"`
+}
+
+// MetadataDiffResult is the result of a metadata diff.
+type MetadataDiffResult struct {
+	Dir       string               `json:"dir"`
+	VersionID string               `json:"versionId"`
+	AppID     string               `json:"appId,omitempty"`
+	Changes   []MetadataDiffChange `json:"changes"`
+	Added     int                  `json:"added"`
+	Changed   int                  `json:"changed"`
+	Removed   int                  `json:"removed"`
+	InSync    bool                 `json:"inSync"`
+}
+
+// KeywordsLocaleReport describes the keywords of one locale.
+type KeywordsLocaleReport struct {
+	Locale           string   `json:"locale"`
+	Keywords         string   `json:"keywords"`
+	Characters       int      `json:"characters"`
+	Limit            int      `json:"limit"`
+	Remaining        int      `json:"remaining"`
+	Terms            int      `json:"terms"`
+	Duplicates       []string `json:"duplicates,omitempty"`
+	InTitle          []string `json:"inTitle,omitempty"`
+	WastedCharacters int      `json:"wastedCharacters"`
+	Suggested        string   `json:"suggested,omitempty"`
+	Dropped          []string `json:"dropped,omitempty"`
+	Action           string   `json:"action,omitempty"`
+}
+
+// KeywordsCheckResult is the result of a keywords check.
+type KeywordsCheckResult struct {
+	VersionID string                 `json:"versionId"`
+	AppID     string                 `json:"appId,omitempty"`
+	AppInfoID string                 `json:"appInfoId,omitempty"`
+	DryRun    bool                   `json:"dryRun,omitempty"`
+	Locales   []KeywordsLocaleReport `json:"locales"`
+}
+
+// MetadataLintFinding is one problem found by metadata lint.
+type MetadataLintFinding struct {
+	Locale   string `json:"locale"`
+	Field    string `json:"field,omitempty"`
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	Length   int    `json:"length,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+}
+
+// MetadataLintResult is the result of a metadata lint run.
+type MetadataLintResult struct {
+	Source       string                `json:"source"`
+	Dir          string                `json:"dir,omitempty"`
+	VersionID    string                `json:"versionId,omitempty"`
+	Locales      []string              `json:"locales"`
+	Findings     []MetadataLintFinding `json:"findings"`
+	ErrorCount   int                   `json:"errorCount"`
+	WarningCount int                   `json:"warningCount"`
+	Valid        bool                  `json:"valid"`
+}
+
+// GitHubAnnotations reports each finding as a workflow annotation.
+func (r *MetadataLintResult) GitHubAnnotations() []GitHubAnnotation {
+	annotations := make([]GitHubAnnotation, 0, len(r.Findings))
+	for _, finding := range r.Findings {
+		title := finding.Locale
+		if finding.Field != "" {
+			title += " " + finding.Field
+		}
+		annotations = append(annotations, GitHubAnnotation{
+			Level:   finding.Severity,
+			Title:   title,
+			Message: finding.Message,
+		})
+	}
+	return annotations
+}
+
+func metadataLintSummaryRows(result *MetadataLintResult) ([]string, [][]string) {
+	status := "PASSED"
+	if !result.Valid {
+		status = "FAILED"
+	}
+	headers := []string{"Result", "Source", "Locales", "Errors", "Warnings"}
+	rows := [][]string{{
+		status,
+		result.Source,
+		fmt.Sprintf("%d", len(result.Locales)),
+		fmt.Sprintf("%d", result.ErrorCount),
+		fmt.Sprintf("%d", result.WarningCount),
+	}}
+	return headers, rows
+}
+
+func metadataLintFindingsRows(findings []MetadataLintFinding) ([]string, [][]string) {
+	headers := []string{"Locale", "Field", "Rule", "Severity", "Message"}
+	rows := make([][]string, 0, len(findings))
+	for _, finding := range findings {
+		rows = append(rows, []string{finding.Locale, finding.Field, finding.Rule, finding.Severity, finding.Message})
+	}
+	return headers, rows
+}
+
+func keywordsCheckResultRows(result *KeywordsCheckResult) ([]string, [][]string) {
+	headers := []string{"Locale", "Characters", "Remaining", "Duplicates", "In Title", "Wasted", "Suggested"}
+	rows := make([][]string, 0, len(result.Locales))
+	for _, report := range result.Locales {
+		rows = append(rows, []string{
+			report.Locale,
+			fmt.Sprintf("%d/%d", report.Characters, report.Limit),
+			strconv.Itoa(report.Remaining),
+			strings.Join(report.Duplicates, ", "),
+			strings.Join(report.InTitle, ", "),
+			strconv.Itoa(report.WastedCharacters),
+			report.Suggested,
+		})
+	}
+	return headers, rows
+}
+
+func metadataDiffSummaryRows(result *MetadataDiffResult) ([]string, [][]string) {
+	status := "IN SYNC"
+	if !result.InSync {
+		status = "DRIFT DETECTED"
+	}
+	headers := []string{"Result", "Added", "Changed", "Removed"}
+	rows := [][]string{{
+		status,
+		fmt.Sprintf("%d", result.Added),
+		fmt.Sprintf("%d", result.Changed),
+		fmt.Sprintf("%d", result.Removed),
+	}}
+	return headers, rows
+}
+
+func metadataDiffChangesRows(changes []MetadataDiffChange) ([]string, [][]string) {
+	headers := []string{"Locale", "Field", "Change", "Local", "Remote"}
+	rows := make([][]string, 0, len(changes))
+	for _, change := range changes {
+		rows = append(rows, []string{change.Locale, change.Field, change.Change, metadataDiffValue(change.Local), metadataDiffValue(change.Remote)})
+	}
+	return headers, rows
+}
+
+// metadataDiffValue flattens a value to one line and shortens it for tables.
+func metadataDiffValue(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	runes := []rune(value)
+	if len(runes) > metadataDiffValueWidth {
+		return string(runes[:metadataDiffValueWidth-1]) + "…"
+	}
+	return value
+}
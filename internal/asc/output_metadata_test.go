@@ -0,0 +1,70 @@
+package asc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrintTable_MetadataLintResult(t *testing.T) {
+	result := &MetadataLintResult{
+		Source:     "local",
+		Locales:    []string{"en-US"},
+		ErrorCount: 1,
+		Findings: []MetadataLintFinding{
+			{Locale: "en-US", Field: "name", Rule: "max-length", Severity: "error", Message: "name is 31 characters; limit is 30"},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	if !strings.Contains(output, "FAILED") || !strings.Contains(output, "Warnings") {
+		t.Fatalf("expected summary in output, got: %s", output)
+	}
+	if !strings.Contains(output, "max-length") {
+		t.Fatalf("expected finding in output, got: %s", output)
+	}
+}
+
+func TestPrintTable_KeywordsCheckResult(t *testing.T) {
+	result := &KeywordsCheckResult{
+		VersionID: "version-1",
+		Locales: []KeywordsLocaleReport{
+			{Locale: "en-US", Characters: 40, Limit: 100, Remaining: 60, Duplicates: []string{"photo"}, WastedCharacters: 6},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	if !strings.Contains(output, "Duplicates") || !strings.Contains(output, "Suggested") {
+		t.Fatalf("expected header in output, got: %s", output)
+	}
+	if !strings.Contains(output, "40/100") || !strings.Contains(output, "photo") {
+		t.Fatalf("expected locale row in output, got: %s", output)
+	}
+}
+
+func TestPrintTable_MetadataDiffResult(t *testing.T) {
+	result := &MetadataDiffResult{
+		Dir:       "./metadata",
+		VersionID: "version-1",
+		Changes: []MetadataDiffChange{
+			{Locale: "en-US", Field: "description", Change: "changed", Local: strings.Repeat("word ", 20), Remote: "Old\ndescription"},
+		},
+		Changed: 1,
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	if !strings.Contains(output, "DRIFT DETECTED") {
+		t.Fatalf("expected summary in output, got: %s", output)
+	}
+	if !strings.Contains(output, "Old description") || !strings.Contains(output, "…") {
+		t.Fatalf("expected flattened and shortened values in output, got: %s", output)
+	}
+}
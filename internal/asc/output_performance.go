@@ -3,6 +3,8 @@ package asc
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 // PerformanceDownloadResult represents CLI output for performance downloads.
@@ -18,6 +20,101 @@ type PerformanceDownloadResult struct {
 	DecompressedSize      int64  `json:"decompressedSize,omitempty"`
 }
 
+// PerfPowerMetricPercentile is one flattened data point from an Xcode
+// metrics payload: a metric value for a device class and percentile at an
+// app version.
+type PerfPowerMetricPercentile struct {
+	Platform    string  `json:"platform,omitempty"`
+	Category    string  `json:"category"`
+	Metric      string  `json:"metric"`
+	Unit        string  `json:"unit,omitempty"`
+	Device      string  `json:"device"`
+	Percentile  string  `json:"percentile"`
+	Version     string  `json:"version"`
+	Value       float64 `json:"value"`
+	ErrorMargin float64 `json:"errorMargin,omitempty"`
+}
+
+// PerfPowerMetricPercentilesResult represents CLI output for flattened
+// performance/power metrics.
+type PerfPowerMetricPercentilesResult struct {
+	AppID   string                      `json:"appId,omitempty"`
+	BuildID string                      `json:"buildId,omitempty"`
+	Data    []PerfPowerMetricPercentile `json:"data"`
+}
+
+// Percentiles flattens the metrics payload into one entry per metric,
+// device class, percentile and version.
+func (r *PerfPowerMetricsResponse) Percentiles() ([]PerfPowerMetricPercentile, error) {
+	if r == nil || len(r.Data) == 0 {
+		return nil, fmt.Errorf("perf power metrics response is empty")
+	}
+
+	var payload struct {
+		ProductData []struct {
+			Platform         string `json:"platform"`
+			MetricCategories []struct {
+				Identifier string `json:"identifier"`
+				Metrics    []struct {
+					Identifier string `json:"identifier"`
+					Unit       struct {
+						Identifier  string `json:"identifier"`
+						DisplayName string `json:"displayName"`
+					} `json:"unit"`
+					Datasets []struct {
+						FilterCriteria struct {
+							Percentile          string `json:"percentile"`
+							Device              string `json:"device"`
+							DeviceMarketingName string `json:"deviceMarketingName"`
+						} `json:"filterCriteria"`
+						Points []struct {
+							Version     string  `json:"version"`
+							Value       float64 `json:"value"`
+							ErrorMargin float64 `json:"errorMargin"`
+						} `json:"points"`
+					} `json:"datasets"`
+				} `json:"metrics"`
+			} `json:"metricCategories"`
+		} `json:"productData"`
+	}
+	if err := json.Unmarshal(r.Data, &payload); err != nil {
+		return nil, fmt.Errorf("decode perf power metrics: %w", err)
+	}
+
+	items := make([]PerfPowerMetricPercentile, 0)
+	for _, product := range payload.ProductData {
+		for _, category := range product.MetricCategories {
+			for _, metric := range category.Metrics {
+				unit := metric.Unit.DisplayName
+				if unit == "" {
+					unit = metric.Unit.Identifier
+				}
+				for _, dataset := range metric.Datasets {
+					criteria := dataset.FilterCriteria
+					device := criteria.DeviceMarketingName
+					if device == "" {
+						device = criteria.Device
+					}
+					for _, point := range dataset.Points {
+						items = append(items, PerfPowerMetricPercentile{
+							Platform:    product.Platform,
+							Category:    category.Identifier,
+							Metric:      metric.Identifier,
+							Unit:        unit,
+							Device:      device,
+							Percentile:  strings.TrimPrefix(criteria.Percentile, "percentile."),
+							Version:     point.Version,
+							Value:       point.Value,
+							ErrorMargin: point.ErrorMargin,
+						})
+					}
+				}
+			}
+		}
+	}
+	return items, nil
+}
+
 type perfPowerMetricsSummary struct {
 	Version         string
 	ProductCount    int
@@ -109,6 +206,23 @@ func perfPowerMetricsRows(resp *PerfPowerMetricsResponse) ([]string, [][]string,
 	return headers, rows, nil
 }
 
+func perfPowerMetricPercentilesRows(result *PerfPowerMetricPercentilesResult) ([]string, [][]string) {
+	headers := []string{"Category", "Metric", "Device", "Percentile", "Version", "Value", "Unit"}
+	rows := make([][]string, 0, len(result.Data))
+	for _, item := range result.Data {
+		rows = append(rows, []string{
+			item.Category,
+			item.Metric,
+			item.Device,
+			item.Percentile,
+			item.Version,
+			strconv.FormatFloat(item.Value, 'f', -1, 64),
+			item.Unit,
+		})
+	}
+	return headers, rows
+}
+
 func diagnosticSignaturesRows(resp *DiagnosticSignaturesResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Type", "Weight", "Insight", "Signature"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -0,0 +1,50 @@
+package asc
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPerfPowerMetricsResponsePercentiles(t *testing.T) {
+	resp := &PerfPowerMetricsResponse{
+		Data: json.RawMessage(`{"version":"1.0","productData":[{"platform":"iOS","metricCategories":[
+			{"identifier":"launch","metrics":[{"identifier":"timeToFirstDraw","unit":{"identifier":"s","displayName":"s"},"datasets":[
+				{"filterCriteria":{"percentile":"percentile.fifty","device":"all_iphones","deviceMarketingName":"All iPhones"},"points":[
+					{"version":"1.0","value":0.42,"errorMargin":0.01},
+					{"version":"1.1","value":0.4}
+				]},
+				{"filterCriteria":{"percentile":"percentile.ninety","device":"iPad13,4"},"points":[{"version":"1.1","value":1.5}]}
+			]}]}
+		]}]}`),
+	}
+
+	items, err := resp.Percentiles()
+	if err != nil {
+		t.Fatalf("Percentiles() error: %v", err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d: %+v", len(items), items)
+	}
+	want := PerfPowerMetricPercentile{
+		Platform:    "iOS",
+		Category:    "launch",
+		Metric:      "timeToFirstDraw",
+		Unit:        "s",
+		Device:      "All iPhones",
+		Percentile:  "fifty",
+		Version:     "1.0",
+		Value:       0.42,
+		ErrorMargin: 0.01,
+	}
+	if items[0] != want {
+		t.Fatalf("unexpected first item: %+v", items[0])
+	}
+	if items[2].Device != "iPad13,4" || items[2].Percentile != "ninety" || items[2].Value != 1.5 {
+		t.Fatalf("expected device identifier fallback, got %+v", items[2])
+	}
+
+	headers, rows := perfPowerMetricPercentilesRows(&PerfPowerMetricPercentilesResult{Data: items})
+	if len(headers) != 7 || len(rows) != 3 || rows[0][5] != "0.42" {
+		t.Fatalf("unexpected rows: %v %v", headers, rows)
+	}
+}
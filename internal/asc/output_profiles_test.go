@@ -0,0 +1,59 @@
+package asc
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestPrintTable_ProfilesWithoutIncludeOmitsRelatedColumns(t *testing.T) {
+	resp := &ProfilesResponse{
+		Data: []Resource[ProfileAttributes]{
+			{
+				ID:            "p1",
+				Attributes:    ProfileAttributes{Name: "Store", ProfileType: "IOS_APP_STORE"},
+				Relationships: json.RawMessage(`{"devices":{"links":{"related":"https://example.com"}}}`),
+			},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(resp)
+	})
+
+	if strings.Contains(output, "Devices") || strings.Contains(output, "Certificates") {
+		t.Fatalf("expected no related columns, got: %s", output)
+	}
+}
+
+func TestPrintTable_ProfilesWithIncludedCertificatesAndDevices(t *testing.T) {
+	resp := &ProfilesResponse{
+		Data: []Resource[ProfileAttributes]{
+			{
+				ID:            "p1",
+				Attributes:    ProfileAttributes{Name: "Dev", ProfileType: "IOS_APP_DEVELOPMENT"},
+				Relationships: json.RawMessage(`{"certificates":{"data":[{"type":"certificates","id":"c1"}]},"devices":{"data":[{"type":"devices","id":"d1"},{"type":"devices","id":"d-missing"}]}}`),
+			},
+			{
+				ID:            "p2",
+				Attributes:    ProfileAttributes{Name: "Store", ProfileType: "IOS_APP_STORE"},
+				Relationships: json.RawMessage(`{"certificates":{"data":[{"type":"certificates","id":"c1"}]},"devices":{"data":[]}}`),
+			},
+		},
+		Included: json.RawMessage(`[
+			{"type":"certificates","id":"c1","attributes":{"name":"Apple Development","serialNumber":"ABC123"}},
+			{"type":"devices","id":"d1","attributes":{"name":"Test iPhone"}}
+		]`),
+	}
+
+	headers, rows := profilesRows(resp)
+	if got := strings.Join(headers, ","); got != "ID,Name,Type,State,Expiration,Certificates,Devices" {
+		t.Fatalf("unexpected headers: %s", got)
+	}
+	if rows[0][5] != "Apple Development (ABC123)" || rows[0][6] != "Test iPhone, d-missing" {
+		t.Fatalf("unexpected first row: %v", rows[0])
+	}
+	if rows[1][6] != "" {
+		t.Fatalf("expected no devices for store profile, got %q", rows[1][6])
+	}
+}
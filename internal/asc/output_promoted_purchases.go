@@ -19,6 +19,17 @@ type AppPromotedPurchasesLinkResult struct {
 	Action              string   `json:"action"`
 }
 
+// PromotedPurchaseImageUploadResult describes an uploaded promoted purchase image.
+type PromotedPurchaseImageUploadResult struct {
+	PromotedPurchaseID string `json:"promotedPurchaseId"`
+	ProductType        string `json:"productType"`
+	ProductID          string `json:"productId"`
+	ImageID            string `json:"imageId"`
+	FileName           string `json:"fileName"`
+	FileSize           int64  `json:"fileSize"`
+	State              string `json:"state,omitempty"`
+}
+
 func promotedPurchaseBool(value *bool) string {
 	if value == nil {
 		return ""
@@ -55,3 +66,17 @@ func appPromotedPurchasesLinkResultRows(result *AppPromotedPurchasesLinkResult)
 	}}
 	return headers, rows
 }
+
+func promotedPurchaseImageUploadResultRows(result *PromotedPurchaseImageUploadResult) ([]string, [][]string) {
+	headers := []string{"Promoted Purchase ID", "Product Type", "Product ID", "Image ID", "File Name", "File Size", "State"}
+	rows := [][]string{{
+		result.PromotedPurchaseID,
+		result.ProductType,
+		result.ProductID,
+		result.ImageID,
+		result.FileName,
+		fmt.Sprintf("%d", result.FileSize),
+		result.State,
+	}}
+	return headers, rows
+}
@@ -0,0 +1,103 @@
+package asc
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces masked cells in table and markdown output.
+const RedactedValue = "[redacted]"
+
+var redactOverride struct {
+	mu  sync.RWMutex
+	val *bool
+}
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// revenueHeaderTerms mark columns holding money figures.
+var revenueHeaderTerms = []string{"proceeds", "revenue", "sales", "amount", "earnings"}
+
+// personHeaderTerms mark columns holding people's names regardless of context.
+var personHeaderTerms = []string{"first name", "last name", "nickname", "reviewer"}
+
+// SetRedactOverride sets an explicit output redaction override.
+// When set, it takes precedence over env/config. When unset (nil), behavior falls back to env/config.
+func SetRedactOverride(value *bool) {
+	redactOverride.mu.Lock()
+	defer redactOverride.mu.Unlock()
+	redactOverride.val = value
+}
+
+// ResolveRedactEnabled returns whether table and markdown output should be redacted.
+// Precedence: explicit override > ASC_REDACT > config.
+func ResolveRedactEnabled() bool {
+	redactOverride.mu.RLock()
+	override := redactOverride.val
+	redactOverride.mu.RUnlock()
+	if override != nil {
+		return *override
+	}
+	if value, ok := envValue("ASC_REDACT"); ok {
+		enabled, _ := strconv.ParseBool(value)
+		return enabled
+	}
+	cfg := loadConfig()
+	if cfg == nil {
+		return false
+	}
+	enabled, _ := strconv.ParseBool(strings.TrimSpace(cfg.Redact))
+	return enabled
+}
+
+// redactRows masks emails, people's names, and revenue figures. Columns are
+// chosen by header; email addresses are masked wherever they appear. The
+// input rows are not modified.
+func redactRows(headers []string, rows [][]string) [][]string {
+	masked := make([]bool, len(headers))
+	hasEmailColumn := false
+	for i, header := range headers {
+		normalized := strings.ToLower(strings.TrimSpace(header))
+		if strings.Contains(normalized, "email") {
+			masked[i] = true
+			hasEmailColumn = true
+		}
+		if containsAny(normalized, revenueHeaderTerms) || containsAny(normalized, personHeaderTerms) {
+			masked[i] = true
+		}
+	}
+	// A plain "Name" next to an email column names a person (testers, users).
+	if hasEmailColumn {
+		for i, header := range headers {
+			if strings.EqualFold(strings.TrimSpace(header), "name") {
+				masked[i] = true
+			}
+		}
+	}
+
+	redacted := make([][]string, len(rows))
+	for r, row := range rows {
+		out := make([]string, len(row))
+		for c, cell := range row {
+			switch {
+			case c < len(masked) && masked[c] && strings.TrimSpace(cell) != "":
+				out[c] = RedactedValue
+			default:
+				out[c] = emailPattern.ReplaceAllString(cell, RedactedValue)
+			}
+		}
+		redacted[r] = out
+	}
+	return redacted
+}
+
+func containsAny(value string, terms []string) bool {
+	for _, term := range terms {
+		if strings.Contains(value, term) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package asc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRedactRows_MasksPeopleAndRevenue(t *testing.T) {
+	headers := []string{"ID", "Email", "Name", "Estimated Proceeds", "Comment"}
+	rows := [][]string{
+		{"tester-1", "jane@example.com", "Jane Doe", "0.70 USD", "ping ops@example.org please"},
+		{"tester-2", "", "", "", "no contact"},
+	}
+
+	got := redactRows(headers, rows)
+	want := [][]string{
+		{"tester-1", RedactedValue, RedactedValue, RedactedValue, "ping " + RedactedValue + " please"},
+		{"tester-2", "", "", "", "no contact"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("redactRows() = %v, want %v", got, want)
+	}
+	if rows[0][1] != "jane@example.com" {
+		t.Fatalf("expected input rows to be left untouched, got %v", rows[0])
+	}
+}
+
+func TestRedactRows_KeepsNameWithoutEmailColumn(t *testing.T) {
+	headers := []string{"ID", "Name", "Bundle ID"}
+	rows := [][]string{{"app-1", "My App", "com.example.app"}}
+
+	got := redactRows(headers, rows)
+	if !reflect.DeepEqual(got, rows) {
+		t.Fatalf("expected app names to be kept, got %v", got)
+	}
+}
+
+func TestRenderTable_RedactsWhenEnabled(t *testing.T) {
+	enabled := true
+	SetRedactOverride(&enabled)
+	t.Cleanup(func() { SetRedactOverride(nil) })
+
+	output := captureStdout(t, func() error {
+		RenderTable([]string{"ID", "Email"}, [][]string{{"tester-1", "tester@example.com"}})
+		return nil
+	})
+	if strings.Contains(output, "tester@example.com") {
+		t.Fatalf("expected email to be redacted, got: %s", output)
+	}
+	if !strings.Contains(output, RedactedValue) {
+		t.Fatalf("expected redacted marker, got: %s", output)
+	}
+}
+
+func TestResolveRedactEnabled_Env(t *testing.T) {
+	SetRedactOverride(nil)
+	t.Setenv("ASC_REDACT", "true")
+	if !ResolveRedactEnabled() {
+		t.Fatal("expected ASC_REDACT=true to enable redaction")
+	}
+
+	t.Setenv("ASC_REDACT", "0")
+	if ResolveRedactEnabled() {
+		t.Fatal("expected ASC_REDACT=0 to disable redaction")
+	}
+
+	disabled := false
+	SetRedactOverride(&disabled)
+	t.Cleanup(func() { SetRedactOverride(nil) })
+	t.Setenv("ASC_REDACT", "1")
+	if ResolveRedactEnabled() {
+		t.Fatal("expected override to take precedence over ASC_REDACT")
+	}
+}
@@ -66,22 +66,32 @@ func registerDirect[T any](fn func(*T, func([]string, [][]string)) error) {
 // using the provided render function (RenderTable or RenderMarkdown).
 // Falls back to JSON output for unregistered types.
 func renderByRegistry(data any, render func([]string, [][]string)) error {
+	rendered, err := renderRegistered(data, render)
+	if err != nil || rendered {
+		return err
+	}
+	return PrintJSON(data)
+}
+
+// renderRegistered renders data when its type is registered and reports
+// whether it did.
+func renderRegistered(data any, render func([]string, [][]string)) (bool, error) {
 	t := reflect.TypeOf(data)
 
 	// Check direct render registry first (multi-table types).
 	if fn, ok := directRenderRegistry[t]; ok {
-		return fn(data, render)
+		return true, fn(data, render)
 	}
 
 	// Standard single-table types.
 	if fn, ok := outputRegistry[t]; ok {
 		h, r, err := fn(data)
 		if err != nil {
-			return err
+			return true, err
 		}
 		render(h, r)
-		return nil
+		return true, nil
 	}
 
-	return PrintJSON(data)
+	return false, nil
 }
@@ -6,6 +6,8 @@ func init() {
 	registerRows(crashesRows)
 	registerRows(reviewsRows)
 	registerRows(customerReviewSummarizationsRows)
+	registerRows(reviewExportResultRows)
+	registerRows(reviewStatsResultRows)
 	registerRows(func(v *CustomerReviewResponse) ([]string, [][]string) {
 		return reviewsRows(&ReviewsResponse{Data: []Resource[ReviewAttributes]{v.Data}})
 	})
@@ -228,6 +230,9 @@ func init() {
 	registerRows(inAppPurchaseContentRows)
 	registerRows(inAppPurchasePriceScheduleRows)
 	registerRows(inAppPurchaseReviewScreenshotRows)
+	registerRows(iapTaxTreatmentResultRows)
+	registerRows(iapLocalizationImportResultRows)
+	registerRows(iapSubmitPreflightResultRows)
 	registerRows(appEventsRows)
 	registerRows(func(v *AppEventResponse) ([]string, [][]string) {
 		return appEventsRows(&AppEventsResponse{Data: []Resource[AppEventAttributes]{v.Data}})
@@ -267,6 +272,8 @@ func init() {
 	registerRowsErr(territoryAgeRatingsRows)
 	registerRows(offerCodeValuesRows)
 	registerRows(appPricePointsRows)
+	registerRows(pricePointFindResultRows)
+	registerRows(appSchedulePricesResultRows)
 	registerRows(appPriceScheduleRows)
 	registerRows(appPricesRows)
 	registerRows(buildsRows)
@@ -283,6 +290,8 @@ func init() {
 	registerRows(offerCodesRows)
 	registerRows(offerCodeCustomCodesRows)
 	registerRows(subscriptionOfferCodeRows)
+	registerRows(subscriptionOfferCodesGenerateResultRows)
+	registerRows(subscriptionOfferCodeCodesResultRows)
 	registerRows(winBackOffersRows)
 	registerRows(func(v *WinBackOfferResponse) ([]string, [][]string) {
 		return winBackOffersRows(&WinBackOffersResponse{Data: []Resource[WinBackOfferAttributes]{v.Data}})
@@ -292,6 +301,15 @@ func init() {
 	registerRows(func(v *AppStoreVersionResponse) ([]string, [][]string) {
 		return appStoreVersionsRows(&AppStoreVersionsResponse{Data: []Resource[AppStoreVersionAttributes]{v.Data}})
 	})
+	registerDirect(func(v *ReleaseNotes, render func([]string, [][]string)) error {
+		h, r := releaseNotesRows(v)
+		render(h, r)
+		if len(v.Localizations) > 0 {
+			lh, lr := releaseNotesLocalizationsRows(v.Localizations)
+			render(lh, lr)
+		}
+		return nil
+	})
 	registerRows(preReleaseVersionsRows)
 	registerRows(func(v *BuildResponse) ([]string, [][]string) {
 		return buildsRows(&BuildsResponse{Data: []Resource[BuildAttributes]{v.Data}})
@@ -322,10 +340,13 @@ func init() {
 	})
 	registerRows(winBackOfferDeleteResultRows)
 	registerRows(subscriptionPriceDeleteResultRows)
+	registerRows(subscriptionPriceImportResultRows)
+	registerRows(subscriptionPromotionalOfferSignatureParamsRows)
 	registerRowsErr(offerCodePricesRows)
 	registerRows(appAvailabilityRows)
 	registerRows(territoryAvailabilitiesRows)
 	registerRows(endAppAvailabilityPreOrderRows)
+	registerRows(preOrderStatusResultRows)
 	registerRows(func(v *PreReleaseVersionResponse) ([]string, [][]string) {
 		return preReleaseVersionsRows(&PreReleaseVersionsResponse{Data: []PreReleaseVersion{v.Data}})
 	})
@@ -342,6 +363,9 @@ func init() {
 		return betaBuildLocalizationsRows(&BetaBuildLocalizationsResponse{Data: []Resource[BetaBuildLocalizationAttributes]{v.Data}})
 	})
 	registerRows(appInfoLocalizationsRows)
+	registerRows(func(v *AppInfoLocalizationResponse) ([]string, [][]string) {
+		return appInfoLocalizationsRows(&AppInfoLocalizationsResponse{Data: []Resource[AppInfoLocalizationAttributes]{v.Data}})
+	})
 	registerRows(appScreenshotSetsRows)
 	registerRows(func(v *AppScreenshotSetResponse) ([]string, [][]string) {
 		return appScreenshotSetsRows(&AppScreenshotSetsResponse{Data: []Resource[AppScreenshotSetAttributes]{v.Data}})
@@ -376,6 +400,8 @@ func init() {
 	})
 	registerRows(devicesRows)
 	registerRows(deviceLocalUDIDRows)
+	registerRows(deviceRegisterBulkResultRows)
+	registerRows(deviceAuditResultRows)
 	registerRows(func(v *DeviceResponse) ([]string, [][]string) {
 		return devicesRows(&DevicesResponse{Data: []Resource[DeviceAttributes]{v.Data}})
 	})
@@ -385,6 +411,8 @@ func init() {
 	})
 	registerRows(userDeleteResultRows)
 	registerRows(userInvitationRevokeResultRows)
+	registerRows(userOffboardResultRows)
+	registerRows(userAuditResultRows)
 	registerRows(betaAppReviewDetailsRows)
 	registerRows(func(v *BetaAppReviewDetailResponse) ([]string, [][]string) {
 		return betaAppReviewDetailsRows(&BetaAppReviewDetailsResponse{Data: []Resource[BetaAppReviewDetailAttributes]{v.Data}})
@@ -441,6 +469,18 @@ func init() {
 	registerRows(buildExpireAllResultRows)
 	registerRows(appScreenshotListResultRows)
 	registerRows(appPreviewListResultRows)
+	registerRows(screenshotDownloadResultRows)
+	registerRows(screenshotReorderResultRows)
+	registerRows(screenshotSetsDeleteResultRows)
+	registerDirect(func(v *ScreenshotValidateResult, render func([]string, [][]string)) error {
+		h, r := screenshotValidateResultMainRows(v)
+		render(h, r)
+		if len(v.Issues) > 0 {
+			ih, ir := screenshotValidationIssueRows(v.Issues)
+			render(ih, ir)
+		}
+		return nil
+	})
 	registerDirect(func(v *AppScreenshotUploadResult, render func([]string, [][]string)) error {
 		h, r := appScreenshotUploadResultMainRows(v)
 		render(h, r)
@@ -459,6 +499,15 @@ func init() {
 		}
 		return nil
 	})
+	registerDirect(func(v *ScreenshotSyncResult, render func([]string, [][]string)) error {
+		h, r := screenshotSyncResultMainRows(v)
+		render(h, r)
+		if len(v.Items) > 0 {
+			ih, ir := screenshotSyncItemRows(v.Items)
+			render(ih, ir)
+		}
+		return nil
+	})
 	registerRows(appClipAdvancedExperienceImageUploadResultRows)
 	registerRows(appClipHeaderImageUploadResultRows)
 	registerRows(assetDeleteResultRows)
@@ -472,7 +521,11 @@ func init() {
 	registerRows(testFlightPublishResultRows)
 	registerRows(appStorePublishResultRows)
 	registerRows(salesReportResultRows)
+	registerRows(salesSummaryResultRows)
+	registerRows(proceedsSummaryResultRows)
 	registerRows(financeReportResultRows)
+	registerRows(reportBackfillResultRows)
+	registerRows(reportWaitResultRows)
 	registerRows(financeRegionsRows)
 	registerRows(analyticsReportRequestResultRows)
 	registerRows(analyticsReportRequestDeleteResultRows)
@@ -481,6 +534,7 @@ func init() {
 		return analyticsReportRequestsRows(&AnalyticsReportRequestsResponse{Data: []AnalyticsReportRequestResource{v.Data}, Links: v.Links})
 	})
 	registerRows(analyticsReportDownloadResultRows)
+	registerRows(analyticsReportDownloadAllResultRows)
 	registerRows(analyticsReportGetResultRows)
 	registerRows(analyticsReportsRows)
 	registerRows(func(v *AnalyticsReportResponse) ([]string, [][]string) {
@@ -675,6 +729,11 @@ func init() {
 	registerRows(subscriptionDeleteResultRows)
 	registerRows(betaTesterDeleteResultRows)
 	registerRows(betaTesterGroupsUpdateResultRows)
+	registerRows(betaTesterSyncResultRows)
+	registerRows(appStoreLinksResultRows)
+	registerRows(outputDiffResultRows)
+	registerRows(applyResultRows)
+	registerRows(waitResultRows)
 	registerRows(betaTesterAppsUpdateResultRows)
 	registerRows(betaTesterBuildsUpdateResultRows)
 	registerRows(appBetaTestersUpdateResultRows)
@@ -684,6 +743,7 @@ func init() {
 	registerRows(betaBuildLocalizationDeleteResultRows)
 	registerRows(betaTesterInvitationResultRows)
 	registerRows(promotedPurchaseDeleteResultRows)
+	registerRows(promotedPurchaseImageUploadResultRows)
 	registerRows(appPromotedPurchasesLinkResultRows)
 	registerRows(sandboxTesterClearHistoryResultRows)
 	registerRows(bundleIDDeleteResultRows)
@@ -694,12 +754,18 @@ func init() {
 	registerRows(merchantIDDeleteResultRows)
 	registerRows(passTypeIDDeleteResultRows)
 	registerRows(bundleIDCapabilityDeleteResultRows)
+	registerRows(bundleIDCapabilityStateResultRows)
 	registerRows(certificateRevokeResultRows)
+	registerRows(certificateRevokeAllResultRows)
+	registerRows(certificateExpiryReportRows)
+	registerRows(certificateDownloadResultRows)
+	registerRows(profileRepairResultRows)
 	registerRows(profileDeleteResultRows)
 	registerRows(endUserLicenseAgreementRows)
 	registerRows(endUserLicenseAgreementDeleteResultRows)
 	registerRows(profileDownloadResultRows)
 	registerRows(signingFetchResultRows)
+	registerRows(signingSetupResultRows)
 	registerRows(xcodeCloudRunResultRows)
 	registerRows(xcodeCloudStatusResultRows)
 	registerRows(ciProductsRows)
@@ -752,10 +818,22 @@ func init() {
 		return ciIssuesRows(&CiIssuesResponse{Data: []CiIssueResource{v.Data}})
 	})
 	registerRows(ciArtifactDownloadResultRows)
+	registerRows(ciArtifactsDownloadResultRows)
+	registerRows(ciIssuesListResultRows)
+	registerDirect(func(v *CiTestResultsSummary, render func([]string, [][]string)) error {
+		h, r := ciTestResultsSummaryRows(v)
+		render(h, r)
+		if len(v.Failures) > 0 {
+			fh, fr := ciTestFailuresRows(v.Failures)
+			render(fh, fr)
+		}
+		return nil
+	})
 	registerRows(ciWorkflowDeleteResultRows)
 	registerRows(ciProductDeleteResultRows)
 	registerRows(customerReviewResponseRows)
 	registerRows(customerReviewResponseDeleteResultRows)
+	registerRows(customerReviewResponseBatchResultRows)
 	registerRows(accessibilityDeclarationDeleteResultRows)
 	registerRows(appStoreReviewAttachmentDeleteResultRows)
 	registerRows(routingAppCoverageDeleteResultRows)
@@ -807,10 +885,30 @@ func init() {
 	registerRows(appStoreVersionExperimentTreatmentDeleteResultRows)
 	registerRows(appStoreVersionExperimentTreatmentLocalizationDeleteResultRows)
 	registerRowsErr(perfPowerMetricsRows)
+	registerRows(perfPowerMetricPercentilesRows)
 	registerRows(diagnosticSignaturesRows)
 	registerRowsErr(diagnosticLogsRows)
 	registerRows(performanceDownloadResultRows)
 	registerRows(notarySubmissionStatusRows)
 	registerRows(notarySubmissionsListRows)
 	registerRows(notarySubmissionLogsRows)
+	registerDirect(func(v *MetadataDiffResult, render func([]string, [][]string)) error {
+		h, r := metadataDiffSummaryRows(v)
+		render(h, r)
+		if len(v.Changes) > 0 {
+			ch, cr := metadataDiffChangesRows(v.Changes)
+			render(ch, cr)
+		}
+		return nil
+	})
+	registerRows(keywordsCheckResultRows)
+	registerDirect(func(v *MetadataLintResult, render func([]string, [][]string)) error {
+		h, r := metadataLintSummaryRows(v)
+		render(h, r)
+		if len(v.Findings) > 0 {
+			fh, fr := metadataLintFindingsRows(v.Findings)
+			render(fh, fr)
+		}
+		return nil
+	})
 }
@@ -0,0 +1,79 @@
+package asc
+
+import "fmt"
+
+// ReleaseNotes is the output of release-notes generate.
+type ReleaseNotes struct {
+	AppID         string                     `json:"appId,omitempty"`
+	AppName       string                     `json:"appName,omitempty"`
+	BundleID      string                     `json:"bundleId,omitempty"`
+	VersionID     string                     `json:"versionId"`
+	Version       string                     `json:"version"`
+	Platform      string                     `json:"platform,omitempty"`
+	State         string                     `json:"state,omitempty"`
+	Build         *ReleaseNotesBuild         `json:"build,omitempty"`
+	PhasedRelease *ReleaseNotesPhasedRelease `json:"phasedRelease,omitempty"`
+	Localizations []ReleaseNotesLocalization `json:"localizations"`
+}
+
+// ReleaseNotesBuild is the build attached to the version.
+type ReleaseNotesBuild struct {
+	ID              string `json:"id"`
+	Number          string `json:"number"`
+	UploadedDate    string `json:"uploadedDate,omitempty"`
+	ProcessingState string `json:"processingState,omitempty"`
+	MinOSVersion    string `json:"minOsVersion,omitempty"`
+}
+
+// ReleaseNotesPhasedRelease is the version's phased release state.
+type ReleaseNotesPhasedRelease struct {
+	State              string `json:"state"`
+	StartDate          string `json:"startDate,omitempty"`
+	CurrentDayNumber   int    `json:"currentDayNumber,omitempty"`
+	TotalPauseDuration int    `json:"totalPauseDuration,omitempty"`
+}
+
+// ReleaseNotesLocalization is one locale's What's New and promotional text.
+type ReleaseNotesLocalization struct {
+	Locale          string `json:"locale"`
+	WhatsNew        string `json:"whatsNew,omitempty"`
+	PromotionalText string `json:"promotionalText,omitempty"`
+}
+
+func releaseNotesRows(notes *ReleaseNotes) ([]string, [][]string) {
+	headers := []string{"App", "Bundle ID", "Version", "Platform", "State", "Build", "Phased Release"}
+	build := ""
+	if notes.Build != nil {
+		build = notes.Build.Number
+	}
+	phased := ""
+	if notes.PhasedRelease != nil {
+		phased = notes.PhasedRelease.State
+		if notes.PhasedRelease.CurrentDayNumber > 0 {
+			phased += fmt.Sprintf(" (day %d)", notes.PhasedRelease.CurrentDayNumber)
+		}
+	}
+	rows := [][]string{{
+		compactWhitespace(notes.AppName),
+		notes.BundleID,
+		notes.Version,
+		notes.Platform,
+		notes.State,
+		build,
+		phased,
+	}}
+	return headers, rows
+}
+
+func releaseNotesLocalizationsRows(localizations []ReleaseNotesLocalization) ([]string, [][]string) {
+	headers := []string{"Locale", "What's New", "Promotional Text"}
+	rows := make([][]string, 0, len(localizations))
+	for _, item := range localizations {
+		rows = append(rows, []string{
+			item.Locale,
+			compactWhitespace(item.WhatsNew),
+			compactWhitespace(item.PromotionalText),
+		})
+	}
+	return headers, rows
+}
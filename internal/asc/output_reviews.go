@@ -0,0 +1,103 @@
+package asc
+
+import "strconv"
+
+// ReviewExportItem is one review in the export, with its response if any.
+type ReviewExportItem struct {
+	ID                   string `json:"id"`
+	CreatedDate          string `json:"createdDate"`
+	Rating               int    `json:"rating"`
+	Territory            string `json:"territory"`
+	ReviewerNickname     string `json:"reviewerNickname,omitempty"`
+	Title                string `json:"title,omitempty"`
+	Body                 string `json:"body,omitempty"`
+	ResponseID           string `json:"responseId,omitempty"`
+	ResponseBody         string `json:"responseBody,omitempty"`
+	ResponseState        string `json:"responseState,omitempty"`
+	ResponseLastModified string `json:"responseLastModifiedDate,omitempty"`
+}
+
+// ReviewExportResult is the output of reviews export.
+type ReviewExportResult struct {
+	AppID          string             `json:"appId"`
+	Since          string             `json:"since,omitempty"`
+	Until          string             `json:"until,omitempty"`
+	Total          int                `json:"total"`
+	RespondedCount int                `json:"respondedCount"`
+	Reviews        []ReviewExportItem `json:"reviews"`
+}
+
+// ReviewStatsBucket is the review count and average rating for one group.
+type ReviewStatsBucket struct {
+	Key           string  `json:"key"`
+	Platform      string  `json:"platform,omitempty"`
+	Count         int     `json:"count"`
+	Percent       float64 `json:"percent"`
+	AverageRating float64 `json:"averageRating"`
+}
+
+// ReviewStatsResult is the output of reviews stats.
+type ReviewStatsResult struct {
+	AppID         string              `json:"appId"`
+	Since         string              `json:"since"`
+	Until         string              `json:"until,omitempty"`
+	Total         int                 `json:"total"`
+	AverageRating float64             `json:"averageRating"`
+	ByTerritory   []ReviewStatsBucket `json:"byTerritory"`
+	ByRating      []ReviewStatsBucket `json:"byRating"`
+	ByVersion     []ReviewStatsBucket `json:"byVersion"`
+}
+
+func reviewExportResultRows(result *ReviewExportResult) ([]string, [][]string) {
+	headers := []string{"ID", "Created", "Rating", "Territory", "Reviewer", "Title", "Body", "Response ID", "Response", "Response State", "Response Modified"}
+	rows := make([][]string, 0, len(result.Reviews))
+	for _, item := range result.Reviews {
+		rows = append(rows, []string{
+			item.ID,
+			item.CreatedDate,
+			strconv.Itoa(item.Rating),
+			item.Territory,
+			item.ReviewerNickname,
+			item.Title,
+			item.Body,
+			item.ResponseID,
+			item.ResponseBody,
+			item.ResponseState,
+			item.ResponseLastModified,
+		})
+	}
+	return headers, rows
+}
+
+func reviewStatsResultRows(result *ReviewStatsResult) ([]string, [][]string) {
+	headers := []string{"Group", "Key", "Platform", "Count", "Percent", "Average Rating"}
+	rows := [][]string{{"all", "all", "", strconv.Itoa(result.Total), formatReviewStat(100), formatReviewStat(result.AverageRating)}}
+	if result.Total == 0 {
+		rows[0][4] = formatReviewStat(0)
+	}
+	groups := []struct {
+		name    string
+		buckets []ReviewStatsBucket
+	}{
+		{name: "territory", buckets: result.ByTerritory},
+		{name: "rating", buckets: result.ByRating},
+		{name: "version", buckets: result.ByVersion},
+	}
+	for _, group := range groups {
+		for _, bucket := range group.buckets {
+			rows = append(rows, []string{
+				group.name,
+				bucket.Key,
+				bucket.Platform,
+				strconv.Itoa(bucket.Count),
+				formatReviewStat(bucket.Percent),
+				formatReviewStat(bucket.AverageRating),
+			})
+		}
+	}
+	return headers, rows
+}
+
+func formatReviewStat(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
@@ -0,0 +1,36 @@
+package asc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrintCSV_ReviewStatsResult(t *testing.T) {
+	result := &ReviewStatsResult{
+		AppID:         "app-1",
+		Total:         2,
+		AverageRating: 4.5,
+		ByTerritory:   []ReviewStatsBucket{{Key: "USA", Count: 2, Percent: 100, AverageRating: 4.5}},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintCSV(result)
+	})
+
+	if !strings.Contains(output, "all,all,,2,100.00,4.50") {
+		t.Fatalf("expected overall row in output, got: %s", output)
+	}
+	if !strings.Contains(output, "territory,USA,,2,100.00,4.50") {
+		t.Fatalf("expected territory row in output, got: %s", output)
+	}
+}
+
+func TestPrintCSV_ReviewStatsResultEmpty(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return PrintCSV(&ReviewStatsResult{AppID: "app-1"})
+	})
+
+	if !strings.Contains(output, "all,all,,0,0.00,0.00") {
+		t.Fatalf("expected zero percent for empty stats, got: %s", output)
+	}
+}
@@ -3228,6 +3228,48 @@ func TestPrintTable_SalesReportResult(t *testing.T) {
 	}
 }
 
+func TestPrintCSV_SalesSummaryResult(t *testing.T) {
+	result := &SalesSummaryResult{
+		GroupBy: []string{"sku"},
+		Groups: []SalesSummaryGroup{
+			{Group: map[string]string{"sku": "com.example.pro"}, Currency: "USD", Units: 3, Proceeds: 4.2},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintCSV(result)
+	})
+
+	if !strings.Contains(output, "SKU,Currency,Units,Proceeds") {
+		t.Fatalf("expected group-by column header in output, got: %s", output)
+	}
+	if !strings.Contains(output, "com.example.pro,USD,3,4.20") {
+		t.Fatalf("expected summary row in output, got: %s", output)
+	}
+}
+
+func TestPrintCSV_ProceedsSummaryResult(t *testing.T) {
+	result := &ProceedsSummaryResult{
+		Currency: "USD",
+		Total:    12.5,
+		Apps:     []ProceedsTotal{{ID: "123", Name: "Example", Proceeds: 12.5}},
+		Regions:  []ProceedsTotal{{ID: "US", Proceeds: 12.5}},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintCSV(result)
+	})
+
+	if !strings.Contains(output, "Kind,ID,Name,Proceeds (USD)") {
+		t.Fatalf("expected currency header in output, got: %s", output)
+	}
+	for _, row := range []string{"app,123,Example,12.50", "region,US,,12.50", "total,,,12.50"} {
+		if !strings.Contains(output, row) {
+			t.Fatalf("expected row %q in output, got: %s", row, output)
+		}
+	}
+}
+
 func TestPrintTable_FinanceReportResult(t *testing.T) {
 	result := &FinanceReportResult{
 		VendorNumber: "12345678",
@@ -3371,15 +3413,18 @@ func TestPrintTable_AnalyticsReportRequests(t *testing.T) {
 }
 
 func TestPrintTable_SandboxTesters(t *testing.T) {
+	interrupt := true
 	resp := &SandboxTestersResponse{
 		Data: []Resource[SandboxTesterAttributes]{
 			{
 				ID: "tester-1",
 				Attributes: SandboxTesterAttributes{
-					AccountName: "tester@example.com",
-					FirstName:   "Test",
-					LastName:    "User",
-					Territory:   "USA",
+					AccountName:             "tester@example.com",
+					FirstName:               "Test",
+					LastName:                "User",
+					Territory:               "USA",
+					InterruptPurchases:      &interrupt,
+					SubscriptionRenewalRate: "MONTHLY_RENEWAL_EVERY_FIVE_MINUTES",
 				},
 			},
 		},
@@ -3395,6 +3440,9 @@ func TestPrintTable_SandboxTesters(t *testing.T) {
 	if !strings.Contains(output, "tester@example.com") {
 		t.Fatalf("expected tester email in output, got: %s", output)
 	}
+	if !strings.Contains(output, "Renewal Rate") || !strings.Contains(output, "MONTHLY_RENEWAL_EVERY_FIVE_MINUTES") {
+		t.Fatalf("expected renewal rate in output, got: %s", output)
+	}
 }
 
 func TestPrintTable_BetaTesterGroupsUpdateResult(t *testing.T) {
@@ -3473,6 +3521,19 @@ func TestPrintMarkdown_SandboxTesterClearHistoryResult(t *testing.T) {
 	}
 }
 
+func TestPrintTable_SandboxTesterClearHistoryResultMultipleTesters(t *testing.T) {
+	result := &SandboxTesterClearHistoryResult{
+		RequestID: "request-1",
+		TesterIDs: []string{"tester-1", "tester-2"},
+		Cleared:   true,
+	}
+
+	_, rows := sandboxTesterClearHistoryResultRows(result)
+	if len(rows) != 2 || rows[0][1] != "tester-1" || rows[1][1] != "tester-2" {
+		t.Fatalf("expected one row per tester, got %v", rows)
+	}
+}
+
 func TestPrintTable_Devices(t *testing.T) {
 	resp := &DevicesResponse{
 		Data: []Resource[DeviceAttributes]{
@@ -4646,3 +4707,26 @@ func TestPrintMarkdown_AppEventLocalizationDeleteResult(t *testing.T) {
 		t.Fatalf("expected localization id in output, got: %s", output)
 	}
 }
+
+func TestPrintCSV(t *testing.T) {
+	result := &PricePointFindResult{
+		Territories: []PricePointTerritoryPrice{
+			{Territory: "DEU", Currency: "EUR", CustomerPrice: "4,99", Proceeds: "3.5", PricePointID: "pp-deu"},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintCSV(result)
+	})
+
+	want := "Territory,Currency,Customer Price,Proceeds,Price Point ID\nDEU,EUR,\"4,99\",3.5,pp-deu\n"
+	if output != want {
+		t.Fatalf("unexpected csv:\n got %q\nwant %q", output, want)
+	}
+}
+
+func TestPrintCSV_UnregisteredType(t *testing.T) {
+	if err := PrintCSV(&struct{ Name string }{Name: "x"}); err == nil || !strings.Contains(err.Error(), "csv output is not supported") {
+		t.Fatalf("expected unsupported error, got %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// WaitResult represents CLI output for asc wait.
+type WaitResult struct {
+	Resource  string          `json:"resource"`
+	ID        string          `json:"id"`
+	Until     string          `json:"until"`
+	Satisfied bool            `json:"satisfied"`
+	Attempts  int             `json:"attempts"`
+	Elapsed   string          `json:"elapsed"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+func waitResultRows(result *WaitResult) ([]string, [][]string) {
+	headers := []string{"Resource", "ID", "Until", "Satisfied", "Attempts", "Elapsed"}
+	rows := [][]string{{
+		result.Resource,
+		result.ID,
+		result.Until,
+		fmt.Sprintf("%t", result.Satisfied),
+		fmt.Sprintf("%d", result.Attempts),
+		result.Elapsed,
+	}}
+	return headers, rows
+}
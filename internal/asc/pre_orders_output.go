@@ -1,7 +1,47 @@
 package asc
 
+import (
+	"fmt"
+	"strings"
+)
+
+// PreOrderTerritoryStatus describes the pre-order state of a single territory.
+type PreOrderTerritoryStatus struct {
+	Territory               string   `json:"territory"`
+	TerritoryAvailabilityID string   `json:"territoryAvailabilityId"`
+	Available               bool     `json:"available"`
+	PreOrderEnabled         bool     `json:"preOrderEnabled"`
+	ReleaseDate             string   `json:"releaseDate,omitempty"`
+	PreOrderPublishDate     string   `json:"preOrderPublishDate,omitempty"`
+	ContentStatuses         []string `json:"contentStatuses,omitempty"`
+}
+
+// PreOrderStatusResult represents CLI output for per-territory pre-order status.
+type PreOrderStatusResult struct {
+	AppID          string                    `json:"appId"`
+	AvailabilityID string                    `json:"availabilityId"`
+	PreOrderCount  int                       `json:"preOrderCount"`
+	Territories    []PreOrderTerritoryStatus `json:"territories"`
+}
+
 func endAppAvailabilityPreOrderRows(resp *EndAppAvailabilityPreOrderResponse) ([]string, [][]string) {
 	headers := []string{"ID"}
 	rows := [][]string{{resp.Data.ID}}
 	return headers, rows
 }
+
+func preOrderStatusResultRows(result *PreOrderStatusResult) ([]string, [][]string) {
+	headers := []string{"Territory", "Available", "Pre-Order", "Release Date", "Pre-Order Published", "Content Statuses"}
+	rows := make([][]string, 0, len(result.Territories))
+	for _, item := range result.Territories {
+		rows = append(rows, []string{
+			item.Territory,
+			fmt.Sprintf("%t", item.Available),
+			fmt.Sprintf("%t", item.PreOrderEnabled),
+			item.ReleaseDate,
+			item.PreOrderPublishDate,
+			strings.Join(item.ContentStatuses, ", "),
+		})
+	}
+	return headers, rows
+}
@@ -30,9 +30,11 @@ type AppAvailabilityV2Attributes struct {
 
 // TerritoryAvailabilityAttributes describes availability for a territory.
 type TerritoryAvailabilityAttributes struct {
-	Available       bool   `json:"available"`
-	ReleaseDate     string `json:"releaseDate,omitempty"`
-	PreOrderEnabled bool   `json:"preOrderEnabled,omitempty"`
+	Available           bool     `json:"available"`
+	ReleaseDate         string   `json:"releaseDate,omitempty"`
+	PreOrderEnabled     bool     `json:"preOrderEnabled,omitempty"`
+	PreOrderPublishDate string   `json:"preOrderPublishDate,omitempty"`
+	ContentStatuses     []string `json:"contentStatuses,omitempty"`
 }
 
 // Response types
@@ -52,6 +54,19 @@ type AppPriceScheduleCreateAttributes struct {
 	PricePointID    string `json:"-"`
 	StartDate       string `json:"-"`
 	BaseTerritoryID string `json:"-"`
+	// CurrentPricePointID, when set, stays in effect until StartDate so the
+	// new price is scheduled as a future change.
+	CurrentPricePointID string `json:"-"`
+	// AdditionalPrices are further manual prices kept in the new schedule,
+	// such as the prices of territories other than the base territory.
+	AdditionalPrices []AppPriceSchedulePrice `json:"-"`
+}
+
+// AppPriceSchedulePrice is a manual price included in a new price schedule.
+type AppPriceSchedulePrice struct {
+	PricePointID string
+	StartDate    string
+	EndDate      string
 }
 
 // AppPriceScheduleCreateRequest is a request to create a price schedule.
@@ -83,7 +98,8 @@ type AppPriceCreateResource struct {
 
 // AppPriceRelationships describes relationships for app prices.
 type AppPriceRelationships struct {
-	AppPricePoint Relationship `json:"appPricePoint"`
+	AppPricePoint Relationship  `json:"appPricePoint"`
+	Territory     *Relationship `json:"territory,omitempty"`
 }
 
 // AppAvailabilityV2CreateAttributes defines inputs for app availability.
@@ -2,6 +2,46 @@ package asc
 
 import "fmt"
 
+// PricePointTerritoryPrice is the equalized price of a price point in one territory.
+type PricePointTerritoryPrice struct {
+	Territory     string `json:"territory"`
+	Currency      string `json:"currency,omitempty"`
+	CustomerPrice string `json:"customerPrice"`
+	Proceeds      string `json:"proceeds"`
+	PricePointID  string `json:"pricePointId"`
+}
+
+// PricePointFindResult is a customer price resolved to its price point, with
+// the equalized prices of every territory.
+type PricePointFindResult struct {
+	AppID         string                     `json:"appId"`
+	BaseTerritory string                     `json:"baseTerritory"`
+	Price         string                     `json:"price"`
+	PricePointID  string                     `json:"pricePointId"`
+	Territories   []PricePointTerritoryPrice `json:"territories"`
+}
+
+// AppScheduledPrice is a current or upcoming app price in one territory.
+type AppScheduledPrice struct {
+	Territory     string `json:"territory"`
+	Currency      string `json:"currency,omitempty"`
+	Status        string `json:"status"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	Manual        bool   `json:"manual"`
+	CustomerPrice string `json:"customerPrice,omitempty"`
+	Proceeds      string `json:"proceeds,omitempty"`
+	PricePointID  string `json:"pricePointId,omitempty"`
+}
+
+// AppSchedulePricesResult lists the current and upcoming prices of an app.
+type AppSchedulePricesResult struct {
+	AppID      string              `json:"appId"`
+	ScheduleID string              `json:"scheduleId"`
+	Date       string              `json:"date"`
+	Prices     []AppScheduledPrice `json:"prices"`
+}
+
 func territoriesRows(resp *TerritoriesResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Currency"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -63,3 +103,30 @@ func territoryAvailabilitiesRows(resp *TerritoryAvailabilitiesResponse) ([]strin
 	}
 	return headers, rows
 }
+
+func pricePointFindResultRows(result *PricePointFindResult) ([]string, [][]string) {
+	headers := []string{"Territory", "Currency", "Customer Price", "Proceeds", "Price Point ID"}
+	rows := make([][]string, 0, len(result.Territories))
+	for _, item := range result.Territories {
+		rows = append(rows, []string{item.Territory, item.Currency, item.CustomerPrice, item.Proceeds, item.PricePointID})
+	}
+	return headers, rows
+}
+
+func appSchedulePricesResultRows(result *AppSchedulePricesResult) ([]string, [][]string) {
+	headers := []string{"Territory", "Status", "Start Date", "End Date", "Customer Price", "Currency", "Proceeds", "Manual"}
+	rows := make([][]string, 0, len(result.Prices))
+	for _, price := range result.Prices {
+		rows = append(rows, []string{
+			price.Territory,
+			price.Status,
+			price.StartDate,
+			price.EndDate,
+			price.CustomerPrice,
+			price.Currency,
+			price.Proceeds,
+			fmt.Sprintf("%t", price.Manual),
+		})
+	}
+	return headers, rows
+}
@@ -131,6 +131,25 @@ func TestGetAppPricePointEqualizations(t *testing.T) {
 	}
 }
 
+func TestGetAppPricePointEqualizations_WithQuery(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v3/appPricePoints/pp-1/equalizations" {
+			t.Fatalf("expected path /v3/appPricePoints/pp-1/equalizations, got %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("include") != "territory" {
+			t.Fatalf("expected include=territory, got %q", values.Get("include"))
+		}
+		if values.Get("limit") != "200" {
+			t.Fatalf("expected limit=200, got %q", values.Get("limit"))
+		}
+	}, jsonResponse(http.StatusOK, `{"data":[]}`))
+
+	if _, err := client.GetAppPricePointEqualizations(context.Background(), "pp-1", WithPricePointsInclude([]string{"territory"}), WithPricePointsLimit(200)); err != nil {
+		t.Fatalf("GetAppPricePointEqualizations() error: %v", err)
+	}
+}
+
 func TestGetAppPriceSchedule(t *testing.T) {
 	resp := AppPriceScheduleResponse{
 		Data: Resource[AppPriceScheduleAttributes]{
@@ -201,6 +220,9 @@ func TestGetAppPriceScheduleManualPrices(t *testing.T) {
 		if req.URL.Path != "/v1/appPriceSchedules/schedule-1/manualPrices" {
 			t.Fatalf("expected path /v1/appPriceSchedules/schedule-1/manualPrices, got %s", req.URL.Path)
 		}
+		if req.URL.Query().Get("include") != "appPricePoint,territory" {
+			t.Fatalf("expected include=appPricePoint,territory, got %q", req.URL.RawQuery)
+		}
 	}, jsonResponse(http.StatusOK, string(body)))
 
 	if _, err := client.GetAppPriceScheduleManualPrices(context.Background(), "schedule-1"); err != nil {
@@ -226,6 +248,25 @@ func TestGetAppPriceScheduleAutomaticPrices(t *testing.T) {
 	}
 }
 
+func TestGetAppPriceScheduleAutomaticPrices_WithQuery(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		values := req.URL.Query()
+		if values.Get("include") != "appPricePoint,territory" {
+			t.Fatalf("expected include=appPricePoint,territory, got %q", req.URL.RawQuery)
+		}
+		if values.Get("filter[territory]") != "GBR,JPN" {
+			t.Fatalf("expected filter[territory]=GBR,JPN, got %q", req.URL.RawQuery)
+		}
+		if values.Get("limit") != "200" {
+			t.Fatalf("expected limit=200, got %q", req.URL.RawQuery)
+		}
+	}, jsonResponse(http.StatusOK, `{"data":[]}`))
+
+	if _, err := client.GetAppPriceScheduleAutomaticPrices(context.Background(), "schedule-1", WithAppPricesTerritories([]string{"gbr", "JPN"}), WithAppPricesLimit(200)); err != nil {
+		t.Fatalf("GetAppPriceScheduleAutomaticPrices() error: %v", err)
+	}
+}
+
 func TestGetAppPriceScheduleBaseTerritory(t *testing.T) {
 	resp := TerritoryResponse{
 		Data: Resource[TerritoryAttributes]{
@@ -306,6 +347,76 @@ func TestCreateAppPriceSchedule(t *testing.T) {
 	}
 }
 
+func TestCreateAppPriceSchedule_KeepsCurrentPrice(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		var createReq AppPriceScheduleCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&createReq); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if len(createReq.Data.Relationships.ManualPrices.Data) != 2 || len(createReq.Included) != 2 {
+			t.Fatalf("expected 2 manual prices, got %+v", createReq)
+		}
+		current := createReq.Included[1]
+		if current.Relationships.AppPricePoint.Data.ID != "pp-current" {
+			t.Fatalf("expected current price point pp-current, got %q", current.Relationships.AppPricePoint.Data.ID)
+		}
+		if current.Attributes.StartDate != "" || current.Attributes.EndDate != "2024-03-01" {
+			t.Fatalf("expected current price to end 2024-03-01, got %+v", current.Attributes)
+		}
+		if createReq.Data.Relationships.ManualPrices.Data[1].ID != current.ID {
+			t.Fatalf("expected manual price relationship to match included id")
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"appPriceSchedules","id":"schedule-1"}}`))
+
+	_, err := client.CreateAppPriceSchedule(context.Background(), "app-1", AppPriceScheduleCreateAttributes{
+		PricePointID:        "pp-1",
+		StartDate:           "2024-03-01",
+		BaseTerritoryID:     "USA",
+		CurrentPricePointID: "pp-current",
+	})
+	if err != nil {
+		t.Fatalf("CreateAppPriceSchedule() error: %v", err)
+	}
+}
+
+func TestCreateAppPriceSchedule_AdditionalPrices(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		var createReq AppPriceScheduleCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&createReq); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if len(createReq.Data.Relationships.ManualPrices.Data) != 3 || len(createReq.Included) != 3 {
+			t.Fatalf("expected 3 manual prices, got %+v", createReq)
+		}
+		for i, price := range createReq.Included {
+			if createReq.Data.Relationships.ManualPrices.Data[i].ID != price.ID {
+				t.Fatalf("expected manual price relationship %d to match included id", i)
+			}
+		}
+		kept := createReq.Included[1]
+		if kept.Relationships.AppPricePoint.Data.ID != "pp-gbr" || kept.Attributes.StartDate != "" {
+			t.Fatalf("expected kept GBR price, got %+v", kept)
+		}
+		future := createReq.Included[2]
+		if future.Relationships.AppPricePoint.Data.ID != "pp-deu" || future.Attributes.StartDate != "2099-01-01" {
+			t.Fatalf("expected future DEU price, got %+v", future)
+		}
+	}, jsonResponse(http.StatusCreated, `{"data":{"type":"appPriceSchedules","id":"schedule-1"}}`))
+
+	_, err := client.CreateAppPriceSchedule(context.Background(), "app-1", AppPriceScheduleCreateAttributes{
+		PricePointID:    "pp-1",
+		StartDate:       "2024-03-01",
+		BaseTerritoryID: "USA",
+		AdditionalPrices: []AppPriceSchedulePrice{
+			{PricePointID: "pp-gbr"},
+			{PricePointID: "pp-deu", StartDate: "2099-01-01"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateAppPriceSchedule() error: %v", err)
+	}
+}
+
 func TestGetAppAvailabilityV2(t *testing.T) {
 	resp := AppAvailabilityV2Response{
 		Data: Resource[AppAvailabilityV2Attributes]{
@@ -7,6 +7,13 @@ type PromotedPurchaseAttributes struct {
 	State              string `json:"state,omitempty"`
 }
 
+// PromotedPurchaseRelationships describes the product a promoted purchase
+// promotes. App Store Connect only fills in the data when it is included.
+type PromotedPurchaseRelationships struct {
+	InAppPurchaseV2 *Relationship `json:"inAppPurchaseV2,omitempty"`
+	Subscription    *Relationship `json:"subscription,omitempty"`
+}
+
 // PromotedPurchasesResponse is the response from promoted purchases list endpoints.
 type PromotedPurchasesResponse = Response[PromotedPurchaseAttributes]
 
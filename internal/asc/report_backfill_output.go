@@ -0,0 +1,52 @@
+package asc
+
+import "fmt"
+
+// ReportBackfillItem is the outcome of downloading one report date during a
+// backfill.
+type ReportBackfillItem struct {
+	ReportDate       string `json:"reportDate"`
+	Status           string `json:"status"`
+	FilePath         string `json:"filePath"`
+	FileSize         int64  `json:"fileSize,omitempty"`
+	DecompressedPath string `json:"decompressedPath,omitempty"`
+	DecompressedSize int64  `json:"decompressedSize,omitempty"`
+	Attempts         int    `json:"attempts,omitempty"`
+	Error            string `json:"error,omitempty"`
+}
+
+// ReportBackfillResult represents CLI output for sales and finance report
+// backfills over a date range.
+type ReportBackfillResult struct {
+	Report       string               `json:"report"`
+	VendorNumber string               `json:"vendorNumber"`
+	From         string               `json:"from"`
+	To           string               `json:"to"`
+	OutputDir    string               `json:"outputDir"`
+	Downloaded   int                  `json:"downloaded"`
+	Skipped      int                  `json:"skipped"`
+	Pending      int                  `json:"pending"`
+	Failed       int                  `json:"failed"`
+	Items        []ReportBackfillItem `json:"items"`
+	SQLitePath   string               `json:"sqlitePath,omitempty"`
+	ParquetPath  string               `json:"parquetPath,omitempty"`
+	ExportedRows int                  `json:"exportedRows,omitempty"`
+}
+
+func reportBackfillResultRows(result *ReportBackfillResult) ([]string, [][]string) {
+	headers := []string{"Date", "Status", "File", "Size", "Decompressed File", "Decompressed Size", "Attempts", "Error"}
+	rows := make([][]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		rows = append(rows, []string{
+			item.ReportDate,
+			item.Status,
+			item.FilePath,
+			fmt.Sprintf("%d", item.FileSize),
+			item.DecompressedPath,
+			fmt.Sprintf("%d", item.DecompressedSize),
+			fmt.Sprintf("%d", item.Attempts),
+			item.Error,
+		})
+	}
+	return headers, rows
+}
@@ -0,0 +1,36 @@
+package asc
+
+import "fmt"
+
+// ReportWaitResult represents CLI output for waiting on a sales report to be
+// published.
+type ReportWaitResult struct {
+	VendorNumber     string `json:"vendorNumber"`
+	ReportType       string `json:"reportType"`
+	ReportSubType    string `json:"reportSubType"`
+	Frequency        string `json:"frequency"`
+	ReportDate       string `json:"reportDate"`
+	Version          string `json:"version,omitempty"`
+	Available        bool   `json:"available"`
+	Attempts         int    `json:"attempts"`
+	Elapsed          string `json:"elapsed"`
+	FilePath         string `json:"filePath,omitempty"`
+	FileSize         int64  `json:"fileSize,omitempty"`
+	DecompressedPath string `json:"decompressedPath,omitempty"`
+	DecompressedSize int64  `json:"decompressedSize,omitempty"`
+}
+
+func reportWaitResultRows(result *ReportWaitResult) ([]string, [][]string) {
+	headers := []string{"Type", "Frequency", "Date", "Available", "Attempts", "Elapsed", "File", "Decompressed File"}
+	rows := [][]string{{
+		result.ReportType,
+		result.Frequency,
+		result.ReportDate,
+		fmt.Sprintf("%t", result.Available),
+		fmt.Sprintf("%d", result.Attempts),
+		result.Elapsed,
+		result.FilePath,
+		result.DecompressedPath,
+	}}
+	return headers, rows
+}
@@ -14,6 +14,15 @@ type CustomerReviewResponseAttributes struct {
 	State        string `json:"state,omitempty"`
 }
 
+// CustomerReviewResponseStatePendingPublish marks a response that App Review
+// has accepted but the App Store does not show yet.
+const CustomerReviewResponseStatePendingPublish = "PENDING_PUBLISH"
+
+// PendingPublication reports whether the response is not yet visible on the App Store.
+func (a CustomerReviewResponseAttributes) PendingPublication() bool {
+	return strings.EqualFold(strings.TrimSpace(a.State), CustomerReviewResponseStatePendingPublish)
+}
+
 // CustomerReviewResponseResource is a customer review response resource.
 type CustomerReviewResponseResource struct {
 	Type       ResourceType                     `json:"type"`
@@ -61,13 +70,36 @@ type CustomerReviewResponseDeleteResult struct {
 	Deleted bool   `json:"deleted"`
 }
 
+// CustomerReviewResponseBatchItem is one review handled by reviews respond-batch.
+type CustomerReviewResponseBatchItem struct {
+	ReviewID         string `json:"reviewId"`
+	Rating           int    `json:"rating"`
+	Territory        string `json:"territory,omitempty"`
+	ReviewerNickname string `json:"reviewerNickname,omitempty"`
+	Response         string `json:"response"`
+	ResponseID       string `json:"responseId,omitempty"`
+	Responded        bool   `json:"responded"`
+	Error            string `json:"error,omitempty"`
+}
+
+// CustomerReviewResponseBatchResult represents CLI output for reviews respond-batch.
+type CustomerReviewResponseBatchResult struct {
+	AppID   string                            `json:"appId"`
+	DryRun  bool                              `json:"dryRun"`
+	Total   int                               `json:"total"`
+	Failed  int                               `json:"failed"`
+	Reviews []CustomerReviewResponseBatchItem `json:"reviews"`
+}
+
 // ResourceTypeCustomerReviewResponses is the resource type for customer review responses.
 const ResourceTypeCustomerReviewResponses ResourceType = "customerReviewResponses"
 
 // ResourceTypeCustomerReviews is the resource type for customer reviews.
 const ResourceTypeCustomerReviews ResourceType = "customerReviews"
 
-// CreateCustomerReviewResponse creates a response to a customer review.
+// CreateCustomerReviewResponse creates a response to a customer review. The API
+// has no update endpoint; posting again for the same review replaces the
+// existing response.
 func (c *Client) CreateCustomerReviewResponse(ctx context.Context, reviewID, responseBody string) (*CustomerReviewResponseResponse, error) {
 	reviewID = strings.TrimSpace(reviewID)
 	responseBody = strings.TrimSpace(responseBody)
@@ -3,10 +3,11 @@ package asc
 import "fmt"
 
 func customerReviewResponseRows(resp *CustomerReviewResponseResponse) ([]string, [][]string) {
-	headers := []string{"ID", "State", "Last Modified", "Response Body"}
+	headers := []string{"ID", "State", "Pending Publication", "Last Modified", "Response Body"}
 	rows := [][]string{{
 		resp.Data.ID,
 		sanitizeTerminal(resp.Data.Attributes.State),
+		fmt.Sprintf("%t", resp.Data.Attributes.PendingPublication()),
 		sanitizeTerminal(resp.Data.Attributes.LastModified),
 		compactWhitespace(resp.Data.Attributes.ResponseBody),
 	}}
@@ -18,3 +19,26 @@ func customerReviewResponseDeleteResultRows(result *CustomerReviewResponseDelete
 	rows := [][]string{{result.ID, fmt.Sprintf("%t", result.Deleted)}}
 	return headers, rows
 }
+
+func customerReviewResponseBatchResultRows(result *CustomerReviewResponseBatchResult) ([]string, [][]string) {
+	headers := []string{"Review ID", "Rating", "Territory", "Reviewer", "Response", "Status"}
+	rows := make([][]string, 0, len(result.Reviews))
+	for _, item := range result.Reviews {
+		status := "responded"
+		switch {
+		case item.Error != "":
+			status = "failed: " + compactWhitespace(item.Error)
+		case result.DryRun:
+			status = "would-respond"
+		}
+		rows = append(rows, []string{
+			item.ReviewID,
+			fmt.Sprintf("%d", item.Rating),
+			item.Territory,
+			compactWhitespace(item.ReviewerNickname),
+			compactWhitespace(item.Response),
+			status,
+		})
+	}
+	return headers, rows
+}
@@ -271,3 +271,22 @@ func TestGetCustomerReviewResponseForReview_ValidationErrors(t *testing.T) {
 		t.Fatalf("expected error for whitespace reviewID, got nil")
 	}
 }
+
+func TestCustomerReviewResponseRowsPendingPublication(t *testing.T) {
+	resp := &CustomerReviewResponseResponse{
+		Data: CustomerReviewResponseResource{
+			ID:         "response-1",
+			Attributes: CustomerReviewResponseAttributes{ResponseBody: "Thanks!", State: "PENDING_PUBLISH"},
+		},
+	}
+
+	headers, rows := customerReviewResponseRows(resp)
+	if headers[2] != "Pending Publication" || rows[0][2] != "true" {
+		t.Fatalf("expected pending publication column, got %v %v", headers, rows)
+	}
+
+	resp.Data.Attributes.State = "PUBLISHED"
+	if _, rows := customerReviewResponseRows(resp); rows[0][2] != "false" {
+		t.Fatalf("expected published response not pending, got %v", rows)
+	}
+}
@@ -256,15 +256,23 @@ func (c *Client) UpdateSandboxTester(ctx context.Context, testerID string, attri
 
 // ClearSandboxTesterPurchaseHistory clears purchase history for a sandbox tester.
 func (c *Client) ClearSandboxTesterPurchaseHistory(ctx context.Context, testerID string) (*SandboxTesterClearHistoryResponse, error) {
+	return c.ClearSandboxTestersPurchaseHistory(ctx, []string{testerID})
+}
+
+// ClearSandboxTestersPurchaseHistory clears purchase history for several sandbox testers in one request.
+func (c *Client) ClearSandboxTestersPurchaseHistory(ctx context.Context, testerIDs []string) (*SandboxTesterClearHistoryResponse, error) {
+	if len(testerIDs) == 0 {
+		return nil, fmt.Errorf("at least one sandbox tester ID is required")
+	}
+	testers := make([]ResourceData, 0, len(testerIDs))
+	for _, testerID := range testerIDs {
+		testers = append(testers, ResourceData{Type: ResourceTypeSandboxTesters, ID: testerID})
+	}
 	payload := SandboxTesterClearHistoryRequest{
 		Data: SandboxTesterClearHistoryData{
 			Type: ResourceTypeSandboxTestersClearHistory,
 			Relationships: SandboxTesterClearHistoryRelationships{
-				SandboxTesters: RelationshipList{
-					Data: []ResourceData{
-						{Type: ResourceTypeSandboxTesters, ID: testerID},
-					},
-				},
+				SandboxTesters: RelationshipList{Data: testers},
 			},
 		},
 	}
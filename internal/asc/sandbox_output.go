@@ -6,10 +6,12 @@ import (
 )
 
 // SandboxTesterClearHistoryResult represents CLI output for clear history requests.
+// TesterID is set when a single tester was cleared; TesterIDs when several were.
 type SandboxTesterClearHistoryResult struct {
-	RequestID string `json:"requestId"`
-	TesterID  string `json:"testerId"`
-	Cleared   bool   `json:"cleared"`
+	RequestID string   `json:"requestId"`
+	TesterID  string   `json:"testerId,omitempty"`
+	TesterIDs []string `json:"testerIds,omitempty"`
+	Cleared   bool     `json:"cleared"`
 }
 
 func formatSandboxTesterName(attr SandboxTesterAttributes) string {
@@ -17,7 +19,7 @@ func formatSandboxTesterName(attr SandboxTesterAttributes) string {
 }
 
 func sandboxTestersRows(resp *SandboxTestersResponse) ([]string, [][]string) {
-	headers := []string{"ID", "Email", "Name", "Territory"}
+	headers := []string{"ID", "Email", "Name", "Territory", "Interrupt Purchases", "Renewal Rate"}
 	rows := make([][]string, 0, len(resp.Data))
 	for _, item := range resp.Data {
 		rows = append(rows, []string{
@@ -25,6 +27,8 @@ func sandboxTestersRows(resp *SandboxTestersResponse) ([]string, [][]string) {
 			sandboxTesterEmail(item.Attributes),
 			formatSandboxTesterName(item.Attributes),
 			sandboxTesterTerritory(item.Attributes),
+			boolValue(item.Attributes.InterruptPurchases),
+			item.Attributes.SubscriptionRenewalRate,
 		})
 	}
 	return headers, rows
@@ -32,10 +36,17 @@ func sandboxTestersRows(resp *SandboxTestersResponse) ([]string, [][]string) {
 
 func sandboxTesterClearHistoryResultRows(result *SandboxTesterClearHistoryResult) ([]string, [][]string) {
 	headers := []string{"Request ID", "Tester ID", "Cleared"}
-	rows := [][]string{{
-		result.RequestID,
-		result.TesterID,
-		fmt.Sprintf("%t", result.Cleared),
-	}}
+	testerIDs := result.TesterIDs
+	if len(testerIDs) == 0 {
+		testerIDs = []string{result.TesterID}
+	}
+	rows := make([][]string, 0, len(testerIDs))
+	for _, testerID := range testerIDs {
+		rows = append(rows, []string{
+			result.RequestID,
+			testerID,
+			fmt.Sprintf("%t", result.Cleared),
+		})
+	}
 	return headers, rows
 }
@@ -195,3 +195,22 @@ func TestClearSandboxTesterPurchaseHistory(t *testing.T) {
 		t.Fatalf("ClearSandboxTesterPurchaseHistory() error: %v", err)
 	}
 }
+
+func TestClearSandboxTestersPurchaseHistory_MultipleTesters(t *testing.T) {
+	response := jsonResponse(http.StatusCreated, `{"data":{"type":"sandboxTestersClearPurchaseHistoryRequest","id":"request-1"}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		var body SandboxTesterClearHistoryRequest
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		testers := body.Data.Relationships.SandboxTesters.Data
+		if len(testers) != 2 || testers[0].ID != "tester-1" || testers[1].ID != "tester-2" {
+			t.Fatalf("expected both testers in one request, got %+v", testers)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.ClearSandboxTestersPurchaseHistory(context.Background(), []string{"tester-1", "tester-2"}); err != nil {
+		t.Fatalf("ClearSandboxTestersPurchaseHistory() error: %v", err)
+	}
+}
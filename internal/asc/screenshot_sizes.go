@@ -0,0 +1,56 @@
+package asc
+
+import "strings"
+
+// MaxScreenshotsPerSet is the number of screenshots a set can hold.
+const MaxScreenshotsPerSet = 10
+
+// ScreenshotSize is an accepted screenshot size in pixels, in portrait
+// orientation for devices that have one.
+type ScreenshotSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// screenshotSizes lists the sizes App Store Connect accepts per display type.
+// iMessage display types accept the sizes of the matching app display type.
+var screenshotSizes = map[string][]ScreenshotSize{
+	"APP_IPHONE_69":         {{1320, 2868}, {1290, 2796}, {1260, 2736}},
+	"APP_IPHONE_67":         {{1290, 2796}, {1284, 2778}},
+	"APP_IPHONE_65":         {{1284, 2778}, {1242, 2688}},
+	"APP_IPHONE_61":         {{1206, 2622}, {1179, 2556}, {1170, 2532}, {1125, 2436}, {1080, 2340}},
+	"APP_IPHONE_58":         {{1170, 2532}, {1125, 2436}, {1080, 2340}},
+	"APP_IPHONE_55":         {{1242, 2208}},
+	"APP_IPHONE_47":         {{750, 1334}},
+	"APP_IPHONE_40":         {{640, 1136}, {640, 1096}},
+	"APP_IPHONE_35":         {{640, 960}, {640, 920}},
+	"APP_IPAD_PRO_3GEN_129": {{2064, 2752}, {2048, 2732}},
+	"APP_IPAD_PRO_3GEN_11":  {{1668, 2420}, {1668, 2388}, {1640, 2360}, {1488, 2266}},
+	"APP_IPAD_PRO_129":      {{2048, 2732}},
+	"APP_IPAD_105":          {{1668, 2224}},
+	"APP_IPAD_97":           {{1536, 2048}, {1536, 2008}, {768, 1024}, {768, 1004}},
+	"APP_DESKTOP":           {{1280, 800}, {1440, 900}, {2560, 1600}, {2880, 1800}},
+	"APP_WATCH_ULTRA":       {{422, 514}, {410, 502}},
+	"APP_WATCH_SERIES_10":   {{416, 496}},
+	"APP_WATCH_SERIES_7":    {{396, 484}},
+	"APP_WATCH_SERIES_4":    {{368, 448}},
+	"APP_WATCH_SERIES_3":    {{312, 390}},
+	"APP_APPLE_TV":          {{1920, 1080}, {3840, 2160}},
+	"APP_APPLE_VISION_PRO":  {{3840, 2160}},
+}
+
+// ScreenshotSizesForDisplayType returns the accepted sizes for a display type.
+func ScreenshotSizesForDisplayType(displayType string) []ScreenshotSize {
+	return screenshotSizes[strings.TrimPrefix(displayType, "IMESSAGE_")]
+}
+
+// IsAcceptedScreenshotSize reports whether width x height is accepted for
+// displayType in either orientation.
+func IsAcceptedScreenshotSize(displayType string, width, height int) bool {
+	for _, size := range ScreenshotSizesForDisplayType(displayType) {
+		if (size.Width == width && size.Height == height) || (size.Width == height && size.Height == width) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package asc
+
+import "testing"
+
+func TestScreenshotSizesCoverDisplayTypes(t *testing.T) {
+	for _, displayType := range ValidScreenshotDisplayTypes {
+		if len(ScreenshotSizesForDisplayType(displayType)) == 0 {
+			t.Errorf("no sizes for %s", displayType)
+		}
+	}
+}
+
+func TestIsAcceptedScreenshotSize(t *testing.T) {
+	tests := []struct {
+		displayType   string
+		width, height int
+		want          bool
+	}{
+		{"APP_IPHONE_67", 1290, 2796, true},
+		{"APP_IPHONE_67", 2796, 1290, true},
+		{"IMESSAGE_APP_IPHONE_67", 1290, 2796, true},
+		{"APP_IPHONE_67", 1242, 2688, false},
+		{"APP_IPAD_PRO_3GEN_129", 2048, 2732, true},
+		{"UNKNOWN", 1290, 2796, false},
+	}
+	for _, test := range tests {
+		if got := IsAcceptedScreenshotSize(test.displayType, test.width, test.height); got != test.want {
+			t.Errorf("IsAcceptedScreenshotSize(%s, %d, %d) = %t, want %t", test.displayType, test.width, test.height, got, test.want)
+		}
+	}
+}
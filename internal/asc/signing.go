@@ -87,6 +87,24 @@ type BundleIDCapabilityCreateRequest struct {
 	Data BundleIDCapabilityCreateData `json:"data"`
 }
 
+// BundleIDCapabilityUpdateAttributes describes attributes for updating a capability.
+type BundleIDCapabilityUpdateAttributes struct {
+	CapabilityType string              `json:"capabilityType,omitempty"`
+	Settings       []CapabilitySetting `json:"settings,omitempty"`
+}
+
+// BundleIDCapabilityUpdateData is the data portion of a capability update request.
+type BundleIDCapabilityUpdateData struct {
+	Type       ResourceType                        `json:"type"`
+	ID         string                              `json:"id"`
+	Attributes *BundleIDCapabilityUpdateAttributes `json:"attributes,omitempty"`
+}
+
+// BundleIDCapabilityUpdateRequest is a request to update a bundle ID capability.
+type BundleIDCapabilityUpdateRequest struct {
+	Data BundleIDCapabilityUpdateData `json:"data"`
+}
+
 // BundleIDsResponse is the response from bundle IDs list endpoint.
 type BundleIDsResponse = Response[BundleIDAttributes]
 
@@ -117,10 +135,18 @@ type CertificateCreateAttributes struct {
 	CSRContent      string `json:"csrContent"`
 }
 
+// CertificateCreateRelationships links a new certificate to a pass type ID
+// or merchant ID.
+type CertificateCreateRelationships struct {
+	PassTypeID *Relationship `json:"passTypeId,omitempty"`
+	MerchantID *Relationship `json:"merchantId,omitempty"`
+}
+
 // CertificateCreateData is the data portion of a certificate create request.
 type CertificateCreateData struct {
-	Type       ResourceType                `json:"type"`
-	Attributes CertificateCreateAttributes `json:"attributes"`
+	Type          ResourceType                    `json:"type"`
+	Attributes    CertificateCreateAttributes     `json:"attributes"`
+	Relationships *CertificateCreateRelationships `json:"relationships,omitempty"`
 }
 
 // CertificateCreateRequest is a request to create a certificate.
@@ -155,7 +181,8 @@ type CertificateResponse = SingleResponse[CertificateAttributes]
 type ProfileState string
 
 const (
-	ProfileStateActive ProfileState = "ACTIVE"
+	ProfileStateActive  ProfileState = "ACTIVE"
+	ProfileStateInvalid ProfileState = "INVALID"
 )
 
 // ProfileAttributes describes a profile resource.
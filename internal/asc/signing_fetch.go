@@ -12,3 +12,21 @@ type SigningFetchResult struct {
 	OutputPath       string   `json:"outputPath"`
 	Created          bool     `json:"created,omitempty"`
 }
+
+// SigningSetupResult represents CLI output for signing setup.
+type SigningSetupResult struct {
+	BundleID           string   `json:"bundleId"`
+	BundleIDResource   string   `json:"bundleIdResourceId"`
+	ProfileType        string   `json:"profileType"`
+	CertificateType    string   `json:"certificateType"`
+	CertificateIDs     []string `json:"certificateIds"`
+	CertificateCreated bool     `json:"certificateCreated"`
+	CertificateFiles   []string `json:"certificateFiles"`
+	P12File            string   `json:"p12File,omitempty"`
+	ProfileID          string   `json:"profileId"`
+	ProfileName        string   `json:"profileName"`
+	ProfileExpiration  string   `json:"profileExpirationDate,omitempty"`
+	ProfileCreated     bool     `json:"profileCreated"`
+	ProfileFile        string   `json:"profileFile"`
+	OutputPath         string   `json:"outputPath"`
+}
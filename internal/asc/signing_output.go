@@ -18,12 +18,84 @@ type BundleIDCapabilityDeleteResult struct {
 	Deleted bool   `json:"deleted"`
 }
 
+// BundleIDCapabilityStateResult represents CLI output for enabling or disabling a capability.
+type BundleIDCapabilityStateResult struct {
+	BundleID       string `json:"bundleId"`
+	CapabilityType string `json:"capabilityType"`
+	CapabilityID   string `json:"capabilityId,omitempty"`
+	Enabled        bool   `json:"enabled"`
+	Changed        bool   `json:"changed"`
+}
+
 // CertificateRevokeResult represents CLI output for certificate revocations.
 type CertificateRevokeResult struct {
 	ID      string `json:"id"`
 	Revoked bool   `json:"revoked"`
 }
 
+// CertificateProfileSummary represents a profile that includes a certificate.
+type CertificateProfileSummary struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ProfileType string `json:"profileType,omitempty"`
+}
+
+// CertificateRevokeAllItem represents a certificate selected for revocation.
+type CertificateRevokeAllItem struct {
+	ID               string                      `json:"id"`
+	Name             string                      `json:"name,omitempty"`
+	CertificateType  string                      `json:"certificateType,omitempty"`
+	ExpirationDate   string                      `json:"expirationDate,omitempty"`
+	Expired          bool                        `json:"expired"`
+	Revoked          *bool                       `json:"revoked,omitempty"`
+	AffectedProfiles []CertificateProfileSummary `json:"affectedProfiles,omitempty"`
+}
+
+// CertificateRevokeAllFailure represents a failed revocation attempt.
+type CertificateRevokeAllFailure struct {
+	ID    string `json:"id"`
+	Error string `json:"error"`
+}
+
+// CertificateRevokeAllResult represents CLI output for batch certificate revocation.
+type CertificateRevokeAllResult struct {
+	DryRun        bool                          `json:"dryRun"`
+	ExpiredOnly   bool                          `json:"expiredOnly,omitempty"`
+	SelectedCount int                           `json:"selectedCount"`
+	RevokedCount  int                           `json:"revokedCount"`
+	Certificates  []CertificateRevokeAllItem    `json:"certificates"`
+	Failures      []CertificateRevokeAllFailure `json:"failures,omitempty"`
+}
+
+// CertificateExpiryItem represents a certificate that expires within the checked window.
+type CertificateExpiryItem struct {
+	ID              string                      `json:"id"`
+	Name            string                      `json:"name,omitempty"`
+	CertificateType string                      `json:"certificateType,omitempty"`
+	SerialNumber    string                      `json:"serialNumber,omitempty"`
+	ExpirationDate  string                      `json:"expirationDate"`
+	DaysRemaining   int                         `json:"daysRemaining"`
+	Expired         bool                        `json:"expired"`
+	Profiles        []CertificateProfileSummary `json:"profiles"`
+}
+
+// CertificateExpiryReport represents CLI output for certificate expiry checks.
+type CertificateExpiryReport struct {
+	ExpiringWithin string                  `json:"expiringWithin"`
+	CheckedAt      string                  `json:"checkedAt"`
+	CheckedCount   int                     `json:"checkedCount"`
+	ExpiringCount  int                     `json:"expiringCount"`
+	Certificates   []CertificateExpiryItem `json:"certificates"`
+}
+
+// CertificateDownloadResult represents CLI output for certificate downloads.
+type CertificateDownloadResult struct {
+	ID         string `json:"id"`
+	Name       string `json:"name,omitempty"`
+	Format     string `json:"format"`
+	OutputPath string `json:"outputPath"`
+}
+
 // ProfileDeleteResult represents CLI output for profile deletions.
 type ProfileDeleteResult struct {
 	ID      string `json:"id"`
@@ -37,6 +109,38 @@ type ProfileDownloadResult struct {
 	OutputPath string `json:"outputPath"`
 }
 
+// ProfileRepairItem represents an invalid or expired profile selected for regeneration.
+type ProfileRepairItem struct {
+	ID                   string   `json:"id"`
+	Name                 string   `json:"name"`
+	ProfileType          string   `json:"profileType"`
+	ProfileState         string   `json:"profileState,omitempty"`
+	ExpirationDate       string   `json:"expirationDate,omitempty"`
+	Reason               string   `json:"reason"`
+	BundleID             string   `json:"bundleId"`
+	CertificateIDs       []string `json:"certificateIds"`
+	ReplacedCertificates bool     `json:"replacedCertificates,omitempty"`
+	DeviceIDs            []string `json:"deviceIds,omitempty"`
+	NewProfileID         string   `json:"newProfileId,omitempty"`
+	OutputPath           string   `json:"outputPath,omitempty"`
+}
+
+// ProfileRepairFailure represents a profile that could not be regenerated.
+type ProfileRepairFailure struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Error string `json:"error"`
+}
+
+// ProfileRepairResult represents CLI output for profile repair.
+type ProfileRepairResult struct {
+	DryRun        bool                   `json:"dryRun"`
+	SelectedCount int                    `json:"selectedCount"`
+	RepairedCount int                    `json:"repairedCount"`
+	Profiles      []ProfileRepairItem    `json:"profiles"`
+	Failures      []ProfileRepairFailure `json:"failures,omitempty"`
+}
+
 func bundleIDsRows(resp *BundleIDsResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Identifier", "Platform", "Seed ID"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -77,6 +181,18 @@ func bundleIDCapabilityDeleteResultRows(result *BundleIDCapabilityDeleteResult)
 	return headers, rows
 }
 
+func bundleIDCapabilityStateResultRows(result *BundleIDCapabilityStateResult) ([]string, [][]string) {
+	headers := []string{"Bundle ID", "Capability", "Capability ID", "Enabled", "Changed"}
+	rows := [][]string{{
+		result.BundleID,
+		result.CapabilityType,
+		result.CapabilityID,
+		fmt.Sprintf("%t", result.Enabled),
+		fmt.Sprintf("%t", result.Changed),
+	}}
+	return headers, rows
+}
+
 func certificatesRows(resp *CertificatesResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Type", "Expiration", "Serial"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -98,18 +214,211 @@ func certificateRevokeResultRows(result *CertificateRevokeResult) ([]string, [][
 	return headers, rows
 }
 
+func certificateRevokeAllResultRows(result *CertificateRevokeAllResult) ([]string, [][]string) {
+	status := "revoked"
+	if result.DryRun {
+		status = "would-revoke"
+	}
+	headers := []string{"ID", "Name", "Type", "Expiration", "Status", "Affected Profiles"}
+	rows := make([][]string, 0, len(result.Certificates)+len(result.Failures))
+	for _, item := range result.Certificates {
+		profiles := make([]string, 0, len(item.AffectedProfiles))
+		for _, profile := range item.AffectedProfiles {
+			profiles = append(profiles, compactWhitespace(profile.Name))
+		}
+		rows = append(rows, []string{
+			item.ID,
+			compactWhitespace(item.Name),
+			item.CertificateType,
+			item.ExpirationDate,
+			status,
+			strings.Join(profiles, ", "),
+		})
+	}
+	for _, failure := range result.Failures {
+		rows = append(rows, []string{failure.ID, "", "", "", "failed: " + compactWhitespace(failure.Error), ""})
+	}
+	return headers, rows
+}
+
+func certificateExpiryReportRows(result *CertificateExpiryReport) ([]string, [][]string) {
+	headers := []string{"ID", "Name", "Type", "Serial", "Expiration", "Days Left", "Profiles"}
+	rows := make([][]string, 0, len(result.Certificates))
+	for _, item := range result.Certificates {
+		profiles := make([]string, 0, len(item.Profiles))
+		for _, profile := range item.Profiles {
+			profiles = append(profiles, compactWhitespace(profile.Name))
+		}
+		rows = append(rows, []string{
+			item.ID,
+			compactWhitespace(item.Name),
+			item.CertificateType,
+			item.SerialNumber,
+			item.ExpirationDate,
+			fmt.Sprintf("%d", item.DaysRemaining),
+			strings.Join(profiles, ", "),
+		})
+	}
+	return headers, rows
+}
+
+func certificateDownloadResultRows(result *CertificateDownloadResult) ([]string, [][]string) {
+	headers := []string{"ID", "Name", "Format", "Output Path"}
+	rows := [][]string{{
+		result.ID,
+		compactWhitespace(result.Name),
+		result.Format,
+		result.OutputPath,
+	}}
+	return headers, rows
+}
+
 func profilesRows(resp *ProfilesResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Type", "State", "Expiration"}
+	related := profilesRelatedColumns(resp)
+	for _, column := range related {
+		headers = append(headers, column.header)
+	}
 	rows := make([][]string, 0, len(resp.Data))
-	for _, item := range resp.Data {
-		rows = append(rows, []string{
+	for index, item := range resp.Data {
+		row := []string{
 			item.ID,
 			compactWhitespace(item.Attributes.Name),
 			item.Attributes.ProfileType,
 			string(item.Attributes.ProfileState),
 			item.Attributes.ExpirationDate,
+		}
+		for _, column := range related {
+			row = append(row, column.values[index])
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
+
+type profilesRelatedColumn struct {
+	header string
+	values []string
+}
+
+// profilesRelatedColumns builds Bundle ID, Certificates, and Devices columns
+// for relationships that were requested with include, naming each related
+// resource from the included data.
+func profilesRelatedColumns(resp *ProfilesResponse) []profilesRelatedColumn {
+	if len(resp.Included) == 0 {
+		return nil
+	}
+	var included []struct {
+		Type       ResourceType `json:"type"`
+		ID         string       `json:"id"`
+		Attributes struct {
+			Name         string `json:"name"`
+			Identifier   string `json:"identifier"`
+			SerialNumber string `json:"serialNumber"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal(resp.Included, &included); err != nil {
+		return nil
+	}
+	names := make(map[string]string, len(included))
+	for _, item := range included {
+		name := item.Attributes.Name
+		switch {
+		case item.Type == ResourceTypeBundleIds && item.Attributes.Identifier != "":
+			name = item.Attributes.Identifier
+		case item.Type == ResourceTypeCertificates && item.Attributes.SerialNumber != "":
+			name = fmt.Sprintf("%s (%s)", item.Attributes.Name, item.Attributes.SerialNumber)
+		}
+		names[string(item.Type)+"/"+item.ID] = compactWhitespace(name)
+	}
+	label := func(data ResourceData) string {
+		if name := names[string(data.Type)+"/"+data.ID]; name != "" {
+			return name
+		}
+		return data.ID
+	}
+
+	bundleIDs := profilesRelatedColumn{header: "Bundle ID", values: make([]string, len(resp.Data))}
+	certificates := profilesRelatedColumn{header: "Certificates", values: make([]string, len(resp.Data))}
+	devices := profilesRelatedColumn{header: "Devices", values: make([]string, len(resp.Data))}
+	var hasBundleIDs, hasCertificates, hasDevices bool
+	for index, item := range resp.Data {
+		var relationships struct {
+			BundleID struct {
+				Data *ResourceData `json:"data"`
+			} `json:"bundleId"`
+			Certificates struct {
+				Data *[]ResourceData `json:"data"`
+			} `json:"certificates"`
+			Devices struct {
+				Data *[]ResourceData `json:"data"`
+			} `json:"devices"`
+		}
+		if len(item.Relationships) == 0 || json.Unmarshal(item.Relationships, &relationships) != nil {
+			continue
+		}
+		if relationships.BundleID.Data != nil {
+			hasBundleIDs = true
+			bundleIDs.values[index] = label(*relationships.BundleID.Data)
+		}
+		if relationships.Certificates.Data != nil {
+			hasCertificates = true
+			certificates.values[index] = joinRelatedLabels(*relationships.Certificates.Data, label)
+		}
+		if relationships.Devices.Data != nil {
+			hasDevices = true
+			devices.values[index] = joinRelatedLabels(*relationships.Devices.Data, label)
+		}
+	}
+
+	var columns []profilesRelatedColumn
+	if hasBundleIDs {
+		columns = append(columns, bundleIDs)
+	}
+	if hasCertificates {
+		columns = append(columns, certificates)
+	}
+	if hasDevices {
+		columns = append(columns, devices)
+	}
+	return columns
+}
+
+func joinRelatedLabels(items []ResourceData, label func(ResourceData) string) string {
+	labels := make([]string, 0, len(items))
+	for _, item := range items {
+		labels = append(labels, label(item))
+	}
+	return strings.Join(labels, ", ")
+}
+
+func profileRepairResultRows(result *ProfileRepairResult) ([]string, [][]string) {
+	status := "regenerated"
+	if result.DryRun {
+		status = "would-regenerate"
+	}
+	headers := []string{"ID", "Name", "Type", "Reason", "Certificates", "Devices", "Status", "New ID", "Output Path"}
+	rows := make([][]string, 0, len(result.Profiles)+len(result.Failures))
+	for _, item := range result.Profiles {
+		certificates := strings.Join(item.CertificateIDs, ", ")
+		if item.ReplacedCertificates {
+			certificates += " (replaced)"
+		}
+		rows = append(rows, []string{
+			item.ID,
+			compactWhitespace(item.Name),
+			item.ProfileType,
+			item.Reason,
+			certificates,
+			fmt.Sprintf("%d", len(item.DeviceIDs)),
+			status,
+			item.NewProfileID,
+			item.OutputPath,
 		})
 	}
+	for _, failure := range result.Failures {
+		rows = append(rows, []string{failure.ID, compactWhitespace(failure.Name), "", "", "", "", "failed: " + compactWhitespace(failure.Error), "", ""})
+	}
 	return headers, rows
 }
 
@@ -151,6 +460,24 @@ func signingFetchResultRows(result *SigningFetchResult) ([]string, [][]string) {
 	return headers, rows
 }
 
+func signingSetupResultRows(result *SigningSetupResult) ([]string, [][]string) {
+	headers := []string{"Bundle ID", "Profile Type", "Certificate IDs", "Certificate Created", "Profile ID", "Profile Name", "Profile Expires", "Profile Created", "Profile File", "Certificate Files", "P12 File"}
+	rows := [][]string{{
+		result.BundleID,
+		result.ProfileType,
+		joinSigningList(result.CertificateIDs),
+		fmt.Sprintf("%t", result.CertificateCreated),
+		result.ProfileID,
+		compactWhitespace(result.ProfileName),
+		result.ProfileExpiration,
+		fmt.Sprintf("%t", result.ProfileCreated),
+		result.ProfileFile,
+		joinSigningList(result.CertificateFiles),
+		result.P12File,
+	}}
+	return headers, rows
+}
+
 func formatCapabilitySettings(settings []CapabilitySetting) string {
 	if len(settings) == 0 {
 		return ""
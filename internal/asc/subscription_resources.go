@@ -189,7 +189,27 @@ type SubscriptionPromotionalOfferCreateData struct {
 
 // SubscriptionPromotionalOfferCreateRequest is a request to create a promotional offer.
 type SubscriptionPromotionalOfferCreateRequest struct {
-	Data SubscriptionPromotionalOfferCreateData `json:"data"`
+	Data     SubscriptionPromotionalOfferCreateData          `json:"data"`
+	Included []SubscriptionPromotionalOfferPriceInlineCreate `json:"included,omitempty"`
+}
+
+// SubscriptionPromotionalOfferPrice describes a territory price for a new promotional offer.
+type SubscriptionPromotionalOfferPrice struct {
+	TerritoryID  string
+	PricePointID string
+}
+
+// SubscriptionPromotionalOfferPriceRelationships describes promotional offer price relationships.
+type SubscriptionPromotionalOfferPriceRelationships struct {
+	Territory              Relationship `json:"territory"`
+	SubscriptionPricePoint Relationship `json:"subscriptionPricePoint"`
+}
+
+// SubscriptionPromotionalOfferPriceInlineCreate describes inline creation data for promotional offer prices.
+type SubscriptionPromotionalOfferPriceInlineCreate struct {
+	Type          ResourceType                                   `json:"type"`
+	ID            string                                         `json:"id,omitempty"`
+	Relationships SubscriptionPromotionalOfferPriceRelationships `json:"relationships,omitempty"`
 }
 
 // SubscriptionPromotionalOfferUpdateRelationships describes relationships for promotional offer updates.
@@ -3,6 +3,7 @@ package asc
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // SubscriptionGroupDeleteResult represents CLI output for group deletions.
@@ -23,6 +24,42 @@ type SubscriptionPriceDeleteResult struct {
 	Deleted bool   `json:"deleted"`
 }
 
+// SubscriptionPriceImportItem is one territory of a subscription price import.
+type SubscriptionPriceImportItem struct {
+	Territory  string `json:"territory"`
+	PricePoint string `json:"pricePoint"`
+	StartDate  string `json:"startDate,omitempty"`
+	Preserved  bool   `json:"preserved"`
+	PriceID    string `json:"priceId,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// SubscriptionPriceImportResult is the result of a subscription price import.
+type SubscriptionPriceImportResult struct {
+	SubscriptionID string                        `json:"subscriptionId"`
+	DryRun         bool                          `json:"dryRun"`
+	Prices         []SubscriptionPriceImportItem `json:"prices"`
+	Failed         int                           `json:"failed"`
+}
+
+// PromotionalOfferSignatureSeparator is the invisible separator (U+2063)
+// StoreKit expects between the fields of a promotional offer signature.
+const PromotionalOfferSignatureSeparator = "\u2063"
+
+// SubscriptionPromotionalOfferSignatureParams lists the values a server needs
+// to sign a promotional offer for StoreKit.
+type SubscriptionPromotionalOfferSignatureParams struct {
+	AppBundleID       string `json:"appBundleId"`
+	KeyIdentifier     string `json:"keyIdentifier,omitempty"`
+	ProductIdentifier string `json:"productIdentifier"`
+	OfferIdentifier   string `json:"offerIdentifier"`
+	OfferID           string `json:"offerId"`
+	Payload           string `json:"payload"`
+	Algorithm         string `json:"algorithm"`
+	Nonce             string `json:"nonce"`
+	Timestamp         string `json:"timestamp"`
+}
+
 func subscriptionGroupsRows(resp *SubscriptionGroupsResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Reference Name"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -136,3 +173,39 @@ func subscriptionPriceRelationshipIDs(raw json.RawMessage) (string, string, erro
 	}
 	return territoryID, pricePointID, nil
 }
+
+func subscriptionPriceImportResultRows(result *SubscriptionPriceImportResult) ([]string, [][]string) {
+	headers := []string{"Territory", "Price Point", "Start Date", "Preserved", "Price ID", "Error"}
+	rows := make([][]string, 0, len(result.Prices))
+	for _, item := range result.Prices {
+		startDate := item.StartDate
+		if startDate == "" {
+			startDate = "immediately"
+		}
+		rows = append(rows, []string{
+			item.Territory,
+			item.PricePoint,
+			startDate,
+			fmt.Sprintf("%t", item.Preserved),
+			item.PriceID,
+			item.Error,
+		})
+	}
+	return headers, rows
+}
+
+func subscriptionPromotionalOfferSignatureParamsRows(params *SubscriptionPromotionalOfferSignatureParams) ([]string, [][]string) {
+	headers := []string{"Field", "Value"}
+	rows := [][]string{
+		{"appBundleId", params.AppBundleID},
+		{"keyIdentifier", params.KeyIdentifier},
+		{"productIdentifier", params.ProductIdentifier},
+		{"offerIdentifier", params.OfferIdentifier},
+		{"offerId", params.OfferID},
+		{"payload", strings.ReplaceAll(params.Payload, PromotionalOfferSignatureSeparator, " + U+2063 + ")},
+		{"algorithm", params.Algorithm},
+		{"nonce", params.Nonce},
+		{"timestamp", params.Timestamp},
+	}
+	return headers, rows
+}
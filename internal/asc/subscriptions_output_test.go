@@ -127,3 +127,25 @@ func TestPrintMarkdown_SubscriptionGracePeriod(t *testing.T) {
 		t.Fatalf("expected grace period fields in output, got: %s", output)
 	}
 }
+
+func TestPrintTable_SubscriptionPriceImportResult(t *testing.T) {
+	result := &SubscriptionPriceImportResult{
+		SubscriptionID: "sub-1",
+		Prices: []SubscriptionPriceImportItem{
+			{Territory: "USA", PricePoint: "pp-usa", Preserved: true, PriceID: "price-1"},
+			{Territory: "GBR", PricePoint: "pp-gbr", StartDate: "2026-01-01", Error: "not found"},
+		},
+		Failed: 1,
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintTable(result)
+	})
+
+	if !strings.Contains(output, "Preserved") || !strings.Contains(output, "immediately") {
+		t.Fatalf("expected immediate start date in output, got: %s", output)
+	}
+	if !strings.Contains(output, "2026-01-01") || !strings.Contains(output, "not found") {
+		t.Fatalf("expected scheduled price in output, got: %s", output)
+	}
+}
@@ -456,6 +456,55 @@ func TestCreateSubscriptionPromotionalOffer(t *testing.T) {
 	}
 }
 
+func TestCreateSubscriptionPromotionalOfferWithPrices(t *testing.T) {
+	response := jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionPromotionalOffers","id":"offer-1"}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionPromotionalOffers" {
+			t.Fatalf("expected path /v1/subscriptionPromotionalOffers, got %s", req.URL.Path)
+		}
+		var payload SubscriptionPromotionalOfferCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		prices := payload.Data.Relationships.Prices.Data
+		if len(prices) != 2 || prices[0].ID != "${local-price-1}" || prices[1].ID != "${local-price-2}" {
+			t.Fatalf("unexpected price relationships: %+v", prices)
+		}
+		if len(payload.Included) != 2 {
+			t.Fatalf("expected 2 included prices, got %d", len(payload.Included))
+		}
+		first := payload.Included[0]
+		if first.Type != ResourceTypeSubscriptionPromotionalOfferPrices || first.ID != "${local-price-1}" {
+			t.Fatalf("unexpected included price: %+v", first)
+		}
+		if first.Relationships.Territory.Data.ID != "USA" || first.Relationships.SubscriptionPricePoint.Data.ID != "pp-1" {
+			t.Fatalf("unexpected included price relationships: %+v", first.Relationships)
+		}
+		if payload.Included[1].Relationships.Territory.Data.ID != "JPN" {
+			t.Fatalf("expected second territory JPN, got %+v", payload.Included[1].Relationships)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	attrs := SubscriptionPromotionalOfferCreateAttributes{
+		Name:            "Spring",
+		OfferCode:       "SPRING",
+		Duration:        SubscriptionOfferDurationOneMonth,
+		OfferMode:       SubscriptionOfferModeFreeTrial,
+		NumberOfPeriods: 1,
+	}
+	prices := []SubscriptionPromotionalOfferPrice{
+		{TerritoryID: "usa", PricePointID: "pp-1"},
+		{TerritoryID: "JPN", PricePointID: "pp-2"},
+	}
+	if _, err := client.CreateSubscriptionPromotionalOfferWithPrices(context.Background(), "sub-1", attrs, prices); err != nil {
+		t.Fatalf("CreateSubscriptionPromotionalOfferWithPrices() error: %v", err)
+	}
+}
+
 func TestUpdateSubscriptionPromotionalOffer(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":{"type":"subscriptionPromotionalOffers","id":"offer-1"}}`)
 	client := newTestClient(t, func(req *http.Request) {
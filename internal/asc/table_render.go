@@ -1,6 +1,7 @@
 package asc
 
 import (
+	"io"
 	"os"
 
 	"github.com/olekukonko/tablewriter"
@@ -11,7 +12,11 @@ import (
 // RenderTable writes a bordered Unicode table to stdout.
 // Headers preserve their original casing and are center-aligned.
 // Data rows are left-aligned for readability.
+// Sensitive cells are masked when redaction is enabled.
 func RenderTable(headers []string, rows [][]string) {
+	if ResolveRedactEnabled() {
+		rows = redactRows(headers, rows)
+	}
 	table := tablewriter.NewTable(os.Stdout,
 		tablewriter.WithConfig(tablewriter.Config{
 			Header: tw.CellConfig{
@@ -34,7 +39,14 @@ func RenderTable(headers []string, rows [][]string) {
 // Headers preserve their original casing. Data rows are left-aligned.
 // Pipe characters in cell values are escaped automatically by the renderer.
 func RenderMarkdown(headers []string, rows [][]string) {
-	table := tablewriter.NewTable(os.Stdout,
+	renderMarkdownTo(os.Stdout, headers, rows)
+}
+
+func renderMarkdownTo(w io.Writer, headers []string, rows [][]string) {
+	if ResolveRedactEnabled() {
+		rows = redactRows(headers, rows)
+	}
+	table := tablewriter.NewTable(w,
 		tablewriter.WithRenderer(renderer.NewMarkdown()),
 		tablewriter.WithConfig(tablewriter.Config{
 			Header: tw.CellConfig{
@@ -20,6 +20,10 @@ type UploadOptions struct {
 	Concurrency int
 	Client      *http.Client
 	RetryOpts   RetryOptions
+	// RetryOnRateLimit retries rate-limited (429) upload responses.
+	RetryOnRateLimit bool
+	// State, when set, skips completed operations and records progress.
+	State *UploadState
 }
 
 // UploadOption configures upload options.
@@ -67,10 +71,12 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 		return errors.New("no upload operations provided")
 	}
 
+	policy := ResolveEndpointPolicy(EndpointClassUploads)
 	uploadOpts := UploadOptions{
-		Concurrency: 1,
-		Client:      newUploadClient(),
-		RetryOpts:   ResolveRetryOptions(),
+		Concurrency:      1,
+		Client:           newUploadClient(),
+		RetryOpts:        policy.Retry,
+		RetryOnRateLimit: policy.RetryOnRateLimit,
 	}
 	for _, opt := range opts {
 		opt(&uploadOpts)
@@ -150,6 +156,9 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 
 sendLoop:
 	for i, op := range operations {
+		if uploadOpts.State != nil && uploadOpts.State.IsCompleted(i) {
+			continue
+		}
 		select {
 		case <-ctx.Done():
 			break sendLoop
@@ -189,11 +198,12 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 		defer resp.Body.Close()
 		_, _ = io.Copy(io.Discard, resp.Body)
 
-		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if (resp.StatusCode == http.StatusTooManyRequests && uploadOpts.RetryOnRateLimit) || resp.StatusCode == http.StatusServiceUnavailable {
 			retryAfter := parseRetryAfterHeader(resp.Header.Get("Retry-After"))
 			return struct{}{}, &RetryableError{
 				Err:        buildRetryableError(resp.StatusCode, retryAfter, nil),
 				RetryAfter: retryAfter,
+				StatusCode: resp.StatusCode,
 			}
 		}
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
@@ -205,6 +215,11 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 	if err != nil {
 		return fmt.Errorf("upload operation %d: %w", task.index, err)
 	}
+	if uploadOpts.State != nil {
+		if err := uploadOpts.State.MarkCompleted(task.index); err != nil {
+			return fmt.Errorf("upload operation %d: %w", task.index, err)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,117 @@
+package asc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// UploadState records which upload operations of a reservation have completed.
+// It is saved to a JSON file after every chunk so an interrupted upload can
+// resume from the last completed chunk instead of reserving a new upload.
+type UploadState struct {
+	ReservationID string            `json:"reservationId"`
+	ParentID      string            `json:"parentId,omitempty"`
+	FileSize      int64             `json:"fileSize"`
+	ModTime       time.Time         `json:"modTime"`
+	Operations    []UploadOperation `json:"operations"`
+	Completed     []int             `json:"completed"`
+
+	path string
+	mu   sync.Mutex
+}
+
+// NewUploadState returns the state for a new reservation of the file described
+// by info. It is saved to path; call Save to write it before uploading.
+func NewUploadState(path, reservationID string, info os.FileInfo, operations []UploadOperation) *UploadState {
+	return &UploadState{
+		ReservationID: reservationID,
+		FileSize:      info.Size(),
+		ModTime:       info.ModTime().UTC(),
+		Operations:    operations,
+		Completed:     []int{},
+		path:          path,
+	}
+}
+
+// LoadUploadState reads a saved upload state. The error wraps os.ErrNotExist
+// when no state has been saved at path.
+func LoadUploadState(path string) (*UploadState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var state UploadState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parse upload state %s: %w", path, err)
+	}
+	if state.ReservationID == "" || len(state.Operations) == 0 {
+		return nil, fmt.Errorf("upload state %s is incomplete", path)
+	}
+	state.path = path
+	return &state, nil
+}
+
+// MatchesFile reports whether the state was recorded for the file described by
+// info, judged by size and modification time.
+func (s *UploadState) MatchesFile(info os.FileInfo) bool {
+	return s.FileSize == info.Size() && s.ModTime.Equal(info.ModTime().UTC())
+}
+
+// IsCompleted reports whether the operation at index has been uploaded.
+func (s *UploadState) IsCompleted(index int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, done := range s.Completed {
+		if done == index {
+			return true
+		}
+	}
+	return false
+}
+
+// MarkCompleted records the operation at index as uploaded and saves the state.
+func (s *UploadState) MarkCompleted(index int) error {
+	s.mu.Lock()
+	s.Completed = append(s.Completed, index)
+	sort.Ints(s.Completed)
+	s.mu.Unlock()
+	return s.Save()
+}
+
+// Save writes the state to its file, replacing any previous state atomically.
+func (s *UploadState) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteFileAtomic(s.path, data, 0o700); err != nil {
+		return fmt.Errorf("save upload state: %w", err)
+	}
+	return nil
+}
+
+// Remove deletes the saved state once the upload no longer needs resuming.
+func (s *UploadState) Remove() error {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// WithUploadState skips operations the state already records as completed and
+// records each operation as it completes.
+func WithUploadState(state *UploadState) UploadOption {
+	return func(opts *UploadOptions) {
+		opts.State = state
+	}
+}
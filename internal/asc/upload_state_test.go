@@ -0,0 +1,124 @@
+package asc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestUploadStateSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "app.ipa")
+	if err := os.WriteFile(filePath, []byte("abcdefghij"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+
+	statePath := filepath.Join(dir, "state", "upload.json")
+	if _, err := LoadUploadState(statePath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected not-exist error, got %v", err)
+	}
+
+	ops := []UploadOperation{
+		{Method: "PUT", URL: "https://example.com/0", Offset: 0, Length: 5},
+		{Method: "PUT", URL: "https://example.com/1", Offset: 5, Length: 5},
+	}
+	state := NewUploadState(statePath, "FILE_ID", info, ops)
+	state.ParentID = "UPLOAD_ID"
+	if err := state.Save(); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	if err := state.MarkCompleted(1); err != nil {
+		t.Fatalf("MarkCompleted() error: %v", err)
+	}
+
+	loaded, err := LoadUploadState(statePath)
+	if err != nil {
+		t.Fatalf("LoadUploadState() error: %v", err)
+	}
+	if loaded.ReservationID != "FILE_ID" || loaded.ParentID != "UPLOAD_ID" {
+		t.Fatalf("unexpected IDs: %+v", loaded)
+	}
+	if !reflect.DeepEqual(loaded.Operations, ops) {
+		t.Fatalf("unexpected operations: %+v", loaded.Operations)
+	}
+	if loaded.IsCompleted(0) || !loaded.IsCompleted(1) {
+		t.Fatalf("unexpected completed operations: %v", loaded.Completed)
+	}
+	if !loaded.MatchesFile(info) {
+		t.Fatal("expected state to match the file")
+	}
+
+	if err := os.WriteFile(filePath, []byte("abcdefghijk"), 0o600); err != nil {
+		t.Fatalf("rewrite file: %v", err)
+	}
+	changed, err := os.Stat(filePath)
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+	if loaded.MatchesFile(changed) {
+		t.Fatal("expected state not to match a changed file")
+	}
+
+	if err := loaded.Remove(); err != nil {
+		t.Fatalf("Remove() error: %v", err)
+	}
+	if _, err := os.Stat(statePath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected state file to be removed, got %v", err)
+	}
+}
+
+func TestExecuteUploadOperations_SkipsCompletedOperations(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "app.ipa")
+	if err := os.WriteFile(filePath, []byte("abcdefghij"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ops := []UploadOperation{
+		{Method: "PUT", URL: server.URL + "/op0", Offset: 0, Length: 5},
+		{Method: "PUT", URL: server.URL + "/op1", Offset: 5, Length: 5},
+	}
+	state := NewUploadState(filepath.Join(dir, "state.json"), "FILE_ID", info, ops)
+	if err := state.MarkCompleted(0); err != nil {
+		t.Fatalf("MarkCompleted() error: %v", err)
+	}
+
+	err = ExecuteUploadOperations(context.Background(), filePath, ops,
+		WithUploadHTTPClient(server.Client()),
+		WithUploadState(state),
+	)
+	if err != nil {
+		t.Fatalf("ExecuteUploadOperations() error: %v", err)
+	}
+	if !reflect.DeepEqual(paths, []string{"/op1"}) {
+		t.Fatalf("expected only /op1 to be uploaded, got %v", paths)
+	}
+
+	saved, err := LoadUploadState(filepath.Join(dir, "state.json"))
+	if err != nil {
+		t.Fatalf("LoadUploadState() error: %v", err)
+	}
+	if !reflect.DeepEqual(saved.Completed, []int{0, 1}) {
+		t.Fatalf("expected both operations recorded, got %v", saved.Completed)
+	}
+}
@@ -94,3 +94,32 @@ type UserInvitationRevokeResult struct {
 	ID      string `json:"id"`
 	Revoked bool   `json:"revoked"`
 }
+
+// UserOffboardApp represents an app a user or invitation could see.
+type UserOffboardApp struct {
+	ID       string `json:"id"`
+	Name     string `json:"name,omitempty"`
+	BundleID string `json:"bundleId,omitempty"`
+}
+
+// UserOffboardAccess records the roles and app access held by a user or
+// pending invitation before it was removed.
+type UserOffboardAccess struct {
+	ID                  string            `json:"id"`
+	Username            string            `json:"username,omitempty"`
+	Name                string            `json:"name,omitempty"`
+	Roles               []string          `json:"roles"`
+	AllAppsVisible      bool              `json:"allAppsVisible"`
+	ProvisioningAllowed bool              `json:"provisioningAllowed"`
+	VisibleApps         []UserOffboardApp `json:"visibleApps,omitempty"`
+	Removed             bool              `json:"removed"`
+	Error               string            `json:"error,omitempty"`
+}
+
+// UserOffboardResult represents CLI output for users offboard.
+type UserOffboardResult struct {
+	Email       string               `json:"email"`
+	DryRun      bool                 `json:"dryRun"`
+	User        *UserOffboardAccess  `json:"user,omitempty"`
+	Invitations []UserOffboardAccess `json:"invitations"`
+}
@@ -5,6 +5,33 @@ import (
 	"strings"
 )
 
+// UserAuditApp describes an app a user can see.
+type UserAuditApp struct {
+	ID       string `json:"id"`
+	Name     string `json:"name,omitempty"`
+	BundleID string `json:"bundleId,omitempty"`
+}
+
+// UserAuditItem describes one user in the access audit.
+type UserAuditItem struct {
+	ID                  string         `json:"id"`
+	Username            string         `json:"username"`
+	Name                string         `json:"name,omitempty"`
+	Roles               []string       `json:"roles"`
+	ProvisioningAllowed bool           `json:"provisioningAllowed"`
+	AllAppsVisible      bool           `json:"allAppsVisible"`
+	VisibleApps         []UserAuditApp `json:"visibleApps,omitempty"`
+}
+
+// UserAuditResult is the output of users audit.
+type UserAuditResult struct {
+	Total               int             `json:"total"`
+	AdminCount          int             `json:"adminCount"`
+	ProvisioningCount   int             `json:"provisioningCount"`
+	AllAppsVisibleCount int             `json:"allAppsVisibleCount"`
+	Users               []UserAuditItem `json:"users"`
+}
+
 func formatPersonName(firstName, lastName string) string {
 	first := strings.TrimSpace(firstName)
 	last := strings.TrimSpace(lastName)
@@ -72,3 +99,69 @@ func userInvitationRevokeResultRows(result *UserInvitationRevokeResult) ([]strin
 	rows := [][]string{{result.ID, fmt.Sprintf("%t", result.Revoked)}}
 	return headers, rows
 }
+
+func userOffboardResultRows(result *UserOffboardResult) ([]string, [][]string) {
+	headers := []string{"Kind", "ID", "Username", "Roles", "Apps", "Status"}
+	rows := make([][]string, 0, len(result.Invitations)+1)
+	appendRow := func(kind string, access UserOffboardAccess) {
+		apps := "all"
+		if !access.AllAppsVisible {
+			names := make([]string, 0, len(access.VisibleApps))
+			for _, app := range access.VisibleApps {
+				if strings.TrimSpace(app.Name) != "" {
+					names = append(names, compactWhitespace(app.Name))
+				} else {
+					names = append(names, app.ID)
+				}
+			}
+			apps = strings.Join(names, ", ")
+		}
+		status := "removed"
+		switch {
+		case access.Error != "":
+			status = "failed: " + compactWhitespace(access.Error)
+		case result.DryRun:
+			status = "would-remove"
+		}
+		rows = append(rows, []string{
+			kind,
+			access.ID,
+			compactWhitespace(access.Username),
+			strings.Join(access.Roles, ","),
+			apps,
+			status,
+		})
+	}
+	for _, invitation := range result.Invitations {
+		appendRow("invitation", invitation)
+	}
+	if result.User != nil {
+		appendRow("user", *result.User)
+	}
+	return headers, rows
+}
+
+func userAuditResultRows(result *UserAuditResult) ([]string, [][]string) {
+	headers := []string{"ID", "Username", "Name", "Roles", "Provisioning", "All Apps", "Visible Apps"}
+	rows := make([][]string, 0, len(result.Users))
+	for _, item := range result.Users {
+		apps := make([]string, 0, len(item.VisibleApps))
+		for _, app := range item.VisibleApps {
+			if app.BundleID != "" {
+				apps = append(apps, fmt.Sprintf("%s (%s)", app.Name, app.BundleID))
+			} else {
+				apps = append(apps, app.ID)
+			}
+		}
+		rows = append(rows, []string{
+			item.ID,
+			item.Username,
+			item.Name,
+			strings.Join(item.Roles, ";"),
+			fmt.Sprintf("%t", item.ProvisioningAllowed),
+			fmt.Sprintf("%t", item.AllAppsVisible),
+			strings.Join(apps, "; "),
+		})
+	}
+	return headers, rows
+}
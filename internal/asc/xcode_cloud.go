@@ -504,7 +504,8 @@ type CiBuildRunCreateData struct {
 // CiBuildRunCreateRelationships describes relationships for creating a CI build run.
 type CiBuildRunCreateRelationships struct {
 	Workflow          *Relationship `json:"workflow"`
-	SourceBranchOrTag *Relationship `json:"sourceBranchOrTag"`
+	SourceBranchOrTag *Relationship `json:"sourceBranchOrTag,omitempty"`
+	PullRequest       *Relationship `json:"pullRequest,omitempty"`
 }
 
 // Query types for Xcode Cloud endpoints
@@ -1616,32 +1617,9 @@ func (c *Client) ResolveCiWorkflowByName(ctx context.Context, productID, workflo
 // ResolveGitReferenceByName finds a git reference (branch or tag) by name.
 // Returns an error if no reference or multiple references match the name.
 func (c *Client) ResolveGitReferenceByName(ctx context.Context, repositoryID, refName string) (*ScmGitReferenceResource, error) {
-	var allRefs []ScmGitReferenceResource
-	var nextURL string
-
-	for {
-		var resp *ScmGitReferencesResponse
-		var err error
-
-		if nextURL != "" {
-			resp, err = c.GetScmGitReferences(ctx, repositoryID, WithScmGitReferencesNextURL(nextURL))
-		} else {
-			resp, err = c.GetScmGitReferences(ctx, repositoryID, WithScmGitReferencesLimit(200))
-		}
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch git references: %w", err)
-		}
-
-		allRefs = append(allRefs, resp.Data...)
-
-		if resp.Links.Next == "" {
-			break
-		}
-		nextURL = resp.Links.Next
-	}
-
-	if len(allRefs) == 0 {
-		return nil, fmt.Errorf("no git references found for repository %q", repositoryID)
+	allRefs, err := c.getAllScmGitReferences(ctx, repositoryID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Find matching references by name
@@ -1677,14 +1655,112 @@ func (c *Client) ResolveGitReferenceByName(ctx context.Context, repositoryID, re
 	return &matches[0], nil
 }
 
+// ResolveGitReferenceOfKind finds a branch or tag (kind BRANCH or TAG) by
+// name. Unlike ResolveGitReferenceByName, a tag never matches a branch of
+// the same name.
+func (c *Client) ResolveGitReferenceOfKind(ctx context.Context, repositoryID, kind, refName string) (*ScmGitReferenceResource, error) {
+	allRefs, err := c.getAllScmGitReferences(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	kind = strings.ToUpper(strings.TrimSpace(kind))
+	prefix := "refs/heads/"
+	if kind == "TAG" {
+		prefix = "refs/tags/"
+	}
+	normalizedName := strings.TrimSpace(refName)
+	for _, ref := range allRefs {
+		if ref.Attributes.IsDeleted || !strings.EqualFold(ref.Attributes.Kind, kind) {
+			continue
+		}
+		canonical := ref.Attributes.CanonicalName
+		if ref.Attributes.Name == normalizedName || canonical == normalizedName || canonical == prefix+normalizedName {
+			return &ref, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no %s named %q found", strings.ToLower(kind), refName)
+}
+
+// ResolveScmPullRequestByNumber finds an open pull request of a repository
+// by its number.
+func (c *Client) ResolveScmPullRequestByNumber(ctx context.Context, repositoryID string, number int) (*ScmPullRequestResource, error) {
+	var nextURL string
+
+	for {
+		var resp *ScmPullRequestsResponse
+		var err error
+
+		if nextURL != "" {
+			resp, err = c.GetScmRepositoryPullRequests(ctx, repositoryID, WithScmPullRequestsNextURL(nextURL))
+		} else {
+			resp, err = c.GetScmRepositoryPullRequests(ctx, repositoryID, WithScmPullRequestsLimit(200))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch pull requests: %w", err)
+		}
+
+		for _, pr := range resp.Data {
+			if pr.Attributes.Number != number {
+				continue
+			}
+			if pr.Attributes.IsClosed {
+				return nil, fmt.Errorf("pull request #%d is closed", number)
+			}
+			return &pr, nil
+		}
+
+		if resp.Links.Next == "" {
+			break
+		}
+		nextURL = resp.Links.Next
+	}
+
+	return nil, fmt.Errorf("no pull request #%d found for repository %q", number, repositoryID)
+}
+
+func (c *Client) getAllScmGitReferences(ctx context.Context, repositoryID string) ([]ScmGitReferenceResource, error) {
+	var allRefs []ScmGitReferenceResource
+	var nextURL string
+
+	for {
+		var resp *ScmGitReferencesResponse
+		var err error
+
+		if nextURL != "" {
+			resp, err = c.GetScmGitReferences(ctx, repositoryID, WithScmGitReferencesNextURL(nextURL))
+		} else {
+			resp, err = c.GetScmGitReferences(ctx, repositoryID, WithScmGitReferencesLimit(200))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch git references: %w", err)
+		}
+
+		allRefs = append(allRefs, resp.Data...)
+
+		if resp.Links.Next == "" {
+			break
+		}
+		nextURL = resp.Links.Next
+	}
+
+	if len(allRefs) == 0 {
+		return nil, fmt.Errorf("no git references found for repository %q", repositoryID)
+	}
+	return allRefs, nil
+}
+
 // XcodeCloudRunResult represents the result of triggering a build run.
 type XcodeCloudRunResult struct {
 	BuildRunID        string `json:"buildRunId"`
 	BuildNumber       int    `json:"buildNumber,omitempty"`
 	WorkflowID        string `json:"workflowId"`
 	WorkflowName      string `json:"workflowName,omitempty"`
-	GitReferenceID    string `json:"gitReferenceId"`
+	GitReferenceID    string `json:"gitReferenceId,omitempty"`
 	GitReferenceName  string `json:"gitReferenceName,omitempty"`
+	PullRequestID     string `json:"pullRequestId,omitempty"`
+	PullRequestNumber int    `json:"pullRequestNumber,omitempty"`
 	ExecutionProgress string `json:"executionProgress,omitempty"`
 	CompletionStatus  string `json:"completionStatus,omitempty"`
 	StartReason       string `json:"startReason,omitempty"`
@@ -15,6 +15,73 @@ type CiArtifactDownloadResult struct {
 	BytesWritten int64  `json:"bytesWritten,omitempty"`
 }
 
+// CiArtifactsDownloadResult represents CLI output for downloading the
+// artifacts of a build run.
+type CiArtifactsDownloadResult struct {
+	BuildRunID string                    `json:"buildRunId"`
+	OutputDir  string                    `json:"outputDir"`
+	Types      []string                  `json:"types,omitempty"`
+	Downloaded int                       `json:"downloaded"`
+	Skipped    int                       `json:"skipped"`
+	Artifacts  []CiArtifactsDownloadItem `json:"artifacts"`
+}
+
+// CiArtifactsDownloadItem is one artifact of a CiArtifactsDownloadResult.
+type CiArtifactsDownloadItem struct {
+	ID           string `json:"id"`
+	ActionID     string `json:"actionId"`
+	ActionName   string `json:"actionName,omitempty"`
+	FileName     string `json:"fileName,omitempty"`
+	FileType     string `json:"fileType,omitempty"`
+	FileSize     int    `json:"fileSize,omitempty"`
+	Path         string `json:"path"`
+	Status       string `json:"status"` // downloaded or skipped (file already exists)
+	BytesWritten int64  `json:"bytesWritten,omitempty"`
+}
+
+// CiIssuesListResult represents CLI output listing the issues of every
+// action of a build run.
+type CiIssuesListResult struct {
+	BuildRunID string        `json:"buildRunId"`
+	Types      []string      `json:"types,omitempty"`
+	Issues     []CiIssueItem `json:"issues"`
+}
+
+// CiIssueItem is one issue of a CiIssuesListResult.
+type CiIssueItem struct {
+	ID         string        `json:"id"`
+	ActionID   string        `json:"actionId"`
+	ActionName string        `json:"actionName,omitempty"`
+	IssueType  string        `json:"issueType"`
+	Category   string        `json:"category,omitempty"`
+	Message    string        `json:"message,omitempty"`
+	FileSource *FileLocation `json:"fileSource,omitempty"`
+}
+
+// CiTestResultsSummary represents CLI output summarizing the test results
+// of a build run.
+type CiTestResultsSummary struct {
+	BuildRunID       string          `json:"buildRunId"`
+	Total            int             `json:"total"`
+	Passed           int             `json:"passed"`
+	Failed           int             `json:"failed"`
+	Skipped          int             `json:"skipped"`
+	ExpectedFailures int             `json:"expectedFailures,omitempty"`
+	Failures         []CiTestFailure `json:"failures"`
+}
+
+// CiTestFailure is a failing test of a CiTestResultsSummary. MIXED tests
+// failed on some destinations only.
+type CiTestFailure struct {
+	ID         string        `json:"id"`
+	ActionName string        `json:"actionName,omitempty"`
+	ClassName  string        `json:"className,omitempty"`
+	Name       string        `json:"name"`
+	Status     string        `json:"status"`
+	Message    string        `json:"message,omitempty"`
+	FileSource *FileLocation `json:"fileSource,omitempty"`
+}
+
 // CiWorkflowDeleteResult represents CLI output for workflow deletions.
 type CiWorkflowDeleteResult struct {
 	ID      string `json:"id"`
@@ -28,7 +95,11 @@ type CiProductDeleteResult struct {
 }
 
 func xcodeCloudRunResultRows(result *XcodeCloudRunResult) ([]string, [][]string) {
-	headers := []string{"Build Run ID", "Build #", "Workflow ID", "Workflow Name", "Git Ref ID", "Git Ref Name", "Progress", "Status", "Start Reason", "Created"}
+	headers := []string{"Build Run ID", "Build #", "Workflow ID", "Workflow Name", "Git Ref ID", "Git Ref Name", "PR #", "Progress", "Status", "Start Reason", "Created"}
+	pullRequest := ""
+	if result.PullRequestNumber > 0 {
+		pullRequest = fmt.Sprintf("%d", result.PullRequestNumber)
+	}
 	rows := [][]string{{
 		result.BuildRunID,
 		fmt.Sprintf("%d", result.BuildNumber),
@@ -36,6 +107,7 @@ func xcodeCloudRunResultRows(result *XcodeCloudRunResult) ([]string, [][]string)
 		result.WorkflowName,
 		result.GitReferenceID,
 		result.GitReferenceName,
+		pullRequest,
 		result.ExecutionProgress,
 		result.CompletionStatus,
 		result.StartReason,
@@ -281,6 +353,37 @@ func ciTestResultsRows(resp *CiTestResultsResponse) ([]string, [][]string) {
 	return headers, rows
 }
 
+func ciTestResultsSummaryRows(result *CiTestResultsSummary) ([]string, [][]string) {
+	headers := []string{"Build Run ID", "Total", "Passed", "Failed", "Skipped", "Expected Failures"}
+	rows := [][]string{{
+		result.BuildRunID,
+		fmt.Sprintf("%d", result.Total),
+		fmt.Sprintf("%d", result.Passed),
+		fmt.Sprintf("%d", result.Failed),
+		fmt.Sprintf("%d", result.Skipped),
+		fmt.Sprintf("%d", result.ExpectedFailures),
+	}}
+	return headers, rows
+}
+
+func ciTestFailuresRows(failures []CiTestFailure) ([]string, [][]string) {
+	headers := []string{"Action", "Class", "Name", "Status", "File", "Line", "Message"}
+	rows := make([][]string, 0, len(failures))
+	for _, item := range failures {
+		filePath, lineNumber := formatFileLocation(item.FileSource)
+		rows = append(rows, []string{
+			item.ActionName,
+			item.ClassName,
+			item.Name,
+			item.Status,
+			filePath,
+			lineNumber,
+			item.Message,
+		})
+	}
+	return headers, rows
+}
+
 func ciIssuesRows(resp *CiIssuesResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Type", "File", "Line", "Message"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -310,6 +413,40 @@ func ciArtifactDownloadResultRows(result *CiArtifactDownloadResult) ([]string, [
 	return headers, rows
 }
 
+func ciArtifactsDownloadResultRows(result *CiArtifactsDownloadResult) ([]string, [][]string) {
+	headers := []string{"ID", "Action", "Name", "Type", "Size", "Status", "Path"}
+	rows := make([][]string, 0, len(result.Artifacts))
+	for _, item := range result.Artifacts {
+		rows = append(rows, []string{
+			item.ID,
+			item.ActionName,
+			item.FileName,
+			item.FileType,
+			fmt.Sprintf("%d", item.FileSize),
+			item.Status,
+			item.Path,
+		})
+	}
+	return headers, rows
+}
+
+func ciIssuesListResultRows(result *CiIssuesListResult) ([]string, [][]string) {
+	headers := []string{"ID", "Action", "Type", "File", "Line", "Message"}
+	rows := make([][]string, 0, len(result.Issues))
+	for _, item := range result.Issues {
+		filePath, lineNumber := formatFileLocation(item.FileSource)
+		rows = append(rows, []string{
+			item.ID,
+			item.ActionName,
+			item.IssueType,
+			filePath,
+			lineNumber,
+			item.Message,
+		})
+	}
+	return headers, rows
+}
+
 func ciWorkflowDeleteResultRows(result *CiWorkflowDeleteResult) ([]string, [][]string) {
 	headers := []string{"ID", "Deleted"}
 	rows := [][]string{{result.ID, fmt.Sprintf("%t", result.Deleted)}}
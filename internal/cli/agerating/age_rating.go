@@ -1,10 +1,13 @@
 package agerating
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
+	"reflect"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -25,6 +28,21 @@ var kidsAgeBandValues = []string{
 	"NINE_TO_ELEVEN",
 }
 
+var ageRatingOverrideValues = []string{
+	"NONE",
+	"NINE_PLUS",
+	"THIRTEEN_PLUS",
+	"SIXTEEN_PLUS",
+	"EIGHTEEN_PLUS",
+	"UNRATED",
+}
+
+var koreaAgeRatingOverrideValues = []string{
+	"NONE",
+	"FIFTEEN_PLUS",
+	"NINETEEN_PLUS",
+}
+
 // AgeRatingCommand returns the age rating command with subcommands.
 func AgeRatingCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("age-rating", flag.ExitOnError)
@@ -38,7 +56,8 @@ func AgeRatingCommand() *ffcli.Command {
 Examples:
   asc age-rating get --app APP_ID
   asc age-rating get --app-info-id APP_INFO_ID
-  asc age-rating set --app APP_ID --kids-age-band FIVE_AND_UNDER --gambling false`,
+  asc age-rating set --app APP_ID --kids-age-band FIVE_AND_UNDER --gambling false
+  asc age-rating set --version-id VERSION_ID --file age-rating.json`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -129,6 +148,18 @@ func AgeRatingSetCommand() *ffcli.Command {
 	seventeenPlus := fs.String("seventeen-plus", "", "17+ content (true/false, not supported by API)")
 	unrestrictedWebAccess := fs.String("unrestricted-web-access", "", "Unrestricted web access (true/false)")
 	kidsAgeBand := fs.String("kids-age-band", "", "Kids age band: FIVE_AND_UNDER, SIX_TO_EIGHT, NINE_TO_ELEVEN")
+	advertising := fs.String("advertising", "", "Advertising (true/false)")
+	ageAssurance := fs.String("age-assurance", "", "Age assurance (true/false)")
+	healthOrWellnessTopics := fs.String("health-or-wellness-topics", "", "Health or wellness topics (true/false)")
+	lootBox := fs.String("loot-box", "", "Loot boxes (true/false)")
+	messagingAndChat := fs.String("messaging-and-chat", "", "Messaging and chat (true/false)")
+	parentalControls := fs.String("parental-controls", "", "Parental controls (true/false)")
+	userGeneratedContent := fs.String("user-generated-content", "", "User-generated content (true/false)")
+	gunsOrOtherWeapons := fs.String("guns-or-other-weapons", "", "Guns or other weapons: NONE, INFREQUENT_OR_MILD, FREQUENT_OR_INTENSE")
+	ageRatingOverride := fs.String("age-rating-override", "", "Age rating override: NONE, NINE_PLUS, THIRTEEN_PLUS, SIXTEEN_PLUS, EIGHTEEN_PLUS, UNRATED")
+	koreaAgeRatingOverride := fs.String("korea-age-rating-override", "", "Korea age rating override: NONE, FIFTEEN_PLUS, NINETEEN_PLUS")
+	developerAgeRatingInfoURL := fs.String("developer-age-rating-info-url", "", "Developer age rating info URL")
+	file := fs.String("file", "", "Path to a JSON file of declaration attributes (flags override file values)")
 
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
@@ -141,7 +172,13 @@ func AgeRatingSetCommand() *ffcli.Command {
 
 Examples:
   asc age-rating set --id DECLARATION_ID --gambling false --kids-age-band FIVE_AND_UNDER
-  asc age-rating set --app APP_ID --violence-realistic FREQUENT_OR_INTENSE --unrestricted-web-access true`,
+  asc age-rating set --app APP_ID --violence-realistic FREQUENT_OR_INTENSE --unrestricted-web-access true
+  asc age-rating set --version-id VERSION_ID --loot-box true --messaging-and-chat false
+  asc age-rating set --version-id VERSION_ID --file age-rating.json
+
+The --file payload uses API attribute names and accepts either a bare
+attributes object or the JSON printed by "asc age-rating get":
+  {"gambling": false, "lootBox": true, "violenceCartoonOrFantasy": "INFREQUENT_OR_MILD"}`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -160,7 +197,16 @@ Examples:
 				}
 			}
 
-			attributes, err := buildAgeRatingAttributes(map[string]string{
+			var fileAttributes asc.AgeRatingDeclarationAttributes
+			if fileValue := strings.TrimSpace(*file); fileValue != "" {
+				loaded, err := readAgeRatingFile(fileValue)
+				if err != nil {
+					return fmt.Errorf("age-rating set: %w", err)
+				}
+				fileAttributes = loaded
+			}
+
+			flagAttributes, err := buildAgeRatingAttributes(map[string]string{
 				"gambling":                      *gambling,
 				"gambling-simulated":            *gamblingSimulated,
 				"alcohol-tobacco-drug-use":      *alcoholTobaccoDrug,
@@ -177,10 +223,22 @@ Examples:
 				"seventeen-plus":                *seventeenPlus,
 				"unrestricted-web-access":       *unrestrictedWebAccess,
 				"kids-age-band":                 *kidsAgeBand,
+				"advertising":                   *advertising,
+				"age-assurance":                 *ageAssurance,
+				"health-or-wellness-topics":     *healthOrWellnessTopics,
+				"loot-box":                      *lootBox,
+				"messaging-and-chat":            *messagingAndChat,
+				"parental-controls":             *parentalControls,
+				"user-generated-content":        *userGeneratedContent,
+				"guns-or-other-weapons":         *gunsOrOtherWeapons,
+				"age-rating-override":           *ageRatingOverride,
+				"korea-age-rating-override":     *koreaAgeRatingOverride,
+				"developer-age-rating-info-url": *developerAgeRatingInfoURL,
 			})
 			if err != nil {
 				return err
 			}
+			attributes := mergeAgeRatingAttributes(fileAttributes, flagAttributes)
 
 			if !hasAgeRatingUpdates(attributes) {
 				return fmt.Errorf("age-rating set: at least one update flag is required")
@@ -312,6 +370,49 @@ func buildAgeRatingAttributes(values map[string]string) (asc.AgeRatingDeclaratio
 	if err != nil {
 		return attrs, err
 	}
+	advertising, err := shared.ParseOptionalBoolFlag("--advertising", values["advertising"])
+	if err != nil {
+		return attrs, err
+	}
+	ageAssurance, err := shared.ParseOptionalBoolFlag("--age-assurance", values["age-assurance"])
+	if err != nil {
+		return attrs, err
+	}
+	healthOrWellnessTopics, err := shared.ParseOptionalBoolFlag("--health-or-wellness-topics", values["health-or-wellness-topics"])
+	if err != nil {
+		return attrs, err
+	}
+	lootBox, err := shared.ParseOptionalBoolFlag("--loot-box", values["loot-box"])
+	if err != nil {
+		return attrs, err
+	}
+	messagingAndChat, err := shared.ParseOptionalBoolFlag("--messaging-and-chat", values["messaging-and-chat"])
+	if err != nil {
+		return attrs, err
+	}
+	parentalControls, err := shared.ParseOptionalBoolFlag("--parental-controls", values["parental-controls"])
+	if err != nil {
+		return attrs, err
+	}
+	userGeneratedContent, err := shared.ParseOptionalBoolFlag("--user-generated-content", values["user-generated-content"])
+	if err != nil {
+		return attrs, err
+	}
+	gunsOrOtherWeapons, err := parseOptionalEnumFlag("--guns-or-other-weapons", values["guns-or-other-weapons"], ageRatingLevelValues)
+	if err != nil {
+		return attrs, err
+	}
+	ageRatingOverride, err := parseOptionalEnumFlag("--age-rating-override", values["age-rating-override"], ageRatingOverrideValues)
+	if err != nil {
+		return attrs, err
+	}
+	koreaAgeRatingOverride, err := parseOptionalEnumFlag("--korea-age-rating-override", values["korea-age-rating-override"], koreaAgeRatingOverrideValues)
+	if err != nil {
+		return attrs, err
+	}
+	if raw := strings.TrimSpace(values["developer-age-rating-info-url"]); raw != "" {
+		attrs.DeveloperAgeRatingInfoURL = &raw
+	}
 
 	attrs.Gambling = gambling
 	attrs.UnrestrictedWebAccess = unrestrictedWebAccess
@@ -328,10 +429,105 @@ func buildAgeRatingAttributes(values map[string]string) (asc.AgeRatingDeclaratio
 	attrs.ViolenceRealistic = violenceRealistic
 	attrs.ViolenceRealisticProlongedGraphicOrSadistic = violenceRealisticGraphic
 	attrs.KidsAgeBand = kidsAgeBand
+	attrs.Advertising = advertising
+	attrs.AgeAssurance = ageAssurance
+	attrs.HealthOrWellnessTopics = healthOrWellnessTopics
+	attrs.LootBox = lootBox
+	attrs.MessagingAndChat = messagingAndChat
+	attrs.ParentalControls = parentalControls
+	attrs.UserGeneratedContent = userGeneratedContent
+	attrs.GunsOrOtherWeapons = gunsOrOtherWeapons
+	attrs.AgeRatingOverrideV2 = ageRatingOverride
+	attrs.KoreaAgeRatingOverride = koreaAgeRatingOverride
 
 	return attrs, nil
 }
 
+// readAgeRatingFile loads declaration attributes from a JSON file. The file may
+// hold a bare attributes object or a full "age-rating get" response.
+func readAgeRatingFile(path string) (asc.AgeRatingDeclarationAttributes, error) {
+	var attrs asc.AgeRatingDeclarationAttributes
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return attrs, fmt.Errorf("read --file: %w", err)
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return attrs, fmt.Errorf("--file is empty")
+	}
+
+	var envelope struct {
+		Data *struct {
+			Attributes json.RawMessage `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return attrs, fmt.Errorf("--file: invalid JSON: %w", err)
+	}
+	if envelope.Data != nil {
+		data = envelope.Data.Attributes
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&attrs); err != nil {
+		return attrs, fmt.Errorf("--file: %w", err)
+	}
+	if attrs.SeventeenPlus != nil {
+		return attrs, fmt.Errorf("--file: seventeenPlus is not supported by the App Store Connect API")
+	}
+
+	enums := []struct {
+		name    string
+		value   *string
+		allowed []string
+	}{
+		{"alcoholTobaccoOrDrugUseOrReferences", attrs.AlcoholTobaccoOrDrugUseOrReferences, ageRatingLevelValues},
+		{"contests", attrs.Contests, ageRatingLevelValues},
+		{"gamblingSimulated", attrs.GamblingSimulated, ageRatingLevelValues},
+		{"gunsOrOtherWeapons", attrs.GunsOrOtherWeapons, ageRatingLevelValues},
+		{"medicalOrTreatmentInformation", attrs.MedicalOrTreatmentInformation, ageRatingLevelValues},
+		{"profanityOrCrudeHumor", attrs.ProfanityOrCrudeHumor, ageRatingLevelValues},
+		{"sexualContentGraphicAndNudity", attrs.SexualContentGraphicAndNudity, ageRatingLevelValues},
+		{"sexualContentOrNudity", attrs.SexualContentOrNudity, ageRatingLevelValues},
+		{"horrorOrFearThemes", attrs.HorrorOrFearThemes, ageRatingLevelValues},
+		{"matureOrSuggestiveThemes", attrs.MatureOrSuggestiveThemes, ageRatingLevelValues},
+		{"violenceCartoonOrFantasy", attrs.ViolenceCartoonOrFantasy, ageRatingLevelValues},
+		{"violenceRealistic", attrs.ViolenceRealistic, ageRatingLevelValues},
+		{"violenceRealisticProlongedGraphicOrSadistic", attrs.ViolenceRealisticProlongedGraphicOrSadistic, ageRatingLevelValues},
+		{"kidsAgeBand", attrs.KidsAgeBand, kidsAgeBandValues},
+		{"ageRatingOverrideV2", attrs.AgeRatingOverrideV2, ageRatingOverrideValues},
+		{"koreaAgeRatingOverride", attrs.KoreaAgeRatingOverride, koreaAgeRatingOverrideValues},
+	}
+	for _, enum := range enums {
+		if enum.value == nil {
+			continue
+		}
+		normalized, err := parseOptionalEnumFlag(enum.name, *enum.value, enum.allowed)
+		if err != nil {
+			return attrs, fmt.Errorf("--file: %w", err)
+		}
+		if normalized != nil {
+			*enum.value = *normalized
+		}
+	}
+
+	return attrs, nil
+}
+
+// mergeAgeRatingAttributes returns base with every non-nil field of overrides applied.
+func mergeAgeRatingAttributes(base, overrides asc.AgeRatingDeclarationAttributes) asc.AgeRatingDeclarationAttributes {
+	merged := base
+	mergedValue := reflect.ValueOf(&merged).Elem()
+	overrideValue := reflect.ValueOf(overrides)
+	for i := 0; i < overrideValue.NumField(); i++ {
+		if field := overrideValue.Field(i); !field.IsNil() {
+			mergedValue.Field(i).Set(field)
+		}
+	}
+	return merged
+}
+
 func hasAgeRatingUpdates(attrs asc.AgeRatingDeclarationAttributes) bool {
 	return attrs.Gambling != nil ||
 		attrs.UnrestrictedWebAccess != nil ||
@@ -347,7 +543,18 @@ func hasAgeRatingUpdates(attrs asc.AgeRatingDeclarationAttributes) bool {
 		attrs.ViolenceCartoonOrFantasy != nil ||
 		attrs.ViolenceRealistic != nil ||
 		attrs.ViolenceRealisticProlongedGraphicOrSadistic != nil ||
-		attrs.KidsAgeBand != nil
+		attrs.KidsAgeBand != nil ||
+		attrs.Advertising != nil ||
+		attrs.AgeAssurance != nil ||
+		attrs.HealthOrWellnessTopics != nil ||
+		attrs.LootBox != nil ||
+		attrs.MessagingAndChat != nil ||
+		attrs.ParentalControls != nil ||
+		attrs.UserGeneratedContent != nil ||
+		attrs.GunsOrOtherWeapons != nil ||
+		attrs.AgeRatingOverrideV2 != nil ||
+		attrs.KoreaAgeRatingOverride != nil ||
+		attrs.DeveloperAgeRatingInfoURL != nil
 }
 
 func parseOptionalEnumFlag(name, raw string, allowed []string) (*string, error) {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
@@ -82,3 +84,58 @@ func TestAgeRatingHelpers(t *testing.T) {
 		t.Fatal("expected updates when one pointer attribute is set")
 	}
 }
+
+func TestReadAgeRatingFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+		return path
+	}
+
+	attrs, err := readAgeRatingFile(write("bare.json", `{"messagingAndChat":true,"kidsAgeBand":"six_to_eight"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attrs.MessagingAndChat == nil || !*attrs.MessagingAndChat {
+		t.Fatalf("expected messagingAndChat true, got %v", attrs.MessagingAndChat)
+	}
+	if attrs.KidsAgeBand == nil || *attrs.KidsAgeBand != "SIX_TO_EIGHT" {
+		t.Fatalf("expected normalized kids age band, got %v", attrs.KidsAgeBand)
+	}
+
+	attrs, err = readAgeRatingFile(write("envelope.json", `{"data":{"id":"AGE_ID","attributes":{"parentalControls":false}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attrs.ParentalControls == nil || *attrs.ParentalControls {
+		t.Fatalf("expected parentalControls false, got %v", attrs.ParentalControls)
+	}
+
+	if _, err := readAgeRatingFile(write("bad-enum.json", `{"contests":"SOMETIMES"}`)); err == nil {
+		t.Fatal("expected enum validation error")
+	}
+	if _, err := readAgeRatingFile(write("seventeen.json", `{"seventeenPlus":true}`)); err == nil {
+		t.Fatal("expected seventeenPlus error")
+	}
+	if _, err := readAgeRatingFile(write("empty.json", "  ")); err == nil {
+		t.Fatal("expected empty file error")
+	}
+}
+
+func TestMergeAgeRatingAttributes(t *testing.T) {
+	yes, no := true, false
+	none := "NONE"
+	merged := mergeAgeRatingAttributes(
+		asc.AgeRatingDeclarationAttributes{LootBox: &no, Contests: &none},
+		asc.AgeRatingDeclarationAttributes{LootBox: &yes},
+	)
+	if merged.LootBox == nil || !*merged.LootBox {
+		t.Fatalf("expected override to win, got %v", merged.LootBox)
+	}
+	if merged.Contests == nil || *merged.Contests != "NONE" {
+		t.Fatalf("expected base value to be kept, got %v", merged.Contests)
+	}
+}
@@ -20,6 +20,7 @@ func AnalyticsCommand() *ffcli.Command {
 
 Examples:
   asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --date "2024-01-20"
+  asc analytics subscription-events --vendor "12345678" --date "2024-01-20"
   asc analytics request --app "APP_ID" --access-type ONGOING
   asc analytics requests --app "APP_ID"
   asc analytics get --request-id "REQUEST_ID"
@@ -30,6 +31,9 @@ Examples:
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			AnalyticsSalesCommand(),
+			AnalyticsSubscriptionsCommand(),
+			AnalyticsSubscribersCommand(),
+			AnalyticsSubscriptionEventsCommand(),
 			AnalyticsRequestCommand(),
 			AnalyticsRequestsCommand(),
 			AnalyticsGetCommand(),
@@ -5,7 +5,9 @@ import (
 	"compress/gzip"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
@@ -96,3 +98,131 @@ func TestDecompressGzipFile(t *testing.T) {
 		t.Fatalf("expected decompressed content to be hello, got %q", string(data))
 	}
 }
+
+func TestSalesSummaryAggregatesUnitsAndProceedsPerCurrency(t *testing.T) {
+	report := "Provider\tSKU\tUnits\tDeveloper Proceeds\tCountry Code\tCurrency of Proceeds\n" +
+		"APPLE\tpro\t3\t0.70\tUS\tUSD\n" +
+		"APPLE\tpro\t-1\t0.70\tUS\tUSD\n" +
+		"APPLE\tpro\t2\t0.60\tDE\tEUR\n" +
+		"APPLE\tlite\t5\t0\tUS\tUSD\n" +
+		"\n"
+
+	summary := newSalesSummary([]string{"sku"})
+	records, err := summary.add(bytes.NewReader([]byte(report)))
+	if err != nil {
+		t.Fatalf("add() error: %v", err)
+	}
+	if records != 4 {
+		t.Fatalf("expected 4 records, got %d", records)
+	}
+
+	groups := summary.result()
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups, got %+v", groups)
+	}
+	if groups[0].Group["sku"] != "lite" || groups[0].Units != 5 || groups[0].Proceeds != 0 {
+		t.Fatalf("unexpected lite group: %+v", groups[0])
+	}
+	if groups[1].Currency != "EUR" || groups[1].Units != 2 || groups[1].Proceeds != 1.2 {
+		t.Fatalf("unexpected EUR group: %+v", groups[1])
+	}
+	if groups[2].Currency != "USD" || groups[2].Units != 2 || groups[2].Proceeds != 1.4 {
+		t.Fatalf("unexpected USD group: %+v", groups[2])
+	}
+
+	if _, err := newSalesSummary([]string{"sku"}).add(bytes.NewReader([]byte("Start Date\tEnd Date\n"))); err != errNotSalesReport {
+		t.Fatalf("expected errNotSalesReport for non-sales file, got %v", err)
+	}
+	subscriber := "Event Date\tSubscriber ID\tDeveloper Proceeds\tProceeds Currency\tUnits\n2024-01-20\t1\t0.70\tUSD\t1\n"
+	if _, err := newSalesSummary([]string{"sku"}).add(bytes.NewReader([]byte(subscriber))); err != errNotSalesReport {
+		t.Fatalf("expected errNotSalesReport for subscriber report, got %v", err)
+	}
+}
+
+func TestNormalizeSubscriptionReportVersion(t *testing.T) {
+	if version, err := normalizeSubscriptionReportVersion(""); err != nil || version != asc.SalesReportVersion1_3 {
+		t.Fatalf("expected default 1_3, got %q (%v)", version, err)
+	}
+	if version, err := normalizeSubscriptionReportVersion("1_2"); err != nil || version != asc.SalesReportVersion1_2 {
+		t.Fatalf("expected 1_2, got %q (%v)", version, err)
+	}
+	if _, err := normalizeSubscriptionReportVersion("1_0"); err == nil {
+		t.Fatal("expected error for sales-only version 1_0")
+	}
+}
+
+func TestResolveWaitReportDate(t *testing.T) {
+	// 06:00 UTC on Jan 21 is still Jan 20 in Pacific time.
+	now := time.Date(2024, 1, 21, 6, 0, 0, 0, time.UTC)
+
+	if got, err := resolveWaitReportDate("yesterday", asc.SalesReportFrequencyDaily, now); err != nil || got != "2024-01-19" {
+		t.Fatalf("yesterday = %q (%v), want 2024-01-19", got, err)
+	}
+	if got, err := resolveWaitReportDate("Today", asc.SalesReportFrequencyDaily, now); err != nil || got != "2024-01-20" {
+		t.Fatalf("today = %q (%v), want 2024-01-20", got, err)
+	}
+	if got, err := resolveWaitReportDate("2024-01", asc.SalesReportFrequencyMonthly, now); err != nil || got != "2024-01" {
+		t.Fatalf("monthly date = %q (%v), want 2024-01", got, err)
+	}
+	if _, err := resolveWaitReportDate("yesterday", asc.SalesReportFrequencyWeekly, now); err == nil {
+		t.Fatal("expected error for yesterday with weekly frequency")
+	}
+}
+
+func TestProceedsSummaryConvertsWithReportExchangeRates(t *testing.T) {
+	report := "Start Date\tApple Identifier\tTitle\tExtended Partner Share\tPartner Share Currency\tCountry Of Sale\n" +
+		"01/01/2024\t111\tPro\t1,000.00\tUSD\tUS\n" +
+		"01/01/2024\t111\tPro\t100\tEUR\tDE\n" +
+		"01/01/2024\t222\tLite\t50\tGBP\tGB\n" +
+		"\n" +
+		"Country Of Sale\tPartner Share Currency\tExchange Rate\tBank Account Currency\n" +
+		"DE\tEUR\t1.10\tUSD\n" +
+		"GB\tGBP\t1.25\tEUR\n"
+
+	parsed, err := parseFinanceReport(bytes.NewReader([]byte(report)))
+	if err != nil {
+		t.Fatalf("parseFinanceReport() error: %v", err)
+	}
+	if len(parsed.rows) != 3 {
+		t.Fatalf("expected 3 rows, got %+v", parsed.rows)
+	}
+
+	// GBP is only listed with rates into EUR, so it needs a fallback.
+	if err := newProceedsSummary("USD", nil).add(parsed); err == nil || !strings.Contains(err.Error(), "--rate GBP=RATE") {
+		t.Fatalf("expected missing GBP rate error, got %v", err)
+	}
+
+	summary := newProceedsSummary("USD", map[string]float64{"GBP": 1.3, "EUR": 9})
+	if err := summary.add(parsed); err != nil {
+		t.Fatalf("add() error: %v", err)
+	}
+	total, apps, regions := summary.result()
+	if total != 1175 {
+		t.Fatalf("expected total 1175, got %v", total)
+	}
+	if len(apps) != 2 || apps[0].ID != "111" || apps[0].Name != "Pro" || apps[0].Proceeds != 1110 || apps[1].Proceeds != 65 {
+		t.Fatalf("unexpected apps: %+v", apps)
+	}
+	if len(regions) != 3 || regions[0].ID != "US" || regions[1].ID != "DE" || regions[1].Proceeds != 110 || regions[2].ID != "GB" {
+		t.Fatalf("unexpected regions: %+v", regions)
+	}
+
+	if _, err := parseFinanceReport(bytes.NewReader([]byte("Provider\tSKU\tUnits\n"))); err != errNotFinanceReport {
+		t.Fatalf("expected errNotFinanceReport, got %v", err)
+	}
+}
+
+func TestParseProceedsRates(t *testing.T) {
+	rates, err := parseProceedsRates("eur=1.08, GBP=1.27")
+	if err != nil {
+		t.Fatalf("parseProceedsRates() error: %v", err)
+	}
+	if rates["EUR"] != 1.08 || rates["GBP"] != 1.27 {
+		t.Fatalf("unexpected rates: %+v", rates)
+	}
+	for _, value := range []string{"EUR", "EUR=0", "=1.2", "EUR=abc"} {
+		if _, err := parseProceedsRates(value); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
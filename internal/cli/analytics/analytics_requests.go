@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -409,6 +410,7 @@ func AnalyticsDownloadCommand() *ffcli.Command {
 	instanceID := fs.String("instance-id", "", "Analytics report instance ID")
 	segmentID := fs.String("segment-id", "", "Analytics report segment ID (required if multiple)")
 	output := fs.String("output", "", "Output file path (default: analytics_report_{requestId}_{instanceId}.csv.gz)")
+	outputDir := fs.String("output-dir", "", "Download every segment into this directory")
 	decompress := fs.Bool("decompress", false, "Decompress gzip output to .csv")
 	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
@@ -419,10 +421,14 @@ func AnalyticsDownloadCommand() *ffcli.Command {
 		ShortHelp:  "Download analytics report data.",
 		LongHelp: `Download analytics report data.
 
+Use --output-dir to download every segment of an instance into a directory,
+one analytics_report_{instanceId}_{segmentId}.csv.gz file per segment.
+
 Examples:
   asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID"
   asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID" --decompress
-  asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID" --segment-id "SEGMENT_ID"`,
+  asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID" --segment-id "SEGMENT_ID"
+  asc analytics download --request-id "REQUEST_ID" --instance-id "INSTANCE_ID" --output-dir ./reports`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -440,6 +446,10 @@ Examples:
 			if err := validateUUIDFlag("--instance-id", *instanceID); err != nil {
 				return fmt.Errorf("analytics download: %w", err)
 			}
+			if strings.TrimSpace(*outputDir) != "" && (strings.TrimSpace(*output) != "" || strings.TrimSpace(*segmentID) != "") {
+				fmt.Fprintln(os.Stderr, "Error: --output-dir cannot be used with --output or --segment-id")
+				return flag.ErrHelp
+			}
 			if strings.TrimSpace(*segmentID) != "" {
 				if err := validateUUIDFlag("--segment-id", *segmentID); err != nil {
 					return fmt.Errorf("analytics download: %w", err)
@@ -490,6 +500,37 @@ Examples:
 				return fmt.Errorf("analytics download: no segments available for instance %q", strings.TrimSpace(*instanceID))
 			}
 
+			if dir := strings.TrimSpace(*outputDir); dir != "" {
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					return fmt.Errorf("analytics download: failed to create output directory: %w", err)
+				}
+				result := &asc.AnalyticsReportDownloadAllResult{
+					RequestID:  strings.TrimSpace(*requestID),
+					InstanceID: strings.TrimSpace(*instanceID),
+					OutputDir:  dir,
+					Segments:   make([]asc.AnalyticsReportDownloadResult, 0, len(segments)),
+				}
+				for _, segment := range segments {
+					segmentOutput := filepath.Join(dir, fmt.Sprintf("analytics_report_%s_%s.csv.gz", result.InstanceID, segment.ID))
+					compressedPath, decompressedPath := shared.ResolveReportOutputPaths(segmentOutput, segmentOutput, ".csv", *decompress)
+					compressedSize, decompressedSize, err := downloadAnalyticsSegment(requestCtx, client, segment, compressedPath, decompressedPath)
+					if err != nil {
+						return fmt.Errorf("analytics download: segment %s: %w", segment.ID, err)
+					}
+					result.Segments = append(result.Segments, asc.AnalyticsReportDownloadResult{
+						RequestID:        result.RequestID,
+						InstanceID:       result.InstanceID,
+						SegmentID:        segment.ID,
+						FilePath:         compressedPath,
+						FileSize:         compressedSize,
+						Decompressed:     *decompress,
+						DecompressedPath: decompressedPath,
+						DecompressedSize: decompressedSize,
+					})
+				}
+				return shared.PrintOutput(result, *outputFormat, *pretty)
+			}
+
 			selectedSegment := segments[0]
 			if strings.TrimSpace(*segmentID) != "" {
 				found := false
@@ -504,31 +545,12 @@ Examples:
 					return fmt.Errorf("analytics download: segment %q not found for instance %q", strings.TrimSpace(*segmentID), strings.TrimSpace(*instanceID))
 				}
 			} else if len(segments) > 1 {
-				return fmt.Errorf("analytics download: multiple segments found; specify --segment-id")
-			}
-
-			downloadURL := strings.TrimSpace(selectedSegment.Attributes.URL)
-			if downloadURL == "" {
-				return fmt.Errorf("analytics download: segment download URL is empty")
-			}
-
-			download, err := client.DownloadAnalyticsReport(requestCtx, downloadURL)
-			if err != nil {
-				return fmt.Errorf("analytics download: failed to download report: %w", err)
+				return fmt.Errorf("analytics download: multiple segments found; specify --segment-id or --output-dir")
 			}
-			defer download.Body.Close()
 
-			compressedSize, err := shared.WriteStreamToFile(compressedPath, download.Body)
+			compressedSize, decompressedSize, err := downloadAnalyticsSegment(requestCtx, client, selectedSegment, compressedPath, decompressedPath)
 			if err != nil {
-				return fmt.Errorf("analytics download: failed to write report: %w", err)
-			}
-
-			var decompressedSize int64
-			if *decompress {
-				decompressedSize, err = shared.DecompressGzipFile(compressedPath, decompressedPath)
-				if err != nil {
-					return fmt.Errorf("analytics download: %w", err)
-				}
+				return fmt.Errorf("analytics download: %w", err)
 			}
 
 			result := &asc.AnalyticsReportDownloadResult{
@@ -546,3 +568,24 @@ Examples:
 		},
 	}
 }
+
+// downloadAnalyticsSegment downloads one segment's report file to
+// compressedPath, and to decompressedPath as well when it is set.
+func downloadAnalyticsSegment(ctx context.Context, client *asc.Client, segment asc.Resource[asc.AnalyticsReportSegmentAttributes], compressedPath, decompressedPath string) (int64, int64, error) {
+	downloadURL := strings.TrimSpace(segment.Attributes.URL)
+	if downloadURL == "" {
+		return 0, 0, fmt.Errorf("segment download URL is empty")
+	}
+
+	download, err := client.DownloadAnalyticsReport(ctx, downloadURL)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to download report: %w", err)
+	}
+	defer download.Body.Close()
+
+	compressedSize, decompressedSize, err := shared.WriteReportStream(compressedPath, decompressedPath, download.Body)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to write report: %w", err)
+	}
+	return compressedSize, decompressedSize, nil
+}
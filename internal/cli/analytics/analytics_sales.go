@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -25,6 +26,13 @@ func AnalyticsSalesCommand() *ffcli.Command {
 	version := fs.String("version", "1_0", "Report format version: 1_0 (default), 1_1")
 	output := fs.String("output", "", "Output file path (default: sales_report_{date}_{type}.tsv.gz)")
 	decompress := fs.Bool("decompress", false, "Decompress gzip output to .tsv")
+	from := fs.String("from", "", "Backfill: first report date (same format as --date)")
+	to := fs.String("to", "", "Backfill: last report date, inclusive (same format as --date)")
+	outputDir := fs.String("output-dir", "reports", "Backfill: root directory for downloaded reports")
+	retries := fs.Int("retries", 3, "Backfill: times to retry dates whose reports are not available yet")
+	retryDelay := fs.Duration("retry-delay", time.Minute, "Backfill: wait between retries of unavailable dates")
+	toSQLite := fs.String("to-sqlite", "", "Also load the report rows into a new SQLite database at this path (replaced if it exists)")
+	toParquet := fs.String("to-parquet", "", "Also write the report rows to a Parquet file at this path (replaced if it exists)")
 	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -34,11 +42,29 @@ func AnalyticsSalesCommand() *ffcli.Command {
 		ShortHelp:  "Download sales and trends reports.",
 		LongHelp: `Download sales and trends reports.
 
+Use --from and --to instead of --date to backfill a range of report dates
+(days, weeks, months or years, following --frequency). Reports are written to
+  {output-dir}/sales/{vendor}/{TYPE}_{SUBTYPE}_{FREQUENCY}/{date}.tsv.gz
+dates whose files already exist are skipped, and dates whose reports are not
+available yet are retried and otherwise reported as pending, so re-running the
+same command picks up where the last run stopped.
+
+For SUBSCRIPTION, SUBSCRIPTION_EVENT and SUBSCRIBER reports, which use their
+own subtypes and format versions, prefer asc analytics subscriptions,
+subscription-events and subscribers.
+
+Use --to-sqlite or --to-parquet to also load the report rows into a typed
+"sales" table with snake_case columns and a leading report_date column. With
+--from/--to, every date whose report is on disk is included, so the file
+always covers the whole range downloaded so far.
+
 Examples:
   asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --date "2024-01-20"
   asc analytics sales --vendor "12345678" --type SUBSCRIPTION --subtype DETAILED --frequency MONTHLY --date "2024-01"
   asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --date "2024-01-20" --decompress
-  asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --date "2024-01-20" --output "reports/daily_sales.tsv.gz"`,
+  asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --date "2024-01-20" --output "reports/daily_sales.tsv.gz"
+  asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --from "2024-01-01" --to "2024-01-31"
+  asc analytics sales --vendor "12345678" --type SALES --subtype SUMMARY --frequency DAILY --from "2024-01-01" --to "2024-01-31" --to-sqlite sales.db`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -59,7 +85,29 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --frequency is required")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*date) == "" {
+			backfill := strings.TrimSpace(*from) != "" || strings.TrimSpace(*to) != ""
+			export := shared.ReportExport{
+				SQLitePath:  strings.TrimSpace(*toSQLite),
+				ParquetPath: strings.TrimSpace(*toParquet),
+			}
+			if backfill {
+				if strings.TrimSpace(*from) == "" || strings.TrimSpace(*to) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --from and --to must be used together")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*date) != "" || strings.TrimSpace(*output) != "" {
+					fmt.Fprintln(os.Stderr, "Error: --from/--to cannot be used with --date or --output")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*outputDir) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --output-dir is required")
+					return flag.ErrHelp
+				}
+				if *retries < 0 {
+					fmt.Fprintln(os.Stderr, "Error: --retries must be 0 or greater")
+					return flag.ErrHelp
+				}
+			} else if strings.TrimSpace(*date) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --date is required")
 				return flag.ErrHelp
 			}
@@ -76,67 +124,98 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("analytics sales: %w", err)
 			}
-			reportDate, err := normalizeReportDate(*date, freq)
-			if err != nil {
-				return fmt.Errorf("analytics sales: %w", err)
-			}
 			reportVersion, err := normalizeSalesReportVersion(*version)
 			if err != nil {
 				return fmt.Errorf("analytics sales: %w", err)
 			}
 
-			defaultOutput := fmt.Sprintf("sales_report_%s_%s.tsv.gz", reportDate, string(salesType))
-			compressedPath, decompressedPath := shared.ResolveReportOutputPaths(*output, defaultOutput, ".tsv", *decompress)
-
-			client, err := shared.GetASCClient()
-			if err != nil {
-				return fmt.Errorf("analytics sales: %w", err)
-			}
-
-			requestCtx, cancel := shared.ContextWithTimeout(ctx)
-			defer cancel()
-
-			download, err := client.GetSalesReport(requestCtx, asc.SalesReportParams{
+			params := asc.SalesReportParams{
 				VendorNumber:  vendorNumber,
 				ReportType:    salesType,
 				ReportSubType: subType,
 				Frequency:     freq,
-				ReportDate:    reportDate,
 				Version:       reportVersion,
-			})
-			if err != nil {
-				return fmt.Errorf("analytics sales: failed to download report: %w", err)
 			}
-			defer download.Body.Close()
-
-			compressedSize, err := shared.WriteStreamToFile(compressedPath, download.Body)
-			if err != nil {
-				return fmt.Errorf("analytics sales: failed to write report: %w", err)
+			opts := salesReportOptions{
+				command:      "analytics sales",
+				table:        "sales",
+				output:       strings.TrimSpace(*output),
+				outputDir:    strings.TrimSpace(*outputDir),
+				decompress:   *decompress,
+				retries:      *retries,
+				retryDelay:   *retryDelay,
+				export:       export,
+				outputFormat: *outputFormat,
+				pretty:       *pretty,
 			}
-
-			var decompressedSize int64
-			if *decompress {
-				decompressedSize, err = shared.DecompressGzipFile(compressedPath, decompressedPath)
+			if backfill {
+				dates, err := salesReportDateRange(strings.TrimSpace(*from), strings.TrimSpace(*to), freq)
 				if err != nil {
 					return fmt.Errorf("analytics sales: %w", err)
 				}
+				return runSalesBackfill(ctx, params, dates, opts)
 			}
 
-			result := &asc.SalesReportResult{
-				VendorNumber:     vendorNumber,
-				ReportType:       string(salesType),
-				ReportSubType:    string(subType),
-				Frequency:        string(freq),
-				ReportDate:       reportDate,
-				Version:          string(reportVersion),
-				FilePath:         compressedPath,
-				FileSize:         compressedSize,
-				Decompressed:     *decompress,
-				DecompressedPath: decompressedPath,
-				DecompressedSize: decompressedSize,
+			reportDate, err := normalizeReportDate(*date, freq)
+			if err != nil {
+				return fmt.Errorf("analytics sales: %w", err)
 			}
-
-			return shared.PrintOutput(result, *outputFormat, *pretty)
+			params.ReportDate = reportDate
+			return runSalesDownload(ctx, params, fmt.Sprintf("sales_report_%s_%s.tsv.gz", reportDate, string(salesType)), opts)
 		},
 	}
 }
+
+// runSalesDownload downloads the sales report for params.ReportDate to
+// opts.output, or defaultOutput when no path was given.
+func runSalesDownload(ctx context.Context, params asc.SalesReportParams, defaultOutput string, opts salesReportOptions) error {
+	compressedPath, decompressedPath := shared.ResolveReportOutputPaths(opts.output, defaultOutput, ".tsv", opts.decompress)
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("%s: %w", opts.command, err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	download, err := client.GetSalesReport(requestCtx, params)
+	if err != nil {
+		return fmt.Errorf("%s: failed to download report: %w", opts.command, err)
+	}
+	defer download.Body.Close()
+
+	compressedSize, decompressedSize, err := shared.WriteReportStream(compressedPath, decompressedPath, download.Body)
+	if err != nil {
+		return fmt.Errorf("%s: failed to write report: %w", opts.command, err)
+	}
+
+	result := &asc.SalesReportResult{
+		VendorNumber:     params.VendorNumber,
+		ReportType:       string(params.ReportType),
+		ReportSubType:    string(params.ReportSubType),
+		Frequency:        string(params.Frequency),
+		ReportDate:       params.ReportDate,
+		Version:          string(params.Version),
+		FilePath:         compressedPath,
+		FileSize:         compressedSize,
+		Decompressed:     opts.decompress,
+		DecompressedPath: decompressedPath,
+		DecompressedSize: decompressedSize,
+	}
+	if opts.export.Enabled() {
+		path := compressedPath
+		if decompressedPath != "" {
+			path = decompressedPath
+		}
+		rows, err := shared.ExportReports(opts.table, []shared.ReportExportFile{{ReportDate: params.ReportDate, Path: path}}, opts.export)
+		if err != nil {
+			return fmt.Errorf("%s: %w", opts.command, err)
+		}
+		result.SQLitePath = opts.export.SQLitePath
+		result.ParquetPath = opts.export.ParquetPath
+		result.ExportedRows = rows
+	}
+
+	return shared.PrintOutput(result, opts.outputFormat, opts.pretty)
+}
@@ -0,0 +1,95 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// salesReportOptions configures how a sales report command downloads,
+// exports and prints reports. command prefixes errors and table names the
+// exported table.
+type salesReportOptions struct {
+	command      string
+	table        string
+	output       string
+	outputDir    string
+	decompress   bool
+	retries      int
+	retryDelay   time.Duration
+	export       shared.ReportExport
+	outputFormat string
+	pretty       bool
+}
+
+// salesReportDateRange lists the report dates from from to to for the
+// frequency; weekly reports step seven days from --from.
+func salesReportDateRange(from, to string, frequency asc.SalesReportFrequency) ([]string, error) {
+	switch frequency {
+	case asc.SalesReportFrequencyMonthly:
+		return shared.ReportDateRange(from, to, "2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) })
+	case asc.SalesReportFrequencyYearly:
+		return shared.ReportDateRange(from, to, "2006", func(t time.Time) time.Time { return t.AddDate(1, 0, 0) })
+	case asc.SalesReportFrequencyWeekly:
+		return shared.ReportDateRange(from, to, "2006-01-02", func(t time.Time) time.Time { return t.AddDate(0, 0, 7) })
+	default:
+		return shared.ReportDateRange(from, to, "2006-01-02", func(t time.Time) time.Time { return t.AddDate(0, 0, 1) })
+	}
+}
+
+// runSalesBackfill downloads the sales report for each date into
+// {outputDir}/sales/{vendor}/{TYPE}_{SUBTYPE}_{FREQUENCY}/{date}.tsv.gz.
+func runSalesBackfill(ctx context.Context, params asc.SalesReportParams, dates []string, opts salesReportOptions) error {
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("%s: %w", opts.command, err)
+	}
+
+	seriesDir := filepath.Join(opts.outputDir, "sales", params.VendorNumber,
+		fmt.Sprintf("%s_%s_%s", params.ReportType, params.ReportSubType, params.Frequency))
+	backfill := shared.ReportBackfill{
+		Dates: dates,
+		Paths: func(date string) (string, string) {
+			path := filepath.Join(seriesDir, date+".tsv.gz")
+			return shared.ResolveReportOutputPaths(path, path, ".tsv", opts.decompress)
+		},
+		Download: func(ctx context.Context, date string) (*asc.ReportDownload, error) {
+			dateParams := params
+			dateParams.ReportDate = date
+			return client.GetSalesReport(ctx, dateParams)
+		},
+		Retries:    opts.retries,
+		RetryDelay: opts.retryDelay,
+	}
+
+	items, runErr := backfill.Run(ctx)
+	result := &asc.ReportBackfillResult{
+		Report:       "sales",
+		VendorNumber: params.VendorNumber,
+		From:         dates[0],
+		To:           dates[len(dates)-1],
+		OutputDir:    seriesDir,
+		Items:        items,
+	}
+	shared.SummarizeReportBackfill(result)
+	if opts.export.Enabled() {
+		if err := shared.ExportReportBackfill(opts.table, result, opts.export); err != nil {
+			return fmt.Errorf("%s: %w", opts.command, err)
+		}
+	}
+
+	if err := shared.PrintOutput(result, opts.outputFormat, opts.pretty); err != nil {
+		return err
+	}
+	if runErr != nil {
+		return fmt.Errorf("%s: %w", opts.command, runErr)
+	}
+	if result.Failed > 0 {
+		return fmt.Errorf("%s: %d of %d report dates failed", opts.command, result.Failed, len(items))
+	}
+	return nil
+}
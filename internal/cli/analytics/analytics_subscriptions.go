@@ -0,0 +1,207 @@
+package analytics
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// subscriptionReportSpec describes one of the subscription sales report
+// types. They are only generated daily, have a fixed subtype and use their
+// own format versions, separate from the 1_0/1_1 of basic sales reports.
+type subscriptionReportSpec struct {
+	name       string
+	reportType asc.SalesReportType
+	subType    asc.SalesReportSubType
+	table      string
+	shortHelp  string
+	contents   string
+}
+
+var subscriptionReportVersions = []asc.SalesReportVersion{asc.SalesReportVersion1_2, asc.SalesReportVersion1_3}
+
+// AnalyticsSubscriptionsCommand downloads SUBSCRIPTION reports.
+func AnalyticsSubscriptionsCommand() *ffcli.Command {
+	return subscriptionReportCommand(subscriptionReportSpec{
+		name:       "subscriptions",
+		reportType: asc.SalesReportTypeSubscription,
+		subType:    asc.SalesReportSubTypeSummary,
+		table:      "subscriptions",
+		shortHelp:  "Download daily subscription reports (active subscriptions).",
+		contents: `Subscription reports list active subscriptions per app, subscription,
+duration, offer and country: Active Standard Price Subscriptions, Active
+Free Trial Introductory Offer Subscriptions, Active Pay Up Front and Pay As
+You Go Introductory Offer Subscriptions, and Billing Retry and Grace Period
+counts, with Customer Price and Developer Proceeds per subscription.`,
+	})
+}
+
+// AnalyticsSubscribersCommand downloads SUBSCRIBER reports.
+func AnalyticsSubscribersCommand() *ffcli.Command {
+	return subscriptionReportCommand(subscriptionReportSpec{
+		name:       "subscribers",
+		reportType: asc.SalesReportTypeSubscriber,
+		subType:    asc.SalesReportSubTypeDetailed,
+		table:      "subscribers",
+		shortHelp:  "Download daily subscriber reports (transaction-level).",
+		contents: `Subscriber reports have one row per subscription transaction with an
+anonymous Subscriber ID, Event Date, Purchase Date, Customer Price and
+Currency, Developer Proceeds and Proceeds Currency, offer details, Refund
+and Units. Proceeds use "Proceeds Currency", not the "Currency of Proceeds"
+of sales reports, so asc reports summarize skips these files.`,
+	})
+}
+
+// AnalyticsSubscriptionEventsCommand downloads SUBSCRIPTION_EVENT reports.
+func AnalyticsSubscriptionEventsCommand() *ffcli.Command {
+	return subscriptionReportCommand(subscriptionReportSpec{
+		name:       "subscription-events",
+		reportType: asc.SalesReportTypeSubscriptionEvent,
+		subType:    asc.SalesReportSubTypeSummary,
+		table:      "subscription_events",
+		shortHelp:  "Download daily subscription event reports.",
+		contents: `Subscription event reports count subscription events per Event Date,
+Event (for example Subscribe, Renew, Cancel, Refund, Crossgrade), app,
+subscription, offer and country in the Quantity column, with Days Before
+Canceling and Days Canceled where relevant.`,
+	})
+}
+
+func subscriptionReportCommand(spec subscriptionReportSpec) *ffcli.Command {
+	fs := flag.NewFlagSet(spec.name, flag.ExitOnError)
+
+	vendor := fs.String("vendor", "", "Vendor number (or ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER env)")
+	date := fs.String("date", "", "Report date (YYYY-MM-DD)")
+	version := fs.String("version", string(asc.SalesReportVersion1_3), "Report format version: 1_3 (default), 1_2")
+	output := fs.String("output", "", fmt.Sprintf("Output file path (default: %s_report_{date}.tsv.gz)", strings.ToLower(string(spec.reportType))))
+	decompress := fs.Bool("decompress", false, "Decompress gzip output to .tsv")
+	from := fs.String("from", "", "Backfill: first report date (YYYY-MM-DD)")
+	to := fs.String("to", "", "Backfill: last report date, inclusive (YYYY-MM-DD)")
+	outputDir := fs.String("output-dir", "reports", "Backfill: root directory for downloaded reports")
+	retries := fs.Int("retries", 3, "Backfill: times to retry dates whose reports are not available yet")
+	retryDelay := fs.Duration("retry-delay", time.Minute, "Backfill: wait between retries of unavailable dates")
+	toSQLite := fs.String("to-sqlite", "", "Also load the report rows into a new SQLite database at this path (replaced if it exists)")
+	toParquet := fs.String("to-parquet", "", "Also write the report rows to a Parquet file at this path (replaced if it exists)")
+	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	command := "analytics " + spec.name
+	return &ffcli.Command{
+		Name:       spec.name,
+		ShortUsage: fmt.Sprintf("asc analytics %s --vendor VENDOR (--date YYYY-MM-DD | --from YYYY-MM-DD --to YYYY-MM-DD) [flags]", spec.name),
+		ShortHelp:  spec.shortHelp,
+		LongHelp: fmt.Sprintf(`%s
+
+Downloads %s/%s/DAILY sales reports. %s
+
+Their columns differ from basic sales reports and between format versions,
+so --version defaults to the current 1_3 format; 1_2 is still accepted.
+
+Use --from and --to instead of --date to backfill a range of days into
+  {output-dir}/sales/{vendor}/%s_%s_DAILY/{date}.tsv.gz
+and --to-sqlite or --to-parquet to also load the rows into a typed %q table.
+
+Examples:
+  asc analytics %s --vendor "12345678" --date "2024-01-20"
+  asc analytics %s --vendor "12345678" --date "2024-01-20" --decompress
+  asc analytics %s --vendor "12345678" --from "2024-01-01" --to "2024-01-31" --to-sqlite %s.db`,
+			spec.shortHelp, spec.reportType, spec.subType, spec.contents,
+			spec.reportType, spec.subType, spec.table,
+			spec.name, spec.name, spec.name, spec.table),
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			vendorNumber := shared.ResolveVendorNumber(*vendor)
+			if vendorNumber == "" {
+				fmt.Fprintln(os.Stderr, "Error: --vendor is required (or set ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER)")
+				return flag.ErrHelp
+			}
+			backfill := strings.TrimSpace(*from) != "" || strings.TrimSpace(*to) != ""
+			if backfill {
+				if strings.TrimSpace(*from) == "" || strings.TrimSpace(*to) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --from and --to must be used together")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*date) != "" || strings.TrimSpace(*output) != "" {
+					fmt.Fprintln(os.Stderr, "Error: --from/--to cannot be used with --date or --output")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*outputDir) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --output-dir is required")
+					return flag.ErrHelp
+				}
+				if *retries < 0 {
+					fmt.Fprintln(os.Stderr, "Error: --retries must be 0 or greater")
+					return flag.ErrHelp
+				}
+			} else if strings.TrimSpace(*date) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --date is required")
+				return flag.ErrHelp
+			}
+
+			reportVersion, err := normalizeSubscriptionReportVersion(*version)
+			if err != nil {
+				return fmt.Errorf("%s: %w", command, err)
+			}
+
+			params := asc.SalesReportParams{
+				VendorNumber:  vendorNumber,
+				ReportType:    spec.reportType,
+				ReportSubType: spec.subType,
+				Frequency:     asc.SalesReportFrequencyDaily,
+				Version:       reportVersion,
+			}
+			opts := salesReportOptions{
+				command:    command,
+				table:      spec.table,
+				output:     strings.TrimSpace(*output),
+				outputDir:  strings.TrimSpace(*outputDir),
+				decompress: *decompress,
+				retries:    *retries,
+				retryDelay: *retryDelay,
+				export: shared.ReportExport{
+					SQLitePath:  strings.TrimSpace(*toSQLite),
+					ParquetPath: strings.TrimSpace(*toParquet),
+				},
+				outputFormat: *outputFormat,
+				pretty:       *pretty,
+			}
+			if backfill {
+				dates, err := salesReportDateRange(strings.TrimSpace(*from), strings.TrimSpace(*to), asc.SalesReportFrequencyDaily)
+				if err != nil {
+					return fmt.Errorf("%s: %w", command, err)
+				}
+				return runSalesBackfill(ctx, params, dates, opts)
+			}
+
+			reportDate, err := normalizeReportDate(*date, asc.SalesReportFrequencyDaily)
+			if err != nil {
+				return fmt.Errorf("%s: %w", command, err)
+			}
+			params.ReportDate = reportDate
+			defaultOutput := fmt.Sprintf("%s_report_%s.tsv.gz", strings.ToLower(string(spec.reportType)), reportDate)
+			return runSalesDownload(ctx, params, defaultOutput, opts)
+		},
+	}
+}
+
+func normalizeSubscriptionReportVersion(value string) (asc.SalesReportVersion, error) {
+	normalized := strings.TrimSpace(value)
+	if normalized == "" {
+		return asc.SalesReportVersion1_3, nil
+	}
+	for _, version := range subscriptionReportVersions {
+		if normalized == string(version) {
+			return version, nil
+		}
+	}
+	return "", fmt.Errorf("--version must be 1_2 or 1_3")
+}
@@ -144,6 +144,105 @@ func TestAnalyticsSalesValidationErrors(t *testing.T) {
 	}
 }
 
+func TestAnalyticsSubscriptionReportValidationErrors(t *testing.T) {
+	t.Setenv("ASC_VENDOR_NUMBER", "")
+	t.Setenv("ASC_ANALYTICS_VENDOR_NUMBER", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "subscriptions missing vendor",
+			args:    []string{"analytics", "subscriptions", "--date", "2024-01-20"},
+			wantErr: "--vendor is required",
+		},
+		{
+			name:    "subscribers missing date",
+			args:    []string{"analytics", "subscribers", "--vendor", "12345678"},
+			wantErr: "--date is required",
+		},
+		{
+			name:    "subscription-events from without to",
+			args:    []string{"analytics", "subscription-events", "--vendor", "12345678", "--from", "2024-01-01"},
+			wantErr: "--from and --to must be used together",
+		},
+		{
+			name:    "subscription-events range with date",
+			args:    []string{"analytics", "subscription-events", "--vendor", "12345678", "--from", "2024-01-01", "--to", "2024-01-02", "--date", "2024-01-01"},
+			wantErr: "--from/--to cannot be used with --date or --output",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stdout, stderr, err := runAnalyticsCommand(t, test.args)
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", err)
+			}
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestReportsWaitValidationErrors(t *testing.T) {
+	t.Setenv("ASC_VENDOR_NUMBER", "")
+	t.Setenv("ASC_ANALYTICS_VENDOR_NUMBER", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing vendor",
+			args:    []string{"wait", "--date", "yesterday"},
+			wantErr: "--vendor is required",
+		},
+		{
+			name:    "missing date",
+			args:    []string{"wait", "--vendor", "12345678"},
+			wantErr: "--date is required",
+		},
+		{
+			name:    "non-positive poll interval",
+			args:    []string{"wait", "--vendor", "12345678", "--date", "yesterday", "--poll-interval", "0s"},
+			wantErr: "--poll-interval must be greater than 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := ReportsCommand()
+			cmd.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, stderr := captureOutput(t, func() {
+				if err := cmd.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = cmd.Run(context.Background())
+			})
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", runErr)
+			}
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
 func TestAnalyticsRequestValidationErrors(t *testing.T) {
 	t.Setenv("ASC_APP_ID", "")
 
@@ -297,6 +396,17 @@ func TestAnalyticsDownloadValidationErrors(t *testing.T) {
 			args:    []string{"analytics", "download", "--request-id", "11111111-1111-1111-1111-111111111111"},
 			wantErr: "--instance-id is required",
 		},
+		{
+			name: "output dir with segment id",
+			args: []string{
+				"analytics", "download",
+				"--request-id", "11111111-1111-1111-1111-111111111111",
+				"--instance-id", "22222222-2222-2222-2222-222222222222",
+				"--segment-id", "33333333-3333-3333-3333-333333333333",
+				"--output-dir", "reports",
+			},
+			wantErr: "--output-dir cannot be used with --output or --segment-id",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,407 @@
+package analytics
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// ReportsProceedsCommand returns the reports proceeds subcommand.
+func ReportsProceedsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("proceeds", flag.ExitOnError)
+
+	dir := fs.String("dir", "reports", "Directory of downloaded finance reports (.tsv or .tsv.gz), searched recursively")
+	currency := fs.String("currency", "", "Currency to convert proceeds to (e.g. USD)")
+	rates := fs.String("rate", "", "Comma-separated CUR=RATE fallbacks (units of --currency per unit of CUR) for currencies a report has no exchange rate for")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "proceeds",
+		ShortUsage: "asc reports proceeds --dir DIR --currency USD [--rate EUR=1.08]",
+		ShortHelp:  "Total finance report proceeds per app and region in one currency.",
+		LongHelp: `Total finance report proceeds per app and region in one currency.
+
+Reads finance reports downloaded with asc finance reports under --dir and
+sums Extended Partner Share, converted from Partner Share Currency to
+--currency. Each report is converted with its own exchange rates, taken from
+any section of the report with an Exchange Rate column whose Bank Account
+Currency is --currency, so every month uses the rates Apple paid it at.
+--rate supplies rates for currencies a report has none for; a currency
+without either is an error.
+
+Regions are the report's Region column, or Country Of Sale when there is
+none. Point --dir at one report series: consolidated (ZZ) and per-region
+reports for the same month cover the same sales. Files without finance
+report columns are skipped and listed in the output.
+
+Examples:
+  asc reports proceeds --dir ./reports/finance --currency USD
+  asc reports proceeds --dir ./reports/finance --currency EUR --rate USD=0.92,GBP=1.17 --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			root := strings.TrimSpace(*dir)
+			if root == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			target := strings.ToUpper(strings.TrimSpace(*currency))
+			if target == "" {
+				fmt.Fprintln(os.Stderr, "Error: --currency is required")
+				return flag.ErrHelp
+			}
+			fallbackRates, err := parseProceedsRates(*rates)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: --rate: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			files, err := findReportFiles(root)
+			if err != nil {
+				return fmt.Errorf("reports proceeds: %w", err)
+			}
+			if len(files) == 0 {
+				return fmt.Errorf("reports proceeds: no .tsv or .tsv.gz reports found in %s", root)
+			}
+
+			summary := newProceedsSummary(target, fallbackRates)
+			result := &asc.ProceedsSummaryResult{Dir: root, Currency: target}
+			for _, path := range files {
+				report, err := readFinanceReportFile(path)
+				if errors.Is(err, errNotFinanceReport) {
+					result.SkippedFiles = append(result.SkippedFiles, path)
+					continue
+				}
+				if err != nil {
+					return fmt.Errorf("reports proceeds: %s: %w", path, err)
+				}
+				if err := summary.add(report); err != nil {
+					return fmt.Errorf("reports proceeds: %s: %w", path, err)
+				}
+				result.Files++
+				result.Records += len(report.rows)
+			}
+			result.Total, result.Apps, result.Regions = summary.result()
+
+			return shared.PrintOutputWithCSV(result, *output, *pretty)
+		},
+	}
+}
+
+var errNotFinanceReport = errors.New("not a finance report")
+
+// parseProceedsRates parses "EUR=1.08,GBP=1.27".
+func parseProceedsRates(value string) (map[string]float64, error) {
+	rates := make(map[string]float64)
+	for _, entry := range shared.SplitCSV(value) {
+		code, rateText, ok := strings.Cut(entry, "=")
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if !ok || code == "" {
+			return nil, fmt.Errorf("%q must be CUR=RATE", entry)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rateText), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("%q must have a positive rate", entry)
+		}
+		rates[code] = rate
+	}
+	return rates, nil
+}
+
+type financeRow struct {
+	appID    string
+	title    string
+	region   string
+	currency string
+	amount   float64
+}
+
+type financeRate struct {
+	rate         float64
+	bankCurrency string
+}
+
+type financeReport struct {
+	rows  []financeRow
+	rates map[string]financeRate
+}
+
+func readFinanceReportFile(path string) (*financeReport, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		reader = gz
+	}
+	return parseFinanceReport(reader)
+}
+
+// parseFinanceReport reads the sales rows of a finance report (its first
+// section) and any exchange rates listed in later sections. Sections are
+// separated by blank lines.
+func parseFinanceReport(reader io.Reader) (*financeReport, error) {
+	sections, err := readTSVSections(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(sections) == 0 {
+		return nil, errNotFinanceReport
+	}
+
+	columns := tsvColumns(sections[0][0])
+	amountColumn, hasAmount := columns["extended partner share"]
+	currencyColumn, hasCurrency := columns["partner share currency"]
+	if !hasAmount || !hasCurrency {
+		return nil, errNotFinanceReport
+	}
+	regionColumn, hasRegion := columns["region"]
+	if !hasRegion {
+		regionColumn, hasRegion = columns["country of sale"]
+	}
+	field := func(record []string, index int, ok bool) string {
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+	appColumn, hasApp := columns["apple identifier"]
+	titleColumn, hasTitle := columns["title"]
+
+	report := &financeReport{rates: make(map[string]financeRate)}
+	for i, record := range sections[0][1:] {
+		amountText := field(record, amountColumn, true)
+		amount, err := parseReportNumber(amountText)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid Extended Partner Share %q", i+2, amountText)
+		}
+		report.rows = append(report.rows, financeRow{
+			appID:    field(record, appColumn, hasApp),
+			title:    field(record, titleColumn, hasTitle),
+			region:   field(record, regionColumn, hasRegion),
+			currency: strings.ToUpper(field(record, currencyColumn, true)),
+			amount:   amount,
+		})
+	}
+
+	for _, section := range sections[1:] {
+		parseFinanceRates(section, report.rates)
+	}
+	return report, nil
+}
+
+var currencyInParens = regexp.MustCompile(`\(([A-Za-z]{3})\)\s*$`)
+
+// parseFinanceRates reads a section with an Exchange Rate column. The
+// currency comes from a currency column other than Bank Account Currency,
+// or from a "Region (CUR)" label in the first column.
+func parseFinanceRates(section [][]string, rates map[string]financeRate) {
+	columns := tsvColumns(section[0])
+	rateColumn, ok := columns["exchange rate"]
+	if !ok {
+		return
+	}
+	bankColumn, hasBank := columns["bank account currency"]
+	currencyColumn, hasCurrency := -1, false
+	for i, name := range section[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if strings.Contains(name, "currency") && name != "bank account currency" {
+			currencyColumn, hasCurrency = i, true
+			break
+		}
+	}
+
+	for _, record := range section[1:] {
+		if rateColumn >= len(record) {
+			continue
+		}
+		rate, err := parseReportNumber(record[rateColumn])
+		if err != nil || rate <= 0 {
+			continue
+		}
+		var code string
+		if hasCurrency && currencyColumn < len(record) {
+			code = strings.TrimSpace(record[currencyColumn])
+		} else if match := currencyInParens.FindStringSubmatch(record[0]); match != nil {
+			code = match[1]
+		}
+		if code == "" {
+			continue
+		}
+		entry := financeRate{rate: rate}
+		if hasBank && bankColumn < len(record) {
+			entry.bankCurrency = strings.ToUpper(strings.TrimSpace(record[bankColumn]))
+		}
+		rates[strings.ToUpper(code)] = entry
+	}
+}
+
+func tsvColumns(header []string) map[string]int {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	return columns
+}
+
+// readTSVSections splits a tab-separated file into blank-line separated
+// sections of records.
+func readTSVSections(reader io.Reader) ([][][]string, error) {
+	var sections [][][]string
+	var current [][]string
+	buffered := bufio.NewReader(reader)
+	for {
+		line, err := buffered.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if strings.TrimSpace(line) == "" {
+			if len(current) > 0 {
+				sections = append(sections, current)
+				current = nil
+			}
+		} else {
+			current = append(current, strings.Split(line, "\t"))
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+	if len(current) > 0 {
+		sections = append(sections, current)
+	}
+	return sections, nil
+}
+
+func parseReportNumber(value string) (float64, error) {
+	return strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(value), ",", ""), 64)
+}
+
+type proceedsSummary struct {
+	currency      string
+	fallbackRates map[string]float64
+	apps          map[string]*asc.ProceedsTotal
+	regions       map[string]*asc.ProceedsTotal
+	total         float64
+}
+
+func newProceedsSummary(currency string, fallbackRates map[string]float64) *proceedsSummary {
+	return &proceedsSummary{
+		currency:      currency,
+		fallbackRates: fallbackRates,
+		apps:          make(map[string]*asc.ProceedsTotal),
+		regions:       make(map[string]*asc.ProceedsTotal),
+	}
+}
+
+// add converts a report's rows with its own exchange rates and adds them
+// to the totals. Nothing is added when a currency cannot be converted.
+func (s *proceedsSummary) add(report *financeReport) error {
+	converted := make([]float64, len(report.rows))
+	var missing []string
+	for i, row := range report.rows {
+		rate, ok := s.rate(report, row.currency)
+		if !ok {
+			if !containsString(missing, row.currency) {
+				missing = append(missing, row.currency)
+			}
+			continue
+		}
+		converted[i] = row.amount * rate
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("no exchange rate to %s for %s; pass --rate %s=RATE", s.currency, strings.Join(missing, ", "), missing[0])
+	}
+
+	for i, row := range report.rows {
+		app := s.apps[row.appID]
+		if app == nil {
+			app = &asc.ProceedsTotal{ID: row.appID}
+			s.apps[row.appID] = app
+		}
+		if app.Name == "" {
+			app.Name = row.title
+		}
+		app.Proceeds += converted[i]
+
+		region := s.regions[row.region]
+		if region == nil {
+			region = &asc.ProceedsTotal{ID: row.region}
+			s.regions[row.region] = region
+		}
+		region.Proceeds += converted[i]
+		s.total += converted[i]
+	}
+	return nil
+}
+
+func (s *proceedsSummary) rate(report *financeReport, currency string) (float64, bool) {
+	if currency == s.currency {
+		return 1, true
+	}
+	if entry, ok := report.rates[currency]; ok && entry.bankCurrency == s.currency {
+		return entry.rate, true
+	}
+	rate, ok := s.fallbackRates[currency]
+	return rate, ok
+}
+
+// result returns the total and the app and region totals, largest first.
+func (s *proceedsSummary) result() (float64, []asc.ProceedsTotal, []asc.ProceedsTotal) {
+	return roundProceeds(s.total), sortedProceeds(s.apps), sortedProceeds(s.regions)
+}
+
+func sortedProceeds(totals map[string]*asc.ProceedsTotal) []asc.ProceedsTotal {
+	out := make([]asc.ProceedsTotal, 0, len(totals))
+	for _, total := range totals {
+		entry := *total
+		entry.Proceeds = roundProceeds(entry.Proceeds)
+		out = append(out, entry)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Proceeds != out[j].Proceeds {
+			return out[i].Proceeds > out[j].Proceeds
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+func roundProceeds(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func containsString(values []string, value string) bool {
+	for _, existing := range values {
+		if existing == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,331 @@
+package analytics
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/tableexport"
+)
+
+func salesSummaryGroupKeys() []string {
+	keys := make([]string, 0, len(asc.SalesSummaryColumns))
+	for key := range asc.SalesSummaryColumns {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ReportsCommand returns the top-level reports command.
+func ReportsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("reports", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "reports",
+		ShortUsage: "asc reports <subcommand> [flags]",
+		ShortHelp:  "Wait for and work with downloaded sales and finance reports.",
+		LongHelp: `Wait for and work with downloaded sales and finance reports.
+
+Examples:
+  asc reports wait --vendor "12345678" --date yesterday --download
+  asc reports summarize --dir ./reports --group-by country,sku
+  asc reports proceeds --dir ./reports/finance --currency USD`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			ReportsSummarizeCommand(),
+			ReportsWaitCommand(),
+			ReportsProceedsCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// ReportsSummarizeCommand returns the reports summarize subcommand.
+func ReportsSummarizeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("summarize", flag.ExitOnError)
+
+	dir := fs.String("dir", "reports", "Directory of downloaded sales reports (.tsv or .tsv.gz), searched recursively")
+	groupBy := fs.String("group-by", "country", "Comma-separated grouping: "+strings.Join(salesSummaryGroupKeys(), ", "))
+	toSQLite := fs.String("to-sqlite", "", "Also write the summary to a new SQLite database at this path (replaced if it exists)")
+	toParquet := fs.String("to-parquet", "", "Also write the summary to a Parquet file at this path (replaced if it exists)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "summarize",
+		ShortUsage: "asc reports summarize --dir DIR [--group-by country,sku]",
+		ShortHelp:  "Aggregate units and proceeds from downloaded sales reports.",
+		LongHelp: `Aggregate units and proceeds from sales reports downloaded with
+asc analytics sales.
+
+Every .tsv and .tsv.gz file under --dir is read; a .tsv.gz is ignored when
+its decompressed .tsv is also present, so nothing is counted twice. Files
+without sales report columns (for example finance or subscriber reports)
+are skipped and listed in the output.
+
+Proceeds are units times developer proceeds, totalled per currency of
+proceeds: groups are always split by currency, since reports do not carry
+exchange rates.
+
+Use --to-sqlite or --to-parquet to also write the groups to a typed
+"sales_summary" table, with one column per --group-by key followed by
+currency, units and proceeds.
+
+Examples:
+  asc reports summarize --dir ./reports --group-by country,sku
+  asc reports summarize --dir ./reports/sales --group-by date --output table
+  asc reports summarize --dir ./reports --group-by title --output csv > summary.csv
+  asc reports summarize --dir ./reports --group-by date,country --to-parquet summary.parquet`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			root := strings.TrimSpace(*dir)
+			if root == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			keys := shared.SplitCSV(*groupBy)
+			if len(keys) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --group-by is required")
+				return flag.ErrHelp
+			}
+			for i, key := range keys {
+				keys[i] = strings.ToLower(key)
+				if _, ok := asc.SalesSummaryColumns[keys[i]]; !ok {
+					fmt.Fprintf(os.Stderr, "Error: --group-by must be one of: %s\n", strings.Join(salesSummaryGroupKeys(), ", "))
+					return flag.ErrHelp
+				}
+			}
+
+			files, err := findReportFiles(root)
+			if err != nil {
+				return fmt.Errorf("reports summarize: %w", err)
+			}
+			if len(files) == 0 {
+				return fmt.Errorf("reports summarize: no .tsv or .tsv.gz reports found in %s", root)
+			}
+
+			summary := newSalesSummary(keys)
+			result := &asc.SalesSummaryResult{Dir: root, GroupBy: keys}
+			for _, path := range files {
+				records, err := summary.addFile(path)
+				if errors.Is(err, errNotSalesReport) {
+					result.SkippedFiles = append(result.SkippedFiles, path)
+					continue
+				}
+				if err != nil {
+					return fmt.Errorf("reports summarize: %s: %w", path, err)
+				}
+				result.Files++
+				result.Records += records
+			}
+			result.Groups = summary.result()
+
+			export := shared.ReportExport{
+				SQLitePath:  strings.TrimSpace(*toSQLite),
+				ParquetPath: strings.TrimSpace(*toParquet),
+			}
+			if export.Enabled() {
+				tables, _, err := asc.CollectTables(result)
+				if err != nil {
+					return fmt.Errorf("reports summarize: %w", err)
+				}
+				if err := shared.ExportTable(tableexport.NewTable("sales_summary", tables[0].Headers, tables[0].Rows), export); err != nil {
+					return fmt.Errorf("reports summarize: %w", err)
+				}
+				result.SQLitePath = export.SQLitePath
+				result.ParquetPath = export.ParquetPath
+			}
+
+			return shared.PrintOutputWithCSV(result, *output, *pretty)
+		},
+	}
+}
+
+// findReportFiles lists report files under root in a stable order,
+// preferring a decompressed .tsv over its .tsv.gz.
+func findReportFiles(root string) ([]string, error) {
+	seen := make(map[string]bool)
+	var files []string
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		switch {
+		case strings.HasSuffix(path, ".tsv"):
+			seen[path] = true
+			files = append(files, path)
+		case strings.HasSuffix(path, ".tsv.gz"):
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	kept := files[:0]
+	for _, path := range files {
+		if strings.HasSuffix(path, ".gz") && seen[strings.TrimSuffix(path, ".gz")] {
+			continue
+		}
+		kept = append(kept, path)
+	}
+	sort.Strings(kept)
+	return kept, nil
+}
+
+var errNotSalesReport = errors.New("not a sales report")
+
+type salesSummaryKey struct {
+	group    string
+	currency string
+}
+
+type salesSummary struct {
+	groupBy []string
+	groups  map[salesSummaryKey]*asc.SalesSummaryGroup
+}
+
+func newSalesSummary(groupBy []string) *salesSummary {
+	return &salesSummary{groupBy: groupBy, groups: make(map[salesSummaryKey]*asc.SalesSummaryGroup)}
+}
+
+func (s *salesSummary) addFile(path string) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return 0, err
+		}
+		defer gz.Close()
+		reader = gz
+	}
+	return s.add(reader)
+}
+
+// add reads one tab-separated sales report and returns the number of rows
+// it aggregated.
+func (s *salesSummary) add(reader io.Reader) (int, error) {
+	tsv := csv.NewReader(reader)
+	tsv.Comma = '\t'
+	tsv.LazyQuotes = true
+	tsv.FieldsPerRecord = -1
+
+	header, err := tsv.Read()
+	if errors.Is(err, io.EOF) {
+		return 0, errNotSalesReport
+	}
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	unitsColumn, hasUnits := columns["Units"]
+	proceedsColumn, hasProceeds := columns["Developer Proceeds"]
+	// Subscriber reports also have Units and Developer Proceeds, but name
+	// their currency column "Proceeds Currency"; counting them would double
+	// the sales they duplicate.
+	_, hasCurrency := columns["Currency of Proceeds"]
+	if !hasUnits || !hasProceeds || !hasCurrency {
+		return 0, errNotSalesReport
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	records := 0
+	for line := 2; ; line++ {
+		record, err := tsv.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return records, err
+		}
+		if len(record) <= unitsColumn || len(record) <= proceedsColumn || strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		units, err := strconv.ParseFloat(strings.TrimSpace(record[unitsColumn]), 64)
+		if err != nil {
+			return records, fmt.Errorf("line %d: invalid Units %q", line, record[unitsColumn])
+		}
+		proceeds, err := strconv.ParseFloat(strings.TrimSpace(record[proceedsColumn]), 64)
+		if err != nil {
+			return records, fmt.Errorf("line %d: invalid Developer Proceeds %q", line, record[proceedsColumn])
+		}
+
+		values := make([]string, len(s.groupBy))
+		for i, key := range s.groupBy {
+			values[i] = field(record, asc.SalesSummaryColumns[key])
+		}
+		key := salesSummaryKey{group: strings.Join(values, "\x00"), currency: field(record, "Currency of Proceeds")}
+		group, ok := s.groups[key]
+		if !ok {
+			group = &asc.SalesSummaryGroup{Group: make(map[string]string, len(s.groupBy)), Currency: key.currency}
+			for i, name := range s.groupBy {
+				group.Group[name] = values[i]
+			}
+			s.groups[key] = group
+		}
+		group.Units += int64(math.Round(units))
+		group.Proceeds += units * proceeds
+		records++
+	}
+	return records, nil
+}
+
+// result returns the groups sorted by group values, then currency.
+func (s *salesSummary) result() []asc.SalesSummaryGroup {
+	keys := make([]salesSummaryKey, 0, len(s.groups))
+	for key := range s.groups {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].group != keys[j].group {
+			return keys[i].group < keys[j].group
+		}
+		return keys[i].currency < keys[j].currency
+	})
+	groups := make([]asc.SalesSummaryGroup, 0, len(keys))
+	for _, key := range keys {
+		group := *s.groups[key]
+		group.Proceeds = math.Round(group.Proceeds*100) / 100
+		groups = append(groups, group)
+	}
+	return groups
+}
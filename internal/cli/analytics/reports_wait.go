@@ -0,0 +1,221 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var (
+	reportsWaitNow   = time.Now
+	reportsWaitAfter = time.After
+)
+
+// ReportsWaitCommand returns the reports wait subcommand.
+func ReportsWaitCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("wait", flag.ExitOnError)
+
+	vendor := fs.String("vendor", "", "Vendor number (or ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER env)")
+	reportType := fs.String("type", string(asc.SalesReportTypeSales), "Report type: SALES, PRE_ORDER, NEWSSTAND, SUBSCRIPTION, SUBSCRIPTION_EVENT")
+	reportSubType := fs.String("subtype", string(asc.SalesReportSubTypeSummary), "Report subtype: SUMMARY, DETAILED")
+	frequency := fs.String("frequency", string(asc.SalesReportFrequencyDaily), "Frequency: DAILY, WEEKLY, MONTHLY, YEARLY")
+	date := fs.String("date", "", "Report date: yesterday, today, or daily/weekly YYYY-MM-DD, monthly YYYY-MM, yearly YYYY")
+	version := fs.String("version", "1_0", "Report format version: 1_0 (default), 1_1")
+	timeout := fs.Duration("timeout", 2*time.Hour, "Maximum time to wait")
+	pollInterval := fs.Duration("poll-interval", 10*time.Minute, "Time between polls")
+	download := fs.Bool("download", false, "Download the report once it is available")
+	file := fs.String("file", "", "Download path (default: sales_report_{date}_{type}.tsv.gz); implies --download")
+	decompress := fs.Bool("decompress", false, "Decompress the downloaded report to .tsv; implies --download")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "wait",
+		ShortUsage: "asc reports wait --date DATE [--type SALES] [--frequency DAILY] [flags]",
+		ShortHelp:  "Wait until Apple publishes a sales report, then optionally download it.",
+		LongHelp: `Wait until Apple publishes a sales report, then optionally download it.
+
+Polls the sales report endpoint every --poll-interval until the report for
+--date exists (the API answers 404 until then), instead of guessing the
+publish time in cron. With --download, --file or --decompress the report is
+saved as soon as it is available.
+
+--date yesterday and --date today are resolved in Pacific time, the day
+Apple's daily reports are based on, and are only valid for DAILY reports.
+
+Exits 0 once the report is available and 1 when --timeout elapses; the result
+is printed in both cases.
+
+Examples:
+  asc reports wait --vendor "12345678" --type SALES --frequency DAILY --date yesterday --timeout 2h
+  asc reports wait --vendor "12345678" --date yesterday --download --decompress
+  asc reports wait --vendor "12345678" --frequency MONTHLY --date 2024-01 --poll-interval 1h --timeout 72h --file reports/2024-01.tsv.gz`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			vendorNumber := shared.ResolveVendorNumber(*vendor)
+			if vendorNumber == "" {
+				fmt.Fprintln(os.Stderr, "Error: --vendor is required (or set ASC_VENDOR_NUMBER/ASC_ANALYTICS_VENDOR_NUMBER)")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*date) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --date is required")
+				return flag.ErrHelp
+			}
+			if *timeout <= 0 {
+				fmt.Fprintln(os.Stderr, "Error: --timeout must be greater than 0")
+				return flag.ErrHelp
+			}
+			if *pollInterval <= 0 {
+				fmt.Fprintln(os.Stderr, "Error: --poll-interval must be greater than 0")
+				return flag.ErrHelp
+			}
+
+			salesType, err := normalizeSalesReportType(*reportType)
+			if err != nil {
+				return fmt.Errorf("reports wait: %w", err)
+			}
+			subType, err := normalizeSalesReportSubType(*reportSubType)
+			if err != nil {
+				return fmt.Errorf("reports wait: %w", err)
+			}
+			freq, err := normalizeSalesReportFrequency(*frequency)
+			if err != nil {
+				return fmt.Errorf("reports wait: %w", err)
+			}
+			reportVersion, err := normalizeSalesReportVersion(*version)
+			if err != nil {
+				return fmt.Errorf("reports wait: %w", err)
+			}
+			reportDate, err := resolveWaitReportDate(*date, freq, reportsWaitNow())
+			if err != nil {
+				return fmt.Errorf("reports wait: %w", err)
+			}
+
+			params := asc.SalesReportParams{
+				VendorNumber:  vendorNumber,
+				ReportType:    salesType,
+				ReportSubType: subType,
+				Frequency:     freq,
+				ReportDate:    reportDate,
+				Version:       reportVersion,
+			}
+			result := &asc.ReportWaitResult{
+				VendorNumber:  vendorNumber,
+				ReportType:    string(salesType),
+				ReportSubType: string(subType),
+				Frequency:     string(freq),
+				ReportDate:    reportDate,
+				Version:       string(reportVersion),
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reports wait: %w", err)
+			}
+
+			waitCtx, cancel := shared.ContextWithTimeoutDuration(ctx, *timeout)
+			defer cancel()
+
+			start := reportsWaitNow()
+			reportDownload, waitErr := pollSalesReport(waitCtx, func(ctx context.Context) (*asc.ReportDownload, error) {
+				return client.GetSalesReport(ctx, params)
+			}, *pollInterval, result)
+			result.Elapsed = reportsWaitNow().Sub(start).Round(time.Second).String()
+
+			if waitErr == nil {
+				defer reportDownload.Body.Close()
+				if *download || strings.TrimSpace(*file) != "" || *decompress {
+					defaultOutput := fmt.Sprintf("sales_report_%s_%s.tsv.gz", reportDate, string(salesType))
+					compressedPath, decompressedPath := shared.ResolveReportOutputPaths(strings.TrimSpace(*file), defaultOutput, ".tsv", *decompress)
+					compressedSize, decompressedSize, err := shared.WriteReportStream(compressedPath, decompressedPath, reportDownload.Body)
+					if err != nil {
+						return fmt.Errorf("reports wait: failed to write report: %w", err)
+					}
+					result.FilePath = compressedPath
+					result.FileSize = compressedSize
+					result.DecompressedPath = decompressedPath
+					result.DecompressedSize = decompressedSize
+				}
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if waitErr != nil {
+				return fmt.Errorf("reports wait: %s report %s: %w", salesType, reportDate, waitErr)
+			}
+			return nil
+		},
+	}
+}
+
+// pollSalesReport requests the report until it exists. A not-found answer
+// means Apple has not published it yet; any other error stops waiting.
+// Report downloads are not cut off by the wait deadline once they start.
+func pollSalesReport(ctx context.Context, fetch func(context.Context) (*asc.ReportDownload, error), pollInterval time.Duration, result *asc.ReportWaitResult) (*asc.ReportDownload, error) {
+	for {
+		download, err := fetch(ctx)
+		if err != nil && ctx.Err() != nil {
+			return nil, reportWaitContextError(ctx.Err())
+		}
+		result.Attempts++
+		if err == nil {
+			result.Available = true
+			return download, nil
+		}
+		if !asc.IsNotFound(err) {
+			return nil, err
+		}
+
+		if shared.ProgressEnabled() {
+			fmt.Fprintf(os.Stderr, "Report %s not available yet (attempt %d)\n", result.ReportDate, result.Attempts)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, reportWaitContextError(ctx.Err())
+		case <-reportsWaitAfter(pollInterval):
+		}
+	}
+}
+
+func reportWaitContextError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return fmt.Errorf("canceled waiting for report")
+	}
+	return fmt.Errorf("timed out waiting for report")
+}
+
+// resolveWaitReportDate accepts the dates analytics sales does, plus
+// yesterday and today for daily reports.
+func resolveWaitReportDate(value string, frequency asc.SalesReportFrequency, now time.Time) (string, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(value))
+	if trimmed != "yesterday" && trimmed != "today" {
+		return normalizeReportDate(value, frequency)
+	}
+	if frequency != asc.SalesReportFrequencyDaily {
+		return "", fmt.Errorf("--date %s is only valid for DAILY reports", trimmed)
+	}
+	day := now.In(pacificTime())
+	if trimmed == "yesterday" {
+		day = day.AddDate(0, 0, -1)
+	}
+	return day.Format("2006-01-02"), nil
+}
+
+func pacificTime() *time.Location {
+	if location, err := time.LoadLocation("America/Los_Angeles"); err == nil {
+		return location
+	}
+	return time.FixedZone("PST", -8*60*60)
+}
@@ -0,0 +1,470 @@
+package apply
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var iapTypes = map[string]struct{}{
+	"CONSUMABLE":                {},
+	"NON_CONSUMABLE":            {},
+	"NON_RENEWING_SUBSCRIPTION": {},
+}
+
+var subscriptionPeriods = []string{
+	string(asc.SubscriptionPeriodOneWeek),
+	string(asc.SubscriptionPeriodOneMonth),
+	string(asc.SubscriptionPeriodTwoMonths),
+	string(asc.SubscriptionPeriodThreeMonths),
+	string(asc.SubscriptionPeriodSixMonths),
+	string(asc.SubscriptionPeriodOneYear),
+}
+
+// AppConfig is the YAML schema reconciled by asc apply.
+// Only fields present in the file are managed; everything else is left untouched.
+type AppConfig struct {
+	App                string                    `yaml:"app"`
+	Info               *InfoConfig               `yaml:"info,omitempty"`
+	Version            *VersionConfig            `yaml:"version,omitempty"`
+	Pricing            *PricingConfig            `yaml:"pricing,omitempty"`
+	Availability       *AvailabilityConfig       `yaml:"availability,omitempty"`
+	InAppPurchases     []InAppPurchaseConfig     `yaml:"inAppPurchases,omitempty"`
+	SubscriptionGroups []SubscriptionGroupConfig `yaml:"subscriptionGroups,omitempty"`
+	BetaGroups         []BetaGroupConfig         `yaml:"betaGroups,omitempty"`
+}
+
+// InfoConfig describes app-level (App Info) localizations.
+type InfoConfig struct {
+	AppInfoID     string                            `yaml:"appInfoId,omitempty"`
+	Localizations map[string]InfoLocalizationConfig `yaml:"localizations"`
+}
+
+// InfoLocalizationConfig describes App Info localization fields.
+type InfoLocalizationConfig struct {
+	Name              string `yaml:"name,omitempty"`
+	Subtitle          string `yaml:"subtitle,omitempty"`
+	PrivacyPolicyURL  string `yaml:"privacyPolicyUrl,omitempty"`
+	PrivacyChoicesURL string `yaml:"privacyChoicesUrl,omitempty"`
+	PrivacyPolicyText string `yaml:"privacyPolicyText,omitempty"`
+}
+
+// VersionConfig describes App Store version localizations.
+type VersionConfig struct {
+	ID            string                               `yaml:"id,omitempty"`
+	Version       string                               `yaml:"version,omitempty"`
+	Platform      string                               `yaml:"platform,omitempty"`
+	Localizations map[string]VersionLocalizationConfig `yaml:"localizations"`
+}
+
+// VersionLocalizationConfig describes App Store version localization fields.
+type VersionLocalizationConfig struct {
+	Description     string `yaml:"description,omitempty"`
+	Keywords        string `yaml:"keywords,omitempty"`
+	WhatsNew        string `yaml:"whatsNew,omitempty"`
+	PromotionalText string `yaml:"promotionalText,omitempty"`
+	SupportURL      string `yaml:"supportUrl,omitempty"`
+	MarketingURL    string `yaml:"marketingUrl,omitempty"`
+}
+
+// PricingConfig describes the app's manual base price.
+type PricingConfig struct {
+	BaseTerritory string `yaml:"baseTerritory"`
+	PricePoint    string `yaml:"pricePoint"`
+}
+
+// AvailabilityConfig describes the territories where the app is available.
+type AvailabilityConfig struct {
+	AvailableInNewTerritories *bool    `yaml:"availableInNewTerritories,omitempty"`
+	Territories               []string `yaml:"territories"`
+}
+
+// InAppPurchaseConfig describes an in-app purchase and its localizations.
+type InAppPurchaseConfig struct {
+	ProductID      string                                     `yaml:"productId"`
+	ReferenceName  string                                     `yaml:"referenceName,omitempty"`
+	Type           string                                     `yaml:"type,omitempty"`
+	ReviewNote     string                                     `yaml:"reviewNote,omitempty"`
+	FamilySharable *bool                                      `yaml:"familySharable,omitempty"`
+	Localizations  map[string]InAppPurchaseLocalizationConfig `yaml:"localizations,omitempty"`
+	Pricing        *InAppPurchasePricingConfig                `yaml:"pricing,omitempty"`
+}
+
+// InAppPurchasePricingConfig describes an in-app purchase's manual base price.
+// The price is a customer price rather than a price point ID so the same file
+// can be applied to another app.
+type InAppPurchasePricingConfig struct {
+	BaseTerritory string `yaml:"baseTerritory"`
+	Price         string `yaml:"price"`
+}
+
+// InAppPurchaseLocalizationConfig describes in-app purchase localization fields.
+type InAppPurchaseLocalizationConfig struct {
+	Name        string `yaml:"name,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// SubscriptionGroupConfig describes a subscription group, matched by
+// reference name, and its auto-renewable subscriptions.
+type SubscriptionGroupConfig struct {
+	ReferenceName string               `yaml:"referenceName"`
+	Subscriptions []SubscriptionConfig `yaml:"subscriptions,omitempty"`
+}
+
+// SubscriptionConfig describes an auto-renewable subscription, its
+// localizations, and its prices. Prices map territories to customer prices.
+type SubscriptionConfig struct {
+	ProductID          string                                    `yaml:"productId"`
+	ReferenceName      string                                    `yaml:"referenceName,omitempty"`
+	SubscriptionPeriod string                                    `yaml:"subscriptionPeriod,omitempty"`
+	GroupLevel         *int                                      `yaml:"groupLevel,omitempty"`
+	ReviewNote         string                                    `yaml:"reviewNote,omitempty"`
+	FamilySharable     *bool                                     `yaml:"familySharable,omitempty"`
+	Localizations      map[string]SubscriptionLocalizationConfig `yaml:"localizations,omitempty"`
+	Prices             map[string]string                         `yaml:"prices,omitempty"`
+}
+
+// SubscriptionLocalizationConfig describes subscription localization fields.
+type SubscriptionLocalizationConfig struct {
+	Name        string `yaml:"name,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// BetaGroupConfig describes a TestFlight beta group, matched by name.
+type BetaGroupConfig struct {
+	Name              string `yaml:"name"`
+	IsInternalGroup   *bool  `yaml:"isInternalGroup,omitempty"`
+	PublicLinkEnabled *bool  `yaml:"publicLinkEnabled,omitempty"`
+	PublicLinkLimit   *int   `yaml:"publicLinkLimit,omitempty"`
+	FeedbackEnabled   *bool  `yaml:"feedbackEnabled,omitempty"`
+}
+
+// ApplyCommand returns the apply command.
+func ApplyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+
+	file := fs.String("file", "", "Path to app YAML config (required)")
+	fs.StringVar(file, "f", "", "Shorthand for --file")
+	appID := fs.String("app", "", "App Store Connect app ID (overrides app in the file; or ASC_APP_ID)")
+	confirm := fs.Bool("confirm", false, "Apply the planned changes (default prints the plan only)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "apply",
+		ShortUsage: "asc apply -f app.yaml [--confirm] [flags]",
+		ShortHelp:  "Reconcile app metadata, pricing, availability, IAPs, subscriptions, and beta groups from YAML.",
+		LongHelp: `Reconcile app metadata, pricing, availability, IAPs, subscriptions, and beta groups from YAML.
+
+asc apply compares the file against App Store Connect and prints a plan of
+the creates and updates needed to converge. Nothing is changed until you
+re-run with --confirm. Only sections and fields present in the file are
+managed; empty values are ignored.
+
+Changing a base price replaces the app's or in-app purchase's price schedule.
+Manual prices in other territories are kept; scheduled changes in the base
+territory are dropped.
+
+Example app.yaml:
+  app: "123456789"
+  info:
+    localizations:
+      en-US:
+        name: "My App"
+        subtitle: "Do more"
+  version:
+    version: "1.2.0"
+    platform: IOS
+    localizations:
+      en-US:
+        description: "..."
+        whatsNew: "Bug fixes"
+  pricing:
+    baseTerritory: USA
+    pricePoint: "PRICE_POINT_ID"
+  availability:
+    availableInNewTerritories: true
+    territories: [USA, GBR, DEU]
+  inAppPurchases:
+    - productId: com.example.coins
+      referenceName: "100 Coins"
+      type: CONSUMABLE
+      localizations:
+        en-US:
+          name: "100 Coins"
+          description: "A pile of coins"
+      pricing:
+        baseTerritory: USA
+        price: "0.99"
+  subscriptionGroups:
+    - referenceName: "Premium"
+      subscriptions:
+        - productId: com.example.premium.monthly
+          referenceName: "Premium Monthly"
+          subscriptionPeriod: ONE_MONTH
+          prices:
+            USA: "4.99"
+  betaGroups:
+    - name: "External Testers"
+      publicLinkEnabled: true
+      publicLinkLimit: 500
+      feedbackEnabled: true
+
+Use asc export-state to generate a starting file from the current app, or
+asc iap export for an in-app purchase and subscription catalog only.
+
+Examples:
+  asc apply -f app.yaml
+  asc apply -f app.yaml --output table
+  asc apply -f app.yaml --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			path := strings.TrimSpace(*file)
+			if path == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			config, err := readAppConfig(path)
+			if err != nil {
+				return fmt.Errorf("apply: %w", err)
+			}
+
+			return runApply(ctx, "apply", path, config, *appID, *confirm, *output, *pretty)
+		},
+	}
+}
+
+// runApply plans config against the app and, with confirm, applies the plan.
+// The app comes from appIDFlag, then the file, then ASC_APP_ID.
+func runApply(ctx context.Context, command, path string, config *AppConfig, appIDFlag string, confirm bool, output string, pretty bool) error {
+	resolvedAppID := strings.TrimSpace(appIDFlag)
+	if resolvedAppID == "" {
+		resolvedAppID = strings.TrimSpace(config.App)
+	}
+	if resolvedAppID == "" {
+		resolvedAppID = shared.ResolveAppID("")
+	}
+	if resolvedAppID == "" {
+		fmt.Fprintln(os.Stderr, "Error: app is required (set app in the file, --app, or ASC_APP_ID)")
+		return flag.ErrHelp
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("%s: %w", command, err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	state, err := fetchApplyState(requestCtx, client, resolvedAppID, config)
+	if err != nil {
+		return fmt.Errorf("%s: %w", command, err)
+	}
+	planned, err := planApply(resolvedAppID, config, state)
+	if err != nil {
+		return fmt.Errorf("%s: %w", command, err)
+	}
+
+	result := &asc.ApplyResult{
+		File:    filepath.Clean(path),
+		AppID:   resolvedAppID,
+		Applied: confirm,
+		Changes: make([]asc.ApplyChange, 0, len(planned)),
+	}
+
+	failed := 0
+	if confirm {
+		failed = runPlan(requestCtx, client, planned)
+	}
+	for _, change := range planned {
+		result.Changes = append(result.Changes, change.ApplyChange)
+	}
+
+	if err := shared.PrintOutput(result, output, pretty); err != nil {
+		return err
+	}
+	if !confirm && len(planned) > 0 {
+		fmt.Fprintf(os.Stderr, "Plan: %d change(s). Re-run with --confirm to apply.\n", len(planned))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%s: %d change(s) failed", command, failed)
+	}
+	return nil
+}
+
+// readAppConfig loads and validates an apply config file.
+func readAppConfig(path string) (*AppConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read --file: %w", err)
+	}
+
+	var config AppConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%s is empty", path)
+		}
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if err := normalizeAppConfig(&config); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &config, nil
+}
+
+func normalizeAppConfig(config *AppConfig) error {
+	if config.Version != nil {
+		config.Version.ID = strings.TrimSpace(config.Version.ID)
+		config.Version.Version = strings.TrimSpace(config.Version.Version)
+		if config.Version.ID == "" && config.Version.Version == "" {
+			return fmt.Errorf("version: id or version is required")
+		}
+		platform := config.Version.Platform
+		if strings.TrimSpace(platform) == "" {
+			platform = "IOS"
+		}
+		normalized, err := shared.NormalizeAppStoreVersionPlatform(platform)
+		if err != nil {
+			return fmt.Errorf("version: platform must be one of IOS, MAC_OS, TV_OS, VISION_OS")
+		}
+		config.Version.Platform = normalized
+	}
+
+	if config.Pricing != nil {
+		config.Pricing.BaseTerritory = strings.ToUpper(strings.TrimSpace(config.Pricing.BaseTerritory))
+		config.Pricing.PricePoint = strings.TrimSpace(config.Pricing.PricePoint)
+		if config.Pricing.BaseTerritory == "" || config.Pricing.PricePoint == "" {
+			return fmt.Errorf("pricing: baseTerritory and pricePoint are required")
+		}
+	}
+
+	if config.Availability != nil {
+		seen := make(map[string]bool, len(config.Availability.Territories))
+		territories := make([]string, 0, len(config.Availability.Territories))
+		for _, territory := range config.Availability.Territories {
+			territory = strings.ToUpper(strings.TrimSpace(territory))
+			if territory == "" || seen[territory] {
+				continue
+			}
+			seen[territory] = true
+			territories = append(territories, territory)
+		}
+		config.Availability.Territories = territories
+	}
+
+	seenProducts := make(map[string]bool, len(config.InAppPurchases))
+	for i := range config.InAppPurchases {
+		iap := &config.InAppPurchases[i]
+		iap.ProductID = strings.TrimSpace(iap.ProductID)
+		if iap.ProductID == "" {
+			return fmt.Errorf("inAppPurchases[%d]: productId is required", i)
+		}
+		if seenProducts[iap.ProductID] {
+			return fmt.Errorf("inAppPurchases: duplicate productId %q", iap.ProductID)
+		}
+		seenProducts[iap.ProductID] = true
+		if iap.Type != "" {
+			iap.Type = strings.ToUpper(strings.TrimSpace(iap.Type))
+			if _, ok := iapTypes[iap.Type]; !ok {
+				return fmt.Errorf("inAppPurchases[%s]: type must be one of CONSUMABLE, NON_CONSUMABLE, NON_RENEWING_SUBSCRIPTION", iap.ProductID)
+			}
+		}
+		if iap.Pricing != nil {
+			iap.Pricing.BaseTerritory = strings.ToUpper(strings.TrimSpace(iap.Pricing.BaseTerritory))
+			iap.Pricing.Price = strings.TrimSpace(iap.Pricing.Price)
+			if iap.Pricing.BaseTerritory == "" || iap.Pricing.Price == "" {
+				return fmt.Errorf("inAppPurchases[%s]: pricing baseTerritory and price are required", iap.ProductID)
+			}
+			if _, ok := new(big.Rat).SetString(iap.Pricing.Price); !ok {
+				return fmt.Errorf("inAppPurchases[%s]: pricing price must be a number, got %q", iap.ProductID, iap.Pricing.Price)
+			}
+		}
+	}
+
+	seenSubscriptionGroups := make(map[string]bool, len(config.SubscriptionGroups))
+	for i := range config.SubscriptionGroups {
+		group := &config.SubscriptionGroups[i]
+		group.ReferenceName = strings.TrimSpace(group.ReferenceName)
+		if group.ReferenceName == "" {
+			return fmt.Errorf("subscriptionGroups[%d]: referenceName is required", i)
+		}
+		if seenSubscriptionGroups[group.ReferenceName] {
+			return fmt.Errorf("subscriptionGroups: duplicate referenceName %q", group.ReferenceName)
+		}
+		seenSubscriptionGroups[group.ReferenceName] = true
+		for j := range group.Subscriptions {
+			sub := &group.Subscriptions[j]
+			sub.ProductID = strings.TrimSpace(sub.ProductID)
+			if sub.ProductID == "" {
+				return fmt.Errorf("subscriptionGroups[%s]: subscriptions[%d]: productId is required", group.ReferenceName, j)
+			}
+			if seenProducts[sub.ProductID] {
+				return fmt.Errorf("subscriptionGroups[%s]: duplicate productId %q", group.ReferenceName, sub.ProductID)
+			}
+			seenProducts[sub.ProductID] = true
+			if sub.SubscriptionPeriod != "" {
+				sub.SubscriptionPeriod = strings.ToUpper(strings.TrimSpace(sub.SubscriptionPeriod))
+				if !slices.Contains(subscriptionPeriods, sub.SubscriptionPeriod) {
+					return fmt.Errorf("subscriptions[%s]: subscriptionPeriod must be one of %s", sub.ProductID, strings.Join(subscriptionPeriods, ", "))
+				}
+			}
+			if sub.GroupLevel != nil && *sub.GroupLevel < 1 {
+				return fmt.Errorf("subscriptions[%s]: groupLevel must be 1 or greater", sub.ProductID)
+			}
+			if len(sub.Prices) > 0 {
+				prices := make(map[string]string, len(sub.Prices))
+				for territory, price := range sub.Prices {
+					territory = strings.ToUpper(strings.TrimSpace(territory))
+					price = strings.TrimSpace(price)
+					if territory == "" {
+						return fmt.Errorf("subscriptions[%s]: prices territory is required", sub.ProductID)
+					}
+					if _, ok := prices[territory]; ok {
+						return fmt.Errorf("subscriptions[%s]: duplicate price for %s", sub.ProductID, territory)
+					}
+					if _, ok := new(big.Rat).SetString(price); !ok {
+						return fmt.Errorf("subscriptions[%s]: price for %s must be a number, got %q", sub.ProductID, territory, price)
+					}
+					prices[territory] = price
+				}
+				sub.Prices = prices
+			}
+		}
+	}
+
+	seenGroups := make(map[string]bool, len(config.BetaGroups))
+	for i := range config.BetaGroups {
+		group := &config.BetaGroups[i]
+		group.Name = strings.TrimSpace(group.Name)
+		if group.Name == "" {
+			return fmt.Errorf("betaGroups[%d]: name is required", i)
+		}
+		if seenGroups[group.Name] {
+			return fmt.Errorf("betaGroups: duplicate name %q", group.Name)
+		}
+		seenGroups[group.Name] = true
+		if group.PublicLinkLimit != nil && *group.PublicLinkLimit < 0 {
+			return fmt.Errorf("betaGroups[%s]: publicLinkLimit must be 0 or greater", group.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,610 @@
+package apply
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "app.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return path
+}
+
+func TestReadAppConfigNormalizes(t *testing.T) {
+	path := writeConfig(t, `
+app: "123"
+version:
+  version: "1.0"
+  platform: ios
+pricing:
+  baseTerritory: usa
+  pricePoint: " pp-1 "
+availability:
+  territories: [usa, GBR, USA, ""]
+inAppPurchases:
+  - productId: com.example.coins
+    type: consumable
+`)
+	config, err := readAppConfig(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Version.Platform != "IOS" {
+		t.Fatalf("expected IOS platform, got %q", config.Version.Platform)
+	}
+	if config.Pricing.BaseTerritory != "USA" || config.Pricing.PricePoint != "pp-1" {
+		t.Fatalf("unexpected pricing: %+v", config.Pricing)
+	}
+	if !reflect.DeepEqual(config.Availability.Territories, []string{"USA", "GBR"}) {
+		t.Fatalf("unexpected territories: %v", config.Availability.Territories)
+	}
+	if config.InAppPurchases[0].Type != "CONSUMABLE" {
+		t.Fatalf("expected CONSUMABLE, got %q", config.InAppPurchases[0].Type)
+	}
+}
+
+func TestReadAppConfigErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "empty", content: "", wantErr: "is empty"},
+		{name: "unknown field", content: "app: \"1\"\nbogus: true\n", wantErr: "field bogus not found"},
+		{name: "version without selector", content: "version:\n  platform: IOS\n", wantErr: "version: id or version is required"},
+		{name: "invalid platform", content: "version:\n  version: \"1.0\"\n  platform: WATCH\n", wantErr: "version: platform must be one of"},
+		{name: "pricing incomplete", content: "pricing:\n  baseTerritory: USA\n", wantErr: "pricing: baseTerritory and pricePoint are required"},
+		{name: "iap missing product", content: "inAppPurchases:\n  - referenceName: Coins\n", wantErr: "inAppPurchases[0]: productId is required"},
+		{name: "iap duplicate", content: "inAppPurchases:\n  - productId: a\n  - productId: a\n", wantErr: `duplicate productId "a"`},
+		{name: "iap invalid type", content: "inAppPurchases:\n  - productId: a\n    type: SUBSCRIPTION\n", wantErr: "type must be one of"},
+		{name: "iap pricing incomplete", content: "inAppPurchases:\n  - productId: a\n    pricing:\n      baseTerritory: USA\n", wantErr: "pricing baseTerritory and price are required"},
+		{name: "iap pricing not a number", content: "inAppPurchases:\n  - productId: a\n    pricing:\n      baseTerritory: USA\n      price: cheap\n", wantErr: "pricing price must be a number"},
+		{name: "subscription group missing name", content: "subscriptionGroups:\n  - subscriptions: []\n", wantErr: "subscriptionGroups[0]: referenceName is required"},
+		{name: "subscription duplicate iap", content: "inAppPurchases:\n  - productId: a\nsubscriptionGroups:\n  - referenceName: g\n    subscriptions:\n      - productId: a\n", wantErr: `duplicate productId "a"`},
+		{name: "subscription invalid period", content: "subscriptionGroups:\n  - referenceName: g\n    subscriptions:\n      - productId: a\n        subscriptionPeriod: WEEKLY\n", wantErr: "subscriptionPeriod must be one of"},
+		{name: "subscription price not a number", content: "subscriptionGroups:\n  - referenceName: g\n    subscriptions:\n      - productId: a\n        prices:\n          USA: cheap\n", wantErr: "price for USA must be a number"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := readAppConfig(writeConfig(t, test.content))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPlanApplyLocalizations(t *testing.T) {
+	config := &AppConfig{
+		Info: &InfoConfig{Localizations: map[string]InfoLocalizationConfig{
+			"en-US": {Name: "My App", Subtitle: "New subtitle"},
+			"de-DE": {Name: "Meine App"},
+			"fr-FR": {Name: "Mon App"},
+		}},
+	}
+	state := &applyState{
+		AppInfoID: "info-1",
+		InfoLocalizations: map[string]asc.Resource[asc.AppInfoLocalizationAttributes]{
+			"en-US": {ID: "loc-en", Attributes: asc.AppInfoLocalizationAttributes{Locale: "en-US", Name: "My App", Subtitle: "Old"}},
+			"fr-FR": {ID: "loc-fr", Attributes: asc.AppInfoLocalizationAttributes{Locale: "fr-FR", Name: "Mon App", Subtitle: "Unmanaged"}},
+		},
+	}
+
+	changes, err := planApply("123", config, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %+v", changes)
+	}
+	if changes[0].Action != applyActionCreate || changes[0].Target != "de-DE" || !reflect.DeepEqual(changes[0].Fields, []string{"name"}) {
+		t.Fatalf("unexpected create change: %+v", changes[0].ApplyChange)
+	}
+	if changes[1].Action != applyActionUpdate || changes[1].Target != "en-US" || !reflect.DeepEqual(changes[1].Fields, []string{"subtitle"}) {
+		t.Fatalf("unexpected update change: %+v", changes[1].ApplyChange)
+	}
+	for _, change := range changes {
+		if change.Status != applyStatusPlanned {
+			t.Fatalf("expected planned status, got %q", change.Status)
+		}
+	}
+}
+
+func TestPlanApplyAvailabilityTogglesTerritories(t *testing.T) {
+	config := &AppConfig{Availability: &AvailabilityConfig{Territories: []string{"USA", "GBR"}}}
+	state := &applyState{Availability: &availabilityState{
+		AvailableInNewTerritories: true,
+		Territories: map[string]territoryState{
+			"USA": {ID: "ta-usa", Available: true},
+			"GBR": {ID: "ta-gbr", Available: false},
+			"DEU": {ID: "ta-deu", Available: true},
+		},
+	}}
+
+	changes, err := planApply("123", config, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	targets := make([]string, 0, len(changes))
+	for _, change := range changes {
+		targets = append(targets, change.Target)
+	}
+	if !reflect.DeepEqual(targets, []string{"DEU", "GBR"}) {
+		t.Fatalf("expected DEU and GBR updates, got %v", targets)
+	}
+
+	config.Availability.Territories = []string{"USA", "ZZZ"}
+	if _, err := planApply("123", config, state); err == nil || !strings.Contains(err.Error(), "unknown territories: ZZZ") {
+		t.Fatalf("expected unknown territory error, got %v", err)
+	}
+}
+
+func TestPlanApplyAvailabilityWithoutTerritories(t *testing.T) {
+	disabled := false
+	config := &AppConfig{Availability: &AvailabilityConfig{AvailableInNewTerritories: &disabled}}
+	state := &applyState{Availability: &availabilityState{
+		AvailableInNewTerritories: false,
+		Territories: map[string]territoryState{
+			"USA": {ID: "ta-usa", Available: true},
+			"GBR": {ID: "ta-gbr", Available: false},
+		},
+	}}
+
+	changes, err := planApply("123", config, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no territory changes, got %+v", changes)
+	}
+
+	state.Availability.AvailableInNewTerritories = true
+	changes, err = planApply("123", config, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Resource != "appAvailability" || !reflect.DeepEqual(changes[0].Fields, []string{"availableInNewTerritories"}) {
+		t.Fatalf("expected availableInNewTerritories update only, got %+v", changes)
+	}
+	if got := state.Availability.availableTerritories(); !reflect.DeepEqual(got, []string{"USA"}) {
+		t.Fatalf("expected current territories to be kept, got %v", got)
+	}
+
+	if _, err := planApply("123", config, &applyState{}); err == nil || !strings.Contains(err.Error(), "territories are required") {
+		t.Fatalf("expected territories required error, got %v", err)
+	}
+}
+
+func TestPlanApplyAvailabilityCreatesWhenMissing(t *testing.T) {
+	enabled := true
+	config := &AppConfig{Availability: &AvailabilityConfig{AvailableInNewTerritories: &enabled, Territories: []string{"USA"}}}
+
+	changes, err := planApply("123", config, &applyState{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Resource != "appAvailability" || changes[0].Action != applyActionCreate {
+		t.Fatalf("expected appAvailability create, got %+v", changes)
+	}
+}
+
+func TestPlanApplyPricing(t *testing.T) {
+	config := &AppConfig{Pricing: &PricingConfig{BaseTerritory: "USA", PricePoint: "pp-2"}}
+
+	changes, err := planApply("123", config, &applyState{Pricing: &pricingState{BaseTerritory: "USA", PricePoint: "pp-2"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no changes, got %+v", changes)
+	}
+
+	changes, err = planApply("123", config, &applyState{Pricing: &pricingState{BaseTerritory: "USA", PricePoint: "pp-1"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Action != applyActionUpdate || !reflect.DeepEqual(changes[0].Fields, []string{"pricePoint"}) {
+		t.Fatalf("expected pricePoint update, got %+v", changes)
+	}
+}
+
+func TestPlanApplyInAppPurchases(t *testing.T) {
+	config := &AppConfig{InAppPurchases: []InAppPurchaseConfig{
+		{
+			ProductID:     "com.example.new",
+			ReferenceName: "New",
+			Type:          "CONSUMABLE",
+			Localizations: map[string]InAppPurchaseLocalizationConfig{"en-US": {Name: "New"}},
+		},
+		{
+			ProductID:     "com.example.existing",
+			ReferenceName: "Renamed",
+			Localizations: map[string]InAppPurchaseLocalizationConfig{"en-US": {Name: "Existing", Description: "Updated"}},
+		},
+	}}
+	state := &applyState{InAppPurchases: map[string]*iapState{
+		"com.example.existing": {
+			ID:         "iap-1",
+			Attributes: asc.InAppPurchaseV2Attributes{Name: "Existing", ProductID: "com.example.existing", InAppPurchaseType: "NON_CONSUMABLE"},
+			Localizations: map[string]asc.Resource[asc.InAppPurchaseLocalizationAttributes]{
+				"en-US": {ID: "iap-loc-1", Attributes: asc.InAppPurchaseLocalizationAttributes{Name: "Existing", Locale: "en-US", Description: "Old"}},
+			},
+		},
+	}}
+
+	changes, err := planApply("123", config, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := make([]string, 0, len(changes))
+	for _, change := range changes {
+		got = append(got, change.Resource+":"+change.Action+":"+change.Target+":"+strings.Join(change.Fields, ","))
+	}
+	want := []string{
+		"inAppPurchase:create:com.example.new:referenceName,type",
+		"inAppPurchaseLocalization:create:com.example.new/en-US:name",
+		"inAppPurchase:update:com.example.existing:referenceName",
+		"inAppPurchaseLocalization:update:com.example.existing/en-US:description",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected changes:\n got %v\nwant %v", got, want)
+	}
+
+	config.InAppPurchases[1].Type = "CONSUMABLE"
+	if _, err := planApply("123", config, state); err == nil || !strings.Contains(err.Error(), "type cannot change") {
+		t.Fatalf("expected type change error, got %v", err)
+	}
+
+	config.InAppPurchases = []InAppPurchaseConfig{{ProductID: "com.example.other"}}
+	if _, err := planApply("123", config, state); err == nil || !strings.Contains(err.Error(), "referenceName and type are required") {
+		t.Fatalf("expected create requirements error, got %v", err)
+	}
+}
+
+func TestPlanApplyInAppPurchasePricing(t *testing.T) {
+	config := &AppConfig{InAppPurchases: []InAppPurchaseConfig{
+		{ProductID: "com.example.new", ReferenceName: "New", Type: "CONSUMABLE", Pricing: &InAppPurchasePricingConfig{BaseTerritory: "USA", Price: "0.99"}},
+		{ProductID: "com.example.unpriced", Pricing: &InAppPurchasePricingConfig{BaseTerritory: "USA", Price: "1.99"}},
+		{ProductID: "com.example.same", Pricing: &InAppPurchasePricingConfig{BaseTerritory: "USA", Price: "2.9"}},
+		{ProductID: "com.example.changed", Pricing: &InAppPurchasePricingConfig{BaseTerritory: "GBR", Price: "2.99"}},
+	}}
+	state := &applyState{InAppPurchases: map[string]*iapState{
+		"com.example.unpriced": {ID: "iap-1"},
+		"com.example.same":     {ID: "iap-2", Pricing: &iapPricingState{BaseTerritory: "USA", Price: "2.90"}},
+		"com.example.changed":  {ID: "iap-3", Pricing: &iapPricingState{BaseTerritory: "USA", Price: "1.99"}},
+	}}
+
+	changes, err := planApply("123", config, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := make([]string, 0, len(changes))
+	for _, change := range changes {
+		got = append(got, change.Resource+":"+change.Action+":"+change.Target+":"+strings.Join(change.Fields, ","))
+	}
+	want := []string{
+		"inAppPurchase:create:com.example.new:referenceName,type",
+		"inAppPurchasePriceSchedule:create:com.example.new:baseTerritory,price",
+		"inAppPurchasePriceSchedule:create:com.example.unpriced:baseTerritory,price",
+		"inAppPurchasePriceSchedule:update:com.example.changed:baseTerritory,price",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected changes:\n got %v\nwant %v", got, want)
+	}
+}
+
+func TestPlanApplySubscriptionGroups(t *testing.T) {
+	level := 1
+	config := &AppConfig{SubscriptionGroups: []SubscriptionGroupConfig{
+		{
+			ReferenceName: "New",
+			Subscriptions: []SubscriptionConfig{{
+				ProductID:          "com.example.new.monthly",
+				ReferenceName:      "New Monthly",
+				SubscriptionPeriod: "ONE_MONTH",
+				Localizations:      map[string]SubscriptionLocalizationConfig{"en-US": {Name: "New"}},
+				Prices:             map[string]string{"USA": "4.99"},
+			}},
+		},
+		{
+			ReferenceName: "Premium",
+			Subscriptions: []SubscriptionConfig{{
+				ProductID:     "com.example.premium",
+				ReferenceName: "Premium Monthly",
+				GroupLevel:    &level,
+				Localizations: map[string]SubscriptionLocalizationConfig{"en-US": {Name: "Premium", Description: "Updated"}},
+				Prices:        map[string]string{"GBR": "3.99", "USA": "5.99", "DEU": "4.9"},
+			}},
+		},
+	}}
+	state := &applyState{SubscriptionGroups: map[string]*subscriptionGroupState{
+		"Premium": {ID: "grp-1", Subscriptions: map[string]*subscriptionState{
+			"com.example.premium": {
+				ID:         "sub-1",
+				Attributes: asc.SubscriptionAttributes{Name: "Premium Monthly", ProductID: "com.example.premium", SubscriptionPeriod: "ONE_MONTH", GroupLevel: 2},
+				Localizations: map[string]asc.Resource[asc.SubscriptionLocalizationAttributes]{
+					"en-US": {ID: "sub-loc-1", Attributes: asc.SubscriptionLocalizationAttributes{Name: "Premium", Locale: "en-US", Description: "Old"}},
+				},
+				Prices: map[string]string{"USA": "4.99", "DEU": "4.90"},
+			},
+		}},
+	}}
+
+	changes, err := planApply("123", config, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := make([]string, 0, len(changes))
+	for _, change := range changes {
+		got = append(got, change.Resource+":"+change.Action+":"+change.Target+":"+strings.Join(change.Fields, ","))
+	}
+	want := []string{
+		"subscriptionGroup:create:New:referenceName",
+		"subscription:create:com.example.new.monthly:referenceName,subscriptionPeriod",
+		"subscriptionLocalization:create:com.example.new.monthly/en-US:name",
+		"subscriptionPrice:create:com.example.new.monthly/USA:price",
+		"subscription:update:com.example.premium:groupLevel",
+		"subscriptionLocalization:update:com.example.premium/en-US:description",
+		"subscriptionPrice:create:com.example.premium/GBR:price",
+		"subscriptionPrice:update:com.example.premium/USA:price",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected changes:\n got %v\nwant %v", got, want)
+	}
+
+	config.SubscriptionGroups = []SubscriptionGroupConfig{{ReferenceName: "Other", Subscriptions: []SubscriptionConfig{{ProductID: "com.example.premium"}}}}
+	if _, err := planApply("123", config, state); err == nil || !strings.Contains(err.Error(), `belongs to group "Premium"`) {
+		t.Fatalf("expected group mismatch error, got %v", err)
+	}
+
+	config.SubscriptionGroups = []SubscriptionGroupConfig{{ReferenceName: "Premium", Subscriptions: []SubscriptionConfig{{ProductID: "com.example.other"}}}}
+	if _, err := planApply("123", config, state); err == nil || !strings.Contains(err.Error(), "referenceName and subscriptionPeriod are required") {
+		t.Fatalf("expected create requirements error, got %v", err)
+	}
+}
+
+func TestAddCurrentSubscriptionPrices(t *testing.T) {
+	price := func(id, start, pricePoint, territory string) asc.Resource[asc.SubscriptionPriceAttributes] {
+		return asc.Resource[asc.SubscriptionPriceAttributes]{
+			ID:            id,
+			Attributes:    asc.SubscriptionPriceAttributes{StartDate: start},
+			Relationships: []byte(`{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"` + pricePoint + `"}},"territory":{"data":{"type":"territories","id":"` + territory + `"}}}`),
+		}
+	}
+	resp := &asc.SubscriptionPricesResponse{
+		Data: []asc.Resource[asc.SubscriptionPriceAttributes]{
+			price("p-1", "2024-06-01", "pp-new", "USA"),
+			price("p-2", "", "pp-old", "USA"),
+			price("p-3", "2099-01-01", "pp-future", "USA"),
+			price("p-4", "", "pp-gbr", "GBR"),
+		},
+		Included: []byte(`[{"type":"subscriptionPricePoints","id":"pp-new","attributes":{"customerPrice":"5.99"}},` +
+			`{"type":"subscriptionPricePoints","id":"pp-old","attributes":{"customerPrice":"4.99"}},` +
+			`{"type":"subscriptionPricePoints","id":"pp-future","attributes":{"customerPrice":"6.99"}},` +
+			`{"type":"subscriptionPricePoints","id":"pp-gbr","attributes":{"customerPrice":"3.99"}}]`),
+	}
+
+	prices := map[string]string{}
+	addCurrentSubscriptionPrices(resp, "2025-01-01", prices, map[string]string{})
+	if !reflect.DeepEqual(prices, map[string]string{"USA": "5.99", "GBR": "3.99"}) {
+		t.Fatalf("unexpected prices: %v", prices)
+	}
+}
+
+func TestCurrentIAPManualPrice(t *testing.T) {
+	resp := &asc.InAppPurchasePricesResponse{
+		Data: []asc.Resource[asc.InAppPurchasePriceAttributes]{
+			{ID: "old", Attributes: asc.InAppPurchasePriceAttributes{StartDate: "2024-01-01", EndDate: "2025-01-01"}, Relationships: []byte(`{"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"pp-old"}},"territory":{"data":{"type":"territories","id":"USA"}}}`)},
+			{ID: "current", Attributes: asc.InAppPurchasePriceAttributes{StartDate: "2025-01-01"}, Relationships: []byte(`{"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"pp-current"}},"territory":{"data":{"type":"territories","id":"USA"}}}`)},
+			{ID: "other", Attributes: asc.InAppPurchasePriceAttributes{StartDate: "2025-06-01"}, Relationships: []byte(`{"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"pp-gbr"}},"territory":{"data":{"type":"territories","id":"GBR"}}}`)},
+			{ID: "future", Attributes: asc.InAppPurchasePriceAttributes{StartDate: "2099-01-01"}, Relationships: []byte(`{"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"pp-future"}},"territory":{"data":{"type":"territories","id":"USA"}}}`)},
+		},
+		Included: []byte(`[` +
+			`{"type":"inAppPurchasePricePoints","id":"pp-old","attributes":{"customerPrice":"0.99"}},` +
+			`{"type":"inAppPurchasePricePoints","id":"pp-current","attributes":{"customerPrice":"1.99"}},` +
+			`{"type":"inAppPurchasePricePoints","id":"pp-gbr","attributes":{"customerPrice":"1.49"}},` +
+			`{"type":"territories","id":"USA","attributes":{"currency":"USD"}}]`),
+	}
+	if got := currentIAPManualPrice(resp, "USA", "2026-06-01"); got != "1.99" {
+		t.Fatalf("expected 1.99, got %q", got)
+	}
+
+	manual := iapManualPrices(resp, "2026-06-01")
+	got := make([]string, 0, len(manual))
+	for _, price := range manual {
+		got = append(got, price.Territory+":"+price.Price.PricePointID+":"+price.Price.StartDate)
+	}
+	want := []string{"USA:pp-current:", "GBR:pp-gbr:", "USA:pp-future:2099-01-01"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected manual prices:\n got %v\nwant %v", got, want)
+	}
+}
+
+func TestCurrentManualPricePoint(t *testing.T) {
+	resp := &asc.AppPricesResponse{Data: []asc.Resource[asc.AppPriceAttributes]{
+		{ID: "old", Attributes: asc.AppPriceAttributes{StartDate: "2024-01-01", EndDate: "2025-01-01"}, Relationships: []byte(`{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-old"}},"territory":{"data":{"type":"territories","id":"USA"}}}`)},
+		{ID: "current", Attributes: asc.AppPriceAttributes{StartDate: "2025-01-01"}, Relationships: []byte(`{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-current"}},"territory":{"data":{"type":"territories","id":"USA"}}}`)},
+		{ID: "other", Attributes: asc.AppPriceAttributes{StartDate: "2025-06-01"}, Relationships: []byte(`{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-gbr"}},"territory":{"data":{"type":"territories","id":"GBR"}}}`)},
+		{ID: "future", Attributes: asc.AppPriceAttributes{StartDate: "2099-01-01"}, Relationships: []byte(`{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-future"}},"territory":{"data":{"type":"territories","id":"USA"}}}`)},
+	}}
+	if got := currentManualPricePoint(resp, "USA", "2026-06-01"); got != "pp-current" {
+		t.Fatalf("expected pp-current, got %q", got)
+	}
+	if got := currentManualPricePoint(resp, "GBR", "2026-06-01"); got != "pp-gbr" {
+		t.Fatalf("expected pp-gbr, got %q", got)
+	}
+
+	manual := appManualPrices(resp, "2026-06-01")
+	got := make([]string, 0, len(manual))
+	for _, price := range manual {
+		got = append(got, price.Territory+":"+price.Price.PricePointID+":"+price.Price.StartDate)
+	}
+	want := []string{"USA:pp-current:", "GBR:pp-gbr:", "USA:pp-future:2099-01-01"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected manual prices:\n got %v\nwant %v", got, want)
+	}
+}
+
+func TestRunPlanSkipsAfterFailure(t *testing.T) {
+	calls := 0
+	changes := []plannedChange{
+		{run: func(_ context.Context, _ *asc.Client) error { calls++; return nil }},
+		{run: func(_ context.Context, _ *asc.Client) error { calls++; return errors.New("boom") }},
+		{run: func(_ context.Context, _ *asc.Client) error { calls++; return nil }},
+	}
+	if failed := runPlan(context.Background(), nil, changes); failed != 1 {
+		t.Fatalf("expected 1 failure, got %d", failed)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+	statuses := []string{changes[0].Status, changes[1].Status, changes[2].Status}
+	if !reflect.DeepEqual(statuses, []string{applyStatusApplied, applyStatusFailed, applyStatusSkipped}) {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+	if changes[1].Error != "boom" {
+		t.Fatalf("expected error recorded, got %q", changes[1].Error)
+	}
+}
+
+func TestPlanApplyBetaGroups(t *testing.T) {
+	enabled := true
+	limit := 100
+	config := &AppConfig{BetaGroups: []BetaGroupConfig{
+		{Name: "New Group", PublicLinkEnabled: &enabled},
+		{Name: "Existing", PublicLinkEnabled: &enabled, PublicLinkLimit: &limit},
+		{Name: "Unchanged", FeedbackEnabled: &enabled},
+	}}
+	state := &applyState{BetaGroups: map[string]asc.Resource[asc.BetaGroupAttributes]{
+		"Existing":  {ID: "group-1", Attributes: asc.BetaGroupAttributes{Name: "Existing", PublicLinkEnabled: true}},
+		"Unchanged": {ID: "group-2", Attributes: asc.BetaGroupAttributes{Name: "Unchanged", FeedbackEnabled: true}},
+	}}
+
+	changes, err := planApply("123", config, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := make([]string, 0, len(changes))
+	for _, change := range changes {
+		got = append(got, change.Action+":"+change.Target+":"+strings.Join(change.Fields, ","))
+	}
+	want := []string{
+		"create:New Group:name,publicLinkEnabled",
+		"update:Existing:publicLinkLimit",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected changes:\n got %v\nwant %v", got, want)
+	}
+}
+
+func TestReadAppConfigBetaGroupErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "missing name", content: "betaGroups:\n  - feedbackEnabled: true\n", wantErr: "betaGroups[0]: name is required"},
+		{name: "duplicate", content: "betaGroups:\n  - name: a\n  - name: \" a \"\n", wantErr: `duplicate name "a"`},
+		{name: "negative limit", content: "betaGroups:\n  - name: a\n    publicLinkLimit: -1\n", wantErr: "publicLinkLimit must be 0 or greater"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := readAppConfig(writeConfig(t, test.content))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestStateToAppConfigRoundTrip(t *testing.T) {
+	state := &applyState{
+		InfoLocalizations: map[string]asc.Resource[asc.AppInfoLocalizationAttributes]{
+			"en-US": {ID: "loc-en", Attributes: asc.AppInfoLocalizationAttributes{Locale: "en-US", Name: "My App"}},
+		},
+		VersionLocalizations: map[string]asc.Resource[asc.AppStoreVersionLocalizationAttributes]{
+			"en-US": {ID: "vloc-en", Attributes: asc.AppStoreVersionLocalizationAttributes{Locale: "en-US", Description: "Desc"}},
+		},
+		Pricing: &pricingState{BaseTerritory: "USA", PricePoint: "pp-1"},
+		Availability: &availabilityState{
+			AvailableInNewTerritories: true,
+			Territories: map[string]territoryState{
+				"USA": {ID: "ta-usa", Available: true},
+				"DEU": {ID: "ta-deu", Available: false},
+				"GBR": {ID: "ta-gbr", Available: true},
+			},
+		},
+		InAppPurchases: map[string]*iapState{
+			"com.example.coins": {
+				ID:         "iap-1",
+				Attributes: asc.InAppPurchaseV2Attributes{Name: "Coins", ProductID: "com.example.coins", InAppPurchaseType: "CONSUMABLE"},
+				Localizations: map[string]asc.Resource[asc.InAppPurchaseLocalizationAttributes]{
+					"en-US": {ID: "iap-loc", Attributes: asc.InAppPurchaseLocalizationAttributes{Locale: "en-US", Name: "Coins"}},
+				},
+				Pricing: &iapPricingState{BaseTerritory: "USA", Price: "0.99"},
+			},
+		},
+		SubscriptionGroups: map[string]*subscriptionGroupState{
+			"Premium": {ID: "grp-1", Subscriptions: map[string]*subscriptionState{
+				"com.example.premium": {
+					ID:         "sub-1",
+					Attributes: asc.SubscriptionAttributes{Name: "Premium Monthly", ProductID: "com.example.premium", SubscriptionPeriod: "ONE_MONTH", GroupLevel: 1},
+					Localizations: map[string]asc.Resource[asc.SubscriptionLocalizationAttributes]{
+						"en-US": {ID: "sub-loc", Attributes: asc.SubscriptionLocalizationAttributes{Locale: "en-US", Name: "Premium"}},
+					},
+					Prices: map[string]string{"USA": "4.99", "GBR": "3.99"},
+				},
+			}},
+		},
+		BetaGroups: map[string]asc.Resource[asc.BetaGroupAttributes]{
+			"Internal": {ID: "group-1", Attributes: asc.BetaGroupAttributes{Name: "Internal", IsInternalGroup: true}},
+			"Public":   {ID: "group-2", Attributes: asc.BetaGroupAttributes{Name: "Public", PublicLinkEnabled: true, PublicLinkLimitEnabled: true, PublicLinkLimit: 50}},
+		},
+	}
+
+	config := stateToAppConfig("123", "1.0", "IOS", state)
+	if !reflect.DeepEqual(config.Availability.Territories, []string{"GBR", "USA"}) {
+		t.Fatalf("unexpected territories: %v", config.Availability.Territories)
+	}
+	if config.BetaGroups[0].PublicLinkEnabled != nil || *config.BetaGroups[1].PublicLinkLimit != 50 {
+		t.Fatalf("unexpected beta groups: %+v", config.BetaGroups)
+	}
+
+	for _, format := range []string{"yaml", "json"} {
+		t.Run(format, func(t *testing.T) {
+			data, err := marshalAppConfig(config, format)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			readBack, err := readAppConfig(writeConfig(t, string(data)))
+			if err != nil {
+				t.Fatalf("read back: %v\n%s", err, data)
+			}
+			if !reflect.DeepEqual(readBack, config) {
+				t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", readBack, config)
+			}
+
+			// Applying an unmodified export against the same state is a no-op.
+			changes, err := planApply("123", readBack, state)
+			if err != nil {
+				t.Fatalf("plan: %v", err)
+			}
+			if len(changes) != 0 {
+				t.Fatalf("expected no changes, got %+v", changes)
+			}
+		})
+	}
+}
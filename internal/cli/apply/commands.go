@@ -0,0 +1,8 @@
+package apply
+
+import "github.com/peterbourgon/ff/v3/ffcli"
+
+// Command returns the apply command.
+func Command() *ffcli.Command {
+	return ApplyCommand()
+}
@@ -0,0 +1,376 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+type exportStateSummary struct {
+	File           string `json:"file"`
+	AppID          string `json:"appId"`
+	Format         string `json:"format"`
+	Version        string `json:"version,omitempty"`
+	InfoLocales    int    `json:"infoLocales"`
+	VersionLocales int    `json:"versionLocales"`
+	InAppPurchases int    `json:"inAppPurchases"`
+	BetaGroups     int    `json:"betaGroups"`
+	Pricing        bool   `json:"pricing"`
+	Availability   bool   `json:"availability"`
+}
+
+// ExportStateCommand returns the export-state command.
+func ExportStateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export-state", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	output := fs.String("output", "", "Output file path (required)")
+	format := fs.String("format", "yaml", "File format: yaml (default), json")
+	version := fs.String("version", "", "App Store version string to export (default: latest for --platform)")
+	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "export-state",
+		ShortUsage: "asc export-state --app APP_ID --output app.yaml [flags]",
+		ShortHelp:  "Export an app's current state as an asc apply config.",
+		LongHelp: `Export an app's current state as an asc apply config.
+
+Writes app info localizations, the selected App Store version and its
+localizations, pricing, availability, in-app purchases with their prices, and
+TestFlight beta groups to a file that asc apply can read back. Commit the file to adopt a
+GitOps workflow, or keep it as a backup to restore with asc apply.
+
+Sections with no data (for example, an app without manual pricing) are
+omitted so that applying the file leaves them untouched.
+
+Examples:
+  asc export-state --app "APP_ID" --output app.yaml
+  asc export-state --app "APP_ID" --output app.json --format json
+  asc export-state --app "APP_ID" --output app.yaml --version 1.2.0 --platform MAC_OS`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			outputPath := strings.TrimSpace(*output)
+			if outputPath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --output is required")
+				return flag.ErrHelp
+			}
+
+			formatValue := strings.ToLower(strings.TrimSpace(*format))
+			if formatValue != "yaml" && formatValue != "json" {
+				fmt.Fprintln(os.Stderr, "Error: --format must be yaml or json")
+				return flag.ErrHelp
+			}
+
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("export-state: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			config, err := exportAppConfig(requestCtx, client, resolvedAppID, strings.TrimSpace(*version), normalizedPlatform)
+			if err != nil {
+				return fmt.Errorf("export-state: %w", err)
+			}
+
+			data, err := marshalAppConfig(config, formatValue)
+			if err != nil {
+				return fmt.Errorf("export-state: %w", err)
+			}
+			if err := fileutil.WriteFileAtomic(outputPath, data, 0o755); err != nil {
+				return fmt.Errorf("export-state: %w", err)
+			}
+
+			summary := exportStateSummary{
+				File:           filepath.Clean(outputPath),
+				AppID:          resolvedAppID,
+				Format:         formatValue,
+				InAppPurchases: len(config.InAppPurchases),
+				BetaGroups:     len(config.BetaGroups),
+				Pricing:        config.Pricing != nil,
+				Availability:   config.Availability != nil,
+			}
+			if config.Info != nil {
+				summary.InfoLocales = len(config.Info.Localizations)
+			}
+			if config.Version != nil {
+				summary.Version = config.Version.Version
+				summary.VersionLocales = len(config.Version.Localizations)
+			}
+
+			if *pretty {
+				return asc.PrintPrettyJSON(summary)
+			}
+			return asc.PrintJSON(summary)
+		},
+	}
+}
+
+// exportAppConfig reads the current app state and converts it to an AppConfig.
+func exportAppConfig(ctx context.Context, client *asc.Client, appID, version, platform string) (*AppConfig, error) {
+	state := &applyState{}
+
+	appInfoID, infoLocalizations, err := fetchInfoLocalizations(ctx, client, appID, "")
+	if err != nil {
+		return nil, err
+	}
+	state.AppInfoID = appInfoID
+	state.InfoLocalizations = infoLocalizations
+
+	versionID, versionString, err := resolveExportVersion(ctx, client, appID, version, platform)
+	if err != nil {
+		return nil, err
+	}
+	if versionID != "" {
+		localizations, err := fetchVersionLocalizations(ctx, client, versionID)
+		if err != nil {
+			return nil, err
+		}
+		state.VersionID = versionID
+		state.VersionLocalizations = localizations
+	}
+
+	if state.Pricing, err = fetchPricingState(ctx, client, appID); err != nil {
+		return nil, err
+	}
+	if state.Availability, err = fetchAvailabilityState(ctx, client, appID); err != nil {
+		return nil, err
+	}
+	if state.InAppPurchases, err = fetchIAPState(ctx, client, appID, nil); err != nil {
+		return nil, err
+	}
+	if state.BetaGroups, err = fetchBetaGroupState(ctx, client, appID); err != nil {
+		return nil, err
+	}
+
+	return stateToAppConfig(appID, versionString, platform, state), nil
+}
+
+// resolveExportVersion returns the version to export. Without an explicit
+// version string, the most recently created version for the platform is used.
+// An app with no versions yet returns empty values.
+func resolveExportVersion(ctx context.Context, client *asc.Client, appID, version, platform string) (string, string, error) {
+	if version != "" {
+		versionID, err := shared.ResolveAppStoreVersionID(ctx, client, appID, version, platform)
+		if err != nil {
+			return "", "", err
+		}
+		return versionID, version, nil
+	}
+
+	resp, err := client.GetAppStoreVersions(ctx, appID,
+		asc.WithAppStoreVersionsPlatforms([]string{platform}),
+		asc.WithAppStoreVersionsLimit(200),
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to fetch app store versions: %w", err)
+	}
+	var latest *asc.Resource[asc.AppStoreVersionAttributes]
+	for i := range resp.Data {
+		item := &resp.Data[i]
+		if latest == nil || item.Attributes.CreatedDate > latest.Attributes.CreatedDate {
+			latest = item
+		}
+	}
+	if latest == nil {
+		return "", "", nil
+	}
+	return latest.ID, latest.Attributes.VersionString, nil
+}
+
+// stateToAppConfig converts fetched state into the schema read by asc apply.
+func stateToAppConfig(appID, version, platform string, state *applyState) *AppConfig {
+	config := &AppConfig{App: appID}
+
+	if len(state.InfoLocalizations) > 0 {
+		config.Info = &InfoConfig{Localizations: make(map[string]InfoLocalizationConfig, len(state.InfoLocalizations))}
+		for locale, item := range state.InfoLocalizations {
+			attrs := item.Attributes
+			config.Info.Localizations[locale] = InfoLocalizationConfig{
+				Name:              attrs.Name,
+				Subtitle:          attrs.Subtitle,
+				PrivacyPolicyURL:  attrs.PrivacyPolicyURL,
+				PrivacyChoicesURL: attrs.PrivacyChoicesURL,
+				PrivacyPolicyText: attrs.PrivacyPolicyText,
+			}
+		}
+	}
+
+	if len(state.VersionLocalizations) > 0 && version != "" {
+		config.Version = &VersionConfig{
+			Version:       version,
+			Platform:      platform,
+			Localizations: make(map[string]VersionLocalizationConfig, len(state.VersionLocalizations)),
+		}
+		for locale, item := range state.VersionLocalizations {
+			attrs := item.Attributes
+			config.Version.Localizations[locale] = VersionLocalizationConfig{
+				Description:     attrs.Description,
+				Keywords:        attrs.Keywords,
+				WhatsNew:        attrs.WhatsNew,
+				PromotionalText: attrs.PromotionalText,
+				SupportURL:      attrs.SupportURL,
+				MarketingURL:    attrs.MarketingURL,
+			}
+		}
+	}
+
+	if state.Pricing != nil && state.Pricing.PricePoint != "" {
+		config.Pricing = &PricingConfig{
+			BaseTerritory: state.Pricing.BaseTerritory,
+			PricePoint:    state.Pricing.PricePoint,
+		}
+	}
+
+	if state.Availability != nil {
+		availableInNew := state.Availability.AvailableInNewTerritories
+		territories := make([]string, 0, len(state.Availability.Territories))
+		for _, territory := range sortedKeys(state.Availability.Territories) {
+			if state.Availability.Territories[territory].Available {
+				territories = append(territories, territory)
+			}
+		}
+		config.Availability = &AvailabilityConfig{
+			AvailableInNewTerritories: &availableInNew,
+			Territories:               territories,
+		}
+	}
+
+	for _, productID := range sortedKeys(state.InAppPurchases) {
+		iap := state.InAppPurchases[productID]
+		familySharable := iap.Attributes.FamilySharable
+		item := InAppPurchaseConfig{
+			ProductID:      productID,
+			ReferenceName:  iap.Attributes.Name,
+			Type:           iap.Attributes.InAppPurchaseType,
+			ReviewNote:     iap.Attributes.ReviewNote,
+			FamilySharable: &familySharable,
+		}
+		if len(iap.Localizations) > 0 {
+			item.Localizations = make(map[string]InAppPurchaseLocalizationConfig, len(iap.Localizations))
+			for locale, loc := range iap.Localizations {
+				item.Localizations[locale] = InAppPurchaseLocalizationConfig{
+					Name:        loc.Attributes.Name,
+					Description: loc.Attributes.Description,
+				}
+			}
+		}
+		if iap.Pricing != nil && iap.Pricing.Price != "" {
+			item.Pricing = &InAppPurchasePricingConfig{
+				BaseTerritory: iap.Pricing.BaseTerritory,
+				Price:         iap.Pricing.Price,
+			}
+		}
+		config.InAppPurchases = append(config.InAppPurchases, item)
+	}
+
+	for _, name := range sortedKeys(state.SubscriptionGroups) {
+		group := state.SubscriptionGroups[name]
+		item := SubscriptionGroupConfig{ReferenceName: name}
+		for _, productID := range sortedKeys(group.Subscriptions) {
+			sub := group.Subscriptions[productID]
+			familySharable := sub.Attributes.FamilySharable
+			subConfig := SubscriptionConfig{
+				ProductID:          productID,
+				ReferenceName:      sub.Attributes.Name,
+				SubscriptionPeriod: sub.Attributes.SubscriptionPeriod,
+				ReviewNote:         sub.Attributes.ReviewNote,
+				FamilySharable:     &familySharable,
+			}
+			if sub.Attributes.GroupLevel > 0 {
+				groupLevel := sub.Attributes.GroupLevel
+				subConfig.GroupLevel = &groupLevel
+			}
+			if len(sub.Localizations) > 0 {
+				subConfig.Localizations = make(map[string]SubscriptionLocalizationConfig, len(sub.Localizations))
+				for locale, loc := range sub.Localizations {
+					subConfig.Localizations[locale] = SubscriptionLocalizationConfig{
+						Name:        loc.Attributes.Name,
+						Description: loc.Attributes.Description,
+					}
+				}
+			}
+			if len(sub.Prices) > 0 {
+				subConfig.Prices = make(map[string]string, len(sub.Prices))
+				for territory, price := range sub.Prices {
+					subConfig.Prices[territory] = price
+				}
+			}
+			item.Subscriptions = append(item.Subscriptions, subConfig)
+		}
+		config.SubscriptionGroups = append(config.SubscriptionGroups, item)
+	}
+
+	for _, name := range sortedKeys(state.BetaGroups) {
+		attrs := state.BetaGroups[name].Attributes
+		isInternal := attrs.IsInternalGroup
+		group := BetaGroupConfig{Name: name, IsInternalGroup: &isInternal}
+		if !isInternal {
+			publicLinkEnabled := attrs.PublicLinkEnabled
+			feedbackEnabled := attrs.FeedbackEnabled
+			publicLinkLimit := 0
+			if attrs.PublicLinkLimitEnabled {
+				publicLinkLimit = attrs.PublicLinkLimit
+			}
+			group.PublicLinkEnabled = &publicLinkEnabled
+			group.PublicLinkLimit = &publicLinkLimit
+			group.FeedbackEnabled = &feedbackEnabled
+		}
+		config.BetaGroups = append(config.BetaGroups, group)
+	}
+
+	return config
+}
+
+// marshalAppConfig encodes config as YAML or JSON. JSON keys follow the YAML
+// field names so both formats are readable by asc apply.
+func marshalAppConfig(config *AppConfig, format string) ([]byte, error) {
+	var buf strings.Builder
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(config); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	if format != "json" {
+		return []byte(buf.String()), nil
+	}
+
+	var generic any
+	if err := yaml.Unmarshal([]byte(buf.String()), &generic); err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(generic, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
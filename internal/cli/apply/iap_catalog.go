@@ -0,0 +1,210 @@
+package apply
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+type iapExportSummary struct {
+	File               string `json:"file"`
+	AppID              string `json:"appId"`
+	Format             string `json:"format"`
+	InAppPurchases     int    `json:"inAppPurchases"`
+	SubscriptionGroups int    `json:"subscriptionGroups"`
+	Subscriptions      int    `json:"subscriptions"`
+	Localizations      int    `json:"localizations"`
+	Priced             int    `json:"priced"`
+}
+
+// IAPExportCommand returns the iap export subcommand.
+func IAPExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("iap export", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	out := fs.String("out", "", "Catalog file to write (required)")
+	format := fs.String("format", "yaml", "File format: yaml (default), json")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc iap export --app APP_ID --out catalog.yaml [flags]",
+		ShortHelp:  "Export an app's in-app purchase and subscription catalog as an asc apply config.",
+		LongHelp: `Export an app's in-app purchase and subscription catalog as an asc apply config.
+
+Writes every in-app purchase with its localizations and base price, and every
+subscription group with its subscriptions, their localizations and their
+prices in each territory, to a file that asc iap import (or asc apply) reads
+back. Prices are written as customer prices rather than price point IDs, so
+the catalog can provision another app.
+
+Examples:
+  asc iap export --app "APP_ID" --out catalog.yaml
+  asc iap export --app "APP_ID" --out catalog.json --format json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			outPath := strings.TrimSpace(*out)
+			if outPath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --out is required")
+				return flag.ErrHelp
+			}
+
+			formatValue := strings.ToLower(strings.TrimSpace(*format))
+			if formatValue != "yaml" && formatValue != "json" {
+				fmt.Fprintln(os.Stderr, "Error: --format must be yaml or json")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("iap export: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			iaps, err := fetchIAPState(requestCtx, client, resolvedAppID, nil)
+			if err != nil {
+				return fmt.Errorf("iap export: %w", err)
+			}
+			subscriptionGroups, err := fetchSubscriptionState(requestCtx, client, resolvedAppID, nil)
+			if err != nil {
+				return fmt.Errorf("iap export: %w", err)
+			}
+			config := stateToAppConfig(resolvedAppID, "", "", &applyState{InAppPurchases: iaps, SubscriptionGroups: subscriptionGroups})
+
+			data, err := marshalAppConfig(config, formatValue)
+			if err != nil {
+				return fmt.Errorf("iap export: %w", err)
+			}
+			if err := fileutil.WriteFileAtomic(outPath, data, 0o755); err != nil {
+				return fmt.Errorf("iap export: %w", err)
+			}
+
+			summary := iapExportSummary{
+				File:               filepath.Clean(outPath),
+				AppID:              resolvedAppID,
+				Format:             formatValue,
+				InAppPurchases:     len(config.InAppPurchases),
+				SubscriptionGroups: len(config.SubscriptionGroups),
+			}
+			for _, iap := range config.InAppPurchases {
+				summary.Localizations += len(iap.Localizations)
+				if iap.Pricing != nil {
+					summary.Priced++
+				}
+			}
+			for _, group := range config.SubscriptionGroups {
+				summary.Subscriptions += len(group.Subscriptions)
+				for _, sub := range group.Subscriptions {
+					summary.Localizations += len(sub.Localizations)
+					if len(sub.Prices) > 0 {
+						summary.Priced++
+					}
+				}
+			}
+
+			if *pretty {
+				return asc.PrintPrettyJSON(summary)
+			}
+			return asc.PrintJSON(summary)
+		},
+	}
+}
+
+// IAPImportCommand returns the iap import subcommand.
+func IAPImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("iap import", flag.ExitOnError)
+
+	file := fs.String("file", "", "Path to catalog YAML or JSON (required)")
+	appID := fs.String("app", "", "App Store Connect app ID (overrides app in the file; or ASC_APP_ID)")
+	confirm := fs.Bool("confirm", false, "Apply the planned changes (default prints the plan only)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc iap import --file catalog.yaml [--app APP_ID] [--confirm]",
+		ShortHelp:  "Reconcile in-app purchases, subscriptions, localizations, and prices from a catalog file.",
+		LongHelp: `Reconcile in-app purchases, subscriptions, localizations, and prices from a catalog file.
+
+Creates missing in-app purchases, subscription groups, subscriptions, and
+localizations, updates changed fields, and sets prices so the app matches the
+catalog. Nothing is changed until you re-run with --confirm. The file uses the
+inAppPurchases and subscriptionGroups sections of the asc apply schema;
+generate one with asc iap export. Pass --app to provision the catalog into a
+different app than the one it was exported from.
+
+Changing an in-app purchase's base price replaces its price schedule. Manual
+prices in other territories are kept; scheduled changes in the base territory
+are dropped. Subscription prices are set per territory and take effect
+immediately; use asc subscriptions prices import to schedule changes or keep
+existing subscribers on their current price.
+
+Example catalog.yaml:
+  app: "123456789"
+  inAppPurchases:
+    - productId: com.example.coins
+      referenceName: "100 Coins"
+      type: CONSUMABLE
+      localizations:
+        en-US:
+          name: "100 Coins"
+          description: "A pile of coins"
+      pricing:
+        baseTerritory: USA
+        price: "0.99"
+  subscriptionGroups:
+    - referenceName: "Premium"
+      subscriptions:
+        - productId: com.example.premium.monthly
+          referenceName: "Premium Monthly"
+          subscriptionPeriod: ONE_MONTH
+          groupLevel: 1
+          localizations:
+            en-US:
+              name: "Premium"
+          prices:
+            USA: "4.99"
+            GBR: "4.99"
+
+Examples:
+  asc iap import --file catalog.yaml
+  asc iap import --file catalog.yaml --app "NEW_APP_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			path := strings.TrimSpace(*file)
+			if path == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			config, err := readAppConfig(path)
+			if err != nil {
+				return fmt.Errorf("iap import: %w", err)
+			}
+			if config.Info != nil || config.Version != nil || config.Pricing != nil || config.Availability != nil || len(config.BetaGroups) > 0 {
+				return fmt.Errorf("iap import: %s may only contain app, inAppPurchases, and subscriptionGroups; use asc apply for other sections", path)
+			}
+
+			return runApply(ctx, "iap import", path, config, *appID, *confirm, *output, *pretty)
+		},
+	}
+}
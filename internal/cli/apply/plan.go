@@ -0,0 +1,1131 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	applyActionCreate = "create"
+	applyActionUpdate = "update"
+
+	applyStatusPlanned = "planned"
+	applyStatusApplied = "applied"
+	applyStatusFailed  = "failed"
+	applyStatusSkipped = "skipped"
+)
+
+// applyState is the current App Store Connect state for the sections in a config.
+type applyState struct {
+	AppInfoID            string
+	InfoLocalizations    map[string]asc.Resource[asc.AppInfoLocalizationAttributes]
+	VersionID            string
+	VersionLocalizations map[string]asc.Resource[asc.AppStoreVersionLocalizationAttributes]
+	Pricing              *pricingState
+	Availability         *availabilityState
+	InAppPurchases       map[string]*iapState
+	SubscriptionGroups   map[string]*subscriptionGroupState
+	BetaGroups           map[string]asc.Resource[asc.BetaGroupAttributes]
+}
+
+type pricingState struct {
+	BaseTerritory string
+	PricePoint    string
+	ManualPrices  []appManualPrice
+}
+
+// appManualPrice is a manual app price together with its territory.
+type appManualPrice struct {
+	Territory string
+	Price     asc.AppPriceSchedulePrice
+}
+
+type availabilityState struct {
+	AvailableInNewTerritories bool
+	Territories               map[string]territoryState
+}
+
+type territoryState struct {
+	ID        string
+	Available bool
+}
+
+// availableTerritories returns the territories the app is currently available in, sorted.
+func (s *availabilityState) availableTerritories() []string {
+	territories := make([]string, 0, len(s.Territories))
+	for territory, state := range s.Territories {
+		if state.Available {
+			territories = append(territories, territory)
+		}
+	}
+	sort.Strings(territories)
+	return territories
+}
+
+type iapState struct {
+	ID            string
+	Attributes    asc.InAppPurchaseV2Attributes
+	Localizations map[string]asc.Resource[asc.InAppPurchaseLocalizationAttributes]
+	Pricing       *iapPricingState
+}
+
+type iapPricingState struct {
+	BaseTerritory string
+	Price         string
+	// ManualPrices are the manual prices that have not ended, in every
+	// territory. A new price schedule replaces them all.
+	ManualPrices []iapManualPrice
+}
+
+type iapManualPrice struct {
+	Territory string
+	Price     asc.InAppPurchasePriceSchedulePrice
+}
+
+// iapFetch selects the in-app purchase sub-resources fetchIAPState reads.
+type iapFetch struct {
+	Localizations bool
+	Pricing       bool
+}
+
+// iapPriceRelationships are the relationships of an in-app purchase price.
+type iapPriceRelationships struct {
+	InAppPurchasePricePoint struct {
+		Data asc.ResourceData `json:"data"`
+	} `json:"inAppPurchasePricePoint"`
+	Territory struct {
+		Data asc.ResourceData `json:"data"`
+	} `json:"territory"`
+}
+
+// plannedChange pairs a reported change with the call that makes it.
+type plannedChange struct {
+	asc.ApplyChange
+	run func(ctx context.Context, client *asc.Client) error
+}
+
+func fetchApplyState(ctx context.Context, client *asc.Client, appID string, config *AppConfig) (*applyState, error) {
+	state := &applyState{}
+
+	if config.Info != nil && len(config.Info.Localizations) > 0 {
+		appInfoID, localizations, err := fetchInfoLocalizations(ctx, client, appID, config.Info.AppInfoID)
+		if err != nil {
+			return nil, err
+		}
+		state.AppInfoID = appInfoID
+		state.InfoLocalizations = localizations
+	}
+
+	if config.Version != nil && len(config.Version.Localizations) > 0 {
+		versionID := config.Version.ID
+		if versionID == "" {
+			resolved, err := shared.ResolveAppStoreVersionID(ctx, client, appID, config.Version.Version, config.Version.Platform)
+			if err != nil {
+				return nil, err
+			}
+			versionID = resolved
+		}
+		localizations, err := fetchVersionLocalizations(ctx, client, versionID)
+		if err != nil {
+			return nil, err
+		}
+		state.VersionID = versionID
+		state.VersionLocalizations = localizations
+	}
+
+	if config.Pricing != nil {
+		pricing, err := fetchPricingState(ctx, client, appID)
+		if err != nil {
+			return nil, err
+		}
+		state.Pricing = pricing
+	}
+
+	if config.Availability != nil {
+		availability, err := fetchAvailabilityState(ctx, client, appID)
+		if err != nil {
+			return nil, err
+		}
+		state.Availability = availability
+	}
+
+	if len(config.InAppPurchases) > 0 {
+		managed := make(map[string]iapFetch, len(config.InAppPurchases))
+		for _, iap := range config.InAppPurchases {
+			managed[iap.ProductID] = iapFetch{Localizations: len(iap.Localizations) > 0, Pricing: iap.Pricing != nil}
+		}
+		iaps, err := fetchIAPState(ctx, client, appID, managed)
+		if err != nil {
+			return nil, err
+		}
+		state.InAppPurchases = iaps
+	}
+
+	if len(config.SubscriptionGroups) > 0 {
+		managed := make(map[string]iapFetch)
+		for _, group := range config.SubscriptionGroups {
+			for _, sub := range group.Subscriptions {
+				managed[sub.ProductID] = iapFetch{Localizations: len(sub.Localizations) > 0, Pricing: len(sub.Prices) > 0}
+			}
+		}
+		groups, err := fetchSubscriptionState(ctx, client, appID, managed)
+		if err != nil {
+			return nil, err
+		}
+		state.SubscriptionGroups = groups
+	}
+
+	if len(config.BetaGroups) > 0 {
+		groups, err := fetchBetaGroupState(ctx, client, appID)
+		if err != nil {
+			return nil, err
+		}
+		state.BetaGroups = groups
+	}
+
+	return state, nil
+}
+
+// fetchInfoLocalizations returns the app info ID (selecting the editable one
+// when appInfoID is empty) and its localizations keyed by locale.
+func fetchInfoLocalizations(ctx context.Context, client *asc.Client, appID, appInfoID string) (string, map[string]asc.Resource[asc.AppInfoLocalizationAttributes], error) {
+	appInfoID = strings.TrimSpace(appInfoID)
+	if appInfoID == "" {
+		appInfos, err := client.GetAppInfos(ctx, appID)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to fetch app info: %w", err)
+		}
+		appInfoID = shared.SelectBestAppInfoID(appInfos)
+		if appInfoID == "" {
+			return "", nil, fmt.Errorf("no app info found for app %q", appID)
+		}
+	}
+
+	firstPage, err := client.GetAppInfoLocalizations(ctx, appInfoID, asc.WithAppInfoLocalizationsLimit(200))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch app info localizations: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppInfoLocalizations(ctx, appInfoID, asc.WithAppInfoLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch app info localizations: %w", err)
+	}
+	resp, ok := paginated.(*asc.AppInfoLocalizationsResponse)
+	if !ok {
+		return "", nil, fmt.Errorf("unexpected app info localizations response")
+	}
+	localizations := make(map[string]asc.Resource[asc.AppInfoLocalizationAttributes], len(resp.Data))
+	for _, item := range resp.Data {
+		localizations[item.Attributes.Locale] = item
+	}
+	return appInfoID, localizations, nil
+}
+
+func fetchVersionLocalizations(ctx context.Context, client *asc.Client, versionID string) (map[string]asc.Resource[asc.AppStoreVersionLocalizationAttributes], error) {
+	firstPage, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch version localizations: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch version localizations: %w", err)
+	}
+	resp, ok := paginated.(*asc.AppStoreVersionLocalizationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected version localizations response")
+	}
+	localizations := make(map[string]asc.Resource[asc.AppStoreVersionLocalizationAttributes], len(resp.Data))
+	for _, item := range resp.Data {
+		localizations[item.Attributes.Locale] = item
+	}
+	return localizations, nil
+}
+
+func fetchPricingState(ctx context.Context, client *asc.Client, appID string) (*pricingState, error) {
+	schedule, err := client.GetAppPriceSchedule(ctx, appID)
+	if err != nil {
+		if errors.Is(err, asc.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch price schedule: %w", err)
+	}
+	baseTerritory, err := client.GetAppPriceScheduleBaseTerritory(ctx, schedule.Data.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch price schedule base territory: %w", err)
+	}
+	manualPrices, err := client.GetAppPriceScheduleManualPrices(ctx, schedule.Data.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manual prices: %w", err)
+	}
+
+	today := time.Now().UTC().Format("2006-01-02")
+	state := &pricingState{BaseTerritory: strings.ToUpper(baseTerritory.Data.ID)}
+	state.PricePoint = currentManualPricePoint(manualPrices, state.BaseTerritory, today)
+	state.ManualPrices = appManualPrices(manualPrices, today)
+	return state, nil
+}
+
+// appManualPrices returns the manual prices that have not ended on the given
+// date with their territories. Prices already in effect lose their start
+// date, since a new schedule cannot start in the past.
+func appManualPrices(resp *asc.AppPricesResponse, today string) []appManualPrice {
+	var prices []appManualPrice
+	for _, price := range resp.Data {
+		if end := price.Attributes.EndDate; end != "" && end <= today {
+			continue
+		}
+		var relationships asc.AppPriceRelationships
+		if len(price.Relationships) == 0 || json.Unmarshal(price.Relationships, &relationships) != nil {
+			continue
+		}
+		territory := ""
+		if relationships.Territory != nil {
+			territory = strings.ToUpper(relationships.Territory.Data.ID)
+		}
+		start := price.Attributes.StartDate
+		if start <= today {
+			start = ""
+		}
+		prices = append(prices, appManualPrice{
+			Territory: territory,
+			Price: asc.AppPriceSchedulePrice{
+				PricePointID: relationships.AppPricePoint.Data.ID,
+				StartDate:    start,
+				EndDate:      price.Attributes.EndDate,
+			},
+		})
+	}
+	return prices
+}
+
+// currentManualPricePoint returns the price point of the manual price in
+// effect in territory on the given date.
+func currentManualPricePoint(resp *asc.AppPricesResponse, territory, today string) string {
+	current := ""
+	currentStart := ""
+	for _, price := range resp.Data {
+		start := price.Attributes.StartDate
+		end := price.Attributes.EndDate
+		if start != "" && start > today {
+			continue
+		}
+		if end != "" && end <= today {
+			continue
+		}
+		if current != "" && start < currentStart {
+			continue
+		}
+		var relationships asc.AppPriceRelationships
+		if len(price.Relationships) == 0 || json.Unmarshal(price.Relationships, &relationships) != nil {
+			continue
+		}
+		if relationships.Territory != nil && !strings.EqualFold(relationships.Territory.Data.ID, territory) {
+			continue
+		}
+		current = relationships.AppPricePoint.Data.ID
+		currentStart = start
+	}
+	return current
+}
+
+func fetchAvailabilityState(ctx context.Context, client *asc.Client, appID string) (*availabilityState, error) {
+	availability, err := client.GetAppAvailabilityV2(ctx, appID)
+	if err != nil {
+		if shared.IsAppAvailabilityMissing(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch app availability: %w", err)
+	}
+	territories, err := shared.FetchTerritoryAvailabilities(ctx, client, availability.Data.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch territory availabilities: %w", err)
+	}
+
+	state := &availabilityState{
+		AvailableInNewTerritories: availability.Data.Attributes.AvailableInNewTerritories,
+		Territories:               make(map[string]territoryState, len(territories.Data)),
+	}
+	for _, item := range territories.Data {
+		territoryID, err := shared.TerritoryIDForAvailability(item)
+		if err != nil {
+			return nil, err
+		}
+		state.Territories[territoryID] = territoryState{ID: item.ID, Available: item.Attributes.Available}
+	}
+	return state, nil
+}
+
+// fetchIAPState returns in-app purchases keyed by product ID. When managed is
+// nil every IAP is returned with localizations and pricing; otherwise only the
+// listed products are returned, with the sub-resources the map value selects.
+func fetchIAPState(ctx context.Context, client *asc.Client, appID string, managed map[string]iapFetch) (map[string]*iapState, error) {
+	firstPage, err := client.GetInAppPurchasesV2(ctx, appID, asc.WithIAPLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch in-app purchases: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetInAppPurchasesV2(ctx, appID, asc.WithIAPNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch in-app purchases: %w", err)
+	}
+	resp, ok := paginated.(*asc.InAppPurchasesV2Response)
+	if !ok {
+		return nil, fmt.Errorf("unexpected in-app purchases response")
+	}
+
+	states := make(map[string]*iapState)
+	for _, item := range resp.Data {
+		productID := item.Attributes.ProductID
+		fetch := iapFetch{Localizations: true, Pricing: true}
+		if managed != nil {
+			include, ok := managed[productID]
+			if !ok {
+				continue
+			}
+			fetch = include
+		}
+		state := &iapState{ID: item.ID, Attributes: item.Attributes}
+		if fetch.Localizations {
+			localizations, err := client.GetInAppPurchaseLocalizations(ctx, item.ID, asc.WithIAPLocalizationsLimit(200))
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch localizations for %s: %w", productID, err)
+			}
+			state.Localizations = make(map[string]asc.Resource[asc.InAppPurchaseLocalizationAttributes], len(localizations.Data))
+			for _, loc := range localizations.Data {
+				state.Localizations[loc.Attributes.Locale] = loc
+			}
+		}
+		if fetch.Pricing {
+			pricing, err := fetchIAPPricingState(ctx, client, item.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch pricing for %s: %w", productID, err)
+			}
+			state.Pricing = pricing
+		}
+		states[productID] = state
+	}
+	return states, nil
+}
+
+// fetchIAPPricingState returns the base territory and the customer price in
+// effect there today, or nil when the in-app purchase has no price schedule.
+func fetchIAPPricingState(ctx context.Context, client *asc.Client, iapID string) (*iapPricingState, error) {
+	schedule, err := client.GetInAppPurchasePriceSchedule(ctx, iapID)
+	if err != nil {
+		if errors.Is(err, asc.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch price schedule: %w", err)
+	}
+	baseTerritory, err := client.GetInAppPurchasePriceScheduleBaseTerritory(ctx, schedule.Data.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch price schedule base territory: %w", err)
+	}
+	manualPrices, err := client.GetInAppPurchasePriceScheduleManualPrices(ctx, schedule.Data.ID,
+		asc.WithIAPPriceSchedulePricesInclude([]string{"inAppPurchasePricePoint", "territory"}),
+		asc.WithIAPPriceSchedulePricesLimit(200),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manual prices: %w", err)
+	}
+
+	today := time.Now().UTC().Format("2006-01-02")
+	state := &iapPricingState{BaseTerritory: strings.ToUpper(baseTerritory.Data.ID)}
+	state.Price = currentIAPManualPrice(manualPrices, state.BaseTerritory, today)
+	state.ManualPrices = iapManualPrices(manualPrices, today)
+	return state, nil
+}
+
+// iapManualPrices returns the manual prices that have not ended on the given
+// date with their territories. Prices already in effect lose their start
+// date, since a new schedule cannot start in the past.
+func iapManualPrices(resp *asc.InAppPurchasePricesResponse, today string) []iapManualPrice {
+	var prices []iapManualPrice
+	for _, price := range resp.Data {
+		if end := price.Attributes.EndDate; end != "" && end <= today {
+			continue
+		}
+		var relationships iapPriceRelationships
+		if len(price.Relationships) == 0 || json.Unmarshal(price.Relationships, &relationships) != nil {
+			continue
+		}
+		start := price.Attributes.StartDate
+		if start <= today {
+			start = ""
+		}
+		prices = append(prices, iapManualPrice{
+			Territory: strings.ToUpper(relationships.Territory.Data.ID),
+			Price: asc.InAppPurchasePriceSchedulePrice{
+				PricePointID: relationships.InAppPurchasePricePoint.Data.ID,
+				StartDate:    start,
+				EndDate:      price.Attributes.EndDate,
+			},
+		})
+	}
+	return prices
+}
+
+// currentIAPManualPrice returns the customer price of the manual price in
+// effect in territory on the given date, read from the included price points.
+func currentIAPManualPrice(resp *asc.InAppPurchasePricesResponse, territory, today string) string {
+	customerPrices := map[string]string{}
+	if len(resp.Included) > 0 {
+		var included []struct {
+			Type       asc.ResourceType                      `json:"type"`
+			ID         string                                `json:"id"`
+			Attributes asc.InAppPurchasePricePointAttributes `json:"attributes"`
+		}
+		if err := json.Unmarshal(resp.Included, &included); err == nil {
+			for _, item := range included {
+				if item.Type == asc.ResourceTypeInAppPurchasePricePoints {
+					customerPrices[item.ID] = item.Attributes.CustomerPrice
+				}
+			}
+		}
+	}
+
+	current := ""
+	currentStart := ""
+	for _, price := range resp.Data {
+		start := price.Attributes.StartDate
+		end := price.Attributes.EndDate
+		if start != "" && start > today {
+			continue
+		}
+		if end != "" && end <= today {
+			continue
+		}
+		if current != "" && start < currentStart {
+			continue
+		}
+		var relationships iapPriceRelationships
+		if len(price.Relationships) == 0 || json.Unmarshal(price.Relationships, &relationships) != nil {
+			continue
+		}
+		if id := relationships.Territory.Data.ID; id != "" && !strings.EqualFold(id, territory) {
+			continue
+		}
+		current = customerPrices[relationships.InAppPurchasePricePoint.Data.ID]
+		currentStart = start
+	}
+	return current
+}
+
+func fetchBetaGroupState(ctx context.Context, client *asc.Client, appID string) (map[string]asc.Resource[asc.BetaGroupAttributes], error) {
+	firstPage, err := client.GetBetaGroups(ctx, appID, asc.WithBetaGroupsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch beta groups: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetBetaGroups(ctx, appID, asc.WithBetaGroupsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch beta groups: %w", err)
+	}
+	resp, ok := paginated.(*asc.BetaGroupsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected beta groups response")
+	}
+	groups := make(map[string]asc.Resource[asc.BetaGroupAttributes], len(resp.Data))
+	for _, group := range resp.Data {
+		groups[group.Attributes.Name] = group
+	}
+	return groups, nil
+}
+
+// planApply diffs the desired config against the current state and returns
+// the changes needed to converge, in the order they must be applied.
+func planApply(appID string, config *AppConfig, state *applyState) ([]plannedChange, error) {
+	var changes []plannedChange
+
+	if config.Info != nil {
+		changes = append(changes, planInfoLocalizations(config.Info, state)...)
+	}
+	if config.Version != nil {
+		changes = append(changes, planVersionLocalizations(config.Version, state)...)
+	}
+	if config.Pricing != nil {
+		changes = append(changes, planPricing(appID, config.Pricing, state.Pricing)...)
+	}
+	if config.Availability != nil {
+		availabilityChanges, err := planAvailability(appID, config.Availability, state.Availability)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, availabilityChanges...)
+	}
+	for _, iap := range config.InAppPurchases {
+		iapChanges, err := planInAppPurchase(appID, iap, state.InAppPurchases[iap.ProductID])
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, iapChanges...)
+	}
+	for _, group := range config.SubscriptionGroups {
+		groupChanges, err := planSubscriptionGroup(appID, group, state.SubscriptionGroups)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, groupChanges...)
+	}
+
+	for _, group := range config.BetaGroups {
+		changes = append(changes, planBetaGroup(appID, group, state.BetaGroups)...)
+	}
+
+	for i := range changes {
+		changes[i].Status = applyStatusPlanned
+	}
+	return changes, nil
+}
+
+func planInfoLocalizations(info *InfoConfig, state *applyState) []plannedChange {
+	var changes []plannedChange
+	appInfoID := state.AppInfoID
+	for _, locale := range sortedKeys(info.Localizations) {
+		desired := info.Localizations[locale]
+		attrs := asc.AppInfoLocalizationAttributes{
+			Locale:            locale,
+			Name:              desired.Name,
+			Subtitle:          desired.Subtitle,
+			PrivacyPolicyURL:  desired.PrivacyPolicyURL,
+			PrivacyChoicesURL: desired.PrivacyChoicesURL,
+			PrivacyPolicyText: desired.PrivacyPolicyText,
+		}
+		existing, ok := state.InfoLocalizations[locale]
+		if !ok {
+			changes = append(changes, plannedChange{
+				ApplyChange: asc.ApplyChange{Resource: "appInfoLocalization", Action: applyActionCreate, Target: locale, Fields: nonEmptyFields(attrs)},
+				run: func(ctx context.Context, client *asc.Client) error {
+					_, err := client.CreateAppInfoLocalization(ctx, appInfoID, attrs)
+					return err
+				},
+			})
+			continue
+		}
+		fields := changedFields(existing.Attributes, attrs)
+		if len(fields) == 0 {
+			continue
+		}
+		id := existing.ID
+		changes = append(changes, plannedChange{
+			ApplyChange: asc.ApplyChange{Resource: "appInfoLocalization", Action: applyActionUpdate, Target: locale, Fields: fields},
+			run: func(ctx context.Context, client *asc.Client) error {
+				_, err := client.UpdateAppInfoLocalization(ctx, id, attrs)
+				return err
+			},
+		})
+	}
+	return changes
+}
+
+func planVersionLocalizations(version *VersionConfig, state *applyState) []plannedChange {
+	var changes []plannedChange
+	versionID := state.VersionID
+	for _, locale := range sortedKeys(version.Localizations) {
+		desired := version.Localizations[locale]
+		attrs := asc.AppStoreVersionLocalizationAttributes{
+			Locale:          locale,
+			Description:     desired.Description,
+			Keywords:        desired.Keywords,
+			WhatsNew:        desired.WhatsNew,
+			PromotionalText: desired.PromotionalText,
+			SupportURL:      desired.SupportURL,
+			MarketingURL:    desired.MarketingURL,
+		}
+		existing, ok := state.VersionLocalizations[locale]
+		if !ok {
+			changes = append(changes, plannedChange{
+				ApplyChange: asc.ApplyChange{Resource: "appStoreVersionLocalization", Action: applyActionCreate, Target: locale, Fields: nonEmptyFields(attrs)},
+				run: func(ctx context.Context, client *asc.Client) error {
+					_, err := client.CreateAppStoreVersionLocalization(ctx, versionID, attrs)
+					return err
+				},
+			})
+			continue
+		}
+		fields := changedFields(existing.Attributes, attrs)
+		if len(fields) == 0 {
+			continue
+		}
+		id := existing.ID
+		changes = append(changes, plannedChange{
+			ApplyChange: asc.ApplyChange{Resource: "appStoreVersionLocalization", Action: applyActionUpdate, Target: locale, Fields: fields},
+			run: func(ctx context.Context, client *asc.Client) error {
+				_, err := client.UpdateAppStoreVersionLocalization(ctx, id, attrs)
+				return err
+			},
+		})
+	}
+	return changes
+}
+
+func planPricing(appID string, desired *PricingConfig, current *pricingState) []plannedChange {
+	action := applyActionCreate
+	var fields []string
+	var kept []asc.AppPriceSchedulePrice
+	if current != nil {
+		if current.BaseTerritory != desired.BaseTerritory {
+			fields = append(fields, "baseTerritory")
+		}
+		if current.PricePoint != desired.PricePoint {
+			fields = append(fields, "pricePoint")
+		}
+		if len(fields) == 0 {
+			return nil
+		}
+		action = applyActionUpdate
+		for _, manual := range current.ManualPrices {
+			if manual.Territory != desired.BaseTerritory {
+				kept = append(kept, manual.Price)
+			}
+		}
+	} else {
+		fields = []string{"baseTerritory", "pricePoint"}
+	}
+
+	attrs := asc.AppPriceScheduleCreateAttributes{
+		PricePointID:     desired.PricePoint,
+		BaseTerritoryID:  desired.BaseTerritory,
+		AdditionalPrices: kept,
+	}
+	return []plannedChange{{
+		ApplyChange: asc.ApplyChange{Resource: "appPriceSchedule", Action: action, Target: desired.BaseTerritory, Fields: fields},
+		run: func(ctx context.Context, client *asc.Client) error {
+			attrs.StartDate = time.Now().UTC().Format("2006-01-02")
+			_, err := client.CreateAppPriceSchedule(ctx, appID, attrs)
+			return err
+		},
+	}}
+}
+
+func planAvailability(appID string, desired *AvailabilityConfig, current *availabilityState) ([]plannedChange, error) {
+	wanted := make(map[string]bool, len(desired.Territories))
+	for _, territory := range desired.Territories {
+		wanted[territory] = true
+	}
+
+	// Without territories the file only manages availableInNewTerritories;
+	// reconciling against an empty set would remove the app from sale everywhere.
+	manageTerritories := len(desired.Territories) > 0
+
+	recreate := current == nil
+	if current != nil && desired.AvailableInNewTerritories != nil && *desired.AvailableInNewTerritories != current.AvailableInNewTerritories {
+		recreate = true
+	}
+	if recreate {
+		territories := desired.Territories
+		if !manageTerritories {
+			if current == nil {
+				return nil, fmt.Errorf("availability: territories are required to create the app's availability")
+			}
+			territories = current.availableTerritories()
+		}
+		availabilities := make([]asc.TerritoryAvailabilityCreate, 0, len(territories))
+		for _, territory := range territories {
+			availabilities = append(availabilities, asc.TerritoryAvailabilityCreate{TerritoryID: territory, Available: true})
+		}
+		attrs := asc.AppAvailabilityV2CreateAttributes{
+			AvailableInNewTerritories: desired.AvailableInNewTerritories,
+			TerritoryAvailabilities:   availabilities,
+		}
+		action := applyActionCreate
+		fields := []string{"territories"}
+		if current != nil {
+			action = applyActionUpdate
+			fields = []string{"availableInNewTerritories"}
+			if manageTerritories {
+				fields = append(fields, "territories")
+			}
+		} else if desired.AvailableInNewTerritories != nil {
+			fields = append([]string{"availableInNewTerritories"}, fields...)
+		}
+		return []plannedChange{{
+			ApplyChange: asc.ApplyChange{Resource: "appAvailability", Action: action, Target: appID, Fields: fields},
+			run: func(ctx context.Context, client *asc.Client) error {
+				_, err := client.CreateAppAvailabilityV2(ctx, appID, attrs)
+				return err
+			},
+		}}, nil
+	}
+	if !manageTerritories {
+		return nil, nil
+	}
+
+	missing := make([]string, 0)
+	for _, territory := range desired.Territories {
+		if _, ok := current.Territories[territory]; !ok {
+			missing = append(missing, territory)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("availability: unknown territories: %s", strings.Join(missing, ", "))
+	}
+
+	territories := make([]string, 0, len(current.Territories))
+	for territory := range current.Territories {
+		territories = append(territories, territory)
+	}
+	sort.Strings(territories)
+
+	var changes []plannedChange
+	for _, territory := range territories {
+		existing := current.Territories[territory]
+		available := wanted[territory]
+		if existing.Available == available {
+			continue
+		}
+		id := existing.ID
+		changes = append(changes, plannedChange{
+			ApplyChange: asc.ApplyChange{Resource: "territoryAvailability", Action: applyActionUpdate, Target: territory, Fields: []string{"available"}},
+			run: func(ctx context.Context, client *asc.Client) error {
+				_, err := client.UpdateTerritoryAvailability(ctx, id, asc.TerritoryAvailabilityUpdateAttributes{Available: &available})
+				return err
+			},
+		})
+	}
+	return changes, nil
+}
+
+func planInAppPurchase(appID string, desired InAppPurchaseConfig, current *iapState) ([]plannedChange, error) {
+	var changes []plannedChange
+	iapID := new(string)
+
+	if current == nil {
+		if desired.ReferenceName == "" || desired.Type == "" {
+			return nil, fmt.Errorf("inAppPurchases[%s]: referenceName and type are required to create an in-app purchase", desired.ProductID)
+		}
+		attrs := asc.InAppPurchaseV2CreateAttributes{
+			Name:              desired.ReferenceName,
+			ProductID:         desired.ProductID,
+			InAppPurchaseType: desired.Type,
+			ReviewNote:        desired.ReviewNote,
+		}
+		fields := []string{"referenceName", "type"}
+		if desired.ReviewNote != "" {
+			fields = append(fields, "reviewNote")
+		}
+		if desired.FamilySharable != nil {
+			attrs.FamilySharable = *desired.FamilySharable
+			fields = append(fields, "familySharable")
+		}
+		changes = append(changes, plannedChange{
+			ApplyChange: asc.ApplyChange{Resource: "inAppPurchase", Action: applyActionCreate, Target: desired.ProductID, Fields: fields},
+			run: func(ctx context.Context, client *asc.Client) error {
+				resp, err := client.CreateInAppPurchaseV2(ctx, appID, attrs)
+				if err != nil {
+					return err
+				}
+				*iapID = resp.Data.ID
+				return nil
+			},
+		})
+	} else {
+		*iapID = current.ID
+		if desired.Type != "" && !strings.EqualFold(desired.Type, current.Attributes.InAppPurchaseType) {
+			return nil, fmt.Errorf("inAppPurchases[%s]: type cannot change from %s to %s", desired.ProductID, current.Attributes.InAppPurchaseType, desired.Type)
+		}
+		var attrs asc.InAppPurchaseV2UpdateAttributes
+		var fields []string
+		if desired.ReferenceName != "" && desired.ReferenceName != current.Attributes.Name {
+			attrs.Name = &desired.ReferenceName
+			fields = append(fields, "referenceName")
+		}
+		if desired.ReviewNote != "" && desired.ReviewNote != current.Attributes.ReviewNote {
+			attrs.ReviewNote = &desired.ReviewNote
+			fields = append(fields, "reviewNote")
+		}
+		if desired.FamilySharable != nil && *desired.FamilySharable != current.Attributes.FamilySharable {
+			attrs.FamilySharable = desired.FamilySharable
+			fields = append(fields, "familySharable")
+		}
+		if len(fields) > 0 {
+			id := current.ID
+			changes = append(changes, plannedChange{
+				ApplyChange: asc.ApplyChange{Resource: "inAppPurchase", Action: applyActionUpdate, Target: desired.ProductID, Fields: fields},
+				run: func(ctx context.Context, client *asc.Client) error {
+					_, err := client.UpdateInAppPurchaseV2(ctx, id, attrs)
+					return err
+				},
+			})
+		}
+	}
+
+	for _, locale := range sortedKeys(desired.Localizations) {
+		loc := desired.Localizations[locale]
+		target := desired.ProductID + "/" + locale
+		var existing *asc.Resource[asc.InAppPurchaseLocalizationAttributes]
+		if current != nil {
+			if item, ok := current.Localizations[locale]; ok {
+				existing = &item
+			}
+		}
+
+		if existing == nil {
+			if loc.Name == "" {
+				return nil, fmt.Errorf("inAppPurchases[%s]: localization %s requires name", desired.ProductID, locale)
+			}
+			attrs := asc.InAppPurchaseLocalizationCreateAttributes{Name: loc.Name, Locale: locale, Description: loc.Description}
+			fields := []string{"name"}
+			if loc.Description != "" {
+				fields = append(fields, "description")
+			}
+			changes = append(changes, plannedChange{
+				ApplyChange: asc.ApplyChange{Resource: "inAppPurchaseLocalization", Action: applyActionCreate, Target: target, Fields: fields},
+				run: func(ctx context.Context, client *asc.Client) error {
+					_, err := client.CreateInAppPurchaseLocalization(ctx, *iapID, attrs)
+					return err
+				},
+			})
+			continue
+		}
+
+		var attrs asc.InAppPurchaseLocalizationUpdateAttributes
+		var fields []string
+		if loc.Name != "" && loc.Name != existing.Attributes.Name {
+			attrs.Name = &loc.Name
+			fields = append(fields, "name")
+		}
+		if loc.Description != "" && loc.Description != existing.Attributes.Description {
+			attrs.Description = &loc.Description
+			fields = append(fields, "description")
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		id := existing.ID
+		changes = append(changes, plannedChange{
+			ApplyChange: asc.ApplyChange{Resource: "inAppPurchaseLocalization", Action: applyActionUpdate, Target: target, Fields: fields},
+			run: func(ctx context.Context, client *asc.Client) error {
+				_, err := client.UpdateInAppPurchaseLocalization(ctx, id, attrs)
+				return err
+			},
+		})
+	}
+
+	if desired.Pricing != nil {
+		changes = append(changes, planIAPPricing(desired.ProductID, desired.Pricing, current, iapID)...)
+	}
+	return changes, nil
+}
+
+// planIAPPricing sets the base price of an in-app purchase. The price point is
+// resolved when the change runs, since a new in-app purchase has no price
+// points until it is created. Creating a schedule replaces the existing one,
+// so manual prices in other territories are carried over; scheduled changes
+// in the base territory are dropped.
+func planIAPPricing(productID string, desired *InAppPurchasePricingConfig, current *iapState, iapID *string) []plannedChange {
+	action := applyActionCreate
+	fields := []string{"baseTerritory", "price"}
+	var kept []asc.InAppPurchasePriceSchedulePrice
+	if current != nil && current.Pricing != nil {
+		fields = nil
+		if current.Pricing.BaseTerritory != desired.BaseTerritory {
+			fields = append(fields, "baseTerritory")
+		}
+		if !shared.SameCustomerPrice(current.Pricing.Price, desired.Price) {
+			fields = append(fields, "price")
+		}
+		if len(fields) == 0 {
+			return nil
+		}
+		action = applyActionUpdate
+		for _, manual := range current.Pricing.ManualPrices {
+			if manual.Territory != desired.BaseTerritory {
+				kept = append(kept, manual.Price)
+			}
+		}
+	}
+
+	pricing := *desired
+	return []plannedChange{{
+		ApplyChange: asc.ApplyChange{Resource: "inAppPurchasePriceSchedule", Action: action, Target: productID, Fields: fields},
+		run: func(ctx context.Context, client *asc.Client) error {
+			pricePointID, err := findIAPPricePoint(ctx, client, *iapID, pricing.BaseTerritory, pricing.Price)
+			if err != nil {
+				return err
+			}
+			_, err = client.CreateInAppPurchasePriceSchedule(ctx, *iapID, asc.InAppPurchasePriceScheduleCreateAttributes{
+				BaseTerritoryID: pricing.BaseTerritory,
+				Prices:          append([]asc.InAppPurchasePriceSchedulePrice{{PricePointID: pricePointID}}, kept...),
+			})
+			return err
+		},
+	}}
+}
+
+// findIAPPricePoint returns the in-app purchase price point in territory whose
+// customer price equals price.
+func findIAPPricePoint(ctx context.Context, client *asc.Client, iapID, territory, price string) (string, error) {
+	firstPage, err := client.GetInAppPurchasePricePoints(ctx, iapID, asc.WithIAPPricePointsTerritory(territory), asc.WithIAPPricePointsLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch price points: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetInAppPurchasePricePoints(ctx, iapID, asc.WithIAPPricePointsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch price points: %w", err)
+	}
+	resp, ok := paginated.(*asc.InAppPurchasePricePointsResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected price points response")
+	}
+	for _, point := range resp.Data {
+		if shared.SameCustomerPrice(point.Attributes.CustomerPrice, price) {
+			return point.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no %s price point with customer price %s", territory, price)
+}
+
+func planBetaGroup(appID string, desired BetaGroupConfig, current map[string]asc.Resource[asc.BetaGroupAttributes]) []plannedChange {
+	existing, ok := current[desired.Name]
+	currentAttrs := existing.Attributes
+	attrs := asc.BetaGroupUpdateAttributes{}
+	var fields []string
+	if desired.IsInternalGroup != nil && *desired.IsInternalGroup != currentAttrs.IsInternalGroup {
+		attrs.IsInternalGroup = desired.IsInternalGroup
+		fields = append(fields, "isInternalGroup")
+	}
+	if desired.PublicLinkEnabled != nil && *desired.PublicLinkEnabled != currentAttrs.PublicLinkEnabled {
+		attrs.PublicLinkEnabled = desired.PublicLinkEnabled
+		fields = append(fields, "publicLinkEnabled")
+	}
+	if desired.PublicLinkLimit != nil {
+		currentLimit := 0
+		if currentAttrs.PublicLinkLimitEnabled {
+			currentLimit = currentAttrs.PublicLinkLimit
+		}
+		if *desired.PublicLinkLimit != currentLimit {
+			limitEnabled := *desired.PublicLinkLimit > 0
+			attrs.PublicLinkLimitEnabled = &limitEnabled
+			attrs.PublicLinkLimit = *desired.PublicLinkLimit
+			fields = append(fields, "publicLinkLimit")
+		}
+	}
+	if desired.FeedbackEnabled != nil && *desired.FeedbackEnabled != currentAttrs.FeedbackEnabled {
+		attrs.FeedbackEnabled = desired.FeedbackEnabled
+		fields = append(fields, "feedbackEnabled")
+	}
+
+	update := func(ctx context.Context, client *asc.Client, groupID string) error {
+		_, err := client.UpdateBetaGroup(ctx, groupID, asc.BetaGroupUpdateRequest{
+			Data: asc.BetaGroupUpdateData{Type: asc.ResourceTypeBetaGroups, ID: groupID, Attributes: &attrs},
+		})
+		return err
+	}
+
+	if !ok {
+		return []plannedChange{{
+			ApplyChange: asc.ApplyChange{Resource: "betaGroup", Action: applyActionCreate, Target: desired.Name, Fields: append([]string{"name"}, fields...)},
+			run: func(ctx context.Context, client *asc.Client) error {
+				resp, err := client.CreateBetaGroup(ctx, appID, desired.Name)
+				if err != nil {
+					return err
+				}
+				if len(fields) == 0 {
+					return nil
+				}
+				return update(ctx, client, resp.Data.ID)
+			},
+		}}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	groupID := existing.ID
+	return []plannedChange{{
+		ApplyChange: asc.ApplyChange{Resource: "betaGroup", Action: applyActionUpdate, Target: desired.Name, Fields: fields},
+		run: func(ctx context.Context, client *asc.Client) error {
+			return update(ctx, client, groupID)
+		},
+	}}
+}
+
+// runPlan applies changes in order. After the first failure the remaining
+// changes are skipped, since later changes may depend on earlier ones.
+func runPlan(ctx context.Context, client *asc.Client, changes []plannedChange) int {
+	failed := 0
+	for i := range changes {
+		if failed > 0 {
+			changes[i].Status = applyStatusSkipped
+			continue
+		}
+		if err := changes[i].run(ctx, client); err != nil {
+			changes[i].Status = applyStatusFailed
+			changes[i].Error = err.Error()
+			failed++
+			continue
+		}
+		changes[i].Status = applyStatusApplied
+	}
+	return failed
+}
+
+// changedFields lists the JSON fields set in desired that differ from current.
+func changedFields(current, desired any) []string {
+	currentMap := jsonFields(current)
+	var fields []string
+	for name, value := range jsonFields(desired) {
+		if name == "locale" || value == "" {
+			continue
+		}
+		if currentMap[name] != value {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func nonEmptyFields(value any) []string {
+	var fields []string
+	for name, fieldValue := range jsonFields(value) {
+		if name == "locale" || fieldValue == "" {
+			continue
+		}
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func jsonFields(value any) map[string]string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+	result := make(map[string]string, len(fields))
+	for name, fieldValue := range fields {
+		if text, ok := fieldValue.(string); ok {
+			result[name] = text
+		}
+	}
+	return result
+}
+
+func sortedKeys[T any](values map[string]T) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,397 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type subscriptionGroupState struct {
+	ID            string
+	Subscriptions map[string]*subscriptionState
+}
+
+type subscriptionState struct {
+	ID            string
+	Attributes    asc.SubscriptionAttributes
+	Localizations map[string]asc.Resource[asc.SubscriptionLocalizationAttributes]
+	// Prices maps territories to the customer price in effect today.
+	Prices map[string]string
+}
+
+// subscriptionPriceRelationships are the relationships of a subscription price.
+type subscriptionPriceRelationships struct {
+	SubscriptionPricePoint struct {
+		Data asc.ResourceData `json:"data"`
+	} `json:"subscriptionPricePoint"`
+	Territory struct {
+		Data asc.ResourceData `json:"data"`
+	} `json:"territory"`
+}
+
+// fetchSubscriptionState returns subscription groups keyed by reference name.
+// Every group and subscription is listed; when managed is nil every
+// subscription also gets localizations and prices, otherwise only the listed
+// products get the sub-resources the map value selects.
+func fetchSubscriptionState(ctx context.Context, client *asc.Client, appID string, managed map[string]iapFetch) (map[string]*subscriptionGroupState, error) {
+	firstPage, err := client.GetSubscriptionGroups(ctx, appID, asc.WithSubscriptionGroupsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch subscription groups: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSubscriptionGroups(ctx, appID, asc.WithSubscriptionGroupsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch subscription groups: %w", err)
+	}
+	resp, ok := paginated.(*asc.SubscriptionGroupsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected subscription groups response")
+	}
+
+	groups := make(map[string]*subscriptionGroupState, len(resp.Data))
+	for _, group := range resp.Data {
+		subscriptions, err := fetchGroupSubscriptions(ctx, client, group.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch subscriptions for group %s: %w", group.Attributes.ReferenceName, err)
+		}
+		state := &subscriptionGroupState{ID: group.ID, Subscriptions: make(map[string]*subscriptionState, len(subscriptions))}
+		for _, item := range subscriptions {
+			productID := item.Attributes.ProductID
+			fetch := iapFetch{Localizations: true, Pricing: true}
+			if managed != nil {
+				fetch = managed[productID]
+			}
+			sub := &subscriptionState{ID: item.ID, Attributes: item.Attributes}
+			if fetch.Localizations {
+				localizations, err := client.GetSubscriptionLocalizations(ctx, item.ID, asc.WithSubscriptionLocalizationsLimit(200))
+				if err != nil {
+					return nil, fmt.Errorf("failed to fetch localizations for %s: %w", productID, err)
+				}
+				sub.Localizations = make(map[string]asc.Resource[asc.SubscriptionLocalizationAttributes], len(localizations.Data))
+				for _, loc := range localizations.Data {
+					sub.Localizations[loc.Attributes.Locale] = loc
+				}
+			}
+			if fetch.Pricing {
+				prices, err := fetchSubscriptionPrices(ctx, client, item.ID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to fetch prices for %s: %w", productID, err)
+				}
+				sub.Prices = prices
+			}
+			state.Subscriptions[productID] = sub
+		}
+		groups[group.Attributes.ReferenceName] = state
+	}
+	return groups, nil
+}
+
+func fetchGroupSubscriptions(ctx context.Context, client *asc.Client, groupID string) ([]asc.Resource[asc.SubscriptionAttributes], error) {
+	firstPage, err := client.GetSubscriptions(ctx, groupID, asc.WithSubscriptionsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSubscriptions(ctx, groupID, asc.WithSubscriptionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := paginated.(*asc.SubscriptionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected subscriptions response")
+	}
+	return resp.Data, nil
+}
+
+// fetchSubscriptionPrices returns the customer price in effect today in each
+// territory. Pages are walked by hand because PaginateAll drops included
+// price points.
+func fetchSubscriptionPrices(ctx context.Context, client *asc.Client, subscriptionID string) (map[string]string, error) {
+	today := time.Now().UTC().Format("2006-01-02")
+	prices := map[string]string{}
+	starts := map[string]string{}
+
+	resp, err := client.GetSubscriptionPrices(ctx, subscriptionID,
+		asc.WithSubscriptionPricesInclude([]string{"subscriptionPricePoint", "territory"}),
+		asc.WithSubscriptionPricesLimit(200),
+	)
+	for {
+		if err != nil {
+			return nil, err
+		}
+		addCurrentSubscriptionPrices(resp, today, prices, starts)
+		if resp.Links.Next == "" {
+			return prices, nil
+		}
+		resp, err = client.GetSubscriptionPrices(ctx, subscriptionID, asc.WithSubscriptionPricesNextURL(resp.Links.Next))
+	}
+}
+
+// addCurrentSubscriptionPrices records, per territory, the customer price of
+// the latest price on a page that has started by today. starts holds the
+// start date of each recorded price so later pages can replace it.
+func addCurrentSubscriptionPrices(resp *asc.SubscriptionPricesResponse, today string, prices, starts map[string]string) {
+	customerPrices := map[string]string{}
+	if len(resp.Included) > 0 {
+		var included []struct {
+			Type       asc.ResourceType                     `json:"type"`
+			ID         string                               `json:"id"`
+			Attributes asc.SubscriptionPricePointAttributes `json:"attributes"`
+		}
+		if err := json.Unmarshal(resp.Included, &included); err == nil {
+			for _, item := range included {
+				if item.Type == asc.ResourceTypeSubscriptionPricePoints {
+					customerPrices[item.ID] = item.Attributes.CustomerPrice
+				}
+			}
+		}
+	}
+
+	for _, price := range resp.Data {
+		start := price.Attributes.StartDate
+		if start != "" && start > today {
+			continue
+		}
+		var relationships subscriptionPriceRelationships
+		if len(price.Relationships) == 0 || json.Unmarshal(price.Relationships, &relationships) != nil {
+			continue
+		}
+		territory := strings.ToUpper(relationships.Territory.Data.ID)
+		customerPrice, ok := customerPrices[relationships.SubscriptionPricePoint.Data.ID]
+		if territory == "" || !ok {
+			continue
+		}
+		if current, seen := starts[territory]; seen && start < current {
+			continue
+		}
+		prices[territory] = customerPrice
+		starts[territory] = start
+	}
+}
+
+// planSubscriptionGroup creates the group when it is missing and plans each
+// of its subscriptions. Subscriptions are matched by product ID across all
+// groups, since a subscription cannot move to another group.
+func planSubscriptionGroup(appID string, desired SubscriptionGroupConfig, current map[string]*subscriptionGroupState) ([]plannedChange, error) {
+	var changes []plannedChange
+	groupID := new(string)
+
+	group := current[desired.ReferenceName]
+	if group == nil {
+		attrs := asc.SubscriptionGroupCreateAttributes{ReferenceName: desired.ReferenceName}
+		changes = append(changes, plannedChange{
+			ApplyChange: asc.ApplyChange{Resource: "subscriptionGroup", Action: applyActionCreate, Target: desired.ReferenceName, Fields: []string{"referenceName"}},
+			run: func(ctx context.Context, client *asc.Client) error {
+				resp, err := client.CreateSubscriptionGroup(ctx, appID, attrs)
+				if err != nil {
+					return err
+				}
+				*groupID = resp.Data.ID
+				return nil
+			},
+		})
+	} else {
+		*groupID = group.ID
+	}
+
+	for _, sub := range desired.Subscriptions {
+		var existing *subscriptionState
+		for name, other := range current {
+			if item, ok := other.Subscriptions[sub.ProductID]; ok {
+				if name != desired.ReferenceName {
+					return nil, fmt.Errorf("subscriptionGroups[%s]: subscription %s belongs to group %q", desired.ReferenceName, sub.ProductID, name)
+				}
+				existing = item
+			}
+		}
+		subChanges, err := planSubscription(groupID, sub, existing)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, subChanges...)
+	}
+	return changes, nil
+}
+
+func planSubscription(groupID *string, desired SubscriptionConfig, current *subscriptionState) ([]plannedChange, error) {
+	var changes []plannedChange
+	subID := new(string)
+
+	if current == nil {
+		if desired.ReferenceName == "" || desired.SubscriptionPeriod == "" {
+			return nil, fmt.Errorf("subscriptions[%s]: referenceName and subscriptionPeriod are required to create a subscription", desired.ProductID)
+		}
+		attrs := asc.SubscriptionCreateAttributes{
+			Name:               desired.ReferenceName,
+			ProductID:          desired.ProductID,
+			SubscriptionPeriod: desired.SubscriptionPeriod,
+			ReviewNote:         desired.ReviewNote,
+			FamilySharable:     desired.FamilySharable,
+			GroupLevel:         desired.GroupLevel,
+		}
+		fields := []string{"referenceName", "subscriptionPeriod"}
+		if desired.GroupLevel != nil {
+			fields = append(fields, "groupLevel")
+		}
+		if desired.ReviewNote != "" {
+			fields = append(fields, "reviewNote")
+		}
+		if desired.FamilySharable != nil {
+			fields = append(fields, "familySharable")
+		}
+		changes = append(changes, plannedChange{
+			ApplyChange: asc.ApplyChange{Resource: "subscription", Action: applyActionCreate, Target: desired.ProductID, Fields: fields},
+			run: func(ctx context.Context, client *asc.Client) error {
+				resp, err := client.CreateSubscription(ctx, *groupID, attrs)
+				if err != nil {
+					return err
+				}
+				*subID = resp.Data.ID
+				return nil
+			},
+		})
+	} else {
+		*subID = current.ID
+		var attrs asc.SubscriptionUpdateAttributes
+		var fields []string
+		if desired.ReferenceName != "" && desired.ReferenceName != current.Attributes.Name {
+			attrs.Name = &desired.ReferenceName
+			fields = append(fields, "referenceName")
+		}
+		if desired.SubscriptionPeriod != "" && desired.SubscriptionPeriod != current.Attributes.SubscriptionPeriod {
+			attrs.SubscriptionPeriod = &desired.SubscriptionPeriod
+			fields = append(fields, "subscriptionPeriod")
+		}
+		if desired.GroupLevel != nil && *desired.GroupLevel != current.Attributes.GroupLevel {
+			attrs.GroupLevel = desired.GroupLevel
+			fields = append(fields, "groupLevel")
+		}
+		if desired.ReviewNote != "" && desired.ReviewNote != current.Attributes.ReviewNote {
+			attrs.ReviewNote = &desired.ReviewNote
+			fields = append(fields, "reviewNote")
+		}
+		if desired.FamilySharable != nil && *desired.FamilySharable != current.Attributes.FamilySharable {
+			attrs.FamilySharable = desired.FamilySharable
+			fields = append(fields, "familySharable")
+		}
+		if len(fields) > 0 {
+			id := current.ID
+			changes = append(changes, plannedChange{
+				ApplyChange: asc.ApplyChange{Resource: "subscription", Action: applyActionUpdate, Target: desired.ProductID, Fields: fields},
+				run: func(ctx context.Context, client *asc.Client) error {
+					_, err := client.UpdateSubscription(ctx, id, attrs)
+					return err
+				},
+			})
+		}
+	}
+
+	for _, locale := range sortedKeys(desired.Localizations) {
+		loc := desired.Localizations[locale]
+		target := desired.ProductID + "/" + locale
+		var existing *asc.Resource[asc.SubscriptionLocalizationAttributes]
+		if current != nil {
+			if item, ok := current.Localizations[locale]; ok {
+				existing = &item
+			}
+		}
+
+		if existing == nil {
+			if loc.Name == "" {
+				return nil, fmt.Errorf("subscriptions[%s]: localization %s requires name", desired.ProductID, locale)
+			}
+			attrs := asc.SubscriptionLocalizationCreateAttributes{Name: loc.Name, Locale: locale, Description: loc.Description}
+			fields := []string{"name"}
+			if loc.Description != "" {
+				fields = append(fields, "description")
+			}
+			changes = append(changes, plannedChange{
+				ApplyChange: asc.ApplyChange{Resource: "subscriptionLocalization", Action: applyActionCreate, Target: target, Fields: fields},
+				run: func(ctx context.Context, client *asc.Client) error {
+					_, err := client.CreateSubscriptionLocalization(ctx, *subID, attrs)
+					return err
+				},
+			})
+			continue
+		}
+
+		var attrs asc.SubscriptionLocalizationUpdateAttributes
+		var fields []string
+		if loc.Name != "" && loc.Name != existing.Attributes.Name {
+			attrs.Name = &loc.Name
+			fields = append(fields, "name")
+		}
+		if loc.Description != "" && loc.Description != existing.Attributes.Description {
+			attrs.Description = &loc.Description
+			fields = append(fields, "description")
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		id := existing.ID
+		changes = append(changes, plannedChange{
+			ApplyChange: asc.ApplyChange{Resource: "subscriptionLocalization", Action: applyActionUpdate, Target: target, Fields: fields},
+			run: func(ctx context.Context, client *asc.Client) error {
+				_, err := client.UpdateSubscriptionLocalization(ctx, id, attrs)
+				return err
+			},
+		})
+	}
+
+	for _, territory := range sortedKeys(desired.Prices) {
+		price := desired.Prices[territory]
+		action := applyActionCreate
+		if current != nil {
+			if currentPrice, ok := current.Prices[territory]; ok {
+				if shared.SameCustomerPrice(currentPrice, price) {
+					continue
+				}
+				action = applyActionUpdate
+			}
+		}
+		changes = append(changes, plannedChange{
+			ApplyChange: asc.ApplyChange{Resource: "subscriptionPrice", Action: action, Target: desired.ProductID + "/" + territory, Fields: []string{"price"}},
+			run: func(ctx context.Context, client *asc.Client) error {
+				pricePointID, err := findSubscriptionPricePoint(ctx, client, *subID, territory, price)
+				if err != nil {
+					return err
+				}
+				_, err = client.CreateSubscriptionPrice(ctx, *subID, pricePointID, territory, asc.SubscriptionPriceCreateAttributes{})
+				return err
+			},
+		})
+	}
+	return changes, nil
+}
+
+// findSubscriptionPricePoint returns the subscription price point in
+// territory whose customer price equals price.
+func findSubscriptionPricePoint(ctx context.Context, client *asc.Client, subscriptionID, territory, price string) (string, error) {
+	firstPage, err := client.GetSubscriptionPricePoints(ctx, subscriptionID, asc.WithSubscriptionPricePointsTerritory(territory), asc.WithSubscriptionPricePointsLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch price points: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSubscriptionPricePoints(ctx, subscriptionID, asc.WithSubscriptionPricePointsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch price points: %w", err)
+	}
+	resp, ok := paginated.(*asc.SubscriptionPricePointsResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected price points response")
+	}
+	for _, point := range resp.Data {
+		if shared.SameCustomerPrice(point.Attributes.CustomerPrice, price) {
+			return point.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no %s price point with customer price %s", territory, price)
+}
@@ -28,7 +28,8 @@ func AppInfoCommand() *ffcli.Command {
 Examples:
   asc app-info get --app "APP_ID"
   asc app-info get --app "APP_ID" --version "1.2.3" --platform IOS
-  asc app-info set --app "APP_ID" --locale "en-US" --whats-new "Bug fixes"`,
+  asc app-info set --app "APP_ID" --locale "en-US" --whats-new "Bug fixes"
+  asc app-info localizations list --app "APP_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -36,6 +37,7 @@ Examples:
 			AppInfoSetCommand(),
 			AppInfoRelationshipsCommand(),
 			AppInfoTerritoryAgeRatingsCommand(),
+			AppInfoLocalizationsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -0,0 +1,319 @@
+package apps
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// appInfoTextLimit is the App Store Connect character limit for the app name
+// and subtitle.
+const appInfoTextLimit = 30
+
+// AppInfoLocalizationsCommand returns the app-info localizations command group.
+func AppInfoLocalizationsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("app-info localizations", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "localizations",
+		ShortUsage: "asc app-info localizations <subcommand> [flags]",
+		ShortHelp:  "Manage App Info localizations (name, subtitle, privacy policy).",
+		LongHelp: `Manage App Info localizations (name, subtitle, privacy policy).
+
+Without --app-info, the app info that is editable for the next submission
+(the one in PREPARE_FOR_SUBMISSION) is used.
+
+Examples:
+  asc app-info localizations list --app "APP_ID"
+  asc app-info localizations get --app "APP_ID" --locale "en-US"
+  asc app-info localizations update --app "APP_ID" --locale "en-US" --subtitle "Edit photos fast"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			AppInfoLocalizationsListCommand(),
+			AppInfoLocalizationsGetCommand(),
+			AppInfoLocalizationsUpdateCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// AppInfoLocalizationsListCommand returns the app-info localizations list subcommand.
+func AppInfoLocalizationsListCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("app-info localizations list", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
+	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
+	next := fs.String("next", "", "Fetch next page using a links.next URL")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "list",
+		ShortUsage: "asc app-info localizations list --app <id> [flags]",
+		ShortHelp:  "List App Info localizations.",
+		LongHelp: `List App Info localizations.
+
+Examples:
+  asc app-info localizations list --app "APP_ID"
+  asc app-info localizations list --app "APP_ID" --locale "en-US,ja"
+  asc app-info localizations list --app-info "APP_INFO_ID" --paginate`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *limit != 0 && (*limit < 1 || *limit > 200) {
+				return fmt.Errorf("app-info localizations list: --limit must be between 1 and 200")
+			}
+			if err := shared.ValidateNextURL(*next); err != nil {
+				return fmt.Errorf("app-info localizations list: %w", err)
+			}
+			appInfoValue := strings.TrimSpace(*appInfoID)
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if appInfoValue == "" && resolvedAppID == "" && strings.TrimSpace(*next) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app or --app-info is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("app-info localizations list: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if strings.TrimSpace(*next) == "" {
+				appInfoValue, err = shared.ResolveEditableAppInfoID(requestCtx, client, resolvedAppID, appInfoValue)
+				if err != nil {
+					return fmt.Errorf("app-info localizations list: %w", err)
+				}
+			}
+
+			opts := []asc.AppInfoLocalizationsOption{
+				asc.WithAppInfoLocalizationsLimit(*limit),
+				asc.WithAppInfoLocalizationsNextURL(*next),
+			}
+			if locales := shared.SplitCSV(*locale); len(locales) > 0 {
+				opts = append(opts, asc.WithAppInfoLocalizationLocales(locales))
+			}
+
+			if *paginate {
+				paginateOpts := append(opts, asc.WithAppInfoLocalizationsLimit(200))
+				firstPage, err := client.GetAppInfoLocalizations(requestCtx, appInfoValue, paginateOpts...)
+				if err != nil {
+					return fmt.Errorf("app-info localizations list: failed to fetch: %w", err)
+				}
+
+				resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetAppInfoLocalizations(ctx, appInfoValue, asc.WithAppInfoLocalizationsNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("app-info localizations list: %w", err)
+				}
+				return shared.PrintOutput(resp, *output, *pretty)
+			}
+
+			resp, err := client.GetAppInfoLocalizations(requestCtx, appInfoValue, opts...)
+			if err != nil {
+				return fmt.Errorf("app-info localizations list: failed to fetch: %w", err)
+			}
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// AppInfoLocalizationsGetCommand returns the app-info localizations get subcommand.
+func AppInfoLocalizationsGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("app-info localizations get", flag.ExitOnError)
+
+	id := fs.String("id", "", "App Info localization ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	locale := fs.String("locale", "", "Locale to get (with --app or --app-info)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc app-info localizations get (--id <id> | --app <id> --locale <locale>) [flags]",
+		ShortHelp:  "Get an App Info localization.",
+		LongHelp: `Get an App Info localization by ID, or by locale.
+
+Examples:
+  asc app-info localizations get --id "LOCALIZATION_ID"
+  asc app-info localizations get --app "APP_ID" --locale "en-US"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			target, err := newAppInfoLocalizationTarget(*id, *appID, *appInfoID, *locale)
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("app-info localizations get: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			localizationID, err := target.resolve(requestCtx, client)
+			if err != nil {
+				return fmt.Errorf("app-info localizations get: %w", err)
+			}
+
+			resp, err := client.GetAppInfoLocalization(requestCtx, localizationID)
+			if err != nil {
+				return fmt.Errorf("app-info localizations get: failed to fetch: %w", err)
+			}
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// AppInfoLocalizationsUpdateCommand returns the app-info localizations update subcommand.
+func AppInfoLocalizationsUpdateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("app-info localizations update", flag.ExitOnError)
+
+	id := fs.String("id", "", "App Info localization ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	locale := fs.String("locale", "", "Locale to update (with --app or --app-info)")
+	name := fs.String("name", "", "App name (max 30 characters)")
+	subtitle := fs.String("subtitle", "", "Subtitle (max 30 characters)")
+	privacyPolicyURL := fs.String("privacy-policy-url", "", "Privacy policy URL")
+	privacyChoicesURL := fs.String("privacy-choices-url", "", "Privacy choices URL")
+	privacyPolicyText := fs.String("privacy-policy-text", "", "Privacy policy text (tvOS)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "update",
+		ShortUsage: "asc app-info localizations update (--id <id> | --app <id> --locale <locale>) [flags]",
+		ShortHelp:  "Update an App Info localization.",
+		LongHelp: `Update an App Info localization by ID, or by locale.
+
+Only the fields passed as flags are changed.
+
+Examples:
+  asc app-info localizations update --app "APP_ID" --locale "en-US" --name "Photo Studio" --subtitle "Edit photos fast"
+  asc app-info localizations update --id "LOCALIZATION_ID" --privacy-policy-url "https://example.com/privacy"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			attrs := asc.AppInfoLocalizationAttributes{
+				Name:              strings.TrimSpace(*name),
+				Subtitle:          strings.TrimSpace(*subtitle),
+				PrivacyPolicyURL:  strings.TrimSpace(*privacyPolicyURL),
+				PrivacyChoicesURL: strings.TrimSpace(*privacyChoicesURL),
+				PrivacyPolicyText: strings.TrimSpace(*privacyPolicyText),
+			}
+			if attrs == (asc.AppInfoLocalizationAttributes{}) {
+				fmt.Fprintln(os.Stderr, "Error: at least one of --name, --subtitle, --privacy-policy-url, --privacy-choices-url, or --privacy-policy-text is required")
+				return flag.ErrHelp
+			}
+			if length := utf8.RuneCountInString(attrs.Name); length > appInfoTextLimit {
+				fmt.Fprintf(os.Stderr, "Error: --name is %d characters (limit %d)\n", length, appInfoTextLimit)
+				return flag.ErrHelp
+			}
+			if length := utf8.RuneCountInString(attrs.Subtitle); length > appInfoTextLimit {
+				fmt.Fprintf(os.Stderr, "Error: --subtitle is %d characters (limit %d)\n", length, appInfoTextLimit)
+				return flag.ErrHelp
+			}
+
+			target, err := newAppInfoLocalizationTarget(*id, *appID, *appInfoID, *locale)
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("app-info localizations update: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			localizationID, err := target.resolve(requestCtx, client)
+			if err != nil {
+				return fmt.Errorf("app-info localizations update: %w", err)
+			}
+
+			resp, err := client.UpdateAppInfoLocalization(requestCtx, localizationID, attrs)
+			if err != nil {
+				return fmt.Errorf("app-info localizations update: failed to update: %w", err)
+			}
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// appInfoLocalizationTarget identifies one App Info localization, either by
+// ID or by locale within an app info.
+type appInfoLocalizationTarget struct {
+	id        string
+	appID     string
+	appInfoID string
+	locale    string
+}
+
+func newAppInfoLocalizationTarget(id, appID, appInfoID, locale string) (*appInfoLocalizationTarget, error) {
+	target := &appInfoLocalizationTarget{
+		id:        strings.TrimSpace(id),
+		appInfoID: strings.TrimSpace(appInfoID),
+		locale:    strings.TrimSpace(locale),
+	}
+	if target.id != "" {
+		if target.locale != "" {
+			fmt.Fprintln(os.Stderr, "Error: --id and --locale are mutually exclusive")
+			return nil, flag.ErrHelp
+		}
+		return target, nil
+	}
+	if target.locale == "" {
+		fmt.Fprintln(os.Stderr, "Error: --id or --locale is required")
+		return nil, flag.ErrHelp
+	}
+	target.appID = shared.ResolveAppID(appID)
+	if target.appID == "" && target.appInfoID == "" {
+		fmt.Fprintln(os.Stderr, "Error: --app or --app-info is required with --locale")
+		return nil, flag.ErrHelp
+	}
+	return target, nil
+}
+
+// resolve returns the localization ID, looking it up by locale in the
+// editable app info when no ID was given.
+func (t *appInfoLocalizationTarget) resolve(ctx context.Context, client *asc.Client) (string, error) {
+	if t.id != "" {
+		return t.id, nil
+	}
+	appInfoID, err := shared.ResolveEditableAppInfoID(ctx, client, t.appID, t.appInfoID)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.GetAppInfoLocalizations(ctx, appInfoID, asc.WithAppInfoLocalizationLocales([]string{t.locale}))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	for _, item := range resp.Data {
+		if item.Attributes.Locale == t.locale {
+			return item.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no %s localization found for app info %s", t.locale, appInfoID)
+}
@@ -316,7 +316,8 @@ func AppSetupAvailabilitySetCommand() *ffcli.Command {
 		LongHelp: `Set app availability for territories.
 
 Examples:
-  asc app-setup availability set --app "123456789" --territory "USA,GBR" --available true --available-in-new-territories true`,
+  asc app-setup availability set --app "123456789" --territory "USA,GBR" --available true --available-in-new-territories true
+  asc app-setup availability set --app "123456789" --all --except "CHN" --available=true --available-in-new-territories=true`,
 		ErrorPrefix:                      "app-setup availability set",
 		IncludeAvailableInNewTerritories: true,
 	})
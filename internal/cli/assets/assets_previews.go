@@ -7,6 +7,7 @@ import (
 	"mime"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -106,26 +107,54 @@ func AssetsPreviewsUploadCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("upload", flag.ExitOnError)
 
 	localizationID := fs.String("version-localization", "", "App Store version localization ID")
+	setID := fs.String("set", "", "Existing preview set ID (instead of --version-localization and --device-type)")
 	path := fs.String("path", "", "Path to preview file or directory")
 	deviceType := fs.String("device-type", "", "Device type (e.g., IPHONE_65)")
+	posterTime := fs.String("poster-time", "", "Poster frame time code (HH:MM:SS or HH:MM:SS:FF), set once processing completes")
+	resume := fs.Bool("resume", false, "Resume interrupted uploads from their last completed chunk")
+	concurrency := fs.Int("concurrency", 1, "Number of files to upload at once")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "upload",
-		ShortUsage: "asc assets previews upload --version-localization \"LOC_ID\" --path \"./previews\" --device-type \"IPHONE_65\"",
+		ShortUsage: "asc assets previews upload (--version-localization \"LOC_ID\" --device-type \"IPHONE_65\" | --set \"SET_ID\") --path \"./previews\"",
 		ShortHelp:  "Upload previews for a localization.",
 		LongHelp: `Upload previews for a localization.
 
+Each video is reserved, uploaded in chunks, and committed with its MD5
+checksum. The command then polls until App Store Connect has processed each
+preview. With --poster-time, the poster frame of each preview is set once
+processing completes.
+
+With --concurrency, several files upload at once; the set is then
+reordered so the new previews keep the order of --path.
+
+Progress is saved after every chunk under ~/.asc/uploads. If an upload is
+interrupted, rerun the same command with --resume to reuse the reservation
+and upload only the remaining chunks.
+
+Upload into the set for --device-type, creating it if needed, or into an
+existing set with --set.
+
 Examples:
   asc assets previews upload --version-localization "LOC_ID" --path "./previews" --device-type "IPHONE_65"
-  asc assets previews upload --version-localization "LOC_ID" --path "./previews/preview.mov" --device-type "IPHONE_65"`,
+  asc assets previews upload --version-localization "LOC_ID" --path "./previews/preview.mov" --device-type "IPHONE_65"
+  asc assets previews upload --set "SET_ID" --path "./preview.mp4" --poster-time "00:00:05"
+  asc assets previews upload --set "SET_ID" --path "./preview.mp4" --resume
+  asc assets previews upload --set "SET_ID" --path "./previews" --concurrency 3`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			locID := strings.TrimSpace(*localizationID)
-			if locID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --version-localization is required")
+			setValue := strings.TrimSpace(*setID)
+			deviceValue := strings.TrimSpace(*deviceType)
+			if setValue != "" && (locID != "" || deviceValue != "") {
+				fmt.Fprintln(os.Stderr, "Error: --set cannot be combined with --version-localization or --device-type")
+				return flag.ErrHelp
+			}
+			if setValue == "" && locID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-localization is required (or use --set)")
 				return flag.ErrHelp
 			}
 			pathValue := strings.TrimSpace(*path)
@@ -133,15 +162,27 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --path is required")
 				return flag.ErrHelp
 			}
-			deviceValue := strings.TrimSpace(*deviceType)
-			if deviceValue == "" {
+			if setValue == "" && deviceValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --device-type is required")
 				return flag.ErrHelp
 			}
+			if *concurrency < 1 {
+				fmt.Fprintln(os.Stderr, "Error: --concurrency must be at least 1")
+				return flag.ErrHelp
+			}
+			posterValue := strings.TrimSpace(*posterTime)
+			if posterValue != "" && !previewTimeCodePattern.MatchString(posterValue) {
+				fmt.Fprintln(os.Stderr, "Error: --poster-time must be HH:MM:SS or HH:MM:SS:FF")
+				return flag.ErrHelp
+			}
 
-			previewType, err := normalizePreviewType(deviceValue)
-			if err != nil {
-				return fmt.Errorf("assets previews upload: %w", err)
+			previewType := ""
+			if setValue == "" {
+				var err error
+				previewType, err = normalizePreviewType(deviceValue)
+				if err != nil {
+					return fmt.Errorf("assets previews upload: %w", err)
+				}
 			}
 
 			files, err := collectAssetFiles(pathValue)
@@ -157,24 +198,60 @@ Examples:
 			requestCtx, cancel := contextWithAssetUploadTimeout(ctx)
 			defer cancel()
 
-			set, err := ensurePreviewSet(requestCtx, client, locID, previewType)
-			if err != nil {
-				return fmt.Errorf("assets previews upload: %w", err)
+			var set asc.Resource[asc.AppPreviewSetAttributes]
+			if setValue != "" {
+				resp, err := client.GetAppPreviewSet(requestCtx, setValue)
+				if err != nil {
+					return fmt.Errorf("assets previews upload: failed to fetch preview set: %w", err)
+				}
+				set = resp.Data
+			} else {
+				set, err = ensurePreviewSet(requestCtx, client, locID, previewType)
+				if err != nil {
+					return fmt.Errorf("assets previews upload: %w", err)
+				}
 			}
 
-			results := make([]asc.AssetUploadResultItem, 0, len(files))
+			jobs := make([]assetUploadJob, 0, len(files))
 			for _, filePath := range files {
-				item, err := uploadPreviewAsset(requestCtx, client, set.ID, filePath)
+				jobs = append(jobs, assetUploadJob{setID: set.ID, filePath: filePath})
+			}
+			results, err := uploadAssetsConcurrently(requestCtx, jobs, *concurrency, func(ctx context.Context, job assetUploadJob) (asc.AssetUploadResultItem, error) {
+				item, err := uploadPreviewAsset(ctx, client, job.setID, job.filePath, *resume)
 				if err != nil {
-					return fmt.Errorf("assets previews upload: %w", err)
+					return item, err
+				}
+				if posterValue != "" {
+					if _, err := client.UpdateAppPreviewFrameTimeCode(ctx, item.AssetID, posterValue); err != nil {
+						return item, fmt.Errorf("failed to set poster frame: %w", err)
+					}
+				}
+				return item, nil
+			})
+			if err != nil {
+				return fmt.Errorf("assets previews upload: %w", err)
+			}
+			if *concurrency > 1 && len(results) > 1 {
+				resp, err := client.GetAppPreviews(requestCtx, set.ID)
+				if err != nil {
+					return fmt.Errorf("assets previews upload: failed to fetch previews: %w", err)
+				}
+				current := make([]string, 0, len(resp.Data))
+				for _, preview := range resp.Data {
+					current = append(current, preview.ID)
+				}
+				if order, changed := uploadedOrder(current, results); changed {
+					if err := client.ReplaceAppPreviewSetPreviews(requestCtx, set.ID, order); err != nil {
+						return fmt.Errorf("assets previews upload: failed to reorder previews: %w", err)
+					}
 				}
-				results = append(results, item)
 			}
 
 			result := asc.AppPreviewUploadResult{
 				VersionLocalizationID: locID,
 				SetID:                 set.ID,
 				PreviewType:           set.Attributes.PreviewType,
+				PreviewFrameTimeCode:  posterValue,
 				Results:               results,
 			}
 
@@ -235,6 +312,9 @@ Examples:
 	}
 }
 
+// previewTimeCodePattern matches HH:MM:SS with an optional frame number.
+var previewTimeCodePattern = regexp.MustCompile(`^\d{2}:[0-5]\d:[0-5]\d(:\d{2})?$`)
+
 func normalizePreviewType(input string) (string, error) {
 	value := strings.ToUpper(strings.TrimSpace(input))
 	if value == "" {
@@ -264,7 +344,7 @@ func ensurePreviewSet(ctx context.Context, client *asc.Client, localizationID, p
 	return created.Data, nil
 }
 
-func uploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
+func uploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath string, resume bool) (asc.AssetUploadResultItem, error) {
 	if err := asc.ValidateImageFile(filePath); err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
@@ -290,23 +370,38 @@ func uploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath
 		return asc.AssetUploadResultItem{}, err
 	}
 
-	created, err := client.CreateAppPreview(ctx, setID, info.Name(), info.Size(), mimeType)
+	lookup := func(ctx context.Context, id string) (*asc.AssetDeliveryState, error) {
+		resp, err := client.GetAppPreview(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data.Attributes.AssetDeliveryState, nil
+	}
+	reserve := func() (string, []asc.UploadOperation, error) {
+		created, err := client.CreateAppPreview(ctx, setID, info.Name(), info.Size(), mimeType)
+		if err != nil {
+			return "", nil, err
+		}
+		return created.Data.ID, created.Data.Attributes.UploadOperations, nil
+	}
+	upload, err := reserveAssetUpload(ctx, "preview", setID, filePath, info, resume, lookup, reserve)
 	if err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
-	if len(created.Data.Attributes.UploadOperations) == 0 {
-		return asc.AssetUploadResultItem{}, fmt.Errorf("no upload operations returned for %q", info.Name())
-	}
+	assetID := upload.ReservationID
 
-	if err := asc.UploadAssetFromFile(ctx, file, info.Size(), created.Data.Attributes.UploadOperations); err != nil {
+	if err := asc.UploadAssetFromFile(ctx, file, info.Size(), upload.Operations, asc.WithUploadState(upload)); err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
 
-	if _, err := client.UpdateAppPreview(ctx, created.Data.ID, true, checksum.Hash); err != nil {
+	if _, err := client.UpdateAppPreview(ctx, assetID, true, checksum.Hash); err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+	if err := upload.Remove(); err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
 
-	state, err := waitForPreviewDelivery(ctx, client, created.Data.ID)
+	state, err := waitForPreviewDelivery(ctx, client, assetID)
 	if err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
@@ -314,7 +409,7 @@ func uploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath
 	return asc.AssetUploadResultItem{
 		FileName: info.Name(),
 		FilePath: filePath,
-		AssetID:  created.Data.ID,
+		AssetID:  assetID,
 		State:    state,
 	}, nil
 }
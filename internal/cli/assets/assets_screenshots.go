@@ -26,13 +26,21 @@ func AssetsScreenshotsCommand() *ffcli.Command {
 Examples:
   asc assets screenshots list --version-localization "LOC_ID"
   asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65"
-  asc assets screenshots delete --id "SCREENSHOT_ID" --confirm`,
+  asc assets screenshots delete --id "SCREENSHOT_ID" --confirm
+  asc assets screenshots sync --version-id "VERSION_ID" --dir "./screenshots" --dry-run
+  asc assets screenshots download --version-id "VERSION_ID" --out "./screenshots"
+  asc assets screenshots reorder --set "SET_ID" --ids "SHOT_3,SHOT_1,SHOT_2"
+  asc assets screenshots validate --dir "./screenshots"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			AssetsScreenshotsListCommand(),
 			AssetsScreenshotsUploadCommand(),
 			AssetsScreenshotsDeleteCommand(),
+			AssetsScreenshotsSyncCommand(),
+			AssetsScreenshotsDownloadCommand(),
+			AssetsScreenshotsReorderCommand(),
+			AssetsScreenshotsValidateCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -104,26 +112,53 @@ func AssetsScreenshotsUploadCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("upload", flag.ExitOnError)
 
 	localizationID := fs.String("version-localization", "", "App Store version localization ID")
+	setID := fs.String("set", "", "Existing screenshot set ID (instead of --version-localization and --device-type)")
 	path := fs.String("path", "", "Path to screenshot file or directory")
 	deviceType := fs.String("device-type", "", "Device type (e.g., IPHONE_65)")
+	resume := fs.Bool("resume", false, "Resume interrupted uploads from their last completed chunk")
+	concurrency := fs.Int("concurrency", 1, "Number of files to upload at once")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "upload",
-		ShortUsage: "asc assets screenshots upload --version-localization \"LOC_ID\" --path \"./screenshots\" --device-type \"IPHONE_65\"",
+		ShortUsage: "asc assets screenshots upload (--version-localization \"LOC_ID\" --device-type \"IPHONE_65\" | --set \"SET_ID\") --path \"./screenshots\"",
 		ShortHelp:  "Upload screenshots for a localization.",
 		LongHelp: `Upload screenshots for a localization.
 
+Each file is reserved, uploaded in the chunks App Store Connect asks for,
+and committed with its MD5 checksum. Chunks that fail with a network error
+or a 429/503 response are retried. The command waits until App Store
+Connect has processed each screenshot.
+
+With --concurrency, several files upload at once; the set is then
+reordered so the new screenshots keep the order of --path.
+
+Progress is saved after every chunk under ~/.asc/uploads. If an upload is
+interrupted, rerun the same command with --resume to reuse the reservation
+and upload only the remaining chunks.
+
+Upload into the set for --device-type, creating it if needed, or into an
+existing set with --set.
+
 Examples:
   asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots" --device-type "IPHONE_65"
-  asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots/en-US.png" --device-type "IPHONE_65"`,
+  asc assets screenshots upload --version-localization "LOC_ID" --path "./screenshots/en-US.png" --device-type "IPHONE_65"
+  asc assets screenshots upload --set "SET_ID" --path "./shot.png"
+  asc assets screenshots upload --set "SET_ID" --path "./screenshots" --resume
+  asc assets screenshots upload --set "SET_ID" --path "./screenshots" --concurrency 4`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			locID := strings.TrimSpace(*localizationID)
-			if locID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --version-localization is required")
+			setValue := strings.TrimSpace(*setID)
+			deviceValue := strings.TrimSpace(*deviceType)
+			if setValue != "" && (locID != "" || deviceValue != "") {
+				fmt.Fprintln(os.Stderr, "Error: --set cannot be combined with --version-localization or --device-type")
+				return flag.ErrHelp
+			}
+			if setValue == "" && locID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-localization is required (or use --set)")
 				return flag.ErrHelp
 			}
 			pathValue := strings.TrimSpace(*path)
@@ -131,15 +166,22 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --path is required")
 				return flag.ErrHelp
 			}
-			deviceValue := strings.TrimSpace(*deviceType)
-			if deviceValue == "" {
+			if setValue == "" && deviceValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --device-type is required")
 				return flag.ErrHelp
 			}
+			if *concurrency < 1 {
+				fmt.Fprintln(os.Stderr, "Error: --concurrency must be at least 1")
+				return flag.ErrHelp
+			}
 
-			displayType, err := normalizeScreenshotDisplayType(deviceValue)
-			if err != nil {
-				return fmt.Errorf("assets screenshots upload: %w", err)
+			displayType := ""
+			if setValue == "" {
+				var err error
+				displayType, err = normalizeScreenshotDisplayType(deviceValue)
+				if err != nil {
+					return fmt.Errorf("assets screenshots upload: %w", err)
+				}
 			}
 
 			files, err := collectAssetFiles(pathValue)
@@ -155,18 +197,44 @@ Examples:
 			requestCtx, cancel := contextWithAssetUploadTimeout(ctx)
 			defer cancel()
 
-			set, err := ensureScreenshotSet(requestCtx, client, locID, displayType)
-			if err != nil {
-				return fmt.Errorf("assets screenshots upload: %w", err)
+			var set asc.Resource[asc.AppScreenshotSetAttributes]
+			if setValue != "" {
+				resp, err := client.GetAppScreenshotSet(requestCtx, setValue)
+				if err != nil {
+					return fmt.Errorf("assets screenshots upload: failed to fetch screenshot set: %w", err)
+				}
+				set = resp.Data
+			} else {
+				set, err = ensureScreenshotSet(requestCtx, client, locID, displayType)
+				if err != nil {
+					return fmt.Errorf("assets screenshots upload: %w", err)
+				}
 			}
 
-			results := make([]asc.AssetUploadResultItem, 0, len(files))
+			jobs := make([]assetUploadJob, 0, len(files))
 			for _, filePath := range files {
-				item, err := uploadScreenshotAsset(requestCtx, client, set.ID, filePath)
+				jobs = append(jobs, assetUploadJob{setID: set.ID, filePath: filePath})
+			}
+			results, err := uploadAssetsConcurrently(requestCtx, jobs, *concurrency, func(ctx context.Context, job assetUploadJob) (asc.AssetUploadResultItem, error) {
+				return uploadScreenshotAsset(ctx, client, job.setID, job.filePath, *resume)
+			})
+			if err != nil {
+				return fmt.Errorf("assets screenshots upload: %w", err)
+			}
+			if *concurrency > 1 && len(results) > 1 {
+				resp, err := client.GetAppScreenshots(requestCtx, set.ID)
 				if err != nil {
-					return fmt.Errorf("assets screenshots upload: %w", err)
+					return fmt.Errorf("assets screenshots upload: failed to fetch screenshots: %w", err)
+				}
+				current := make([]string, 0, len(resp.Data))
+				for _, shot := range resp.Data {
+					current = append(current, shot.ID)
+				}
+				if order, changed := uploadedOrder(current, results); changed {
+					if err := client.ReplaceAppScreenshotSetScreenshots(requestCtx, set.ID, order); err != nil {
+						return fmt.Errorf("assets screenshots upload: failed to reorder screenshots: %w", err)
+					}
 				}
-				results = append(results, item)
 			}
 
 			result := asc.AppScreenshotUploadResult{
@@ -186,30 +254,61 @@ func AssetsScreenshotsDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
 
 	id := fs.String("id", "", "Screenshot ID")
+	versionID := fs.String("version-id", "", "App Store version ID (deletes whole screenshot sets; requires --locale)")
+	locale := fs.String("locale", "", "With --version-id: locale(s) whose sets to delete, comma-separated")
+	displayType := fs.String("display-type", "", "With --version-id: only delete these display type(s), comma-separated")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "delete",
-		ShortUsage: "asc assets screenshots delete --id \"SCREENSHOT_ID\" --confirm",
-		ShortHelp:  "Delete a screenshot by ID.",
-		LongHelp: `Delete a screenshot by ID.
+		ShortUsage: "asc assets screenshots delete (--id \"SCREENSHOT_ID\" | --version-id \"VERSION_ID\" --locale \"LOCALE\") --confirm",
+		ShortHelp:  "Delete a screenshot, or the screenshot sets of a locale.",
+		LongHelp: `Delete a screenshot, or the screenshot sets of a locale.
+
+With --id, deletes one screenshot. With --version-id and --locale, deletes
+the locale's screenshot sets and every screenshot in them, optionally only
+those of --display-type. The APP_ prefix of display types is optional.
 
 Examples:
-  asc assets screenshots delete --id "SCREENSHOT_ID" --confirm`,
+  asc assets screenshots delete --id "SCREENSHOT_ID" --confirm
+  asc assets screenshots delete --version-id "VERSION_ID" --locale "de-DE" --confirm
+  asc assets screenshots delete --version-id "VERSION_ID" --locale "de-DE,fr-FR" --display-type "APP_IPHONE_67" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			assetID := strings.TrimSpace(*id)
-			if assetID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+			versionValue := strings.TrimSpace(*versionID)
+			locales := shared.SplitCSV(*locale)
+			displayTypes := shared.SplitCSVUpper(*displayType)
+			if assetID != "" && versionValue != "" {
+				fmt.Fprintln(os.Stderr, "Error: --id and --version-id are mutually exclusive")
+				return flag.ErrHelp
+			}
+			if assetID == "" && versionValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required (or use --version-id with --locale)")
+				return flag.ErrHelp
+			}
+			if versionValue != "" && len(locales) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --locale is required with --version-id")
+				return flag.ErrHelp
+			}
+			if versionValue == "" && (len(locales) > 0 || len(displayTypes) > 0) {
+				fmt.Fprintln(os.Stderr, "Error: --locale and --display-type require --version-id")
 				return flag.ErrHelp
 			}
 			if !*confirm {
 				fmt.Fprintln(os.Stderr, "Error: --confirm is required to delete")
 				return flag.ErrHelp
 			}
+			for i, value := range displayTypes {
+				normalized, err := syncDisplayType(value)
+				if err != nil {
+					return fmt.Errorf("assets screenshots delete: %w", err)
+				}
+				displayTypes[i] = normalized
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -219,6 +318,14 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if versionValue != "" {
+				result, err := deleteScreenshotSets(requestCtx, client, versionValue, locales, displayTypes)
+				if err != nil {
+					return fmt.Errorf("assets screenshots delete: %w", err)
+				}
+				return shared.PrintOutput(result, *output, *pretty)
+			}
+
 			if err := client.DeleteAppScreenshot(requestCtx, assetID); err != nil {
 				return fmt.Errorf("assets screenshots delete: %w", err)
 			}
@@ -264,7 +371,7 @@ func ensureScreenshotSet(ctx context.Context, client *asc.Client, localizationID
 	return created.Data, nil
 }
 
-func uploadScreenshotAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
+func uploadScreenshotAsset(ctx context.Context, client *asc.Client, setID, filePath string, resume bool) (asc.AssetUploadResultItem, error) {
 	if err := asc.ValidateImageFile(filePath); err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
@@ -285,23 +392,38 @@ func uploadScreenshotAsset(ctx context.Context, client *asc.Client, setID, fileP
 		return asc.AssetUploadResultItem{}, err
 	}
 
-	created, err := client.CreateAppScreenshot(ctx, setID, info.Name(), info.Size())
+	lookup := func(ctx context.Context, id string) (*asc.AssetDeliveryState, error) {
+		resp, err := client.GetAppScreenshot(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data.Attributes.AssetDeliveryState, nil
+	}
+	reserve := func() (string, []asc.UploadOperation, error) {
+		created, err := client.CreateAppScreenshot(ctx, setID, info.Name(), info.Size())
+		if err != nil {
+			return "", nil, err
+		}
+		return created.Data.ID, created.Data.Attributes.UploadOperations, nil
+	}
+	upload, err := reserveAssetUpload(ctx, "screenshot", setID, filePath, info, resume, lookup, reserve)
 	if err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
-	if len(created.Data.Attributes.UploadOperations) == 0 {
-		return asc.AssetUploadResultItem{}, fmt.Errorf("no upload operations returned for %q", info.Name())
-	}
+	assetID := upload.ReservationID
 
-	if err := asc.UploadAssetFromFile(ctx, file, info.Size(), created.Data.Attributes.UploadOperations); err != nil {
+	if err := asc.UploadAssetFromFile(ctx, file, info.Size(), upload.Operations, asc.WithUploadState(upload)); err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
 
-	if _, err := client.UpdateAppScreenshot(ctx, created.Data.ID, true, checksum.Hash); err != nil {
+	if _, err := client.UpdateAppScreenshot(ctx, assetID, true, checksum.Hash); err != nil {
+		return asc.AssetUploadResultItem{}, err
+	}
+	if err := upload.Remove(); err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
 
-	state, err := waitForScreenshotDelivery(ctx, client, created.Data.ID)
+	state, err := waitForScreenshotDelivery(ctx, client, assetID)
 	if err != nil {
 		return asc.AssetUploadResultItem{}, err
 	}
@@ -309,7 +431,7 @@ func uploadScreenshotAsset(ctx context.Context, client *asc.Client, setID, fileP
 	return asc.AssetUploadResultItem{
 		FileName: info.Name(),
 		FilePath: filePath,
-		AssetID:  created.Data.ID,
+		AssetID:  assetID,
 		State:    state,
 	}, nil
 }
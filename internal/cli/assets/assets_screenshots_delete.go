@@ -0,0 +1,60 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// deleteScreenshotSets deletes the screenshot sets of the given locales of a
+// version, limited to displayTypes when any are given. Every locale must
+// exist on the version; nothing is deleted otherwise.
+func deleteScreenshotSets(ctx context.Context, client *asc.Client, versionID string, locales, displayTypes []string) (*asc.ScreenshotSetsDeleteResult, error) {
+	locResp, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	localizationIDs := make(map[string]string, len(locResp.Data))
+	for _, loc := range locResp.Data {
+		localizationIDs[loc.Attributes.Locale] = loc.ID
+	}
+	for _, locale := range locales {
+		if _, ok := localizationIDs[locale]; !ok {
+			return nil, fmt.Errorf("version %s has no %s localization", versionID, locale)
+		}
+	}
+
+	wanted := make(map[string]bool, len(displayTypes))
+	for _, displayType := range displayTypes {
+		wanted[displayType] = true
+	}
+
+	result := &asc.ScreenshotSetsDeleteResult{
+		VersionID: versionID,
+		Locales:   locales,
+		Sets:      []asc.ScreenshotSetDeleteItem{},
+	}
+	for _, locale := range locales {
+		setsResp, err := client.GetAppScreenshotSets(ctx, localizationIDs[locale])
+		if err != nil {
+			return result, fmt.Errorf("failed to fetch screenshot sets for %s: %w", locale, err)
+		}
+		for _, set := range setsResp.Data {
+			displayType := strings.ToUpper(set.Attributes.ScreenshotDisplayType)
+			if len(wanted) > 0 && !wanted[displayType] {
+				continue
+			}
+			if err := client.DeleteAppScreenshotSet(ctx, set.ID); err != nil {
+				return result, fmt.Errorf("failed to delete %s %s set: %w", locale, displayType, err)
+			}
+			result.Sets = append(result.Sets, asc.ScreenshotSetDeleteItem{
+				Locale:      locale,
+				DisplayType: displayType,
+				SetID:       set.ID,
+			})
+		}
+	}
+	return result, nil
+}
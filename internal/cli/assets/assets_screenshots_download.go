@@ -0,0 +1,196 @@
+package assets
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// AssetsScreenshotsDownloadCommand returns the screenshots download subcommand.
+func AssetsScreenshotsDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID")
+	out := fs.String("out", "", "Output directory")
+	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
+	overwrite := fs.Bool("overwrite", false, "Overwrite existing files")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc assets screenshots download --version-id \"VERSION_ID\" --out \"./screenshots\" [flags]",
+		ShortHelp:  "Download all screenshots of a version.",
+		LongHelp: `Download all screenshots of a version.
+
+Screenshots are downloaded at their original resolution into
+<out>/<locale>/<display-type>/NN_<file name>, numbered in set order. This is
+the layout "asc assets screenshots sync" reads, so a download can be edited
+and synced back.
+
+Screenshots that App Store Connect has not finished processing have no image
+yet and are reported as skipped.
+
+Examples:
+  asc assets screenshots download --version-id "VERSION_ID" --out "./screenshots"
+  asc assets screenshots download --version-id "VERSION_ID" --out "./screenshots" --locale "en-US,de-DE" --overwrite`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			versionValue := strings.TrimSpace(*versionID)
+			if versionValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+			outValue := strings.TrimSpace(*out)
+			if outValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --out is required")
+				return flag.ErrHelp
+			}
+			locales := make(map[string]bool)
+			for _, value := range shared.SplitCSV(*locale) {
+				locales[value] = true
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("assets screenshots download: %w", err)
+			}
+
+			requestCtx, cancel := contextWithAssetUploadTimeout(ctx)
+			defer cancel()
+
+			locResp, err := client.GetAppStoreVersionLocalizations(requestCtx, versionValue, asc.WithAppStoreVersionLocalizationsLimit(200))
+			if err != nil {
+				return fmt.Errorf("assets screenshots download: failed to fetch localizations: %w", err)
+			}
+
+			result := &asc.ScreenshotDownloadResult{
+				VersionID: versionValue,
+				Dir:       outValue,
+				Files:     []asc.ScreenshotDownloadItem{},
+			}
+			for _, loc := range locResp.Data {
+				if len(locales) > 0 && !locales[loc.Attributes.Locale] {
+					continue
+				}
+				if err := downloadLocalizationScreenshots(requestCtx, client, loc.ID, loc.Attributes.Locale, outValue, *overwrite, result); err != nil {
+					return fmt.Errorf("assets screenshots download: %w", err)
+				}
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+func downloadLocalizationScreenshots(ctx context.Context, client *asc.Client, localizationID, locale, outDir string, overwrite bool, result *asc.ScreenshotDownloadResult) error {
+	setsResp, err := client.GetAppScreenshotSets(ctx, localizationID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch screenshot sets for %s: %w", locale, err)
+	}
+	for _, set := range setsResp.Data {
+		displayType := set.Attributes.ScreenshotDisplayType
+		shotsResp, err := client.GetAppScreenshots(ctx, set.ID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch screenshots for %s %s: %w", locale, displayType, err)
+		}
+		if len(shotsResp.Data) == 0 {
+			continue
+		}
+
+		dir := filepath.Join(outDir, locale, displayType)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", dir, err)
+		}
+		for i, shot := range shotsResp.Data {
+			asset := shot.Attributes.ImageAsset
+			if asset == nil || strings.TrimSpace(asset.TemplateURL) == "" {
+				result.Skipped = append(result.Skipped, shot.ID)
+				continue
+			}
+			name, format := screenshotDownloadName(i+1, shot.Attributes.FileName, shot.ID)
+			path := filepath.Join(dir, name)
+			written, err := downloadScreenshotFile(ctx, client, asset, format, path, overwrite)
+			if err != nil {
+				return fmt.Errorf("failed to download %s: %w", shot.ID, err)
+			}
+			result.Files = append(result.Files, asc.ScreenshotDownloadItem{
+				Locale:       locale,
+				DisplayType:  displayType,
+				ScreenshotID: shot.ID,
+				FilePath:     path,
+				Width:        asset.Width,
+				Height:       asset.Height,
+				BytesWritten: written,
+			})
+		}
+	}
+	return nil
+}
+
+// screenshotDownloadName returns the NN_<name> file name for the screenshot
+// at position and the image format to request. JPEG uploads are downloaded as
+// JPEG; everything else as PNG.
+func screenshotDownloadName(position int, fileName, id string) (string, string) {
+	base := filepath.Base(strings.TrimSpace(fileName))
+	if base == "." || base == string(filepath.Separator) || base == "" {
+		base = id
+	}
+	ext := strings.ToLower(filepath.Ext(base))
+	stem := strings.TrimSuffix(base, filepath.Ext(base))
+	format := "png"
+	if ext == ".jpg" || ext == ".jpeg" {
+		format = "jpg"
+	}
+	return fmt.Sprintf("%02d_%s.%s", position, stem, format), format
+}
+
+func downloadScreenshotFile(ctx context.Context, client *asc.Client, asset *asc.ImageAsset, format, path string, overwrite bool) (int64, error) {
+	if overwrite {
+		if info, err := os.Lstat(path); err == nil {
+			if !info.Mode().IsRegular() {
+				return 0, fmt.Errorf("refusing to overwrite %q: not a regular file", path)
+			}
+			if err := os.Remove(path); err != nil {
+				return 0, err
+			}
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return 0, err
+		}
+	}
+
+	file, err := shared.OpenNewFileNoFollow(path, 0o644)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return 0, fmt.Errorf("output file already exists (use --overwrite): %s", path)
+		}
+		return 0, err
+	}
+
+	download, err := client.DownloadImageAsset(ctx, asset, format)
+	if err != nil {
+		file.Close()
+		_ = os.Remove(path)
+		return 0, err
+	}
+	defer download.Body.Close()
+
+	written, err := io.Copy(file, download.Body)
+	if err != nil {
+		file.Close()
+		_ = os.Remove(path)
+		return 0, err
+	}
+	return written, file.Close()
+}
@@ -0,0 +1,23 @@
+package assets
+
+import "testing"
+
+func TestScreenshotDownloadName(t *testing.T) {
+	tests := []struct {
+		position   int
+		fileName   string
+		wantName   string
+		wantFormat string
+	}{
+		{1, "home.png", "01_home.png", "png"},
+		{2, "Detail Screen.JPEG", "02_Detail Screen.jpg", "jpg"},
+		{3, "../../escape.png", "03_escape.png", "png"},
+		{12, "", "12_SHOT_ID.png", "png"},
+	}
+	for _, test := range tests {
+		name, format := screenshotDownloadName(test.position, test.fileName, "SHOT_ID")
+		if name != test.wantName || format != test.wantFormat {
+			t.Fatalf("screenshotDownloadName(%d, %q) = %q, %q; want %q, %q", test.position, test.fileName, name, format, test.wantName, test.wantFormat)
+		}
+	}
+}
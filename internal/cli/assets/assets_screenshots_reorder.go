@@ -0,0 +1,146 @@
+package assets
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// AssetsScreenshotsReorderCommand returns the screenshots reorder subcommand.
+func AssetsScreenshotsReorderCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("reorder", flag.ExitOnError)
+
+	setID := fs.String("set", "", "Screenshot set ID")
+	ids := fs.String("ids", "", "All screenshot IDs of the set in the new order, comma-separated")
+	byFileName := fs.Bool("by-filename", false, "Order screenshots by file name instead of --ids")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "reorder",
+		ShortUsage: "asc assets screenshots reorder --set \"SET_ID\" (--ids \"ID3,ID1,ID2\" | --by-filename)",
+		ShortHelp:  "Reorder the screenshots of a set.",
+		LongHelp: `Reorder the screenshots of a set.
+
+--ids must list every screenshot in the set exactly once, since App Store
+Connect replaces the set's screenshot list with the given order. With
+--by-filename, screenshots are sorted by their uploaded file name, which
+suits NN_name.png naming.
+
+Examples:
+  asc assets screenshots reorder --set "SET_ID" --ids "SHOT_3,SHOT_1,SHOT_2"
+  asc assets screenshots reorder --set "SET_ID" --by-filename`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			setValue := strings.TrimSpace(*setID)
+			if setValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --set is required")
+				return flag.ErrHelp
+			}
+			order := shared.SplitCSV(*ids)
+			if len(order) > 0 && *byFileName {
+				fmt.Fprintln(os.Stderr, "Error: --ids and --by-filename are mutually exclusive")
+				return flag.ErrHelp
+			}
+			if len(order) == 0 && !*byFileName {
+				fmt.Fprintln(os.Stderr, "Error: --ids or --by-filename is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("assets screenshots reorder: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetAppScreenshots(requestCtx, setValue)
+			if err != nil {
+				return fmt.Errorf("assets screenshots reorder: failed to fetch screenshots: %w", err)
+			}
+
+			if *byFileName {
+				order = screenshotOrderByFileName(resp.Data)
+			} else if err := validateScreenshotOrder(order, resp.Data); err != nil {
+				return fmt.Errorf("assets screenshots reorder: %w", err)
+			}
+
+			current := make([]string, 0, len(resp.Data))
+			fileNames := make(map[string]string, len(resp.Data))
+			for _, shot := range resp.Data {
+				current = append(current, shot.ID)
+				fileNames[shot.ID] = shot.Attributes.FileName
+			}
+
+			result := &asc.ScreenshotReorderResult{
+				SetID:   setValue,
+				Order:   order,
+				Changed: strings.Join(current, ",") != strings.Join(order, ","),
+			}
+			for _, id := range order {
+				result.FileNames = append(result.FileNames, fileNames[id])
+			}
+			if result.Changed {
+				if err := client.ReplaceAppScreenshotSetScreenshots(requestCtx, setValue, order); err != nil {
+					return fmt.Errorf("assets screenshots reorder: %w", err)
+				}
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// screenshotOrderByFileName returns the screenshot IDs sorted by file name.
+// Screenshots with the same name keep their current relative order.
+func screenshotOrderByFileName(shots []asc.Resource[asc.AppScreenshotAttributes]) []string {
+	sorted := make([]asc.Resource[asc.AppScreenshotAttributes], len(shots))
+	copy(sorted, shots)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Attributes.FileName < sorted[j].Attributes.FileName
+	})
+	order := make([]string, 0, len(sorted))
+	for _, shot := range sorted {
+		order = append(order, shot.ID)
+	}
+	return order
+}
+
+// validateScreenshotOrder checks that order lists every screenshot of the set
+// exactly once, so the replace does not drop any screenshot.
+func validateScreenshotOrder(order []string, shots []asc.Resource[asc.AppScreenshotAttributes]) error {
+	inSet := make(map[string]bool, len(shots))
+	for _, shot := range shots {
+		inSet[shot.ID] = true
+	}
+	seen := make(map[string]bool, len(order))
+	for _, id := range order {
+		if !inSet[id] {
+			return fmt.Errorf("screenshot %s is not in the set", id)
+		}
+		if seen[id] {
+			return fmt.Errorf("screenshot %s is listed more than once", id)
+		}
+		seen[id] = true
+	}
+	if len(seen) != len(inSet) {
+		var missing []string
+		for _, shot := range shots {
+			if !seen[shot.ID] {
+				missing = append(missing, shot.ID)
+			}
+		}
+		return fmt.Errorf("--ids is missing screenshot(s) %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package assets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func reorderTestShots() []asc.Resource[asc.AppScreenshotAttributes] {
+	return []asc.Resource[asc.AppScreenshotAttributes]{
+		{ID: "A", Attributes: asc.AppScreenshotAttributes{FileName: "03_c.png"}},
+		{ID: "B", Attributes: asc.AppScreenshotAttributes{FileName: "01_a.png"}},
+		{ID: "C", Attributes: asc.AppScreenshotAttributes{FileName: "02_b.png"}},
+	}
+}
+
+func TestScreenshotOrderByFileName(t *testing.T) {
+	got := strings.Join(screenshotOrderByFileName(reorderTestShots()), ",")
+	if got != "B,C,A" {
+		t.Fatalf("expected B,C,A, got %s", got)
+	}
+}
+
+func TestValidateScreenshotOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   []string
+		wantErr string
+	}{
+		{name: "permutation", order: []string{"C", "A", "B"}},
+		{name: "unknown", order: []string{"C", "A", "X"}, wantErr: "X is not in the set"},
+		{name: "duplicate", order: []string{"C", "A", "A"}, wantErr: "A is listed more than once"},
+		{name: "missing", order: []string{"C", "A"}, wantErr: "missing screenshot(s) B"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateScreenshotOrder(test.order, reorderTestShots())
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,440 @@
+package assets
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Screenshot sync actions.
+const (
+	screenshotSyncKeep   = "keep"
+	screenshotSyncUpload = "upload"
+	screenshotSyncDelete = "delete"
+)
+
+// localScreenshotSet is one <locale>/<display-type>/ directory. unsupported
+// lists files without a PNG or JPEG extension, which sync ignores.
+type localScreenshotSet struct {
+	locale      string
+	displayType string
+	files       []localScreenshotFile
+	unsupported []string
+}
+
+type localScreenshotFile struct {
+	name     string
+	path     string
+	checksum string
+}
+
+// screenshotSyncPlan is the planned work for one screenshot set. keep maps
+// local file indexes to the remote screenshot with the same checksum.
+type screenshotSyncPlan struct {
+	keep    map[int]string
+	upload  []int
+	deletes []asc.Resource[asc.AppScreenshotAttributes]
+}
+
+// screenshotSetSync is one set part way through a sync: deletes are done and
+// the set exists, and ids receives the uploaded screenshot per file index.
+type screenshotSetSync struct {
+	local  localScreenshotSet
+	setID  string
+	remote []asc.Resource[asc.AppScreenshotAttributes]
+	plan   screenshotSyncPlan
+	ids    map[int]string
+}
+
+// AssetsScreenshotsSyncCommand returns the screenshots sync subcommand.
+func AssetsScreenshotsSyncCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("sync", flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID")
+	dir := fs.String("dir", "", "Directory laid out as <locale>/<display-type>/<file>.png")
+	dryRun := fs.Bool("dry-run", false, "Show the sync plan without changing anything")
+	concurrency := fs.Int("concurrency", 1, "Number of files to upload at once, across all sets")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "sync",
+		ShortUsage: "asc assets screenshots sync --version-id \"VERSION_ID\" --dir \"./screenshots\" [flags]",
+		ShortHelp:  "Sync screenshot sets with a local directory.",
+		LongHelp: `Sync screenshot sets with a local directory.
+
+The directory is laid out as <locale>/<display-type>/<file>, for example
+en-US/APP_IPHONE_67/01_home.png. The APP_ prefix of display types is
+optional. Files are ordered by name, so a numeric prefix sets the order.
+
+For every display type directory, the matching set of the locale's version
+localization is made to equal the directory:
+  - files whose MD5 checksum matches a remote screenshot are kept
+  - other files are uploaded (the set is created when missing)
+  - remote screenshots with no matching file are deleted
+  - the set is reordered to the file order
+
+Uploads run one at a time unless --concurrency is set; rate-limited requests
+are retried with backoff either way.
+
+Sets without a local directory are left untouched. The images are checked
+as by "asc assets screenshots validate" first, and nothing is changed when
+any check fails.
+
+Examples:
+  asc assets screenshots sync --version-id "VERSION_ID" --dir "./screenshots" --dry-run
+  asc assets screenshots sync --version-id "VERSION_ID" --dir "./screenshots" --output table
+  asc assets screenshots sync --version-id "VERSION_ID" --dir "./screenshots" --concurrency 4`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			versionValue := strings.TrimSpace(*versionID)
+			if versionValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			if *concurrency < 1 {
+				fmt.Fprintln(os.Stderr, "Error: --concurrency must be at least 1")
+				return flag.ErrHelp
+			}
+
+			localSets, err := readScreenshotSyncDir(dirValue)
+			if err != nil {
+				return fmt.Errorf("assets screenshots sync: %w", err)
+			}
+			if len(localSets) == 0 {
+				return fmt.Errorf("assets screenshots sync: no screenshots found in %s", dirValue)
+			}
+			// Sync ignores files it would not upload, so only the images are
+			// checked before anything changes.
+			for i := range localSets {
+				localSets[i].unsupported = nil
+			}
+			if issues := validateScreenshotSets(localSets); len(issues) > 0 {
+				first := issues[0]
+				return fmt.Errorf("assets screenshots sync: %d screenshot issue(s), run asc assets screenshots validate for details; first: %s/%s: %s", len(issues), first.Locale, first.DisplayType, first.Message)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("assets screenshots sync: %w", err)
+			}
+
+			requestCtx, cancel := contextWithAssetUploadTimeout(ctx)
+			defer cancel()
+
+			locResp, err := client.GetAppStoreVersionLocalizations(requestCtx, versionValue, asc.WithAppStoreVersionLocalizationsLimit(200))
+			if err != nil {
+				return fmt.Errorf("assets screenshots sync: failed to fetch localizations: %w", err)
+			}
+			localizationIDs := make(map[string]string, len(locResp.Data))
+			for _, loc := range locResp.Data {
+				localizationIDs[loc.Attributes.Locale] = loc.ID
+			}
+			for _, set := range localSets {
+				if _, ok := localizationIDs[set.locale]; !ok {
+					return fmt.Errorf("assets screenshots sync: version %s has no %s localization", versionValue, set.locale)
+				}
+			}
+
+			result := &asc.ScreenshotSyncResult{
+				VersionID: versionValue,
+				Dir:       dirValue,
+				DryRun:    *dryRun,
+				Items:     make([]asc.ScreenshotSyncItem, 0),
+			}
+			remoteSets := make(map[string][]asc.Resource[asc.AppScreenshotSetAttributes])
+			syncs := make([]*screenshotSetSync, 0, len(localSets))
+			for _, set := range localSets {
+				locID := localizationIDs[set.locale]
+				if _, ok := remoteSets[locID]; !ok {
+					setsResp, err := client.GetAppScreenshotSets(requestCtx, locID)
+					if err != nil {
+						return fmt.Errorf("assets screenshots sync: failed to fetch sets for %s: %w", set.locale, err)
+					}
+					remoteSets[locID] = setsResp.Data
+				}
+				setSync, err := prepareScreenshotSetSync(requestCtx, client, locID, remoteSets[locID], set, *dryRun)
+				if err != nil {
+					return fmt.Errorf("assets screenshots sync: %s %s: %w", set.locale, set.displayType, err)
+				}
+				syncs = append(syncs, setSync)
+			}
+
+			// Uploads of all sets share one worker pool.
+			var jobs []assetUploadJob
+			var owners []*screenshotSetSync
+			var fileIndexes []int
+			for _, setSync := range syncs {
+				for _, index := range setSync.plan.upload {
+					jobs = append(jobs, assetUploadJob{setID: setSync.setID, filePath: setSync.local.files[index].path})
+					owners = append(owners, setSync)
+					fileIndexes = append(fileIndexes, index)
+				}
+			}
+			if !*dryRun {
+				uploaded, err := uploadAssetsConcurrently(requestCtx, jobs, *concurrency, func(ctx context.Context, job assetUploadJob) (asc.AssetUploadResultItem, error) {
+					return uploadScreenshotAsset(ctx, client, job.setID, job.filePath, false)
+				})
+				if err != nil {
+					return fmt.Errorf("assets screenshots sync: %w", err)
+				}
+				for i, item := range uploaded {
+					owners[i].ids[fileIndexes[i]] = item.AssetID
+				}
+			}
+
+			for _, setSync := range syncs {
+				if err := finishScreenshotSetSync(requestCtx, client, setSync, *dryRun, *concurrency > 1, result); err != nil {
+					return fmt.Errorf("assets screenshots sync: %s %s: %w", setSync.local.locale, setSync.local.displayType, err)
+				}
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// prepareScreenshotSetSync plans one set, deletes the remote screenshots with
+// no matching file, and creates the set when files need uploading into it.
+func prepareScreenshotSetSync(ctx context.Context, client *asc.Client, localizationID string, remoteSets []asc.Resource[asc.AppScreenshotSetAttributes], local localScreenshotSet, dryRun bool) (*screenshotSetSync, error) {
+	setSync := &screenshotSetSync{local: local}
+	for _, set := range remoteSets {
+		if strings.EqualFold(set.Attributes.ScreenshotDisplayType, local.displayType) {
+			setSync.setID = set.ID
+			break
+		}
+	}
+
+	if setSync.setID != "" {
+		resp, err := client.GetAppScreenshots(ctx, setSync.setID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch screenshots: %w", err)
+		}
+		setSync.remote = resp.Data
+	}
+
+	setSync.plan = planScreenshotSetSync(local.files, setSync.remote)
+
+	// Delete first so that uploads do not exceed the per-set limit.
+	if !dryRun {
+		for _, shot := range setSync.plan.deletes {
+			if err := client.DeleteAppScreenshot(ctx, shot.ID); err != nil {
+				return nil, fmt.Errorf("failed to delete screenshot %s: %w", shot.ID, err)
+			}
+		}
+	}
+
+	if setSync.setID == "" && len(setSync.plan.upload) > 0 && !dryRun {
+		created, err := client.CreateAppScreenshotSet(ctx, localizationID, local.displayType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create screenshot set: %w", err)
+		}
+		setSync.setID = created.Data.ID
+	}
+
+	setSync.ids = make(map[int]string, len(local.files))
+	for index, id := range setSync.plan.keep {
+		setSync.ids[index] = id
+	}
+	for _, index := range setSync.plan.upload {
+		setSync.ids[index] = "new:" + local.files[index].name
+	}
+	return setSync, nil
+}
+
+// finishScreenshotSetSync records the actions of one set in result once its
+// uploads are done, and reorders the set to the file order. Uploads that ran
+// concurrently land in no particular order, so a set with more than one of
+// them is always reordered.
+func finishScreenshotSetSync(ctx context.Context, client *asc.Client, setSync *screenshotSetSync, dryRun, concurrent bool, result *asc.ScreenshotSyncResult) error {
+	local, plan, ids := setSync.local, setSync.plan, setSync.ids
+	item := func(fileName, action, screenshotID string) {
+		result.Items = append(result.Items, asc.ScreenshotSyncItem{
+			Locale:       local.locale,
+			DisplayType:  local.displayType,
+			FileName:     fileName,
+			Action:       action,
+			ScreenshotID: screenshotID,
+		})
+	}
+
+	for _, shot := range plan.deletes {
+		item(shot.Attributes.FileName, screenshotSyncDelete, shot.ID)
+		result.Deleted++
+	}
+	result.Uploaded += len(plan.upload)
+
+	for index, file := range local.files {
+		if id, ok := plan.keep[index]; ok {
+			item(file.name, screenshotSyncKeep, id)
+			result.Kept++
+			continue
+		}
+		id := ids[index]
+		if dryRun {
+			id = ""
+		}
+		item(file.name, screenshotSyncUpload, id)
+	}
+
+	// Kept screenshots stay in their remote order and uploads are appended,
+	// so the set only needs reordering when that differs from the file order.
+	kept := make(map[string]bool, len(plan.keep))
+	for _, id := range plan.keep {
+		kept[id] = true
+	}
+	var current, desired []string
+	for _, shot := range setSync.remote {
+		if kept[shot.ID] {
+			current = append(current, shot.ID)
+		}
+	}
+	for _, index := range plan.upload {
+		current = append(current, ids[index])
+	}
+	for index := range local.files {
+		desired = append(desired, ids[index])
+	}
+
+	if strings.Join(current, ",") != strings.Join(desired, ",") || (concurrent && len(plan.upload) > 1) {
+		if !dryRun {
+			if err := client.ReplaceAppScreenshotSetScreenshots(ctx, setSync.setID, desired); err != nil {
+				return fmt.Errorf("failed to reorder screenshots: %w", err)
+			}
+		}
+		result.Reordered = append(result.Reordered, local.locale+"/"+local.displayType)
+	}
+	return nil
+}
+
+// planScreenshotSetSync matches local files to remote screenshots by MD5
+// checksum. Each remote screenshot matches at most one file; unmatched files
+// are uploaded and unmatched remote screenshots deleted.
+func planScreenshotSetSync(local []localScreenshotFile, remote []asc.Resource[asc.AppScreenshotAttributes]) screenshotSyncPlan {
+	plan := screenshotSyncPlan{keep: make(map[int]string)}
+	used := make(map[string]bool, len(remote))
+	for index, file := range local {
+		matched := false
+		for _, shot := range remote {
+			if used[shot.ID] || !strings.EqualFold(shot.Attributes.SourceFileChecksum, file.checksum) {
+				continue
+			}
+			used[shot.ID] = true
+			plan.keep[index] = shot.ID
+			matched = true
+			break
+		}
+		if !matched {
+			plan.upload = append(plan.upload, index)
+		}
+	}
+	for _, shot := range remote {
+		if !used[shot.ID] {
+			plan.deletes = append(plan.deletes, shot)
+		}
+	}
+	return plan
+}
+
+// readScreenshotSyncDir reads <locale>/<display-type>/ directories, sorted
+// by locale and display type, with files sorted by name.
+func readScreenshotSyncDir(dir string) ([]localScreenshotSet, error) {
+	localeEntries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("screenshots directory not found: %s", dir)
+		}
+		return nil, fmt.Errorf("failed to read screenshots directory: %w", err)
+	}
+
+	var sets []localScreenshotSet
+	for _, localeEntry := range localeEntries {
+		if !localeEntry.IsDir() || strings.HasPrefix(localeEntry.Name(), ".") {
+			continue
+		}
+		locale := localeEntry.Name()
+		typeEntries, err := os.ReadDir(filepath.Join(dir, locale))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", locale, err)
+		}
+		for _, typeEntry := range typeEntries {
+			if !typeEntry.IsDir() || strings.HasPrefix(typeEntry.Name(), ".") {
+				continue
+			}
+			displayType, err := syncDisplayType(typeEntry.Name())
+			if err != nil {
+				return nil, fmt.Errorf("%s/%s: %w", locale, typeEntry.Name(), err)
+			}
+			files, unsupported, err := readScreenshotSyncFiles(filepath.Join(dir, locale, typeEntry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			sets = append(sets, localScreenshotSet{locale: locale, displayType: displayType, files: files, unsupported: unsupported})
+		}
+	}
+	sort.Slice(sets, func(i, j int) bool {
+		if sets[i].locale != sets[j].locale {
+			return sets[i].locale < sets[j].locale
+		}
+		return sets[i].displayType < sets[j].displayType
+	})
+	return sets, nil
+}
+
+func readScreenshotSyncFiles(dir string) ([]localScreenshotFile, []string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	var files []localScreenshotFile
+	var unsupported []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.Type().IsRegular() || strings.HasPrefix(name, ".") {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(name)) {
+		case ".png", ".jpg", ".jpeg":
+		default:
+			unsupported = append(unsupported, name)
+			continue
+		}
+		path := filepath.Join(dir, name)
+		if err := asc.ValidateImageFile(path); err != nil {
+			return nil, nil, err
+		}
+		checksum, err := asc.ComputeChecksum(path, asc.ChecksumAlgorithmMD5)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to checksum %s: %w", path, err)
+		}
+		files = append(files, localScreenshotFile{name: name, path: path, checksum: checksum.Hash})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].name < files[j].name })
+	sort.Strings(unsupported)
+	return files, unsupported, nil
+}
+
+// syncDisplayType accepts display types with or without the APP_ prefix.
+func syncDisplayType(name string) (string, error) {
+	value := strings.ToUpper(strings.TrimSpace(name))
+	if asc.IsValidScreenshotDisplayType(value) {
+		return value, nil
+	}
+	return normalizeScreenshotDisplayType(value)
+}
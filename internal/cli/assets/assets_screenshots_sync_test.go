@@ -0,0 +1,82 @@
+package assets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestPlanScreenshotSetSync(t *testing.T) {
+	local := []localScreenshotFile{
+		{name: "01_home.png", checksum: "aaa"},
+		{name: "02_detail.png", checksum: "bbb"},
+		{name: "03_copy.png", checksum: "aaa"},
+	}
+	remote := []asc.Resource[asc.AppScreenshotAttributes]{
+		{ID: "OLD", Attributes: asc.AppScreenshotAttributes{SourceFileChecksum: "zzz"}},
+		{ID: "HOME", Attributes: asc.AppScreenshotAttributes{SourceFileChecksum: "AAA"}},
+	}
+
+	plan := planScreenshotSetSync(local, remote)
+
+	if len(plan.keep) != 1 || plan.keep[0] != "HOME" {
+		t.Fatalf("expected 01_home.png to keep HOME, got %v", plan.keep)
+	}
+	if len(plan.upload) != 2 || plan.upload[0] != 1 || plan.upload[1] != 2 {
+		t.Fatalf("expected files 1 and 2 to upload, got %v", plan.upload)
+	}
+	if len(plan.deletes) != 1 || plan.deletes[0].ID != "OLD" {
+		t.Fatalf("expected OLD to be deleted, got %v", plan.deletes)
+	}
+}
+
+func TestReadScreenshotSyncDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"en-US/IPHONE_67/02_detail.png":     "detail",
+		"en-US/IPHONE_67/01_home.png":       "home",
+		"en-US/IPHONE_67/.DS_Store":         "junk",
+		"en-US/IPHONE_67/notes.txt":         "notes",
+		"de-DE/APP_IPAD_PRO_3GEN_129/a.jpg": "ipad",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	sets, err := readScreenshotSyncDir(dir)
+	if err != nil {
+		t.Fatalf("readScreenshotSyncDir() error: %v", err)
+	}
+	if len(sets) != 2 {
+		t.Fatalf("expected 2 sets, got %d", len(sets))
+	}
+	if sets[0].locale != "de-DE" || sets[0].displayType != "APP_IPAD_PRO_3GEN_129" || len(sets[0].files) != 1 {
+		t.Fatalf("unexpected first set: %+v", sets[0])
+	}
+	phone := sets[1]
+	if phone.displayType != "APP_IPHONE_67" || len(phone.files) != 2 || phone.files[0].name != "01_home.png" || phone.files[1].name != "02_detail.png" {
+		t.Fatalf("unexpected iPhone set: %+v", phone)
+	}
+	// md5("home")
+	if phone.files[0].checksum != "106a6c241b8797f52e1e77317b96a201" {
+		t.Fatalf("unexpected checksum %s", phone.files[0].checksum)
+	}
+}
+
+func TestReadScreenshotSyncDirRejectsUnknownDisplayType(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "en-US", "PHONE"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if _, err := readScreenshotSyncDir(dir); err == nil {
+		t.Fatal("expected unknown display type error")
+	}
+}
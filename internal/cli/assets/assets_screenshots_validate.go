@@ -0,0 +1,166 @@
+package assets
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Screenshot validation rule identifiers reported in issues.
+const (
+	screenshotRuleFormat     = "unsupported-format"
+	screenshotRuleExtension  = "extension-mismatch"
+	screenshotRuleDimensions = "invalid-dimensions"
+	screenshotRuleColorSpace = "color-space"
+	screenshotRuleAlpha      = "alpha-channel"
+	screenshotRuleCount      = "too-many-screenshots"
+)
+
+// AssetsScreenshotsValidateCommand returns the screenshots validate subcommand.
+func AssetsScreenshotsValidateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+
+	dir := fs.String("dir", "", "Directory laid out as <locale>/<display-type>/<file>.png")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "validate",
+		ShortUsage: "asc assets screenshots validate --dir \"./screenshots\" [flags]",
+		ShortHelp:  "Check screenshots against App Store requirements before uploading.",
+		LongHelp: `Check screenshots against App Store requirements before uploading.
+
+Reads the same <locale>/<display-type>/<file> layout as "asc assets
+screenshots sync" and checks, without contacting App Store Connect:
+  - unsupported-format: files that are not PNG or JPEG
+  - extension-mismatch: file contents that do not match the extension
+  - invalid-dimensions: sizes App Store Connect does not accept for the
+    display type (either orientation)
+  - color-space: grayscale or CMYK images (RGB is required)
+  - alpha-channel: PNGs with transparency
+  - too-many-screenshots: more than 10 screenshots in a set
+
+The command exits non-zero when any issue is found. Sync runs the same
+checks before it uploads anything.
+
+Examples:
+  asc assets screenshots validate --dir "./screenshots"
+  asc assets screenshots validate --dir "./screenshots" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+
+			localSets, err := readScreenshotSyncDir(dirValue)
+			if err != nil {
+				return fmt.Errorf("assets screenshots validate: %w", err)
+			}
+
+			result := &asc.ScreenshotValidateResult{
+				Dir:    dirValue,
+				Sets:   len(localSets),
+				Issues: validateScreenshotSets(localSets),
+			}
+			for _, set := range localSets {
+				result.Files += len(set.files)
+			}
+			result.Valid = len(result.Issues) == 0
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if !result.Valid {
+				return shared.NewReportedError(fmt.Errorf("assets screenshots validate: found %d issue(s)", len(result.Issues)))
+			}
+			return nil
+		},
+	}
+}
+
+// validateScreenshotSets checks every set and file and returns the issues in
+// directory order.
+func validateScreenshotSets(sets []localScreenshotSet) []asc.ScreenshotValidationIssue {
+	issues := make([]asc.ScreenshotValidationIssue, 0)
+	for _, set := range sets {
+		issue := func(file, rule, message string) {
+			issues = append(issues, asc.ScreenshotValidationIssue{
+				Locale:      set.locale,
+				DisplayType: set.displayType,
+				File:        file,
+				Rule:        rule,
+				Message:     message,
+			})
+		}
+		for _, name := range set.unsupported {
+			issue(name, screenshotRuleFormat, fmt.Sprintf("%s is not a PNG or JPEG file", name))
+		}
+		if len(set.files) > asc.MaxScreenshotsPerSet {
+			issue("", screenshotRuleCount, fmt.Sprintf("%d screenshots, at most %d are allowed per set", len(set.files), asc.MaxScreenshotsPerSet))
+		}
+		for _, file := range set.files {
+			rule, message := validateScreenshotFile(file.path, set.displayType)
+			if rule != "" {
+				issue(file.name, rule, message)
+			}
+		}
+	}
+	return issues
+}
+
+// validateScreenshotFile checks one image file and returns the first rule it
+// breaks, or empty strings when the file is acceptable.
+func validateScreenshotFile(path, displayType string) (string, string) {
+	name := filepath.Base(path)
+	file, err := os.Open(path)
+	if err != nil {
+		return screenshotRuleFormat, fmt.Sprintf("%s: %v", name, err)
+	}
+	defer file.Close()
+
+	config, format, err := image.DecodeConfig(file)
+	if err != nil {
+		return screenshotRuleFormat, fmt.Sprintf("%s is not a readable PNG or JPEG image", name)
+	}
+
+	wantFormat := "png"
+	if ext := strings.ToLower(filepath.Ext(name)); ext == ".jpg" || ext == ".jpeg" {
+		wantFormat = "jpeg"
+	}
+	if format != wantFormat {
+		return screenshotRuleExtension, fmt.Sprintf("%s has a %s extension but contains %s data", name, strings.ToUpper(wantFormat), strings.ToUpper(format))
+	}
+
+	switch config.ColorModel {
+	case color.GrayModel, color.Gray16Model:
+		return screenshotRuleColorSpace, fmt.Sprintf("%s is grayscale; screenshots must be RGB", name)
+	case color.CMYKModel:
+		return screenshotRuleColorSpace, fmt.Sprintf("%s is CMYK; screenshots must be RGB", name)
+	case color.NRGBAModel, color.NRGBA64Model:
+		return screenshotRuleAlpha, fmt.Sprintf("%s has an alpha channel; flatten it before uploading", name)
+	}
+
+	if !asc.IsAcceptedScreenshotSize(displayType, config.Width, config.Height) {
+		accepted := make([]string, 0)
+		for _, size := range asc.ScreenshotSizesForDisplayType(displayType) {
+			accepted = append(accepted, fmt.Sprintf("%dx%d", size.Width, size.Height))
+		}
+		return screenshotRuleDimensions, fmt.Sprintf("%s is %dx%d; %s accepts %s", name, config.Width, config.Height, displayType, strings.Join(accepted, ", "))
+	}
+	return "", ""
+}
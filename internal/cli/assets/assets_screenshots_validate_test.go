@@ -0,0 +1,90 @@
+package assets
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeValidateTestImage fills img with an opaque color and encodes it to
+// path. With translucent, one pixel is made translucent so that the PNG
+// encoder keeps the alpha channel.
+func writeValidateTestImage(t *testing.T, path string, img draw.Image, asJPEG, translucent bool) {
+	t.Helper()
+
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{R: 10, G: 20, B: 30, A: 255}), image.Point{}, draw.Src)
+	if translucent {
+		img.Set(0, 0, color.NRGBA{A: 10})
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer file.Close()
+	if asJPEG {
+		err = jpeg.Encode(file, img, nil)
+	} else {
+		err = png.Encode(file, img)
+	}
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+}
+
+func TestValidateScreenshotFile(t *testing.T) {
+	dir := t.TempDir()
+	watch := image.Rect(0, 0, 368, 448)
+	tests := []struct {
+		name        string
+		img         draw.Image
+		asJPEG      bool
+		translucent bool
+		wantRule    string
+	}{
+		{name: "valid.png", img: image.NewRGBA(watch)},
+		{name: "landscape.png", img: image.NewRGBA(image.Rect(0, 0, 448, 368))},
+		{name: "valid.jpg", img: image.NewRGBA(watch), asJPEG: true},
+		{name: "gray.png", img: image.NewGray(watch), wantRule: screenshotRuleColorSpace},
+		{name: "alpha.png", img: image.NewNRGBA(watch), translucent: true, wantRule: screenshotRuleAlpha},
+		{name: "mislabeled.png", img: image.NewRGBA(watch), asJPEG: true, wantRule: screenshotRuleExtension},
+		{name: "small.png", img: image.NewRGBA(image.Rect(0, 0, 300, 400)), wantRule: screenshotRuleDimensions},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(dir, test.name)
+			writeValidateTestImage(t, path, test.img, test.asJPEG, test.translucent)
+
+			rule, message := validateScreenshotFile(path, "APP_WATCH_SERIES_4")
+			if rule != test.wantRule {
+				t.Fatalf("rule = %q (%s), want %q", rule, message, test.wantRule)
+			}
+		})
+	}
+}
+
+func TestValidateScreenshotSetsCountAndFormat(t *testing.T) {
+	dir := t.TempDir()
+	set := localScreenshotSet{locale: "en-US", displayType: "APP_WATCH_SERIES_4", unsupported: []string{"shot.heic"}}
+	for i := 1; i <= 11; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("%02d.png", i))
+		writeValidateTestImage(t, path, image.NewRGBA(image.Rect(0, 0, 368, 448)), false, false)
+		set.files = append(set.files, localScreenshotFile{name: filepath.Base(path), path: path})
+	}
+
+	issues := validateScreenshotSets([]localScreenshotSet{set})
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues, got %+v", issues)
+	}
+	if issues[0].Rule != screenshotRuleFormat || issues[0].File != "shot.heic" {
+		t.Fatalf("unexpected first issue: %+v", issues[0])
+	}
+	if issues[1].Rule != screenshotRuleCount {
+		t.Fatalf("unexpected second issue: %+v", issues[1])
+	}
+}
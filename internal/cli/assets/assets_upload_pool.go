@@ -0,0 +1,112 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// assetUploadJob is one file to upload into a set.
+type assetUploadJob struct {
+	setID    string
+	filePath string
+}
+
+// assetUploadFunc uploads one file and returns its result.
+type assetUploadFunc func(ctx context.Context, job assetUploadJob) (asc.AssetUploadResultItem, error)
+
+// uploadAssetsConcurrently runs upload for every job with at most concurrency
+// uploads in flight and reports aggregate progress on stderr. Results are
+// returned in job order. The first failure stops jobs that have not started.
+func uploadAssetsConcurrently(ctx context.Context, jobs []assetUploadJob, concurrency int, upload assetUploadFunc) ([]asc.AssetUploadResultItem, error) {
+	results := make([]asc.AssetUploadResultItem, len(jobs))
+	if len(jobs) == 0 {
+		return results, nil
+	}
+	if concurrency > len(jobs) {
+		concurrency = len(jobs)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var firstErr error
+	done := 0
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				if ctx.Err() != nil {
+					return
+				}
+				item, err := upload(ctx, jobs[index])
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = fmt.Errorf("%s: %w", filepath.Base(jobs[index].filePath), err)
+						cancel()
+					}
+				} else {
+					results[index] = item
+					done++
+					if shared.ProgressEnabled() {
+						fmt.Fprintf(os.Stderr, "Uploaded %d/%d: %s\n", done, len(jobs), filepath.Base(jobs[index].filePath))
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+sendLoop:
+	for index := range jobs {
+		select {
+		case <-ctx.Done():
+			break sendLoop
+		case indexes <- index:
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// uploadedOrder returns the set order that keeps the existing assets in place
+// and puts the uploaded ones after them in upload order, and whether it differs
+// from current. Concurrent uploads can land in any order, so this restores
+// the order the files were given in.
+func uploadedOrder(current []string, uploaded []asc.AssetUploadResultItem) ([]string, bool) {
+	isUploaded := make(map[string]bool, len(uploaded))
+	for _, item := range uploaded {
+		isUploaded[item.AssetID] = true
+	}
+	desired := make([]string, 0, len(current))
+	for _, id := range current {
+		if !isUploaded[id] {
+			desired = append(desired, id)
+		}
+	}
+	for _, item := range uploaded {
+		desired = append(desired, item.AssetID)
+	}
+	return desired, strings.Join(desired, ",") != strings.Join(current, ",")
+}
@@ -0,0 +1,83 @@
+package assets
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestUploadAssetsConcurrentlyKeepsJobOrder(t *testing.T) {
+	jobs := []assetUploadJob{
+		{setID: "SET", filePath: "/tmp/01.png"},
+		{setID: "SET", filePath: "/tmp/02.png"},
+		{setID: "SET", filePath: "/tmp/03.png"},
+		{setID: "SET", filePath: "/tmp/04.png"},
+	}
+	var inFlight, maxInFlight int32
+	results, err := uploadAssetsConcurrently(context.Background(), jobs, 2, func(ctx context.Context, job assetUploadJob) (asc.AssetUploadResultItem, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		// Finish later jobs first so completion order differs from job order.
+		if strings.HasSuffix(job.filePath, "01.png") {
+			time.Sleep(20 * time.Millisecond)
+		}
+		return asc.AssetUploadResultItem{FilePath: job.filePath, AssetID: "ID_" + job.filePath[len(job.filePath)-6:len(job.filePath)-4]}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 uploads in flight, got %d", maxInFlight)
+	}
+	var ids []string
+	for _, item := range results {
+		ids = append(ids, item.AssetID)
+	}
+	if got := strings.Join(ids, ","); got != "ID_01,ID_02,ID_03,ID_04" {
+		t.Fatalf("expected results in job order, got %s", got)
+	}
+}
+
+func TestUploadAssetsConcurrentlyStopsOnError(t *testing.T) {
+	jobs := []assetUploadJob{
+		{setID: "SET", filePath: "/tmp/bad.png"},
+		{setID: "SET", filePath: "/tmp/02.png"},
+		{setID: "SET", filePath: "/tmp/03.png"},
+	}
+	var calls int32
+	_, err := uploadAssetsConcurrently(context.Background(), jobs, 1, func(ctx context.Context, job assetUploadJob) (asc.AssetUploadResultItem, error) {
+		atomic.AddInt32(&calls, 1)
+		return asc.AssetUploadResultItem{}, errors.New("upload failed")
+	})
+	if err == nil || !strings.Contains(err.Error(), "bad.png: upload failed") {
+		t.Fatalf("expected error naming the file, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected remaining jobs to be skipped, got %d calls", calls)
+	}
+}
+
+func TestUploadedOrder(t *testing.T) {
+	uploaded := []asc.AssetUploadResultItem{{AssetID: "NEW_1"}, {AssetID: "NEW_2"}}
+
+	order, changed := uploadedOrder([]string{"OLD", "NEW_2", "NEW_1"}, uploaded)
+	if !changed || strings.Join(order, ",") != "OLD,NEW_1,NEW_2" {
+		t.Fatalf("expected OLD,NEW_1,NEW_2 (changed), got %v (%v)", order, changed)
+	}
+
+	order, changed = uploadedOrder([]string{"OLD", "NEW_1", "NEW_2"}, uploaded)
+	if changed || strings.Join(order, ",") != "OLD,NEW_1,NEW_2" {
+		t.Fatalf("expected unchanged order, got %v (%v)", order, changed)
+	}
+}
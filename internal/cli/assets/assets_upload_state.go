@@ -0,0 +1,59 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// assetStateAwaitingUpload is the delivery state of a reservation whose file
+// has not been committed yet.
+const assetStateAwaitingUpload = "AWAITING_UPLOAD"
+
+// assetReservation creates a new upload reservation and returns its ID and
+// upload operations.
+type assetReservation func() (string, []asc.UploadOperation, error)
+
+// assetDeliveryLookup fetches the delivery state of a reserved asset.
+type assetDeliveryLookup func(ctx context.Context, id string) (*asc.AssetDeliveryState, error)
+
+// reserveAssetUpload returns the upload state for uploading filePath into
+// setID. With resume, a saved reservation that App Store Connect still awaits
+// is reused so only its remaining chunks are uploaded; otherwise reserve is
+// called and its operations are recorded.
+func reserveAssetUpload(ctx context.Context, kind, setID, filePath string, info os.FileInfo, resume bool, lookup assetDeliveryLookup, reserve assetReservation) (*asc.UploadState, error) {
+	statePath, err := shared.UploadStatePath(kind, setID, filePath)
+	if err != nil {
+		return nil, err
+	}
+	state, err := shared.ResumableUploadState(statePath, info, resume)
+	if err != nil {
+		return nil, err
+	}
+	if state != nil {
+		delivery, err := lookup(ctx, state.ReservationID)
+		if err == nil && (delivery == nil || delivery.State == assetStateAwaitingUpload) {
+			return state, nil
+		}
+		fmt.Fprintf(os.Stderr, "Warning: %s %s can no longer be resumed; starting over\n", kind, state.ReservationID)
+		if err := state.Remove(); err != nil {
+			return nil, err
+		}
+	}
+
+	id, operations, err := reserve()
+	if err != nil {
+		return nil, err
+	}
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("no upload operations returned for %q", info.Name())
+	}
+	state = asc.NewUploadState(statePath, id, info, operations)
+	if err := state.Save(); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
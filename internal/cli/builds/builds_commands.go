@@ -29,6 +29,7 @@ func BuildsUploadCommand() *ffcli.Command {
 	dryRun := fs.Bool("dry-run", false, "Reserve upload operations without uploading the file")
 	concurrency := fs.Int("concurrency", 1, "Upload concurrency (default 1)")
 	verifyChecksum := fs.Bool("checksum", false, "Verify upload checksums if provided by API")
+	resume := fs.Bool("resume", false, "Resume an interrupted upload from its last completed chunk")
 	testNotes := fs.String("test-notes", "", "What to Test notes (requires build processing)")
 	locale := fs.String("locale", "", "Locale for --test-notes (e.g., en-US)")
 	wait := fs.Bool("wait", false, "Wait for build processing to complete")
@@ -48,10 +49,15 @@ the file. Use --dry-run to only reserve the upload operations.
 Use --ipa for iOS, tvOS, and visionOS apps. Use --pkg for macOS apps.
 When using --pkg, the platform is automatically set to MAC_OS.
 
+Progress is saved after every chunk under ~/.asc/uploads. If an upload is
+interrupted, rerun the same command with --resume to reuse the reservation
+and upload only the remaining chunks.
+
 Examples:
   asc builds upload --app "123456789" --ipa "path/to/app.ipa"
   asc builds upload --ipa "app.ipa" --version "1.0.0" --build-number "123"
   asc builds upload --app "123456789" --ipa "app.ipa" --dry-run
+  asc builds upload --app "123456789" --ipa "app.ipa" --resume
   asc builds upload --app "123456789" --ipa "app.ipa" --test-notes "Test flow" --locale "en-US" --wait
   asc builds upload --app "123456789" --pkg "path/to/app.pkg" --version "1.0.0" --build-number "123"`,
 		FlagSet:   fs,
@@ -135,6 +141,9 @@ Examples:
 				if *wait {
 					return fmt.Errorf("builds upload: --wait is not supported with --dry-run")
 				}
+				if *resume {
+					return fmt.Errorf("builds upload: --resume is not supported with --dry-run")
+				}
 			} else if *concurrency < 1 {
 				return fmt.Errorf("builds upload: --concurrency must be at least 1")
 			}
@@ -221,54 +230,54 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
 			defer cancel()
 
-			// Step 1: Create build upload record
-			uploadReq := asc.BuildUploadCreateRequest{
-				Data: asc.BuildUploadCreateData{
-					Type: asc.ResourceTypeBuildUploads,
-					Attributes: asc.BuildUploadAttributes{
-						CFBundleShortVersionString: versionValue,
-						CFBundleVersion:            buildNumberValue,
-						Platform:                   platformValue,
-					},
-					Relationships: &asc.BuildUploadRelationships{
-						App: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: resolvedAppID},
-						},
-					},
-				},
+			// Resume a saved reservation when asked and the file has not been
+			// committed yet; otherwise start a new one.
+			var upload *asc.UploadState
+			var statePath string
+			var uploadID string
+			var fileResp *asc.BuildUploadFileResponse
+			if !*dryRun {
+				target := strings.Join([]string{resolvedAppID, versionValue, buildNumberValue, string(platformValue)}, "/")
+				statePath, err = shared.UploadStatePath("build", target, filePath)
+				if err != nil {
+					return fmt.Errorf("builds upload: %w", err)
+				}
+				upload, err = shared.ResumableUploadState(statePath, fileInfo, *resume)
+				if err != nil {
+					return fmt.Errorf("builds upload: %w", err)
+				}
 			}
-
-			uploadResp, err := client.CreateBuildUpload(requestCtx, uploadReq)
-			if err != nil {
-				return fmt.Errorf("builds upload: failed to create upload record: %w", err)
-			}
-
-			// Step 2: Create build upload file reservation
-			fileReq := asc.BuildUploadFileCreateRequest{
-				Data: asc.BuildUploadFileCreateData{
-					Type: asc.ResourceTypeBuildUploadFiles,
-					Attributes: asc.BuildUploadFileAttributes{
-						FileName:  fileInfo.Name(),
-						FileSize:  fileInfo.Size(),
-						UTI:       fileUTI,
-						AssetType: asc.AssetTypeAsset,
-					},
-					Relationships: &asc.BuildUploadFileRelationships{
-						BuildUpload: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: uploadResp.Data.ID},
-						},
-					},
-				},
+			if upload != nil {
+				resp, err := client.GetBuildUploadFile(requestCtx, upload.ReservationID)
+				if err == nil && (resp.Data.Attributes.Uploaded == nil || !*resp.Data.Attributes.Uploaded) {
+					fileResp = resp
+					uploadID = upload.ParentID
+				} else {
+					fmt.Fprintf(os.Stderr, "Warning: build upload file %s can no longer be resumed; starting over\n", upload.ReservationID)
+					if err := upload.Remove(); err != nil {
+						return fmt.Errorf("builds upload: %w", err)
+					}
+					upload = nil
+				}
 			}
 
-			fileResp, err := client.CreateBuildUploadFile(requestCtx, fileReq)
-			if err != nil {
-				return fmt.Errorf("builds upload: failed to create file reservation: %w", err)
+			if fileResp == nil {
+				fileResp, uploadID, err = createBuildUploadReservation(requestCtx, client, resolvedAppID, versionValue, buildNumberValue, platformValue, fileInfo, fileUTI)
+				if err != nil {
+					return fmt.Errorf("builds upload: %w", err)
+				}
+				if !*dryRun && len(fileResp.Data.Attributes.UploadOperations) > 0 {
+					upload = asc.NewUploadState(statePath, fileResp.Data.ID, fileInfo, fileResp.Data.Attributes.UploadOperations)
+					upload.ParentID = uploadID
+					if err := upload.Save(); err != nil {
+						return fmt.Errorf("builds upload: %w", err)
+					}
+				}
 			}
 
 			// Return upload info including presigned URL operations
 			result := &asc.BuildUploadResult{
-				UploadID:   uploadResp.Data.ID,
+				UploadID:   uploadID,
 				FileID:     fileResp.Data.ID,
 				FileName:   fileResp.Data.Attributes.FileName,
 				FileSize:   fileResp.Data.Attributes.FileSize,
@@ -276,15 +285,16 @@ Examples:
 			}
 
 			if !*dryRun {
-				if len(fileResp.Data.Attributes.UploadOperations) == 0 {
+				if upload == nil {
 					return fmt.Errorf("builds upload: no upload operations returned")
 				}
 
 				uploadOpts := []asc.UploadOption{
 					asc.WithUploadConcurrency(*concurrency),
+					asc.WithUploadState(upload),
 				}
 				uploadCtx, uploadCancel := shared.ContextWithUploadTimeout(ctx)
-				err = asc.ExecuteUploadOperations(uploadCtx, filePath, fileResp.Data.Attributes.UploadOperations, uploadOpts...)
+				err = asc.ExecuteUploadOperations(uploadCtx, filePath, upload.Operations, uploadOpts...)
 				uploadCancel()
 				if err != nil {
 					return fmt.Errorf("builds upload: upload failed: %w", err)
@@ -325,6 +335,9 @@ Examples:
 				if err != nil {
 					return fmt.Errorf("builds upload: failed to commit upload: %w", err)
 				}
+				if err := upload.Remove(); err != nil {
+					return fmt.Errorf("builds upload: %w", err)
+				}
 
 				if commitResp != nil && commitResp.Data.Attributes.Uploaded != nil {
 					result.Uploaded = commitResp.Data.Attributes.Uploaded
@@ -364,6 +377,56 @@ Examples:
 	}
 }
 
+// createBuildUploadReservation creates the build upload record and its file
+// reservation, returning the reservation and the build upload ID.
+func createBuildUploadReservation(ctx context.Context, client *asc.Client, appID, version, buildNumber string, platform asc.Platform, fileInfo os.FileInfo, fileUTI asc.UTI) (*asc.BuildUploadFileResponse, string, error) {
+	// Step 1: Create build upload record
+	uploadReq := asc.BuildUploadCreateRequest{
+		Data: asc.BuildUploadCreateData{
+			Type: asc.ResourceTypeBuildUploads,
+			Attributes: asc.BuildUploadAttributes{
+				CFBundleShortVersionString: version,
+				CFBundleVersion:            buildNumber,
+				Platform:                   platform,
+			},
+			Relationships: &asc.BuildUploadRelationships{
+				App: &asc.Relationship{
+					Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: appID},
+				},
+			},
+		},
+	}
+
+	uploadResp, err := client.CreateBuildUpload(ctx, uploadReq)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create upload record: %w", err)
+	}
+
+	// Step 2: Create build upload file reservation
+	fileReq := asc.BuildUploadFileCreateRequest{
+		Data: asc.BuildUploadFileCreateData{
+			Type: asc.ResourceTypeBuildUploadFiles,
+			Attributes: asc.BuildUploadFileAttributes{
+				FileName:  fileInfo.Name(),
+				FileSize:  fileInfo.Size(),
+				UTI:       fileUTI,
+				AssetType: asc.AssetTypeAsset,
+			},
+			Relationships: &asc.BuildUploadFileRelationships{
+				BuildUpload: &asc.Relationship{
+					Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: uploadResp.Data.ID},
+				},
+			},
+		},
+	}
+
+	fileResp, err := client.CreateBuildUploadFile(ctx, fileReq)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create file reservation: %w", err)
+	}
+	return fileResp, uploadResp.Data.ID, nil
+}
+
 // BuildsCommand returns the builds command with subcommands
 func BuildsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("builds", flag.ExitOnError)
@@ -27,13 +27,17 @@ func BundleIDsCapabilitiesCommand() *ffcli.Command {
 Examples:
   asc bundle-ids capabilities list --bundle "BUNDLE_ID"
   asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD
-  asc bundle-ids capabilities remove --id "CAPABILITY_ID" --confirm`,
+  asc bundle-ids capabilities remove --id "CAPABILITY_ID" --confirm
+  asc bundle-ids capabilities enable --bundle-id "com.example.app" --capability PUSH_NOTIFICATIONS
+  asc bundle-ids capabilities disable --bundle-id "com.example.app" --capability PUSH_NOTIFICATIONS --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			BundleIDsCapabilitiesListCommand(),
 			BundleIDsCapabilitiesAddCommand(),
 			BundleIDsCapabilitiesRemoveCommand(),
+			BundleIDsCapabilitiesEnableCommand(),
+			BundleIDsCapabilitiesDisableCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -117,6 +121,9 @@ func BundleIDsCapabilitiesAddCommand() *ffcli.Command {
 	bundleID := fs.String("bundle", "", "Bundle ID")
 	capability := fs.String("capability", "", "Capability type (e.g., ICLOUD, IN_APP_PURCHASE)")
 	settings := fs.String("settings", "", "Capability settings as JSON array (optional)")
+	settingsFile := fs.String("settings-file", "", "Path to a JSON file with the capability settings array (optional)")
+	var settingFlags capabilitySettingFlags
+	fs.Var(&settingFlags, "setting", "Enable setting options: KEY=OPTION[,OPTION] (repeatable)")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -128,7 +135,9 @@ func BundleIDsCapabilitiesAddCommand() *ffcli.Command {
 
 Examples:
   asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD
-  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD --settings '[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_13","enabled":true}]}]'`,
+  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD --settings '[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_13","enabled":true}]}]'
+  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD --setting ICLOUD_VERSION=XCODE_6
+  asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability DATA_PROTECTION --settings-file "./data-protection.json"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -143,7 +152,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			settingsValue, err := parseCapabilitySettings(*settings)
+			settingsValue, err := resolveCapabilitySettings(*settings, *settingsFile, settingFlags)
 			if err != nil {
 				return fmt.Errorf("bundle-ids capabilities add: %w", err)
 			}
@@ -222,6 +231,231 @@ Examples:
 	}
 }
 
+// BundleIDsCapabilitiesEnableCommand returns the bundle IDs capabilities enable subcommand.
+func BundleIDsCapabilitiesEnableCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("enable", flag.ExitOnError)
+
+	bundleID := fs.String("bundle-id", "", "Bundle ID resource ID or identifier (e.g., com.example.app)")
+	capability := fs.String("capability", "", "Capability type (e.g., PUSH_NOTIFICATIONS, ICLOUD)")
+	settings := fs.String("settings", "", "Capability settings as JSON array (optional)")
+	settingsFile := fs.String("settings-file", "", "Path to a JSON file with the capability settings array (optional)")
+	var settingFlags capabilitySettingFlags
+	fs.Var(&settingFlags, "setting", "Enable setting options: KEY=OPTION[,OPTION] (repeatable)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "enable",
+		ShortUsage: "asc bundle-ids capabilities enable --bundle-id BUNDLE_ID --capability CAPABILITY_TYPE [flags]",
+		ShortHelp:  "Enable a capability on a bundle ID if it is not enabled yet.",
+		LongHelp: `Enable a capability on a bundle ID if it is not enabled yet.
+
+Unlike add, enable is safe to re-run from provisioning scripts: when the
+capability is already enabled nothing changes, unless settings are given,
+in which case the existing capability's settings are updated. --bundle-id
+accepts the bundle ID resource ID or its identifier.
+
+Settings come from a JSON array (--settings or --settings-file) in the
+bundleIdCapabilities API format, and --setting KEY=OPTION adds enabled
+options on top. Apple's API only accepts the setting keys it documents
+(e.g., ICLOUD_VERSION, DATA_PROTECTION_PERMISSION_LEVEL,
+APPLE_ID_AUTH_APP_CONSENT); App Group, iCloud container, and merchant ID
+assignments are not exposed by the API.
+
+Examples:
+  asc bundle-ids capabilities enable --bundle-id "com.example.app" --capability PUSH_NOTIFICATIONS
+  asc bundle-ids capabilities enable --bundle-id "BUNDLE_ID" --capability ICLOUD --setting ICLOUD_VERSION=XCODE_6
+  asc bundle-ids capabilities enable --bundle-id "BUNDLE_ID" --capability DATA_PROTECTION --setting DATA_PROTECTION_PERMISSION_LEVEL=COMPLETE_PROTECTION
+  asc bundle-ids capabilities enable --bundle-id "BUNDLE_ID" --capability ICLOUD --settings-file "./icloud.json"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			bundleValue := strings.TrimSpace(*bundleID)
+			if bundleValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --bundle-id is required")
+				return flag.ErrHelp
+			}
+			capabilityValue := strings.ToUpper(strings.TrimSpace(*capability))
+			if capabilityValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --capability is required")
+				return flag.ErrHelp
+			}
+
+			settingsValue, err := resolveCapabilitySettings(*settings, *settingsFile, settingFlags)
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities enable: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities enable: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resourceID, err := resolveBundleIDResourceID(requestCtx, client, bundleValue)
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities enable: %w", err)
+			}
+			existing, err := findBundleIDCapability(requestCtx, client, resourceID, capabilityValue)
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities enable: %w", err)
+			}
+
+			result := &asc.BundleIDCapabilityStateResult{
+				BundleID:       resourceID,
+				CapabilityType: capabilityValue,
+				Enabled:        true,
+			}
+			switch {
+			case existing == nil:
+				resp, err := client.CreateBundleIDCapability(requestCtx, resourceID, asc.BundleIDCapabilityCreateAttributes{
+					CapabilityType: capabilityValue,
+					Settings:       settingsValue,
+				})
+				if err != nil {
+					return fmt.Errorf("bundle-ids capabilities enable: failed to create: %w", err)
+				}
+				result.CapabilityID = resp.Data.ID
+				result.Changed = true
+			case len(settingsValue) > 0:
+				resp, err := client.UpdateBundleIDCapability(requestCtx, existing.ID, asc.BundleIDCapabilityUpdateAttributes{
+					CapabilityType: capabilityValue,
+					Settings:       settingsValue,
+				})
+				if err != nil {
+					return fmt.Errorf("bundle-ids capabilities enable: failed to update: %w", err)
+				}
+				result.CapabilityID = resp.Data.ID
+				result.Changed = true
+			default:
+				result.CapabilityID = existing.ID
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// BundleIDsCapabilitiesDisableCommand returns the bundle IDs capabilities disable subcommand.
+func BundleIDsCapabilitiesDisableCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("disable", flag.ExitOnError)
+
+	bundleID := fs.String("bundle-id", "", "Bundle ID resource ID or identifier (e.g., com.example.app)")
+	capability := fs.String("capability", "", "Capability type (e.g., PUSH_NOTIFICATIONS, ICLOUD)")
+	confirm := fs.Bool("confirm", false, "Confirm disabling the capability")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "disable",
+		ShortUsage: "asc bundle-ids capabilities disable --bundle-id BUNDLE_ID --capability CAPABILITY_TYPE --confirm",
+		ShortHelp:  "Disable a capability on a bundle ID if it is enabled.",
+		LongHelp: `Disable a capability on a bundle ID if it is enabled.
+
+Looks up the capability by type, so no capability ID is needed, and does
+nothing when the capability is not enabled. Profiles for the bundle ID must
+be regenerated after disabling a capability. --bundle-id accepts the bundle
+ID resource ID or its identifier.
+
+Examples:
+  asc bundle-ids capabilities disable --bundle-id "com.example.app" --capability PUSH_NOTIFICATIONS --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			bundleValue := strings.TrimSpace(*bundleID)
+			if bundleValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --bundle-id is required")
+				return flag.ErrHelp
+			}
+			capabilityValue := strings.ToUpper(strings.TrimSpace(*capability))
+			if capabilityValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --capability is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities disable: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resourceID, err := resolveBundleIDResourceID(requestCtx, client, bundleValue)
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities disable: %w", err)
+			}
+			existing, err := findBundleIDCapability(requestCtx, client, resourceID, capabilityValue)
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities disable: %w", err)
+			}
+
+			result := &asc.BundleIDCapabilityStateResult{
+				BundleID:       resourceID,
+				CapabilityType: capabilityValue,
+			}
+			if existing != nil {
+				if err := client.DeleteBundleIDCapability(requestCtx, existing.ID); err != nil {
+					return fmt.Errorf("bundle-ids capabilities disable: failed to delete: %w", err)
+				}
+				result.CapabilityID = existing.ID
+				result.Changed = true
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// resolveBundleIDResourceID returns the resource ID for a bundle ID given
+// either its resource ID or its identifier (anything containing a dot).
+func resolveBundleIDResourceID(ctx context.Context, client *asc.Client, value string) (string, error) {
+	if !strings.Contains(value, ".") {
+		return value, nil
+	}
+	resp, err := client.GetBundleIDs(ctx, asc.WithBundleIDsFilterIdentifier(value))
+	if err != nil {
+		return "", fmt.Errorf("failed to look up bundle ID %s: %w", value, err)
+	}
+	for _, item := range resp.Data {
+		if item.Attributes.Identifier == value {
+			return item.ID, nil
+		}
+	}
+	return "", fmt.Errorf("bundle ID not found: %s", value)
+}
+
+// findBundleIDCapability returns the bundle ID's capability of the given type,
+// or nil when it is not enabled.
+func findBundleIDCapability(ctx context.Context, client *asc.Client, bundleID, capabilityType string) (*asc.Resource[asc.BundleIDCapabilityAttributes], error) {
+	firstPage, err := client.GetBundleIDCapabilities(ctx, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch capabilities: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetBundleIDCapabilities(ctx, bundleID, asc.WithBundleIDCapabilitiesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate capabilities: %w", err)
+	}
+	capabilities, ok := paginated.(*asc.BundleIDCapabilitiesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected capabilities response type %T", paginated)
+	}
+	for i := range capabilities.Data {
+		if strings.EqualFold(capabilities.Data[i].Attributes.CapabilityType, capabilityType) {
+			return &capabilities.Data[i], nil
+		}
+	}
+	return nil, nil
+}
+
 func parseCapabilitySettings(value string) ([]asc.CapabilitySetting, error) {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
@@ -233,3 +467,77 @@ func parseCapabilitySettings(value string) ([]asc.CapabilitySetting, error) {
 	}
 	return settings, nil
 }
+
+// capabilitySettingFlags collects repeated --setting KEY=OPTION[,OPTION] flags.
+type capabilitySettingFlags []asc.CapabilitySetting
+
+func (f *capabilitySettingFlags) Set(value string) error {
+	key, options, ok := strings.Cut(value, "=")
+	key = strings.ToUpper(strings.TrimSpace(key))
+	optionKeys := shared.SplitCSVUpper(options)
+	if !ok || key == "" || len(optionKeys) == 0 {
+		return fmt.Errorf("must be KEY=OPTION[,OPTION]")
+	}
+	enabled := true
+	setting := asc.CapabilitySetting{Key: key}
+	for _, option := range optionKeys {
+		setting.Options = append(setting.Options, asc.CapabilityOption{Key: option, Enabled: &enabled})
+	}
+	*f = append(*f, setting)
+	return nil
+}
+
+func (f *capabilitySettingFlags) String() string {
+	pairs := make([]string, 0, len(*f))
+	for _, setting := range *f {
+		options := make([]string, 0, len(setting.Options))
+		for _, option := range setting.Options {
+			options = append(options, option.Key)
+		}
+		pairs = append(pairs, setting.Key+"="+strings.Join(options, ","))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// resolveCapabilitySettings combines the --settings JSON or --settings-file
+// payload with any --setting flags. Options of a --setting flag are merged
+// into a setting with the same key from the payload.
+func resolveCapabilitySettings(settingsJSON, settingsFile string, extra capabilitySettingFlags) ([]asc.CapabilitySetting, error) {
+	settingsJSON = strings.TrimSpace(settingsJSON)
+	settingsFile = strings.TrimSpace(settingsFile)
+	if settingsJSON != "" && settingsFile != "" {
+		return nil, fmt.Errorf("--settings and --settings-file are mutually exclusive")
+	}
+
+	var settings []asc.CapabilitySetting
+	if settingsFile != "" {
+		data, err := os.ReadFile(settingsFile)
+		if err != nil {
+			return nil, fmt.Errorf("read --settings-file: %w", err)
+		}
+		if err := json.Unmarshal(data, &settings); err != nil {
+			return nil, fmt.Errorf("--settings-file must contain a JSON array of settings: %w", err)
+		}
+	} else {
+		parsed, err := parseCapabilitySettings(settingsJSON)
+		if err != nil {
+			return nil, err
+		}
+		settings = parsed
+	}
+
+	for _, setting := range extra {
+		merged := false
+		for i := range settings {
+			if strings.EqualFold(settings[i].Key, setting.Key) {
+				settings[i].Options = append(settings[i].Options, setting.Options...)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			settings = append(settings, setting)
+		}
+	}
+	return settings, nil
+}
@@ -3,6 +3,8 @@ package bundleids
 import (
 	"context"
 	"flag"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -138,6 +140,30 @@ func TestBundleIDsCapabilitiesRemoveCommand_MissingConfirm(t *testing.T) {
 	}
 }
 
+func TestBundleIDsCapabilitiesEnableCommand_MissingCapability(t *testing.T) {
+	cmd := BundleIDsCapabilitiesEnableCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--bundle-id", "com.example.app"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --capability is missing, got %v", err)
+	}
+}
+
+func TestBundleIDsCapabilitiesDisableCommand_MissingConfirm(t *testing.T) {
+	cmd := BundleIDsCapabilitiesDisableCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--bundle-id", "com.example.app", "--capability", "PUSH_NOTIFICATIONS"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --confirm is missing, got %v", err)
+	}
+}
+
 func TestExtractBundleIDFromNextURL(t *testing.T) {
 	next := "https://api.appstoreconnect.apple.com/v1/bundleIds/bundle-123/profiles?cursor=abc"
 	got, err := extractBundleIDFromNextURL(next)
@@ -166,3 +192,54 @@ func TestExtractBundleIDFromNextURL_Invalid(t *testing.T) {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestCapabilitySettingFlags_Set(t *testing.T) {
+	var flags capabilitySettingFlags
+	if err := flags.Set("icloud_version=xcode_6,xcode_5"); err != nil {
+		t.Fatalf("Set() error: %v", err)
+	}
+	if len(flags) != 1 || flags[0].Key != "ICLOUD_VERSION" || len(flags[0].Options) != 2 {
+		t.Fatalf("unexpected settings: %+v", flags)
+	}
+	if option := flags[0].Options[1]; option.Key != "XCODE_5" || option.Enabled == nil || !*option.Enabled {
+		t.Fatalf("unexpected option: %+v", option)
+	}
+	for _, invalid := range []string{"ICLOUD_VERSION", "=XCODE_6", "ICLOUD_VERSION="} {
+		if err := flags.Set(invalid); err == nil {
+			t.Fatalf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestResolveCapabilitySettings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	if err := os.WriteFile(path, []byte(`[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_5","enabled":true}]}]`), 0o600); err != nil {
+		t.Fatalf("write settings: %v", err)
+	}
+
+	var flags capabilitySettingFlags
+	if err := flags.Set("ICLOUD_VERSION=XCODE_6"); err != nil {
+		t.Fatalf("Set() error: %v", err)
+	}
+	if err := flags.Set("DATA_PROTECTION_PERMISSION_LEVEL=COMPLETE_PROTECTION"); err != nil {
+		t.Fatalf("Set() error: %v", err)
+	}
+
+	settings, err := resolveCapabilitySettings("", path, flags)
+	if err != nil {
+		t.Fatalf("resolveCapabilitySettings() error: %v", err)
+	}
+	if len(settings) != 2 {
+		t.Fatalf("expected 2 settings, got %+v", settings)
+	}
+	if settings[0].Key != "ICLOUD_VERSION" || len(settings[0].Options) != 2 || settings[0].Options[1].Key != "XCODE_6" {
+		t.Fatalf("expected --setting options merged into the file setting, got %+v", settings[0])
+	}
+	if settings[1].Key != "DATA_PROTECTION_PERMISSION_LEVEL" {
+		t.Fatalf("unexpected second setting: %+v", settings[1])
+	}
+
+	if _, err := resolveCapabilitySettings("[]", path, nil); err == nil {
+		t.Fatal("expected error when --settings and --settings-file are both set")
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/signingkeys"
 )
 
 // CertificatesCommand returns the certificates command with subcommands.
@@ -30,18 +31,24 @@ Examples:
   asc certificates list
   asc certificates list --certificate-type IOS_DISTRIBUTION
   asc certificates get --id "CERT_ID" --include passTypeId
+  asc certificates check --expiring-within 30d
   asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"
   asc certificates update --id "CERT_ID" --activated true
   asc certificates update --id "CERT_ID" --activated false
+  asc certificates download --id "CERT_ID" --output "./dist.cer"
+  asc certificates download --id "CERT_ID" --key "./dist.key" --password "$P12_PASSWORD" --output "./dist.p12"
   asc certificates revoke --id "CERT_ID" --confirm
+  asc certificates revoke --expired-only --dry-run
   asc certificates relationships pass-type-id --id "CERT_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			CertificatesListCommand(),
 			CertificatesGetCommand(),
+			CertificatesCheckCommand(),
 			CertificatesCreateCommand(),
 			CertificatesUpdateCommand(),
+			CertificatesDownloadCommand(),
 			CertificatesRevokeCommand(),
 			CertificatesRelationshipsCommand(),
 		},
@@ -188,34 +195,79 @@ func CertificatesCreateCommand() *ffcli.Command {
 
 	certificateType := fs.String("certificate-type", "", "Certificate type (e.g., IOS_DISTRIBUTION)")
 	csrPath := fs.String("csr", "", "CSR file path")
+	generateKey := fs.String("generate-key", "", "Generate a private key and CSR locally, writing the key to this path")
+	commonName := fs.String("common-name", "asc", "Common name of the generated CSR (with --generate-key)")
+	certificateOut := fs.String("certificate-out", "", "Write the issued certificate (.cer, DER) to this path")
+	passTypeID := fs.String("pass-type-id", "", "Pass type ID resource ID to issue a Wallet pass certificate for")
+	merchantID := fs.String("merchant-id", "", "Merchant ID resource ID to issue an Apple Pay certificate for")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "create",
-		ShortUsage: "asc certificates create --certificate-type TYPE --csr ./cert.csr",
+		ShortUsage: "asc certificates create --certificate-type TYPE (--csr ./cert.csr | --generate-key ./cert.key) [--certificate-out ./cert.cer]",
 		ShortHelp:  "Create a signing certificate.",
 		LongHelp: `Create a signing certificate.
 
+Submit an existing certificate signing request with --csr, or pass
+--generate-key to create a 2048-bit RSA private key and CSR locally. The key
+is written (PEM, mode 0600) before the CSR is submitted and is never sent to
+App Store Connect. Use --certificate-out to save the issued certificate.
+
+Wallet pass certificates need --pass-type-id (the certificate type defaults
+to PASS_TYPE_ID). Apple Pay certificates need --merchant-id and an Apple Pay
+--certificate-type such as APPLE_PAY or APPLE_PAY_MERCHANT_IDENTITY.
+
 Examples:
-  asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"`,
+  asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"
+  asc certificates create --certificate-type IOS_DISTRIBUTION --generate-key "./dist.key" --certificate-out "./dist.cer"
+  asc certificates create --pass-type-id "PASS_ID" --generate-key "./pass.key" --certificate-out "./pass.cer"
+  asc certificates create --certificate-type APPLE_PAY_MERCHANT_IDENTITY --merchant-id "MERCHANT_ID" --csr "./merchant.csr"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
+			passTypeIDValue := strings.TrimSpace(*passTypeID)
+			merchantIDValue := strings.TrimSpace(*merchantID)
+			if passTypeIDValue != "" && merchantIDValue != "" {
+				fmt.Fprintln(os.Stderr, "Error: --pass-type-id and --merchant-id are mutually exclusive")
+				return flag.ErrHelp
+			}
 			certificateValue := strings.ToUpper(strings.TrimSpace(*certificateType))
+			if certificateValue == "" && passTypeIDValue != "" {
+				certificateValue = "PASS_TYPE_ID"
+			}
 			if certificateValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --certificate-type is required")
 				return flag.ErrHelp
 			}
 			csrValue := strings.TrimSpace(*csrPath)
-			if csrValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --csr is required")
+			keyPath := strings.TrimSpace(*generateKey)
+			if csrValue == "" && keyPath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --csr or --generate-key is required")
+				return flag.ErrHelp
+			}
+			if csrValue != "" && keyPath != "" {
+				fmt.Fprintln(os.Stderr, "Error: --csr and --generate-key are mutually exclusive")
 				return flag.ErrHelp
 			}
+			certificatePath := strings.TrimSpace(*certificateOut)
 
-			csrContent, err := readCSRContent(csrValue)
-			if err != nil {
-				return fmt.Errorf("certificates create: %w", err)
+			var csrContent string
+			if keyPath != "" {
+				keyPEM, content, err := signingkeys.GenerateKeyAndCSR(strings.TrimSpace(*commonName))
+				if err != nil {
+					return fmt.Errorf("certificates create: %w", err)
+				}
+				if err := writeNewFile(keyPath, keyPEM); err != nil {
+					return fmt.Errorf("certificates create: write private key: %w", err)
+				}
+				csrContent = content
+			} else {
+				content, err := readCSRContent(csrValue)
+				if err != nil {
+					return fmt.Errorf("certificates create: %w", err)
+				}
+				csrContent = content
 			}
 
 			client, err := shared.GetASCClient()
@@ -226,11 +278,33 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resp, err := client.CreateCertificate(requestCtx, csrContent, certificateValue)
+			var relationships *asc.CertificateCreateRelationships
+			switch {
+			case passTypeIDValue != "":
+				relationships = &asc.CertificateCreateRelationships{
+					PassTypeID: &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypePassTypeIds, ID: passTypeIDValue}},
+				}
+			case merchantIDValue != "":
+				relationships = &asc.CertificateCreateRelationships{
+					MerchantID: &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeMerchantIds, ID: merchantIDValue}},
+				}
+			}
+
+			resp, err := client.CreateCertificateWithRelationships(requestCtx, csrContent, certificateValue, relationships)
 			if err != nil {
 				return fmt.Errorf("certificates create: failed to create: %w", err)
 			}
 
+			if certificatePath != "" {
+				data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(resp.Data.Attributes.CertificateContent))
+				if err != nil || len(data) == 0 {
+					return fmt.Errorf("certificates create: certificate %s was created but its content could not be decoded", resp.Data.ID)
+				}
+				if err := writeNewFile(certificatePath, data); err != nil {
+					return fmt.Errorf("certificates create: certificate %s was created but writing it failed: %w", resp.Data.ID, err)
+				}
+			}
+
 			return shared.PrintOutput(resp, *output, *pretty)
 		},
 	}
@@ -292,58 +366,6 @@ Examples:
 	}
 }
 
-// CertificatesRevokeCommand returns the certificates revoke subcommand.
-func CertificatesRevokeCommand() *ffcli.Command {
-	fs := flag.NewFlagSet("revoke", flag.ExitOnError)
-
-	id := fs.String("id", "", "Certificate ID")
-	confirm := fs.Bool("confirm", false, "Confirm revocation")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
-	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
-
-	return &ffcli.Command{
-		Name:       "revoke",
-		ShortUsage: "asc certificates revoke --id \"CERT_ID\" --confirm",
-		ShortHelp:  "Revoke a signing certificate.",
-		LongHelp: `Revoke a signing certificate.
-
-Examples:
-  asc certificates revoke --id "CERT_ID" --confirm`,
-		FlagSet:   fs,
-		UsageFunc: shared.DefaultUsageFunc,
-		Exec: func(ctx context.Context, args []string) error {
-			idValue := strings.TrimSpace(*id)
-			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
-				return flag.ErrHelp
-			}
-			if !*confirm {
-				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
-				return flag.ErrHelp
-			}
-
-			client, err := shared.GetASCClient()
-			if err != nil {
-				return fmt.Errorf("certificates revoke: %w", err)
-			}
-
-			requestCtx, cancel := shared.ContextWithTimeout(ctx)
-			defer cancel()
-
-			if err := client.RevokeCertificate(requestCtx, idValue); err != nil {
-				return fmt.Errorf("certificates revoke: failed to revoke: %w", err)
-			}
-
-			result := &asc.CertificateRevokeResult{
-				ID:      idValue,
-				Revoked: true,
-			}
-
-			return shared.PrintOutput(result, *output, *pretty)
-		},
-	}
-}
-
 func readCSRContent(path string) (string, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -0,0 +1,135 @@
+package certificates
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// CertificatesCheckCommand returns the certificates check subcommand.
+func CertificatesCheckCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+
+	expiringWithin := fs.String("expiring-within", "30d", "Report certificates expiring within this window (e.g., 30d, 2w, 72h)")
+	certificateType := fs.String("certificate-type", "", "Filter by certificate type(s), comma-separated")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "check",
+		ShortUsage: "asc certificates check [--expiring-within 30d] [flags]",
+		ShortHelp:  "Report certificates that expire soon and exit non-zero when any do.",
+		LongHelp: `Report certificates that expire soon and exit non-zero when any do.
+
+Lists every certificate that has expired or expires within --expiring-within,
+with its type, serial number, expiration date, and the provisioning profiles
+that include it. The command exits non-zero when any certificate matches, so
+it can run as a scheduled CI guardrail.
+
+Examples:
+  asc certificates check
+  asc certificates check --expiring-within 60d --output table
+  asc certificates check --expiring-within 2w --certificate-type IOS_DISTRIBUTION`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			windowValue := strings.TrimSpace(*expiringWithin)
+			window, err := shared.ParseExpiryWindow(windowValue)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("certificates check: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			var opts []asc.CertificatesOption
+			if types := shared.SplitCSVUpper(*certificateType); len(types) > 0 {
+				opts = append(opts, asc.WithCertificatesTypes(types))
+			}
+			certificates, err := fetchAllCertificates(requestCtx, client, opts...)
+			if err != nil {
+				return fmt.Errorf("certificates check: %w", err)
+			}
+
+			now := time.Now().UTC()
+			result := &asc.CertificateExpiryReport{
+				ExpiringWithin: windowValue,
+				CheckedAt:      now.Format(time.RFC3339),
+				CheckedCount:   len(certificates),
+				Certificates:   expiringCertificates(certificates, now, window),
+			}
+			result.ExpiringCount = len(result.Certificates)
+
+			if result.ExpiringCount > 0 {
+				profiles, err := profilesByCertificate(requestCtx, client)
+				if err != nil {
+					return fmt.Errorf("certificates check: %w", err)
+				}
+				for i := range result.Certificates {
+					if matched, ok := profiles[result.Certificates[i].ID]; ok {
+						result.Certificates[i].Profiles = matched
+					}
+				}
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.ExpiringCount > 0 {
+				return shared.NewReportedError(fmt.Errorf("certificates check: %d certificate(s) expire within %s", result.ExpiringCount, windowValue))
+			}
+			return nil
+		},
+	}
+}
+
+// expiringCertificates returns the certificates that expire before now+window,
+// soonest first.
+func expiringCertificates(certificates []asc.Resource[asc.CertificateAttributes], now time.Time, window time.Duration) []asc.CertificateExpiryItem {
+	deadline := now.Add(window)
+	items := make([]asc.CertificateExpiryItem, 0)
+	for _, certificate := range certificates {
+		value := strings.TrimSpace(certificate.Attributes.ExpirationDate)
+		if value == "" {
+			continue
+		}
+		expires, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: certificate %s has invalid expirationDate %q: %v\n", certificate.ID, value, err)
+			continue
+		}
+		if expires.After(deadline) {
+			continue
+		}
+		items = append(items, asc.CertificateExpiryItem{
+			ID:              certificate.ID,
+			Name:            certificate.Attributes.Name,
+			CertificateType: certificate.Attributes.CertificateType,
+			SerialNumber:    certificate.Attributes.SerialNumber,
+			ExpirationDate:  value,
+			DaysRemaining:   int(math.Floor(expires.Sub(now).Hours() / 24)),
+			Expired:         !expires.After(now),
+			Profiles:        []asc.CertificateProfileSummary{},
+		})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DaysRemaining < items[j].DaysRemaining
+	})
+	return items
+}
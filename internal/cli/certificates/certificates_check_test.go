@@ -0,0 +1,32 @@
+package certificates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestExpiringCertificates(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	certificate := func(id, expiration string) asc.Resource[asc.CertificateAttributes] {
+		return asc.Resource[asc.CertificateAttributes]{ID: id, Attributes: asc.CertificateAttributes{ExpirationDate: expiration}}
+	}
+
+	items := expiringCertificates([]asc.Resource[asc.CertificateAttributes]{
+		certificate("later", "2026-06-01T00:00:00.000+00:00"),
+		certificate("soon", "2026-03-11T12:00:00.000+00:00"),
+		certificate("expired", "2026-02-27T12:00:00.000+00:00"),
+		certificate("unknown", ""),
+	}, now, 30*24*time.Hour)
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 expiring certificates, got %+v", items)
+	}
+	if items[0].ID != "expired" || !items[0].Expired || items[0].DaysRemaining != -2 {
+		t.Fatalf("unexpected first item: %+v", items[0])
+	}
+	if items[1].ID != "soon" || items[1].Expired || items[1].DaysRemaining != 10 {
+		t.Fatalf("unexpected second item: %+v", items[1])
+	}
+}
@@ -0,0 +1,125 @@
+package certificates
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/signingkeys"
+)
+
+// CertificatesDownloadCommand returns the certificates download subcommand.
+func CertificatesDownloadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("download", flag.ExitOnError)
+
+	id := fs.String("id", "", "Certificate ID")
+	outputPath := fs.String("output", "", "Output .cer file path (or .p12 with --key)")
+	keyPath := fs.String("key", "", "Private key (PEM) to bundle with the certificate into a .p12")
+	password := fs.String("password", "", "Password for the .p12 (or "+signingkeys.P12PasswordEnvVar+")")
+	output := fs.String("output-format", "json", "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "download",
+		ShortUsage: "asc certificates download --id \"CERT_ID\" --output ./cert.cer [--key ./cert.key --password PASSWORD]",
+		ShortHelp:  "Download a certificate as .cer, or export it with its key as .p12.",
+		LongHelp: `Download a certificate as .cer, or export it with its key as .p12.
+
+Without --key the certificate is written in DER form (.cer). With --key the
+certificate and the matching private key are written to a password-protected
+PKCS#12 file that can be imported into a CI keychain. The key must be an
+unencrypted PEM key (PKCS#1, PKCS#8, or EC) and must match the certificate.
+Pass the password with --password or the ` + signingkeys.P12PasswordEnvVar + ` environment variable.
+
+Examples:
+  asc certificates download --id "CERT_ID" --output "./dist.cer"
+  ` + signingkeys.P12PasswordEnvVar + `=secret asc certificates download --id "CERT_ID" --key "./dist.key" --output "./dist.p12"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			pathValue := strings.TrimSpace(*outputPath)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --output is required")
+				return flag.ErrHelp
+			}
+			keyValue := strings.TrimSpace(*keyPath)
+			passwordValue := *password
+			if passwordValue == "" {
+				passwordValue = os.Getenv(signingkeys.P12PasswordEnvVar)
+			}
+			if keyValue == "" && *password != "" {
+				fmt.Fprintln(os.Stderr, "Error: --password requires --key")
+				return flag.ErrHelp
+			}
+			if keyValue != "" && passwordValue == "" {
+				fmt.Fprintf(os.Stderr, "Error: --password (or %s) is required with --key\n", signingkeys.P12PasswordEnvVar)
+				return flag.ErrHelp
+			}
+
+			var key crypto.PrivateKey
+			if keyValue != "" {
+				parsed, err := signingkeys.ReadPrivateKey(keyValue)
+				if err != nil {
+					return fmt.Errorf("certificates download: %w", err)
+				}
+				key = parsed
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("certificates download: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetCertificate(requestCtx, idValue)
+			if err != nil {
+				return fmt.Errorf("certificates download: failed to fetch: %w", err)
+			}
+
+			content := strings.Join(strings.Fields(resp.Data.Attributes.CertificateContent), "")
+			if content == "" {
+				return fmt.Errorf("certificates download: certificate content is empty")
+			}
+			der, err := base64.StdEncoding.DecodeString(content)
+			if err != nil {
+				return fmt.Errorf("certificates download: decode certificate: %w", err)
+			}
+
+			result := &asc.CertificateDownloadResult{
+				ID:         idValue,
+				Name:       resp.Data.Attributes.Name,
+				Format:     "cer",
+				OutputPath: pathValue,
+			}
+			data := der
+			if key != nil {
+				data, err = signingkeys.ExportPKCS12(der, key, resp.Data.Attributes.Name, passwordValue)
+				if err != nil {
+					return fmt.Errorf("certificates download: %w", err)
+				}
+				result.Format = "p12"
+			}
+
+			if err := writeNewFile(pathValue, data); err != nil {
+				return fmt.Errorf("certificates download: %w", err)
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
@@ -0,0 +1,278 @@
+package certificates
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// CertificatesRevokeCommand returns the certificates revoke subcommand.
+func CertificatesRevokeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("revoke", flag.ExitOnError)
+
+	id := fs.String("id", "", "Certificate ID(s), comma-separated")
+	idsFromFile := fs.String("ids-from-file", "", "File with certificate IDs, one per line (# comments allowed)")
+	expiredOnly := fs.Bool("expired-only", false, "Only revoke expired certificates (all expired certificates when no IDs are given)")
+	dryRun := fs.Bool("dry-run", false, "Preview certificates and the profiles they would invalidate without revoking")
+	confirm := fs.Bool("confirm", false, "Confirm revocation (required unless --dry-run)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "revoke",
+		ShortUsage: "asc certificates revoke (--id \"CERT_ID\" | --ids-from-file ids.txt | --expired-only) (--dry-run | --confirm)",
+		ShortHelp:  "Revoke one or more signing certificates.",
+		LongHelp: `Revoke one or more signing certificates.
+
+Select certificates with --id (comma-separated), --ids-from-file, or
+--expired-only. Combined with IDs, --expired-only skips certificates that
+have not expired yet; on its own it selects every expired certificate.
+
+Revocation is irreversible and invalidates every provisioning profile that
+includes the certificate. Run with --dry-run first to list the selected
+certificates and the profiles they would invalidate, then re-run with
+--confirm.
+
+Examples:
+  asc certificates revoke --id "CERT_ID" --confirm
+  asc certificates revoke --id "CERT_1,CERT_2" --dry-run
+  asc certificates revoke --ids-from-file "./certs.txt" --confirm
+  asc certificates revoke --expired-only --dry-run
+  asc certificates revoke --expired-only --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			ids := shared.SplitCSV(*id)
+			if path := strings.TrimSpace(*idsFromFile); path != "" {
+				fileIDs, err := readCertificateIDsFile(path)
+				if err != nil {
+					return fmt.Errorf("certificates revoke: %w", err)
+				}
+				ids = append(ids, fileIDs...)
+			}
+			ids = uniqueCertificateIDs(ids)
+
+			if len(ids) == 0 && !*expiredOnly {
+				fmt.Fprintln(os.Stderr, "Error: --id, --ids-from-file, or --expired-only is required")
+				return flag.ErrHelp
+			}
+			if !*dryRun && !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("certificates revoke: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			// A single certificate revoked by ID keeps the original output.
+			if len(ids) == 1 && strings.TrimSpace(*idsFromFile) == "" && !*expiredOnly && !*dryRun {
+				if err := client.RevokeCertificate(requestCtx, ids[0]); err != nil {
+					return fmt.Errorf("certificates revoke: failed to revoke: %w", err)
+				}
+				return shared.PrintOutput(&asc.CertificateRevokeResult{ID: ids[0], Revoked: true}, *output, *pretty)
+			}
+
+			certificates, err := selectCertificatesToRevoke(requestCtx, client, ids, *expiredOnly, time.Now().UTC())
+			if err != nil {
+				return fmt.Errorf("certificates revoke: %w", err)
+			}
+
+			result := &asc.CertificateRevokeAllResult{
+				DryRun:        *dryRun,
+				ExpiredOnly:   *expiredOnly,
+				SelectedCount: len(certificates),
+				Certificates:  make([]asc.CertificateRevokeAllItem, 0, len(certificates)),
+				Failures:      make([]asc.CertificateRevokeAllFailure, 0),
+			}
+
+			if *dryRun {
+				affected, err := profilesByCertificate(requestCtx, client)
+				if err != nil {
+					return fmt.Errorf("certificates revoke: %w", err)
+				}
+				for _, item := range certificates {
+					item.AffectedProfiles = affected[item.ID]
+					result.Certificates = append(result.Certificates, item)
+				}
+				return shared.PrintOutput(result, *output, *pretty)
+			}
+
+			for _, item := range certificates {
+				if err := client.RevokeCertificate(requestCtx, item.ID); err != nil {
+					result.Failures = append(result.Failures, asc.CertificateRevokeAllFailure{
+						ID:    item.ID,
+						Error: err.Error(),
+					})
+					continue
+				}
+				revoked := true
+				item.Revoked = &revoked
+				result.RevokedCount++
+				result.Certificates = append(result.Certificates, item)
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if len(result.Failures) > 0 {
+				return fmt.Errorf("certificates revoke: %d certificates failed to revoke", len(result.Failures))
+			}
+			return nil
+		},
+	}
+}
+
+// selectCertificatesToRevoke resolves the requested IDs, or every
+// certificate when none are given, and applies the expired-only filter.
+func selectCertificatesToRevoke(ctx context.Context, client *asc.Client, ids []string, expiredOnly bool, now time.Time) ([]asc.CertificateRevokeAllItem, error) {
+	var resources []asc.Resource[asc.CertificateAttributes]
+	if len(ids) > 0 {
+		for _, id := range ids {
+			resp, err := client.GetCertificate(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch certificate %s: %w", id, err)
+			}
+			resources = append(resources, resp.Data)
+		}
+	} else {
+		all, err := fetchAllCertificates(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		resources = all
+	}
+
+	items := make([]asc.CertificateRevokeAllItem, 0, len(resources))
+	for _, resource := range resources {
+		expired, err := certificateExpired(resource.Attributes.ExpirationDate, now)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: certificate %s has invalid expirationDate %q: %v\n", resource.ID, resource.Attributes.ExpirationDate, err)
+		}
+		if expiredOnly && !expired {
+			continue
+		}
+		items = append(items, asc.CertificateRevokeAllItem{
+			ID:              resource.ID,
+			Name:            resource.Attributes.Name,
+			CertificateType: resource.Attributes.CertificateType,
+			ExpirationDate:  resource.Attributes.ExpirationDate,
+			Expired:         expired,
+		})
+	}
+	return items, nil
+}
+
+func fetchAllCertificates(ctx context.Context, client *asc.Client, opts ...asc.CertificatesOption) ([]asc.Resource[asc.CertificateAttributes], error) {
+	firstPage, err := client.GetCertificates(ctx, append([]asc.CertificatesOption{asc.WithCertificatesLimit(200)}, opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch certificates: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCertificates(ctx, asc.WithCertificatesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate certificates: %w", err)
+	}
+	all, ok := paginated.(*asc.CertificatesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected certificates response type %T", paginated)
+	}
+	return all.Data, nil
+}
+
+func certificateExpired(expirationDate string, now time.Time) (bool, error) {
+	trimmed := strings.TrimSpace(expirationDate)
+	if trimmed == "" {
+		return false, nil
+	}
+	expires, err := time.Parse(time.RFC3339, trimmed)
+	if err != nil {
+		return false, err
+	}
+	return !expires.After(now), nil
+}
+
+// profilesByCertificate maps certificate IDs to the profiles that include them.
+func profilesByCertificate(ctx context.Context, client *asc.Client) (map[string][]asc.CertificateProfileSummary, error) {
+	firstPage, err := client.GetProfiles(ctx, asc.WithProfilesLimit(200), asc.WithProfilesInclude([]string{"certificates"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch profiles: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate profiles: %w", err)
+	}
+	profiles, ok := paginated.(*asc.ProfilesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected profiles response type %T", paginated)
+	}
+
+	affected := make(map[string][]asc.CertificateProfileSummary)
+	for _, profile := range profiles.Data {
+		var relationships struct {
+			Certificates struct {
+				Data []asc.ResourceData `json:"data"`
+			} `json:"certificates"`
+		}
+		if len(profile.Relationships) == 0 || json.Unmarshal(profile.Relationships, &relationships) != nil {
+			continue
+		}
+		for _, certificate := range relationships.Certificates.Data {
+			affected[certificate.ID] = append(affected[certificate.ID], asc.CertificateProfileSummary{
+				ID:          profile.ID,
+				Name:        profile.Attributes.Name,
+				ProfileType: profile.Attributes.ProfileType,
+			})
+		}
+	}
+	return affected, nil
+}
+
+// readCertificateIDsFile reads certificate IDs separated by newlines or
+// commas, skipping blank lines and # comments.
+func readCertificateIDsFile(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read --ids-from-file: %w", err)
+	}
+	var ids []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if index := strings.Index(line, "#"); index >= 0 {
+			line = line[:index]
+		}
+		ids = append(ids, shared.SplitCSV(line)...)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("--ids-from-file %s contains no certificate IDs", path)
+	}
+	return ids, nil
+}
+
+func uniqueCertificateIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
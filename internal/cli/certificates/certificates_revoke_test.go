@@ -0,0 +1,59 @@
+package certificates
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReadCertificateIDsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ids.txt")
+	content := "# expired distribution certs\nCERT_1\n\nCERT_2, CERT_3 # old team\n  CERT_1\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write ids: %v", err)
+	}
+
+	ids, err := readCertificateIDsFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"CERT_1", "CERT_2", "CERT_3"}
+	if got := uniqueCertificateIDs(ids); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestReadCertificateIDsFile_Empty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ids.txt")
+	if err := os.WriteFile(path, []byte("# nothing here\n\n"), 0o600); err != nil {
+		t.Fatalf("write ids: %v", err)
+	}
+	if _, err := readCertificateIDsFile(path); err == nil {
+		t.Fatal("expected error for a file without IDs")
+	}
+}
+
+func TestCertificateExpired(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{value: "2026-02-28T23:59:59.000+00:00", want: true},
+		{value: "2026-03-02T00:00:00Z", want: false},
+		{value: "", want: false},
+		{value: "soon", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := certificateExpired(test.value, now)
+		if (err != nil) != test.wantErr {
+			t.Fatalf("%q: unexpected error %v", test.value, err)
+		}
+		if got != test.want {
+			t.Fatalf("%q: expected %t, got %t", test.value, test.want, got)
+		}
+	}
+}
@@ -101,3 +101,51 @@ func TestCertificatesRelationshipsPassTypeIDCommand_MissingID(t *testing.T) {
 		t.Fatalf("expected flag.ErrHelp when --id is missing, got %v", err)
 	}
 }
+
+func TestCertificatesCreateCommand_CSRAndGenerateKeyExclusive(t *testing.T) {
+	cmd := CertificatesCreateCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--certificate-type", "IOS_DISTRIBUTION", "--csr", "./cert.csr", "--generate-key", "./cert.key"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --csr and --generate-key are both set, got %v", err)
+	}
+}
+
+func TestCertificatesCreateCommand_PassTypeAndMerchantExclusive(t *testing.T) {
+	cmd := CertificatesCreateCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--csr", "./cert.csr", "--pass-type-id", "PASS_ID", "--merchant-id", "MERCHANT_ID"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --pass-type-id and --merchant-id are both set, got %v", err)
+	}
+}
+
+func TestCertificatesCreateCommand_MerchantRequiresType(t *testing.T) {
+	cmd := CertificatesCreateCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--csr", "./cert.csr", "--merchant-id", "MERCHANT_ID"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --certificate-type is missing, got %v", err)
+	}
+}
+
+func TestCertificatesRevokeCommand_DryRunSkipsConfirm(t *testing.T) {
+	cmd := CertificatesRevokeCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--dry-run"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when no certificates are selected, got %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+package certificates
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// writeNewFile writes data to a new file with mode 0600, refusing to
+// overwrite an existing file or follow a symlink.
+func writeNewFile(path string, data []byte) error {
+	file, err := shared.OpenNewFileNoFollow(path, 0o600)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("output file already exists: %w", err)
+		}
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+	return file.Sync()
+}
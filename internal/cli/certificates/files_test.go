@@ -0,0 +1,25 @@
+package certificates
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteNewFile_RefusesOverwrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cert.key")
+	if err := writeNewFile(path, []byte("first")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected mode 0600, got %v", info.Mode().Perm())
+	}
+	if err := writeNewFile(path, []byte("second")); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected already exists error, got %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAgeRatingSetFromFileMergesFlagOverrides(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	filePath := filepath.Join(t.TempDir(), "age-rating.json")
+	payload := `{"data":{"type":"ageRatingDeclarations","id":"AGE_ID","attributes":{"lootBox":false,"gunsOrOtherWeapons":"infrequent_or_mild","violenceCartoonOrFantasy":"NONE"}}}`
+	if err := os.WriteFile(filePath, []byte(payload), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/ageRatingDeclarations/AGE_ID" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		var body struct {
+			Data struct {
+				Attributes map[string]any `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		attrs := body.Data.Attributes
+		if attrs["lootBox"] != true {
+			t.Fatalf("expected flag to override lootBox, got %v", attrs["lootBox"])
+		}
+		if attrs["gunsOrOtherWeapons"] != "INFREQUENT_OR_MILD" {
+			t.Fatalf("expected normalized gunsOrOtherWeapons, got %v", attrs["gunsOrOtherWeapons"])
+		}
+		if attrs["violenceCartoonOrFantasy"] != "NONE" {
+			t.Fatalf("expected violenceCartoonOrFantasy from file, got %v", attrs["violenceCartoonOrFantasy"])
+		}
+		if _, ok := attrs["gambling"]; ok {
+			t.Fatalf("expected unset attributes to be omitted, got %v", attrs)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"ageRatingDeclarations","id":"AGE_ID","attributes":{"lootBox":true}}}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"age-rating", "set", "--id", "AGE_ID", "--file", filePath, "--loot-box", "true"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	var out struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &out); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if out.Data.ID != "AGE_ID" {
+		t.Fatalf("expected AGE_ID, got %q", out.Data.ID)
+	}
+}
+
+func TestAgeRatingSetRejectsUnknownFileAttributes(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	filePath := filepath.Join(t.TempDir(), "age-rating.json")
+	if err := os.WriteFile(filePath, []byte(`{"lootBoxes":true}`), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"age-rating", "set", "--id", "AGE_ID", "--file", filePath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), `unknown field "lootBoxes"`) {
+		t.Fatalf("expected unknown field error, got %v", runErr)
+	}
+}
@@ -0,0 +1,100 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAnalyticsDownloadOutputDirDownloadsEverySegment(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	const (
+		requestID  = "11111111-1111-1111-1111-111111111111"
+		instanceID = "22222222-2222-2222-2222-222222222222"
+		segmentA   = "33333333-3333-3333-3333-333333333333"
+		segmentB   = "44444444-4444-4444-4444-444444444444"
+	)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.URL.Path == "/v1/analyticsReportRequests/"+requestID+"/reports":
+			body = `{"data":[{"type":"analyticsReports","id":"report-1","attributes":{"name":"App Sessions"}}]}`
+		case req.URL.Path == "/v1/analyticsReports/report-1/instances":
+			body = `{"data":[{"type":"analyticsReportInstances","id":"` + instanceID + `","attributes":{"granularity":"DAILY"}}]}`
+		case req.URL.Path == "/v1/analyticsReportInstances/"+instanceID+"/segments":
+			body = `{"data":[` +
+				`{"type":"analyticsReportSegments","id":"` + segmentA + `","attributes":{"url":"https://mzstatic.com/a.gz"}},` +
+				`{"type":"analyticsReportSegments","id":"` + segmentB + `","attributes":{"url":"https://mzstatic.com/b.gz"}}` +
+				`]}`
+		case req.URL.Host == "mzstatic.com":
+			body = "data-" + strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/"), ".gz")
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	dir := filepath.Join(t.TempDir(), "reports")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"analytics", "download", "--request-id", requestID, "--instance-id", instanceID, "--output-dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var result struct {
+		OutputDir string `json:"outputDir"`
+		Segments  []struct {
+			SegmentID string `json:"segmentId"`
+			FilePath  string `json:"filePath"`
+		} `json:"segments"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.OutputDir != dir || len(result.Segments) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	for _, test := range []struct {
+		segmentID string
+		want      string
+	}{
+		{segmentA, "data-a"},
+		{segmentB, "data-b"},
+	} {
+		path := filepath.Join(dir, "analytics_report_"+instanceID+"_"+test.segmentID+".csv.gz")
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read %s: %v", path, err)
+		}
+		if string(data) != test.want {
+			t.Fatalf("expected %s to contain %q, got %q", path, test.want, data)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package cmdtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAnalyticsSubscriptionReportsUseTheirTypeSubtypeAndVersion(t *testing.T) {
+	tests := []struct {
+		command     string
+		reportType  string
+		subType     string
+		defaultFile string
+	}{
+		{"subscriptions", "SUBSCRIPTION", "SUMMARY", "subscription_report_2024-01-20.tsv.gz"},
+		{"subscribers", "SUBSCRIBER", "DETAILED", "subscriber_report_2024-01-20.tsv.gz"},
+		{"subscription-events", "SUBSCRIPTION_EVENT", "SUMMARY", "subscription_event_report_2024-01-20.tsv.gz"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.command, func(t *testing.T) {
+			setupAuth(t)
+			t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+			dir := t.TempDir()
+			wd, err := os.Getwd()
+			if err != nil {
+				t.Fatalf("getwd: %v", err)
+			}
+			if err := os.Chdir(dir); err != nil {
+				t.Fatalf("chdir: %v", err)
+			}
+			t.Cleanup(func() { _ = os.Chdir(wd) })
+
+			originalTransport := http.DefaultTransport
+			t.Cleanup(func() {
+				http.DefaultTransport = originalTransport
+			})
+
+			http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				query := req.URL.Query()
+				if req.URL.Path != "/v1/salesReports" ||
+					query.Get("filter[reportType]") != test.reportType ||
+					query.Get("filter[reportSubType]") != test.subType ||
+					query.Get("filter[frequency]") != "DAILY" ||
+					query.Get("filter[version]") != "1_3" ||
+					query.Get("filter[reportDate]") != "2024-01-20" {
+					t.Fatalf("unexpected request: %s", req.URL.String())
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader(gzipReport(t, "Event Date\tQuantity\n2024-01-20\t3\n"))),
+					Header:     http.Header{"Content-Type": []string{"application/a-gzip"}},
+				}, nil
+			})
+
+			cmd := RootCommand("1.2.3")
+			cmd.FlagSet.SetOutput(io.Discard)
+			stdout, _ := captureOutput(t, func() {
+				if err := cmd.Parse([]string{"analytics", test.command, "--vendor", "12345678", "--date", "2024-01-20"}); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := cmd.Run(context.Background()); err != nil {
+					t.Fatalf("run error: %v", err)
+				}
+			})
+
+			var result struct {
+				ReportType    string `json:"reportType"`
+				ReportSubType string `json:"reportSubType"`
+				Version       string `json:"version"`
+				FilePath      string `json:"filePath"`
+			}
+			if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+				t.Fatalf("parse output: %v (%q)", err, stdout)
+			}
+			if result.ReportType != test.reportType || result.ReportSubType != test.subType || result.Version != "1_3" || result.FilePath != test.defaultFile {
+				t.Fatalf("unexpected result: %+v", result)
+			}
+			if _, err := os.Stat(filepath.Join(dir, test.defaultFile)); err != nil {
+				t.Fatalf("expected report file: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAppInfoLocalizationsValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "list missing app",
+			args:    []string{"app-info", "localizations", "list"},
+			wantErr: "Error: --app or --app-info is required",
+		},
+		{
+			name:    "get missing id and locale",
+			args:    []string{"app-info", "localizations", "get", "--app", "APP_ID"},
+			wantErr: "Error: --id or --locale is required",
+		},
+		{
+			name:    "get locale without app",
+			args:    []string{"app-info", "localizations", "get", "--locale", "en-US"},
+			wantErr: "Error: --app or --app-info is required with --locale",
+		},
+		{
+			name:    "get id and locale",
+			args:    []string{"app-info", "localizations", "get", "--id", "LOC_ID", "--locale", "en-US"},
+			wantErr: "Error: --id and --locale are mutually exclusive",
+		},
+		{
+			name:    "update without fields",
+			args:    []string{"app-info", "localizations", "update", "--id", "LOC_ID"},
+			wantErr: "Error: at least one of --name",
+		},
+		{
+			name:    "update name too long",
+			args:    []string{"app-info", "localizations", "update", "--id", "LOC_ID", "--name", strings.Repeat("n", 31)},
+			wantErr: "Error: --name is 31 characters (limit 30)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+// appInfosWithEditable returns a live app info and an editable one, so tests
+// verify that the PREPARE_FOR_SUBMISSION record is chosen.
+const appInfosWithEditable = `{"data":[
+	{"type":"appInfos","id":"INFO_LIVE","attributes":{"appStoreState":"READY_FOR_SALE"}},
+	{"type":"appInfos","id":"INFO_EDIT","attributes":{"appStoreState":"PREPARE_FOR_SUBMISSION"}}
+]}`
+
+func TestAppInfoLocalizationsListUsesEditableAppInfo(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch req.URL.Path {
+		case "/v1/apps/APP_ID/appInfos":
+			body = appInfosWithEditable
+		case "/v1/appInfos/INFO_EDIT/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"AI_EN","attributes":{"locale":"en-US","name":"My App"}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"app-info", "localizations", "list", "--app", "APP_ID"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"id":"AI_EN"`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
+
+func TestAppInfoLocalizationsUpdateByLocale(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	patchBody := ""
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/APP_ID/appInfos":
+			body = appInfosWithEditable
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appInfos/INFO_EDIT/appInfoLocalizations":
+			if got := req.URL.Query().Get("filter[locale]"); got != "de-DE" {
+				t.Fatalf("expected filter[locale]=de-DE, got %q", got)
+			}
+			body = `{"data":[{"type":"appInfoLocalizations","id":"AI_DE","attributes":{"locale":"de-DE"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appInfoLocalizations/AI_DE":
+			payload, _ := io.ReadAll(req.Body)
+			patchBody = string(payload)
+			body = `{"data":{"type":"appInfoLocalizations","id":"AI_DE","attributes":{"locale":"de-DE","subtitle":"Fotos schnell bearbeiten"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"app-info", "localizations", "update", "--app", "APP_ID", "--locale", "de-DE", "--subtitle", "Fotos schnell bearbeiten"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(patchBody, `"subtitle":"Fotos schnell bearbeiten"`) || strings.Contains(patchBody, `"name"`) {
+		t.Fatalf("unexpected PATCH payload: %s", patchBody)
+	}
+	if !strings.Contains(stdout, `"id":"AI_DE"`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
+
+func TestAppInfoLocalizationsGetMissingLocale(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":[]}`
+		if req.URL.Path == "/v1/apps/APP_ID/appInfos" {
+			body = appInfosWithEditable
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"app-info", "localizations", "get", "--app", "APP_ID", "--locale", "ja"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "no ja localization found for app info INFO_EDIT") {
+		t.Fatalf("expected missing locale error, got %v", runErr)
+	}
+}
@@ -148,6 +148,46 @@ func TestLocalizationsMediaSetsValidationErrors(t *testing.T) {
 			args:    []string{"localizations", "screenshot-sets", "get"},
 			wantErr: "--id is required",
 		},
+		{
+			name:    "screenshot sets list invalid display type",
+			args:    []string{"localizations", "screenshot-sets", "list", "--localization-id", "LOC_ID", "--display-type", "IPHONE_99"},
+			wantErr: `invalid --display-type "IPHONE_99"`,
+		},
+		{
+			name:    "screenshot sets create missing display type",
+			args:    []string{"localizations", "screenshot-sets", "create", "--localization-id", "LOC_ID"},
+			wantErr: "--display-type is required",
+		},
+		{
+			name:    "screenshot sets create invalid display type",
+			args:    []string{"localizations", "screenshot-sets", "create", "--localization-id", "LOC_ID", "--display-type", "IPHONE_67"},
+			wantErr: `invalid --display-type "IPHONE_67"`,
+		},
+		{
+			name:    "screenshot sets delete missing confirm",
+			args:    []string{"localizations", "screenshot-sets", "delete", "--id", "SET_ID"},
+			wantErr: "--confirm is required to delete",
+		},
+		{
+			name:    "preview sets list invalid preview type",
+			args:    []string{"localizations", "preview-sets", "list", "--localization-id", "LOC_ID", "--preview-type", "IPHONE_99"},
+			wantErr: `invalid --preview-type "IPHONE_99"`,
+		},
+		{
+			name:    "preview sets create missing preview type",
+			args:    []string{"localizations", "preview-sets", "create", "--localization-id", "LOC_ID"},
+			wantErr: "--preview-type is required",
+		},
+		{
+			name:    "preview sets create invalid preview type",
+			args:    []string{"localizations", "preview-sets", "create", "--localization-id", "LOC_ID", "--preview-type", "APP_IPHONE_67"},
+			wantErr: `invalid --preview-type "APP_IPHONE_67"`,
+		},
+		{
+			name:    "preview sets delete missing confirm",
+			args:    []string{"localizations", "preview-sets", "delete", "--id", "SET_ID"},
+			wantErr: "--confirm is required to delete",
+		},
 	}
 
 	for _, test := range tests {
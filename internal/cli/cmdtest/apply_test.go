@@ -0,0 +1,365 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestApplyValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	configPath := filepath.Join(t.TempDir(), "app.yaml")
+	if err := os.WriteFile(configPath, []byte("info:\n  localizations: {}\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing file",
+			args:    []string{"apply"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "missing app",
+			args:    []string{"apply", "-f", configPath},
+			wantErr: "app is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestApplyPlansAndAppliesInfoLocalizations(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	configPath := filepath.Join(t.TempDir(), "app.yaml")
+	config := `app: "123"
+info:
+  localizations:
+    en-US:
+      name: "My App"
+      subtitle: "New subtitle"
+    de-DE:
+      name: "Meine App"
+`
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var mutations []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/123/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"info-1","attributes":{"state":"PREPARE_FOR_SUBMISSION"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appInfos/info-1/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"loc-en","attributes":{"locale":"en-US","name":"My App","subtitle":"Old"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appInfoLocalizations/loc-en":
+			mutations = append(mutations, "PATCH loc-en")
+			body = `{"data":{"type":"appInfoLocalizations","id":"loc-en","attributes":{"locale":"en-US"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appInfoLocalizations":
+			mutations = append(mutations, "POST")
+			body = `{"data":{"type":"appInfoLocalizations","id":"loc-de","attributes":{"locale":"de-DE"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	type applyOutput struct {
+		Applied bool `json:"applied"`
+		Changes []struct {
+			Resource string   `json:"resource"`
+			Action   string   `json:"action"`
+			Target   string   `json:"target"`
+			Fields   []string `json:"fields"`
+			Status   string   `json:"status"`
+		} `json:"changes"`
+	}
+
+	run := func(args ...string) (applyOutput, string) {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		stdout, stderr := captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		var result applyOutput
+		if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+			t.Fatalf("parse output: %v (%q)", err, stdout)
+		}
+		return result, stderr
+	}
+
+	plan, stderr := run("apply", "-f", configPath)
+	if len(mutations) != 0 {
+		t.Fatalf("expected no mutations without --confirm, got %v", mutations)
+	}
+	if plan.Applied || len(plan.Changes) != 2 {
+		t.Fatalf("unexpected plan: %+v", plan)
+	}
+	if plan.Changes[0].Target != "de-DE" || plan.Changes[0].Action != "create" || plan.Changes[0].Status != "planned" {
+		t.Fatalf("unexpected first change: %+v", plan.Changes[0])
+	}
+	if plan.Changes[1].Target != "en-US" || plan.Changes[1].Action != "update" || strings.Join(plan.Changes[1].Fields, ",") != "subtitle" {
+		t.Fatalf("unexpected second change: %+v", plan.Changes[1])
+	}
+	if !strings.Contains(stderr, "Re-run with --confirm") {
+		t.Fatalf("expected confirm hint, got %q", stderr)
+	}
+
+	applied, _ := run("apply", "-f", configPath, "--confirm")
+	if !applied.Applied {
+		t.Fatalf("expected applied result, got %+v", applied)
+	}
+	if strings.Join(mutations, ";") != "POST;PATCH loc-en" {
+		t.Fatalf("unexpected mutations: %v", mutations)
+	}
+	for _, change := range applied.Changes {
+		if change.Status != "applied" {
+			t.Fatalf("expected applied status, got %+v", change)
+		}
+	}
+}
+
+func TestApplyPricingKeepsOtherTerritories(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	configPath := filepath.Join(t.TempDir(), "app.yaml")
+	config := `app: "123"
+pricing:
+  baseTerritory: USA
+  pricePoint: pp-usa-new
+`
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var schedule string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/123/appPriceSchedule":
+			body = `{"data":{"type":"appPriceSchedules","id":"sched-1"}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appPriceSchedules/sched-1/baseTerritory":
+			body = `{"data":{"type":"territories","id":"USA"}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appPriceSchedules/sched-1/manualPrices":
+			body = `{"data":[` +
+				`{"type":"appPrices","id":"price-gbr","attributes":{"startDate":"2024-06-01"},` +
+				`"relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-gbr"}},"territory":{"data":{"type":"territories","id":"GBR"}}}},` +
+				`{"type":"appPrices","id":"price-usa","attributes":{"startDate":"2024-01-01"},` +
+				`"relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"pp-usa"}},"territory":{"data":{"type":"territories","id":"USA"}}}}]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appPriceSchedules":
+			payload, _ := io.ReadAll(req.Body)
+			schedule = string(payload)
+			body = `{"data":{"type":"appPriceSchedules","id":"sched-2"}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"apply", "-f", configPath, "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"fields":["pricePoint"]`) {
+		t.Fatalf("expected a pricePoint update for the base territory only, got %s", stdout)
+	}
+	if !strings.Contains(schedule, `"pp-usa-new"`) || !strings.Contains(schedule, `"pp-gbr"`) {
+		t.Fatalf("expected new base price and kept GBR price, got %s", schedule)
+	}
+	if strings.Contains(schedule, `"pp-usa"`) {
+		t.Fatalf("expected old base price to be replaced, got %s", schedule)
+	}
+}
+
+func TestExportStateValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "missing app", args: []string{"export-state", "--output", "app.yaml"}, wantErr: "--app is required"},
+		{name: "missing output", args: []string{"export-state", "--app", "123"}, wantErr: "--output is required"},
+		{name: "invalid format", args: []string{"export-state", "--app", "123", "--output", "app.toml", "--format", "toml"}, wantErr: "--format must be yaml or json"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestExportStateWritesApplyConfig(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	notFound := `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"not found"}]}`
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		status := http.StatusOK
+		body := ""
+		switch req.URL.Path {
+		case "/v1/apps/123/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"info-1","attributes":{"state":"READY_FOR_DISTRIBUTION"}}]}`
+		case "/v1/appInfos/info-1/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"loc-en","attributes":{"locale":"en-US","name":"My App","subtitle":"Do more"}}]}`
+		case "/v1/apps/123/appStoreVersions":
+			body = `{"data":[` +
+				`{"type":"appStoreVersions","id":"v-1","attributes":{"platform":"IOS","versionString":"1.0","createdDate":"2025-01-01T00:00:00Z"}},` +
+				`{"type":"appStoreVersions","id":"v-2","attributes":{"platform":"IOS","versionString":"1.1","createdDate":"2025-06-01T00:00:00Z"}}]}`
+		case "/v1/appStoreVersions/v-2/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"vloc-en","attributes":{"locale":"en-US","whatsNew":"Bug fixes"}}]}`
+		case "/v1/apps/123/appPriceSchedule", "/v1/apps/123/appAvailabilityV2", "/v2/inAppPurchases/iap-1/iapPriceSchedule":
+			status = http.StatusNotFound
+			body = notFound
+		case "/v1/apps/123/inAppPurchasesV2":
+			body = `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Coins","productId":"com.example.coins","inAppPurchaseType":"CONSUMABLE"}}]}`
+		case "/v2/inAppPurchases/iap-1/inAppPurchaseLocalizations":
+			body = `{"data":[{"type":"inAppPurchaseLocalizations","id":"iap-loc","attributes":{"locale":"en-US","name":"Coins","description":"A pile"}}]}`
+		case "/v1/apps/123/betaGroups":
+			body = `{"data":[{"type":"betaGroups","id":"group-1","attributes":{"name":"External","publicLinkEnabled":true}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	outputPath := filepath.Join(t.TempDir(), "app.yaml")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"export-state", "--app", "123", "--output", outputPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var summary struct {
+		Version        string `json:"version"`
+		InfoLocales    int    `json:"infoLocales"`
+		InAppPurchases int    `json:"inAppPurchases"`
+		BetaGroups     int    `json:"betaGroups"`
+		Pricing        bool   `json:"pricing"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if summary.Version != "1.1" || summary.InfoLocales != 1 || summary.InAppPurchases != 1 || summary.BetaGroups != 1 || summary.Pricing {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	content := string(data)
+	for _, want := range []string{`app: "123"`, "version: \"1.1\"", "whatsNew: Bug fixes", "productId: com.example.coins", "name: External", "publicLinkEnabled: true"} {
+		if !strings.Contains(content, want) {
+			t.Fatalf("expected export to contain %q, got:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "pricing:") || strings.Contains(content, "availability:") {
+		t.Fatalf("expected missing sections to be omitted, got:\n%s", content)
+	}
+}
@@ -0,0 +1,100 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAssetsPreviewsUploadToSetSetsPosterFrameAfterProcessing(t *testing.T) {
+	setupAuth(t)
+
+	content := []byte("fake mp4 bytes")
+	filePath := filepath.Join(t.TempDir(), "preview.mp4")
+	if err := os.WriteFile(filePath, content, 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	stateCalls := 0
+	posterSet := false
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appPreviewSets/SET_ID":
+			body = `{"data":{"type":"appPreviewSets","id":"SET_ID","attributes":{"previewType":"IPHONE_67"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appPreviews":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"mimeType":"video/mp4"`) {
+				t.Fatalf("unexpected reservation body: %s", payload)
+			}
+			status = http.StatusCreated
+			body = `{"data":{"type":"appPreviews","id":"PREVIEW_ID","attributes":{"fileName":"preview.mp4","uploadOperations":[{"method":"PUT","url":"https://upload.example.com/chunk","length":14,"offset":0}]}}}`
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example.com":
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appPreviews/PREVIEW_ID":
+			payload, _ := io.ReadAll(req.Body)
+			if strings.Contains(string(payload), "previewFrameTimeCode") {
+				if stateCalls == 0 {
+					t.Fatal("expected the poster frame to be set after processing completes")
+				}
+				if !strings.Contains(string(payload), `"previewFrameTimeCode":"00:00:05"`) {
+					t.Fatalf("unexpected poster body: %s", payload)
+				}
+				posterSet = true
+			}
+			body = `{"data":{"type":"appPreviews","id":"PREVIEW_ID","attributes":{"fileName":"preview.mp4"}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appPreviews/PREVIEW_ID":
+			stateCalls++
+			body = `{"data":{"type":"appPreviews","id":"PREVIEW_ID","attributes":{"fileName":"preview.mp4","assetDeliveryState":{"state":"COMPLETE"}}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "previews", "upload", "--set", "SET_ID", "--path", filePath, "--poster-time", "00:00:05"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !posterSet {
+		t.Fatal("expected the poster frame to be set")
+	}
+
+	var result struct {
+		SetID                string `json:"setId"`
+		PreviewType          string `json:"previewType"`
+		PreviewFrameTimeCode string `json:"previewFrameTimeCode"`
+		Results              []struct {
+			AssetID string `json:"assetId"`
+			State   string `json:"state"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.SetID != "SET_ID" || result.PreviewType != "IPHONE_67" || result.PreviewFrameTimeCode != "00:00:05" || len(result.Results) != 1 || result.Results[0].State != "COMPLETE" {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+}
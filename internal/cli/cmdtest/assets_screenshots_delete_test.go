@@ -0,0 +1,102 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAssetsScreenshotsDeleteSetsForLocaleAndDisplayType(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var deleted []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}},{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_DE/appScreenshotSets":
+			body = `{"data":[{"type":"appScreenshotSets","id":"SET_PHONE","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}},{"type":"appScreenshotSets","id":"SET_IPAD","attributes":{"screenshotDisplayType":"APP_IPAD_PRO_3GEN_129"}}]}`
+		case req.Method == http.MethodDelete && strings.HasPrefix(req.URL.Path, "/v1/appScreenshotSets/"):
+			deleted = append(deleted, strings.TrimPrefix(req.URL.Path, "/v1/appScreenshotSets/"))
+			status = http.StatusNoContent
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "screenshots", "delete", "--version-id", "VERSION_ID", "--locale", "de-DE", "--display-type", "iphone_67", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(deleted) != 1 || deleted[0] != "SET_PHONE" {
+		t.Fatalf("expected only SET_PHONE to be deleted, got %v", deleted)
+	}
+	var result struct {
+		Sets []struct {
+			Locale      string `json:"locale"`
+			DisplayType string `json:"displayType"`
+			SetID       string `json:"setId"`
+		} `json:"sets"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Sets) != 1 || result.Sets[0].Locale != "de-DE" || result.Sets[0].DisplayType != "APP_IPHONE_67" {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+}
+
+func TestAssetsScreenshotsDeleteRejectsUnknownLocaleBeforeDeleting(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}]}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "screenshots", "delete", "--version-id", "VERSION_ID", "--locale", "en-US,fr-FR", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "has no fr-FR localization") {
+			t.Fatalf("expected missing locale error, got %v", err)
+		}
+	})
+}
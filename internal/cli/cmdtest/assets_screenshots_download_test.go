@@ -0,0 +1,106 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func screenshotDownloadTransport(t *testing.T) roundTripFunc {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch req.URL.Host + req.URL.Path {
+		case "api.appstoreconnect.apple.com/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}},{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE"}}]}`
+		case "api.appstoreconnect.apple.com/v1/appStoreVersionLocalizations/LOC_EN/appScreenshotSets":
+			body = `{"data":[{"type":"appScreenshotSets","id":"SET_PHONE","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}}]}`
+		case "api.appstoreconnect.apple.com/v1/appScreenshotSets/SET_PHONE/appScreenshots":
+			body = `{"data":[{"type":"appScreenshots","id":"SHOT_1","attributes":{"fileName":"home.png","imageAsset":{"templateUrl":"https://is1-ssl.mzstatic.com/image/shot1/{w}x{h}bb.{f}","width":1290,"height":2796}}},{"type":"appScreenshots","id":"SHOT_2","attributes":{"fileName":"detail.jpg","imageAsset":{"templateUrl":"https://is1-ssl.mzstatic.com/image/shot2/{w}x{h}bb.{f}","width":1290,"height":2796}}},{"type":"appScreenshots","id":"SHOT_3","attributes":{"fileName":"pending.png"}}]}`
+		case "is1-ssl.mzstatic.com/image/shot1/1290x2796bb.png",
+			"is1-ssl.mzstatic.com/image/shot2/1290x2796bb.jpg":
+			body = "IMG:" + req.URL.Path
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	}
+}
+
+func runScreenshotDownload(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"assets", "screenshots", "download"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, runErr
+}
+
+func TestAssetsScreenshotsDownloadWritesLocaleDisplayTypeTree(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = screenshotDownloadTransport(t)
+
+	outDir := t.TempDir()
+	stdout, err := runScreenshotDownload(t, "--version-id", "VERSION_ID", "--out", outDir, "--locale", "en-US")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	var result struct {
+		Files []struct {
+			ScreenshotID string `json:"screenshotId"`
+			Width        int    `json:"width"`
+		} `json:"files"`
+		Skipped []string `json:"skipped"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Files) != 2 || result.Files[0].Width != 1290 || len(result.Skipped) != 1 || result.Skipped[0] != "SHOT_3" {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+
+	setDir := filepath.Join(outDir, "en-US", "APP_IPHONE_67")
+	for name, want := range map[string]string{
+		"01_home.png":   "IMG:/image/shot1/1290x2796bb.png",
+		"02_detail.jpg": "IMG:/image/shot2/1290x2796bb.jpg",
+	} {
+		data, err := os.ReadFile(filepath.Join(setDir, name))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		if string(data) != want {
+			t.Fatalf("%s = %q, want %q", name, data, want)
+		}
+	}
+
+	// A second download refuses to replace files without --overwrite.
+	if _, err := runScreenshotDownload(t, "--version-id", "VERSION_ID", "--out", outDir, "--locale", "en-US"); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected already exists error, got %v", err)
+	}
+	if _, err := runScreenshotDownload(t, "--version-id", "VERSION_ID", "--out", outDir, "--locale", "en-US", "--overwrite"); err != nil {
+		t.Fatalf("overwrite run error: %v", err)
+	}
+}
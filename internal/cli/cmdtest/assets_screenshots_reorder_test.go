@@ -0,0 +1,108 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func screenshotReorderTransport(t *testing.T, patched *string) roundTripFunc {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/SET_ID/appScreenshots":
+			body = `{"data":[{"type":"appScreenshots","id":"SHOT_1","attributes":{"fileName":"01_home.png"}},{"type":"appScreenshots","id":"SHOT_2","attributes":{"fileName":"02_detail.png"}},{"type":"appScreenshots","id":"SHOT_3","attributes":{"fileName":"03_share.png"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshotSets/SET_ID/relationships/appScreenshots":
+			payload, _ := io.ReadAll(req.Body)
+			*patched = string(payload)
+			status = http.StatusNoContent
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	}
+}
+
+func runScreenshotReorder(t *testing.T, args ...string) string {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"assets", "screenshots", "reorder"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	return stdout
+}
+
+func TestAssetsScreenshotsReorderByIDs(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	patched := ""
+	http.DefaultTransport = screenshotReorderTransport(t, &patched)
+
+	stdout := runScreenshotReorder(t, "--set", "SET_ID", "--ids", "SHOT_3,SHOT_1,SHOT_2")
+
+	var payload struct {
+		Data []struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(patched), &payload); err != nil {
+		t.Fatalf("failed to parse PATCH body: %v\n%s", err, patched)
+	}
+	if len(payload.Data) != 3 || payload.Data[0].ID != "SHOT_3" || payload.Data[1].ID != "SHOT_1" || payload.Data[2].ID != "SHOT_2" || payload.Data[0].Type != "appScreenshots" {
+		t.Fatalf("unexpected PATCH body: %s", patched)
+	}
+
+	var result struct {
+		Changed   bool     `json:"changed"`
+		FileNames []string `json:"fileNames"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if !result.Changed || strings.Join(result.FileNames, ",") != "03_share.png,01_home.png,02_detail.png" {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+}
+
+func TestAssetsScreenshotsReorderByFileNameSkipsUnchangedOrder(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	patched := ""
+	http.DefaultTransport = screenshotReorderTransport(t, &patched)
+
+	stdout := runScreenshotReorder(t, "--set", "SET_ID", "--by-filename")
+
+	if patched != "" {
+		t.Fatalf("expected no PATCH for an already ordered set, got %s", patched)
+	}
+	if !strings.Contains(stdout, `"changed":false`) {
+		t.Fatalf("expected changed=false, got %s", stdout)
+	}
+}
@@ -0,0 +1,239 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestPNG writes an opaque width x height PNG filled with fill and
+// returns its bytes.
+func writeTestPNG(t *testing.T, path string, width, height int, fill color.Color) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(fill), image.Point{}, draw.Src)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create %s: %v", path, err)
+	}
+	if err := png.Encode(file, img); err != nil {
+		t.Fatalf("encode %s: %v", path, err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatalf("close %s: %v", path, err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v", path, err)
+	}
+	return data
+}
+
+func writeScreenshotSyncDir(t *testing.T) (string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	setDir := filepath.Join(dir, "en-US", "IPHONE_67")
+	writeTestPNG(t, filepath.Join(setDir, "01_new.png"), 1290, 2796, color.RGBA{R: 255, A: 255})
+	keep := writeTestPNG(t, filepath.Join(setDir, "02_keep.png"), 1290, 2796, color.RGBA{B: 255, A: 255})
+	sum := md5.Sum(keep)
+	return dir, hex.EncodeToString(sum[:])
+}
+
+func screenshotSyncTransport(t *testing.T, keepChecksum string, requests *[]string) roundTripFunc {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		*requests = append(*requests, req.Method+" "+req.URL.Path)
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_EN/appScreenshotSets":
+			body = `{"data":[{"type":"appScreenshotSets","id":"SET_ID","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/SET_ID/appScreenshots":
+			body = `{"data":[{"type":"appScreenshots","id":"KEEP_ID","attributes":{"fileName":"keep.png","sourceFileChecksum":"` + keepChecksum + `"}},{"type":"appScreenshots","id":"OLD_ID","attributes":{"fileName":"old.png","sourceFileChecksum":"0000"}}]}`
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/appScreenshots/OLD_ID":
+			status = http.StatusNoContent
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appScreenshots":
+			status = http.StatusCreated
+			body = `{"data":{"type":"appScreenshots","id":"NEW_ID","attributes":{"fileName":"01_new.png","uploadOperations":[{"method":"PUT","url":"https://upload.example.com/chunk","length":9,"offset":0}]}}}`
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example.com":
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshots/NEW_ID":
+			body = `{"data":{"type":"appScreenshots","id":"NEW_ID","attributes":{"fileName":"01_new.png"}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshots/NEW_ID":
+			body = `{"data":{"type":"appScreenshots","id":"NEW_ID","attributes":{"fileName":"01_new.png","assetDeliveryState":{"state":"COMPLETE"}}}}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshotSets/SET_ID/relationships/appScreenshots":
+			payload, _ := io.ReadAll(req.Body)
+			if strings.Index(string(payload), "NEW_ID") > strings.Index(string(payload), "KEEP_ID") {
+				t.Fatalf("expected NEW_ID before KEEP_ID, got %s", payload)
+			}
+			status = http.StatusNoContent
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	}
+}
+
+type screenshotSyncOutput struct {
+	Items []struct {
+		FileName     string `json:"fileName"`
+		Action       string `json:"action"`
+		ScreenshotID string `json:"screenshotId"`
+	} `json:"items"`
+	Kept      int      `json:"kept"`
+	Uploaded  int      `json:"uploaded"`
+	Deleted   int      `json:"deleted"`
+	Reordered []string `json:"reordered"`
+}
+
+func runScreenshotSync(t *testing.T, args ...string) screenshotSyncOutput {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"assets", "screenshots", "sync"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result screenshotSyncOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	return result
+}
+
+func TestAssetsScreenshotsSyncUploadsDeletesAndReorders(t *testing.T) {
+	setupAuth(t)
+
+	dir, keepChecksum := writeScreenshotSyncDir(t)
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = screenshotSyncTransport(t, keepChecksum, &requests)
+
+	result := runScreenshotSync(t, "--version-id", "VERSION_ID", "--dir", dir)
+
+	if result.Kept != 1 || result.Uploaded != 1 || result.Deleted != 1 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	if len(result.Reordered) != 1 || result.Reordered[0] != "en-US/APP_IPHONE_67" {
+		t.Fatalf("expected the set to be reordered, got %v", result.Reordered)
+	}
+	if len(result.Items) != 3 || result.Items[1].FileName != "01_new.png" || result.Items[1].ScreenshotID != "NEW_ID" || result.Items[2].ScreenshotID != "KEEP_ID" {
+		t.Fatalf("unexpected items: %+v", result.Items)
+	}
+
+	deleteIndex, uploadIndex := -1, -1
+	for i, request := range requests {
+		switch request {
+		case "DELETE /v1/appScreenshots/OLD_ID":
+			deleteIndex = i
+		case "POST /v1/appScreenshots":
+			uploadIndex = i
+		}
+	}
+	if deleteIndex < 0 || uploadIndex < 0 || deleteIndex > uploadIndex {
+		t.Fatalf("expected delete before upload, got %v", requests)
+	}
+}
+
+func TestAssetsScreenshotsSyncDryRunOnlyReads(t *testing.T) {
+	setupAuth(t)
+
+	dir, keepChecksum := writeScreenshotSyncDir(t)
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = screenshotSyncTransport(t, keepChecksum, &requests)
+
+	result := runScreenshotSync(t, "--version-id", "VERSION_ID", "--dir", dir, "--dry-run")
+
+	for _, request := range requests {
+		if !strings.HasPrefix(request, http.MethodGet+" ") {
+			t.Fatalf("unexpected request in dry run: %s", request)
+		}
+	}
+	if result.Kept != 1 || result.Uploaded != 1 || result.Deleted != 1 || len(result.Reordered) != 1 {
+		t.Fatalf("unexpected plan: %+v", result)
+	}
+}
+
+func TestAssetsScreenshotsSyncRejectsInvalidScreenshotsBeforeRequests(t *testing.T) {
+	setupAuth(t)
+
+	dir, _ := writeScreenshotSyncDir(t)
+	writeTestPNG(t, filepath.Join(dir, "en-US", "IPHONE_67", "03_small.png"), 100, 200, color.RGBA{G: 255, A: 255})
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "screenshots", "sync", "--version-id", "VERSION_ID", "--dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "03_small.png is 100x200") {
+			t.Fatalf("expected dimension error, got %v", err)
+		}
+	})
+}
+
+func TestAssetsScreenshotsSyncRequiresDir(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "screenshots", "sync", "--version-id", "VERSION_ID"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "--dir is required") {
+		t.Fatalf("expected --dir error, got %q", stderr)
+	}
+}
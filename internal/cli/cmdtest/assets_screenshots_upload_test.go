@@ -0,0 +1,293 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestAssetsScreenshotsUploadToSetRetriesFailedChunk(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_BASE_DELAY", "1ms")
+	t.Setenv("ASC_MAX_DELAY", "1ms")
+
+	content := []byte("fake png bytes")
+	sum := md5.Sum(content)
+	wantChecksum := hex.EncodeToString(sum[:])
+	filePath := filepath.Join(t.TempDir(), "shot.png")
+	if err := os.WriteFile(filePath, content, 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	chunkCalls := 0
+	committed := false
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/SET_ID":
+			body = `{"data":{"type":"appScreenshotSets","id":"SET_ID","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appScreenshots":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"id":"SET_ID"`) || !strings.Contains(string(payload), `"fileName":"shot.png"`) {
+				t.Fatalf("unexpected reservation body: %s", payload)
+			}
+			status = http.StatusCreated
+			body = `{"data":{"type":"appScreenshots","id":"SHOT_ID","attributes":{"fileName":"shot.png","uploadOperations":[{"method":"PUT","url":"https://upload.example.com/chunk","length":14,"offset":0}]}}}`
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example.com":
+			chunkCalls++
+			if chunkCalls == 1 {
+				status = http.StatusServiceUnavailable
+				break
+			}
+			payload, _ := io.ReadAll(req.Body)
+			if string(payload) != string(content) {
+				t.Fatalf("unexpected chunk body %q", payload)
+			}
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshots/SHOT_ID":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"uploaded":true`) || !strings.Contains(string(payload), wantChecksum) {
+				t.Fatalf("unexpected commit body: %s", payload)
+			}
+			committed = true
+			body = `{"data":{"type":"appScreenshots","id":"SHOT_ID","attributes":{"fileName":"shot.png"}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshots/SHOT_ID":
+			body = `{"data":{"type":"appScreenshots","id":"SHOT_ID","attributes":{"fileName":"shot.png","assetDeliveryState":{"state":"COMPLETE"}}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "screenshots", "upload", "--set", "SET_ID", "--path", filePath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if chunkCalls != 2 {
+		t.Fatalf("expected the failed chunk to be retried once, got %d calls", chunkCalls)
+	}
+	if !committed {
+		t.Fatal("expected the upload to be committed")
+	}
+
+	var result struct {
+		SetID       string `json:"setId"`
+		DisplayType string `json:"displayType"`
+		Results     []struct {
+			AssetID string `json:"assetId"`
+			State   string `json:"state"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.SetID != "SET_ID" || result.DisplayType != "APP_IPHONE_67" || len(result.Results) != 1 || result.Results[0].AssetID != "SHOT_ID" || result.Results[0].State != "COMPLETE" {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+}
+
+func TestAssetsScreenshotsUploadResumeSkipsCompletedChunks(t *testing.T) {
+	setupAuth(t)
+
+	content := []byte("fake png bytes")
+	filePath := filepath.Join(t.TempDir(), "shot.png")
+	if err := os.WriteFile(filePath, content, 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	reservations := 0
+	chunks := make([]string, 0)
+	failSecondChunk := true
+	committed := false
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/SET_ID":
+			body = `{"data":{"type":"appScreenshotSets","id":"SET_ID","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appScreenshots":
+			reservations++
+			status = http.StatusCreated
+			body = `{"data":{"type":"appScreenshots","id":"SHOT_ID","attributes":{"fileName":"shot.png","uploadOperations":[` +
+				`{"method":"PUT","url":"https://upload.example.com/chunk1","length":7,"offset":0},` +
+				`{"method":"PUT","url":"https://upload.example.com/chunk2","length":7,"offset":7}]}}}`
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example.com":
+			if req.URL.Path == "/chunk2" && failSecondChunk {
+				status = http.StatusBadRequest
+				break
+			}
+			chunks = append(chunks, req.URL.Path)
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshots/SHOT_ID":
+			committed = true
+			body = `{"data":{"type":"appScreenshots","id":"SHOT_ID","attributes":{"fileName":"shot.png"}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshots/SHOT_ID":
+			state := "AWAITING_UPLOAD"
+			if committed {
+				state = "COMPLETE"
+			}
+			body = `{"data":{"type":"appScreenshots","id":"SHOT_ID","attributes":{"fileName":"shot.png","assetDeliveryState":{"state":"` + state + `"}}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	run := func(args ...string) error {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		var runErr error
+		captureOutput(t, func() {
+			if err := root.Parse(append([]string{"assets", "screenshots", "upload", "--set", "SET_ID", "--path", filePath}, args...)); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		return runErr
+	}
+
+	if err := run(); err == nil {
+		t.Fatal("expected the interrupted upload to fail")
+	}
+	failSecondChunk = false
+	if err := run("--resume"); err != nil {
+		t.Fatalf("resume error: %v", err)
+	}
+
+	if reservations != 1 {
+		t.Fatalf("expected the reservation to be reused, got %d reservations", reservations)
+	}
+	if strings.Join(chunks, ",") != "/chunk1,/chunk2" {
+		t.Fatalf("expected each chunk to be uploaded once, got %v", chunks)
+	}
+	if !committed {
+		t.Fatal("expected the upload to be committed")
+	}
+}
+
+func TestAssetsScreenshotsUploadConcurrentlyRestoresFileOrder(t *testing.T) {
+	setupAuth(t)
+
+	dir := t.TempDir()
+	for _, name := range []string{"01_home.png", "02_detail.png", "03_settings.png"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("fake png "+name), 0o600); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var mu sync.Mutex
+	reserved := map[string]string{}
+	var reorderBody string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/SET_ID":
+			body = `{"data":{"type":"appScreenshotSets","id":"SET_ID","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appScreenshots":
+			payload, _ := io.ReadAll(req.Body)
+			var name string
+			for _, candidate := range []string{"01_home.png", "02_detail.png", "03_settings.png"} {
+				if strings.Contains(string(payload), candidate) {
+					name = candidate
+				}
+			}
+			id := "SHOT_" + name[:2]
+			reserved[id] = name
+			status = http.StatusCreated
+			body = `{"data":{"type":"appScreenshots","id":"` + id + `","attributes":{"fileName":"` + name + `","uploadOperations":[{"method":"PUT","url":"https://upload.example.com/` + id + `","length":4,"offset":0}]}}}`
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example.com":
+		case req.Method == http.MethodPatch && strings.HasPrefix(req.URL.Path, "/v1/appScreenshots/"):
+			body = `{"data":{"type":"appScreenshots","id":"` + strings.TrimPrefix(req.URL.Path, "/v1/appScreenshots/") + `","attributes":{}}}`
+		case req.Method == http.MethodGet && strings.HasPrefix(req.URL.Path, "/v1/appScreenshots/"):
+			body = `{"data":{"type":"appScreenshots","id":"` + strings.TrimPrefix(req.URL.Path, "/v1/appScreenshots/") + `","attributes":{"assetDeliveryState":{"state":"COMPLETE"}}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/SET_ID/appScreenshots":
+			// Simulate uploads landing out of order.
+			body = `{"data":[{"type":"appScreenshots","id":"SHOT_EXISTING"},{"type":"appScreenshots","id":"SHOT_03"},{"type":"appScreenshots","id":"SHOT_01"},{"type":"appScreenshots","id":"SHOT_02"}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshotSets/SET_ID/relationships/appScreenshots":
+			payload, _ := io.ReadAll(req.Body)
+			reorderBody = string(payload)
+			status = http.StatusNoContent
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "screenshots", "upload", "--set", "SET_ID", "--path", dir, "--concurrency", "3"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(reserved) != 3 {
+		t.Fatalf("expected 3 reservations, got %v", reserved)
+	}
+	wantOrder := `"id":"SHOT_EXISTING"},{"type":"appScreenshots","id":"SHOT_01"},{"type":"appScreenshots","id":"SHOT_02"},{"type":"appScreenshots","id":"SHOT_03"`
+	if !strings.Contains(reorderBody, wantOrder) {
+		t.Fatalf("expected set reordered to file order, got %s", reorderBody)
+	}
+
+	var result struct {
+		Results []struct {
+			FileName string `json:"fileName"`
+			AssetID  string `json:"assetId"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Results) != 3 || result.Results[0].AssetID != "SHOT_01" || result.Results[2].AssetID != "SHOT_03" {
+		t.Fatalf("expected results in file order, got %s", stdout)
+	}
+}
@@ -0,0 +1,61 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"image/color"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAssetsScreenshotsValidateReportsIssues(t *testing.T) {
+	dir := t.TempDir()
+	setDir := filepath.Join(dir, "en-US", "APP_IPHONE_67")
+	writeTestPNG(t, filepath.Join(setDir, "01_home.png"), 1290, 2796, color.RGBA{R: 255, A: 255})
+	writeTestPNG(t, filepath.Join(setDir, "02_ipad.png"), 2048, 2732, color.RGBA{B: 255, A: 255})
+	if err := os.WriteFile(filepath.Join(setDir, "03_shot.heic"), []byte("heic"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"assets", "screenshots", "validate", "--dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil {
+		t.Fatal("expected a non-zero exit for invalid screenshots")
+	}
+	if errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("unexpected usage error: %v", runErr)
+	}
+
+	var result struct {
+		Files  int  `json:"files"`
+		Valid  bool `json:"valid"`
+		Issues []struct {
+			File string `json:"file"`
+			Rule string `json:"rule"`
+		} `json:"issues"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.Valid || result.Files != 2 || len(result.Issues) != 2 {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+	if result.Issues[0].File != "03_shot.heic" || result.Issues[0].Rule != "unsupported-format" {
+		t.Fatalf("unexpected first issue: %+v", result.Issues[0])
+	}
+	if result.Issues[1].File != "02_ipad.png" || result.Issues[1].Rule != "invalid-dimensions" {
+		t.Fatalf("unexpected second issue: %+v", result.Issues[1])
+	}
+}
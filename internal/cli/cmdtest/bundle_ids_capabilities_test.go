@@ -0,0 +1,142 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runBundleIDCapabilityCommand(t *testing.T, args []string, handler func(req *http.Request) (int, string)) map[string]any {
+	t.Helper()
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status, body := handler(req)
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result map[string]any
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	return result
+}
+
+func TestBundleIDsCapabilitiesEnableCreatesMissingCapability(t *testing.T) {
+	var created bool
+	result := runBundleIDCapabilityCommand(t, []string{"bundle-ids", "capabilities", "enable", "--bundle-id", "com.example.app", "--capability", "push_notifications"}, func(req *http.Request) (int, string) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds":
+			if got := req.URL.Query().Get("filter[identifier]"); got != "com.example.app" {
+				t.Fatalf("expected filter[identifier]=com.example.app, got %q", got)
+			}
+			return http.StatusOK, `{"data":[` +
+				`{"type":"bundleIds","id":"bundle-other","attributes":{"identifier":"com.example.app.widget"}},` +
+				`{"type":"bundleIds","id":"bundle-1","attributes":{"identifier":"com.example.app"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds/bundle-1/bundleIdCapabilities":
+			return http.StatusOK, `{"data":[{"type":"bundleIdCapabilities","id":"cap-icloud","attributes":{"capabilityType":"ICLOUD"}}]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/bundleIdCapabilities":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"PUSH_NOTIFICATIONS"`) || !strings.Contains(string(payload), `"bundle-1"`) {
+				t.Fatalf("unexpected create body: %s", payload)
+			}
+			created = true
+			return http.StatusCreated, `{"data":{"type":"bundleIdCapabilities","id":"cap-push","attributes":{"capabilityType":"PUSH_NOTIFICATIONS"}}}`
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return 0, ""
+	})
+
+	if !created {
+		t.Fatal("expected capability to be created")
+	}
+	if result["bundleId"] != "bundle-1" || result["capabilityId"] != "cap-push" || result["enabled"] != true || result["changed"] != true {
+		t.Fatalf("unexpected result: %v", result)
+	}
+}
+
+func TestBundleIDsCapabilitiesEnableIsNoOpWhenEnabled(t *testing.T) {
+	result := runBundleIDCapabilityCommand(t, []string{"bundle-ids", "capabilities", "enable", "--bundle-id", "bundle-1", "--capability", "ICLOUD"}, func(req *http.Request) (int, string) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds/bundle-1/bundleIdCapabilities" {
+			return http.StatusOK, `{"data":[{"type":"bundleIdCapabilities","id":"cap-icloud","attributes":{"capabilityType":"ICLOUD"}}]}`
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return 0, ""
+	})
+
+	if result["capabilityId"] != "cap-icloud" || result["enabled"] != true || result["changed"] != false {
+		t.Fatalf("unexpected result: %v", result)
+	}
+}
+
+func TestBundleIDsCapabilitiesDisableDeletesCapability(t *testing.T) {
+	var deleted bool
+	result := runBundleIDCapabilityCommand(t, []string{"bundle-ids", "capabilities", "disable", "--bundle-id", "bundle-1", "--capability", "ICLOUD", "--confirm"}, func(req *http.Request) (int, string) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds/bundle-1/bundleIdCapabilities":
+			return http.StatusOK, `{"data":[{"type":"bundleIdCapabilities","id":"cap-icloud","attributes":{"capabilityType":"ICLOUD"}}]}`
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/bundleIdCapabilities/cap-icloud":
+			deleted = true
+			return http.StatusNoContent, ""
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return 0, ""
+	})
+
+	if !deleted {
+		t.Fatal("expected capability to be deleted")
+	}
+	if result["capabilityId"] != "cap-icloud" || result["enabled"] != false || result["changed"] != true {
+		t.Fatalf("unexpected result: %v", result)
+	}
+}
+
+func TestBundleIDsCapabilitiesEnableUpdatesSettingsWhenEnabled(t *testing.T) {
+	var updated bool
+	result := runBundleIDCapabilityCommand(t, []string{"bundle-ids", "capabilities", "enable", "--bundle-id", "bundle-1", "--capability", "ICLOUD", "--setting", "ICLOUD_VERSION=XCODE_6"}, func(req *http.Request) (int, string) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds/bundle-1/bundleIdCapabilities":
+			return http.StatusOK, `{"data":[{"type":"bundleIdCapabilities","id":"cap-icloud","attributes":{"capabilityType":"ICLOUD"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/bundleIdCapabilities/cap-icloud":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"key":"ICLOUD_VERSION"`) || !strings.Contains(string(payload), `"key":"XCODE_6","enabled":true`) {
+				t.Fatalf("unexpected update body: %s", payload)
+			}
+			updated = true
+			return http.StatusOK, `{"data":{"type":"bundleIdCapabilities","id":"cap-icloud","attributes":{"capabilityType":"ICLOUD"}}}`
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return 0, ""
+	})
+
+	if !updated {
+		t.Fatal("expected capability settings to be updated")
+	}
+	if result["capabilityId"] != "cap-icloud" || result["changed"] != true {
+		t.Fatalf("unexpected result: %v", result)
+	}
+}
@@ -0,0 +1,124 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func TestCertificatesCheckReportsExpiringCertificates(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	soon := time.Now().UTC().Add(10 * 24 * time.Hour).Format(time.RFC3339)
+	later := time.Now().UTC().Add(200 * 24 * time.Hour).Format(time.RFC3339)
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch req.URL.Path {
+		case "/v1/certificates":
+			if got := req.URL.Query().Get("filter[certificateType]"); got != "IOS_DISTRIBUTION" {
+				t.Fatalf("expected filter[certificateType]=IOS_DISTRIBUTION, got %q", got)
+			}
+			body = `{"data":[` +
+				`{"type":"certificates","id":"cert-soon","attributes":{"name":"Soon","certificateType":"IOS_DISTRIBUTION","serialNumber":"ABC","expirationDate":"` + soon + `"}},` +
+				`{"type":"certificates","id":"cert-later","attributes":{"name":"Later","certificateType":"IOS_DISTRIBUTION","serialNumber":"DEF","expirationDate":"` + later + `"}}]}`
+		case "/v1/profiles":
+			body = `{"data":[{"type":"profiles","id":"prof-1","attributes":{"name":"App Store","profileType":"IOS_APP_STORE"},"relationships":{"certificates":{"data":[{"type":"certificates","id":"cert-soon"}]}}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"certificates", "check", "--expiring-within", "30d", "--certificate-type", "ios_distribution"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	var reported shared.ReportedError
+	if !errors.As(runErr, &reported) {
+		t.Fatalf("expected reported error, got %v", runErr)
+	}
+
+	var result struct {
+		CheckedCount  int `json:"checkedCount"`
+		ExpiringCount int `json:"expiringCount"`
+		Certificates  []struct {
+			ID            string `json:"id"`
+			SerialNumber  string `json:"serialNumber"`
+			DaysRemaining int    `json:"daysRemaining"`
+			Profiles      []struct {
+				ID string `json:"id"`
+			} `json:"profiles"`
+		} `json:"certificates"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.CheckedCount != 2 || result.ExpiringCount != 1 || len(result.Certificates) != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	item := result.Certificates[0]
+	if item.ID != "cert-soon" || item.SerialNumber != "ABC" || item.DaysRemaining != 9 || len(item.Profiles) != 1 || item.Profiles[0].ID != "prof-1" {
+		t.Fatalf("unexpected certificate: %+v", item)
+	}
+}
+
+func TestCertificatesCheckPassesWithoutExpiringCertificates(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	later := time.Now().UTC().Add(200 * 24 * time.Hour).Format(time.RFC3339)
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/certificates" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body := `{"data":[{"type":"certificates","id":"cert-later","attributes":{"name":"Later","expirationDate":"` + later + `"}}]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"certificates", "check"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"expiringCount":0`) || !strings.Contains(stdout, `"certificates":[]`) {
+		t.Fatalf("unexpected output: %q", stdout)
+	}
+}
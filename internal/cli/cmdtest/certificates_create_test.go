@@ -0,0 +1,168 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCertificatesCreateGeneratesKeyAndWritesCertificate(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	certificate := []byte("issued-certificate-der")
+	var csrContent string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/certificates" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		var payload struct {
+			Data struct {
+				Attributes struct {
+					CSRContent      string `json:"csrContent"`
+					CertificateType string `json:"certificateType"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if payload.Data.Attributes.CertificateType != "IOS_DISTRIBUTION" {
+			t.Fatalf("unexpected certificate type %q", payload.Data.Attributes.CertificateType)
+		}
+		csrContent = payload.Data.Attributes.CSRContent
+		body := `{"data":{"type":"certificates","id":"cert-1","attributes":{"certificateType":"IOS_DISTRIBUTION","certificateContent":"` +
+			base64.StdEncoding.EncodeToString(certificate) + `"}}}`
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "dist.key")
+	certPath := filepath.Join(dir, "dist.cer")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"certificates", "create", "--certificate-type", "ios_distribution", "--generate-key", keyPath, "--common-name", "Example", "--certificate-out", certPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"cert-1"`) {
+		t.Fatalf("expected certificate in output, got %q", stdout)
+	}
+
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		t.Fatalf("read key: %v", err)
+	}
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		t.Fatalf("expected PEM key, got %q", keyPEM)
+	}
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("parse key: %v", err)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(csrContent)
+	if err != nil {
+		t.Fatalf("decode CSR: %v", err)
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		t.Fatalf("parse CSR: %v", err)
+	}
+	if csr.Subject.CommonName != "Example" || !key.PublicKey.Equal(csr.PublicKey) {
+		t.Fatalf("expected submitted CSR to match the written key")
+	}
+
+	written, err := os.ReadFile(certPath)
+	if err != nil {
+		t.Fatalf("read certificate: %v", err)
+	}
+	if string(written) != string(certificate) {
+		t.Fatalf("unexpected certificate content %q", written)
+	}
+}
+
+func TestCertificatesCreateLinksPassTypeID(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/certificates" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		var payload struct {
+			Data struct {
+				Attributes struct {
+					CertificateType string `json:"certificateType"`
+				} `json:"attributes"`
+				Relationships struct {
+					PassTypeID struct {
+						Data struct {
+							Type string `json:"type"`
+							ID   string `json:"id"`
+						} `json:"data"`
+					} `json:"passTypeId"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if payload.Data.Attributes.CertificateType != "PASS_TYPE_ID" {
+			t.Fatalf("expected default PASS_TYPE_ID, got %q", payload.Data.Attributes.CertificateType)
+		}
+		link := payload.Data.Relationships.PassTypeID.Data
+		if link.Type != "passTypeIds" || link.ID != "pass-1" {
+			t.Fatalf("unexpected passTypeId relationship: %+v", link)
+		}
+		body := `{"data":{"type":"certificates","id":"cert-pass","attributes":{"certificateType":"PASS_TYPE_ID"}}}`
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	keyPath := filepath.Join(t.TempDir(), "pass.key")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"certificates", "create", "--pass-type-id", "pass-1", "--generate-key", keyPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"cert-pass"`) {
+		t.Fatalf("expected certificate in output, got %q", stdout)
+	}
+}
@@ -0,0 +1,150 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCertificatesDownloadValidationErrors(t *testing.T) {
+	t.Setenv("ASC_P12_PASSWORD", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "missing id", args: []string{"certificates", "download", "--output", "dist.cer"}, wantErr: "--id is required"},
+		{name: "missing output", args: []string{"certificates", "download", "--id", "cert-1"}, wantErr: "--output is required"},
+		{name: "key without password", args: []string{"certificates", "download", "--id", "cert-1", "--output", "dist.p12", "--key", "dist.key"}, wantErr: "--password (or ASC_P12_PASSWORD) is required"},
+		{name: "password without key", args: []string{"certificates", "download", "--id", "cert-1", "--output", "dist.cer", "--password", "secret"}, wantErr: "--password requires --key"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestCertificatesDownloadWritesCerAndP12(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_P12_PASSWORD", "secret")
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Example Distribution"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/certificates/cert-1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body := `{"data":{"type":"certificates","id":"cert-1","attributes":{"name":"Example Distribution","certificateType":"IOS_DISTRIBUTION","certificateContent":"` +
+			base64.StdEncoding.EncodeToString(certDER) + `"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "dist.key")
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(keyPath, keyPEM, 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+
+	run := func(args ...string) map[string]string {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(append([]string{"certificates", "download", "--id", "cert-1"}, args...)); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		var result map[string]string
+		if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+			t.Fatalf("parse output: %v (%q)", err, stdout)
+		}
+		return result
+	}
+
+	cerPath := filepath.Join(dir, "dist.cer")
+	result := run("--output", cerPath)
+	if result["format"] != "cer" || result["outputPath"] != cerPath || result["name"] != "Example Distribution" {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	written, err := os.ReadFile(cerPath)
+	if err != nil {
+		t.Fatalf("read certificate: %v", err)
+	}
+	if string(written) != string(certDER) {
+		t.Fatal("expected DER certificate to be written")
+	}
+
+	p12Path := filepath.Join(dir, "dist.p12")
+	result = run("--key", keyPath, "--output", p12Path)
+	if result["format"] != "p12" || result["outputPath"] != p12Path {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	info, err := os.Stat(p12Path)
+	if err != nil {
+		t.Fatalf("stat p12: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected 0600 permissions, got %v", info.Mode().Perm())
+	}
+}
@@ -0,0 +1,188 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCertificatesRevokeValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "missing selection", args: []string{"certificates", "revoke", "--confirm"}, wantErr: "--id, --ids-from-file, or --expired-only is required"},
+		{name: "missing confirm", args: []string{"certificates", "revoke", "--expired-only"}, wantErr: "--confirm is required"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestCertificatesRevokeExpiredOnlyDryRunListsProfiles(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("dry run must not mutate: %s %s", req.Method, req.URL.String())
+		}
+		body := ""
+		switch req.URL.Path {
+		case "/v1/certificates":
+			body = `{"data":[` +
+				`{"type":"certificates","id":"cert-old","attributes":{"name":"Old Dist","certificateType":"IOS_DISTRIBUTION","expirationDate":"2020-01-01T00:00:00.000+00:00"}},` +
+				`{"type":"certificates","id":"cert-new","attributes":{"name":"New Dist","certificateType":"IOS_DISTRIBUTION","expirationDate":"2999-01-01T00:00:00.000+00:00"}}]}`
+		case "/v1/profiles":
+			if got := req.URL.Query().Get("include"); got != "certificates" {
+				t.Fatalf("expected include=certificates, got %q", got)
+			}
+			body = `{"data":[` +
+				`{"type":"profiles","id":"prof-1","attributes":{"name":"App Store","profileType":"IOS_APP_STORE"},"relationships":{"certificates":{"data":[{"type":"certificates","id":"cert-old"},{"type":"certificates","id":"cert-new"}]}}},` +
+				`{"type":"profiles","id":"prof-2","attributes":{"name":"Ad Hoc","profileType":"IOS_APP_ADHOC"},"relationships":{"certificates":{"data":[{"type":"certificates","id":"cert-new"}]}}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"certificates", "revoke", "--expired-only", "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		DryRun        bool `json:"dryRun"`
+		SelectedCount int  `json:"selectedCount"`
+		Certificates  []struct {
+			ID               string `json:"id"`
+			Expired          bool   `json:"expired"`
+			AffectedProfiles []struct {
+				ID string `json:"id"`
+			} `json:"affectedProfiles"`
+		} `json:"certificates"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if !result.DryRun || result.SelectedCount != 1 || len(result.Certificates) != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	certificate := result.Certificates[0]
+	if certificate.ID != "cert-old" || !certificate.Expired || len(certificate.AffectedProfiles) != 1 || certificate.AffectedProfiles[0].ID != "prof-1" {
+		t.Fatalf("unexpected certificate: %+v", certificate)
+	}
+}
+
+func TestCertificatesRevokeIDsFromFileReportsFailures(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	idsPath := filepath.Join(t.TempDir(), "ids.txt")
+	if err := os.WriteFile(idsPath, []byte("cert-1\ncert-2\n"), 0o600); err != nil {
+		t.Fatalf("write ids: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var revoked []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		id := strings.TrimPrefix(req.URL.Path, "/v1/certificates/")
+		status := http.StatusOK
+		body := ""
+		switch req.Method {
+		case http.MethodGet:
+			body = `{"data":{"type":"certificates","id":"` + id + `","attributes":{"name":"` + id + `","certificateType":"IOS_DISTRIBUTION"}}}`
+		case http.MethodDelete:
+			revoked = append(revoked, id)
+			status = http.StatusNoContent
+			if id == "cert-2" {
+				status = http.StatusConflict
+				body = `{"errors":[{"status":"409","code":"CONFLICT","title":"Conflict","detail":"in use"}]}`
+			}
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"certificates", "revoke", "--ids-from-file", idsPath, "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "1 certificates failed to revoke") {
+		t.Fatalf("expected failure error, got %v", runErr)
+	}
+
+	var result struct {
+		RevokedCount int `json:"revokedCount"`
+		Failures     []struct {
+			ID string `json:"id"`
+		} `json:"failures"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.RevokedCount != 1 || len(result.Failures) != 1 || result.Failures[0].ID != "cert-2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if strings.Join(revoked, ",") != "cert-1,cert-2" {
+		t.Fatalf("unexpected revocations: %v", revoked)
+	}
+}
@@ -891,6 +891,21 @@ func TestIAPValidationErrors(t *testing.T) {
 			args:    []string{"iap", "localizations", "update", "--name", "Title"},
 			wantErr: "--localization-id is required",
 		},
+		{
+			name:    "iap localizations set missing locale",
+			args:    []string{"iap", "localizations", "set", "--iap-id", "IAP_ID", "--name", "Title"},
+			wantErr: "--locale is required",
+		},
+		{
+			name:    "iap localizations set missing values",
+			args:    []string{"iap", "localizations", "set", "--iap-id", "IAP_ID", "--locale", "en-US"},
+			wantErr: "--name or --description is required",
+		},
+		{
+			name:    "iap localizations import missing dir",
+			args:    []string{"iap", "localizations", "import", "--iap-id", "IAP_ID"},
+			wantErr: "--dir is required",
+		},
 		{
 			name:    "iap localizations delete missing confirm",
 			args:    []string{"iap", "localizations", "delete", "--localization-id", "LOC_ID"},
@@ -931,6 +946,16 @@ func TestIAPValidationErrors(t *testing.T) {
 			args:    []string{"iap", "review-screenshots", "create", "--iap-id", "IAP_ID"},
 			wantErr: "--file is required",
 		},
+		{
+			name:    "iap review-screenshots upload missing iap-id",
+			args:    []string{"iap", "review-screenshots", "upload", "--file", "./review.png"},
+			wantErr: "--iap-id is required",
+		},
+		{
+			name:    "iap review-screenshots upload missing file",
+			args:    []string{"iap", "review-screenshots", "upload", "--iap-id", "IAP_ID"},
+			wantErr: "--file is required",
+		},
 		{
 			name:    "iap review-screenshots update missing screenshot-id",
 			args:    []string{"iap", "review-screenshots", "update", "--file", "./review.png"},
@@ -991,6 +1016,26 @@ func TestIAPValidationErrors(t *testing.T) {
 			args:    []string{"iap", "price-schedules", "create", "--iap-id", "IAP_ID", "--base-territory", "USA"},
 			wantErr: "--prices is required",
 		},
+		{
+			name:    "iap price-schedules set missing price",
+			args:    []string{"iap", "price-schedules", "set", "--iap-id", "IAP_ID"},
+			wantErr: "--price-point or --price is required",
+		},
+		{
+			name:    "iap price-schedules set price and price-point",
+			args:    []string{"iap", "price-schedules", "set", "--iap-id", "IAP_ID", "--price", "4.99", "--price-point", "PP_ID"},
+			wantErr: "--price-point and --price are mutually exclusive",
+		},
+		{
+			name:    "iap price-schedules set invalid start-date",
+			args:    []string{"iap", "price-schedules", "set", "--iap-id", "IAP_ID", "--price-point", "PP_ID", "--start-date", "03/01/2026"},
+			wantErr: "--start-date must be in YYYY-MM-DD format",
+		},
+		{
+			name:    "iap price-points find missing price",
+			args:    []string{"iap", "price-points", "find", "--iap-id", "IAP_ID"},
+			wantErr: "--price is required",
+		},
 		{
 			name:    "iap price-schedules manual-prices missing schedule-id",
 			args:    []string{"iap", "price-schedules", "manual-prices"},
@@ -1061,6 +1106,11 @@ func TestIAPValidationErrors(t *testing.T) {
 			args:    []string{"iap", "submit", "--iap-id", "IAP_ID"},
 			wantErr: "--confirm is required",
 		},
+		{
+			name:    "iap submit dry-run with skip-preflight",
+			args:    []string{"iap", "submit", "--iap-id", "IAP_ID", "--dry-run", "--skip-preflight"},
+			wantErr: "--dry-run and --skip-preflight are mutually exclusive",
+		},
 	}
 
 	for _, test := range tests {
@@ -1121,17 +1171,27 @@ func TestUsersValidationErrors(t *testing.T) {
 		{
 			name:    "users get missing id",
 			args:    []string{"users", "get"},
-			wantErr: "--id is required",
+			wantErr: "--id or --email is required",
+		},
+		{
+			name:    "users get id and email",
+			args:    []string{"users", "get", "--id", "USER_ID", "--email", "user@example.com"},
+			wantErr: "--id and --email are mutually exclusive",
 		},
 		{
 			name:    "users update missing id",
 			args:    []string{"users", "update", "--roles", "ADMIN"},
-			wantErr: "--id is required",
+			wantErr: "--id or --email is required",
 		},
 		{
-			name:    "users update missing roles",
+			name:    "users update missing changes",
 			args:    []string{"users", "update", "--id", "USER_ID"},
-			wantErr: "--roles is required",
+			wantErr: "--roles, --visible-apps, or --all-apps-visible is required",
+		},
+		{
+			name:    "users update visible apps with all apps visible",
+			args:    []string{"users", "update", "--id", "USER_ID", "--visible-apps", "APP_ID", "--all-apps-visible=true"},
+			wantErr: "--visible-apps cannot be used with --all-apps-visible=true",
 		},
 		{
 			name:    "users delete missing confirm",
@@ -1141,7 +1201,17 @@ func TestUsersValidationErrors(t *testing.T) {
 		{
 			name:    "users delete missing id",
 			args:    []string{"users", "delete", "--confirm"},
-			wantErr: "--id is required",
+			wantErr: "--id or --email is required",
+		},
+		{
+			name:    "users offboard missing email",
+			args:    []string{"users", "offboard", "--confirm"},
+			wantErr: "--email is required",
+		},
+		{
+			name:    "users offboard missing confirm",
+			args:    []string{"users", "offboard", "--email", "user@example.com"},
+			wantErr: "--confirm is required",
 		},
 		{
 			name:    "users invite missing email",
@@ -1166,12 +1236,12 @@ func TestUsersValidationErrors(t *testing.T) {
 		{
 			name:    "users invite missing access",
 			args:    []string{"users", "invite", "--email", "user@example.com", "--first-name", "Jane", "--last-name", "Doe", "--roles", "ADMIN"},
-			wantErr: "--all-apps or --visible-app is required",
+			wantErr: "--all-apps or --visible-apps is required",
 		},
 		{
 			name:    "users invite conflicting access",
 			args:    []string{"users", "invite", "--email", "user@example.com", "--first-name", "Jane", "--last-name", "Doe", "--roles", "ADMIN", "--all-apps", "--visible-app", "APP_ID"},
-			wantErr: "--all-apps and --visible-app cannot be used together",
+			wantErr: "--all-apps and --visible-apps cannot be used together",
 		},
 		{
 			name:    "users invites get missing id",
@@ -1186,7 +1256,12 @@ func TestUsersValidationErrors(t *testing.T) {
 		{
 			name:    "users invites revoke missing id",
 			args:    []string{"users", "invites", "revoke", "--confirm"},
-			wantErr: "--id is required",
+			wantErr: "--id or --email is required",
+		},
+		{
+			name:    "users invitations cancel missing confirm",
+			args:    []string{"users", "invitations", "cancel", "--email", "user@example.com"},
+			wantErr: "--confirm is required",
 		},
 	}
 
@@ -1223,6 +1298,16 @@ func TestPricingValidationErrors(t *testing.T) {
 		args    []string
 		wantErr string
 	}{
+		{
+			name:    "pricing price-points find missing price",
+			args:    []string{"pricing", "price-points", "find", "--app", "APP_ID"},
+			wantErr: "--price is required",
+		},
+		{
+			name:    "pricing price-points find invalid price",
+			args:    []string{"pricing", "price-points", "find", "--app", "APP_ID", "--price", "abc"},
+			wantErr: "--price must be a number",
+		},
 		{
 			name:    "pricing schedule get missing app and id",
 			args:    []string{"pricing", "schedule", "get"},
@@ -1243,6 +1328,21 @@ func TestPricingValidationErrors(t *testing.T) {
 			args:    []string{"pricing", "availability", "get", "--app", "APP_ID", "--id", "AVAILABILITY_ID"},
 			wantErr: "Error: --id and --app are mutually exclusive",
 		},
+		{
+			name:    "pricing availability set missing territory",
+			args:    []string{"pricing", "availability", "set", "--app", "APP_ID", "--available", "true"},
+			wantErr: "Error: --territory or --all is required",
+		},
+		{
+			name:    "pricing availability set all with territory",
+			args:    []string{"pricing", "availability", "set", "--app", "APP_ID", "--all", "--territory", "USA", "--available", "true"},
+			wantErr: "Error: --all and --territory are mutually exclusive",
+		},
+		{
+			name:    "pricing availability set except without all",
+			args:    []string{"pricing", "availability", "set", "--app", "APP_ID", "--territory", "USA", "--except", "CHN", "--available", "true"},
+			wantErr: "Error: --except requires --all",
+		},
 	}
 
 	for _, test := range tests {
@@ -1293,6 +1393,16 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "groups", "create", "--app", "APP_ID"},
 			wantErr: "--reference-name is required",
 		},
+		{
+			name:    "subscriptions groups create name without locale",
+			args:    []string{"subscriptions", "groups", "create", "--app", "APP_ID", "--reference-name", "Premium", "--name", "Premium"},
+			wantErr: "--name and --custom-app-name require --locale",
+		},
+		{
+			name:    "subscriptions groups create locale without name",
+			args:    []string{"subscriptions", "groups", "create", "--app", "APP_ID", "--reference-name", "Premium", "--locale", "en-US"},
+			wantErr: "--name is required with --locale",
+		},
 		{
 			name:    "subscriptions groups get missing id",
 			args:    []string{"subscriptions", "groups", "get"},
@@ -1358,6 +1468,11 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "update", "--id", "SUB_ID", "--subscription-period", "BAD"},
 			wantErr: "--subscription-period must be one of",
 		},
+		{
+			name:    "subscriptions update negative group level",
+			args:    []string{"subscriptions", "update", "--id", "SUB_ID", "--group-level", "-1"},
+			wantErr: "--group-level must be at least 1",
+		},
 		{
 			name:    "subscriptions delete missing confirm",
 			args:    []string{"subscriptions", "delete", "--id", "SUB_ID"},
@@ -1378,6 +1493,16 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "prices", "list"},
 			wantErr: "--id is required",
 		},
+		{
+			name:    "subscriptions prices import missing csv",
+			args:    []string{"subscriptions", "prices", "import", "--id", "SUB_ID"},
+			wantErr: "--csv is required",
+		},
+		{
+			name:    "subscriptions prices import invalid start-date",
+			args:    []string{"subscriptions", "prices", "import", "--id", "SUB_ID", "--csv", "prices.csv", "--start-date", "tomorrow"},
+			wantErr: "--start-date must be in YYYY-MM-DD format",
+		},
 		{
 			name:    "subscriptions prices delete missing price-id",
 			args:    []string{"subscriptions", "prices", "delete", "--confirm"},
@@ -1421,12 +1546,17 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 		{
 			name:    "subscriptions grace-periods get missing id",
 			args:    []string{"subscriptions", "grace-periods", "get"},
-			wantErr: "--id is required",
+			wantErr: "--id or --app is required",
 		},
 		{
 			name:    "subscriptions grace-periods update missing id",
 			args:    []string{"subscriptions", "grace-periods", "update"},
-			wantErr: "--id is required",
+			wantErr: "--id or --app is required",
+		},
+		{
+			name:    "subscriptions grace-periods update id and app",
+			args:    []string{"subscriptions", "grace-periods", "update", "--id", "GRACE_ID", "--app", "APP_ID", "--opt-in"},
+			wantErr: "--id and --app are mutually exclusive",
 		},
 		{
 			name:    "subscriptions grace-periods update missing update flags",
@@ -1513,6 +1643,21 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "promotional-offers", "create", "--subscription-id", "SUB_ID", "--offer-code", "SPRING", "--name", "Spring", "--offer-duration", "ONE_MONTH", "--offer-mode", "FREE_TRIAL", "--number-of-periods", "1"},
 			wantErr: "--prices is required",
 		},
+		{
+			name:    "subscriptions promotional-offers create mixed prices",
+			args:    []string{"subscriptions", "promotional-offers", "create", "--subscription-id", "SUB_ID", "--offer-code", "SPRING", "--name", "Spring", "--offer-duration", "ONE_MONTH", "--offer-mode", "FREE_TRIAL", "--number-of-periods", "1", "--prices", "USA:PP_ID,PRICE_ID"},
+			wantErr: "--prices cannot mix",
+		},
+		{
+			name:    "subscriptions promotional-offers signature-params missing subscription-id",
+			args:    []string{"subscriptions", "promotional-offers", "signature-params", "--offer-code", "SPRING", "--app", "APP_ID"},
+			wantErr: "--subscription-id is required",
+		},
+		{
+			name:    "subscriptions promotional-offers signature-params missing offer-code",
+			args:    []string{"subscriptions", "promotional-offers", "signature-params", "--subscription-id", "SUB_ID", "--app", "APP_ID"},
+			wantErr: "--offer-code is required",
+		},
 		{
 			name:    "subscriptions promotional-offers update missing prices",
 			args:    []string{"subscriptions", "promotional-offers", "update", "--id", "OFFER_ID"},
@@ -1528,6 +1673,26 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "promotional-offers", "prices"},
 			wantErr: "--id is required",
 		},
+		{
+			name:    "subscriptions offer-codes generate missing offer-code-id",
+			args:    []string{"subscriptions", "offer-codes", "generate", "--count", "10", "--expiration-date", "2026-12-31"},
+			wantErr: "--offer-code-id is required",
+		},
+		{
+			name:    "subscriptions offer-codes generate missing count",
+			args:    []string{"subscriptions", "offer-codes", "generate", "--offer-code-id", "OFFER_CODE_ID", "--expiration-date", "2026-12-31"},
+			wantErr: "--count must be greater than 0",
+		},
+		{
+			name:    "subscriptions offer-codes generate missing expiration-date",
+			args:    []string{"subscriptions", "offer-codes", "generate", "--offer-code-id", "OFFER_CODE_ID", "--count", "10"},
+			wantErr: "--expiration-date is required",
+		},
+		{
+			name:    "subscriptions offer-codes codes missing subscription-id",
+			args:    []string{"subscriptions", "offer-codes", "codes"},
+			wantErr: "--subscription-id is required",
+		},
 		{
 			name:    "subscriptions offer-codes list missing subscription-id",
 			args:    []string{"subscriptions", "offer-codes", "list"},
@@ -1618,6 +1783,16 @@ func TestSubscriptionsValidationErrors(t *testing.T) {
 			args:    []string{"subscriptions", "groups", "localizations", "update", "--id", "LOC_ID"},
 			wantErr: "at least one update flag is required",
 		},
+		{
+			name:    "subscriptions groups localizations set missing locale",
+			args:    []string{"subscriptions", "groups", "localizations", "set", "--group-id", "GROUP_ID", "--name", "Premium"},
+			wantErr: "--locale is required",
+		},
+		{
+			name:    "subscriptions groups localizations set missing values",
+			args:    []string{"subscriptions", "groups", "localizations", "set", "--group-id", "GROUP_ID", "--locale", "en-US"},
+			wantErr: "--name or --custom-app-name is required",
+		},
 		{
 			name:    "subscriptions groups localizations delete missing confirm",
 			args:    []string{"subscriptions", "groups", "localizations", "delete", "--id", "LOC_ID"},
@@ -1952,6 +2127,24 @@ func TestAgeRatingValidationErrors(t *testing.T) {
 			wantErr:  "--gambling-simulated must be one of",
 			wantHelp: false,
 		},
+		{
+			name:     "age-rating set invalid loot box",
+			args:     []string{"age-rating", "set", "--id", "AGE_ID", "--loot-box", "maybe"},
+			wantErr:  "--loot-box must be true or false",
+			wantHelp: false,
+		},
+		{
+			name:     "age-rating set invalid korea override",
+			args:     []string{"age-rating", "set", "--id", "AGE_ID", "--korea-age-rating-override", "TWELVE_PLUS"},
+			wantErr:  "--korea-age-rating-override must be one of",
+			wantHelp: false,
+		},
+		{
+			name:     "age-rating set missing file",
+			args:     []string{"age-rating", "set", "--id", "AGE_ID", "--file", filepath.Join(t.TempDir(), "missing.json")},
+			wantErr:  "read --file",
+			wantHelp: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -3067,6 +3260,11 @@ func TestAssetsValidationErrors(t *testing.T) {
 			args:    []string{"assets", "screenshots", "upload", "--path", "./screenshots", "--device-type", "IPHONE_65"},
 			wantErr: "--version-localization is required",
 		},
+		{
+			name:    "assets screenshots upload set with device type",
+			args:    []string{"assets", "screenshots", "upload", "--set", "SET_ID", "--path", "./shot.png", "--device-type", "IPHONE_65"},
+			wantErr: "--set cannot be combined with --version-localization or --device-type",
+		},
 		{
 			name:    "assets screenshots upload missing path",
 			args:    []string{"assets", "screenshots", "upload", "--version-localization", "LOC_ID", "--device-type", "IPHONE_65"},
@@ -3077,6 +3275,16 @@ func TestAssetsValidationErrors(t *testing.T) {
 			args:    []string{"assets", "screenshots", "upload", "--version-localization", "LOC_ID", "--path", "./screenshots"},
 			wantErr: "--device-type is required",
 		},
+		{
+			name:    "assets screenshots upload zero concurrency",
+			args:    []string{"assets", "screenshots", "upload", "--set", "SET_ID", "--path", "./screenshots", "--concurrency", "0"},
+			wantErr: "--concurrency must be at least 1",
+		},
+		{
+			name:    "assets screenshots sync zero concurrency",
+			args:    []string{"assets", "screenshots", "sync", "--version-id", "VERSION_ID", "--dir", "./screenshots", "--concurrency", "0"},
+			wantErr: "--concurrency must be at least 1",
+		},
 		{
 			name:    "assets screenshots delete missing id",
 			args:    []string{"assets", "screenshots", "delete"},
@@ -3087,16 +3295,66 @@ func TestAssetsValidationErrors(t *testing.T) {
 			args:    []string{"assets", "screenshots", "delete", "--id", "SCREENSHOT_ID"},
 			wantErr: "--confirm is required to delete",
 		},
+		{
+			name:    "assets screenshots delete version without locale",
+			args:    []string{"assets", "screenshots", "delete", "--version-id", "VERSION_ID", "--confirm"},
+			wantErr: "--locale is required with --version-id",
+		},
+		{
+			name:    "assets screenshots delete id with version",
+			args:    []string{"assets", "screenshots", "delete", "--id", "SCREENSHOT_ID", "--version-id", "VERSION_ID", "--locale", "de-DE", "--confirm"},
+			wantErr: "--id and --version-id are mutually exclusive",
+		},
+		{
+			name:    "assets screenshots download missing out",
+			args:    []string{"assets", "screenshots", "download", "--version-id", "VERSION_ID"},
+			wantErr: "--out is required",
+		},
+		{
+			name:    "assets screenshots reorder missing set",
+			args:    []string{"assets", "screenshots", "reorder", "--ids", "SHOT_1"},
+			wantErr: "--set is required",
+		},
+		{
+			name:    "assets screenshots reorder missing order",
+			args:    []string{"assets", "screenshots", "reorder", "--set", "SET_ID"},
+			wantErr: "--ids or --by-filename is required",
+		},
+		{
+			name:    "assets screenshots reorder ids with by-filename",
+			args:    []string{"assets", "screenshots", "reorder", "--set", "SET_ID", "--ids", "SHOT_1", "--by-filename"},
+			wantErr: "--ids and --by-filename are mutually exclusive",
+		},
+		{
+			name:    "assets screenshots validate missing dir",
+			args:    []string{"assets", "screenshots", "validate"},
+			wantErr: "--dir is required",
+		},
 		{
 			name:    "assets previews list missing localization",
 			args:    []string{"assets", "previews", "list"},
 			wantErr: "--version-localization is required",
 		},
+		{
+			name:    "assets previews upload zero concurrency",
+			args:    []string{"assets", "previews", "upload", "--set", "SET_ID", "--path", "./previews", "--concurrency", "0"},
+			wantErr: "--concurrency must be at least 1",
+		},
 		{
 			name:    "assets previews upload missing localization",
 			args:    []string{"assets", "previews", "upload", "--path", "./previews", "--device-type", "IPHONE_65"},
 			wantErr: "--version-localization is required",
 		},
+		{
+			name:    "assets previews upload set with localization",
+			args:    []string{"assets", "previews", "upload", "--set", "SET_ID", "--version-localization", "LOC_ID", "--path", "./preview.mp4"},
+			wantErr: "--set cannot be combined with --version-localization or --device-type",
+		},
+		{
+			name:    "assets previews upload invalid poster time",
+			args:    []string{"assets", "previews", "upload", "--set", "SET_ID", "--path", "./preview.mp4", "--poster-time", "5s"},
+			wantErr: "--poster-time must be HH:MM:SS or HH:MM:SS:FF",
+		},
 		{
 			name:    "assets previews upload missing path",
 			args:    []string{"assets", "previews", "upload", "--version-localization", "LOC_ID", "--device-type", "IPHONE_65"},
@@ -4671,7 +4929,7 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 		{
 			name:    "xcode-cloud run missing branch",
 			args:    []string{"xcode-cloud", "run", "--workflow-id", "WF_ID"},
-			wantErr: "--branch or --git-reference-id is required",
+			wantErr: "--branch, --tag, --pr or --git-reference-id is required",
 		},
 		{
 			name:    "xcode-cloud run workflow by name without app",
@@ -4766,7 +5024,7 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 		{
 			name:    "xcode-cloud artifacts download missing id",
 			args:    []string{"xcode-cloud", "artifacts", "download", "--path", "./artifact.zip"},
-			wantErr: "--id is required",
+			wantErr: "--id or --run-id is required",
 		},
 		{
 			name:    "xcode-cloud artifacts download missing path",
@@ -4786,7 +5044,7 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 		{
 			name:    "xcode-cloud issues list missing action-id",
 			args:    []string{"xcode-cloud", "issues", "list"},
-			wantErr: "--action-id is required",
+			wantErr: "--action-id or --run-id is required",
 		},
 		{
 			name:    "xcode-cloud issues get missing id",
@@ -4894,7 +5152,7 @@ func TestXcodeCloudMutualExclusiveFlags(t *testing.T) {
 		{
 			name:    "xcode-cloud run branch and git-reference-id are mutually exclusive",
 			args:    []string{"xcode-cloud", "run", "--workflow-id", "WF_ID", "--branch", "main", "--git-reference-id", "REF_ID"},
-			wantErr: "--branch and --git-reference-id are mutually exclusive",
+			wantErr: "--branch, --tag, --pr and --git-reference-id are mutually exclusive",
 		},
 		{
 			name:    "xcode-cloud run invalid poll-interval",
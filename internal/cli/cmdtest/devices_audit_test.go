@@ -0,0 +1,69 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDevicesAuditDisabledOnlyCSV(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("audit must not mutate: %s %s", req.Method, req.URL.String())
+		}
+		body := ""
+		switch req.URL.Path {
+		case "/v1/devices":
+			if got := req.URL.Query().Get("filter[status]"); got != "DISABLED" {
+				t.Fatalf("expected filter[status]=DISABLED, got %q", got)
+			}
+			body = `{"data":[{"type":"devices","id":"dev-1","attributes":{"name":"Old iPad","udid":"UDID-1","platform":"IOS","status":"DISABLED","model":"iPad Air"}}]}`
+		case "/v1/profiles":
+			body = `{"data":[{"type":"profiles","id":"prof-1","attributes":{"name":"Team Dev","profileType":"IOS_APP_DEVELOPMENT","createdDate":"2026-01-02T00:00:00.000+00:00"}}]}`
+		case "/v1/profiles/prof-1/relationships/devices":
+			body = `{"data":[{"type":"devices","id":"dev-1"}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "audit", "--disabled-only", "--output", "csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	records, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv: %v (%q)", err, stdout)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected header and one row, got %v", records)
+	}
+	row := strings.Join(records[1], "|")
+	if row != "dev-1|Old iPad|UDID-1|IOS|DISABLED||iPad Air||1|Team Dev|2026-01-02T00:00:00.000+00:00" {
+		t.Fatalf("unexpected row: %q", row)
+	}
+}
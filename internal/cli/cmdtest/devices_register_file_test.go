@@ -0,0 +1,161 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDevicesRegisterFileSkipsExistingAndReportsFailures(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	path := filepath.Join(t.TempDir(), "devices.txt")
+	content := "Device ID\tDevice Name\tDevice Platform\n" +
+		"udid-existing\tOld iPhone\tios\n" +
+		"udid-new\tNoah iPhone\tios\n" +
+		"udid-bad\tBroken Mac\tmac\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write devices file: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var created []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/devices":
+			body = `{"data":[{"type":"devices","id":"dev-1","attributes":{"name":"Old iPhone","udid":"UDID-EXISTING","platform":"IOS"}}]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/devices":
+			var payload struct {
+				Data struct {
+					Attributes struct {
+						Name     string `json:"name"`
+						UDID     string `json:"udid"`
+						Platform string `json:"platform"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			attrs := payload.Data.Attributes
+			created = append(created, attrs.UDID+"/"+attrs.Platform)
+			if attrs.UDID == "udid-bad" {
+				status = http.StatusConflict
+				body = `{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"Invalid","detail":"invalid UDID"}]}`
+				break
+			}
+			status = http.StatusCreated
+			body = `{"data":{"type":"devices","id":"dev-2","attributes":{"name":"` + attrs.Name + `","udid":"` + attrs.UDID + `","platform":"` + attrs.Platform + `"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "register", "--file", path}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "1 devices failed to register") {
+		t.Fatalf("expected failure error, got %v", runErr)
+	}
+	if strings.Join(created, ",") != "udid-new/IOS,udid-bad/MAC_OS" {
+		t.Fatalf("unexpected registrations: %v", created)
+	}
+
+	var result struct {
+		Total           int `json:"total"`
+		RegisteredCount int `json:"registeredCount"`
+		SkippedCount    int `json:"skippedCount"`
+		Devices         []struct {
+			Line   int    `json:"line"`
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"devices"`
+		Failures []struct {
+			Line int    `json:"line"`
+			UDID string `json:"udid"`
+		} `json:"failures"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.Total != 3 || result.RegisteredCount != 1 || result.SkippedCount != 1 || len(result.Devices) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.Devices[0].ID != "dev-1" || result.Devices[0].Status != "existing" || result.Devices[1].ID != "dev-2" || result.Devices[1].Status != "registered" {
+		t.Fatalf("unexpected devices: %+v", result.Devices)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].Line != 4 || result.Failures[0].UDID != "udid-bad" {
+		t.Fatalf("unexpected failures: %+v", result.Failures)
+	}
+}
+
+func TestDevicesDisableByUDID(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/devices":
+			if got := req.URL.Query().Get("filter[udid]"); got != "UDID-1" {
+				t.Fatalf("expected filter[udid]=UDID-1, got %q", got)
+			}
+			body = `{"data":[{"type":"devices","id":"dev-1","attributes":{"name":"iPhone","udid":"UDID-1","platform":"IOS","status":"ENABLED"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/devices/dev-1":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"status":"DISABLED"`) {
+				t.Fatalf("expected DISABLED status in body, got %s", payload)
+			}
+			body = `{"data":{"type":"devices","id":"dev-1","attributes":{"name":"iPhone","udid":"UDID-1","platform":"IOS","status":"DISABLED"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "disable", "--udid", "UDID-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"status":"DISABLED"`) {
+		t.Fatalf("expected disabled device in output, got %q", stdout)
+	}
+}
@@ -39,6 +39,16 @@ func TestFinanceReportsValidationErrors(t *testing.T) {
 			args:    []string{"finance", "reports", "--vendor", "12345678", "--report-type", "FINANCIAL", "--region", "US"},
 			wantErr: "--date is required",
 		},
+		{
+			name:    "backfill missing to",
+			args:    []string{"finance", "reports", "--vendor", "12345678", "--report-type", "FINANCIAL", "--region", "US", "--from", "2025-01"},
+			wantErr: "--from and --to must be used together",
+		},
+		{
+			name:    "backfill with date",
+			args:    []string{"finance", "reports", "--vendor", "12345678", "--report-type", "FINANCIAL", "--region", "US", "--from", "2025-01", "--to", "2025-03", "--date", "2025-12"},
+			wantErr: "--from/--to cannot be used with --date or --output",
+		},
 	}
 
 	for _, test := range tests {
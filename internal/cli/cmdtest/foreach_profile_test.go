@@ -0,0 +1,179 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/foreachprofile"
+)
+
+func setupForeachProfiles(t *testing.T, names ...string) {
+	t.Helper()
+
+	keys := make([]map[string]string, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, map[string]string{
+			"name":             name,
+			"key_id":           "KEY_" + name,
+			"issuer_id":        "ISSUER_" + name,
+			"private_key_path": "/tmp/" + name + ".p8",
+		})
+	}
+	cfg := map[string]interface{}{
+		"default_key_name": names[0],
+		"key_id":           keys[0]["key_id"],
+		"issuer_id":        keys[0]["issuer_id"],
+		"private_key_path": keys[0]["private_key_path"],
+		"keys":             keys,
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatalf("marshal config: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	t.Setenv("ASC_CONFIG_PATH", path)
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+}
+
+func TestForeachProfileValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"missing command", []string{"foreach-profile"}, "Error: a command to run is required after --"},
+		{"recursive", []string{"foreach-profile", "--", "foreach-profile", "--", "apps", "list"}, "Error: foreach-profile cannot run itself"},
+		{"invalid concurrency", []string{"foreach-profile", "--concurrency", "0", "--", "apps", "list"}, "Error: --concurrency must be at least 1"},
+		{"invalid output", []string{"foreach-profile", "--output", "table", "--", "apps", "list"}, "Error: --output must be json or text"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestForeachProfileRunsEveryProfile(t *testing.T) {
+	setupForeachProfiles(t, "client-a", "client-b", "client-c")
+
+	var mu sync.Mutex
+	var seen []string
+	restore := foreachprofile.SetRunProfileCommand(func(_ context.Context, profile string, args []string) foreachprofile.ProfileRun {
+		mu.Lock()
+		seen = append(seen, profile)
+		mu.Unlock()
+		if strings.Join(args, " ") != "agreements list --output json" {
+			t.Errorf("unexpected args: %v", args)
+		}
+		if profile == "client-b" {
+			return foreachprofile.ProfileRun{ExitCode: 1, Stderr: []byte("Error: forbidden\n")}
+		}
+		return foreachprofile.ProfileRun{Stdout: []byte(`{"data":[{"id":"` + profile + `"}]}` + "\n")}
+	})
+	t.Cleanup(restore)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"foreach-profile", "--concurrency", "2", "--", "agreements", "list", "--output", "json"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "command failed for 1 of 3 profiles") {
+		t.Fatalf("expected failure summary, got %v", runErr)
+	}
+	if len(seen) != 3 {
+		t.Fatalf("expected 3 runs, got %v", seen)
+	}
+
+	var result foreachprofile.ForeachProfileResult
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.Succeeded != 2 || result.Failed != 1 || len(result.Profiles) != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.Profiles[0].Profile != "client-a" || string(result.Profiles[0].Output) != `{"data":[{"id":"client-a"}]}` {
+		t.Fatalf("unexpected first profile: %+v", result.Profiles[0])
+	}
+	if result.Profiles[1].ExitCode != 1 || result.Profiles[1].Stderr != "Error: forbidden" {
+		t.Fatalf("unexpected failed profile: %+v", result.Profiles[1])
+	}
+}
+
+func TestForeachProfileTextOutputTagsLines(t *testing.T) {
+	setupForeachProfiles(t, "client-a", "client-b")
+
+	restore := foreachprofile.SetRunProfileCommand(func(context.Context, string, []string) foreachprofile.ProfileRun {
+		return foreachprofile.ProfileRun{Stdout: []byte("line one\nline two\n")}
+	})
+	t.Cleanup(restore)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"foreach-profile", "--profiles", "client-b", "--output", "text", "--", "auth", "status"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	want := "[client-b] line one\n[client-b] line two\n"
+	if stdout != want {
+		t.Fatalf("expected %q, got %q", want, stdout)
+	}
+}
+
+func TestForeachProfileUnknownProfile(t *testing.T) {
+	setupForeachProfiles(t, "client-a")
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"foreach-profile", "--profiles", "missing", "--", "apps", "list"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), `profile "missing" not found`) {
+		t.Fatalf("expected unknown profile error, got %v", runErr)
+	}
+}
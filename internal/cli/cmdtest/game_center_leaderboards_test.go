@@ -5,6 +5,9 @@ import (
 	"errors"
 	"flag"
 	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -173,6 +176,14 @@ func TestGameCenterLeaderboardLocalizationsCreateValidationErrors(t *testing.T)
 			name: "missing name",
 			args: []string{"game-center", "leaderboards", "localizations", "create", "--leaderboard-id", "LB_ID", "--locale", "en-US"},
 		},
+		{
+			name: "invalid formatter-override",
+			args: []string{"game-center", "leaderboards", "localizations", "create", "--leaderboard-id", "LB_ID", "--locale", "en-US", "--name", "Test", "--formatter-override", "POINTS"},
+		},
+		{
+			name: "update invalid formatter-override",
+			args: []string{"game-center", "leaderboards", "localizations", "update", "--id", "LOC_ID", "--formatter-override", "POINTS"},
+		},
 	}
 
 	for _, test := range tests {
@@ -255,3 +266,49 @@ func TestGameCenterLeaderboardLocalizationImageGetValidationErrors(t *testing.T)
 		t.Fatalf("expected empty stdout, got %q", stdout)
 	}
 }
+
+func TestGameCenterLeaderboardLocalizationsCreateNormalizesFormatterOverride(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var body string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/gameCenterLeaderboardLocalizations" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		data, _ := io.ReadAll(req.Body)
+		body = string(data)
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"gameCenterLeaderboardLocalizations","id":"LOC_ID","attributes":{"locale":"en-US","name":"Fastest Lap","formatterOverride":"ELAPSED_TIME_SECOND"}}}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"game-center", "leaderboards", "localizations", "create",
+			"--leaderboard-id", "LB_ID", "--locale", "en-US", "--name", "Fastest Lap", "--formatter-override", "elapsed_time_second",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(body, `"formatterOverride":"ELAPSED_TIME_SECOND"`) {
+		t.Fatalf("expected normalized formatterOverride in request, got %s", body)
+	}
+	if !strings.Contains(stdout, `"id":"LOC_ID"`) {
+		t.Fatalf("expected created localization in output, got %q", stdout)
+	}
+}
@@ -0,0 +1,343 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIAPCatalogValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	configPath := filepath.Join(t.TempDir(), "catalog.yaml")
+	if err := os.WriteFile(configPath, []byte("inAppPurchases:\n  - productId: com.example.coins\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "export missing app", args: []string{"iap", "export", "--out", "catalog.yaml"}, wantErr: "--app is required"},
+		{name: "export missing out", args: []string{"iap", "export", "--app", "123"}, wantErr: "--out is required"},
+		{name: "export invalid format", args: []string{"iap", "export", "--app", "123", "--out", "catalog.toml", "--format", "toml"}, wantErr: "--format must be yaml or json"},
+		{name: "import missing file", args: []string{"iap", "import"}, wantErr: "--file is required"},
+		{name: "import missing app", args: []string{"iap", "import", "--file", configPath}, wantErr: "app is required"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestIAPImportRejectsOtherSections(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "catalog.yaml")
+	if err := os.WriteFile(configPath, []byte("app: \"123\"\npricing:\n  baseTerritory: USA\n  pricePoint: pp-1\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "import", "--file", configPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "may only contain app, inAppPurchases, and subscriptionGroups") {
+			t.Fatalf("expected section error, got %v", err)
+		}
+	})
+}
+
+func TestIAPExportWritesCatalog(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	notFound := `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The specified resource does not exist","detail":"not found"}]}`
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		status := http.StatusOK
+		body := ""
+		switch req.URL.Path {
+		case "/v1/apps/123/inAppPurchasesV2":
+			body = `{"data":[` +
+				`{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Coins","productId":"com.example.coins","inAppPurchaseType":"CONSUMABLE"}},` +
+				`{"type":"inAppPurchases","id":"iap-2","attributes":{"name":"Pro","productId":"com.example.pro","inAppPurchaseType":"NON_CONSUMABLE"}}]}`
+		case "/v2/inAppPurchases/iap-1/inAppPurchaseLocalizations":
+			body = `{"data":[{"type":"inAppPurchaseLocalizations","id":"iap-loc","attributes":{"locale":"en-US","name":"Coins","description":"A pile"}}]}`
+		case "/v2/inAppPurchases/iap-2/inAppPurchaseLocalizations":
+			body = `{"data":[]}`
+		case "/v2/inAppPurchases/iap-1/iapPriceSchedule":
+			body = `{"data":{"type":"inAppPurchasePriceSchedules","id":"sched-1"}}`
+		case "/v2/inAppPurchases/iap-2/iapPriceSchedule":
+			status = http.StatusNotFound
+			body = notFound
+		case "/v1/inAppPurchasePriceSchedules/sched-1/baseTerritory":
+			body = `{"data":{"type":"territories","id":"USA","attributes":{"currency":"USD"}}}`
+		case "/v1/inAppPurchasePriceSchedules/sched-1/manualPrices":
+			if got := req.URL.Query().Get("include"); got != "inAppPurchasePricePoint,territory" {
+				t.Fatalf("expected include=inAppPurchasePricePoint,territory, got %q", got)
+			}
+			body = `{"data":[{"type":"inAppPurchasePrices","id":"price-1","attributes":{"startDate":"2024-01-01"},` +
+				`"relationships":{"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"pp-1"}},"territory":{"data":{"type":"territories","id":"USA"}}}}],` +
+				`"included":[{"type":"inAppPurchasePricePoints","id":"pp-1","attributes":{"customerPrice":"0.99","proceeds":"0.7"}}]}`
+		case "/v1/apps/123/subscriptionGroups":
+			body = `{"data":[{"type":"subscriptionGroups","id":"grp-1","attributes":{"referenceName":"Premium"}}]}`
+		case "/v1/subscriptionGroups/grp-1/subscriptions":
+			body = `{"data":[{"type":"subscriptions","id":"sub-1","attributes":{"name":"Premium Monthly","productId":"com.example.premium","subscriptionPeriod":"ONE_MONTH","groupLevel":1}}]}`
+		case "/v1/subscriptions/sub-1/subscriptionLocalizations":
+			body = `{"data":[{"type":"subscriptionLocalizations","id":"sub-loc","attributes":{"locale":"en-US","name":"Premium"}}]}`
+		case "/v1/subscriptions/sub-1/prices":
+			body = `{"data":[` +
+				`{"type":"subscriptionPrices","id":"sp-1","attributes":{"startDate":"2024-01-01"},"relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"spp-1"}},"territory":{"data":{"type":"territories","id":"USA"}}}},` +
+				`{"type":"subscriptionPrices","id":"sp-2","attributes":{"startDate":"2099-01-01"},"relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"spp-2"}},"territory":{"data":{"type":"territories","id":"USA"}}}}],` +
+				`"included":[{"type":"subscriptionPricePoints","id":"spp-1","attributes":{"customerPrice":"4.99"}},{"type":"subscriptionPricePoints","id":"spp-2","attributes":{"customerPrice":"5.99"}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	outPath := filepath.Join(t.TempDir(), "catalog.yaml")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "export", "--app", "123", "--out", outPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var summary struct {
+		InAppPurchases     int `json:"inAppPurchases"`
+		SubscriptionGroups int `json:"subscriptionGroups"`
+		Subscriptions      int `json:"subscriptions"`
+		Localizations      int `json:"localizations"`
+		Priced             int `json:"priced"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if summary.InAppPurchases != 2 || summary.SubscriptionGroups != 1 || summary.Subscriptions != 1 || summary.Localizations != 2 || summary.Priced != 2 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	content := string(data)
+	for _, want := range []string{`app: "123"`, "productId: com.example.coins", "baseTerritory: USA", `price: "0.99"`, "productId: com.example.pro", "referenceName: Premium", "subscriptionPeriod: ONE_MONTH", `USA: "4.99"`} {
+		if !strings.Contains(content, want) {
+			t.Fatalf("expected export to contain %q, got:\n%s", want, content)
+		}
+	}
+	for _, unwanted := range []string{"info:", "betaGroups:", "availability:", "5.99"} {
+		if strings.Contains(content, unwanted) {
+			t.Fatalf("expected catalog without %q, got:\n%s", unwanted, content)
+		}
+	}
+}
+
+func TestIAPImportCreatesPricedProduct(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	configPath := filepath.Join(t.TempDir(), "catalog.yaml")
+	config := `app: "999"
+inAppPurchases:
+  - productId: com.example.coins
+    referenceName: "Coins"
+    type: CONSUMABLE
+    pricing:
+      baseTerritory: usa
+      price: "1"
+`
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var mutations []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/123/inAppPurchasesV2":
+			body = `{"data":[]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v2/inAppPurchases":
+			mutations = append(mutations, "POST iap")
+			body = `{"data":{"type":"inAppPurchases","id":"iap-new","attributes":{"productId":"com.example.coins"}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/inAppPurchases/iap-new/pricePoints":
+			if got := req.URL.Query().Get("filter[territory]"); got != "USA" {
+				t.Fatalf("expected filter[territory]=USA, got %q", got)
+			}
+			body = `{"data":[` +
+				`{"type":"inAppPurchasePricePoints","id":"pp-099","attributes":{"customerPrice":"0.99"}},` +
+				`{"type":"inAppPurchasePricePoints","id":"pp-100","attributes":{"customerPrice":"1.00"}}]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/inAppPurchasePriceSchedules":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"pp-100"`) || !strings.Contains(string(payload), `"iap-new"`) {
+				t.Fatalf("unexpected price schedule body: %s", payload)
+			}
+			mutations = append(mutations, "POST schedule")
+			body = `{"data":{"type":"inAppPurchasePriceSchedules","id":"sched-new"}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "import", "--file", configPath, "--app", "123", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		AppID   string `json:"appId"`
+		Applied bool   `json:"applied"`
+		Changes []struct {
+			Resource string `json:"resource"`
+			Status   string `json:"status"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.AppID != "123" || !result.Applied || len(result.Changes) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.Changes[1].Resource != "inAppPurchasePriceSchedule" || result.Changes[1].Status != "applied" {
+		t.Fatalf("unexpected price change: %+v", result.Changes[1])
+	}
+	if strings.Join(mutations, ";") != "POST iap;POST schedule" {
+		t.Fatalf("unexpected mutations: %v", mutations)
+	}
+}
+
+func TestIAPImportPriceUpdateKeepsOtherTerritories(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	configPath := filepath.Join(t.TempDir(), "catalog.yaml")
+	config := `app: "123"
+inAppPurchases:
+  - productId: com.example.coins
+    pricing:
+      baseTerritory: USA
+      price: "1.99"
+`
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var schedule string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/123/inAppPurchasesV2":
+			body = `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Coins","productId":"com.example.coins","inAppPurchaseType":"CONSUMABLE"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/inAppPurchases/iap-1/iapPriceSchedule":
+			body = `{"data":{"type":"inAppPurchasePriceSchedules","id":"sched-1"}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/inAppPurchasePriceSchedules/sched-1/baseTerritory":
+			body = `{"data":{"type":"territories","id":"USA"}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/inAppPurchasePriceSchedules/sched-1/manualPrices":
+			body = `{"data":[` +
+				`{"type":"inAppPurchasePrices","id":"price-usa","attributes":{"startDate":"2024-01-01"},` +
+				`"relationships":{"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"pp-usa"}},"territory":{"data":{"type":"territories","id":"USA"}}}},` +
+				`{"type":"inAppPurchasePrices","id":"price-gbr","attributes":{"startDate":"2024-01-01"},` +
+				`"relationships":{"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"pp-gbr"}},"territory":{"data":{"type":"territories","id":"GBR"}}}}],` +
+				`"included":[{"type":"inAppPurchasePricePoints","id":"pp-usa","attributes":{"customerPrice":"0.99"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/inAppPurchases/iap-1/pricePoints":
+			body = `{"data":[{"type":"inAppPurchasePricePoints","id":"pp-199","attributes":{"customerPrice":"1.99"}}]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/inAppPurchasePriceSchedules":
+			payload, _ := io.ReadAll(req.Body)
+			schedule = string(payload)
+			body = `{"data":{"type":"inAppPurchasePriceSchedules","id":"sched-2"}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "import", "--file", configPath, "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(schedule, `"pp-199"`) || !strings.Contains(schedule, `"pp-gbr"`) {
+		t.Fatalf("expected new base price and kept GBR price, got %s", schedule)
+	}
+	if strings.Contains(schedule, `"pp-usa"`) {
+		t.Fatalf("expected old base price to be replaced, got %s", schedule)
+	}
+}
@@ -0,0 +1,81 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func runIAPRequestCapture(t *testing.T, method, path string, args []string) map[string]any {
+	t.Helper()
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var attributes map[string]any
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != method || req.URL.Path != path {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		var payload struct {
+			Data struct {
+				Attributes map[string]any `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		attributes = payload.Data.Attributes
+		body := `{"data":{"type":"inAppPurchases","id":"IAP_ID","attributes":{"name":"Lifetime","productId":"com.example.lifetime","inAppPurchaseType":"NON_CONSUMABLE"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	return attributes
+}
+
+func TestIAPCreateSendsReviewNoteAndFamilySharing(t *testing.T) {
+	attributes := runIAPRequestCapture(t, http.MethodPost, "/v2/inAppPurchases", []string{
+		"iap", "create", "--app", "APP_ID", "--type", "non-consumable", "--ref-name", "Lifetime",
+		"--product-id", "com.example.lifetime", "--review-note", "Unlocks all levels", "--family-sharable",
+	})
+
+	if attributes["inAppPurchaseType"] != "NON_CONSUMABLE" {
+		t.Fatalf("expected NON_CONSUMABLE type, got %v", attributes["inAppPurchaseType"])
+	}
+	if attributes["reviewNote"] != "Unlocks all levels" || attributes["familySharable"] != true {
+		t.Fatalf("unexpected attributes: %v", attributes)
+	}
+}
+
+func TestIAPUpdateSendsOnlyGivenFields(t *testing.T) {
+	attributes := runIAPRequestCapture(t, http.MethodPatch, "/v2/inAppPurchases/IAP_ID", []string{
+		"iap", "update", "--id", "IAP_ID", "--family-sharable=false", "--review-note", "Use the sandbox account",
+	})
+
+	if _, ok := attributes["name"]; ok {
+		t.Fatalf("expected name to be omitted, got %v", attributes)
+	}
+	if attributes["familySharable"] != false || attributes["reviewNote"] != "Use the sandbox account" {
+		t.Fatalf("unexpected attributes: %v", attributes)
+	}
+}
@@ -0,0 +1,182 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeIAPLocalizationFile(t *testing.T, dir, locale, name, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, locale), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, locale, name), []byte(content+"\n"), 0o644); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+}
+
+func TestIAPLocalizationsImportCreatesAndUpdates(t *testing.T) {
+	setupAuth(t)
+
+	dir := t.TempDir()
+	writeIAPLocalizationFile(t, dir, "en-US", "name.txt", "Lifetime")
+	writeIAPLocalizationFile(t, dir, "en-US", "description.txt", "Unlock everything")
+	writeIAPLocalizationFile(t, dir, "de-DE", "name.txt", "Lebenslang")
+	writeIAPLocalizationFile(t, dir, "fr-FR", "description.txt", "Tout débloquer")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		var body string
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/inAppPurchases/IAP_ID/inAppPurchaseLocalizations":
+			body = `{"data":[` +
+				`{"type":"inAppPurchaseLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","name":"Lifetime","description":"Unlock everything"}},` +
+				`{"type":"inAppPurchaseLocalizations","id":"LOC_FR","attributes":{"locale":"fr-FR","name":"À vie","description":"Ancien"}}` +
+				`],"links":{}}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/inAppPurchaseLocalizations/LOC_FR":
+			var payload struct {
+				Data struct {
+					Attributes map[string]any `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if _, ok := payload.Data.Attributes["name"]; ok {
+				t.Fatalf("expected name to be omitted, got %v", payload.Data.Attributes)
+			}
+			body = `{"data":{"type":"inAppPurchaseLocalizations","id":"LOC_FR","attributes":{"locale":"fr-FR"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/inAppPurchaseLocalizations":
+			body = `{"data":{"type":"inAppPurchaseLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE","name":"Lebenslang"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "localizations", "import", "--iap-id", "IAP_ID", "--dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Locales []struct {
+			Locale         string `json:"locale"`
+			Action         string `json:"action"`
+			LocalizationID string `json:"localizationId"`
+		} `json:"locales"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	got := make([]string, 0, len(result.Locales))
+	for _, item := range result.Locales {
+		got = append(got, item.Locale+":"+item.Action+":"+item.LocalizationID)
+	}
+	want := "de-DE:create:LOC_DE,en-US:unchanged:LOC_EN,fr-FR:update:LOC_FR"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("expected %s, got %s", want, strings.Join(got, ","))
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %v", requests)
+	}
+}
+
+func TestIAPLocalizationsImportRequiresNameForNewLocale(t *testing.T) {
+	setupAuth(t)
+
+	dir := t.TempDir()
+	writeIAPLocalizationFile(t, dir, "ja", "description.txt", "すべて解除")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":[],"links":{}}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "localizations", "import", "--iap-id", "IAP_ID", "--dir", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "ja: name.txt is required") {
+		t.Fatalf("expected missing name error, got %v", runErr)
+	}
+}
+
+func TestIAPLocalizationsSetUpdatesExistingLocale(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var patched bool
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch {
+		case req.Method == http.MethodGet:
+			body = `{"data":[{"type":"inAppPurchaseLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","name":"Lifetime"}}],"links":{}}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/inAppPurchaseLocalizations/LOC_EN":
+			patched = true
+			body = `{"data":{"type":"inAppPurchaseLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","name":"Lifetime","description":"New"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "localizations", "set", "--iap-id", "IAP_ID", "--locale", "en-US", "--description", "New"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if !patched {
+		t.Fatal("expected the existing localization to be updated")
+	}
+}
@@ -0,0 +1,92 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIAPPriceSchedulesSetResolvesPrice(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var scheduleBody string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/inAppPurchases/IAP_ID/pricePoints":
+			if got := req.URL.Query().Get("filter[territory]"); got != "USA" {
+				t.Fatalf("expected USA territory filter, got %q", got)
+			}
+			body = `{"data":[` +
+				`{"type":"inAppPurchasePricePoints","id":"PP_099","attributes":{"customerPrice":"0.99"}},` +
+				`{"type":"inAppPurchasePricePoints","id":"PP_499","attributes":{"customerPrice":"4.99"}}` +
+				`],"links":{}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/inAppPurchasePriceSchedules":
+			data, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			scheduleBody = string(data)
+			body = `{"data":{"type":"inAppPurchasePriceSchedules","id":"SCHEDULE_ID"}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "price-schedules", "set", "--iap-id", "IAP_ID", "--price", "4.99", "--start-date", "2026-03-01"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Data struct {
+			Relationships struct {
+				BaseTerritory struct {
+					Data struct {
+						ID string `json:"id"`
+					} `json:"data"`
+				} `json:"baseTerritory"`
+			} `json:"relationships"`
+		} `json:"data"`
+		Included []struct {
+			Attributes struct {
+				StartDate string `json:"startDate"`
+			} `json:"attributes"`
+			Relationships struct {
+				PricePoint struct {
+					Data struct {
+						ID string `json:"id"`
+					} `json:"data"`
+				} `json:"inAppPurchasePricePoint"`
+			} `json:"relationships"`
+		} `json:"included"`
+	}
+	if err := json.Unmarshal([]byte(scheduleBody), &payload); err != nil {
+		t.Fatalf("decode schedule body: %v\n%s", err, scheduleBody)
+	}
+	if payload.Data.Relationships.BaseTerritory.Data.ID != "USA" {
+		t.Fatalf("expected USA base territory, got %s", scheduleBody)
+	}
+	if len(payload.Included) != 1 || payload.Included[0].Relationships.PricePoint.Data.ID != "PP_499" || payload.Included[0].Attributes.StartDate != "2026-03-01" {
+		t.Fatalf("expected one PP_499 price starting 2026-03-01, got %s", scheduleBody)
+	}
+}
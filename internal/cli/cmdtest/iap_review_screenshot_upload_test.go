@@ -0,0 +1,84 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIAPReviewScreenshotsUploadReplacesExisting(t *testing.T) {
+	setupAuth(t)
+
+	filePath := filepath.Join(t.TempDir(), "review.png")
+	if err := os.WriteFile(filePath, []byte("fake png bytes"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Host+req.URL.Path)
+		status := http.StatusOK
+		var body string
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/inAppPurchases/IAP_ID/appStoreReviewScreenshot":
+			body = `{"data":{"type":"inAppPurchaseAppStoreReviewScreenshots","id":"OLD_ID","attributes":{"fileName":"old.png"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/inAppPurchaseAppStoreReviewScreenshots":
+			status = http.StatusCreated
+			body = `{"data":{"type":"inAppPurchaseAppStoreReviewScreenshots","id":"NEW_ID","attributes":{"fileName":"review.png","uploadOperations":[{"method":"PUT","url":"https://upload.example.com/chunk","length":14,"offset":0}]}}}`
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example.com":
+			body = ``
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/inAppPurchaseAppStoreReviewScreenshots/NEW_ID":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"uploaded":true`) {
+				t.Fatalf("expected upload commit, got %s", payload)
+			}
+			body = `{"data":{"type":"inAppPurchaseAppStoreReviewScreenshots","id":"NEW_ID","attributes":{"fileName":"review.png"}}}`
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/inAppPurchaseAppStoreReviewScreenshots/OLD_ID":
+			status = http.StatusNoContent
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/inAppPurchaseAppStoreReviewScreenshots/NEW_ID":
+			body = `{"data":{"type":"inAppPurchaseAppStoreReviewScreenshots","id":"NEW_ID","attributes":{"fileName":"review.png","assetDeliveryState":{"state":"COMPLETE"}}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "review-screenshots", "upload", "--iap-id", "IAP_ID", "--file", filePath, "--wait"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"state":"COMPLETE"`) {
+		t.Fatalf("expected processed screenshot in output, got %s", stdout)
+	}
+	want := []string{
+		"GET api.appstoreconnect.apple.com/v2/inAppPurchases/IAP_ID/appStoreReviewScreenshot",
+		"POST api.appstoreconnect.apple.com/v1/inAppPurchaseAppStoreReviewScreenshots",
+		"PUT upload.example.com/chunk",
+		"PATCH api.appstoreconnect.apple.com/v1/inAppPurchaseAppStoreReviewScreenshots/NEW_ID",
+		"DELETE api.appstoreconnect.apple.com/v1/inAppPurchaseAppStoreReviewScreenshots/OLD_ID",
+		"GET api.appstoreconnect.apple.com/v1/inAppPurchaseAppStoreReviewScreenshots/NEW_ID",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected requests:\n%s", strings.Join(requests, "\n"))
+	}
+}
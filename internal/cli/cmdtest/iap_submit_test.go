@@ -0,0 +1,128 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// iapSubmitTransport serves the preflight lookups of IAP_ID. Empty bodies are
+// answered with 404.
+func iapSubmitTransport(t *testing.T, localizations, schedule, manualPrices, screenshot string, submitted *bool) roundTripFunc {
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/inAppPurchases/IAP_ID/inAppPurchaseLocalizations":
+			body = localizations
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/inAppPurchases/IAP_ID/iapPriceSchedule":
+			body = schedule
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/inAppPurchasePriceSchedules/SCHEDULE_ID/manualPrices":
+			body = manualPrices
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/inAppPurchases/IAP_ID/appStoreReviewScreenshot":
+			body = screenshot
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/inAppPurchaseSubmissions":
+			*submitted = true
+			body = `{"data":{"type":"inAppPurchaseSubmissions","id":"SUBMISSION_ID"}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if body == "" {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func TestIAPSubmitPreflightReportsMissingRequirements(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	submitted := false
+	http.DefaultTransport = iapSubmitTransport(t,
+		`{"data":[{"type":"inAppPurchaseLocalizations","id":"LOC_ID","attributes":{"locale":"en-US","name":"Lifetime"}}],"links":{}}`,
+		"",
+		"",
+		`{"data":null}`,
+		&submitted,
+	)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "submit", "--iap-id", "IAP_ID", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	var reported shared.ReportedError
+	if !errors.As(runErr, &reported) {
+		t.Fatalf("expected reported error, got %v", runErr)
+	}
+	if submitted {
+		t.Fatal("expected no submission when preflight fails")
+	}
+	var result struct {
+		Ready   bool     `json:"ready"`
+		Missing []string `json:"missing"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	if result.Ready || strings.Join(result.Missing, ",") != "pricing,review-screenshot" {
+		t.Fatalf("expected pricing and review-screenshot missing, got %+v", result)
+	}
+}
+
+func TestIAPSubmitSubmitsWhenPreflightPasses(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	submitted := false
+	http.DefaultTransport = iapSubmitTransport(t,
+		`{"data":[{"type":"inAppPurchaseLocalizations","id":"LOC_ID","attributes":{"locale":"en-US","name":"Lifetime"}}],"links":{}}`,
+		`{"data":{"type":"inAppPurchasePriceSchedules","id":"SCHEDULE_ID"}}`,
+		`{"data":[{"type":"inAppPurchasePrices","id":"PRICE_ID","attributes":{"manual":true}}],"links":{}}`,
+		`{"data":{"type":"inAppPurchaseAppStoreReviewScreenshots","id":"SHOT_ID","attributes":{"fileName":"review.png"}}}`,
+		&submitted,
+	)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "submit", "--iap-id", "IAP_ID", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !submitted {
+		t.Fatal("expected the submission to be created")
+	}
+	if !strings.Contains(stdout, `"SUBMISSION_ID"`) {
+		t.Fatalf("expected submission in output, got %s", stdout)
+	}
+}
@@ -0,0 +1,121 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIAPTaxTreatmentRequiresPricePoint(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"iap", "tax-treatment"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "Error: --price-point is required") {
+		t.Fatalf("unexpected stderr: %q", stderr)
+	}
+}
+
+func TestIAPTaxTreatmentComputesProceedsRate(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/inAppPurchasePricePoints/pp-usa/equalizations" {
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+		}
+		query := req.URL.Query()
+		if query.Get("filter[territory]") != "FRA,DEU" {
+			t.Fatalf("unexpected territory filter: %q", query.Get("filter[territory]"))
+		}
+		if query.Get("include") != "territory" {
+			t.Fatalf("unexpected include: %q", query.Get("include"))
+		}
+
+		body := `{
+			"data":[
+				{"type":"inAppPurchasePricePoints","id":"pp-fra","attributes":{"customerPrice":"1.09","proceeds":"0.76"},"relationships":{"territory":{"data":{"type":"territories","id":"FRA"}}}},
+				{"type":"inAppPurchasePricePoints","id":"pp-deu","attributes":{"customerPrice":"1.09","proceeds":"0.77"},"relationships":{"territory":{"data":{"type":"territories","id":"DEU"}}}}
+			],
+			"included":[
+				{"type":"territories","id":"FRA","attributes":{"currency":"EUR"}},
+				{"type":"territories","id":"DEU","attributes":{"currency":"EUR"}}
+			]
+		}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"iap", "tax-treatment",
+			"--price-point", "pp-usa",
+			"--territory", "fra, deu",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var result struct {
+		PricePointID string `json:"pricePointId"`
+		Territories  []struct {
+			Territory    string `json:"territory"`
+			Currency     string `json:"currency"`
+			PricePointID string `json:"pricePointId"`
+			ProceedsRate string `json:"proceedsRate"`
+		} `json:"territories"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.PricePointID != "pp-usa" {
+		t.Fatalf("expected pricePointId pp-usa, got %q", result.PricePointID)
+	}
+	if len(result.Territories) != 2 {
+		t.Fatalf("expected 2 territories, got %d", len(result.Territories))
+	}
+	deu := result.Territories[0]
+	if deu.Territory != "DEU" || deu.Currency != "EUR" || deu.PricePointID != "pp-deu" || deu.ProceedsRate != "70.64" {
+		t.Fatalf("unexpected DEU entry: %+v", deu)
+	}
+	if result.Territories[1].Territory != "FRA" || result.Territories[1].ProceedsRate != "69.72" {
+		t.Fatalf("unexpected FRA entry: %+v", result.Territories[1])
+	}
+}
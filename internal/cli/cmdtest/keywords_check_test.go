@@ -0,0 +1,169 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestKeywordsCheckValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing version",
+			args:    []string{"keywords", "check"},
+			wantErr: "Error: --version-id is required",
+		},
+		{
+			name:    "set without locale",
+			args:    []string{"keywords", "check", "--version-id", "VERSION_ID", "--set", "a,b"},
+			wantErr: "Error: --locale is required with --set",
+		},
+		{
+			name:    "dry run without set",
+			args:    []string{"keywords", "check", "--version-id", "VERSION_ID", "--dry-run"},
+			wantErr: "Error: --dry-run requires --set",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestKeywordsCheckReportsWastedCharacters(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch req.URL.Path {
+		case "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","keywords":"photo,editor,filters,editor"}}]}`
+		case "/v1/apps/APP_ID/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"INFO_ID","attributes":{"appStoreState":"PREPARE_FOR_SUBMISSION"}}]}`
+		case "/v1/appInfos/INFO_ID/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"AI_EN","attributes":{"locale":"en-US","name":"Photo Studio"}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"keywords", "check", "--version-id", "VERSION_ID", "--app", "APP_ID"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Locales []struct {
+			Locale     string   `json:"locale"`
+			Characters int      `json:"characters"`
+			Duplicates []string `json:"duplicates"`
+			InTitle    []string `json:"inTitle"`
+			Suggested  string   `json:"suggested"`
+		} `json:"locales"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Locales) != 1 {
+		t.Fatalf("expected one locale, got %+v", result.Locales)
+	}
+	report := result.Locales[0]
+	if report.Characters != 27 || len(report.Duplicates) != 1 || len(report.InTitle) != 1 || report.Suggested != "editor,filters" {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
+
+func TestKeywordsCheckSetTrimsToLimit(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	long := strings.Repeat("x", 95)
+	patchBody := ""
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","keywords":"old"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_EN":
+			payload, _ := io.ReadAll(req.Body)
+			patchBody = string(payload)
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"keywords", "check", "--version-id", "VERSION_ID", "--locale", "en-US", "--set", long + ", photo, ab, Ab"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(patchBody, `"keywords":"`+long+`,ab"`) {
+		t.Fatalf("expected trimmed keywords in PATCH, got %s", patchBody)
+	}
+	if !strings.Contains(stdout, `"dropped":["photo"]`) || !strings.Contains(stdout, `"action":"update"`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
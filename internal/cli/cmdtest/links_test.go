@@ -0,0 +1,138 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLinksValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"links", "--locales", "en-US"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "missing locales",
+			args:    []string{"links", "--app", "123"},
+			wantErr: "--locales is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestLinksRejectsInvalidLocale(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"links", "--app", "123", "--locales", "en-US,../x"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), `invalid locale "../x"`) {
+		t.Fatalf("expected invalid locale error, got %v", runErr)
+	}
+}
+
+func TestLinksOutputIncludesCustomPagesAndOfferCodes(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps/123/appCustomProductPages" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body := `{"data":[{"type":"appCustomProductPages","id":"cpp-1","attributes":{"name":"Spring","url":"https://apps.apple.com/app/id123?ppid=ppid-1"}}]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"links", "--app", "123", "--locales", "en-US,ja", "--custom-product-pages", "--offer-codes", "VIP"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var result struct {
+		AppID string `json:"appId"`
+		Links []struct {
+			Kind string `json:"kind"`
+			URL  string `json:"url"`
+		} `json:"links"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	want := []string{
+		"https://apps.apple.com/us/app/id123?l=en",
+		"https://apps.apple.com/jp/app/id123?l=ja",
+		"https://apps.apple.com/us/app/id123?l=en&ppid=ppid-1",
+		"https://apps.apple.com/jp/app/id123?l=ja&ppid=ppid-1",
+		"https://apps.apple.com/redeem?ctx=offercodes&id=123&code=VIP",
+	}
+	if len(result.Links) != len(want) {
+		t.Fatalf("expected %d links, got %+v", len(want), result.Links)
+	}
+	for i, link := range result.Links {
+		if link.URL != want[i] {
+			t.Fatalf("link %d: expected %q, got %q", i, want[i], link.URL)
+		}
+	}
+}
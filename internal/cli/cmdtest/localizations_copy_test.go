@@ -0,0 +1,148 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLocalizationsCopyValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing from version",
+			args:    []string{"localizations", "copy", "--to-version-id", "NEW"},
+			wantErr: "Error: --from-version-id is required",
+		},
+		{
+			name:    "missing to version",
+			args:    []string{"localizations", "copy", "--from-version-id", "OLD"},
+			wantErr: "Error: --to-version-id is required",
+		},
+		{
+			name:    "same version",
+			args:    []string{"localizations", "copy", "--from-version-id", "OLD", "--to-version-id", "OLD"},
+			wantErr: "Error: --from-version-id and --to-version-id must differ",
+		},
+		{
+			name:    "unknown field",
+			args:    []string{"localizations", "copy", "--from-version-id", "OLD", "--to-version-id", "NEW", "--only", "releaseNotes"},
+			wantErr: "Error: --only must be one of:",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestLocalizationsCopyOnlyWhatsNew(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var patchBody, createBody string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/OLD/appStoreVersionLocalizations":
+			body = `{"data":[
+				{"type":"appStoreVersionLocalizations","id":"OLD_EN","attributes":{"locale":"en-US","description":"An app","whatsNew":"Bug fixes"}},
+				{"type":"appStoreVersionLocalizations","id":"OLD_DE","attributes":{"locale":"de-DE","description":"Eine App","whatsNew":"Fehlerbehebungen"}},
+				{"type":"appStoreVersionLocalizations","id":"OLD_FR","attributes":{"locale":"fr-FR","description":"Une app"}}
+			]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/NEW/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"NEW_EN","attributes":{"locale":"en-US"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersionLocalizations/NEW_EN":
+			payload, _ := io.ReadAll(req.Body)
+			patchBody = string(payload)
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"NEW_EN","attributes":{"locale":"en-US"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreVersionLocalizations":
+			payload, _ := io.ReadAll(req.Body)
+			createBody = string(payload)
+			status = http.StatusCreated
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"NEW_DE","attributes":{"locale":"de-DE"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"localizations", "copy",
+			"--from-version-id", "OLD",
+			"--to-version-id", "NEW",
+			"--only", "whatsNew",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(patchBody, `"whatsNew":"Bug fixes"`) || strings.Contains(patchBody, "description") {
+		t.Fatalf("expected PATCH with only whatsNew, got %s", patchBody)
+	}
+	if !strings.Contains(createBody, `"locale":"de-DE"`) || !strings.Contains(createBody, `"whatsNew":"Fehlerbehebungen"`) || strings.Contains(createBody, "description") {
+		t.Fatalf("unexpected create payload: %s", createBody)
+	}
+
+	var result struct {
+		SourceVersionID string `json:"sourceVersionId"`
+		VersionID       string `json:"versionId"`
+		Results         []struct {
+			Locale string `json:"locale"`
+			Action string `json:"action"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.SourceVersionID != "OLD" || result.VersionID != "NEW" || len(result.Results) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if result.Results[0].Locale != "de-DE" || result.Results[0].Action != "create" || result.Results[1].Locale != "en-US" || result.Results[1].Action != "update" {
+		t.Fatalf("unexpected results: %+v", result.Results)
+	}
+}
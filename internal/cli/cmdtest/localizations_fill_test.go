@@ -0,0 +1,123 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLocalizationsFillValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing version",
+			args:    []string{"localizations", "fill", "--app", "APP_ID"},
+			wantErr: "Error: --version-id is required",
+		},
+		{
+			name:    "missing app and locale",
+			args:    []string{"localizations", "fill", "--version-id", "VERSION_ID"},
+			wantErr: "Error: --app or --locale is required",
+		},
+		{
+			name:    "invalid locale",
+			args:    []string{"localizations", "fill", "--version-id", "VERSION_ID", "--locale", "../x"},
+			wantErr: `Error: invalid locale "../x"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestLocalizationsFillMissingOnlyCreatesAppInfoLocales(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var created []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[
+				{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","description":"An app","keywords":"a,b"}},
+				{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE","description":"Eine App"}}
+			]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/APP_ID/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"INFO_ID","attributes":{"appStoreState":"PREPARE_FOR_SUBMISSION"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appInfos/INFO_ID/appInfoLocalizations":
+			body = `{"data":[
+				{"type":"appInfoLocalizations","id":"AI_EN","attributes":{"locale":"en-US","name":"App"}},
+				{"type":"appInfoLocalizations","id":"AI_DE","attributes":{"locale":"de-DE","name":"App"}},
+				{"type":"appInfoLocalizations","id":"AI_JA","attributes":{"locale":"ja","name":"App"}}
+			]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreVersionLocalizations":
+			payload, _ := io.ReadAll(req.Body)
+			created = append(created, string(payload))
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"LOC_JA","attributes":{"locale":"ja"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"localizations", "fill", "--version-id", "VERSION_ID", "--app", "APP_ID", "--source", "en-US", "--missing-only"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(created) != 1 {
+		t.Fatalf("expected one POST, got %d: %v", len(created), created)
+	}
+	if !strings.Contains(created[0], `"locale":"ja"`) || !strings.Contains(created[0], `"description":"An app"`) || !strings.Contains(created[0], `"keywords":"a,b"`) {
+		t.Fatalf("unexpected create payload: %s", created[0])
+	}
+	if !strings.Contains(stdout, `"locale":"ja","action":"create"`) || strings.Contains(stdout, "de-DE") {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
@@ -0,0 +1,100 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLocalizationsPreviewSetsCreateAndDelete(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appPreviewSets":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"previewType":"IPHONE_67"`) || !strings.Contains(string(payload), `"id":"LOC_ID"`) {
+				t.Fatalf("unexpected create body: %s", payload)
+			}
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"appPreviewSets","id":"SET_ID","attributes":{"previewType":"IPHONE_67"}}}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/appPreviewSets/SET_ID":
+			return &http.Response{
+				StatusCode: http.StatusNoContent,
+				Body:       io.NopCloser(strings.NewReader("")),
+				Header:     http.Header{},
+			}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	stdout := runLocalizationsSetsCommand(t, "preview-sets", "create", "--localization-id", "LOC_ID", "--preview-type", "iphone_67")
+	var created struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &created); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if created.Data.ID != "SET_ID" {
+		t.Fatalf("expected SET_ID, got %q", created.Data.ID)
+	}
+
+	stdout = runLocalizationsSetsCommand(t, "preview-sets", "delete", "--id", "SET_ID", "--confirm")
+	var deleted struct {
+		ID      string `json:"id"`
+		Deleted bool   `json:"deleted"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &deleted); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if deleted.ID != "SET_ID" || !deleted.Deleted {
+		t.Fatalf("unexpected delete result: %s", stdout)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %v", requests)
+	}
+}
+
+func TestLocalizationsPreviewSetsListFiltersByPreviewType(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/appStoreVersionLocalizations/LOC_ID/appPreviewSets" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if got := req.URL.Query().Get("filter[previewType]"); got != "IPHONE_67" {
+			t.Fatalf("expected preview type filter, got %q", got)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":[{"type":"appPreviewSets","id":"SET_ID","attributes":{"previewType":"IPHONE_67"}}]}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	stdout := runLocalizationsSetsCommand(t, "preview-sets", "list", "--localization-id", "LOC_ID", "--preview-type", "IPHONE_67")
+	if !strings.Contains(stdout, `"id":"SET_ID"`) {
+		t.Fatalf("expected set in output, got %s", stdout)
+	}
+}
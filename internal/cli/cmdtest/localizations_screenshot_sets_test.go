@@ -0,0 +1,120 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// runLocalizationsSetsCommand runs "asc localizations <group> args..." and
+// returns its stdout.
+func runLocalizationsSetsCommand(t *testing.T, group string, args ...string) string {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"localizations", group}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	return stdout
+}
+
+func TestLocalizationsScreenshotSetsCreateAndDelete(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appScreenshotSets":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"screenshotDisplayType":"APP_IPHONE_67"`) || !strings.Contains(string(payload), `"id":"LOC_ID"`) {
+				t.Fatalf("unexpected create body: %s", payload)
+			}
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"appScreenshotSets","id":"SET_ID","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}}}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/appScreenshotSets/SET_ID":
+			return &http.Response{
+				StatusCode: http.StatusNoContent,
+				Body:       io.NopCloser(strings.NewReader("")),
+				Header:     http.Header{},
+			}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	stdout := runLocalizationsSetsCommand(t, "screenshot-sets", "create", "--localization-id", "LOC_ID", "--display-type", "app_iphone_67")
+	var created struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &created); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if created.Data.ID != "SET_ID" {
+		t.Fatalf("expected SET_ID, got %q", created.Data.ID)
+	}
+
+	stdout = runLocalizationsSetsCommand(t, "screenshot-sets", "delete", "--id", "SET_ID", "--confirm")
+	var deleted struct {
+		ID      string `json:"id"`
+		Deleted bool   `json:"deleted"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &deleted); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if deleted.ID != "SET_ID" || !deleted.Deleted {
+		t.Fatalf("unexpected delete result: %s", stdout)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %v", requests)
+	}
+}
+
+func TestLocalizationsScreenshotSetsListFiltersByDisplayType(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/appStoreVersionLocalizations/LOC_ID/appScreenshotSets" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if got := req.URL.Query().Get("filter[screenshotDisplayType]"); got != "APP_IPHONE_67" {
+			t.Fatalf("expected display type filter, got %q", got)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":[{"type":"appScreenshotSets","id":"SET_ID","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}}]}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	stdout := runLocalizationsSetsCommand(t, "screenshot-sets", "list", "--localization-id", "LOC_ID", "--display-type", "APP_IPHONE_67")
+	if !strings.Contains(stdout, `"id":"SET_ID"`) {
+		t.Fatalf("expected set in output, got %s", stdout)
+	}
+}
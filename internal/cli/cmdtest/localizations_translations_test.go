@@ -0,0 +1,194 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalizationsExportImportValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "export missing format",
+			args:    []string{"localizations", "export", "--version", "VERSION_ID"},
+			wantErr: "Error: --format is required",
+		},
+		{
+			name:    "export invalid format",
+			args:    []string{"localizations", "export", "--version", "VERSION_ID", "--format", "po"},
+			wantErr: "Error: --format must be xliff or csv",
+		},
+		{
+			name:    "export missing version",
+			args:    []string{"localizations", "export", "--format", "xliff"},
+			wantErr: "Error: --version is required for version localizations",
+		},
+		{
+			name:    "import missing path",
+			args:    []string{"localizations", "import", "--version", "VERSION_ID"},
+			wantErr: "Error: --path is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestLocalizationsExportXLIFF(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":[
+			{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","description":"An app","keywords":"a,b"}},
+			{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE","description":"Eine App"}},
+			{"type":"appStoreVersionLocalizations","id":"LOC_FR","attributes":{"locale":"fr-FR"}}
+		]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	dir := filepath.Join(t.TempDir(), "translations")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"localizations", "export", "--version", "VERSION_ID", "--format", "xliff", "--path", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Files []struct {
+			Locale string `json:"locale"`
+			Path   string `json:"path"`
+		} `json:"files"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Files) != 2 || result.Files[0].Locale != "de-DE" || result.Files[1].Locale != "fr-FR" {
+		t.Fatalf("unexpected files: %+v", result.Files)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "de-DE.xliff"))
+	if err != nil {
+		t.Fatalf("read xliff: %v", err)
+	}
+	xliff := string(data)
+	if !strings.Contains(xliff, `<source>An app</source>`) || !strings.Contains(xliff, `<target state="translated">Eine App</target>`) || !strings.Contains(xliff, `<source>a,b</source>`) {
+		t.Fatalf("unexpected xliff:\n%s", xliff)
+	}
+}
+
+func TestLocalizationsImportXLIFFDirectory(t *testing.T) {
+	setupAuth(t)
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"de-DE.xliff": `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">
+  <file original="appStoreVersions/VERSION_ID" source-language="en-US" target-language="de-DE" datatype="plaintext">
+    <body>
+      <trans-unit id="description"><source>An app</source><target>Eine App</target></trans-unit>
+      <trans-unit id="keywords"><source>a,b</source><target></target></trans-unit>
+    </body>
+  </file>
+</xliff>`,
+		"notes.txt": "ignored",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	patchBody := ""
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_DE":
+			payload, _ := io.ReadAll(req.Body)
+			patchBody = string(payload)
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"localizations", "import", "--version", "VERSION_ID", "--path", dir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(patchBody, `"description":"Eine App"`) || strings.Contains(patchBody, "keywords") {
+		t.Fatalf("expected PATCH with translated description only, got %s", patchBody)
+	}
+	if !strings.Contains(stdout, `"locale":"de-DE","action":"update"`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
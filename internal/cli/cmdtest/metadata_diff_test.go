@@ -0,0 +1,143 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMetadataDiffValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing dir",
+			args:    []string{"metadata", "diff", "--remote", "--version-id", "VERSION_ID"},
+			wantErr: "Error: --dir is required",
+		},
+		{
+			name:    "missing remote",
+			args:    []string{"metadata", "diff", "--dir", "./fastlane", "--version-id", "VERSION_ID"},
+			wantErr: "Error: --remote is required",
+		},
+		{
+			name:    "missing version",
+			args:    []string{"metadata", "diff", "--dir", "./fastlane", "--remote"},
+			wantErr: "Error: --version-id is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestMetadataDiffRemoteReportsDrift(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":[
+			{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","description":"An app","keywords":"old,words"}},
+			{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE","description":"Eine App"}}
+		]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	fastlaneDir := t.TempDir()
+	localeDir := filepath.Join(fastlaneDir, "metadata", "en-US")
+	if err := os.MkdirAll(localeDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	files := map[string]string{
+		"description.txt":   "An app\n",
+		"keywords.txt":      "new,words",
+		"release_notes.txt": "Bug fixes",
+		"name.txt":          "Ignored without --app",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(localeDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "diff", "--dir", fastlaneDir, "--remote", "--version-id", "VERSION_ID"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "found 3 difference(s)") {
+		t.Fatalf("expected drift error, got %v", runErr)
+	}
+
+	var result struct {
+		Changes []struct {
+			Locale string `json:"locale"`
+			Field  string `json:"field"`
+			Change string `json:"change"`
+		} `json:"changes"`
+		Added   int  `json:"added"`
+		Changed int  `json:"changed"`
+		Removed int  `json:"removed"`
+		InSync  bool `json:"inSync"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.InSync || result.Added != 1 || result.Changed != 1 || result.Removed != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	got := make([]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		got = append(got, change.Locale+"/"+change.Field+"/"+change.Change)
+	}
+	want := "de-DE/description/removed,en-US/keywords/changed,en-US/whatsNew/added"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("expected changes %s, got %s", want, strings.Join(got, ","))
+	}
+}
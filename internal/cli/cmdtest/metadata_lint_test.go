@@ -0,0 +1,172 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMetadataLintValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing source",
+			args:    []string{"metadata", "lint"},
+			wantErr: "Error: --dir or --remote is required",
+		},
+		{
+			name:    "remote without version",
+			args:    []string{"metadata", "lint", "--remote"},
+			wantErr: "Error: --version-id is required with --remote",
+		},
+		{
+			name:    "dir with remote",
+			args:    []string{"metadata", "lint", "--remote", "--version-id", "VERSION_ID", "--dir", "./fastlane"},
+			wantErr: "Error: --dir and --remote are mutually exclusive",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+type metadataLintOutput struct {
+	Source   string `json:"source"`
+	Locales  []string
+	Findings []struct {
+		Locale   string `json:"locale"`
+		Field    string `json:"field"`
+		Rule     string `json:"rule"`
+		Severity string `json:"severity"`
+	} `json:"findings"`
+	ErrorCount int  `json:"errorCount"`
+	Valid      bool `json:"valid"`
+}
+
+func TestMetadataLintLocalReportsFindings(t *testing.T) {
+	fastlaneDir := t.TempDir()
+	localeDir := filepath.Join(fastlaneDir, "metadata", "en-US")
+	if err := os.MkdirAll(localeDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	files := map[string]string{
+		"description.txt":      "An app",
+		"keywords.txt":         strings.Repeat("k", 120),
+		"promotional_text.txt": "Now faster",
+		"support_url.txt":      "not a url",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(localeDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "lint", "--dir", fastlaneDir, "--require-locales", "en-US,fr-FR"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "found 3 error(s)") {
+		t.Fatalf("expected lint failure, got %v", runErr)
+	}
+
+	var result metadataLintOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.Source != "local" || result.Valid || result.ErrorCount != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	rules := map[string]bool{}
+	for _, finding := range result.Findings {
+		rules[finding.Rule] = true
+	}
+	for _, rule := range []string{"missing-locale", "max-length", "invalid-url"} {
+		if !rules[rule] {
+			t.Fatalf("expected %s finding, got %+v", rule, result.Findings)
+		}
+	}
+}
+
+func TestMetadataLintRemote(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":[
+			{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","description":"An app","supportUrl":"https://example.com"}},
+			{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE","description":"Eine App"}}
+		]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "lint", "--remote", "--version-id", "VERSION_ID"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result metadataLintOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.Source != "remote" || !result.Valid || len(result.Findings) != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if finding := result.Findings[0]; finding.Locale != "de-DE" || finding.Field != "supportUrl" || finding.Rule != "missing-field" || finding.Severity != "warning" {
+		t.Fatalf("unexpected finding: %+v", finding)
+	}
+}
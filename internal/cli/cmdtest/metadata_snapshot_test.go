@@ -0,0 +1,270 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMetadataBackupRestoreValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "backup missing app",
+			args:    []string{"metadata", "backup", "--out", "snapshot.json"},
+			wantErr: "Error: --app is required",
+		},
+		{
+			name:    "backup missing out",
+			args:    []string{"metadata", "backup", "--app", "APP_ID"},
+			wantErr: "Error: --out is required",
+		},
+		{
+			name:    "restore missing in",
+			args:    []string{"metadata", "restore"},
+			wantErr: "Error: --in is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestMetadataBackupWritesSnapshot(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected write during backup: %s %s", req.Method, req.URL.Path)
+		}
+		body := ""
+		switch req.URL.Path {
+		case "/v1/apps/APP_ID/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"INFO_1","attributes":{"appStoreState":"PREPARE_FOR_SUBMISSION"}}]}`
+		case "/v1/appInfos/INFO_1/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"INFO_LOC_EN","attributes":{"locale":"en-US","name":"My App","subtitle":"Does things"}}]}`
+		case "/v1/appInfos/INFO_1/relationships/primaryCategory":
+			body = `{"data":{"type":"appCategories","id":"PRODUCTIVITY"}}`
+		case "/v1/appInfos/INFO_1/relationships/secondaryCategory":
+			body = `{"data":null}`
+		case "/v1/apps/APP_ID/appStoreVersions":
+			body = `{"data":[
+				{"type":"appStoreVersions","id":"V1","attributes":{"versionString":"1.0","createdDate":"2026-01-01T00:00:00Z"}},
+				{"type":"appStoreVersions","id":"V2","attributes":{"versionString":"1.1","createdDate":"2026-05-01T00:00:00Z"}}
+			]}`
+		case "/v1/appStoreVersions/V2/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","description":"An app","whatsNew":"Fixes"}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	outPath := filepath.Join(t.TempDir(), "backups", "snapshot.json")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "backup", "--app", "APP_ID", "--out", outPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var summary struct {
+		VersionID      string `json:"versionId"`
+		Version        string `json:"version"`
+		InfoLocales    int    `json:"infoLocales"`
+		VersionLocales int    `json:"versionLocales"`
+		Categories     bool   `json:"categories"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if summary.VersionID != "V2" || summary.Version != "1.1" || summary.InfoLocales != 1 || summary.VersionLocales != 1 || !summary.Categories {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read snapshot: %v", err)
+	}
+	var snapshot struct {
+		SchemaVersion        int                          `json:"schemaVersion"`
+		AppID                string                       `json:"appId"`
+		Categories           map[string]string            `json:"categories"`
+		AppInfoLocalizations map[string]map[string]string `json:"appInfoLocalizations"`
+		VersionLocalizations map[string]map[string]string `json:"versionLocalizations"`
+	}
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		t.Fatalf("parse snapshot: %v\n%s", err, data)
+	}
+	if snapshot.SchemaVersion != 1 || snapshot.AppID != "APP_ID" || snapshot.Categories["primary"] != "PRODUCTIVITY" {
+		t.Fatalf("unexpected snapshot: %s", data)
+	}
+	if snapshot.AppInfoLocalizations["en-US"]["name"] != "My App" || snapshot.VersionLocalizations["en-US"]["whatsNew"] != "Fixes" {
+		t.Fatalf("unexpected snapshot localizations: %s", data)
+	}
+}
+
+func TestMetadataRestorePushesSnapshot(t *testing.T) {
+	setupAuth(t)
+
+	snapshotPath := filepath.Join(t.TempDir(), "snapshot.json")
+	snapshot := `{
+  "schemaVersion": 1,
+  "createdAt": "2026-10-01T00:00:00Z",
+  "appId": "APP_ID",
+  "appInfoId": "OLD_INFO",
+  "versionId": "V1",
+  "categories": {"primary": "PRODUCTIVITY", "secondary": "UTILITIES"},
+  "appInfoLocalizations": {"en-US": {"name": "My App"}},
+  "versionLocalizations": {"en-US": {"description": "An app"}, "de-DE": {"description": "Eine App"}}
+}`
+	if err := os.WriteFile(snapshotPath, []byte(snapshot), 0o600); err != nil {
+		t.Fatalf("write snapshot: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	requests := map[string]string{}
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		key := req.Method + " " + req.URL.Path
+		if req.Body != nil {
+			payload, _ := io.ReadAll(req.Body)
+			requests[key] = string(payload)
+		}
+		switch key {
+		case "GET /v1/apps/APP_ID/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"INFO_1","attributes":{"appStoreState":"PREPARE_FOR_SUBMISSION"}}]}`
+		case "GET /v1/appInfos/INFO_1/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"INFO_LOC_EN","attributes":{"locale":"en-US","name":"Broken"}}]}`
+		case "PATCH /v1/appInfoLocalizations/INFO_LOC_EN":
+			body = `{"data":{"type":"appInfoLocalizations","id":"INFO_LOC_EN","attributes":{"locale":"en-US"}}}`
+		case "GET /v1/appStoreVersions/V2/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}]}`
+		case "PATCH /v1/appStoreVersionLocalizations/LOC_EN":
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}}`
+		case "POST /v1/appStoreVersionLocalizations":
+			status = http.StatusCreated
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE"}}}`
+		case "PATCH /v1/appInfos/INFO_1":
+			body = `{"data":{"type":"appInfos","id":"INFO_1","attributes":{}}}`
+		default:
+			t.Fatalf("unexpected request: %s", key)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "restore", "--in", snapshotPath, "--version-id", "V2"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(requests["PATCH /v1/appInfoLocalizations/INFO_LOC_EN"], `"name":"My App"`) {
+		t.Fatalf("expected app info name restored, got %v", requests)
+	}
+	if !strings.Contains(requests["POST /v1/appStoreVersionLocalizations"], `"id":"V2"`) {
+		t.Fatalf("expected de-DE created on V2, got %s", requests["POST /v1/appStoreVersionLocalizations"])
+	}
+	categories := requests["PATCH /v1/appInfos/INFO_1"]
+	if !strings.Contains(categories, `"PRODUCTIVITY"`) || !strings.Contains(categories, `"UTILITIES"`) {
+		t.Fatalf("expected categories restored, got %s", categories)
+	}
+
+	var summary struct {
+		AppInfoID            string `json:"appInfoId"`
+		VersionID            string `json:"versionId"`
+		VersionLocalizations []struct {
+			Locale string `json:"locale"`
+			Action string `json:"action"`
+		} `json:"versionLocalizations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if summary.AppInfoID != "INFO_1" || summary.VersionID != "V2" || len(summary.VersionLocalizations) != 2 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+}
+
+func TestMetadataRestoreRejectsUnknownSchema(t *testing.T) {
+	snapshotPath := filepath.Join(t.TempDir(), "snapshot.json")
+	if err := os.WriteFile(snapshotPath, []byte(`{"schemaVersion":2,"appId":"APP_ID"}`), 0o600); err != nil {
+		t.Fatalf("write snapshot: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"metadata", "restore", "--in", snapshotPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "unsupported snapshot schemaVersion 2") {
+		t.Fatalf("expected schema error, got %v", runErr)
+	}
+}
@@ -0,0 +1,107 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMetricsPerfTableFlattensPercentiles(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps/app-1/perfPowerMetrics" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if got := req.URL.Query().Get("filter[metricType]"); got != "LAUNCH,HANG" {
+			t.Fatalf("expected metric type filter, got %q", got)
+		}
+		body := `{"version":"1.0","productData":[{"platform":"iOS","metricCategories":[{"identifier":"launch","metrics":[{"identifier":"timeToFirstDraw","unit":{"displayName":"s"},"datasets":[
+			{"filterCriteria":{"percentile":"percentile.fifty","device":"all_iphones","deviceMarketingName":"All iPhones"},"points":[{"version":"2.1","value":0.42}]},
+			{"filterCriteria":{"percentile":"percentile.fifty","device":"all_ipads","deviceMarketingName":"All iPads"},"points":[{"version":"2.1","value":0.77}]}
+		]}]}]}]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"metrics", "perf", "--app", "app-1", "--metric", "launch,hang", "--device-class", "iPhone", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	if !strings.Contains(stdout, "timeToFirstDraw") || !strings.Contains(stdout, "All iPhones") || !strings.Contains(stdout, "0.42") {
+		t.Fatalf("expected flattened iPhone row, got %q", stdout)
+	}
+	if strings.Contains(stdout, "All iPads") {
+		t.Fatalf("expected iPad rows to be filtered out, got %q", stdout)
+	}
+}
+
+func TestMetricsPerfValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"metrics", "perf"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "invalid metric",
+			args:    []string{"metrics", "perf", "--app", "app-1", "--metric", "FPS"},
+			wantErr: "--metric must be one of",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if !errors.Is(runErr, flag.ErrHelp) {
+				t.Fatalf("expected ErrHelp, got %v", runErr)
+			}
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func migrateExportTransport(t *testing.T, requests *[]string) roundTripFunc {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		*requests = append(*requests, req.URL.Host+req.URL.Path)
+		body := ""
+		contentType := "application/json"
+		switch req.URL.Host + req.URL.Path {
+		case "api.appstoreconnect.apple.com/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","description":"An app","keywords":"app,tools"}}]}`
+		case "api.appstoreconnect.apple.com/v1/apps/APP_ID/appInfos":
+			body = `{"data":[{"type":"appInfos","id":"INFO_ID","attributes":{"appStoreState":"PREPARE_FOR_SUBMISSION"}}]}`
+		case "api.appstoreconnect.apple.com/v1/appInfos/INFO_ID/appInfoLocalizations":
+			body = `{"data":[{"type":"appInfoLocalizations","id":"INFO_EN","attributes":{"locale":"en-US","name":"My App"}}]}`
+		case "api.appstoreconnect.apple.com/v1/appStoreVersions/VERSION_ID/appStoreReviewDetail":
+			body = `{"data":{"type":"appStoreReviewDetails","id":"DETAIL_ID","attributes":{"contactFirstName":"Jane","contactEmail":"jane@example.com","demoAccountName":"demo","demoAccountPassword":"secret"}}}`
+		case "api.appstoreconnect.apple.com/v1/apps/APP_ID":
+			body = `{"data":{"type":"apps","id":"APP_ID","attributes":{"name":"My App","primaryLocale":"en-US"}}}`
+		case "api.appstoreconnect.apple.com/v1/appStoreVersionLocalizations/LOC_EN/appScreenshotSets":
+			body = `{"data":[{"type":"appScreenshotSets","id":"SET_PHONE","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}},{"type":"appScreenshotSets","id":"SET_IMESSAGE","attributes":{"screenshotDisplayType":"IMESSAGE_APP_IPHONE_67"}}]}`
+		case "api.appstoreconnect.apple.com/v1/appScreenshotSets/SET_PHONE/appScreenshots":
+			body = `{"data":[{"type":"appScreenshots","id":"SHOT_1","attributes":{"fileName":"home.png","imageAsset":{"templateUrl":"https://is1-ssl.mzstatic.com/image/shot1/{w}x{h}bb.{f}","width":1290,"height":2796}}},{"type":"appScreenshots","id":"SHOT_2","attributes":{"fileName":"detail.png","imageAsset":{"templateUrl":"https://is1-ssl.mzstatic.com/image/shot2/{w}x{h}bb.{f}","width":1290,"height":2796}}}]}`
+		case "api.appstoreconnect.apple.com/v1/appScreenshotSets/SET_IMESSAGE/appScreenshots":
+			body = `{"data":[{"type":"appScreenshots","id":"SHOT_3","attributes":{"fileName":"sticker.png","imageAsset":{"templateUrl":"https://is1-ssl.mzstatic.com/image/shot3/{w}x{h}bb.{f}","width":1290,"height":2796}}}]}`
+		case "is1-ssl.mzstatic.com/image/shot1/1290x2796bb.png",
+			"is1-ssl.mzstatic.com/image/shot2/1290x2796bb.png",
+			"is1-ssl.mzstatic.com/image/shot3/1290x2796bb.png":
+			body = "PNG:" + req.URL.Path
+			contentType = "image/png"
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{contentType}},
+		}, nil
+	}
+}
+
+func runMigrateExport(t *testing.T, args ...string) string {
+	t.Helper()
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"migrate", "export"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	return stdout
+}
+
+func readExportedFile(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v", path, err)
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func TestMigrateExportWritesDeliverTree(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = migrateExportTransport(t, &requests)
+
+	outputDir := t.TempDir()
+	stdout := runMigrateExport(t, "--app", "APP_ID", "--version-id", "VERSION_ID", "--output-dir", outputDir)
+
+	var result struct {
+		DefaultLocale     string `json:"defaultLocale"`
+		ReviewInformation bool   `json:"reviewInformation"`
+		Screenshots       int    `json:"screenshots"`
+		TotalFiles        int    `json:"totalFiles"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.DefaultLocale != "en-US" || !result.ReviewInformation || result.Screenshots != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	// 3 locale files, 3 default copies, 4 review files, 3 screenshots.
+	if result.TotalFiles != 13 {
+		t.Fatalf("expected 13 files, got %d", result.TotalFiles)
+	}
+
+	metadataDir := filepath.Join(outputDir, "metadata")
+	if got := readExportedFile(t, filepath.Join(metadataDir, "default", "name.txt")); got != "My App" {
+		t.Fatalf("default name = %q", got)
+	}
+	if got := readExportedFile(t, filepath.Join(metadataDir, "default", "keywords.txt")); got != "app,tools" {
+		t.Fatalf("default keywords = %q", got)
+	}
+	if got := readExportedFile(t, filepath.Join(metadataDir, "review_information", "demo_password.txt")); got != "secret" {
+		t.Fatalf("demo password = %q", got)
+	}
+	if _, err := os.Stat(filepath.Join(metadataDir, "review_information", "notes.txt")); !os.IsNotExist(err) {
+		t.Fatalf("expected no notes.txt, got err %v", err)
+	}
+
+	screenshotsDir := filepath.Join(outputDir, "screenshots", "en-US")
+	for path, want := range map[string]string{
+		filepath.Join(screenshotsDir, "APP_IPHONE_67-01.png"):             "PNG:/image/shot1/1290x2796bb.png",
+		filepath.Join(screenshotsDir, "APP_IPHONE_67-02.png"):             "PNG:/image/shot2/1290x2796bb.png",
+		filepath.Join(screenshotsDir, "iMessage", "APP_IPHONE_67-01.png"): "PNG:/image/shot3/1290x2796bb.png",
+	} {
+		if got := readExportedFile(t, path); got != want {
+			t.Fatalf("%s = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestMigrateExportSkipScreenshots(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = migrateExportTransport(t, &requests)
+
+	outputDir := t.TempDir()
+	runMigrateExport(t, "--app", "APP_ID", "--version-id", "VERSION_ID", "--output-dir", outputDir, "--skip-screenshots")
+
+	for _, request := range requests {
+		if strings.Contains(request, "appScreenshot") || strings.Contains(request, "mzstatic") {
+			t.Fatalf("unexpected screenshot request %s", request)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "screenshots")); !os.IsNotExist(err) {
+		t.Fatalf("expected no screenshots directory, got err %v", err)
+	}
+}
+
+func TestMigrateExportReplacesScreenshotSymlinks(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var requests []string
+	http.DefaultTransport = migrateExportTransport(t, &requests)
+
+	outputDir := t.TempDir()
+	target := filepath.Join(t.TempDir(), "outside.txt")
+	if err := os.WriteFile(target, []byte("keep"), 0o600); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+	screenshotsDir := filepath.Join(outputDir, "screenshots", "en-US")
+	if err := os.MkdirAll(screenshotsDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	link := filepath.Join(screenshotsDir, "APP_IPHONE_67-01.png")
+	if err := os.Symlink(target, link); err != nil {
+		t.Fatalf("symlink: %v", err)
+	}
+
+	runMigrateExport(t, "--app", "APP_ID", "--version-id", "VERSION_ID", "--output-dir", outputDir)
+
+	if got := readExportedFile(t, target); got != "keep" {
+		t.Fatalf("expected symlink target untouched, got %q", got)
+	}
+	info, err := os.Lstat(link)
+	if err != nil {
+		t.Fatalf("lstat: %v", err)
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		t.Fatal("expected the symlink to be replaced by a regular file")
+	}
+	if got := readExportedFile(t, link); got != "PNG:/image/shot1/1290x2796bb.png" {
+		t.Fatalf("unexpected screenshot contents %q", got)
+	}
+}
@@ -0,0 +1,92 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFastlaneAppInfoFixture(t *testing.T) string {
+	t.Helper()
+
+	fastlaneDir := t.TempDir()
+	localeDir := filepath.Join(fastlaneDir, "metadata", "en-US")
+	if err := os.MkdirAll(localeDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	files := map[string]string{
+		"description.txt": "An app",
+		"name.txt":        "My App",
+		"subtitle.txt":    "Does things",
+		"privacy_url.txt": "https://example.com/privacy",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(localeDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	return fastlaneDir
+}
+
+func runMigrateImportDryRun(t *testing.T, extraArgs ...string) map[string]interface{} {
+	t.Helper()
+
+	fastlaneDir := writeFastlaneAppInfoFixture(t)
+	args := append([]string{
+		"migrate", "import",
+		"--app", "APP_ID",
+		"--version-id", "VERSION_ID",
+		"--fastlane-dir", fastlaneDir,
+		"--dry-run",
+	}, extraArgs...)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	return result
+}
+
+func TestMigrateImportSkipsPrivacyURLByDefault(t *testing.T) {
+	result := runMigrateImportDryRun(t)
+
+	locs, ok := result["appInfoLocalizations"].([]interface{})
+	if !ok || len(locs) != 1 {
+		t.Fatalf("expected name and subtitle to be imported by default, got %v", result["appInfoLocalizations"])
+	}
+	loc := locs[0].(map[string]interface{})
+	if loc["name"] != "My App" || loc["subtitle"] != "Does things" {
+		t.Fatalf("unexpected app info localization: %v", loc)
+	}
+	if _, ok := loc["privacyPolicyUrl"]; ok {
+		t.Fatalf("expected no privacy URL without --include-app-info, got %v", loc)
+	}
+}
+
+func TestMigrateImportIncludeAppInfo(t *testing.T) {
+	result := runMigrateImportDryRun(t, "--include-app-info")
+
+	locs, ok := result["appInfoLocalizations"].([]interface{})
+	if !ok || len(locs) != 1 {
+		t.Fatalf("expected one app info localization, got %v", result["appInfoLocalizations"])
+	}
+	loc := locs[0].(map[string]interface{})
+	if loc["locale"] != "en-US" || loc["name"] != "My App" || loc["subtitle"] != "Does things" || loc["privacyPolicyUrl"] != "https://example.com/privacy" {
+		t.Fatalf("unexpected app info localization: %v", loc)
+	}
+}
@@ -0,0 +1,110 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrateImportCreatesReviewDetail(t *testing.T) {
+	setupAuth(t)
+
+	fastlaneDir := t.TempDir()
+	localeDir := filepath.Join(fastlaneDir, "metadata", "en-US")
+	reviewDir := filepath.Join(fastlaneDir, "metadata", "review_information")
+	for _, dir := range []string{localeDir, reviewDir} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	files := map[string]string{
+		filepath.Join(localeDir, "description.txt"):     "An app",
+		filepath.Join(reviewDir, "demo_user.txt"):       "demo@example.com",
+		filepath.Join(reviewDir, "demo_password.txt"):   "s3cret",
+		filepath.Join(reviewDir, "phone_number.txt"):    "+1 555 0100",
+		filepath.Join(reviewDir, "notes.txt"):           "Use the demo account.",
+		filepath.Join(reviewDir, "email_address.txt"):   "review@example.com",
+		filepath.Join(reviewDir, "unrelated_field.txt"): "ignored",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	createPayload := ""
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_1","attributes":{"locale":"en-US"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_1":
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"LOC_1","attributes":{"locale":"en-US"}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_ID/appStoreReviewDetail":
+			status = http.StatusNotFound
+			body = `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found"}]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreReviewDetails":
+			payload, _ := io.ReadAll(req.Body)
+			createPayload = string(payload)
+			status = http.StatusCreated
+			body = `{"data":{"type":"appStoreReviewDetails","id":"DETAIL_1","attributes":{}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"migrate", "import",
+			"--app", "APP_ID",
+			"--version-id", "VERSION_ID",
+			"--fastlane-dir", fastlaneDir,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	for _, want := range []string{
+		`"demoAccountName":"demo@example.com"`,
+		`"demoAccountPassword":"s3cret"`,
+		`"demoAccountRequired":true`,
+		`"contactPhone":"+1 555 0100"`,
+		`"contactEmail":"review@example.com"`,
+		`"notes":"Use the demo account."`,
+		`"id":"VERSION_ID"`,
+	} {
+		if !strings.Contains(createPayload, want) {
+			t.Fatalf("expected %s in create payload, got %s", want, createPayload)
+		}
+	}
+	if strings.Contains(createPayload, "contactFirstName") {
+		t.Fatalf("expected missing first name to be omitted, got %s", createPayload)
+	}
+	if !strings.Contains(stdout, `"reviewDetailId":"DETAIL_1"`) {
+		t.Fatalf("expected review detail ID in output, got %s", stdout)
+	}
+	if strings.Contains(stdout, "s3cret") {
+		t.Fatalf("expected demo password to be omitted from output, got %s", stdout)
+	}
+}
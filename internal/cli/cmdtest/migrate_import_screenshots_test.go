@@ -0,0 +1,154 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrateImportScreenshotsUploadsAndSkipsMatchingChecksums(t *testing.T) {
+	setupAuth(t)
+
+	fastlaneDir := t.TempDir()
+	metadataDir := filepath.Join(fastlaneDir, "metadata", "en-US")
+	screenshotsDir := filepath.Join(fastlaneDir, "screenshots", "en-US")
+	for _, dir := range []string{metadataDir, screenshotsDir} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(metadataDir, "description.txt"), []byte("An app"), 0o644); err != nil {
+		t.Fatalf("write description: %v", err)
+	}
+	existing := []byte("existing screenshot")
+	if err := os.WriteFile(filepath.Join(screenshotsDir, "iPhone 15 Pro Max-01.png"), existing, 0o644); err != nil {
+		t.Fatalf("write screenshot: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(screenshotsDir, "iPhone 15 Pro Max-02.png"), []byte("new screenshot"), 0o644); err != nil {
+		t.Fatalf("write screenshot: %v", err)
+	}
+	existingSum := md5.Sum(existing)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[{"type":"appStoreVersionLocalizations","id":"LOC_1","attributes":{"locale":"en-US"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_1":
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"LOC_1","attributes":{"locale":"en-US"}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersionLocalizations/LOC_1/appScreenshotSets":
+			body = `{"data":[{"type":"appScreenshotSets","id":"SET_1","attributes":{"screenshotDisplayType":"APP_IPHONE_67"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appScreenshotSets/SET_1/appScreenshots":
+			body = fmt.Sprintf(`{"data":[{"type":"appScreenshots","id":"SHOT_1","attributes":{"fileName":"old.png","fileSize":19,"sourceFileChecksum":"%s"}}]}`, hex.EncodeToString(existingSum[:]))
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appScreenshots":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"fileName":"iPhone 15 Pro Max-02.png"`) {
+				t.Fatalf("unexpected reservation payload: %s", payload)
+			}
+			body = `{"data":{"type":"appScreenshots","id":"SHOT_2","attributes":{"fileName":"iPhone 15 Pro Max-02.png","fileSize":14,"uploadOperations":[{"method":"PUT","url":"https://upload.example.com/shot2","length":14,"offset":0}]}}}`
+		case req.Method == http.MethodPut && req.URL.Host == "upload.example.com":
+			body = ``
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/appScreenshots/SHOT_2":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"uploaded":true`) {
+				t.Fatalf("expected commit payload, got %s", payload)
+			}
+			body = `{"data":{"type":"appScreenshots","id":"SHOT_2","attributes":{"fileName":"iPhone 15 Pro Max-02.png","fileSize":14}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"migrate", "import",
+			"--app", "APP_ID",
+			"--version-id", "VERSION_ID",
+			"--fastlane-dir", fastlaneDir,
+			"--include-screenshots",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Screenshots []struct {
+			FileName    string `json:"fileName"`
+			DisplayType string `json:"displayType"`
+			Status      string `json:"status"`
+			Reason      string `json:"reason"`
+			AssetID     string `json:"assetId"`
+		} `json:"screenshots"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Screenshots) != 2 {
+		t.Fatalf("expected 2 screenshots, got %+v", result.Screenshots)
+	}
+	first, second := result.Screenshots[0], result.Screenshots[1]
+	if first.Status != "skipped" || first.Reason != "checksum matches existing screenshot" {
+		t.Fatalf("expected first screenshot skipped, got %+v", first)
+	}
+	if second.Status != "uploaded" || second.AssetID != "SHOT_2" || second.DisplayType != "APP_IPHONE_67" {
+		t.Fatalf("expected second screenshot uploaded, got %+v", second)
+	}
+	if got := strings.Count(strings.Join(requests, "\n"), "POST /v1/appScreenshots"); got != 1 {
+		t.Fatalf("expected one reservation, got %d in %v", got, requests)
+	}
+}
+
+func TestMigrateImportScreenshotsMissingDirectory(t *testing.T) {
+	fastlaneDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(fastlaneDir, "metadata", "en-US"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"migrate", "import",
+			"--app", "APP_ID",
+			"--version-id", "VERSION_ID",
+			"--fastlane-dir", fastlaneDir,
+			"--include-screenshots",
+			"--dry-run",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "screenshots directory not found") {
+		t.Fatalf("expected missing screenshots directory error, got %v", runErr)
+	}
+}
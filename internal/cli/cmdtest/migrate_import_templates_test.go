@@ -0,0 +1,118 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFastlaneTemplateFixture(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	fastlaneDir := t.TempDir()
+	localeDir := filepath.Join(fastlaneDir, "metadata", "en-US")
+	if err := os.MkdirAll(localeDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(localeDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	return fastlaneDir
+}
+
+func TestMigrateImportExpandsTemplateVariables(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/appStoreVersions/VERSION_ID" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body := `{"data":{"type":"appStoreVersions","id":"VERSION_ID","attributes":{"versionString":"2.4.0"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	fastlaneDir := writeFastlaneTemplateFixture(t, map[string]string{
+		"release_notes.txt":    "Version {{version}}: {{ season }} update",
+		"promotional_text.txt": "Released {{date}}",
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"migrate", "import",
+			"--app", "APP_ID",
+			"--version-id", "VERSION_ID",
+			"--fastlane-dir", fastlaneDir,
+			"--var", "season=Winter",
+			"--var", "date=2026-01-01",
+			"--dry-run",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Localizations []struct {
+			WhatsNew        string `json:"whatsNew"`
+			PromotionalText string `json:"promotionalText"`
+		} `json:"localizations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Localizations) != 1 {
+		t.Fatalf("expected one localization, got %+v", result.Localizations)
+	}
+	loc := result.Localizations[0]
+	if loc.WhatsNew != "Version 2.4.0: Winter update" || loc.PromotionalText != "Released 2026-01-01" {
+		t.Fatalf("unexpected expansion: %+v", loc)
+	}
+}
+
+func TestMigrateImportUndefinedTemplateVariable(t *testing.T) {
+	fastlaneDir := writeFastlaneTemplateFixture(t, map[string]string{
+		"release_notes.txt": "Hello {{season}}",
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"migrate", "import",
+			"--app", "APP_ID",
+			"--version-id", "VERSION_ID",
+			"--fastlane-dir", fastlaneDir,
+			"--dry-run",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), `en-US whatsNew: undefined template variable "season"`) {
+		t.Fatalf("expected undefined variable error, got %v", runErr)
+	}
+}
@@ -0,0 +1,233 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFastlaneSyncFixture(t *testing.T) string {
+	t.Helper()
+
+	fastlaneDir := t.TempDir()
+	files := map[string]string{
+		filepath.Join("en-US", "description.txt"): "Same description",
+		filepath.Join("en-US", "keywords.txt"):    "new,keywords",
+		filepath.Join("de-DE", "description.txt"): "Gleiche Beschreibung",
+		filepath.Join("fr-FR", "description.txt"): "Nouvelle description",
+	}
+	for name, content := range files {
+		path := filepath.Join(fastlaneDir, "metadata", name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	return fastlaneDir
+}
+
+func TestMigrateSyncValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing version id",
+			args:    []string{"migrate", "sync", "--fastlane-dir", "./fastlane"},
+			wantErr: "Error: --version-id is required",
+		},
+		{
+			name:    "missing fastlane dir",
+			args:    []string{"migrate", "sync", "--version-id", "VERSION_ID"},
+			wantErr: "Error: --fastlane-dir is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestMigrateSyncUpdatesOnlyChangedFields(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("NO_COLOR", "1")
+	fastlaneDir := writeFastlaneSyncFixture(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	patches := map[string]string{}
+	createPayload := ""
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[
+				{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","description":"Same description\n","keywords":"old,keywords"}},
+				{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE","description":"Gleiche Beschreibung"}}
+			]}`
+		case req.Method == http.MethodPatch && strings.HasPrefix(req.URL.Path, "/v1/appStoreVersionLocalizations/"):
+			payload, _ := io.ReadAll(req.Body)
+			patches[req.URL.Path] = string(payload)
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appStoreVersionLocalizations":
+			payload, _ := io.ReadAll(req.Body)
+			createPayload = string(payload)
+			status = http.StatusCreated
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"LOC_FR","attributes":{"locale":"fr-FR"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"migrate", "sync",
+			"--version-id", "VERSION_ID",
+			"--fastlane-dir", fastlaneDir,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(patches) != 1 {
+		t.Fatalf("expected exactly one PATCH, got %v", patches)
+	}
+	patch, ok := patches["/v1/appStoreVersionLocalizations/LOC_EN"]
+	if !ok {
+		t.Fatalf("expected PATCH for LOC_EN, got %v", patches)
+	}
+	if !strings.Contains(patch, `"keywords":"new,keywords"`) || strings.Contains(patch, "description") {
+		t.Fatalf("expected PATCH with only keywords, got %s", patch)
+	}
+	if !strings.Contains(createPayload, `"locale":"fr-FR"`) || !strings.Contains(createPayload, `"description":"Nouvelle description"`) {
+		t.Fatalf("unexpected create payload: %s", createPayload)
+	}
+	if !strings.Contains(stderr, "~ appStoreVersionLocalization en-US") || !strings.Contains(stderr, "- old,keywords") {
+		t.Fatalf("expected change plan on stderr, got %q", stderr)
+	}
+
+	var result struct {
+		Changes []struct {
+			Locale string `json:"locale"`
+			Field  string `json:"field"`
+			Action string `json:"action"`
+			Status string `json:"status"`
+		} `json:"changes"`
+		Unchanged int `json:"unchanged"`
+		Applied   int `json:"applied"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Changes) != 2 || result.Unchanged != 2 || result.Applied != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	for _, change := range result.Changes {
+		if change.Status != "applied" {
+			t.Fatalf("expected applied change, got %+v", change)
+		}
+	}
+}
+
+func TestMigrateSyncDryRunSkipsWrites(t *testing.T) {
+	setupAuth(t)
+	fastlaneDir := writeFastlaneSyncFixture(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected write during dry run: %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":[]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"migrate", "sync",
+			"--version-id", "VERSION_ID",
+			"--fastlane-dir", fastlaneDir,
+			"--dry-run",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		DryRun  bool `json:"dryRun"`
+		Changes []struct {
+			Action string `json:"action"`
+			Status string `json:"status"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if !result.DryRun || len(result.Changes) != 4 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	for _, change := range result.Changes {
+		if change.Action != "create" || change.Status != "planned" {
+			t.Fatalf("expected planned create, got %+v", change)
+		}
+	}
+}
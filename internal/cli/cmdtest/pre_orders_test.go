@@ -0,0 +1,117 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func preOrdersTestTransport(t *testing.T, patched *[]string) roundTripFunc {
+	t.Helper()
+	return func(req *http.Request) (*http.Response, error) {
+		respond := func(body string) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		}
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/APP_ID/appAvailabilityV2":
+			return respond(`{"data":{"type":"appAvailabilities","id":"AVAIL_ID","attributes":{"availableInNewTerritories":true}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/appAvailabilities/AVAIL_ID/territoryAvailabilities":
+			return respond(`{"data":[` +
+				`{"type":"territoryAvailabilities","id":"ta-usa","attributes":{"available":true,"preOrderEnabled":true,"releaseDate":"2026-03-01","preOrderPublishDate":"2026-01-15"},"relationships":{"territory":{"data":{"type":"territories","id":"USA"}}}},` +
+				`{"type":"territoryAvailabilities","id":"ta-gbr","attributes":{"available":true,"preOrderEnabled":false},"relationships":{"territory":{"data":{"type":"territories","id":"GBR"}}}}` +
+				`],"links":{}}`)
+		case req.Method == http.MethodPatch && strings.HasPrefix(req.URL.Path, "/v1/territoryAvailabilities/"):
+			id := strings.TrimPrefix(req.URL.Path, "/v1/territoryAvailabilities/")
+			*patched = append(*patched, id)
+			return respond(`{"data":{"type":"territoryAvailabilities","id":"` + id + `","attributes":{"available":true,"preOrderEnabled":false}}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	}
+}
+
+func TestPreOrdersStatusReportsTerritories(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var patched []string
+	http.DefaultTransport = preOrdersTestTransport(t, &patched)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"pre-orders", "status", "--app", "APP_ID"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	var out struct {
+		AvailabilityID string `json:"availabilityId"`
+		PreOrderCount  int    `json:"preOrderCount"`
+		Territories    []struct {
+			Territory           string `json:"territory"`
+			PreOrderEnabled     bool   `json:"preOrderEnabled"`
+			PreOrderPublishDate string `json:"preOrderPublishDate"`
+		} `json:"territories"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &out); err != nil {
+		t.Fatalf("parse stdout: %v (%q)", err, stdout)
+	}
+	if out.AvailabilityID != "AVAIL_ID" || out.PreOrderCount != 1 {
+		t.Fatalf("unexpected summary: %+v", out)
+	}
+	if len(out.Territories) != 2 || out.Territories[0].Territory != "GBR" || out.Territories[1].PreOrderPublishDate != "2026-01-15" {
+		t.Fatalf("unexpected territories: %+v", out.Territories)
+	}
+}
+
+func TestPreOrdersDisableByTerritory(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	var patched []string
+	http.DefaultTransport = preOrdersTestTransport(t, &patched)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"pre-orders", "disable", "--app", "APP_ID", "--territory", "usa"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(patched) != 1 || patched[0] != "ta-usa" {
+		t.Fatalf("expected PATCH for ta-usa, got %v", patched)
+	}
+	if !strings.Contains(stdout, `"id":"ta-usa"`) {
+		t.Fatalf("expected updated territory availability in output, got %q", stdout)
+	}
+}
@@ -0,0 +1,127 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPricingAvailabilitySetAllExcept(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var created map[string]bool
+	var availableInNew any
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		respond := func(body string) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		}
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/territories":
+			return respond(`{"data":[{"type":"territories","id":"USA"},{"type":"territories","id":"CHN"},{"type":"territories","id":"GBR"}],"links":{}}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v2/appAvailabilities":
+			var body struct {
+				Data struct {
+					Attributes map[string]any `json:"attributes"`
+				} `json:"data"`
+				Included []struct {
+					Attributes struct {
+						Available bool `json:"available"`
+					} `json:"attributes"`
+					Relationships struct {
+						Territory struct {
+							Data struct {
+								ID string `json:"id"`
+							} `json:"data"`
+						} `json:"territory"`
+					} `json:"relationships"`
+				} `json:"included"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			availableInNew = body.Data.Attributes["availableInNewTerritories"]
+			created = map[string]bool{}
+			for _, item := range body.Included {
+				created[item.Relationships.Territory.Data.ID] = item.Attributes.Available
+			}
+			return respond(`{"data":{"type":"appAvailabilities","id":"AVAIL_ID","attributes":{"availableInNewTerritories":false}}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"pricing", "availability", "set", "--app", "APP_ID", "--all", "--except", "chn", "--available=true", "--available-in-new-territories=false"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	want := map[string]bool{"USA": true, "GBR": true, "CHN": false}
+	if len(created) != len(want) {
+		t.Fatalf("expected %v, got %v", want, created)
+	}
+	for territory, available := range want {
+		if created[territory] != available {
+			t.Fatalf("expected %s available=%v, got %v", territory, available, created)
+		}
+	}
+	if availableInNew != false {
+		t.Fatalf("expected availableInNewTerritories=false, got %v", availableInNew)
+	}
+}
+
+func TestPricingAvailabilitySetRejectsUnknownExcept(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/territories" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":[{"type":"territories","id":"USA"}],"links":{}}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"pricing", "availability", "set", "--app", "APP_ID", "--all", "--except", "XYZ", "--available", "true"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "unknown territories in --except: XYZ") {
+		t.Fatalf("expected unknown territory error, got %v", runErr)
+	}
+}
@@ -0,0 +1,75 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPricingPricePointsFindCSV(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch req.URL.Path {
+		case "/v1/apps/APP_ID/appPricePoints":
+			if got := req.URL.Query().Get("filter[territory]"); got != "USA" {
+				t.Fatalf("expected filter[territory]=USA, got %q", got)
+			}
+			body = `{"data":[
+				{"type":"appPricePoints","id":"PP_USA_399","attributes":{"customerPrice":"3.99","proceeds":"3.39"}},
+				{"type":"appPricePoints","id":"PP_USA_499","attributes":{"customerPrice":"4.99","proceeds":"4.24"}}
+			]}`
+		case "/v3/appPricePoints/PP_USA_499/equalizations":
+			if got := req.URL.Query().Get("include"); got != "territory" {
+				t.Fatalf("expected include=territory, got %q", got)
+			}
+			body = `{"data":[
+				{"type":"appPricePoints","id":"PP_JPN","attributes":{"customerPrice":"800","proceeds":"617"},"relationships":{"territory":{"data":{"type":"territories","id":"JPN"}}}},
+				{"type":"appPricePoints","id":"PP_GBR","attributes":{"customerPrice":"4.99","proceeds":"3.49"},"relationships":{"territory":{"data":{"type":"territories","id":"GBR"}}}}
+			]}`
+		case "/v1/territories":
+			body = `{"data":[
+				{"type":"territories","id":"USA","attributes":{"currency":"USD"}},
+				{"type":"territories","id":"GBR","attributes":{"currency":"GBP"}},
+				{"type":"territories","id":"JPN","attributes":{"currency":"JPY"}}
+			]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"pricing", "price-points", "find", "--app", "APP_ID", "--price", "4.990", "--output", "csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	want := strings.Join([]string{
+		"Territory,Currency,Customer Price,Proceeds,Price Point ID",
+		"USA,USD,4.99,4.24,PP_USA_499",
+		"GBR,GBP,4.99,3.49,PP_GBR",
+		"JPN,JPY,800,617,PP_JPN",
+		"",
+	}, "\n")
+	if stdout != want {
+		t.Fatalf("expected CSV:\n%s\ngot:\n%s", want, stdout)
+	}
+}
@@ -0,0 +1,135 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPricingScheduleCreateKeepCurrent(t *testing.T) {
+	requests := runRecordedCommand(t, []string{
+		"pricing", "schedule", "create", "--app", "APP_ID", "--price-point", "PP_NEW",
+		"--base-territory", "USA", "--start-date", "2099-01-01", "--keep-current",
+	}, func(req *http.Request) (int, string) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/APP_ID/appPriceSchedule":
+			return http.StatusOK, `{"data":{"type":"appPriceSchedules","id":"SCHEDULE_ID"}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appPriceSchedules/SCHEDULE_ID/manualPrices":
+			return http.StatusOK, `{"data":[
+				{"type":"appPrices","id":"old","attributes":{"endDate":"2000-01-01"},"relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"PP_OLD"}},"territory":{"data":{"type":"territories","id":"USA"}}}},
+				{"type":"appPrices","id":"current","attributes":{"startDate":"2000-01-01"},"relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"PP_CURRENT"}},"territory":{"data":{"type":"territories","id":"USA"}}}}
+			]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/appPriceSchedules":
+			return http.StatusCreated, `{"data":{"type":"appPriceSchedules","id":"NEW_SCHEDULE_ID"}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+			return 0, ""
+		}
+	})
+
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %v", requests)
+	}
+	for _, want := range []string{`"id":"PP_NEW"`, `"id":"PP_CURRENT"`, `"endDate":"2099-01-01"`, `"startDate":"2099-01-01"`} {
+		if !strings.Contains(requests[2], want) {
+			t.Fatalf("expected create body to contain %s, got %s", want, requests[2])
+		}
+	}
+	if strings.Contains(requests[2], "PP_OLD") {
+		t.Fatalf("expected ended price to be skipped, got %s", requests[2])
+	}
+}
+
+func TestPricingSchedulePrices(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch req.URL.Path {
+		case "/v1/apps/APP_ID/appPriceSchedule":
+			body = `{"data":{"type":"appPriceSchedules","id":"SCHEDULE_ID"}}`
+		case "/v1/appPriceSchedules/SCHEDULE_ID/manualPrices":
+			body = `{"data":[
+				{"type":"appPrices","id":"m1","attributes":{"startDate":"2000-01-01"},"relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"PP_USA"}},"territory":{"data":{"type":"territories","id":"USA"}}}},
+				{"type":"appPrices","id":"m2","attributes":{"startDate":"2099-01-01"},"relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"PP_USA_NEXT"}},"territory":{"data":{"type":"territories","id":"USA"}}}}
+			],"included":[
+				{"type":"appPricePoints","id":"PP_USA","attributes":{"customerPrice":"4.99","proceeds":"4.24"}},
+				{"type":"appPricePoints","id":"PP_USA_NEXT","attributes":{"customerPrice":"5.99","proceeds":"5.09"}},
+				{"type":"territories","id":"USA","attributes":{"currency":"USD"}}
+			]}`
+		case "/v1/appPriceSchedules/SCHEDULE_ID/automaticPrices":
+			if got := req.URL.Query().Get("include"); got != "appPricePoint,territory" {
+				t.Fatalf("expected include=appPricePoint,territory, got %q", got)
+			}
+			if req.URL.Query().Get("cursor") == "" {
+				body = `{"data":[
+					{"type":"appPrices","id":"a1","attributes":{"startDate":"2000-01-01"},"relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"PP_GBR"}},"territory":{"data":{"type":"territories","id":"GBR"}}}}
+				],"included":[
+					{"type":"appPricePoints","id":"PP_GBR","attributes":{"customerPrice":"4.99","proceeds":"3.49"}},
+					{"type":"territories","id":"GBR","attributes":{"currency":"GBP"}}
+				],"links":{"next":"https://api.appstoreconnect.apple.com/v1/appPriceSchedules/SCHEDULE_ID/automaticPrices?cursor=2&include=appPricePoint%2Cterritory"}}`
+			} else {
+				body = `{"data":[
+					{"type":"appPrices","id":"a2","attributes":{"startDate":"2000-01-01"},"relationships":{"appPricePoint":{"data":{"type":"appPricePoints","id":"PP_JPN"}},"territory":{"data":{"type":"territories","id":"JPN"}}}}
+				],"included":[
+					{"type":"appPricePoints","id":"PP_JPN","attributes":{"customerPrice":"800","proceeds":"617"}},
+					{"type":"territories","id":"JPN","attributes":{"currency":"JPY"}}
+				]}`
+			}
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"pricing", "schedule", "prices", "--app", "APP_ID"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		ScheduleID string `json:"scheduleId"`
+		Prices     []struct {
+			Territory     string `json:"territory"`
+			Currency      string `json:"currency"`
+			Status        string `json:"status"`
+			CustomerPrice string `json:"customerPrice"`
+			Manual        bool   `json:"manual"`
+		} `json:"prices"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.ScheduleID != "SCHEDULE_ID" || len(result.Prices) != 4 {
+		t.Fatalf("unexpected result: %s", stdout)
+	}
+	got := make([]string, 0, len(result.Prices))
+	for _, price := range result.Prices {
+		got = append(got, price.Territory+" "+price.Status+" "+price.CustomerPrice+" "+price.Currency)
+	}
+	want := []string{"GBR current 4.99 GBP", "JPN current 800 JPY", "USA current 4.99 USD", "USA upcoming 5.99 USD"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if !result.Prices[2].Manual || result.Prices[0].Manual {
+		t.Fatalf("expected only USA prices to be manual: %s", stdout)
+	}
+}
@@ -0,0 +1,125 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProfilesListFiltersByBundleIDAcrossPagesWithIncluded(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	const secondURL = "https://api.appstoreconnect.apple.com/v1/profiles?cursor=BQ&include=certificates,devices,bundleId"
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	requestCount := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requestCount++
+		body := ""
+		switch requestCount {
+		case 1:
+			values := req.URL.Query()
+			if req.URL.Path != "/v1/profiles" || values.Get("include") != "certificates,devices,bundleId" {
+				t.Fatalf("unexpected first request: %s", req.URL.String())
+			}
+			if values.Get("limit[certificates]") != "50" || values.Get("limit[devices]") != "50" || values.Get("limit") != "200" {
+				t.Fatalf("expected include and page limits, got %s", req.URL.RawQuery)
+			}
+			body = `{"data":[` +
+				`{"type":"profiles","id":"p1","attributes":{"name":"App Dev","profileType":"IOS_APP_DEVELOPMENT"},"relationships":{"bundleId":{"data":{"type":"bundleIds","id":"bid-1"}},"certificates":{"data":[{"type":"certificates","id":"c1"}]},"devices":{"data":[{"type":"devices","id":"d1"}]}}},` +
+				`{"type":"profiles","id":"p2","attributes":{"name":"Other Dev","profileType":"IOS_APP_DEVELOPMENT"},"relationships":{"bundleId":{"data":{"type":"bundleIds","id":"bid-2"}},"certificates":{"data":[{"type":"certificates","id":"c1"}]},"devices":{"data":[]}}}],` +
+				`"included":[{"type":"bundleIds","id":"bid-1","attributes":{"identifier":"com.example.app"}},{"type":"bundleIds","id":"bid-2","attributes":{"identifier":"com.example.other"}},{"type":"certificates","id":"c1","attributes":{"name":"Apple Development","serialNumber":"SERIAL1"}},{"type":"devices","id":"d1","attributes":{"name":"QA iPhone"}}],` +
+				`"links":{"next":"` + secondURL + `"}}`
+		case 2:
+			if req.URL.String() != secondURL {
+				t.Fatalf("unexpected second request: %s", req.URL.String())
+			}
+			body = `{"data":[` +
+				`{"type":"profiles","id":"p3","attributes":{"name":"App Store","profileType":"IOS_APP_STORE"},"relationships":{"bundleId":{"data":{"type":"bundleIds","id":"bid-1"}},"certificates":{"data":[{"type":"certificates","id":"c2"}]},"devices":{"data":[]}}}],` +
+				`"included":[{"type":"bundleIds","id":"bid-1","attributes":{"identifier":"com.example.app"}},{"type":"certificates","id":"c2","attributes":{"name":"Apple Distribution","serialNumber":"SERIAL2"}}]}`
+		default:
+			t.Fatalf("unexpected extra request: %s", req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "list", "--bundle-id", "com.example.app", "--include", "certificates,devices"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+		Included []struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		} `json:"included"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if len(result.Data) != 2 || result.Data[0].ID != "p1" || result.Data[1].ID != "p3" {
+		t.Fatalf("unexpected profiles: %+v", result.Data)
+	}
+	if len(result.Included) != 5 {
+		t.Fatalf("expected included resources from both pages without duplicates, got %+v", result.Included)
+	}
+}
+
+func TestProfilesListTableShowsIncludedCertificatesAndDevices(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":[{"type":"profiles","id":"p1","attributes":{"name":"App Dev","profileType":"IOS_APP_DEVELOPMENT"},"relationships":{"certificates":{"data":[{"type":"certificates","id":"c1"}]},"devices":{"data":[{"type":"devices","id":"d1"}]}}}],` +
+			`"included":[{"type":"certificates","id":"c1","attributes":{"name":"Apple Development","serialNumber":"SERIAL1"}},{"type":"devices","id":"d1","attributes":{"name":"QA iPhone"}}]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "list", "--include", "certificates,devices", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	for _, want := range []string{"Certificates", "Devices", "Apple Development (SERIAL1)", "QA iPhone"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %q in table output, got %q", want, stdout)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProfilesRepairRequiresConfirmOrDryRun(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "repair"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected ErrHelp, got %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "--confirm is required") {
+		t.Fatalf("expected confirm error, got %q", stderr)
+	}
+}
+
+type profileRepairOutput struct {
+	DryRun        bool `json:"dryRun"`
+	SelectedCount int  `json:"selectedCount"`
+	RepairedCount int  `json:"repairedCount"`
+	Profiles      []struct {
+		ID                   string   `json:"id"`
+		Reason               string   `json:"reason"`
+		BundleID             string   `json:"bundleId"`
+		CertificateIDs       []string `json:"certificateIds"`
+		ReplacedCertificates bool     `json:"replacedCertificates"`
+		DeviceIDs            []string `json:"deviceIds"`
+		NewProfileID         string   `json:"newProfileId"`
+		OutputPath           string   `json:"outputPath"`
+	} `json:"profiles"`
+}
+
+func TestProfilesRepairRegeneratesInvalidAndExpiredProfiles(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var (
+		mutations []string
+		created   []map[string]any
+	)
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles":
+			body = `{"data":[` +
+				`{"type":"profiles","id":"prof-ok","attributes":{"name":"Healthy","profileType":"IOS_APP_STORE","profileState":"ACTIVE","expirationDate":"2999-01-01T00:00:00.000+00:00"}},` +
+				`{"type":"profiles","id":"prof-invalid","attributes":{"name":"App Store","profileType":"IOS_APP_STORE","profileState":"INVALID","expirationDate":"2999-01-01T00:00:00.000+00:00"}},` +
+				`{"type":"profiles","id":"prof-expired","attributes":{"name":"Dev Profile","profileType":"IOS_APP_DEVELOPMENT","profileState":"ACTIVE","expirationDate":"2020-01-01T00:00:00.000+00:00"}}]}`
+		case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/bundleId"):
+			body = `{"data":{"type":"bundleIds","id":"bundle-1","attributes":{"identifier":"com.example.app"}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-invalid/certificates":
+			body = `{"data":[{"type":"certificates","id":"cert-revoked","attributes":{"certificateType":"IOS_DISTRIBUTION","expirationDate":"2020-01-01T00:00:00.000+00:00"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-expired/certificates":
+			body = `{"data":[{"type":"certificates","id":"cert-dev","attributes":{"certificateType":"IOS_DEVELOPMENT","expirationDate":"2999-01-01T00:00:00.000+00:00"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/certificates":
+			if got := req.URL.Query().Get("filter[certificateType]"); got != "IOS_DISTRIBUTION" {
+				t.Fatalf("expected IOS_DISTRIBUTION filter, got %q", got)
+			}
+			body = `{"data":[` +
+				`{"type":"certificates","id":"cert-older","attributes":{"certificateType":"IOS_DISTRIBUTION","expirationDate":"2998-01-01T00:00:00.000+00:00"}},` +
+				`{"type":"certificates","id":"cert-newest","attributes":{"certificateType":"IOS_DISTRIBUTION","expirationDate":"2999-01-01T00:00:00.000+00:00"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-invalid/relationships/devices":
+			body = `{"data":[]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-expired/relationships/devices":
+			body = `{"data":[{"type":"devices","id":"device-1"},{"type":"devices","id":"device-2"}]}`
+		case req.Method == http.MethodDelete:
+			mutations = append(mutations, "delete "+strings.TrimPrefix(req.URL.Path, "/v1/profiles/"))
+			status = http.StatusNoContent
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/profiles":
+			var payload map[string]any
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode create body: %v", err)
+			}
+			created = append(created, payload)
+			id := "new-" + string(rune('0'+len(created)))
+			mutations = append(mutations, "create "+id)
+			status = http.StatusCreated
+			body = `{"data":{"type":"profiles","id":"` + id + `","attributes":{"profileContent":"` + base64.StdEncoding.EncodeToString([]byte("profile-"+id)) + `"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	run := func(args ...string) profileRepairOutput {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(append([]string{"profiles", "repair"}, args...)); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		var result profileRepairOutput
+		if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+			t.Fatalf("parse output: %v (%q)", err, stdout)
+		}
+		return result
+	}
+
+	preview := run("--dry-run")
+	if len(mutations) != 0 {
+		t.Fatalf("dry run must not mutate, got %v", mutations)
+	}
+	if !preview.DryRun || preview.SelectedCount != 2 || preview.RepairedCount != 0 || len(preview.Profiles) != 2 {
+		t.Fatalf("unexpected preview: %+v", preview)
+	}
+	invalid := preview.Profiles[0]
+	if invalid.ID != "prof-invalid" || invalid.Reason != "invalid" || !invalid.ReplacedCertificates || strings.Join(invalid.CertificateIDs, ",") != "cert-newest" {
+		t.Fatalf("unexpected invalid profile plan: %+v", invalid)
+	}
+	expired := preview.Profiles[1]
+	if expired.ID != "prof-expired" || expired.Reason != "expired" || expired.ReplacedCertificates || strings.Join(expired.DeviceIDs, ",") != "device-1,device-2" {
+		t.Fatalf("unexpected expired profile plan: %+v", expired)
+	}
+
+	dir := filepath.Join(t.TempDir(), "profiles")
+	result := run("--confirm", "--output-dir", dir)
+	if strings.Join(mutations, ",") != "delete prof-invalid,create new-1,delete prof-expired,create new-2" {
+		t.Fatalf("unexpected mutations: %v", mutations)
+	}
+	if result.RepairedCount != 2 || result.Profiles[0].NewProfileID != "new-1" || result.Profiles[1].NewProfileID != "new-2" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	attributes := created[1]["data"].(map[string]any)["attributes"].(map[string]any)
+	if attributes["name"] != "Dev Profile" || attributes["profileType"] != "IOS_APP_DEVELOPMENT" {
+		t.Fatalf("unexpected create attributes: %v", attributes)
+	}
+
+	wantPath := filepath.Join(dir, "Dev_Profile.mobileprovision")
+	if result.Profiles[1].OutputPath != wantPath {
+		t.Fatalf("expected output path %q, got %q", wantPath, result.Profiles[1].OutputPath)
+	}
+	written, err := os.ReadFile(wantPath)
+	if err != nil {
+		t.Fatalf("read profile: %v", err)
+	}
+	if string(written) != "profile-new-2" {
+		t.Fatalf("unexpected profile content %q", written)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"flag"
 	"io"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -99,6 +100,26 @@ func TestPromotedPurchasesValidationErrors(t *testing.T) {
 			args:    []string{"promoted-purchases", "link", "--app", "APP_ID", "--clear", "--confirm", "--promoted-purchase-id", "PROMO_ID"},
 			wantErr: "--clear cannot be used with --promoted-purchase-id",
 		},
+		{
+			name:    "promoted-purchases reorder missing app",
+			args:    []string{"promoted-purchases", "reorder", "--promoted-purchase-id", "PROMO_ID"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "promoted-purchases reorder missing promoted purchase id",
+			args:    []string{"promoted-purchases", "reorder", "--app", "APP_ID"},
+			wantErr: "--promoted-purchase-id is required",
+		},
+		{
+			name:    "promoted-purchases image upload missing id",
+			args:    []string{"promoted-purchases", "image", "upload", "--file", "./promo.png"},
+			wantErr: "--promoted-purchase-id is required",
+		},
+		{
+			name:    "promoted-purchases image upload missing file",
+			args:    []string{"promoted-purchases", "image", "upload", "--promoted-purchase-id", "PROMO_ID"},
+			wantErr: "--file is required",
+		},
 	}
 
 	for _, test := range tests {
@@ -125,3 +146,55 @@ func TestPromotedPurchasesValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestPromotedPurchasesReorderMovesListedFirst(t *testing.T) {
+	requests := runRecordedCommand(t, []string{
+		"promoted-purchases", "reorder", "--app", "APP_ID", "--promoted-purchase-id", "PROMO_3,PROMO_2",
+	}, func(req *http.Request) (int, string) {
+		if req.Method == http.MethodGet {
+			return http.StatusOK, `{"data":[{"type":"promotedPurchases","id":"PROMO_1"},{"type":"promotedPurchases","id":"PROMO_2"},{"type":"promotedPurchases","id":"PROMO_3"}],"links":{}}`
+		}
+		return http.StatusNoContent, ""
+	})
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d: %v", len(requests), requests)
+	}
+	want := `PATCH /v1/apps/APP_ID/relationships/promotedPurchases {"data":[{"type":"promotedPurchases","id":"PROMO_3"},{"type":"promotedPurchases","id":"PROMO_2"},{"type":"promotedPurchases","id":"PROMO_1"}]}`
+	if strings.TrimSpace(requests[1]) != want {
+		t.Fatalf("unexpected reorder request:\n got %s\nwant %s", requests[1], want)
+	}
+}
+
+func TestPromotedPurchasesReorderRejectsUnlinkedID(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data":[{"type":"promotedPurchases","id":"PROMO_1"}],"links":{}}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"promoted-purchases", "reorder", "--app", "APP_ID", "--promoted-purchase-id", "PROMO_9"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "PROMO_9 is not linked") {
+		t.Fatalf("expected not linked error, got %v", runErr)
+	}
+}
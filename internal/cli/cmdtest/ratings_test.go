@@ -0,0 +1,117 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRatingsGetValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"ratings", "get"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "invalid workers",
+			args:    []string{"ratings", "get", "--app", "123", "--workers", "0"},
+			wantErr: "--workers must be at least 1",
+		},
+		{
+			name:    "unknown territory",
+			args:    []string{"ratings", "get", "--app", "123", "--territory", "us,zz"},
+			wantErr: `--territory "zz" is not a supported App Store country code`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestRatingsGetAggregatesRequestedTerritories(t *testing.T) {
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var mu sync.Mutex
+	lookups := make(map[string]bool)
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `<html></html>`
+		if req.URL.Host != "itunes.apple.com" {
+			t.Fatalf("unexpected request: %s", req.URL.String())
+		}
+		if req.URL.Path == "/lookup" {
+			country := req.URL.Query().Get("country")
+			mu.Lock()
+			lookups[country] = true
+			mu.Unlock()
+			count := map[string]string{"us": "30", "gb": "10"}[country]
+			body = `{"resultCount":1,"results":[{"trackId":123,"trackName":"Test App","averageUserRating":4.5,"userRatingCount":` + count + `}]}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"ratings", "get", "--app", "123", "--territory", "US,gb"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		TotalCount   int64 `json:"totalCount"`
+		CountryCount int   `json:"countryCount"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.TotalCount != 40 || result.CountryCount != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if len(lookups) != 2 || !lookups["us"] || !lookups["gb"] {
+		t.Fatalf("expected lookups for us and gb only, got %v", lookups)
+	}
+}
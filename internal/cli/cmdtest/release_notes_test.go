@@ -0,0 +1,189 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReleaseNotesGenerateValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "missing version id", args: []string{"release-notes", "generate"}, wantErr: "--version-id is required"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func releaseNotesTransport(t *testing.T, withPhased bool) roundTripFunc {
+	t.Helper()
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch req.URL.Path {
+		case "/v1/appStoreVersions/v-1":
+			if req.URL.Query().Get("include") != "app" {
+				t.Fatalf("expected include=app, got %q", req.URL.RawQuery)
+			}
+			body = `{"data":{"type":"appStoreVersions","id":"v-1","attributes":{"platform":"IOS","versionString":"2.1.0","appVersionState":"READY_FOR_DISTRIBUTION"}},` +
+				`"included":[{"type":"apps","id":"123","attributes":{"name":"My App","bundleId":"com.example.app"}}]}`
+		case "/v1/appStoreVersions/v-1/build":
+			body = `{"data":{"type":"builds","id":"b-1","attributes":{"version":"42","uploadedDate":"2026-01-02T03:04:05Z","processingState":"VALID","minOsVersion":"17.0"}}}`
+		case "/v1/appStoreVersions/v-1/appStoreVersionPhasedRelease":
+			if withPhased {
+				body = `{"data":{"type":"appStoreVersionPhasedReleases","id":"pr-1","attributes":{"phasedReleaseState":"ACTIVE","startDate":"2026-01-05","currentDayNumber":3}}}`
+			} else {
+				status = http.StatusNotFound
+				body = `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found","detail":"none"}]}`
+			}
+		case "/v1/appStoreVersions/v-1/appStoreVersionLocalizations":
+			body = `{"data":[` +
+				`{"type":"appStoreVersionLocalizations","id":"l-de","attributes":{"locale":"de-DE","whatsNew":"Fehlerbehebungen"}},` +
+				`{"type":"appStoreVersionLocalizations","id":"l-en","attributes":{"locale":"en-US","whatsNew":"Bug fixes and improvements."}},` +
+				`{"type":"appStoreVersionLocalizations","id":"l-fr","attributes":{"locale":"fr-FR"}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func runReleaseNotes(t *testing.T, args ...string) string {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	return stdout
+}
+
+func TestReleaseNotesGenerateMarkdown(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = releaseNotesTransport(t, true)
+
+	stdout := runReleaseNotes(t, "release-notes", "generate", "--version-id", "v-1", "--output", "md")
+
+	for _, want := range []string{
+		"# My App 2.1.0 (IOS)\n",
+		"- **Bundle ID:** com.example.app\n",
+		"- **State:** READY_FOR_DISTRIBUTION\n",
+		"- **Build:** `42` (uploaded 2026-01-02T03:04:05Z)\n",
+		"- **Minimum OS:** 17.0\n",
+		"- **Phased release:** ACTIVE (day 3 of 7), started 2026-01-05\n",
+		"### de-DE\n\nFehlerbehebungen\n",
+		"### en-US\n\nBug fixes and improvements.\n",
+	} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected output to contain %q, got:\n%s", want, stdout)
+		}
+	}
+	if strings.Contains(stdout, "fr-FR") {
+		t.Fatalf("expected locales without What's New to be skipped, got:\n%s", stdout)
+	}
+	if strings.Index(stdout, "de-DE") > strings.Index(stdout, "en-US") {
+		t.Fatalf("expected locales sorted, got:\n%s", stdout)
+	}
+}
+
+func TestReleaseNotesGenerateDefaultsToJSONWithoutPhasedRelease(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = releaseNotesTransport(t, false)
+
+	stdout := runReleaseNotes(t, "release-notes", "generate", "--version-id", "v-1", "--locale", "en-US")
+
+	var notes struct {
+		AppName       string                   `json:"appName"`
+		Build         *struct{ Number string } `json:"build"`
+		PhasedRelease json.RawMessage          `json:"phasedRelease"`
+		Localizations []struct {
+			Locale string `json:"locale"`
+		} `json:"localizations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &notes); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if notes.AppName != "My App" || notes.Build == nil || notes.Build.Number != "42" {
+		t.Fatalf("unexpected notes: %+v", notes)
+	}
+	if notes.PhasedRelease != nil {
+		t.Fatalf("expected no phased release, got %s", notes.PhasedRelease)
+	}
+	if len(notes.Localizations) != 1 || notes.Localizations[0].Locale != "en-US" {
+		t.Fatalf("expected only en-US, got %+v", notes.Localizations)
+	}
+}
+
+func TestReleaseNotesGenerateTable(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = releaseNotesTransport(t, true)
+
+	stdout := runReleaseNotes(t, "release-notes", "generate", "--version-id", "v-1", "--output", "table")
+
+	for _, want := range []string{"My App", "com.example.app", "2.1.0", "ACTIVE (day 3)", "en-US", "Bug fixes and improvements."} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected table to contain %q, got:\n%s", want, stdout)
+		}
+	}
+	if strings.Contains(stdout, "# My App") {
+		t.Fatalf("expected a table rather than the Markdown document, got:\n%s", stdout)
+	}
+}
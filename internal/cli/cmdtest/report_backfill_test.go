@@ -0,0 +1,165 @@
+package cmdtest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func gzipReport(t *testing.T, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write([]byte(content)); err != nil {
+		t.Fatalf("write gzip: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("close gzip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+type reportBackfillOutput struct {
+	OutputDir  string `json:"outputDir"`
+	Downloaded int    `json:"downloaded"`
+	Skipped    int    `json:"skipped"`
+	Pending    int    `json:"pending"`
+	Failed     int    `json:"failed"`
+	Items      []struct {
+		ReportDate string `json:"reportDate"`
+		Status     string `json:"status"`
+	} `json:"items"`
+}
+
+func TestAnalyticsSalesBackfillSkipsExistingAndLeavesUnavailablePending(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	root := t.TempDir()
+	seriesDir := filepath.Join(root, "sales", "12345678", "SALES_SUMMARY_DAILY")
+	if err := os.MkdirAll(seriesDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(seriesDir, "2024-01-01.tsv.gz"), gzipReport(t, "old"), 0o600); err != nil {
+		t.Fatalf("write existing report: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requested []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/salesReports" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		date := req.URL.Query().Get("filter[reportDate]")
+		requested = append(requested, date)
+		if date == "2024-01-03" {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"Report not available yet"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(gzipReport(t, "sales "+date))),
+			Header:     http.Header{"Content-Type": []string{"application/a-gzip"}},
+		}, nil
+	})
+
+	cmd := RootCommand("1.2.3")
+	cmd.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Parse([]string{
+			"analytics", "sales", "--vendor", "12345678", "--type", "SALES", "--subtype", "SUMMARY", "--frequency", "DAILY",
+			"--from", "2024-01-01", "--to", "2024-01-03", "--output-dir", root, "--retries", "0", "--decompress",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := cmd.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result reportBackfillOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.OutputDir != seriesDir || result.Downloaded != 1 || result.Skipped != 1 || result.Pending != 1 || result.Failed != 0 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if strings.Join(requested, ",") != "2024-01-02,2024-01-03" {
+		t.Fatalf("expected only missing dates to be requested, got %v", requested)
+	}
+
+	data, err := os.ReadFile(filepath.Join(seriesDir, "2024-01-02.tsv"))
+	if err != nil || string(data) != "sales 2024-01-02" {
+		t.Fatalf("expected decompressed report, got %q (%v)", data, err)
+	}
+	if data, err := os.ReadFile(filepath.Join(seriesDir, "2024-01-01.tsv")); err != nil || string(data) != "old" {
+		t.Fatalf("expected existing report to be decompressed in place, got %q (%v)", data, err)
+	}
+}
+
+func TestFinanceReportsBackfillDownloadsEachMonth(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/financeReports" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if got := req.URL.Query().Get("filter[regionCode]"); got != "ZZ" {
+			t.Fatalf("expected region ZZ, got %q", got)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(gzipReport(t, req.URL.Query().Get("filter[reportDate]")))),
+			Header:     http.Header{"Content-Type": []string{"application/a-gzip"}},
+		}, nil
+	})
+
+	root := t.TempDir()
+	cmd := RootCommand("1.2.3")
+	cmd.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Parse([]string{
+			"finance", "reports", "--vendor", "12345678", "--report-type", "FINANCIAL", "--region", "ZZ",
+			"--from", "2024-11", "--to", "2025-01", "--output-dir", root,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := cmd.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result reportBackfillOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.Downloaded != 3 || len(result.Items) != 3 || result.Items[2].ReportDate != "2025-01" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	for _, month := range []string{"2024-11", "2024-12", "2025-01"} {
+		path := filepath.Join(root, "finance", "12345678", "FINANCIAL_ZZ", month+".tsv.gz")
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("expected report at %s: %v", path, err)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package cmdtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type reportExportOutput struct {
+	SQLitePath   string `json:"sqlitePath"`
+	ParquetPath  string `json:"parquetPath"`
+	ExportedRows int    `json:"exportedRows"`
+}
+
+func assertFilePrefix(t *testing.T, path, prefix string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v", path, err)
+	}
+	if !bytes.HasPrefix(data, []byte(prefix)) {
+		t.Fatalf("expected %s to start with %q", path, prefix)
+	}
+	return data
+}
+
+func TestAnalyticsSalesBackfillExportsEveryReportOnDisk(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	header := "Provider\tSKU\tUnits\tDeveloper Proceeds\tBegin Date\n"
+	root := t.TempDir()
+	seriesDir := filepath.Join(root, "sales", "12345678", "SALES_SUMMARY_DAILY")
+	if err := os.MkdirAll(seriesDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	existing := header + "APPLE\t0042\t2\t0.70\t01/01/2024\n"
+	if err := os.WriteFile(filepath.Join(seriesDir, "2024-01-01.tsv.gz"), gzipReport(t, existing), 0o600); err != nil {
+		t.Fatalf("write existing report: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/salesReports" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		report := header + "APPLE\t0042\t1\t0.70\t01/02/2024\nAPPLE\t0043\t3\t1.40\t01/02/2024\n"
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(gzipReport(t, report))),
+			Header:     http.Header{"Content-Type": []string{"application/a-gzip"}},
+		}, nil
+	})
+
+	exportDir := t.TempDir()
+	sqlitePath := filepath.Join(exportDir, "sales.db")
+	parquetPath := filepath.Join(exportDir, "sales.parquet")
+	cmd := RootCommand("1.2.3")
+	cmd.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Parse([]string{
+			"analytics", "sales", "--vendor", "12345678", "--type", "SALES", "--subtype", "SUMMARY", "--frequency", "DAILY",
+			"--from", "2024-01-01", "--to", "2024-01-02", "--output-dir", root,
+			"--to-sqlite", sqlitePath, "--to-parquet", parquetPath,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := cmd.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result reportExportOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.SQLitePath != sqlitePath || result.ParquetPath != parquetPath || result.ExportedRows != 3 {
+		t.Fatalf("unexpected export result: %+v", result)
+	}
+
+	db := assertFilePrefix(t, sqlitePath, "SQLite format 3\x00")
+	if !bytes.Contains(db, []byte(`CREATE TABLE "sales" ("report_date" TEXT, "provider" TEXT, "sku" TEXT, "units" INTEGER, "developer_proceeds" REAL, "begin_date" TEXT)`)) {
+		t.Fatalf("expected typed sales table in SQLite schema")
+	}
+	parquet := assertFilePrefix(t, parquetPath, "PAR1")
+	if !bytes.HasSuffix(parquet, []byte("PAR1")) {
+		t.Fatalf("expected Parquet footer magic")
+	}
+}
+
+func TestFinanceReportsExportSkipsTotals(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		report := "Start Date\tEnd Date\tQuantity\tPartner Share\n" +
+			"12/01/2024\t12/28/2024\t4\t0.70\n" +
+			"12/01/2024\t12/28/2024\t1\t1.40\n" +
+			"\n" +
+			"Total_Rows\t2\n"
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(gzipReport(t, report))),
+			Header:     http.Header{"Content-Type": []string{"application/a-gzip"}},
+		}, nil
+	})
+
+	dir := t.TempDir()
+	sqlitePath := filepath.Join(dir, "finance.db")
+	cmd := RootCommand("1.2.3")
+	cmd.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Parse([]string{
+			"finance", "reports", "--vendor", "12345678", "--report-type", "FINANCIAL", "--region", "ZZ",
+			"--date", "2024-12", "--output", filepath.Join(dir, "finance.tsv.gz"), "--to-sqlite", sqlitePath,
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := cmd.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result reportExportOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.SQLitePath != sqlitePath || result.ExportedRows != 2 {
+		t.Fatalf("unexpected export result: %+v", result)
+	}
+	db := assertFilePrefix(t, sqlitePath, "SQLite format 3\x00")
+	if !bytes.Contains(db, []byte(`"quantity" INTEGER, "partner_share" REAL`)) {
+		t.Fatalf("expected typed finance columns in SQLite schema")
+	}
+}
+
+func TestReportsSummarizeExportsGroups(t *testing.T) {
+	dir := t.TempDir()
+	report := "Provider\tSKU\tUnits\tDeveloper Proceeds\tCountry Code\tCurrency of Proceeds\n" +
+		"APPLE\tpro\t2\t1.00\tUS\tUSD\n"
+	if err := os.WriteFile(filepath.Join(dir, "2024-01-01.tsv"), []byte(report), 0o600); err != nil {
+		t.Fatalf("write report: %v", err)
+	}
+
+	sqlitePath := filepath.Join(dir, "out", "summary.db")
+	parquetPath := filepath.Join(dir, "out", "summary.parquet")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reports", "summarize", "--dir", dir, "--to-sqlite", sqlitePath, "--to-parquet", parquetPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"sqlitePath"`) || !strings.Contains(stdout, `"parquetPath"`) {
+		t.Fatalf("expected export paths in output, got %q", stdout)
+	}
+
+	db := assertFilePrefix(t, sqlitePath, "SQLite format 3\x00")
+	if !bytes.Contains(db, []byte(`CREATE TABLE "sales_summary" ("country_code" TEXT, "currency" TEXT, "units" INTEGER, "proceeds" REAL)`)) {
+		t.Fatalf("expected sales_summary table in SQLite schema")
+	}
+	assertFilePrefix(t, parquetPath, "PAR1")
+}
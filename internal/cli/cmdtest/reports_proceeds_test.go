@@ -0,0 +1,128 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReportsProceedsConvertsFinanceReportsPerMonth(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name string, data []byte) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	header := "Start Date\tEnd Date\tQuantity\tExtended Partner Share\tPartner Share Currency\tApple Identifier\tTitle\tCountry Of Sale\n"
+	rates := "\nCountry Of Sale\tPartner Share Currency\tExchange Rate\tBank Account Currency\n"
+	// Each month is converted with its own EUR rate.
+	writeFile("finance/2024-01.tsv.gz", gzipReport(t, header+
+		"01/01/2024\t01/31/2024\t10\t70.00\tUSD\t111\tPro\tUS\n"+
+		"01/01/2024\t01/31/2024\t5\t20.00\tEUR\t111\tPro\tDE\n"+
+		rates+"DE\tEUR\t1.10\tUSD\n"))
+	writeFile("finance/2024-02.tsv", []byte(header+
+		"02/01/2024\t02/29/2024\t5\t20.00\tEUR\t222\tLite\tDE\n"+
+		rates+"DE\tEUR\t1.05\tUSD\n"))
+	writeFile("sales/2024-01-01.tsv", []byte("Provider\tSKU\tUnits\n"))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"reports", "proceeds", "--dir", dir, "--currency", "usd"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var result struct {
+		Currency     string   `json:"currency"`
+		Files        int      `json:"files"`
+		SkippedFiles []string `json:"skippedFiles"`
+		Records      int      `json:"records"`
+		Total        float64  `json:"total"`
+		Apps         []struct {
+			ID       string  `json:"id"`
+			Name     string  `json:"name"`
+			Proceeds float64 `json:"proceeds"`
+		} `json:"apps"`
+		Regions []struct {
+			ID       string  `json:"id"`
+			Proceeds float64 `json:"proceeds"`
+		} `json:"regions"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.Currency != "USD" || result.Files != 2 || result.Records != 3 || len(result.SkippedFiles) != 1 {
+		t.Fatalf("unexpected file counts: %+v", result)
+	}
+	if result.Total != 113 {
+		t.Fatalf("expected total 113, got %v", result.Total)
+	}
+	if len(result.Apps) != 2 || result.Apps[0].ID != "111" || result.Apps[0].Name != "Pro" || result.Apps[0].Proceeds != 92 || result.Apps[1].Proceeds != 21 {
+		t.Fatalf("unexpected apps: %+v", result.Apps)
+	}
+	if len(result.Regions) != 2 || result.Regions[0].ID != "US" || result.Regions[0].Proceeds != 70 || result.Regions[1].Proceeds != 43 {
+		t.Fatalf("unexpected regions: %+v", result.Regions)
+	}
+}
+
+func TestReportsProceedsReportsMissingExchangeRate(t *testing.T) {
+	dir := t.TempDir()
+	report := "Extended Partner Share\tPartner Share Currency\tApple Identifier\tTitle\tCountry Of Sale\n" +
+		"10.00\tJPY\t111\tPro\tJP\n"
+	if err := os.WriteFile(filepath.Join(dir, "2024-01.tsv"), []byte(report), 0o600); err != nil {
+		t.Fatalf("write report: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reports", "proceeds", "--dir", dir, "--currency", "USD"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "--rate JPY=RATE") {
+		t.Fatalf("expected missing rate error, got %v", runErr)
+	}
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+}
+
+func TestReportsProceedsRequiresCurrency(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"reports", "proceeds", "--dir", t.TempDir()}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "--currency is required") {
+		t.Fatalf("expected currency error, got %q", stderr)
+	}
+}
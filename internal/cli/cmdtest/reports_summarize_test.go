@@ -0,0 +1,99 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReportsSummarizeGroupsDownloadedSalesReports(t *testing.T) {
+	dir := t.TempDir()
+	header := "Provider\tSKU\tTitle\tUnits\tDeveloper Proceeds\tCountry Code\tCurrency of Proceeds\n"
+	writeFile := func(name string, data []byte) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	day1 := header + "APPLE\tpro\tPro\t2\t1.00\tUS\tUSD\nAPPLE\tpro\tPro\t1\t0.50\tGB\tGBP\n"
+	// The decompressed copy of day 1 must win over its .gz.
+	writeFile("sales/2024-01-01.tsv.gz", gzipReport(t, day1))
+	writeFile("sales/2024-01-01.tsv", []byte(day1))
+	writeFile("sales/2024-01-02.tsv.gz", gzipReport(t, header+"APPLE\tpro\tPro\t3\t1.00\tUS\tUSD\n"))
+	writeFile("finance/2024-01.tsv", []byte("Start Date\tEnd Date\n01/01/2024\t01/31/2024\n"))
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"reports", "summarize", "--dir", dir, "--group-by", "country,sku"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var result struct {
+		Files        int      `json:"files"`
+		SkippedFiles []string `json:"skippedFiles"`
+		Records      int      `json:"records"`
+		Groups       []struct {
+			Group    map[string]string `json:"group"`
+			Currency string            `json:"currency"`
+			Units    int64             `json:"units"`
+			Proceeds float64           `json:"proceeds"`
+		} `json:"groups"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.Files != 2 || result.Records != 3 || len(result.SkippedFiles) != 1 {
+		t.Fatalf("unexpected file counts: %+v", result)
+	}
+	if len(result.Groups) != 2 {
+		t.Fatalf("expected 2 groups, got %+v", result.Groups)
+	}
+	gb, us := result.Groups[0], result.Groups[1]
+	if gb.Group["country"] != "GB" || gb.Currency != "GBP" || gb.Units != 1 || gb.Proceeds != 0.5 {
+		t.Fatalf("unexpected GB group: %+v", gb)
+	}
+	if us.Group["country"] != "US" || us.Group["sku"] != "pro" || us.Units != 5 || us.Proceeds != 5 {
+		t.Fatalf("unexpected US group: %+v", us)
+	}
+}
+
+func TestReportsSummarizeRejectsUnknownGroup(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"reports", "summarize", "--dir", t.TempDir(), "--group-by", "planet"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if !errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected ErrHelp, got %v", runErr)
+	}
+	if stdout != "" {
+		t.Fatalf("expected empty stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "--group-by must be one of") {
+		t.Fatalf("expected group-by error, got %q", stderr)
+	}
+}
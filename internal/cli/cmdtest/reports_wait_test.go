@@ -0,0 +1,119 @@
+package cmdtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type reportWaitOutput struct {
+	ReportDate string `json:"reportDate"`
+	Available  bool   `json:"available"`
+	Attempts   int    `json:"attempts"`
+	FilePath   string `json:"filePath"`
+}
+
+func reportNotAvailableResponse() *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(`{"errors":[{"status":"404","code":"NOT_FOUND","title":"Report not available yet"}]}`)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func TestReportsWaitPollsUntilPublishedThenDownloads(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	requests := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/salesReports" || req.URL.Query().Get("filter[reportDate]") != "2024-01-20" {
+			t.Fatalf("unexpected request: %s", req.URL.String())
+		}
+		requests++
+		if requests < 3 {
+			return reportNotAvailableResponse(), nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(gzipReport(t, "published"))),
+			Header:     http.Header{"Content-Type": []string{"application/a-gzip"}},
+		}, nil
+	})
+
+	path := filepath.Join(t.TempDir(), "report.tsv.gz")
+	cmd := RootCommand("1.2.3")
+	cmd.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Parse([]string{
+			"reports", "wait", "--vendor", "12345678", "--date", "2024-01-20",
+			"--poll-interval", "1ms", "--file", path, "--decompress",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := cmd.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result reportWaitOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if !result.Available || result.Attempts != 3 || result.FilePath != path {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	data, err := os.ReadFile(strings.TrimSuffix(path, ".gz"))
+	if err != nil || string(data) != "published" {
+		t.Fatalf("expected decompressed report, got %q (%v)", data, err)
+	}
+}
+
+func TestReportsWaitTimesOut(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return reportNotAvailableResponse(), nil
+	})
+
+	cmd := RootCommand("1.2.3")
+	cmd.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := cmd.Parse([]string{
+			"reports", "wait", "--vendor", "12345678", "--date", "2024-01-20",
+			"--poll-interval", "10ms", "--timeout", "50ms",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = cmd.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "timed out waiting for report") {
+		t.Fatalf("expected timeout error, got %v", runErr)
+	}
+	var result reportWaitOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.Available || result.Attempts == 0 || result.FilePath != "" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
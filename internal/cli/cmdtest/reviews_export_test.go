@@ -0,0 +1,147 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReviewsExportCSVWithinWindow(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	requests := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		body := ""
+		switch requests {
+		case 1:
+			if req.URL.Path != "/v1/apps/app-1/customerReviews" {
+				t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			}
+			query := req.URL.Query()
+			if query.Get("include") != "response" || query.Get("sort") != "-createdDate" || query.Get("limit") != "200" {
+				t.Fatalf("unexpected query: %s", req.URL.RawQuery)
+			}
+			body = `{"data":[` +
+				`{"type":"customerReviews","id":"r-feb","attributes":{"rating":5,"title":"Later","body":"Too new","createdDate":"2025-02-01T00:00:00Z","territory":"USA"}},` +
+				`{"type":"customerReviews","id":"r-1","attributes":{"rating":2,"title":"Crashes","body":"Crashes on launch, twice","reviewerNickname":"sam","createdDate":"2025-01-20T10:00:00Z","territory":"USA"},"relationships":{"response":{"data":{"type":"customerReviewResponses","id":"resp-1"}}}}],` +
+				`"included":[{"type":"customerReviewResponses","id":"resp-1","attributes":{"responseBody":"Fixed in 2.1","state":"PUBLISHED","lastModifiedDate":"2025-01-21T09:00:00Z"}}],` +
+				`"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps/app-1/customerReviews?cursor=2"}}`
+		case 2:
+			if req.URL.Query().Get("cursor") != "2" {
+				t.Fatalf("expected next page request, got %s", req.URL.String())
+			}
+			body = `{"data":[` +
+				`{"type":"customerReviews","id":"r-2","attributes":{"rating":4,"title":"Nice","body":"Works well","createdDate":"2025-01-01T00:00:00Z","territory":"GBR"}},` +
+				`{"type":"customerReviews","id":"r-dec","attributes":{"rating":1,"createdDate":"2024-12-31T23:59:59Z","territory":"USA"}}],` +
+				`"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps/app-1/customerReviews?cursor=3"}}`
+		default:
+			t.Fatalf("expected export to stop before the window, got %s", req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "export", "--app", "app-1", "--since", "2025-01-01", "--until", "2025-02-01", "--output", "csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	records, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv: %v (%q)", err, stdout)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected header and 2 reviews, got %v", records)
+	}
+	if records[0][0] != "ID" || records[0][8] != "Response" {
+		t.Fatalf("unexpected header: %v", records[0])
+	}
+	if records[1][0] != "r-1" || records[1][6] != "Crashes on launch, twice" || records[1][7] != "resp-1" || records[1][8] != "Fixed in 2.1" || records[1][9] != "PUBLISHED" {
+		t.Fatalf("unexpected first review: %v", records[1])
+	}
+	if records[2][0] != "r-2" || records[2][7] != "" {
+		t.Fatalf("unexpected second review: %v", records[2])
+	}
+}
+
+func TestReviewsExportValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"reviews", "export", "--since", "2025-01-01"},
+			wantErr: "Error: --app is required",
+		},
+		{
+			name:    "invalid since",
+			args:    []string{"reviews", "export", "--app", "app-1", "--since", "01/01/2025"},
+			wantErr: "Error: --since must be in YYYY-MM-DD format",
+		},
+		{
+			name:    "invalid until",
+			args:    []string{"reviews", "export", "--app", "app-1", "--until", "2025-02"},
+			wantErr: "Error: --until must be in YYYY-MM-DD format",
+		},
+		{
+			name:    "since after until",
+			args:    []string{"reviews", "export", "--app", "app-1", "--since", "2025-02-01", "--until", "2025-01-01"},
+			wantErr: "Error: --since must be before --until",
+		},
+		{
+			name:    "invalid stars",
+			args:    []string{"reviews", "export", "--app", "app-1", "--stars", "6"},
+			wantErr: "Error: --stars must be between 1 and 5",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
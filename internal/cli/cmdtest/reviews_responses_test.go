@@ -2,9 +2,13 @@ package cmdtest
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
 	"io"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -25,6 +29,41 @@ func TestReviewsRespondValidationErrors(t *testing.T) {
 			args:    []string{"reviews", "respond", "--review-id", "REVIEW_123"},
 			wantErr: "--response is required",
 		},
+		{
+			name:    "reviews respond review and review-id",
+			args:    []string{"reviews", "respond", "--review-id", "REVIEW_123", "--review", "REVIEW_456", "--body", "Thanks!"},
+			wantErr: "--review-id and --review cannot be used together",
+		},
+		{
+			name:    "reviews respond template with response",
+			args:    []string{"reviews", "respond", "--review-id", "REVIEW_123", "--response", "Thanks!", "--template", "thanks.tmpl"},
+			wantErr: "--template cannot be used with --response or --body",
+		},
+		{
+			name:    "reviews respond unreadable template",
+			args:    []string{"reviews", "respond", "--review-id", "REVIEW_123", "--template", "does-not-exist.tmpl"},
+			wantErr: "--template must be readable",
+		},
+		{
+			name:    "reviews respond-batch missing template",
+			args:    []string{"reviews", "respond-batch", "--app", "app-1", "--dry-run"},
+			wantErr: "--template is required",
+		},
+		{
+			name:    "reviews respond-batch invalid rating",
+			args:    []string{"reviews", "respond-batch", "--app", "app-1", "--template", "thanks.tmpl", "--filter-rating", "6", "--dry-run"},
+			wantErr: "--filter-rating must be between 1 and 5",
+		},
+		{
+			name:    "reviews respond-batch missing confirm",
+			args:    []string{"reviews", "respond-batch", "--app", "app-1", "--template", "thanks.tmpl"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "reviews response update missing response",
+			args:    []string{"reviews", "response", "update", "--review", "REVIEW_123"},
+			wantErr: "--response is required",
+		},
 	}
 
 	for _, test := range tests {
@@ -99,7 +138,12 @@ func TestReviewsResponseDeleteValidationErrors(t *testing.T) {
 		{
 			name:    "reviews response delete missing id",
 			args:    []string{"reviews", "response", "delete", "--confirm"},
-			wantErr: "--id is required",
+			wantErr: "--id or --review-id is required",
+		},
+		{
+			name:    "reviews response delete id and review-id",
+			args:    []string{"reviews", "response", "delete", "--id", "RESPONSE_123", "--review-id", "REVIEW_123", "--confirm"},
+			wantErr: "--id and --review-id are mutually exclusive",
 		},
 		{
 			name:    "reviews response delete missing confirm",
@@ -255,3 +299,235 @@ func TestReviewsResponseParentShowsHelp(t *testing.T) {
 		t.Fatalf("expected help output with subcommands, got %q", stderr)
 	}
 }
+
+func TestReviewsResponseUpdateReplacesExistingResponse(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/customerReviews/review-1/response":
+			body = `{"data":{"type":"customerReviewResponses","id":"response-1","attributes":{"responseBody":"Thanks!","state":"PUBLISHED"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/customerReviewResponses":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"Fixed in 2.1"`) || !strings.Contains(string(payload), `"review-1"`) {
+				t.Fatalf("unexpected body: %s", payload)
+			}
+			status = http.StatusCreated
+			body = `{"data":{"type":"customerReviewResponses","id":"response-2","attributes":{"responseBody":"Fixed in 2.1","state":"PENDING_PUBLISH"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "response", "update", "--review", "review-1", "--body", "Fixed in 2.1", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, "Pending Publication") || !strings.Contains(stdout, "PENDING_PUBLISH") || !strings.Contains(stdout, "true") {
+		t.Fatalf("expected pending response in output, got %q", stdout)
+	}
+}
+
+func TestReviewsResponseDeleteByReviewID(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	deleted := ""
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/customerReviews/review-1/response":
+			body = `{"data":{"type":"customerReviewResponses","id":"response-1","attributes":{"responseBody":"Thanks!","state":"PUBLISHED"}}}`
+		case req.Method == http.MethodDelete:
+			deleted = req.URL.Path
+			status = http.StatusNoContent
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "response", "delete", "--review-id", "review-1", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if deleted != "/v1/customerReviewResponses/response-1" {
+		t.Fatalf("expected response-1 to be deleted, got %q", deleted)
+	}
+}
+
+func writeResponseTemplate(t *testing.T, text string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "thanks.tmpl")
+	if err := os.WriteFile(path, []byte(text), 0o600); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+	return path
+}
+
+func TestReviewsRespondRendersTemplate(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	templatePath := writeResponseTemplate(t, "Thanks {{.ReviewerNickname}} for the {{.Rating}} stars!\n")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/customerReviews/review-1":
+			body = `{"data":{"type":"customerReviews","id":"review-1","attributes":{"rating":5,"reviewerNickname":"sam"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/customerReviewResponses":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"Thanks sam for the 5 stars!"`) {
+				t.Fatalf("unexpected body: %s", payload)
+			}
+			status = http.StatusCreated
+			body = `{"data":{"type":"customerReviewResponses","id":"response-1","attributes":{"responseBody":"Thanks sam for the 5 stars!","state":"PENDING_PUBLISH"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "respond", "--review-id", "review-1", "--template", templatePath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"response-1"`) {
+		t.Fatalf("expected created response in output, got %q", stdout)
+	}
+}
+
+func TestReviewsRespondBatchSkipsAnsweredReviews(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	templatePath := writeResponseTemplate(t, "Thank you, {{.ReviewerNickname}}!")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var posted []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/customerReviews":
+			query := req.URL.Query()
+			if query.Get("filter[rating]") != "5" || query.Get("exists[publishedResponse]") != "false" || query.Get("include") != "response" {
+				t.Fatalf("unexpected query: %s", req.URL.RawQuery)
+			}
+			body = `{"data":[` +
+				`{"type":"customerReviews","id":"review-1","attributes":{"rating":5,"reviewerNickname":"sam","createdDate":"2025-01-02T00:00:00Z"}},` +
+				`{"type":"customerReviews","id":"review-2","attributes":{"rating":5,"reviewerNickname":"alex","createdDate":"2025-01-01T00:00:00Z"},"relationships":{"response":{"data":{"type":"customerReviewResponses","id":"pending-1"}}}}]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/customerReviewResponses":
+			payload, _ := io.ReadAll(req.Body)
+			posted = append(posted, string(payload))
+			status = http.StatusCreated
+			body = `{"data":{"type":"customerReviewResponses","id":"response-1","attributes":{"responseBody":"Thank you, sam!","state":"PENDING_PUBLISH"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	run := func(extra ...string) string {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		args := append([]string{"reviews", "respond-batch", "--app", "app-1", "--filter-rating", "5", "--unanswered-only", "--template", templatePath}, extra...)
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(args); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		return stdout
+	}
+
+	preview := run("--dry-run", "--output", "table")
+	if !strings.Contains(preview, "Thank you, sam!") || !strings.Contains(preview, "would-respond") || strings.Contains(preview, "review-2") {
+		t.Fatalf("unexpected preview: %q", preview)
+	}
+	if len(posted) != 0 {
+		t.Fatalf("expected no responses posted during dry run, got %v", posted)
+	}
+
+	var result struct {
+		Total   int `json:"total"`
+		Reviews []struct {
+			ReviewID   string `json:"reviewId"`
+			ResponseID string `json:"responseId"`
+			Responded  bool   `json:"responded"`
+		} `json:"reviews"`
+	}
+	stdout := run("--confirm")
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.Total != 1 || result.Reviews[0].ReviewID != "review-1" || !result.Reviews[0].Responded || result.Reviews[0].ResponseID != "response-1" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if len(posted) != 1 || !strings.Contains(posted[0], `"Thank you, sam!"`) || !strings.Contains(posted[0], `"review-1"`) {
+		t.Fatalf("unexpected posted responses: %v", posted)
+	}
+}
@@ -0,0 +1,94 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReviewsStatsCSVByTerritoryRatingAndVersion(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	recent := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+	old := time.Now().Add(-60 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	review := func(id string, rating int, territory, created string) string {
+		return `{"type":"customerReviews","id":"` + id + `","attributes":{"rating":` + strconv.Itoa(rating) + `,"territory":"` + territory + `","createdDate":"` + created + `"}}`
+	}
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/customerReviews":
+			if got := req.URL.Query().Get("sort"); got != "-createdDate" {
+				t.Fatalf("expected newest-first sort, got %q", got)
+			}
+			body = `{"data":[` + review("r1", 5, "USA", recent) + `,` + review("r2", 1, "USA", recent) + `,` + review("r3", 3, "GBR", recent) + `,` + review("r-old", 1, "USA", old) + `]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/appStoreVersions":
+			body = `{"data":[{"type":"appStoreVersions","id":"v2","attributes":{"versionString":"2.0","platform":"IOS"}},{"type":"appStoreVersions","id":"v1","attributes":{"versionString":"1.0","platform":"IOS"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/v2/customerReviews":
+			body = `{"data":[` + review("r1", 5, "USA", recent) + `,` + review("r2", 1, "USA", recent) + `]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/v1/customerReviews":
+			body = `{"data":[` + review("r-old", 1, "USA", old) + `]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"reviews", "stats", "--app", "app-1", "--since", "30d", "--output", "csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	records, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv: %v (%q)", err, stdout)
+	}
+	got := make(map[string][]string)
+	for _, record := range records[1:] {
+		got[record[0]+"/"+record[1]] = record
+	}
+	checks := map[string][2]string{
+		"all/all":         {"3", "3.00"},
+		"territory/USA":   {"2", "3.00"},
+		"territory/GBR":   {"1", "3.00"},
+		"rating/1":        {"1", "1.00"},
+		"rating/2":        {"0", "0.00"},
+		"version/2.0":     {"2", "3.00"},
+		"version/unknown": {"1", "3.00"},
+	}
+	for key, want := range checks {
+		record, ok := got[key]
+		if !ok {
+			t.Fatalf("missing row %s in %v", key, records)
+		}
+		if record[3] != want[0] || record[5] != want[1] {
+			t.Fatalf("row %s: expected count %s avg %s, got %v", key, want[0], want[1], record)
+		}
+	}
+	if _, ok := got["version/1.0"]; ok {
+		t.Fatalf("expected no row for version without reviews in window: %v", records)
+	}
+}
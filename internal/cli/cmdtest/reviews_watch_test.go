@@ -0,0 +1,143 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReviewsWatchReportsNewLowRatedReviews(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	polls := 0
+	var posted []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/customerReviews":
+			polls++
+			if got := req.URL.Query().Get("sort"); got != "-createdDate" {
+				t.Fatalf("expected newest-first sort, got %q", got)
+			}
+			existing := `{"type":"customerReviews","id":"r-old","attributes":{"rating":1,"title":"Old","createdDate":"2025-01-01T00:00:00Z","territory":"USA"}}`
+			if polls == 1 {
+				body = `{"data":[` + existing + `]}`
+			} else {
+				body = `{"data":[` +
+					`{"type":"customerReviews","id":"r-happy","attributes":{"rating":5,"title":"Great","createdDate":"2025-01-03T00:00:00Z","territory":"USA"}},` +
+					`{"type":"customerReviews","id":"r-new","attributes":{"rating":1,"title":"Broken","body":"Crashes","createdDate":"2025-01-02T00:00:00Z","territory":"USA"}},` +
+					existing + `]}`
+			}
+		case req.Method == http.MethodPost && req.URL.Host == "hooks.example.com":
+			payload, _ := io.ReadAll(req.Body)
+			posted = append(posted, string(payload))
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"--webhook", "https://hooks.example.com/reviews", "reviews", "watch", "--app", "app-1", "--min-rating-below", "3", "--interval", "1ms", "--max-polls", "2"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if polls != 2 {
+		t.Fatalf("expected 2 polls, got %d", polls)
+	}
+	if !strings.Contains(stderr, "1 existing reviews skipped") {
+		t.Fatalf("expected baseline note, got %q", stderr)
+	}
+
+	var batch struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &batch); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if len(batch.Data) != 1 || batch.Data[0].ID != "r-new" {
+		t.Fatalf("expected only r-new, got %+v", batch.Data)
+	}
+	if len(posted) != 1 || !strings.Contains(posted[0], `"r-new"`) {
+		t.Fatalf("expected one webhook post with r-new, got %v", posted)
+	}
+}
+
+func TestReviewsWatchValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"reviews", "watch"},
+			wantErr: "Error: --app is required",
+		},
+		{
+			name:    "invalid min rating",
+			args:    []string{"reviews", "watch", "--app", "app-1", "--min-rating-below", "1"},
+			wantErr: "Error: --min-rating-below must be between 2 and 5",
+		},
+		{
+			name:    "invalid interval",
+			args:    []string{"reviews", "watch", "--app", "app-1", "--interval", "0s"},
+			wantErr: "Error: --interval must be greater than 0",
+		},
+		{
+			name:    "invalid output",
+			args:    []string{"reviews", "watch", "--app", "app-1", "--output", "csv"},
+			wantErr: "Error: --output must be one of",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSandboxTestersClearPurchasesMultipleTesters(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	clearRequests := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/sandboxTesters":
+			body = `{"data":[` +
+				`{"type":"sandboxTesters","id":"tester-1","attributes":{"acAccountName":"a@example.com"}},` +
+				`{"type":"sandboxTesters","id":"tester-2","attributes":{"acAccountName":"b@example.com"}}]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v2/sandboxTestersClearPurchaseHistoryRequest":
+			clearRequests++
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"tester-1"`) || !strings.Contains(string(payload), `"tester-2"`) {
+				t.Fatalf("expected both testers in request body, got %s", payload)
+			}
+			status = http.StatusCreated
+			body = `{"data":{"type":"sandboxTestersClearPurchaseHistoryRequest","id":"request-1"}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"sandbox-testers", "clear-purchases", "--id", "tester-1", "--email", "b@example.com", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if clearRequests != 1 {
+		t.Fatalf("expected a single clear request, got %d", clearRequests)
+	}
+	var result struct {
+		RequestID string   `json:"requestId"`
+		TesterIDs []string `json:"testerIds"`
+		Cleared   bool     `json:"cleared"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.RequestID != "request-1" || strings.Join(result.TesterIDs, ",") != "tester-1,tester-2" || !result.Cleared {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
@@ -90,6 +90,16 @@ func TestSandboxClearHistoryValidationErrors(t *testing.T) {
 			args:    []string{"sandbox", "clear-history", "--confirm"},
 			wantErr: "--id or --email is required",
 		},
+		{
+			name:    "clear-purchases missing confirm",
+			args:    []string{"sandbox", "clear-purchases", "--id", "tester-1"},
+			wantErr: "--confirm is required",
+		},
+		{
+			name:    "sandbox-testers alias missing id and email",
+			args:    []string{"sandbox-testers", "clear-purchases", "--confirm"},
+			wantErr: "--id or --email is required",
+		},
 	}
 
 	for _, test := range tests {
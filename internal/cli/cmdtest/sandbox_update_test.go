@@ -0,0 +1,76 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSandboxUpdateAppliesSettingsToMultipleTesters(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var patched []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || !strings.HasPrefix(req.URL.Path, "/v2/sandboxTesters/") {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		id := strings.TrimPrefix(req.URL.Path, "/v2/sandboxTesters/")
+		patched = append(patched, id)
+
+		var payload struct {
+			Data struct {
+				Attributes map[string]any `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		attrs := payload.Data.Attributes
+		if attrs["subscriptionRenewalRate"] != "MONTHLY_RENEWAL_EVERY_FIVE_MINUTES" || attrs["interruptPurchases"] != false {
+			t.Fatalf("unexpected attributes: %v", attrs)
+		}
+
+		body := `{"data":{"type":"sandboxTesters","id":"` + id + `","attributes":{"interruptPurchases":false,"subscriptionRenewalRate":"MONTHLY_RENEWAL_EVERY_FIVE_MINUTES"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"sandbox", "update", "--id", "tester-1,tester-2", "--subscription-renewal-rate", "5m", "--interrupt-purchases=false"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if strings.Join(patched, ",") != "tester-1,tester-2" {
+		t.Fatalf("expected both testers patched, got %v", patched)
+	}
+	var result struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if len(result.Data) != 2 || result.Data[0].ID != "tester-1" || result.Data[1].ID != "tester-2" {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
@@ -0,0 +1,218 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSigningSetupReusesValidCertificateAndProfile(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	future := time.Now().Add(90 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	past := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+	certContent := base64.StdEncoding.EncodeToString([]byte("cert-der"))
+	profileContent := base64.StdEncoding.EncodeToString([]byte("profile-data"))
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds":
+			body = `{"data":[{"type":"bundleIds","id":"bid-1","attributes":{"identifier":"com.example.app","platform":"IOS"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/certificates":
+			if got := req.URL.Query().Get("filter[certificateType]"); got != "IOS_DISTRIBUTION" {
+				t.Fatalf("expected IOS_DISTRIBUTION filter, got %q", got)
+			}
+			body = `{"data":[` +
+				`{"type":"certificates","id":"cert-expired","attributes":{"serialNumber":"OLD","expirationDate":"` + past + `","certificateContent":"` + certContent + `"}},` +
+				`{"type":"certificates","id":"cert-1","attributes":{"serialNumber":"ABC123","expirationDate":"` + future + `","certificateContent":"` + certContent + `"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds/bid-1/profiles":
+			body = `{"data":[` +
+				`{"type":"profiles","id":"prof-dev","attributes":{"name":"Dev","profileType":"IOS_APP_DEVELOPMENT","profileState":"ACTIVE","expirationDate":"` + future + `"}},` +
+				`{"type":"profiles","id":"prof-1","attributes":{"name":"Store","profileType":"IOS_APP_STORE","profileState":"ACTIVE","expirationDate":"` + future + `","profileContent":"` + profileContent + `"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles/prof-1/certificates":
+			body = `{"data":[{"type":"certificates","id":"cert-1","attributes":{}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	outputDir := filepath.Join(t.TempDir(), "signing")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"signing", "setup", "--bundle-id", "com.example.app", "--output", outputDir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		CertificateIDs     []string `json:"certificateIds"`
+		CertificateCreated bool     `json:"certificateCreated"`
+		ProfileID          string   `json:"profileId"`
+		ProfileCreated     bool     `json:"profileCreated"`
+		ProfileFile        string   `json:"profileFile"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if strings.Join(result.CertificateIDs, ",") != "cert-1" || result.CertificateCreated || result.ProfileID != "prof-1" || result.ProfileCreated {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if data, err := os.ReadFile(result.ProfileFile); err != nil || string(data) != "profile-data" {
+		t.Fatalf("unexpected profile file %q: %v", data, err)
+	}
+	if data, err := os.ReadFile(filepath.Join(outputDir, "ABC123.cer")); err != nil || string(data) != "cert-der" {
+		t.Fatalf("unexpected certificate file %q: %v", data, err)
+	}
+}
+
+func TestSigningSetupCreatesCertificateAndProfileFromKey(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_P12_PASSWORD", "secret")
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	keyPath := filepath.Join(t.TempDir(), "dist.key")
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(keyPath, keyPEM, 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	issuer, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate issuer key: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	future := time.Now().Add(90 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	profileContent := base64.StdEncoding.EncodeToString([]byte("profile-data"))
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		status := http.StatusOK
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds":
+			body = `{"data":[{"type":"bundleIds","id":"bid-1","attributes":{"identifier":"com.example.app","platform":"IOS"}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/certificates":
+			// A valid certificate issued for some other key must not be reused.
+			other := base64.StdEncoding.EncodeToString([]byte("other-der"))
+			body = `{"data":[{"type":"certificates","id":"cert-other","attributes":{"serialNumber":"OTHER","expirationDate":"` + future + `","certificateContent":"` + other + `"}}]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/certificates":
+			var payload struct {
+				Data struct {
+					Attributes struct {
+						CSRContent string `json:"csrContent"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			der, err := base64.StdEncoding.DecodeString(payload.Data.Attributes.CSRContent)
+			if err != nil {
+				t.Fatalf("decode CSR: %v", err)
+			}
+			csr, err := x509.ParseCertificateRequest(der)
+			if err != nil {
+				t.Fatalf("parse CSR: %v", err)
+			}
+			template := &x509.Certificate{
+				SerialNumber: big.NewInt(42),
+				Subject:      pkix.Name{CommonName: csr.Subject.CommonName},
+				NotBefore:    time.Now().Add(-time.Hour),
+				NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+			}
+			issued, err := x509.CreateCertificate(rand.Reader, template, template, csr.PublicKey, issuer)
+			if err != nil {
+				t.Fatalf("issue certificate: %v", err)
+			}
+			status = http.StatusCreated
+			body = `{"data":{"type":"certificates","id":"cert-new","attributes":{"name":"Distribution","serialNumber":"NEW42","expirationDate":"` + future + `","certificateContent":"` + base64.StdEncoding.EncodeToString(issued) + `"}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds/bid-1/profiles":
+			body = `{"data":[]}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/profiles":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"cert-new"`) || !strings.Contains(string(payload), `"IOS_APP_STORE"`) {
+				t.Fatalf("unexpected profile body: %s", payload)
+			}
+			status = http.StatusCreated
+			body = `{"data":{"type":"profiles","id":"prof-new","attributes":{"name":"com.example.app IOS_APP_STORE","profileType":"IOS_APP_STORE","profileState":"ACTIVE","expirationDate":"` + future + `","profileContent":"` + profileContent + `"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	outputDir := filepath.Join(t.TempDir(), "signing")
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"signing", "setup", "--bundle-id", "com.example.app", "--type", "appstore", "--key", keyPath, "--output", outputDir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		CertificateIDs     []string `json:"certificateIds"`
+		CertificateCreated bool     `json:"certificateCreated"`
+		P12File            string   `json:"p12File"`
+		ProfileID          string   `json:"profileId"`
+		ProfileCreated     bool     `json:"profileCreated"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if strings.Join(result.CertificateIDs, ",") != "cert-new" || !result.CertificateCreated || result.ProfileID != "prof-new" || !result.ProfileCreated {
+		t.Fatalf("unexpected result: %+v (requests %v)", result, requests)
+	}
+	if result.P12File != filepath.Join(outputDir, "NEW42.p12") {
+		t.Fatalf("unexpected p12 file %q", result.P12File)
+	}
+	if info, err := os.Stat(result.P12File); err != nil || info.Size() == 0 {
+		t.Fatalf("expected non-empty .p12: %v", err)
+	}
+}
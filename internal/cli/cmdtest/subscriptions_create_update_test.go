@@ -0,0 +1,58 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func subscriptionAttributes(t *testing.T, request string) map[string]any {
+	t.Helper()
+	_, body, _ := strings.Cut(request, " {")
+	var payload struct {
+		Data struct {
+			Attributes map[string]any `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte("{"+body), &payload); err != nil {
+		t.Fatalf("decode request %q: %v", request, err)
+	}
+	return payload.Data.Attributes
+}
+
+func TestSubscriptionsCreateSendsGroupLevelAndReviewNote(t *testing.T) {
+	requests := runRecordedCommand(t, []string{
+		"subscriptions", "create", "--group", "GROUP_ID", "--ref-name", "Yearly", "--product-id", "com.example.yearly",
+		"--group-level", "1", "--review-note", "Use the sandbox account", "--family-sharable",
+	}, func(req *http.Request) (int, string) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/subscriptions" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return http.StatusCreated, `{"data":{"type":"subscriptions","id":"SUB_ID","attributes":{"name":"Yearly"}}}`
+	})
+
+	attributes := subscriptionAttributes(t, requests[0])
+	if attributes["groupLevel"] != float64(1) || attributes["reviewNote"] != "Use the sandbox account" || attributes["familySharable"] != true {
+		t.Fatalf("unexpected attributes: %v", attributes)
+	}
+}
+
+func TestSubscriptionsUpdateSendsOnlyGivenFields(t *testing.T) {
+	requests := runRecordedCommand(t, []string{
+		"subscriptions", "update", "--id", "SUB_ID", "--family-sharable=false", "--group-level", "2",
+	}, func(req *http.Request) (int, string) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/subscriptions/SUB_ID" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return http.StatusOK, `{"data":{"type":"subscriptions","id":"SUB_ID","attributes":{"name":"Yearly"}}}`
+	})
+
+	attributes := subscriptionAttributes(t, requests[0])
+	if _, ok := attributes["name"]; ok {
+		t.Fatalf("expected name to be omitted, got %v", attributes)
+	}
+	if attributes["familySharable"] != false || attributes["groupLevel"] != float64(2) {
+		t.Fatalf("unexpected attributes: %v", attributes)
+	}
+}
@@ -0,0 +1,46 @@
+package cmdtest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSubscriptionsGracePeriodsUpdateByApp(t *testing.T) {
+	requests := runRecordedCommand(t, []string{
+		"subscriptions", "grace-periods", "update", "--app", "APP_ID",
+		"--opt-in", "--duration", "16d", "--renewal-type", "ALL",
+	}, func(req *http.Request) (int, string) {
+		if req.Method == http.MethodGet {
+			return http.StatusOK, `{"data":{"type":"subscriptionGracePeriods","id":"GRACE_ID","attributes":{"optIn":false}}}`
+		}
+		return http.StatusOK, `{"data":{"type":"subscriptionGracePeriods","id":"GRACE_ID","attributes":{"optIn":true,"duration":"SIXTEEN_DAYS","renewalType":"ALL_RENEWALS"}}}`
+	})
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d: %v", len(requests), requests)
+	}
+	if !strings.HasPrefix(requests[0], "GET /v1/apps/APP_ID/subscriptionGracePeriod") {
+		t.Fatalf("expected app grace period lookup, got %s", requests[0])
+	}
+	if !strings.HasPrefix(requests[1], "PATCH /v1/subscriptionGracePeriods/GRACE_ID ") {
+		t.Fatalf("expected grace period update, got %s", requests[1])
+	}
+	for _, want := range []string{`"optIn":true`, `"duration":"SIXTEEN_DAYS"`, `"renewalType":"ALL_RENEWALS"`} {
+		if !strings.Contains(requests[1], want) {
+			t.Fatalf("expected %s in update body, got %s", want, requests[1])
+		}
+	}
+}
+
+func TestSubscriptionsGracePeriodsGetByApp(t *testing.T) {
+	requests := runRecordedCommand(t, []string{
+		"subscriptions", "grace-periods", "get", "--app", "APP_ID",
+	}, func(req *http.Request) (int, string) {
+		return http.StatusOK, `{"data":{"type":"subscriptionGracePeriods","id":"GRACE_ID","attributes":{"optIn":true}}}`
+	})
+
+	if len(requests) != 1 || !strings.HasPrefix(requests[0], "GET /v1/apps/APP_ID/subscriptionGracePeriod") {
+		t.Fatalf("expected app grace period lookup, got %v", requests)
+	}
+}
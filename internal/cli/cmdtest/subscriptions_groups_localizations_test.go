@@ -0,0 +1,110 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// runRecordedCommand runs the CLI with args against handler and returns each
+// request it sent as "METHOD path body".
+func runRecordedCommand(t *testing.T, args []string, handler func(req *http.Request) (int, string)) []string {
+	t.Helper()
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var payload []byte
+		if req.Body != nil {
+			payload, _ = io.ReadAll(req.Body)
+		}
+		requests = append(requests, req.Method+" "+req.URL.Path+" "+string(payload))
+		status, body := handler(req)
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	return requests
+}
+
+func TestSubscriptionsGroupsCreateWithLocalization(t *testing.T) {
+	requests := runRecordedCommand(t, []string{
+		"subscriptions", "groups", "create", "--app", "APP_ID", "--reference-name", "Premium",
+		"--locale", "en-US", "--name", "Premium", "--custom-app-name", "MyApp Pro",
+	}, func(req *http.Request) (int, string) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/subscriptionGroups":
+			return http.StatusCreated, `{"data":{"type":"subscriptionGroups","id":"GROUP_ID","attributes":{"referenceName":"Premium"}}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/subscriptionGroupLocalizations":
+			return http.StatusCreated, `{"data":{"type":"subscriptionGroupLocalizations","id":"LOC_ID","attributes":{"locale":"en-US"}}}`
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		return 0, ""
+	})
+
+	if len(requests) != 2 {
+		t.Fatalf("expected group and localization requests, got %v", requests)
+	}
+	localization := requests[1]
+	if !strings.Contains(localization, `"customAppName":"MyApp Pro"`) || !strings.Contains(localization, `"id":"GROUP_ID"`) {
+		t.Fatalf("unexpected localization request: %s", localization)
+	}
+}
+
+func TestSubscriptionsGroupsLocalizationsSetCreatesMissingLocale(t *testing.T) {
+	requests := runRecordedCommand(t, []string{
+		"subscriptions", "groups", "localizations", "set", "--group-id", "GROUP_ID", "--locale", "de-DE", "--name", "Premium",
+	}, func(req *http.Request) (int, string) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/subscriptionGroups/GROUP_ID/subscriptionGroupLocalizations":
+			return http.StatusOK, `{"data":[{"type":"subscriptionGroupLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","name":"Premium"}}],"links":{}}`
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/subscriptionGroupLocalizations":
+			return http.StatusCreated, `{"data":{"type":"subscriptionGroupLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE"}}}`
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		return 0, ""
+	})
+
+	if len(requests) != 2 || !strings.Contains(requests[1], `"locale":"de-DE"`) {
+		t.Fatalf("expected de-DE to be created, got %v", requests)
+	}
+}
+
+func TestSubscriptionsGroupsLocalizationsSetUpdatesExistingLocale(t *testing.T) {
+	requests := runRecordedCommand(t, []string{
+		"subscriptions", "groups", "localizations", "set", "--group-id", "GROUP_ID", "--locale", "en-US", "--custom-app-name", "MyApp Pro",
+	}, func(req *http.Request) (int, string) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/subscriptionGroups/GROUP_ID/subscriptionGroupLocalizations":
+			return http.StatusOK, `{"data":[{"type":"subscriptionGroupLocalizations","id":"LOC_EN","attributes":{"locale":"en-US","name":"Premium"}}],"links":{}}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/subscriptionGroupLocalizations/LOC_EN":
+			return http.StatusOK, `{"data":{"type":"subscriptionGroupLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}}}`
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		return 0, ""
+	})
+
+	if len(requests) != 2 || strings.Contains(requests[1], `"name"`) {
+		t.Fatalf("expected only customAppName to be updated, got %v", requests)
+	}
+}
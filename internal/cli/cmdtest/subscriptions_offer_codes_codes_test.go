@@ -0,0 +1,74 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSubscriptionsOfferCodesCodesFiltersActive(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch req.URL.Path {
+		case "/v1/subscriptions/SUB_ID/offerCodes":
+			body = `{"data":[{"type":"subscriptionOfferCodes","id":"OC_1","attributes":{"name":"Spring"}}]}`
+		case "/v1/subscriptionOfferCodes/OC_1/customCodes":
+			body = `{"data":[
+				{"type":"subscriptionOfferCodeCustomCodes","id":"CC_1","attributes":{"customCode":"SPRING26","numberOfCodes":1000,"createdDate":"2026-01-01","expirationDate":"2026-12-31","active":true}},
+				{"type":"subscriptionOfferCodeCustomCodes","id":"CC_2","attributes":{"customCode":"WINTER25","numberOfCodes":50,"createdDate":"2025-01-01","active":false}}
+			]}`
+		case "/v1/subscriptionOfferCodes/OC_1/oneTimeUseCodes":
+			body = `{"data":[{"type":"subscriptionOfferCodeOneTimeUseCodes","id":"OT_1","attributes":{"numberOfCodes":500,"createdDate":"2026-02-01","active":true}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"subscriptions", "offer-codes", "codes", "--subscription-id", "SUB_ID", "--active"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Codes []struct {
+			Kind          string `json:"kind"`
+			ID            string `json:"id"`
+			Code          string `json:"code"`
+			OfferCodeName string `json:"offerCodeName"`
+			Active        bool   `json:"active"`
+		} `json:"codes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if len(result.Codes) != 2 {
+		t.Fatalf("expected 2 active codes, got %+v", result.Codes)
+	}
+	if result.Codes[0].ID != "CC_1" || result.Codes[0].Kind != "custom" || result.Codes[0].Code != "SPRING26" {
+		t.Fatalf("unexpected first code: %+v", result.Codes[0])
+	}
+	if result.Codes[1].ID != "OT_1" || result.Codes[1].Kind != "one-time" || result.Codes[1].OfferCodeName != "Spring" {
+		t.Fatalf("unexpected second code: %+v", result.Codes[1])
+	}
+}
@@ -0,0 +1,46 @@
+package cmdtest
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSubscriptionsOfferCodesGenerateWritesCSV(t *testing.T) {
+	outPath := filepath.Join(t.TempDir(), "codes.csv")
+
+	requests := runRecordedCommand(t, []string{
+		"subscriptions", "offer-codes", "generate", "--offer-code-id", "OFFER_CODE_ID",
+		"--count", "2", "--expiration-date", "2026-12-31", "--out", outPath,
+	}, func(req *http.Request) (int, string) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/subscriptionOfferCodeOneTimeUseCodes":
+			return http.StatusCreated, `{"data":{"type":"subscriptionOfferCodeOneTimeUseCodes","id":"BATCH_ID","attributes":{"numberOfCodes":2}}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/subscriptionOfferCodeOneTimeUseCodes/BATCH_ID/values":
+			return http.StatusOK, "code\nAAAA1111\nBBBB2222\n"
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+			return 0, ""
+		}
+	})
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %v", requests)
+	}
+	for _, want := range []string{`"numberOfCodes":2`, `"expirationDate":"2026-12-31"`, `"id":"OFFER_CODE_ID"`} {
+		if !strings.Contains(requests[0], want) {
+			t.Fatalf("expected create body to contain %s, got %s", want, requests[0])
+		}
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read output: %v", err)
+	}
+	want := "code,expiration_date\nAAAA1111,2026-12-31\nBBBB2222,2026-12-31\n"
+	if string(data) != want {
+		t.Fatalf("expected CSV %q, got %q", want, string(data))
+	}
+}
@@ -0,0 +1,90 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func TestSubscriptionsPricesImportReportsFailedTerritories(t *testing.T) {
+	setupAuth(t)
+
+	csvPath := filepath.Join(t.TempDir(), "prices.csv")
+	content := "territory,price_point,preserve_current\nUSA,PP_USA,\nGBR,PP_GBR,false\n"
+	if err := os.WriteFile(csvPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var bodies []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/subscriptionPrices" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		payload, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(payload))
+		if strings.Contains(string(payload), `"GBR"`) {
+			return &http.Response{
+				StatusCode: http.StatusConflict,
+				Body:       io.NopCloser(strings.NewReader(`{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"Price already scheduled"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"type":"subscriptionPrices","id":"PRICE_USA"}}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"subscriptions", "prices", "import", "--id", "SUB_ID", "--csv", csvPath, "--start-date", "2026-03-01", "--preserve-current"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	var reported shared.ReportedError
+	if !errors.As(runErr, &reported) {
+		t.Fatalf("expected reported error, got %v", runErr)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("expected both territories to be attempted, got %d requests", len(bodies))
+	}
+	if !strings.Contains(bodies[0], `"preserved":true`) || !strings.Contains(bodies[0], `"startDate":"2026-03-01"`) {
+		t.Fatalf("expected USA to be preserved from 2026-03-01, got %s", bodies[0])
+	}
+	if strings.Contains(bodies[1], `"preserved"`) {
+		t.Fatalf("expected GBR row to override --preserve-current, got %s", bodies[1])
+	}
+
+	var result struct {
+		Failed int `json:"failed"`
+		Prices []struct {
+			Territory string `json:"territory"`
+			PriceID   string `json:"priceId"`
+			Error     string `json:"error"`
+		} `json:"prices"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v\n%s", err, stdout)
+	}
+	if result.Failed != 1 || result.Prices[0].PriceID != "PRICE_USA" || result.Prices[1].Error == "" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
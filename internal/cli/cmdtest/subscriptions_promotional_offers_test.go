@@ -0,0 +1,101 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSubscriptionsPromotionalOffersCreateWithTerritoryPrices(t *testing.T) {
+	requests := runRecordedCommand(t, []string{
+		"subscriptions", "promotional-offers", "create", "--subscription-id", "SUB_ID",
+		"--offer-code", "SPRING", "--name", "Spring", "--offer-duration", "ONE_MONTH",
+		"--offer-mode", "PAY_AS_YOU_GO", "--number-of-periods", "3",
+		"--prices", "usa:PP_USA,JPN:PP_JPN",
+	}, func(req *http.Request) (int, string) {
+		return http.StatusCreated, `{"data":{"type":"subscriptionPromotionalOffers","id":"OFFER_ID"}}`
+	})
+
+	if len(requests) != 1 || !strings.HasPrefix(requests[0], "POST /v1/subscriptionPromotionalOffers ") {
+		t.Fatalf("unexpected requests: %v", requests)
+	}
+	for _, want := range []string{
+		`"id":"${local-price-1}"`,
+		`"id":"USA"`,
+		`"id":"PP_USA"`,
+		`"id":"JPN"`,
+		`"id":"PP_JPN"`,
+		`"included":[`,
+	} {
+		if !strings.Contains(requests[0], want) {
+			t.Fatalf("expected request body to contain %s, got %s", want, requests[0])
+		}
+	}
+}
+
+func TestSubscriptionsPromotionalOffersSignatureParams(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body string
+		switch req.URL.Path {
+		case "/v1/apps/APP_ID":
+			body = `{"data":{"type":"apps","id":"APP_ID","attributes":{"name":"App","bundleId":"com.example.app","sku":"SKU"}}}`
+		case "/v1/subscriptions/SUB_ID":
+			body = `{"data":{"type":"subscriptions","id":"SUB_ID","attributes":{"name":"Monthly","productId":"com.example.monthly"}}}`
+		case "/v1/subscriptions/SUB_ID/promotionalOffers":
+			body = `{"data":[{"type":"subscriptionPromotionalOffers","id":"OTHER_ID","attributes":{"offerCode":"WINTER"}},{"type":"subscriptionPromotionalOffers","id":"OFFER_ID","attributes":{"offerCode":"SPRING"}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"subscriptions", "promotional-offers", "signature-params",
+			"--subscription-id", "SUB_ID", "--offer-code", "spring", "--app", "APP_ID", "--key-id", "KEY_ID",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var params struct {
+		AppBundleID       string `json:"appBundleId"`
+		KeyIdentifier     string `json:"keyIdentifier"`
+		ProductIdentifier string `json:"productIdentifier"`
+		OfferIdentifier   string `json:"offerIdentifier"`
+		OfferID           string `json:"offerId"`
+		Payload           string `json:"payload"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &params); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if params.AppBundleID != "com.example.app" || params.ProductIdentifier != "com.example.monthly" {
+		t.Fatalf("unexpected identifiers: %+v", params)
+	}
+	if params.OfferIdentifier != "SPRING" || params.OfferID != "OFFER_ID" || params.KeyIdentifier != "KEY_ID" {
+		t.Fatalf("unexpected offer params: %+v", params)
+	}
+	wantPayload := strings.Join([]string{"com.example.app", "KEY_ID", "com.example.monthly", "SPRING", "<appAccountToken>", "<nonce>", "<timestamp>"}, "\u2063")
+	if params.Payload != wantPayload {
+		t.Fatalf("expected payload %q, got %q", wantPayload, params.Payload)
+	}
+}
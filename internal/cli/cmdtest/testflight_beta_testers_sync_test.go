@@ -0,0 +1,220 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTestFlightBetaTestersSyncValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing app",
+			args:    []string{"testflight", "beta-testers", "sync", "--source", "testers.csv", "--group", "QA"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "missing source",
+			args:    []string{"testflight", "beta-testers", "sync", "--app", "app-1", "--group", "QA"},
+			wantErr: "--source is required",
+		},
+		{
+			name:    "missing group",
+			args:    []string{"testflight", "beta-testers", "sync", "--app", "app-1", "--source", "testers.csv"},
+			wantErr: "--group is required",
+		},
+		{
+			name:    "remove missing without confirm",
+			args:    []string{"testflight", "beta-testers", "sync", "--app", "app-1", "--source", "testers.csv", "--group", "QA", "--remove-missing"},
+			wantErr: "--confirm is required with --remove-missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestTestFlightBetaTestersSyncAppliesDiff(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	source := filepath.Join(t.TempDir(), "testers.csv")
+	roster := "Email,First Name,Last Name\nkeep@example.com,Keep,Me\nexisting@example.com,Ex,Isting\nnew@example.com,New,Person\n"
+	if err := os.WriteFile(source, []byte(roster), 0o600); err != nil {
+		t.Fatalf("write roster: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	jsonResponse := func(status int, body string) *http.Response {
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}
+	}
+
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		payload := ""
+		if req.Body != nil {
+			data, _ := io.ReadAll(req.Body)
+			payload = string(data)
+		}
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/app-1/betaGroups":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-qa","attributes":{"name":"QA"}}]}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/betaGroups/group-qa/betaTesters":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"betaTesters","id":"tester-keep","attributes":{"email":"Keep@example.com"}},{"type":"betaTesters","id":"tester-gone","attributes":{"email":"gone@example.com"}}]}`), nil
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/betaTesters":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"betaTesters","id":"tester-existing","attributes":{"email":"existing@example.com"}}]}`), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/betaGroups/group-qa/relationships/betaTesters":
+			if !strings.Contains(payload, `"id":"tester-existing"`) {
+				t.Fatalf("expected existing tester in add payload, got %s", payload)
+			}
+			return jsonResponse(http.StatusNoContent, ""), nil
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/betaTesters":
+			if !strings.Contains(payload, `"email":"new@example.com"`) || !strings.Contains(payload, `"firstName":"New"`) {
+				t.Fatalf("unexpected create payload %s", payload)
+			}
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"betaTesters","id":"tester-new","attributes":{"email":"new@example.com"}}}`), nil
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/betaGroups/group-qa/relationships/betaTesters":
+			if !strings.Contains(payload, `"id":"tester-gone"`) {
+				t.Fatalf("expected removed tester in payload, got %s", payload)
+			}
+			return jsonResponse(http.StatusNoContent, ""), nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "beta-testers", "sync", "--app", "app-1", "--source", source, "--group", "QA", "--remove-missing", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var result struct {
+		DryRun    bool `json:"dryRun"`
+		Added     int  `json:"added"`
+		Removed   int  `json:"removed"`
+		Unchanged int  `json:"unchanged"`
+		Changes   []struct {
+			Action   string `json:"action"`
+			Email    string `json:"email"`
+			TesterID string `json:"testerId"`
+			Status   string `json:"status"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.DryRun || result.Added != 2 || result.Removed != 1 || result.Unchanged != 1 {
+		t.Fatalf("unexpected summary: %+v", result)
+	}
+	for _, change := range result.Changes {
+		if change.Status != "applied" || change.TesterID == "" {
+			t.Fatalf("expected applied change with tester id, got %+v", change)
+		}
+	}
+	if len(requests) != 6 {
+		t.Fatalf("expected 6 requests, got %v", requests)
+	}
+}
+
+func TestTestFlightBetaTestersSyncDryRunMakesNoChanges(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	source := filepath.Join(t.TempDir(), "testers.csv")
+	if err := os.WriteFile(source, []byte("email\nnew@example.com\n"), 0o600); err != nil {
+		t.Fatalf("write roster: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("dry run must not mutate, got %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":[]}`
+		if req.URL.Path == "/v1/apps/app-1/betaGroups" {
+			body = `{"data":[{"type":"betaGroups","id":"group-qa","attributes":{"name":"QA"}}]}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "beta-testers", "sync", "--app", "app-1", "--source", source, "--group", "QA", "--remove-missing", "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, `"dryRun":true`) || !strings.Contains(stdout, `"status":"planned"`) {
+		t.Fatalf("expected planned dry-run output, got %q", stdout)
+	}
+}
@@ -0,0 +1,68 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUsersAuditCSV(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("audit must not mutate: %s %s", req.Method, req.URL.String())
+		}
+		body := ""
+		switch req.URL.Path {
+		case "/v1/users":
+			body = `{"data":[` +
+				`{"type":"users","id":"user-2","attributes":{"username":"zoe@example.com","firstName":"Zoe","lastName":"Z","roles":["DEVELOPER","MARKETING"],"allAppsVisible":false,"provisioningAllowed":true}},` +
+				`{"type":"users","id":"user-1","attributes":{"username":"amy@example.com","firstName":"Amy","lastName":"A","roles":["ADMIN"],"allAppsVisible":true,"provisioningAllowed":false}}]}`
+		case "/v1/users/user-2/visibleApps":
+			body = `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Demo","bundleId":"com.example.demo"}},{"type":"apps","id":"app-2","attributes":{"name":"Other","bundleId":"com.example.other"}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"users", "audit", "--output", "csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	records, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv: %v (%q)", err, stdout)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected header and two rows, got %v", records)
+	}
+	if got := strings.Join(records[1], "|"); got != "user-1|amy@example.com|Amy A|ADMIN|false|true|" {
+		t.Fatalf("unexpected first row: %q", got)
+	}
+	if got := strings.Join(records[2], "|"); got != "user-2|zoe@example.com|Zoe Z|DEVELOPER;MARKETING|true|false|Demo (com.example.demo); Other (com.example.other)" {
+		t.Fatalf("unexpected second row: %q", got)
+	}
+}
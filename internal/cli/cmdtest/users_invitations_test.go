@@ -0,0 +1,61 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUsersInvitationsCancelByEmail(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	deleted := false
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		status := http.StatusOK
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/userInvitations":
+			if got := req.URL.Query().Get("filter[email]"); got != "new@example.com" {
+				t.Fatalf("expected filter[email]=new@example.com, got %q", got)
+			}
+			body = `{"data":[{"type":"userInvitations","id":"invite-1","attributes":{"email":"New@example.com"}}]}`
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/userInvitations/invite-1":
+			deleted = true
+			status = http.StatusNoContent
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"users", "invitations", "cancel", "--email", "new@example.com", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !deleted {
+		t.Fatal("expected invitation to be deleted")
+	}
+	if !strings.Contains(stdout, `"id":"invite-1"`) {
+		t.Fatalf("expected cancelled invitation in output, got %q", stdout)
+	}
+}
@@ -0,0 +1,131 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUsersOffboardCancelsInvitationsAndRemovesUser(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var deletes []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		status := http.StatusOK
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/userInvitations":
+			body = `{"data":[` +
+				`{"type":"userInvitations","id":"invite-1","attributes":{"email":"Leaver@example.com","roles":["DEVELOPER"],"allAppsVisible":true}},` +
+				`{"type":"userInvitations","id":"invite-other","attributes":{"email":"someone@example.com","roles":["ADMIN"]}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users":
+			body = `{"data":[{"type":"users","id":"user-1","attributes":{"username":"leaver@example.com","firstName":"Lee","lastName":"Ver","roles":["APP_MANAGER","FINANCE"],"allAppsVisible":false}}]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users/user-1/visibleApps":
+			body = `{"data":[{"type":"apps","id":"app-1","attributes":{"name":"Demo","bundleId":"com.example.demo"}}]}`
+		case req.Method == http.MethodDelete:
+			deletes = append(deletes, req.URL.Path)
+			status = http.StatusNoContent
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"users", "offboard", "--email", "leaver@example.com", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if got := strings.Join(deletes, ","); got != "/v1/userInvitations/invite-1,/v1/users/user-1" {
+		t.Fatalf("unexpected deletes: %s", got)
+	}
+
+	var result struct {
+		User struct {
+			ID          string   `json:"id"`
+			Roles       []string `json:"roles"`
+			Removed     bool     `json:"removed"`
+			VisibleApps []struct {
+				BundleID string `json:"bundleId"`
+			} `json:"visibleApps"`
+		} `json:"user"`
+		Invitations []struct {
+			ID      string `json:"id"`
+			Removed bool   `json:"removed"`
+		} `json:"invitations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if !result.User.Removed || strings.Join(result.User.Roles, ",") != "APP_MANAGER,FINANCE" {
+		t.Fatalf("unexpected user: %+v", result.User)
+	}
+	if len(result.User.VisibleApps) != 1 || result.User.VisibleApps[0].BundleID != "com.example.demo" {
+		t.Fatalf("expected visible apps in report, got %+v", result.User.VisibleApps)
+	}
+	if len(result.Invitations) != 1 || result.Invitations[0].ID != "invite-1" || !result.Invitations[0].Removed {
+		t.Fatalf("unexpected invitations: %+v", result.Invitations)
+	}
+}
+
+func TestUsersOffboardDryRunRemovesNothing(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/userInvitations":
+			body = `{"data":[]}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users":
+			body = `{"data":[{"type":"users","id":"user-1","attributes":{"username":"leaver@example.com","roles":["ADMIN"],"allAppsVisible":true}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"users", "offboard", "--email", "leaver@example.com", "--dry-run", "--output", "table"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if !strings.Contains(stdout, "would-remove") || !strings.Contains(stdout, "ADMIN") {
+		t.Fatalf("expected dry-run report, got %q", stdout)
+	}
+}
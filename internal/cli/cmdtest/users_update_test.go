@@ -0,0 +1,109 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUsersUpdateByEmailSetsRolesAndVisibleApps(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		body := ""
+		status := http.StatusOK
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/users":
+			if got := req.URL.Query().Get("filter[username]"); got != "dev@example.com" {
+				t.Fatalf("expected filter[username]=dev@example.com, got %q", got)
+			}
+			// The filter can match more than one username; only the exact match counts.
+			body = `{"data":[` +
+				`{"type":"users","id":"user-other","attributes":{"username":"dev@example.com.au"}},` +
+				`{"type":"users","id":"user-1","attributes":{"username":"Dev@example.com"}}]}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/users/user-1":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"roles":["APP_MANAGER"]`) || !strings.Contains(string(payload), `"allAppsVisible":false`) {
+				t.Fatalf("unexpected update body: %s", payload)
+			}
+			body = `{"data":{"type":"users","id":"user-1","attributes":{"username":"Dev@example.com","roles":["APP_MANAGER"],"allAppsVisible":false}}}`
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/users/user-1/relationships/visibleApps":
+			payload, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(payload), `"id":"APP1"`) || !strings.Contains(string(payload), `"id":"APP2"`) {
+				t.Fatalf("unexpected visible apps body: %s", payload)
+			}
+			status = http.StatusNoContent
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"users", "update", "--email", "dev@example.com", "--roles", "APP_MANAGER", "--visible-apps", "APP1,APP2", "--all-apps-visible=false"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(requests) != 3 {
+		t.Fatalf("expected lookup, update, and visible apps requests, got %v", requests)
+	}
+	if !strings.Contains(stdout, `"id":"user-1"`) {
+		t.Fatalf("expected updated user in output, got %q", stdout)
+	}
+}
+
+func TestUsersDeleteByEmailRequiresExactMatch(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/users" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body := `{"data":[{"type":"users","id":"user-other","attributes":{"username":"gone@example.com.au"}}]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"users", "delete", "--email", "gone@example.com", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "no user found with email gone@example.com") {
+		t.Fatalf("expected lookup error, got %v", runErr)
+	}
+}
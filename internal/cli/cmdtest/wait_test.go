@@ -0,0 +1,142 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWaitValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"missing resource", []string{"wait", "--id", "1", "--until", "attributes.state==A"}, "Error: --resource is required"},
+		{"missing until", []string{"wait", "--resource", "build", "--id", "1"}, "Error: --until is required"},
+		{"unknown resource", []string{"wait", "--resource", "widget", "--id", "1", "--until", "attributes.state==A"}, "Error: --resource must be one of:"},
+		{"invalid until", []string{"wait", "--resource", "build", "--id", "1", "--until", "attributes.state"}, "Error: --until: invalid comparison"},
+		{"invalid poll interval", []string{"wait", "--resource", "build", "--id", "1", "--until", "attributes.state==A", "--poll-interval", "0s"}, "Error: --poll-interval must be greater than 0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected %q in stderr, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestWaitBuildSatisfiedImmediately(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/builds/build-1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		body := `{"data":{"type":"builds","id":"build-1","attributes":{"processingState":"VALID"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"wait",
+			"--resource", "build",
+			"--id", "build-1",
+			"--until", "attributes.processingState==VALID",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result struct {
+		Resource  string          `json:"resource"`
+		ID        string          `json:"id"`
+		Satisfied bool            `json:"satisfied"`
+		Attempts  int             `json:"attempts"`
+		Data      json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output: %v\n%s", err, stdout)
+	}
+	if result.Resource != "build" || result.ID != "build-1" || !result.Satisfied || result.Attempts != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestWaitFailOnReturnsError(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":{"type":"builds","id":"build-1","attributes":{"processingState":"INVALID"}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{
+			"wait",
+			"--resource", "build",
+			"--id", "build-1",
+			"--until", "attributes.processingState==VALID",
+			"--fail-on", "attributes.processingState==INVALID",
+		}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), `wait: build build-1: matched --fail-on "attributes.processingState==INVALID"`) {
+		t.Fatalf("expected fail-on error, got %v", runErr)
+	}
+	if !strings.Contains(stdout, `"satisfied":false`) {
+		t.Fatalf("expected final state in output, got %q", stdout)
+	}
+}
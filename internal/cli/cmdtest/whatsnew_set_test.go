@@ -0,0 +1,175 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestWhatsNewSetValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing version",
+			args:    []string{"whatsnew", "set", "--file", "notes.txt", "--all-locales"},
+			wantErr: "Error: --version-id is required",
+		},
+		{
+			name:    "missing source",
+			args:    []string{"whatsnew", "set", "--version-id", "VERSION_ID"},
+			wantErr: "Error: --file or --map is required",
+		},
+		{
+			name:    "file and map",
+			args:    []string{"whatsnew", "set", "--version-id", "VERSION_ID", "--file", "notes.txt", "--map", "notes.json"},
+			wantErr: "Error: --file and --map are mutually exclusive",
+		},
+		{
+			name:    "file without locales",
+			args:    []string{"whatsnew", "set", "--version-id", "VERSION_ID", "--file", "notes.txt"},
+			wantErr: "Error: --file requires --all-locales or --locale",
+		},
+		{
+			name:    "map with all locales",
+			args:    []string{"whatsnew", "set", "--version-id", "VERSION_ID", "--map", "notes.json", "--all-locales"},
+			wantErr: "Error: --all-locales and --locale cannot be used with --map",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func whatsNewTransport(t *testing.T, patched map[string]string) roundTripFunc {
+	t.Helper()
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/appStoreVersions/VERSION_ID/appStoreVersionLocalizations":
+			body = `{"data":[
+				{"type":"appStoreVersionLocalizations","id":"LOC_EN","attributes":{"locale":"en-US"}},
+				{"type":"appStoreVersionLocalizations","id":"LOC_DE","attributes":{"locale":"de-DE"}}
+			]}`
+		case req.Method == http.MethodPatch && strings.HasPrefix(req.URL.Path, "/v1/appStoreVersionLocalizations/"):
+			id := strings.TrimPrefix(req.URL.Path, "/v1/appStoreVersionLocalizations/")
+			payload, _ := io.ReadAll(req.Body)
+			patched[id] = string(payload)
+			body = `{"data":{"type":"appStoreVersionLocalizations","id":"` + id + `","attributes":{}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func TestWhatsNewSetFileAllLocales(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	patched := map[string]string{}
+	http.DefaultTransport = whatsNewTransport(t, patched)
+
+	notesPath := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(notesPath, []byte("Bug fixes and improvements.\n"), 0o644); err != nil {
+		t.Fatalf("write notes: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"whatsnew", "set", "--version-id", "VERSION_ID", "--file", notesPath, "--all-locales"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	ids := make([]string, 0, len(patched))
+	for id, payload := range patched {
+		ids = append(ids, id)
+		if !strings.Contains(payload, `"whatsNew":"Bug fixes and improvements."`) {
+			t.Fatalf("unexpected PATCH payload for %s: %s", id, payload)
+		}
+	}
+	sort.Strings(ids)
+	if strings.Join(ids, ",") != "LOC_DE,LOC_EN" {
+		t.Fatalf("expected both localizations to be updated, got %v", ids)
+	}
+	if !strings.Contains(stdout, `"action":"update"`) {
+		t.Fatalf("unexpected output: %s", stdout)
+	}
+}
+
+func TestWhatsNewSetMapRejectsUnknownLocale(t *testing.T) {
+	setupAuth(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	patched := map[string]string{}
+	http.DefaultTransport = whatsNewTransport(t, patched)
+
+	mapPath := filepath.Join(t.TempDir(), "notes.json")
+	if err := os.WriteFile(mapPath, []byte(`{"en-US":"Bug fixes","ja":"バグ修正"}`), 0o644); err != nil {
+		t.Fatalf("write map: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"whatsnew", "set", "--version-id", "VERSION_ID", "--map", mapPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "version has no localization for: ja") {
+		t.Fatalf("expected unknown locale error, got %v", runErr)
+	}
+	if len(patched) != 0 {
+		t.Fatalf("expected no writes, got %v", patched)
+	}
+}
@@ -0,0 +1,110 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestXcodeCloudArtifactsDownloadRunFiltersByTypeAndSkipsExisting(t *testing.T) {
+	outDir := t.TempDir()
+	downloads := 0
+	respond := func(req *http.Request) string {
+		switch req.URL.Host + req.URL.Path {
+		case "api.appstoreconnect.apple.com/v1/ciBuildRuns/run-1/actions":
+			return `{"data":[` +
+				`{"type":"ciBuildActions","id":"a1","attributes":{"name":"Archive - iOS"}},` +
+				`{"type":"ciBuildActions","id":"a2","attributes":{"name":"Test - iOS"}}]}`
+		case "api.appstoreconnect.apple.com/v1/ciBuildActions/a1/artifacts":
+			return `{"data":[` +
+				`{"type":"ciArtifacts","id":"art-1","attributes":{"fileType":"ARCHIVE","fileName":"App.xcarchive.zip","downloadUrl":"https://download.icloud-content.com/art-1"}},` +
+				`{"type":"ciArtifacts","id":"art-2","attributes":{"fileType":"LOG_BUNDLE","fileName":"logs.zip","downloadUrl":"https://download.icloud-content.com/art-2"}}]}`
+		case "api.appstoreconnect.apple.com/v1/ciBuildActions/a2/artifacts":
+			return `{"data":[{"type":"ciArtifacts","id":"art-3","attributes":{"fileType":"LOG_BUNDLE","fileName":"logs.zip","downloadUrl":"https://download.icloud-content.com/art-3"}}]}`
+		case "download.icloud-content.com/art-2", "download.icloud-content.com/art-3":
+			downloads++
+			return "zip:" + strings.TrimPrefix(req.URL.Path, "/")
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return ""
+		}
+	}
+
+	args := []string{"xcode-cloud", "artifacts", "download", "--run-id", "run-1", "--dir", outDir, "--type", "log_bundle"}
+	stdout, _, err := runXcodeCloudCommand(t, args, respond)
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	var result struct {
+		Downloaded int `json:"downloaded"`
+		Skipped    int `json:"skipped"`
+		Artifacts  []struct {
+			ID         string `json:"id"`
+			ActionName string `json:"actionName"`
+			Path       string `json:"path"`
+			Status     string `json:"status"`
+		} `json:"artifacts"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.Downloaded != 2 || result.Skipped != 0 || len(result.Artifacts) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	for id, path := range map[string]string{
+		"art-2": filepath.Join(outDir, "Archive - iOS", "logs.zip"),
+		"art-3": filepath.Join(outDir, "Test - iOS", "logs.zip"),
+	} {
+		data, err := os.ReadFile(path)
+		if err != nil || string(data) != "zip:"+id {
+			t.Fatalf("expected %s at %s, got %q (%v)", id, path, data, err)
+		}
+	}
+
+	// A second run finds both files on disk and downloads nothing.
+	stdout, _, err = runXcodeCloudCommand(t, args, respond)
+	if err != nil {
+		t.Fatalf("second run error: %v", err)
+	}
+	if downloads != 2 || !strings.Contains(stdout, `"skipped":2`) {
+		t.Fatalf("expected both artifacts skipped, downloads=%d stdout=%q", downloads, stdout)
+	}
+}
+
+func TestXcodeCloudArtifactsDownloadRunRejectsUnknownType(t *testing.T) {
+	_, stderr, err := runXcodeCloudCommand(t, []string{"xcode-cloud", "artifacts", "download", "--run-id", "run-1", "--type", "IPA"}, func(req *http.Request) string {
+		t.Fatalf("unexpected request: %s", req.URL.String())
+		return ""
+	})
+	if err == nil || !strings.Contains(stderr, "--type must be one of") {
+		t.Fatalf("expected --type error, got err=%v stderr=%q", err, stderr)
+	}
+}
+
+func TestXcodeCloudArtifactsDownloadRunValidation(t *testing.T) {
+	noRequests := func(req *http.Request) string {
+		t.Fatalf("unexpected request: %s", req.URL.String())
+		return ""
+	}
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "id and run id", args: []string{"xcode-cloud", "artifacts", "download", "--id", "art-1", "--run-id", "run-1"}, wantErr: "mutually exclusive"},
+		{name: "path with run id", args: []string{"xcode-cloud", "artifacts", "download", "--run-id", "run-1", "--path", "a.zip"}, wantErr: "--path is only valid with --id"},
+		{name: "type with id", args: []string{"xcode-cloud", "artifacts", "download", "--id", "art-1", "--path", "a.zip", "--type", "ARCHIVE"}, wantErr: "--type is only valid with --run-id"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := runXcodeCloudCommand(t, test.args, noRequests)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
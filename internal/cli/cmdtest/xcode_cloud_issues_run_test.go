@@ -0,0 +1,92 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestXcodeCloudIssuesListRunListsIssuesPerActionWithTypeFilter(t *testing.T) {
+	respond := func(req *http.Request) string {
+		switch req.URL.Path {
+		case "/v1/ciBuildRuns/run-1/actions":
+			return `{"data":[` +
+				`{"type":"ciBuildActions","id":"a1","attributes":{"name":"Build - iOS"}},` +
+				`{"type":"ciBuildActions","id":"a2","attributes":{"name":"Analyze - iOS"}}]}`
+		case "/v1/ciBuildActions/a1/issues":
+			return `{"data":[` +
+				`{"type":"ciIssues","id":"i1","attributes":{"issueType":"ERROR","message":"Cannot find 'foo' in scope","fileSource":{"path":"App/View.swift","lineNumber":12}}},` +
+				`{"type":"ciIssues","id":"i2","attributes":{"issueType":"WARNING","message":"Unused variable"}}]}`
+		case "/v1/ciBuildActions/a2/issues":
+			return `{"data":[{"type":"ciIssues","id":"i3","attributes":{"issueType":"ANALYZER_WARNING","category":"Logic error","message":"Null dereference","fileSource":{"path":"App/Model.m","lineNumber":40}}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return ""
+		}
+	}
+
+	stdout, _, err := runXcodeCloudCommand(t, []string{"xcode-cloud", "issues", "list", "--run-id", "run-1", "--type", "error,analyzer_warning"}, respond)
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	var result struct {
+		Issues []struct {
+			ID         string `json:"id"`
+			ActionName string `json:"actionName"`
+			IssueType  string `json:"issueType"`
+			FileSource struct {
+				Path       string `json:"path"`
+				LineNumber int    `json:"lineNumber"`
+			} `json:"fileSource"`
+		} `json:"issues"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if len(result.Issues) != 2 {
+		t.Fatalf("expected 2 issues, got %+v", result.Issues)
+	}
+	first, second := result.Issues[0], result.Issues[1]
+	if first.ID != "i1" || first.ActionName != "Build - iOS" || first.FileSource.Path != "App/View.swift" || first.FileSource.LineNumber != 12 {
+		t.Fatalf("unexpected first issue: %+v", first)
+	}
+	if second.ID != "i3" || second.ActionName != "Analyze - iOS" || second.IssueType != "ANALYZER_WARNING" {
+		t.Fatalf("unexpected second issue: %+v", second)
+	}
+}
+
+func TestXcodeCloudIssuesListRunRejectsUnknownType(t *testing.T) {
+	_, stderr, err := runXcodeCloudCommand(t, []string{"xcode-cloud", "issues", "list", "--run-id", "run-1", "--type", "NOTE"}, func(req *http.Request) string {
+		t.Fatalf("unexpected request: %s", req.URL.String())
+		return ""
+	})
+	if err == nil || !strings.Contains(stderr, "--type must be one of") {
+		t.Fatalf("expected --type error, got err=%v stderr=%q", err, stderr)
+	}
+}
+
+func TestXcodeCloudIssuesListRunValidation(t *testing.T) {
+	noRequests := func(req *http.Request) string {
+		t.Fatalf("unexpected request: %s", req.URL.String())
+		return ""
+	}
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "action id and run id", args: []string{"xcode-cloud", "issues", "list", "--action-id", "a1", "--run-id", "run-1"}, wantErr: "mutually exclusive"},
+		{name: "paginate with run id", args: []string{"xcode-cloud", "issues", "list", "--run-id", "run-1", "--paginate"}, wantErr: "only valid with --action-id"},
+		{name: "type with action id", args: []string{"xcode-cloud", "issues", "list", "--action-id", "a1", "--type", "ERROR"}, wantErr: "--type is only valid with --run-id"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := runXcodeCloudCommand(t, test.args, noRequests)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runXcodeCloudRun(t *testing.T, args []string, refsBody, pullRequestsBody string) (map[string]any, string) {
+	t.Helper()
+
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var created map[string]any
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/ciWorkflows/wf-1/repository":
+			body = `{"data":{"type":"scmRepositories","id":"repo-1"}}`
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/scmRepositories/repo-1/gitReferences":
+			body = refsBody
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/scmRepositories/repo-1/pullRequests":
+			body = pullRequestsBody
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/ciBuildRuns":
+			if err := json.NewDecoder(req.Body).Decode(&created); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			body = `{"data":{"type":"ciBuildRuns","id":"run-1","attributes":{"number":17,"executionProgress":"PENDING"}}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	if created == nil {
+		t.Fatal("expected a build run to be created")
+	}
+	relationships, _ := created["data"].(map[string]any)["relationships"].(map[string]any)
+	return relationships, stdout
+}
+
+func TestXcodeCloudRunTag(t *testing.T) {
+	refs := `{"data":[` +
+		`{"type":"scmGitReferences","id":"ref-branch","attributes":{"name":"v1.2.3","canonicalName":"refs/heads/v1.2.3","kind":"BRANCH"}},` +
+		`{"type":"scmGitReferences","id":"ref-tag","attributes":{"name":"v1.2.3","canonicalName":"refs/tags/v1.2.3","kind":"TAG"}}]}`
+	relationships, stdout := runXcodeCloudRun(t, []string{"xcode-cloud", "run", "--workflow-id", "wf-1", "--tag", "v1.2.3"}, refs, "")
+
+	source, _ := relationships["sourceBranchOrTag"].(map[string]any)
+	if data, _ := source["data"].(map[string]any); data["id"] != "ref-tag" {
+		t.Fatalf("expected tag reference ref-tag, got %+v", relationships)
+	}
+	if _, ok := relationships["pullRequest"]; ok {
+		t.Fatalf("expected no pullRequest relationship, got %+v", relationships)
+	}
+
+	var result struct {
+		BuildRunID     string `json:"buildRunId"`
+		BuildNumber    int    `json:"buildNumber"`
+		WorkflowID     string `json:"workflowId"`
+		GitReferenceID string `json:"gitReferenceId"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if result.BuildRunID != "run-1" || result.BuildNumber != 17 || result.WorkflowID != "wf-1" || result.GitReferenceID != "ref-tag" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestXcodeCloudRunPullRequest(t *testing.T) {
+	pullRequests := `{"data":[{"type":"scmPullRequests","id":"pr-42","attributes":{"number":42}}]}`
+	relationships, stdout := runXcodeCloudRun(t, []string{"xcode-cloud", "run", "--workflow-id", "wf-1", "--pr", "42"}, "", pullRequests)
+
+	pullRequest, _ := relationships["pullRequest"].(map[string]any)
+	if data, _ := pullRequest["data"].(map[string]any); data["id"] != "pr-42" || data["type"] != "scmPullRequests" {
+		t.Fatalf("expected pull request pr-42, got %+v", relationships)
+	}
+	if _, ok := relationships["sourceBranchOrTag"]; ok {
+		t.Fatalf("expected no sourceBranchOrTag relationship, got %+v", relationships)
+	}
+	if !strings.Contains(stdout, `"pullRequestNumber":42`) || !strings.Contains(stdout, `"buildNumber":17`) {
+		t.Fatalf("unexpected output: %q", stdout)
+	}
+}
+
+func TestXcodeCloudRunValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantHelp bool
+		wantErr  string
+	}{
+		{name: "missing reference", args: []string{"xcode-cloud", "run", "--workflow-id", "wf-1"}, wantHelp: true, wantErr: "--branch, --tag, --pr or --git-reference-id is required"},
+		{name: "branch and pr", args: []string{"xcode-cloud", "run", "--workflow-id", "wf-1", "--branch", "main", "--pr", "4"}, wantErr: "mutually exclusive"},
+		{name: "tag and git reference", args: []string{"xcode-cloud", "run", "--workflow-id", "wf-1", "--tag", "v1", "--git-reference-id", "ref-1"}, wantErr: "mutually exclusive"},
+		{name: "negative pr", args: []string{"xcode-cloud", "run", "--workflow-id", "wf-1", "--pr", "-1"}, wantErr: "--pr must be greater than 0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+
+			if test.wantHelp {
+				if !errors.Is(runErr, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", runErr)
+				}
+				if !strings.Contains(stderr, test.wantErr) {
+					t.Fatalf("expected stderr %q, got %q", test.wantErr, stderr)
+				}
+				return
+			}
+			if runErr == nil || !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
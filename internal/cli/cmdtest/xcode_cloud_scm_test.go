@@ -5,6 +5,7 @@ import (
 	"errors"
 	"flag"
 	"io"
+	"net/http"
 	"strings"
 	"testing"
 )
@@ -86,3 +87,18 @@ func TestXcodeCloudScmValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestXcodeCloudScmRepositoriesPullRequests(t *testing.T) {
+	stdout, _, err := runXcodeCloudCommand(t, []string{"xcode-cloud", "scm", "repositories", "pull-requests", "--repo-id", "repo-1"}, func(req *http.Request) string {
+		if req.URL.Path != "/v1/scmRepositories/repo-1/pullRequests" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return `{"data":[{"type":"scmPullRequests","id":"pr-1","attributes":{"number":42,"title":"Fix login"}}]}`
+	})
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !strings.Contains(stdout, `"number":42`) {
+		t.Fatalf("expected pull request in output, got %q", stdout)
+	}
+}
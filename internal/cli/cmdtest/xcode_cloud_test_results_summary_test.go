@@ -0,0 +1,87 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func xcodeCloudTestResultsResponder(t *testing.T) func(req *http.Request) string {
+	return func(req *http.Request) string {
+		switch req.URL.Path {
+		case "/v1/ciBuildRuns/run-1/actions":
+			return `{"data":[` +
+				`{"type":"ciBuildActions","id":"a1","attributes":{"name":"Build - iOS","actionType":"BUILD"}},` +
+				`{"type":"ciBuildActions","id":"a2","attributes":{"name":"Test - iOS","actionType":"TEST"}}]}`
+		case "/v1/ciBuildActions/a2/testResults":
+			return `{"data":[` +
+				`{"type":"ciTestResults","id":"t1","attributes":{"className":"LoginTests","name":"testLogin()","status":"SUCCESS","destinationTestResults":[{"duration":1.5},{"duration":0.5}]}},` +
+				`{"type":"ciTestResults","id":"t2","attributes":{"className":"LoginTests","name":"testLogout()","status":"FAILURE","message":"XCTAssertTrue failed"}},` +
+				`{"type":"ciTestResults","id":"t3","attributes":{"className":"SyncTests","name":"testSync()","status":"MIXED","message":"Timed out on iPad"}},` +
+				`{"type":"ciTestResults","id":"t4","attributes":{"className":"SyncTests","name":"testOffline()","status":"SKIPPED"}}]}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return ""
+		}
+	}
+}
+
+func TestXcodeCloudTestResultsSummarySummarizesTestActions(t *testing.T) {
+	stdout, _, err := runXcodeCloudCommand(t, []string{"xcode-cloud", "test-results", "summary", "--run-id", "run-1"}, xcodeCloudTestResultsResponder(t))
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	var summary struct {
+		Total    int `json:"total"`
+		Passed   int `json:"passed"`
+		Failed   int `json:"failed"`
+		Skipped  int `json:"skipped"`
+		Failures []struct {
+			Name       string `json:"name"`
+			ActionName string `json:"actionName"`
+			Status     string `json:"status"`
+			Message    string `json:"message"`
+		} `json:"failures"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &summary); err != nil {
+		t.Fatalf("parse output: %v (%q)", err, stdout)
+	}
+	if summary.Total != 4 || summary.Passed != 1 || summary.Failed != 2 || summary.Skipped != 1 {
+		t.Fatalf("unexpected counts: %+v", summary)
+	}
+	if len(summary.Failures) != 2 || summary.Failures[0].Name != "testLogout()" || summary.Failures[0].Message != "XCTAssertTrue failed" ||
+		summary.Failures[0].ActionName != "Test - iOS" || summary.Failures[1].Status != "MIXED" {
+		t.Fatalf("unexpected failures: %+v", summary.Failures)
+	}
+}
+
+func TestXcodeCloudTestResultsSummaryJUnitOutput(t *testing.T) {
+	stdout, _, err := runXcodeCloudCommand(t, []string{"xcode-cloud", "test-results", "summary", "--run-id", "run-1", "--output", "junit"}, xcodeCloudTestResultsResponder(t))
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	for _, want := range []string{
+		`<testsuite name="Xcode Cloud build run run-1" tests="4" failures="2" errors="0" skipped="1"`,
+		`<testcase name="testLogin()" classname="Test - iOS.LoginTests" time="2.000">`,
+		`<failure message="XCTAssertTrue failed" type="FAILURE">`,
+		`<failure message="Timed out on iPad" type="MIXED">`,
+		`<skipped></skipped>`,
+	} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected %q in junit output:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestXcodeCloudTestResultsSummaryRequiresRunID(t *testing.T) {
+	_, stderr, err := runXcodeCloudCommand(t, []string{"xcode-cloud", "test-results", "summary"}, func(req *http.Request) string {
+		t.Fatalf("unexpected request: %s", req.URL.String())
+		return ""
+	})
+	if err == nil || !strings.Contains(stderr, "--run-id is required") {
+		t.Fatalf("expected --run-id error, got err=%v stderr=%q", err, stderr)
+	}
+}
@@ -0,0 +1,85 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runXcodeCloudCommand(t *testing.T, args []string, respond func(req *http.Request) string) (string, string, error) {
+	t.Helper()
+
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(respond(req))),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, stderr, runErr
+}
+
+func xcodeCloudWaitResponder(t *testing.T, runs []string) func(req *http.Request) string {
+	polls := 0
+	return func(req *http.Request) string {
+		switch req.URL.Path {
+		case "/v1/ciBuildRuns/run-1":
+			body := runs[min(polls, len(runs)-1)]
+			polls++
+			return body
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return ""
+		}
+	}
+}
+
+func TestXcodeCloudStatusWaitFailsOnFailedRunWithoutProgressOffTerminal(t *testing.T) {
+	runs := []string{
+		`{"data":{"type":"ciBuildRuns","id":"run-1","attributes":{"number":7,"executionProgress":"RUNNING"}}}`,
+		`{"data":{"type":"ciBuildRuns","id":"run-1","attributes":{"number":7,"executionProgress":"COMPLETE","completionStatus":"FAILED"}}}`,
+	}
+
+	stdout, stderr, err := runXcodeCloudCommand(t, []string{"xcode-cloud", "status", "--run-id", "run-1", "--wait", "--poll-interval", "1ms"}, xcodeCloudWaitResponder(t, runs))
+	if err == nil || !strings.Contains(err.Error(), "completed with status: FAILED") {
+		t.Fatalf("expected failed run error, got %v", err)
+	}
+	if stderr != "" {
+		t.Fatalf("expected no progress output when stderr is not a terminal, got %q", stderr)
+	}
+	if !strings.Contains(stdout, `"buildRunId":"run-1"`) || !strings.Contains(stdout, `"completionStatus":"FAILED"`) {
+		t.Fatalf("expected final status output, got %q", stdout)
+	}
+}
+
+func TestXcodeCloudStatusWaitSucceeds(t *testing.T) {
+	runs := []string{`{"data":{"type":"ciBuildRuns","id":"run-1","attributes":{"number":8,"executionProgress":"COMPLETE","completionStatus":"SUCCEEDED"}}}`}
+
+	stdout, _, err := runXcodeCloudCommand(t, []string{"xcode-cloud", "status", "--run-id", "run-1", "--wait"}, xcodeCloudWaitResponder(t, runs))
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !strings.Contains(stdout, `"completionStatus":"SUCCEEDED"`) {
+		t.Fatalf("expected final status output, got %q", stdout)
+	}
+}
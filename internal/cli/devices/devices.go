@@ -39,17 +39,24 @@ func DevicesCommand() *ffcli.Command {
 Examples:
   asc devices list
   asc devices get --id "DEVICE_ID"
+  asc devices audit --disabled-only --output csv
   asc devices local-udid
   asc devices register --name "iPhone 15" --udid "UDID" --platform IOS
-  asc devices update --id "DEVICE_ID" --status DISABLED`,
+  asc devices register --file "./devices.txt"
+  asc devices update --id "DEVICE_ID" --status DISABLED
+  asc devices rename --id "DEVICE_ID" --name "Noah iPhone"
+  asc devices disable --udid "UDID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			DevicesListCommand(),
 			DevicesGetCommand(),
+			DevicesAuditCommand(),
 			DevicesLocalUDIDCommand(),
 			DevicesRegisterCommand(),
 			DevicesUpdateCommand(),
+			DevicesRenameCommand(),
+			DevicesDisableCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -262,22 +269,44 @@ func DevicesRegisterCommand() *ffcli.Command {
 	name := fs.String("name", "", "Device name")
 	udid := fs.String("udid", "", "Device UDID (required unless --udid-from-system)")
 	udidFromSystem := fs.Bool("udid-from-system", false, "Use local macOS hardware UUID as UDID (macOS only)")
-	platform := fs.String("platform", "", "Device platform: "+strings.Join(devicePlatformList(), ", "))
+	platform := fs.String("platform", "", "Device platform: "+strings.Join(devicePlatformList(), ", ")+" (default for --file rows without a platform)")
+	file := fs.String("file", "", "Register devices from a tab-separated file (UDID, name, optional platform)")
+	dryRun := fs.Bool("dry-run", false, "With --file, preview registrations without registering")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "register",
-		ShortUsage: "asc devices register --name NAME --udid UDID --platform " + strings.Join(devicePlatformList(), "|"),
-		ShortHelp:  "Register a new device.",
-		LongHelp: `Register a new device.
+		ShortUsage: "asc devices register (--name NAME --udid UDID --platform " + strings.Join(devicePlatformList(), "|") + " | --file devices.txt)",
+		ShortHelp:  "Register a new device or a file of devices.",
+		LongHelp: `Register a new device or a file of devices.
+
+--file accepts Apple's device upload format: one device per line with the
+UDID, device name, and an optional platform (ios, mac, or an API platform
+value) separated by tabs. A "Device ID" header row, blank lines, and #
+comments are skipped. Rows without a platform use --platform. Devices whose
+UDID is already registered are reported as existing and left untouched.
 
 Examples:
   asc devices register --name "iPhone 15" --udid "UDID" --platform IOS
-  asc devices register --name "My Mac" --udid-from-system --platform MAC_OS`,
+  asc devices register --name "My Mac" --udid-from-system --platform MAC_OS
+  asc devices register --file "./devices.txt" --dry-run
+  asc devices register --file "./devices.txt" --platform IOS`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
+			if fileValue := strings.TrimSpace(*file); fileValue != "" {
+				if strings.TrimSpace(*name) != "" || strings.TrimSpace(*udid) != "" || *udidFromSystem {
+					fmt.Fprintln(os.Stderr, "Error: --file cannot be combined with --name, --udid, or --udid-from-system")
+					return flag.ErrHelp
+				}
+				return registerDevicesFile(ctx, fileValue, *platform, *dryRun, *output, *pretty)
+			}
+			if *dryRun {
+				fmt.Fprintln(os.Stderr, "Error: --dry-run requires --file")
+				return flag.ErrHelp
+			}
+
 			nameValue := strings.TrimSpace(*name)
 			if nameValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --name is required")
@@ -343,6 +372,37 @@ Examples:
 	}
 }
 
+func registerDevicesFile(ctx context.Context, path, defaultPlatform string, dryRun bool, output string, pretty bool) error {
+	defaultPlatform, err := normalizeDevicePlatform(defaultPlatform)
+	if err != nil {
+		return fmt.Errorf("devices register: %w", err)
+	}
+	entries, err := readDevicesFile(path, defaultPlatform)
+	if err != nil {
+		return fmt.Errorf("devices register: %w", err)
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("devices register: %w", err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	result, err := registerDevicesFromFile(requestCtx, client, path, entries, dryRun)
+	if err != nil {
+		return fmt.Errorf("devices register: %w", err)
+	}
+	if err := shared.PrintOutput(result, output, pretty); err != nil {
+		return err
+	}
+	if len(result.Failures) > 0 {
+		return fmt.Errorf("devices register: %d devices failed to register", len(result.Failures))
+	}
+	return nil
+}
+
 // DevicesUpdateCommand returns the devices update subcommand.
 func DevicesUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("update", flag.ExitOnError)
@@ -410,6 +470,133 @@ Examples:
 	}
 }
 
+// DevicesRenameCommand returns the devices rename subcommand.
+func DevicesRenameCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("rename", flag.ExitOnError)
+
+	id := fs.String("id", "", "Device ID")
+	udid := fs.String("udid", "", "Device UDID (alternative to --id)")
+	name := fs.String("name", "", "New device name")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "rename",
+		ShortUsage: "asc devices rename (--id DEVICE_ID | --udid UDID) --name NAME",
+		ShortHelp:  "Rename a device.",
+		LongHelp: `Rename a device by ID or UDID.
+
+Examples:
+  asc devices rename --id "DEVICE_ID" --name "Noah iPhone"
+  asc devices rename --udid "UDID" --name "QA iPad"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue, udidValue, err := deviceSelectorFlags(*id, *udid)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+			nameValue := strings.TrimSpace(*name)
+			if nameValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --name is required")
+				return flag.ErrHelp
+			}
+
+			return updateDevice(ctx, "devices rename", idValue, udidValue, asc.DeviceUpdateAttributes{Name: &nameValue}, *output, *pretty)
+		},
+	}
+}
+
+// DevicesDisableCommand returns the devices disable subcommand.
+func DevicesDisableCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("disable", flag.ExitOnError)
+
+	id := fs.String("id", "", "Device ID")
+	udid := fs.String("udid", "", "Device UDID (alternative to --id)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "disable",
+		ShortUsage: "asc devices disable (--id DEVICE_ID | --udid UDID)",
+		ShortHelp:  "Disable a device.",
+		LongHelp: `Disable a device by ID or UDID.
+
+Disabled devices can no longer be added to provisioning profiles. They still
+count toward the yearly device limit until the membership year resets.
+Re-enable with "asc devices update --status ENABLED".
+
+Examples:
+  asc devices disable --id "DEVICE_ID"
+  asc devices disable --udid "UDID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue, udidValue, err := deviceSelectorFlags(*id, *udid)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			status := asc.DeviceStatusDisabled
+			return updateDevice(ctx, "devices disable", idValue, udidValue, asc.DeviceUpdateAttributes{Status: &status}, *output, *pretty)
+		},
+	}
+}
+
+func updateDevice(ctx context.Context, command, idValue, udidValue string, attrs asc.DeviceUpdateAttributes, output string, pretty bool) error {
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("%s: %w", command, err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	deviceID, err := resolveDeviceID(requestCtx, client, idValue, udidValue)
+	if err != nil {
+		return fmt.Errorf("%s: %w", command, err)
+	}
+
+	device, err := client.UpdateDevice(requestCtx, deviceID, attrs)
+	if err != nil {
+		return fmt.Errorf("%s: failed to update: %w", command, err)
+	}
+
+	return shared.PrintOutput(device, output, pretty)
+}
+
+// resolveDeviceID returns idValue, or looks up the device ID for udidValue.
+func resolveDeviceID(ctx context.Context, client *asc.Client, idValue, udidValue string) (string, error) {
+	if idValue != "" {
+		return idValue, nil
+	}
+	resp, err := client.GetDevices(ctx, asc.WithDevicesUDIDs([]string{udidValue}), asc.WithDevicesLimit(2))
+	if err != nil {
+		return "", fmt.Errorf("failed to look up device %s: %w", udidValue, err)
+	}
+	switch len(resp.Data) {
+	case 0:
+		return "", fmt.Errorf("no device registered with UDID %s", udidValue)
+	case 1:
+		return resp.Data[0].ID, nil
+	}
+	return "", fmt.Errorf("multiple devices registered with UDID %s; use --id", udidValue)
+}
+
+func deviceSelectorFlags(id, udid string) (string, string, error) {
+	idValue := strings.TrimSpace(id)
+	udidValue := strings.TrimSpace(udid)
+	if idValue == "" && udidValue == "" {
+		return "", "", fmt.Errorf("--id or --udid is required")
+	}
+	if idValue != "" && udidValue != "" {
+		return "", "", fmt.Errorf("--id and --udid are mutually exclusive")
+	}
+	return idValue, udidValue, nil
+}
+
 func normalizeDevicePlatform(value string) (string, error) {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
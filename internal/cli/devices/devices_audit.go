@@ -0,0 +1,182 @@
+package devices
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// DevicesAuditCommand returns the devices audit subcommand.
+func DevicesAuditCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("audit", flag.ExitOnError)
+
+	platform := fs.String("platform", "", "Filter by platform(s), comma-separated: "+strings.Join(devicePlatformList(), ", "))
+	disabledOnly := fs.Bool("disabled-only", false, "Only list disabled devices")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "audit",
+		ShortUsage: "asc devices audit [--disabled-only] [--platform IOS] [--output csv]",
+		ShortHelp:  "Report device inventory with profile usage.",
+		LongHelp: `Report device inventory with profile usage.
+
+Lists every registered device grouped by status, with its model, the number
+of provisioning profiles that include it, and the most recently created of
+those profiles. Devices that appear in no profile are good candidates to
+disable before the membership year resets and device slots are reclaimed.
+
+Examples:
+  asc devices audit
+  asc devices audit --disabled-only --output table
+  asc devices audit --platform IOS --output csv > devices.csv`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			platforms, err := normalizeDevicePlatforms(shared.SplitCSV(*platform))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("devices audit: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.DevicesOption{asc.WithDevicesLimit(200)}
+			if len(platforms) > 0 {
+				opts = append(opts, asc.WithDevicesPlatforms(platforms))
+			}
+			if *disabledOnly {
+				opts = append(opts, asc.WithDevicesStatus(string(asc.DeviceStatusDisabled)))
+			}
+			firstPage, err := client.GetDevices(requestCtx, opts...)
+			if err != nil {
+				return fmt.Errorf("devices audit: failed to fetch devices: %w", err)
+			}
+			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetDevices(ctx, asc.WithDevicesNextURL(nextURL))
+			})
+			if err != nil {
+				return fmt.Errorf("devices audit: %w", err)
+			}
+			devices, ok := paginated.(*asc.DevicesResponse)
+			if !ok {
+				return fmt.Errorf("devices audit: unexpected devices response type %T", paginated)
+			}
+
+			usage, err := profilesByDevice(requestCtx, client)
+			if err != nil {
+				return fmt.Errorf("devices audit: %w", err)
+			}
+
+			return shared.PrintOutputWithCSV(buildDeviceAudit(devices.Data, usage), *output, *pretty)
+		},
+	}
+}
+
+// profilesByDevice maps device IDs to the profiles that include them.
+func profilesByDevice(ctx context.Context, client *asc.Client) (map[string][]asc.Resource[asc.ProfileAttributes], error) {
+	firstPage, err := client.GetProfiles(ctx, asc.WithProfilesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch profiles: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate profiles: %w", err)
+	}
+	profiles, ok := paginated.(*asc.ProfilesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected profiles response type %T", paginated)
+	}
+
+	usage := make(map[string][]asc.Resource[asc.ProfileAttributes])
+	for _, profile := range profiles.Data {
+		firstDevices, err := client.GetProfileDevicesRelationships(ctx, profile.ID, asc.WithLinkagesLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch devices for profile %s: %w", profile.ID, err)
+		}
+		paginatedDevices, err := asc.PaginateAll(ctx, firstDevices, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetProfileDevicesRelationships(ctx, profile.ID, asc.WithLinkagesNextURL(nextURL))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("paginate devices for profile %s: %w", profile.ID, err)
+		}
+		linkages, ok := paginatedDevices.(*asc.ProfileDevicesLinkagesResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected profile devices response type %T", paginatedDevices)
+		}
+		for _, device := range linkages.Data {
+			usage[device.ID] = append(usage[device.ID], profile)
+		}
+	}
+	return usage, nil
+}
+
+// buildDeviceAudit joins devices with their profiles, ordering devices by
+// status and then name.
+func buildDeviceAudit(devices []asc.Resource[asc.DeviceAttributes], usage map[string][]asc.Resource[asc.ProfileAttributes]) *asc.DeviceAuditResult {
+	result := &asc.DeviceAuditResult{
+		Total:   len(devices),
+		Devices: make([]asc.DeviceAuditItem, 0, len(devices)),
+	}
+	for _, device := range devices {
+		item := asc.DeviceAuditItem{
+			ID:          device.ID,
+			Name:        device.Attributes.Name,
+			UDID:        device.Attributes.UDID,
+			Platform:    string(device.Attributes.Platform),
+			Status:      string(device.Attributes.Status),
+			DeviceClass: string(device.Attributes.DeviceClass),
+			Model:       device.Attributes.Model,
+			AddedDate:   device.Attributes.AddedDate,
+		}
+		profiles := usage[device.ID]
+		item.ProfileCount = len(profiles)
+		var lastCreated time.Time
+		for _, profile := range profiles {
+			created, _ := time.Parse(time.RFC3339, strings.TrimSpace(profile.Attributes.CreatedDate))
+			if item.LastProfileID == "" || created.After(lastCreated) {
+				lastCreated = created
+				item.LastProfileID = profile.ID
+				item.LastProfileName = profile.Attributes.Name
+				item.LastProfileCreated = profile.Attributes.CreatedDate
+			}
+		}
+
+		switch device.Attributes.Status {
+		case asc.DeviceStatusEnabled:
+			result.EnabledCount++
+		case asc.DeviceStatusDisabled:
+			result.DisabledCount++
+		}
+		if item.ProfileCount == 0 {
+			result.UnassignedCount++
+		}
+		result.Devices = append(result.Devices, item)
+	}
+
+	sort.SliceStable(result.Devices, func(i, j int) bool {
+		left, right := result.Devices[i], result.Devices[j]
+		if left.Status != right.Status {
+			return left.Status < right.Status
+		}
+		return strings.ToLower(left.Name) < strings.ToLower(right.Name)
+	})
+	return result
+}
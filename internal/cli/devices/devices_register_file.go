@@ -0,0 +1,191 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// deviceFileEntry is one device parsed from a bulk registration file.
+type deviceFileEntry struct {
+	Line     int
+	UDID     string
+	Name     string
+	Platform string
+}
+
+// readDevicesFile reads a device list in Apple's upload format: one device
+// per line with tab-separated UDID, name, and optional platform columns. The
+// optional header row, blank lines, and # comments are skipped. Rows without
+// a platform column use defaultPlatform.
+func readDevicesFile(path, defaultPlatform string) ([]deviceFileEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("read --file: %w", err)
+	}
+	defer file.Close()
+	data, err := io.ReadAll(fileutil.SkipBOM(file))
+	if err != nil {
+		return nil, fmt.Errorf("read --file: %w", err)
+	}
+
+	var (
+		entries  []deviceFileEntry
+		problems []string
+	)
+	seen := make(map[string]int)
+	for index, raw := range strings.Split(string(data), "\n") {
+		line := index + 1
+		trimmed := strings.TrimSpace(raw)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		columns := strings.Split(strings.TrimRight(raw, "\r"), "\t")
+		for i := range columns {
+			columns[i] = strings.TrimSpace(columns[i])
+		}
+		if len(entries) == 0 && len(problems) == 0 && isDevicesFileHeader(columns[0]) {
+			continue
+		}
+		if len(columns) < 2 || columns[0] == "" || columns[1] == "" {
+			problems = append(problems, fmt.Sprintf("line %d: expected tab-separated UDID and device name", line))
+			continue
+		}
+
+		key := strings.ToUpper(columns[0])
+		if previous, ok := seen[key]; ok {
+			problems = append(problems, fmt.Sprintf("line %d: duplicate UDID %s (first seen on line %d)", line, columns[0], previous))
+			continue
+		}
+		seen[key] = line
+
+		platformValue := defaultPlatform
+		if len(columns) > 2 && columns[2] != "" {
+			platformValue = columns[2]
+		}
+		if platformValue == "" {
+			problems = append(problems, fmt.Sprintf("line %d: missing platform column (or pass --platform)", line))
+			continue
+		}
+		platform, err := normalizeDevicesFilePlatform(platformValue)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+
+		entries = append(entries, deviceFileEntry{
+			Line:     line,
+			UDID:     columns[0],
+			Name:     columns[1],
+			Platform: platform,
+		})
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid --file %s:\n  %s", path, strings.Join(problems, "\n  "))
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("--file %s contains no devices", path)
+	}
+	return entries, nil
+}
+
+func isDevicesFileHeader(value string) bool {
+	switch strings.ToLower(strings.ReplaceAll(value, " ", "")) {
+	case "deviceid", "deviceidentifier", "udid":
+		return true
+	}
+	return false
+}
+
+// normalizeDevicesFilePlatform accepts the lowercase platform names used in
+// Apple's device files (ios, mac) as well as API platform values.
+func normalizeDevicesFilePlatform(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "ios":
+		return "IOS", nil
+	case "mac", "macos":
+		return "MAC_OS", nil
+	case "tvos":
+		return "TV_OS", nil
+	case "visionos":
+		return "VISION_OS", nil
+	}
+	return normalizeDevicePlatform(value)
+}
+
+// registerDevicesFromFile registers every device in entries that is not
+// already registered, matching existing devices by UDID.
+func registerDevicesFromFile(ctx context.Context, client *asc.Client, path string, entries []deviceFileEntry, dryRun bool) (*asc.DeviceRegisterBulkResult, error) {
+	firstPage, err := client.GetDevices(ctx, asc.WithDevicesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch devices: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetDevices(ctx, asc.WithDevicesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate devices: %w", err)
+	}
+	devices, ok := paginated.(*asc.DevicesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected devices response type %T", paginated)
+	}
+	existing := make(map[string]string, len(devices.Data))
+	for _, device := range devices.Data {
+		existing[strings.ToUpper(strings.TrimSpace(device.Attributes.UDID))] = device.ID
+	}
+
+	result := &asc.DeviceRegisterBulkResult{
+		File:     path,
+		DryRun:   dryRun,
+		Total:    len(entries),
+		Devices:  make([]asc.DeviceRegisterBulkItem, 0, len(entries)),
+		Failures: make([]asc.DeviceRegisterBulkFailure, 0),
+	}
+	for _, entry := range entries {
+		item := asc.DeviceRegisterBulkItem{
+			Line:     entry.Line,
+			Name:     entry.Name,
+			UDID:     entry.UDID,
+			Platform: entry.Platform,
+		}
+		if id, ok := existing[strings.ToUpper(entry.UDID)]; ok {
+			item.ID = id
+			item.Status = "existing"
+			result.SkippedCount++
+			result.Devices = append(result.Devices, item)
+			continue
+		}
+		if dryRun {
+			item.Status = "would-register"
+			result.Devices = append(result.Devices, item)
+			continue
+		}
+
+		resp, err := client.CreateDevice(ctx, asc.DeviceCreateAttributes{
+			Name:     entry.Name,
+			UDID:     entry.UDID,
+			Platform: asc.DevicePlatform(entry.Platform),
+		})
+		if err != nil {
+			result.Failures = append(result.Failures, asc.DeviceRegisterBulkFailure{
+				Line:  entry.Line,
+				UDID:  entry.UDID,
+				Error: err.Error(),
+			})
+			continue
+		}
+		item.ID = resp.Data.ID
+		item.Status = "registered"
+		result.RegisteredCount++
+		result.Devices = append(result.Devices, item)
+	}
+	return result, nil
+}
@@ -3,9 +3,14 @@ package devices
 import (
 	"context"
 	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
 func TestDevicesRegisterCommand_MissingName(t *testing.T) {
@@ -151,3 +156,138 @@ func TestLocalMacUDID_UsesContextTimeout(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestDevicesRegisterCommand_FileConflictsWithName(t *testing.T) {
+	cmd := DevicesRegisterCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--file", "devices.txt", "--name", "Device"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --file and --name are combined, got %v", err)
+	}
+}
+
+func TestDevicesRegisterCommand_DryRunRequiresFile(t *testing.T) {
+	cmd := DevicesRegisterCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--name", "Device", "--udid", "UDID", "--platform", "IOS", "--dry-run"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --dry-run is used without --file, got %v", err)
+	}
+}
+
+func TestDevicesRenameCommand_MissingName(t *testing.T) {
+	cmd := DevicesRenameCommand()
+
+	if err := cmd.FlagSet.Parse([]string{"--id", "DEVICE_ID"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --name is missing, got %v", err)
+	}
+}
+
+func TestDevicesDisableCommand_SelectorValidation(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"--id", "DEVICE_ID", "--udid", "UDID"},
+	}
+	for _, args := range tests {
+		cmd := DevicesDisableCommand()
+		if err := cmd.FlagSet.Parse(args); err != nil {
+			t.Fatalf("failed to parse flags: %v", err)
+		}
+		if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+			t.Fatalf("expected flag.ErrHelp for args %v, got %v", args, err)
+		}
+	}
+}
+
+func TestReadDevicesFile_ParsesAppleFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devices.txt")
+	content := "Device ID\tDevice Name\tDevice Platform\r\n" +
+		"00008030-000A\tNoah iPhone\tios\r\n" +
+		"# lab machines\n" +
+		"\n" +
+		"A1B2C3D4-E5F6\tBuild Mac\tmac\n" +
+		"00008110-000B\tQA iPad\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	entries, err := readDevicesFile(path, "IOS")
+	if err != nil {
+		t.Fatalf("readDevicesFile() error = %v", err)
+	}
+	want := []deviceFileEntry{
+		{Line: 2, UDID: "00008030-000A", Name: "Noah iPhone", Platform: "IOS"},
+		{Line: 5, UDID: "A1B2C3D4-E5F6", Name: "Build Mac", Platform: "MAC_OS"},
+		{Line: 6, UDID: "00008110-000B", Name: "QA iPad", Platform: "IOS"},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Fatalf("readDevicesFile() = %+v, want %+v", entries, want)
+	}
+}
+
+func TestReadDevicesFile_ReportsInvalidLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devices.txt")
+	content := "UDID-1\tPhone\n" +
+		"UDID-2 Phone\n" +
+		"udid-1\tDuplicate\tios\n" +
+		"UDID-3\tWatch\twatchos\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	_, err := readDevicesFile(path, "")
+	if err == nil {
+		t.Fatal("expected error for invalid file")
+	}
+	for _, want := range []string{
+		"line 1: missing platform column",
+		"line 2: expected tab-separated UDID and device name",
+		"line 3: duplicate UDID udid-1",
+		"line 4: --platform must be one of",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected %q in error, got %v", want, err)
+		}
+	}
+}
+
+func TestBuildDeviceAudit_JoinsProfilesAndSortsByStatus(t *testing.T) {
+	devices := []asc.Resource[asc.DeviceAttributes]{
+		{ID: "dev-1", Attributes: asc.DeviceAttributes{Name: "Zed iPhone", Status: asc.DeviceStatusEnabled}},
+		{ID: "dev-2", Attributes: asc.DeviceAttributes{Name: "Old iPad", Status: asc.DeviceStatusDisabled}},
+		{ID: "dev-3", Attributes: asc.DeviceAttributes{Name: "Ann iPhone", Status: asc.DeviceStatusEnabled}},
+	}
+	usage := map[string][]asc.Resource[asc.ProfileAttributes]{
+		"dev-1": {
+			{ID: "prof-new", Attributes: asc.ProfileAttributes{Name: "Dev 2026", CreatedDate: "2026-03-01T00:00:00.000+00:00"}},
+			{ID: "prof-old", Attributes: asc.ProfileAttributes{Name: "Dev 2025", CreatedDate: "2025-03-01T00:00:00.000+00:00"}},
+		},
+	}
+
+	result := buildDeviceAudit(devices, usage)
+
+	if result.Total != 3 || result.EnabledCount != 2 || result.DisabledCount != 1 || result.UnassignedCount != 2 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	var order []string
+	for _, item := range result.Devices {
+		order = append(order, item.ID)
+	}
+	if strings.Join(order, ",") != "dev-2,dev-3,dev-1" {
+		t.Fatalf("unexpected order: %v", order)
+	}
+	zed := result.Devices[2]
+	if zed.ProfileCount != 2 || zed.LastProfileID != "prof-new" || zed.LastProfileName != "Dev 2026" {
+		t.Fatalf("unexpected profile usage: %+v", zed)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -51,6 +52,13 @@ func FinanceReportsCommand() *ffcli.Command {
 	date := fs.String("date", "", "Report date (YYYY-MM, Apple fiscal month)")
 	output := fs.String("output", "", "Output file path (default: finance_report_{date}_{type}_{region}.tsv.gz)")
 	decompress := fs.Bool("decompress", false, "Decompress gzip output to .tsv")
+	from := fs.String("from", "", "Backfill: first report month (YYYY-MM)")
+	to := fs.String("to", "", "Backfill: last report month, inclusive (YYYY-MM)")
+	outputDir := fs.String("output-dir", "reports", "Backfill: root directory for downloaded reports")
+	retries := fs.Int("retries", 3, "Backfill: times to retry months whose reports are not available yet")
+	retryDelay := fs.Duration("retry-delay", time.Minute, "Backfill: wait between retries of unavailable months")
+	toSQLite := fs.String("to-sqlite", "", "Also load the report rows into a new SQLite database at this path (replaced if it exists)")
+	toParquet := fs.String("to-parquet", "", "Also write the report rows to a Parquet file at this path (replaced if it exists)")
 	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -89,6 +97,22 @@ REGION CODES:
 
   Run 'asc finance regions' for the complete list.
 
+BACKFILL:
+
+Use --from and --to instead of --date to download every month in a range.
+Reports are written to
+  {output-dir}/finance/{vendor}/{TYPE}_{REGION}/{date}.tsv.gz
+months whose files already exist are skipped, and months whose reports are
+not available yet are retried and otherwise reported as pending, so
+re-running the same command picks up where the last run stopped.
+
+EXPORT:
+
+Use --to-sqlite or --to-parquet to also load the report rows into a typed
+"finance" table with snake_case columns and a leading report_date column.
+Totals after the first blank line of a report are not included. With
+--from/--to, every month whose report is on disk is included.
+
 Examples:
   # Download single consolidated report (all regions)
   asc finance reports --vendor "12345678" --report-type FINANCIAL --region "ZZ" --date "2025-12"
@@ -100,7 +124,13 @@ Examples:
   asc finance reports --vendor "12345678" --report-type FINANCE_DETAIL --region "Z1" --date "2025-12" --decompress
 
   # Save to custom path
-  asc finance reports --vendor "12345678" --report-type FINANCIAL --region "US" --date "2025-12" --output "reports/finance.tsv.gz"`,
+  asc finance reports --vendor "12345678" --report-type FINANCIAL --region "US" --date "2025-12" --output "reports/finance.tsv.gz"
+
+  # Backfill a year of consolidated reports
+  asc finance reports --vendor "12345678" --report-type FINANCIAL --region "ZZ" --from "2025-01" --to "2025-12"
+
+  # Backfill and load the rows into SQLite
+  asc finance reports --vendor "12345678" --report-type FINANCIAL --region "ZZ" --from "2025-01" --to "2025-12" --to-sqlite finance.db`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -117,7 +147,29 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --region is required")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*date) == "" {
+			backfill := strings.TrimSpace(*from) != "" || strings.TrimSpace(*to) != ""
+			export := shared.ReportExport{
+				SQLitePath:  strings.TrimSpace(*toSQLite),
+				ParquetPath: strings.TrimSpace(*toParquet),
+			}
+			if backfill {
+				if strings.TrimSpace(*from) == "" || strings.TrimSpace(*to) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --from and --to must be used together")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*date) != "" || strings.TrimSpace(*output) != "" {
+					fmt.Fprintln(os.Stderr, "Error: --from/--to cannot be used with --date or --output")
+					return flag.ErrHelp
+				}
+				if strings.TrimSpace(*outputDir) == "" {
+					fmt.Fprintln(os.Stderr, "Error: --output-dir is required")
+					return flag.ErrHelp
+				}
+				if *retries < 0 {
+					fmt.Fprintln(os.Stderr, "Error: --retries must be 0 or greater")
+					return flag.ErrHelp
+				}
+			} else if strings.TrimSpace(*date) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --date is required")
 				return flag.ErrHelp
 			}
@@ -126,14 +178,39 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("finance reports: %w", err)
 			}
-			reportDate, err := normalizeFinanceReportDate(*date)
+			regionCode, err := normalizeFinanceReportRegion(normalizedReportType, *region)
 			if err != nil {
 				return fmt.Errorf("finance reports: %w", err)
 			}
-			regionCode, err := normalizeFinanceReportRegion(normalizedReportType, *region)
+
+			params := asc.FinanceReportParams{
+				VendorNumber: vendorNumber,
+				ReportType:   normalizedReportType,
+				RegionCode:   regionCode,
+			}
+			if backfill {
+				months, err := shared.ReportDateRange(strings.TrimSpace(*from), strings.TrimSpace(*to), "2006-01", func(t time.Time) time.Time {
+					return t.AddDate(0, 1, 0)
+				})
+				if err != nil {
+					return fmt.Errorf("finance reports: %w", err)
+				}
+				return runFinanceBackfill(ctx, params, months, financeBackfillOptions{
+					outputDir:    strings.TrimSpace(*outputDir),
+					decompress:   *decompress,
+					retries:      *retries,
+					retryDelay:   *retryDelay,
+					export:       export,
+					outputFormat: *outputFormat,
+					pretty:       *pretty,
+				})
+			}
+
+			reportDate, err := normalizeFinanceReportDate(*date)
 			if err != nil {
 				return fmt.Errorf("finance reports: %w", err)
 			}
+			params.ReportDate = reportDate
 			defaultOutput := fmt.Sprintf("finance_report_%s_%s_%s.tsv.gz", reportDate, string(normalizedReportType), regionCode)
 			compressedPath, decompressedPath := shared.ResolveReportOutputPaths(*output, defaultOutput, ".tsv", *decompress)
 
@@ -145,30 +222,17 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			download, err := client.DownloadFinanceReport(requestCtx, asc.FinanceReportParams{
-				VendorNumber: vendorNumber,
-				ReportType:   normalizedReportType,
-				RegionCode:   regionCode,
-				ReportDate:   reportDate,
-			})
+			download, err := client.DownloadFinanceReport(requestCtx, params)
 			if err != nil {
 				return fmt.Errorf("finance reports: failed to download report: %w", err)
 			}
 			defer download.Body.Close()
 
-			compressedSize, err := shared.WriteStreamToFile(compressedPath, download.Body)
+			compressedSize, decompressedSize, err := shared.WriteReportStream(compressedPath, decompressedPath, download.Body)
 			if err != nil {
 				return fmt.Errorf("finance reports: failed to write report: %w", err)
 			}
 
-			var decompressedSize int64
-			if *decompress {
-				decompressedSize, err = shared.DecompressGzipFile(compressedPath, decompressedPath)
-				if err != nil {
-					return fmt.Errorf("finance reports: %w", err)
-				}
-			}
-
 			result := &asc.FinanceReportResult{
 				VendorNumber:      vendorNumber,
 				ReportType:        string(normalizedReportType),
@@ -180,6 +244,19 @@ Examples:
 				DecompressedPath:  decompressedPath,
 				DecompressedBytes: decompressedSize,
 			}
+			if export.Enabled() {
+				path := compressedPath
+				if decompressedPath != "" {
+					path = decompressedPath
+				}
+				rows, err := shared.ExportReports("finance", []shared.ReportExportFile{{ReportDate: reportDate, Path: path}}, export)
+				if err != nil {
+					return fmt.Errorf("finance reports: %w", err)
+				}
+				result.SQLitePath = export.SQLitePath
+				result.ParquetPath = export.ParquetPath
+				result.ExportedRows = rows
+			}
 
 			return shared.PrintOutput(result, *outputFormat, *pretty)
 		},
@@ -0,0 +1,74 @@
+package finance
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type financeBackfillOptions struct {
+	outputDir    string
+	decompress   bool
+	retries      int
+	retryDelay   time.Duration
+	export       shared.ReportExport
+	outputFormat string
+	pretty       bool
+}
+
+// runFinanceBackfill downloads the finance report for each month into
+// {outputDir}/finance/{vendor}/{TYPE}_{REGION}/{date}.tsv.gz.
+func runFinanceBackfill(ctx context.Context, params asc.FinanceReportParams, months []string, opts financeBackfillOptions) error {
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("finance reports: %w", err)
+	}
+
+	seriesDir := filepath.Join(opts.outputDir, "finance", params.VendorNumber,
+		fmt.Sprintf("%s_%s", params.ReportType, params.RegionCode))
+	backfill := shared.ReportBackfill{
+		Dates: months,
+		Paths: func(date string) (string, string) {
+			path := filepath.Join(seriesDir, date+".tsv.gz")
+			return shared.ResolveReportOutputPaths(path, path, ".tsv", opts.decompress)
+		},
+		Download: func(ctx context.Context, date string) (*asc.ReportDownload, error) {
+			monthParams := params
+			monthParams.ReportDate = date
+			return client.DownloadFinanceReport(ctx, monthParams)
+		},
+		Retries:    opts.retries,
+		RetryDelay: opts.retryDelay,
+	}
+
+	items, runErr := backfill.Run(ctx)
+	result := &asc.ReportBackfillResult{
+		Report:       "finance",
+		VendorNumber: params.VendorNumber,
+		From:         months[0],
+		To:           months[len(months)-1],
+		OutputDir:    seriesDir,
+		Items:        items,
+	}
+	shared.SummarizeReportBackfill(result)
+	if opts.export.Enabled() {
+		if err := shared.ExportReportBackfill("finance", result, opts.export); err != nil {
+			return fmt.Errorf("finance reports: %w", err)
+		}
+	}
+
+	if err := shared.PrintOutput(result, opts.outputFormat, opts.pretty); err != nil {
+		return err
+	}
+	if runErr != nil {
+		return fmt.Errorf("finance reports: %w", runErr)
+	}
+	if result.Failed > 0 {
+		return fmt.Errorf("finance reports: %d of %d report months failed", result.Failed, len(items))
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package foreachprofile
+
+import "github.com/peterbourgon/ff/v3/ffcli"
+
+// Command returns the foreach-profile command.
+func Command() *ffcli.Command {
+	return ForeachProfileCommand()
+}
@@ -0,0 +1,277 @@
+package foreachprofile
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const defaultConcurrency = 4
+
+// ProfileRun is the outcome of running the command for one profile.
+type ProfileRun struct {
+	Stdout   []byte
+	Stderr   []byte
+	ExitCode int
+}
+
+var runProfileCommand = runProfileSubprocess
+
+// ForeachProfileResult is the JSON output of foreach-profile.
+type ForeachProfileResult struct {
+	Command   []string               `json:"command"`
+	Succeeded int                    `json:"succeeded"`
+	Failed    int                    `json:"failed"`
+	Profiles  []ForeachProfileOutput `json:"profiles"`
+}
+
+// ForeachProfileOutput is one profile's captured output. Output holds the
+// command's stdout as JSON when it parses, otherwise as a string.
+type ForeachProfileOutput struct {
+	Profile  string          `json:"profile"`
+	ExitCode int             `json:"exitCode"`
+	Output   json.RawMessage `json:"output,omitempty"`
+	Stderr   string          `json:"stderr,omitempty"`
+}
+
+// ForeachProfileCommand returns the foreach-profile command.
+func ForeachProfileCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("foreach-profile", flag.ExitOnError)
+
+	profiles := fs.String("profiles", "", "Comma-separated profile names to run (default: all configured profiles)")
+	concurrency := fs.Int("concurrency", defaultConcurrency, "Maximum number of profiles to run at once")
+	output := fs.String("output", "json", "Output format: json (default), text")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "foreach-profile",
+		ShortUsage: "asc foreach-profile [flags] -- <command...>",
+		ShortHelp:  "Run a command once per configured authentication profile.",
+		LongHelp: `Run a command once per configured authentication profile.
+
+Each run is a separate asc process with ASC_PROFILE set to the profile name,
+so runs share no state. Up to --concurrency profiles run at once.
+
+With --output json (default) the results are collected into one document
+keyed by profile; command output that is JSON is embedded as-is. With
+--output text each line is prefixed with "[profile] ", stderr lines going
+to stderr. Exits non-zero when the command fails for any profile.
+
+Examples:
+  asc foreach-profile -- agreements list
+  asc foreach-profile --profiles "client-a,client-b" -- certificates list --output table
+  asc foreach-profile --concurrency 2 --output text -- auth status`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: a command to run is required after --")
+				return flag.ErrHelp
+			}
+			if args[0] == "foreach-profile" {
+				fmt.Fprintln(os.Stderr, "Error: foreach-profile cannot run itself")
+				return flag.ErrHelp
+			}
+			if *concurrency < 1 {
+				fmt.Fprintln(os.Stderr, "Error: --concurrency must be at least 1")
+				return flag.ErrHelp
+			}
+			format := strings.ToLower(strings.TrimSpace(*output))
+			if format != "json" && format != "text" {
+				fmt.Fprintln(os.Stderr, "Error: --output must be json or text")
+				return flag.ErrHelp
+			}
+			if *pretty && format != "json" {
+				fmt.Fprintln(os.Stderr, "Error: --pretty is only valid with JSON output")
+				return flag.ErrHelp
+			}
+
+			names, err := resolveProfiles(shared.SplitCSV(*profiles))
+			if err != nil {
+				return fmt.Errorf("foreach-profile: %w", err)
+			}
+
+			runs := runAll(ctx, names, args, *concurrency)
+			result := buildResult(names, args, runs)
+
+			if format == "text" {
+				printTaggedOutput(os.Stdout, os.Stderr, names, runs)
+			} else if *pretty {
+				err = asc.PrintPrettyJSON(result)
+			} else {
+				err = asc.PrintJSON(result)
+			}
+			if err != nil {
+				return err
+			}
+
+			if result.Failed > 0 {
+				return fmt.Errorf("foreach-profile: command failed for %d of %d profiles", result.Failed, len(names))
+			}
+			return nil
+		},
+	}
+}
+
+// resolveProfiles returns the requested profiles, or every stored profile in
+// stored order when none are requested.
+func resolveProfiles(requested []string) ([]string, error) {
+	credentials, err := auth.ListCredentials()
+	if err != nil {
+		var warning *auth.CredentialsWarning
+		if !errors.As(err, &warning) {
+			return nil, fmt.Errorf("failed to list profiles: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	stored := make([]string, 0, len(credentials))
+	known := make(map[string]struct{}, len(credentials))
+	for _, cred := range credentials {
+		if _, ok := known[cred.Name]; ok {
+			continue
+		}
+		known[cred.Name] = struct{}{}
+		stored = append(stored, cred.Name)
+	}
+
+	if len(requested) == 0 {
+		if len(stored) == 0 {
+			return nil, fmt.Errorf("no profiles configured; add one with asc auth login --name <profile>")
+		}
+		return stored, nil
+	}
+
+	names := make([]string, 0, len(requested))
+	seen := make(map[string]struct{}, len(requested))
+	for _, name := range requested {
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("profile %q not found", name)
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// runAll runs args for every profile with at most limit runs in flight.
+// Results are returned in profile order.
+func runAll(ctx context.Context, names, args []string, limit int) []ProfileRun {
+	runs := make([]ProfileRun, len(names))
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			runs[i] = runProfileCommand(ctx, name, args)
+		}(i, name)
+	}
+	wg.Wait()
+	return runs
+}
+
+// runProfileSubprocess re-executes the current binary with ASC_PROFILE set.
+func runProfileSubprocess(ctx context.Context, profile string, args []string) ProfileRun {
+	executable, err := os.Executable()
+	if err != nil {
+		return ProfileRun{ExitCode: 1, Stderr: []byte(fmt.Sprintf("failed to locate asc executable: %v", err))}
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, executable, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(), "ASC_PROFILE="+profile, "ASC_SKIP_UPDATE=1")
+
+	run := ProfileRun{}
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			run.ExitCode = exitErr.ExitCode()
+		} else {
+			run.ExitCode = 1
+			fmt.Fprintf(&stderr, "%v\n", err)
+		}
+	}
+	run.Stdout = stdout.Bytes()
+	run.Stderr = stderr.Bytes()
+	return run
+}
+
+func buildResult(names, args []string, runs []ProfileRun) *ForeachProfileResult {
+	result := &ForeachProfileResult{
+		Command:  args,
+		Profiles: make([]ForeachProfileOutput, 0, len(names)),
+	}
+	for i, name := range names {
+		run := runs[i]
+		if run.ExitCode == 0 {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+		result.Profiles = append(result.Profiles, ForeachProfileOutput{
+			Profile:  name,
+			ExitCode: run.ExitCode,
+			Output:   outputJSON(run.Stdout),
+			Stderr:   strings.TrimSpace(string(run.Stderr)),
+		})
+	}
+	return result
+}
+
+// outputJSON embeds stdout unchanged when it is JSON, otherwise as a string.
+func outputJSON(stdout []byte) json.RawMessage {
+	trimmed := bytes.TrimSpace(stdout)
+	if len(trimmed) == 0 {
+		return nil
+	}
+	if json.Valid(trimmed) {
+		return json.RawMessage(trimmed)
+	}
+	encoded, err := json.Marshal(string(trimmed))
+	if err != nil {
+		return nil
+	}
+	return encoded
+}
+
+func printTaggedOutput(stdout, stderr io.Writer, names []string, runs []ProfileRun) {
+	for i, name := range names {
+		prefix := "[" + name + "] "
+		writeTagged(stdout, prefix, runs[i].Stdout)
+		writeTagged(stderr, prefix, runs[i].Stderr)
+		if runs[i].ExitCode != 0 {
+			fmt.Fprintf(stderr, "%sexit code %d\n", prefix, runs[i].ExitCode)
+		}
+	}
+}
+
+func writeTagged(w io.Writer, prefix string, data []byte) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		fmt.Fprintf(w, "%s%s\n", prefix, scanner.Text())
+	}
+}
@@ -0,0 +1,17 @@
+package foreachprofile
+
+import "context"
+
+// SetRunProfileCommand replaces the per-profile runner for tests.
+// It returns a restore function to reset the previous runner.
+func SetRunProfileCommand(fn func(context.Context, string, []string) ProfileRun) func() {
+	previous := runProfileCommand
+	if fn == nil {
+		runProfileCommand = runProfileSubprocess
+	} else {
+		runProfileCommand = fn
+	}
+	return func() {
+		runProfileCommand = previous
+	}
+}
@@ -173,7 +173,7 @@ func GameCenterLeaderboardLocalizationsCreateCommand() *ffcli.Command {
 	leaderboardID := fs.String("leaderboard-id", "", "Game Center leaderboard ID")
 	locale := fs.String("locale", "", "Locale (e.g., en-US, de-DE)")
 	name := fs.String("name", "", "Display name for the leaderboard in this locale")
-	formatterOverride := fs.String("formatter-override", "", "Override the default formatter (optional)")
+	formatterOverride := fs.String("formatter-override", "", "Override the default formatter (optional): "+strings.Join(asc.ValidLeaderboardFormatters, ", "))
 	formatterSuffix := fs.String("formatter-suffix", "", "Suffix to append to formatted score (optional)")
 	formatterSuffixSingular := fs.String("formatter-suffix-singular", "", "Singular suffix (optional)")
 	description := fs.String("description", "", "Description for the leaderboard in this locale (optional)")
@@ -210,6 +210,15 @@ Examples:
 				return flag.ErrHelp
 			}
 
+			var formatterOverrideVal *string
+			if trimmed := strings.ToUpper(strings.TrimSpace(*formatterOverride)); trimmed != "" {
+				if !isValidLeaderboardFormatter(trimmed) {
+					fmt.Fprintf(os.Stderr, "Error: --formatter-override must be one of: %s\n", strings.Join(asc.ValidLeaderboardFormatters, ", "))
+					return flag.ErrHelp
+				}
+				formatterOverrideVal = &trimmed
+			}
+
 			client, err := shared.GetASCClient()
 			if err != nil {
 				return fmt.Errorf("game-center leaderboards localizations create: %w", err)
@@ -218,11 +227,6 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			var formatterOverrideVal *string
-			if trimmed := strings.TrimSpace(*formatterOverride); trimmed != "" {
-				formatterOverrideVal = &trimmed
-			}
-
 			var formatterSuffixVal *string
 			if trimmed := strings.TrimSpace(*formatterSuffix); trimmed != "" {
 				formatterSuffixVal = &trimmed
@@ -263,7 +267,7 @@ func GameCenterLeaderboardLocalizationsUpdateCommand() *ffcli.Command {
 
 	localizationID := fs.String("id", "", "Game Center leaderboard localization ID")
 	name := fs.String("name", "", "Display name for the leaderboard in this locale")
-	formatterOverride := fs.String("formatter-override", "", "Override the default formatter")
+	formatterOverride := fs.String("formatter-override", "", "Override the default formatter: "+strings.Join(asc.ValidLeaderboardFormatters, ", "))
 	formatterSuffix := fs.String("formatter-suffix", "", "Suffix to append to formatted score")
 	formatterSuffixSingular := fs.String("formatter-suffix-singular", "", "Singular suffix")
 	description := fs.String("description", "", "Description for the leaderboard in this locale")
@@ -298,7 +302,11 @@ Examples:
 			}
 
 			if strings.TrimSpace(*formatterOverride) != "" {
-				val := strings.TrimSpace(*formatterOverride)
+				val := strings.ToUpper(strings.TrimSpace(*formatterOverride))
+				if !isValidLeaderboardFormatter(val) {
+					fmt.Fprintf(os.Stderr, "Error: --formatter-override must be one of: %s\n", strings.Join(asc.ValidLeaderboardFormatters, ", "))
+					return flag.ErrHelp
+				}
 				attrs.FormatterOverride = &val
 				hasUpdate = true
 			}
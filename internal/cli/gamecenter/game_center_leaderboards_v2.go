@@ -479,7 +479,7 @@ func GameCenterLeaderboardLocalizationsV2CreateCommand() *ffcli.Command {
 	versionID := fs.String("version-id", "", "Game Center leaderboard version ID")
 	locale := fs.String("locale", "", "Locale (e.g., en-US, de-DE)")
 	name := fs.String("name", "", "Display name for the leaderboard in this locale")
-	formatterOverride := fs.String("formatter-override", "", "Override the default formatter (optional)")
+	formatterOverride := fs.String("formatter-override", "", "Override the default formatter (optional): "+strings.Join(asc.ValidLeaderboardFormatters, ", "))
 	formatterSuffix := fs.String("formatter-suffix", "", "Suffix to append to formatted score (optional)")
 	formatterSuffixSingular := fs.String("formatter-suffix-singular", "", "Singular suffix (optional)")
 	description := fs.String("description", "", "Description for the leaderboard in this locale (optional)")
@@ -517,7 +517,11 @@ Examples:
 			}
 
 			var formatterOverrideVal *string
-			if trimmed := strings.TrimSpace(*formatterOverride); trimmed != "" {
+			if trimmed := strings.ToUpper(strings.TrimSpace(*formatterOverride)); trimmed != "" {
+				if !isValidLeaderboardFormatter(trimmed) {
+					fmt.Fprintf(os.Stderr, "Error: --formatter-override must be one of: %s\n", strings.Join(asc.ValidLeaderboardFormatters, ", "))
+					return flag.ErrHelp
+				}
 				formatterOverrideVal = &trimmed
 			}
 
@@ -569,7 +573,7 @@ func GameCenterLeaderboardLocalizationsV2UpdateCommand() *ffcli.Command {
 
 	localizationID := fs.String("id", "", "Game Center leaderboard localization ID")
 	name := fs.String("name", "", "Display name for the leaderboard in this locale")
-	formatterOverride := fs.String("formatter-override", "", "Override the default formatter")
+	formatterOverride := fs.String("formatter-override", "", "Override the default formatter: "+strings.Join(asc.ValidLeaderboardFormatters, ", "))
 	formatterSuffix := fs.String("formatter-suffix", "", "Suffix to append to formatted score")
 	formatterSuffixSingular := fs.String("formatter-suffix-singular", "", "Singular suffix")
 	description := fs.String("description", "", "Description for the leaderboard in this locale")
@@ -604,7 +608,11 @@ Examples:
 			}
 
 			if strings.TrimSpace(*formatterOverride) != "" {
-				val := strings.TrimSpace(*formatterOverride)
+				val := strings.ToUpper(strings.TrimSpace(*formatterOverride))
+				if !isValidLeaderboardFormatter(val) {
+					fmt.Fprintf(os.Stderr, "Error: --formatter-override must be one of: %s\n", strings.Join(asc.ValidLeaderboardFormatters, ", "))
+					return flag.ErrHelp
+				}
 				attrs.FormatterOverride = &val
 				hasUpdate = true
 			}
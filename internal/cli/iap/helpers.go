@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -27,22 +26,6 @@ func contextWithAssetUploadTimeout(ctx context.Context) (context.Context, contex
 	return context.WithTimeout(ctx, asc.ResolveTimeoutWithDefault(iapAssetUploadDefaultTimeout))
 }
 
-func openImageFile(path string) (*os.File, os.FileInfo, error) {
-	if err := asc.ValidateImageFile(path); err != nil {
-		return nil, nil, err
-	}
-	file, err := shared.OpenExistingNoFollow(path)
-	if err != nil {
-		return nil, nil, err
-	}
-	info, err := file.Stat()
-	if err != nil {
-		_ = file.Close()
-		return nil, nil, err
-	}
-	return file, info, nil
-}
-
 func parseOfferCodeEligibilities(value string) ([]string, error) {
 	values := shared.SplitCSVUpper(value)
 	if len(values) == 0 {
@@ -33,7 +33,10 @@ Examples:
   asc iap localizations list --iap-id "IAP_ID"
   asc iap images create --iap-id "IAP_ID" --file "./image.png"
   asc iap availability set --iap-id "IAP_ID" --territories "USA,CAN"
-  asc iap offer-codes create --iap-id "IAP_ID" --name "SPRING" --prices "USA:PRICE_POINT_ID"`,
+  asc iap tax-treatment --price-point "PRICE_POINT_ID" --territory "FRA,DEU"
+  asc iap offer-codes create --iap-id "IAP_ID" --name "SPRING" --prices "USA:PRICE_POINT_ID"
+  asc iap export --app "APP_ID" --out catalog.yaml
+  asc iap import --file catalog.yaml --app "NEW_APP_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -52,6 +55,7 @@ Examples:
 			IAPContentCommand(),
 			IAPPricePointsCommand(),
 			IAPPriceSchedulesCommand(),
+			IAPTaxTreatmentCommand(),
 			IAPOfferCodesCommand(),
 			IAPSubmitCommand(),
 		},
@@ -227,6 +231,8 @@ func IAPCreateCommand() *ffcli.Command {
 	iapType := fs.String("type", "", "IAP type: CONSUMABLE, NON_CONSUMABLE, NON_RENEWING_SUBSCRIPTION")
 	refName := fs.String("ref-name", "", "Reference name")
 	productID := fs.String("product-id", "", "Product ID (e.g., com.example.product)")
+	reviewNote := fs.String("review-note", "", "Note for App Review")
+	familySharable := fs.Bool("family-sharable", false, "Make the purchase available through Family Sharing")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -236,9 +242,13 @@ func IAPCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a new in-app purchase.",
 		LongHelp: `Create a new in-app purchase.
 
+--type also accepts lowercase and hyphenated names such as non-consumable,
+and non-renewing for NON_RENEWING_SUBSCRIPTION.
+
 Examples:
   asc iap create --app "APP_ID" --type CONSUMABLE --ref-name "Pro" --product-id "com.example.pro"
-  asc iap create --app "APP_ID" --type NON_CONSUMABLE --ref-name "Lifetime" --product-id "com.example.lifetime"`,
+  asc iap create --app "APP_ID" --type NON_CONSUMABLE --ref-name "Lifetime" --product-id "com.example.lifetime"
+  asc iap create --app "APP_ID" --type non-consumable --ref-name "Lifetime" --product-id "com.example.lifetime" --family-sharable --review-note "Unlocks all levels"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -278,6 +288,8 @@ Examples:
 				Name:              name,
 				ProductID:         product,
 				InAppPurchaseType: normalizedType,
+				ReviewNote:        strings.TrimSpace(*reviewNote),
+				FamilySharable:    *familySharable,
 			}
 
 			resp, err := client.CreateInAppPurchaseV2(requestCtx, resolvedAppID, attrs)
@@ -296,6 +308,9 @@ func IAPUpdateCommand() *ffcli.Command {
 
 	iapID := fs.String("id", "", "In-app purchase ID")
 	refName := fs.String("ref-name", "", "Reference name")
+	reviewNote := fs.String("review-note", "", "Note for App Review")
+	var familySharable shared.OptionalBool
+	fs.Var(&familySharable, "family-sharable", "Family Sharing: true or false")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -306,7 +321,9 @@ func IAPUpdateCommand() *ffcli.Command {
 		LongHelp: `Update an in-app purchase.
 
 Examples:
-  asc iap update --id "IAP_ID" --ref-name "New Name"`,
+  asc iap update --id "IAP_ID" --ref-name "New Name"
+  asc iap update --id "IAP_ID" --review-note "Test with the sandbox account in the notes"
+  asc iap update --id "IAP_ID" --family-sharable=false`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -317,7 +334,8 @@ Examples:
 			}
 
 			name := strings.TrimSpace(*refName)
-			if name == "" {
+			note := strings.TrimSpace(*reviewNote)
+			if name == "" && note == "" && !familySharable.IsSet() {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -330,8 +348,16 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			attrs := asc.InAppPurchaseV2UpdateAttributes{
-				Name: &name,
+			attrs := asc.InAppPurchaseV2UpdateAttributes{}
+			if name != "" {
+				attrs.Name = &name
+			}
+			if note != "" {
+				attrs.ReviewNote = &note
+			}
+			if familySharable.IsSet() {
+				value := familySharable.Value()
+				attrs.FamilySharable = &value
 			}
 
 			resp, err := client.UpdateInAppPurchaseV2(requestCtx, id, attrs)
@@ -407,13 +433,17 @@ func IAPLocalizationsCommand() *ffcli.Command {
 		LongHelp: `Manage in-app purchase localizations.
 
 Examples:
-  asc iap localizations list --iap-id "IAP_ID"`,
+  asc iap localizations list --iap-id "IAP_ID"
+  asc iap localizations set --iap-id "IAP_ID" --locale "en-US" --name "Lifetime"
+  asc iap localizations import --iap-id "IAP_ID" --dir "./iap/lifetime"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			IAPLocalizationsListCommand(),
 			IAPLocalizationsCreateCommand(),
 			IAPLocalizationsUpdateCommand(),
+			IAPLocalizationsSetCommand(),
+			IAPLocalizationsImportCommand(),
 			IAPLocalizationsDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -502,10 +532,13 @@ Examples:
 }
 
 func normalizeIAPType(value string) (string, error) {
-	normalized := strings.TrimSpace(strings.ToUpper(value))
+	normalized := strings.ReplaceAll(strings.TrimSpace(strings.ToUpper(value)), "-", "_")
 	if normalized == "" {
 		return "", fmt.Errorf("--type is required")
 	}
+	if normalized == "NON_RENEWING" {
+		normalized = string(asc.InAppPurchaseTypeNonRenewingSubscription)
+	}
 	for _, option := range asc.ValidIAPTypes {
 		if normalized == option {
 			return normalized, nil
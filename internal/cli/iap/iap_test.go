@@ -0,0 +1,32 @@
+package iap
+
+import "testing"
+
+func TestNormalizeIAPType(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "CONSUMABLE", want: "CONSUMABLE"},
+		{input: "consumable", want: "CONSUMABLE"},
+		{input: "non-consumable", want: "NON_CONSUMABLE"},
+		{input: " Non_Consumable ", want: "NON_CONSUMABLE"},
+		{input: "non-renewing", want: "NON_RENEWING_SUBSCRIPTION"},
+		{input: "NON_RENEWING_SUBSCRIPTION", want: "NON_RENEWING_SUBSCRIPTION"},
+		{input: "auto-renewable", wantErr: true},
+		{input: "", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := normalizeIAPType(test.input)
+		if test.wantErr {
+			if err == nil {
+				t.Fatalf("normalizeIAPType(%q): expected error, got %q", test.input, got)
+			}
+			continue
+		}
+		if err != nil || got != test.want {
+			t.Fatalf("normalizeIAPType(%q) = %q, %v; want %q", test.input, got, err, test.want)
+		}
+	}
+}
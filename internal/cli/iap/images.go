@@ -194,7 +194,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			file, info, err := openImageFile(pathValue)
+			file, info, err := shared.OpenImageFile(pathValue)
 			if err != nil {
 				return fmt.Errorf("iap images create: %w", err)
 			}
@@ -274,7 +274,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			file, info, err := openImageFile(pathValue)
+			file, info, err := shared.OpenImageFile(pathValue)
 			if err != nil {
 				return fmt.Errorf("iap images update: %w", err)
 			}
@@ -0,0 +1,319 @@
+package iap
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// IAP localization import actions.
+const (
+	iapLocalizationCreate    = "create"
+	iapLocalizationUpdate    = "update"
+	iapLocalizationUnchanged = "unchanged"
+)
+
+// iapLocalizationValues is the text of one locale. Empty values are left
+// unchanged on update.
+type iapLocalizationValues struct {
+	locale      string
+	name        string
+	description string
+}
+
+// IAPLocalizationsSetCommand returns the localizations set subcommand.
+func IAPLocalizationsSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations set", flag.ExitOnError)
+
+	iapID := fs.String("iap-id", "", "In-app purchase ID")
+	locale := fs.String("locale", "", "Locale (e.g., en-US)")
+	name := fs.String("name", "", "Localization name (required when the locale is new)")
+	description := fs.String("description", "", "Description")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc iap localizations set --iap-id \"IAP_ID\" --locale \"en-US\" [--name \"Name\"] [--description \"Description\"]",
+		ShortHelp:  "Create or update the localization of a locale.",
+		LongHelp: `Create or update the localization of a locale.
+
+Updates the in-app purchase's localization for --locale when it exists and
+creates it otherwise, so the command can be rerun safely.
+
+Examples:
+  asc iap localizations set --iap-id "IAP_ID" --locale "en-US" --name "Lifetime" --description "Unlock everything"
+  asc iap localizations set --iap-id "IAP_ID" --locale "de-DE" --description "Alles freischalten"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			iapValue := strings.TrimSpace(*iapID)
+			if iapValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --iap-id is required")
+				return flag.ErrHelp
+			}
+			localeValue := strings.TrimSpace(*locale)
+			if localeValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --locale is required")
+				return flag.ErrHelp
+			}
+			values := iapLocalizationValues{
+				locale:      localeValue,
+				name:        strings.TrimSpace(*name),
+				description: strings.TrimSpace(*description),
+			}
+			if values.name == "" && values.description == "" {
+				fmt.Fprintln(os.Stderr, "Error: --name or --description is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("iap localizations set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			existing, err := fetchIAPLocalizations(requestCtx, client, iapValue)
+			if err != nil {
+				return fmt.Errorf("iap localizations set: %w", err)
+			}
+
+			if current, ok := existing[localeValue]; ok {
+				resp, err := client.UpdateInAppPurchaseLocalization(requestCtx, current.ID, iapLocalizationUpdateAttributes(values))
+				if err != nil {
+					return fmt.Errorf("iap localizations set: failed to update: %w", err)
+				}
+				return shared.PrintOutput(resp, *output, *pretty)
+			}
+
+			if values.name == "" {
+				return fmt.Errorf("iap localizations set: --name is required to create the %s localization", localeValue)
+			}
+			resp, err := client.CreateInAppPurchaseLocalization(requestCtx, iapValue, asc.InAppPurchaseLocalizationCreateAttributes{
+				Name:        values.name,
+				Locale:      values.locale,
+				Description: values.description,
+			})
+			if err != nil {
+				return fmt.Errorf("iap localizations set: failed to create: %w", err)
+			}
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// IAPLocalizationsImportCommand returns the localizations import subcommand.
+func IAPLocalizationsImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations import", flag.ExitOnError)
+
+	iapID := fs.String("iap-id", "", "In-app purchase ID")
+	dir := fs.String("dir", "", "Directory laid out as <locale>/name.txt and <locale>/description.txt")
+	dryRun := fs.Bool("dry-run", false, "Show the changes without applying them")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc iap localizations import --iap-id \"IAP_ID\" --dir \"./iap/lifetime\" [flags]",
+		ShortHelp:  "Create or update localizations from a directory.",
+		LongHelp: `Create or update localizations from a directory.
+
+Reads the same per-locale text file layout as "asc migrate import":
+  <dir>/
+  ├── en-US/
+  │   ├── name.txt
+  │   └── description.txt
+  └── de-DE/
+      └── ...
+
+Existing localizations are updated with the files that are present and new
+locales are created, which needs name.txt. Locales already matching the
+files are left unchanged, and localizations without a directory are kept.
+Every locale is checked before anything is changed.
+
+Examples:
+  asc iap localizations import --iap-id "IAP_ID" --dir "./iap/lifetime" --dry-run
+  asc iap localizations import --iap-id "IAP_ID" --dir "./iap/lifetime" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			iapValue := strings.TrimSpace(*iapID)
+			if iapValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --iap-id is required")
+				return flag.ErrHelp
+			}
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+
+			locales, err := readIAPLocalizationDir(dirValue)
+			if err != nil {
+				return fmt.Errorf("iap localizations import: %w", err)
+			}
+			if len(locales) == 0 {
+				return fmt.Errorf("iap localizations import: no name.txt or description.txt found in %s", dirValue)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("iap localizations import: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			existing, err := fetchIAPLocalizations(requestCtx, client, iapValue)
+			if err != nil {
+				return fmt.Errorf("iap localizations import: %w", err)
+			}
+
+			result := &asc.IAPLocalizationImportResult{
+				IAPID:   iapValue,
+				Dir:     dirValue,
+				DryRun:  *dryRun,
+				Locales: planIAPLocalizationImport(locales, existing),
+			}
+			for _, item := range result.Locales {
+				if item.Action == iapLocalizationCreate && item.Name == "" {
+					return fmt.Errorf("iap localizations import: %s: name.txt is required for a new localization", item.Locale)
+				}
+			}
+
+			if !*dryRun {
+				for i, item := range result.Locales {
+					values := iapLocalizationValues{locale: item.Locale, name: item.Name, description: item.Description}
+					switch item.Action {
+					case iapLocalizationUpdate:
+						if _, err := client.UpdateInAppPurchaseLocalization(requestCtx, item.LocalizationID, iapLocalizationUpdateAttributes(values)); err != nil {
+							return fmt.Errorf("iap localizations import: %s: failed to update: %w", item.Locale, err)
+						}
+					case iapLocalizationCreate:
+						resp, err := client.CreateInAppPurchaseLocalization(requestCtx, iapValue, asc.InAppPurchaseLocalizationCreateAttributes{
+							Name:        values.name,
+							Locale:      values.locale,
+							Description: values.description,
+						})
+						if err != nil {
+							return fmt.Errorf("iap localizations import: %s: failed to create: %w", item.Locale, err)
+						}
+						result.Locales[i].LocalizationID = resp.Data.ID
+					}
+				}
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// fetchIAPLocalizations returns the localizations of an IAP keyed by locale.
+func fetchIAPLocalizations(ctx context.Context, client *asc.Client, iapID string) (map[string]asc.Resource[asc.InAppPurchaseLocalizationAttributes], error) {
+	firstPage, err := client.GetInAppPurchaseLocalizations(ctx, iapID, asc.WithIAPLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetInAppPurchaseLocalizations(ctx, iapID, asc.WithIAPLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	resp, ok := all.(*asc.InAppPurchaseLocalizationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected localizations response type %T", all)
+	}
+	byLocale := make(map[string]asc.Resource[asc.InAppPurchaseLocalizationAttributes], len(resp.Data))
+	for _, loc := range resp.Data {
+		byLocale[loc.Attributes.Locale] = loc
+	}
+	return byLocale, nil
+}
+
+// planIAPLocalizationImport decides the action for each locale. Only the
+// values that are set take part in the comparison.
+func planIAPLocalizationImport(locales []iapLocalizationValues, existing map[string]asc.Resource[asc.InAppPurchaseLocalizationAttributes]) []asc.IAPLocalizationImportItem {
+	items := make([]asc.IAPLocalizationImportItem, 0, len(locales))
+	for _, values := range locales {
+		item := asc.IAPLocalizationImportItem{
+			Locale:      values.locale,
+			Action:      iapLocalizationCreate,
+			Name:        values.name,
+			Description: values.description,
+		}
+		if current, ok := existing[values.locale]; ok {
+			item.LocalizationID = current.ID
+			item.Action = iapLocalizationUnchanged
+			if (values.name != "" && values.name != strings.TrimSpace(current.Attributes.Name)) ||
+				(values.description != "" && values.description != strings.TrimSpace(current.Attributes.Description)) {
+				item.Action = iapLocalizationUpdate
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func iapLocalizationUpdateAttributes(values iapLocalizationValues) asc.InAppPurchaseLocalizationUpdateAttributes {
+	attrs := asc.InAppPurchaseLocalizationUpdateAttributes{}
+	if values.name != "" {
+		attrs.Name = &values.name
+	}
+	if values.description != "" {
+		attrs.Description = &values.description
+	}
+	return attrs
+}
+
+// readIAPLocalizationDir reads <locale>/name.txt and <locale>/description.txt,
+// sorted by locale. Locales without either file are skipped.
+func readIAPLocalizationDir(dir string) ([]iapLocalizationValues, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("directory not found: %s", dir)
+		}
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	var locales []iapLocalizationValues
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		locale := entry.Name()
+		if !shared.IsValidLocale(locale) {
+			return nil, fmt.Errorf("invalid locale directory %q", locale)
+		}
+		values := iapLocalizationValues{locale: locale}
+		for file, target := range map[string]*string{"name.txt": &values.name, "description.txt": &values.description} {
+			data, err := os.ReadFile(filepath.Join(dir, locale, file))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to read %s/%s: %w", locale, file, err)
+			}
+			*target = strings.TrimSpace(string(data))
+		}
+		if values.name == "" && values.description == "" {
+			continue
+		}
+		locales = append(locales, values)
+	}
+	sort.Slice(locales, func(i, j int) bool { return locales[i].locale < locales[j].locale })
+	return locales, nil
+}
@@ -25,11 +25,13 @@ func IAPPricePointsCommand() *ffcli.Command {
 
 Examples:
   asc iap price-points list --iap-id "IAP_ID"
+  asc iap price-points find --iap-id "IAP_ID" --price 4.99
   asc iap price-points equalizations --id "PRICE_POINT_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			IAPPricePointsListCommand(),
+			IAPPricePointsFindCommand(),
 			IAPPricePointsEqualizationsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
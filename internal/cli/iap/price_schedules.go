@@ -26,6 +26,7 @@ func IAPPriceSchedulesCommand() *ffcli.Command {
 Examples:
   asc iap price-schedules get --iap-id "IAP_ID"
   asc iap price-schedules create --iap-id "IAP_ID" --base-territory "USA" --prices "PRICE_POINT_ID:2024-03-01"
+  asc iap price-schedules set --iap-id "IAP_ID" --price 4.99
   asc iap price-schedules manual-prices --schedule-id "SCHEDULE_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -33,6 +34,7 @@ Examples:
 			IAPPriceSchedulesGetCommand(),
 			IAPPriceSchedulesBaseTerritoryCommand(),
 			IAPPriceSchedulesCreateCommand(),
+			IAPPriceSchedulesSetCommand(),
 			IAPPriceSchedulesManualPricesCommand(),
 			IAPPriceSchedulesAutomaticPricesCommand(),
 		},
@@ -0,0 +1,215 @@
+package iap
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const defaultIAPBaseTerritory = "USA"
+
+// IAPPriceSchedulesSetCommand returns the price schedules set subcommand.
+func IAPPriceSchedulesSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("price-schedules set", flag.ExitOnError)
+
+	iapID := fs.String("iap-id", "", "In-app purchase ID")
+	pricePoint := fs.String("price-point", "", "Price point ID")
+	price := fs.String("price", "", "Customer price in the base territory (e.g., 4.99), resolved to its price point")
+	baseTerritory := fs.String("base-territory", defaultIAPBaseTerritory, "Base territory ID")
+	startDate := fs.String("start-date", "", "Start date (YYYY-MM-DD); defaults to immediately")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc iap price-schedules set --iap-id \"IAP_ID\" (--price-point \"PRICE_POINT_ID\" | --price 4.99) [flags]",
+		ShortHelp:  "Set the price of an in-app purchase.",
+		LongHelp: `Set the price of an in-app purchase.
+
+Creates a price schedule with a single manual price in the base territory.
+Other territories are priced automatically from it. Pass the price point ID
+with --price-point, or the customer price with --price to look up the
+matching price point of the base territory.
+
+Examples:
+  asc iap price-schedules set --iap-id "IAP_ID" --price 4.99
+  asc iap price-schedules set --iap-id "IAP_ID" --price-point "PRICE_POINT_ID" --start-date "2026-03-01"
+  asc iap price-schedules set --iap-id "IAP_ID" --price 5.99 --base-territory "GBR"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			iapValue := strings.TrimSpace(*iapID)
+			if iapValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --iap-id is required")
+				return flag.ErrHelp
+			}
+			pricePointValue := strings.TrimSpace(*pricePoint)
+			priceValue := strings.TrimSpace(*price)
+			if pricePointValue == "" && priceValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --price-point or --price is required")
+				return flag.ErrHelp
+			}
+			if pricePointValue != "" && priceValue != "" {
+				fmt.Fprintln(os.Stderr, "Error: --price-point and --price are mutually exclusive")
+				return flag.ErrHelp
+			}
+			territoryValue := strings.ToUpper(strings.TrimSpace(*baseTerritory))
+			if territoryValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --base-territory is required")
+				return flag.ErrHelp
+			}
+			startDateValue := strings.TrimSpace(*startDate)
+			if startDateValue != "" {
+				normalized, err := normalizeIAPDate(startDateValue, "--start-date")
+				if err != nil {
+					fmt.Fprintln(os.Stderr, "Error:", err.Error())
+					return flag.ErrHelp
+				}
+				startDateValue = normalized
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("iap price-schedules set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if priceValue != "" {
+				match, err := findIAPPricePointByPrice(requestCtx, client, iapValue, territoryValue, priceValue)
+				if err != nil {
+					return fmt.Errorf("iap price-schedules set: %w", err)
+				}
+				pricePointValue = match.ID
+			}
+
+			resp, err := client.CreateInAppPurchasePriceSchedule(requestCtx, iapValue, asc.InAppPurchasePriceScheduleCreateAttributes{
+				BaseTerritoryID: territoryValue,
+				Prices: []asc.InAppPurchasePriceSchedulePrice{
+					{PricePointID: pricePointValue, StartDate: startDateValue},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("iap price-schedules set: failed to create: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// IAPPricePointsFindCommand returns the price points find subcommand.
+func IAPPricePointsFindCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("price-points find", flag.ExitOnError)
+
+	iapID := fs.String("iap-id", "", "In-app purchase ID")
+	price := fs.String("price", "", "Customer price to look up (e.g., 4.99)")
+	territory := fs.String("territory", defaultIAPBaseTerritory, "Territory ID")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "find",
+		ShortUsage: "asc iap price-points find --iap-id \"IAP_ID\" --price 4.99 [--territory \"USA\"]",
+		ShortHelp:  "Find the price point for a customer price.",
+		LongHelp: `Find the price point for a customer price.
+
+Looks up the price point of the territory whose customer price equals --price,
+for use with "asc iap price-schedules set --price-point".
+
+Examples:
+  asc iap price-points find --iap-id "IAP_ID" --price 4.99
+  asc iap price-points find --iap-id "IAP_ID" --price 4.49 --territory "GBR"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			iapValue := strings.TrimSpace(*iapID)
+			if iapValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --iap-id is required")
+				return flag.ErrHelp
+			}
+			priceValue := strings.TrimSpace(*price)
+			if priceValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --price is required")
+				return flag.ErrHelp
+			}
+			territoryValue := strings.ToUpper(strings.TrimSpace(*territory))
+			if territoryValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --territory is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("iap price-points find: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			match, err := findIAPPricePointByPrice(requestCtx, client, iapValue, territoryValue, priceValue)
+			if err != nil {
+				return fmt.Errorf("iap price-points find: %w", err)
+			}
+
+			return shared.PrintOutput(&asc.InAppPurchasePricePointsResponse{
+				Data: []asc.Resource[asc.InAppPurchasePricePointAttributes]{match},
+			}, *output, *pretty)
+		},
+	}
+}
+
+// findIAPPricePointByPrice returns the price point of territoryID whose
+// customer price equals price.
+func findIAPPricePointByPrice(ctx context.Context, client *asc.Client, iapID, territoryID, price string) (asc.Resource[asc.InAppPurchasePricePointAttributes], error) {
+	if _, ok := new(big.Rat).SetString(price); !ok {
+		return asc.Resource[asc.InAppPurchasePricePointAttributes]{}, fmt.Errorf("--price must be a number, got %q", price)
+	}
+
+	firstPage, err := client.GetInAppPurchasePricePoints(
+		ctx,
+		iapID,
+		asc.WithIAPPricePointsTerritory(territoryID),
+		asc.WithIAPPricePointsFields([]string{"customerPrice", "proceeds"}),
+		asc.WithIAPPricePointsLimit(8000),
+	)
+	if err != nil {
+		return asc.Resource[asc.InAppPurchasePricePointAttributes]{}, fmt.Errorf("fetch price points: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetInAppPurchasePricePoints(ctx, iapID, asc.WithIAPPricePointsNextURL(nextURL))
+	})
+	if err != nil {
+		return asc.Resource[asc.InAppPurchasePricePointAttributes]{}, fmt.Errorf("paginate price points: %w", err)
+	}
+	resp, ok := paginated.(*asc.InAppPurchasePricePointsResponse)
+	if !ok {
+		return asc.Resource[asc.InAppPurchasePricePointAttributes]{}, fmt.Errorf("unexpected price points response type %T", paginated)
+	}
+
+	match, ok := matchIAPPricePoint(resp.Data, price)
+	if !ok {
+		return asc.Resource[asc.InAppPurchasePricePointAttributes]{}, fmt.Errorf("no %s price point with customer price %s", territoryID, price)
+	}
+	return match, nil
+}
+
+// matchIAPPricePoint returns the price point whose customer price equals
+// price numerically, so "5", "5.0" and "5.00" all match.
+func matchIAPPricePoint(points []asc.Resource[asc.InAppPurchasePricePointAttributes], price string) (asc.Resource[asc.InAppPurchasePricePointAttributes], bool) {
+	for _, point := range points {
+		if shared.SameCustomerPrice(point.Attributes.CustomerPrice, price) {
+			return point, true
+		}
+	}
+	return asc.Resource[asc.InAppPurchasePricePointAttributes]{}, false
+}
@@ -105,3 +105,21 @@ func TestParseManualSchedulePricePointValues_DecodesLegacyPricePointIDs(t *testi
 		t.Fatalf("unexpected value: %#v", value)
 	}
 }
+
+func TestMatchIAPPricePoint_ComparesNumerically(t *testing.T) {
+	points := []asc.Resource[asc.InAppPurchasePricePointAttributes]{
+		{ID: "PP_099", Attributes: asc.InAppPurchasePricePointAttributes{CustomerPrice: "0.99"}},
+		{ID: "PP_500", Attributes: asc.InAppPurchasePricePointAttributes{CustomerPrice: "5.0"}},
+	}
+
+	match, ok := matchIAPPricePoint(points, "5.00")
+	if !ok || match.ID != "PP_500" {
+		t.Fatalf("expected PP_500, got %q (ok=%v)", match.ID, ok)
+	}
+	if _, ok := matchIAPPricePoint(points, "4.99"); ok {
+		t.Fatal("expected no match for 4.99")
+	}
+	if _, ok := matchIAPPricePoint(points, "abc"); ok {
+		t.Fatal("expected no match for a non-numeric price")
+	}
+}
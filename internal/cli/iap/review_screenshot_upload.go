@@ -0,0 +1,155 @@
+package iap
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var iapReviewScreenshotPollInterval = 2 * time.Second
+
+// IAPReviewScreenshotsUploadCommand returns the review screenshots upload subcommand.
+func IAPReviewScreenshotsUploadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("review-screenshots upload", flag.ExitOnError)
+
+	iapID := fs.String("iap-id", "", "In-app purchase ID")
+	filePath := fs.String("file", "", "Path to screenshot file")
+	wait := fs.Bool("wait", false, "Wait until App Store Connect has processed the screenshot")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "upload",
+		ShortUsage: "asc iap review-screenshots upload --iap-id \"IAP_ID\" --file \"./review.png\" [--wait]",
+		ShortHelp:  "Upload or replace the review screenshot of an in-app purchase.",
+		LongHelp: `Upload or replace the review screenshot of an in-app purchase.
+
+An in-app purchase needs a review screenshot before it can be submitted.
+This reserves the upload, uploads the file, and commits it. When the in-app
+purchase already has a screenshot, it is deleted once the new one is
+committed, so the command can be rerun safely.
+
+Examples:
+  asc iap review-screenshots upload --iap-id "IAP_ID" --file "./review.png"
+  asc iap review-screenshots upload --iap-id "IAP_ID" --file "./review.png" --wait`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			iapValue := strings.TrimSpace(*iapID)
+			if iapValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --iap-id is required")
+				return flag.ErrHelp
+			}
+			pathValue := strings.TrimSpace(*filePath)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			file, info, err := shared.OpenImageFile(pathValue)
+			if err != nil {
+				return fmt.Errorf("iap review-screenshots upload: %w", err)
+			}
+			defer file.Close()
+
+			checksum, err := asc.ComputeChecksumFromReader(file, asc.ChecksumAlgorithmMD5)
+			if err != nil {
+				return fmt.Errorf("iap review-screenshots upload: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("iap review-screenshots upload: %w", err)
+			}
+
+			requestCtx, cancel := contextWithAssetUploadTimeout(ctx)
+			defer cancel()
+
+			previousID := ""
+			existing, err := client.GetInAppPurchaseAppStoreReviewScreenshotForIAP(requestCtx, iapValue)
+			if err != nil && !asc.IsNotFound(err) {
+				return fmt.Errorf("iap review-screenshots upload: failed to fetch existing screenshot: %w", err)
+			}
+			if err == nil && existing != nil {
+				previousID = strings.TrimSpace(existing.Data.ID)
+			}
+
+			created, err := client.CreateInAppPurchaseAppStoreReviewScreenshot(requestCtx, iapValue, info.Name(), info.Size())
+			if err != nil {
+				return fmt.Errorf("iap review-screenshots upload: failed to create: %w", err)
+			}
+			if created == nil || len(created.Data.Attributes.UploadOperations) == 0 {
+				return fmt.Errorf("iap review-screenshots upload: no upload operations returned")
+			}
+
+			if err := asc.UploadAssetFromFile(requestCtx, file, info.Size(), created.Data.Attributes.UploadOperations); err != nil {
+				return fmt.Errorf("iap review-screenshots upload: upload failed: %w", err)
+			}
+
+			uploaded := true
+			resp, err := client.UpdateInAppPurchaseAppStoreReviewScreenshot(requestCtx, created.Data.ID, asc.InAppPurchaseAppStoreReviewScreenshotUpdateAttributes{
+				Uploaded:           &uploaded,
+				SourceFileChecksum: &checksum.Hash,
+			})
+			if err != nil {
+				return fmt.Errorf("iap review-screenshots upload: failed to commit upload: %w", err)
+			}
+
+			if previousID != "" && previousID != created.Data.ID {
+				if err := client.DeleteInAppPurchaseAppStoreReviewScreenshot(requestCtx, previousID); err != nil && !asc.IsNotFound(err) {
+					return fmt.Errorf("iap review-screenshots upload: failed to delete previous screenshot: %w", err)
+				}
+			}
+
+			if *wait {
+				resp, err = waitForIAPReviewScreenshotDelivery(requestCtx, client, created.Data.ID)
+				if err != nil {
+					return fmt.Errorf("iap review-screenshots upload: %w", err)
+				}
+			}
+
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// waitForIAPReviewScreenshotDelivery polls a committed review screenshot until
+// its delivery completes or fails.
+func waitForIAPReviewScreenshotDelivery(ctx context.Context, client *asc.Client, screenshotID string) (*asc.InAppPurchaseAppStoreReviewScreenshotResponse, error) {
+	ticker := time.NewTicker(iapReviewScreenshotPollInterval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := client.GetInAppPurchaseAppStoreReviewScreenshot(ctx, screenshotID)
+		if err != nil {
+			return nil, err
+		}
+
+		if delivery := resp.Data.Attributes.AssetDeliveryState; delivery != nil && delivery.State != nil {
+			switch strings.ToUpper(strings.TrimSpace(*delivery.State)) {
+			case "COMPLETE":
+				return resp, nil
+			case "FAILED":
+				details := make([]string, 0, len(delivery.Errors))
+				for _, detail := range delivery.Errors {
+					details = append(details, strings.TrimSpace(detail.Code+" "+detail.Message))
+				}
+				return resp, fmt.Errorf("screenshot %s delivery failed: %s", screenshotID, strings.Join(details, "; "))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for screenshot %s delivery: %w", screenshotID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
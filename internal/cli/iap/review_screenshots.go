@@ -26,6 +26,7 @@ func IAPReviewScreenshotsCommand() *ffcli.Command {
 Examples:
   asc iap review-screenshots get --iap-id "IAP_ID"
   asc iap review-screenshots create --iap-id "IAP_ID" --file "./review.png"
+  asc iap review-screenshots upload --iap-id "IAP_ID" --file "./review.png" --wait
   asc iap review-screenshots update --screenshot-id "SHOT_ID" --file "./review.png"
   asc iap review-screenshots delete --screenshot-id "SHOT_ID" --confirm`,
 		FlagSet:   fs,
@@ -33,6 +34,7 @@ Examples:
 		Subcommands: []*ffcli.Command{
 			IAPReviewScreenshotsGetCommand(),
 			IAPReviewScreenshotsCreateCommand(),
+			IAPReviewScreenshotsUploadCommand(),
 			IAPReviewScreenshotsUpdateCommand(),
 			IAPReviewScreenshotsDeleteCommand(),
 		},
@@ -127,7 +129,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			file, info, err := openImageFile(pathValue)
+			file, info, err := shared.OpenImageFile(pathValue)
 			if err != nil {
 				return fmt.Errorf("iap review-screenshots create: %w", err)
 			}
@@ -207,7 +209,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			file, info, err := openImageFile(pathValue)
+			file, info, err := shared.OpenImageFile(pathValue)
 			if err != nil {
 				return fmt.Errorf("iap review-screenshots update: %w", err)
 			}
@@ -17,6 +17,8 @@ func IAPSubmitCommand() *ffcli.Command {
 
 	iapID := fs.String("iap-id", "", "In-app purchase ID")
 	confirm := fs.Bool("confirm", false, "Confirm submission")
+	dryRun := fs.Bool("dry-run", false, "Only run the preflight checks")
+	skipPreflight := fs.Bool("skip-preflight", false, "Submit without checking localization, pricing, and review screenshot")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -26,7 +28,12 @@ func IAPSubmitCommand() *ffcli.Command {
 		ShortHelp:  "Submit an in-app purchase for review.",
 		LongHelp: `Submit an in-app purchase for review.
 
+Before submitting, checks that the in-app purchase has a localization, a
+price, and a review screenshot. When any is missing, the checks are printed
+and nothing is submitted.
+
 Examples:
+  asc iap submit --iap-id "IAP_ID" --dry-run
   asc iap submit --iap-id "IAP_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -36,10 +43,14 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --iap-id is required")
 				return flag.ErrHelp
 			}
-			if !*confirm {
+			if !*confirm && !*dryRun {
 				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
 				return flag.ErrHelp
 			}
+			if *dryRun && *skipPreflight {
+				fmt.Fprintln(os.Stderr, "Error: --dry-run and --skip-preflight are mutually exclusive")
+				return flag.ErrHelp
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -49,6 +60,24 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if !*skipPreflight {
+				preflight, err := runIAPSubmitPreflight(requestCtx, client, iapValue)
+				if err != nil {
+					return fmt.Errorf("iap submit: %w", err)
+				}
+				if *dryRun || !preflight.Ready {
+					if err := shared.PrintOutput(preflight, *output, *pretty); err != nil {
+						return err
+					}
+				}
+				if !preflight.Ready {
+					return shared.NewReportedError(fmt.Errorf("iap submit: missing %s", strings.Join(preflight.Missing, ", ")))
+				}
+				if *dryRun {
+					return nil
+				}
+			}
+
 			resp, err := client.CreateInAppPurchaseSubmission(requestCtx, iapValue)
 			if err != nil {
 				return fmt.Errorf("iap submit: failed to submit: %w", err)
@@ -0,0 +1,103 @@
+package iap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// IAP submission preflight check names.
+const (
+	iapCheckLocalization     = "localization"
+	iapCheckPricing          = "pricing"
+	iapCheckReviewScreenshot = "review-screenshot"
+)
+
+// runIAPSubmitPreflight checks that the IAP has a localization, a price, and a
+// review screenshot.
+func runIAPSubmitPreflight(ctx context.Context, client *asc.Client, iapID string) (*asc.IAPSubmitPreflightResult, error) {
+	checks := []func(context.Context, *asc.Client, string) (asc.IAPSubmitCheck, error){
+		checkIAPLocalizations,
+		checkIAPPricing,
+		checkIAPReviewScreenshot,
+	}
+
+	result := &asc.IAPSubmitPreflightResult{IAPID: iapID, Missing: []string{}}
+	for _, check := range checks {
+		item, err := check(ctx, client, iapID)
+		if err != nil {
+			return nil, err
+		}
+		result.Checks = append(result.Checks, item)
+		if !item.Passed {
+			result.Missing = append(result.Missing, item.Name)
+		}
+	}
+	result.Ready = len(result.Missing) == 0
+	return result, nil
+}
+
+func checkIAPLocalizations(ctx context.Context, client *asc.Client, iapID string) (asc.IAPSubmitCheck, error) {
+	resp, err := client.GetInAppPurchaseLocalizations(ctx, iapID, asc.WithIAPLocalizationsLimit(200))
+	if err != nil {
+		return asc.IAPSubmitCheck{}, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	locales := make([]string, 0, len(resp.Data))
+	for _, loc := range resp.Data {
+		locales = append(locales, loc.Attributes.Locale)
+	}
+	if len(locales) == 0 {
+		return asc.IAPSubmitCheck{Name: iapCheckLocalization, Detail: "no localizations; add one with \"asc iap localizations set\""}, nil
+	}
+	return asc.IAPSubmitCheck{Name: iapCheckLocalization, Passed: true, Detail: strings.Join(locales, ", ")}, nil
+}
+
+func checkIAPPricing(ctx context.Context, client *asc.Client, iapID string) (asc.IAPSubmitCheck, error) {
+	missing := asc.IAPSubmitCheck{Name: iapCheckPricing, Detail: "no price set; add one with \"asc iap price-schedules set\""}
+
+	schedule, err := client.GetInAppPurchasePriceSchedule(ctx, iapID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return missing, nil
+		}
+		return asc.IAPSubmitCheck{}, fmt.Errorf("failed to fetch price schedule: %w", err)
+	}
+	scheduleID := strings.TrimSpace(schedule.Data.ID)
+	if scheduleID == "" {
+		return missing, nil
+	}
+
+	prices, err := client.GetInAppPurchasePriceScheduleManualPrices(ctx, scheduleID, asc.WithIAPPriceSchedulePricesLimit(1))
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return missing, nil
+		}
+		return asc.IAPSubmitCheck{}, fmt.Errorf("failed to fetch prices: %w", err)
+	}
+	if len(prices.Data) == 0 {
+		return missing, nil
+	}
+	return asc.IAPSubmitCheck{Name: iapCheckPricing, Passed: true, Detail: "price schedule " + scheduleID}, nil
+}
+
+func checkIAPReviewScreenshot(ctx context.Context, client *asc.Client, iapID string) (asc.IAPSubmitCheck, error) {
+	missing := asc.IAPSubmitCheck{Name: iapCheckReviewScreenshot, Detail: "no review screenshot; add one with \"asc iap review-screenshots upload\""}
+
+	resp, err := client.GetInAppPurchaseAppStoreReviewScreenshotForIAP(ctx, iapID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return missing, nil
+		}
+		return asc.IAPSubmitCheck{}, fmt.Errorf("failed to fetch review screenshot: %w", err)
+	}
+	if strings.TrimSpace(resp.Data.ID) == "" {
+		return missing, nil
+	}
+	detail := resp.Data.Attributes.FileName
+	if detail == "" {
+		detail = resp.Data.ID
+	}
+	return asc.IAPSubmitCheck{Name: iapCheckReviewScreenshot, Passed: true, Detail: detail}, nil
+}
@@ -0,0 +1,148 @@
+package iap
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// IAPTaxTreatmentCommand returns the tax-treatment subcommand.
+func IAPTaxTreatmentCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("tax-treatment", flag.ExitOnError)
+
+	pricePointID := fs.String("price-point", "", "In-app purchase price point ID to compare across territories")
+	territories := fs.String("territory", "", "Comma-separated territory IDs to include (e.g., FRA,DEU); defaults to all")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "tax-treatment",
+		ShortUsage: "asc iap tax-treatment --price-point \"PRICE_POINT_ID\" [flags]",
+		ShortHelp:  "Show territory-specific proceeds for an in-app purchase price point.",
+		LongHelp: `Show territory-specific proceeds for an in-app purchase price point.
+
+Lists the equalized customer price and proceeds in each territory, with
+proceeds as a percentage of the customer price. Differences between
+territories reflect the taxes Apple collects and remits there.
+
+The App Store Connect API does not expose tax categories or contract
+regions; set those in App Store Connect under Business and the product's
+pricing page.
+
+Examples:
+  asc iap tax-treatment --price-point "PRICE_POINT_ID"
+  asc iap tax-treatment --price-point "PRICE_POINT_ID" --territory "FRA,DEU,JPN" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*pricePointID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --price-point is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("iap tax-treatment: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.IAPPricePointsOption{
+				asc.WithIAPPricePointsInclude([]string{"territory"}),
+				asc.WithIAPPricePointsLimit(200),
+			}
+			if territoryIDs := shared.SplitCSVUpper(*territories); len(territoryIDs) > 0 {
+				opts = append(opts, asc.WithIAPPricePointsTerritory(strings.Join(territoryIDs, ",")))
+			}
+
+			firstPage, err := client.GetInAppPurchasePricePointEqualizations(requestCtx, id, opts...)
+			if err != nil {
+				return fmt.Errorf("iap tax-treatment: failed to fetch: %w", err)
+			}
+			// PaginateAll only aggregates data, so collect each page's included
+			// territories for currency lookup as pages arrive.
+			included := []json.RawMessage{firstPage.Included}
+			resp, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				page, err := client.GetInAppPurchasePricePointEqualizations(ctx, id, asc.WithIAPPricePointsNextURL(nextURL))
+				if err == nil {
+					included = append(included, page.Included)
+				}
+				return page, err
+			})
+			if err != nil {
+				return fmt.Errorf("iap tax-treatment: %w", err)
+			}
+
+			pricePoints, ok := resp.(*asc.InAppPurchasePricePointsResponse)
+			if !ok {
+				return fmt.Errorf("iap tax-treatment: unexpected response type %T", resp)
+			}
+			result := buildIAPTaxTreatmentResult(id, pricePoints.Data, included)
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+func buildIAPTaxTreatmentResult(
+	pricePointID string,
+	pricePoints []asc.Resource[asc.InAppPurchasePricePointAttributes],
+	included []json.RawMessage,
+) *asc.IAPTaxTreatmentResult {
+	result := &asc.IAPTaxTreatmentResult{
+		PricePointID: pricePointID,
+		Territories:  make([]asc.IAPTerritoryTaxTreatment, 0, len(pricePoints)),
+	}
+	for _, item := range pricePoints {
+		territoryID, err := relationshipID(item.Relationships, "territory")
+		if err != nil {
+			continue
+		}
+		result.Territories = append(result.Territories, asc.IAPTerritoryTaxTreatment{
+			Territory:     territoryID,
+			Currency:      territoryCurrencyFromPages(included, territoryID),
+			PricePointID:  item.ID,
+			CustomerPrice: item.Attributes.CustomerPrice,
+			Proceeds:      item.Attributes.Proceeds,
+			ProceedsRate:  proceedsRate(item.Attributes.CustomerPrice, item.Attributes.Proceeds),
+		})
+	}
+	sort.Slice(result.Territories, func(i, j int) bool {
+		return result.Territories[i].Territory < result.Territories[j].Territory
+	})
+	return result
+}
+
+func territoryCurrencyFromPages(pages []json.RawMessage, territoryID string) string {
+	for _, raw := range pages {
+		if currency := territoryCurrencyFromIncluded(raw, territoryID); currency != "" {
+			return currency
+		}
+	}
+	return ""
+}
+
+// proceedsRate returns proceeds as a percentage of the customer price, or an
+// empty string when either value is missing or the price is zero.
+func proceedsRate(customerPrice, proceeds string) string {
+	price, err := strconv.ParseFloat(strings.TrimSpace(customerPrice), 64)
+	if err != nil || price <= 0 {
+		return ""
+	}
+	net, err := strconv.ParseFloat(strings.TrimSpace(proceeds), 64)
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatFloat(net/price*100, 'f', 2, 64)
+}
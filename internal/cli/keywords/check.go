@@ -0,0 +1,249 @@
+package keywords
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// keywordsLimit is the App Store Connect character limit for keywords.
+const keywordsLimit = 100
+
+// KeywordsCheckCommand returns the keywords check subcommand.
+func KeywordsCheckCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env); enables the title/subtitle check")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
+	set := fs.String("set", "", "Set keywords from a comma-separated list, trimmed to the 100-character limit (requires --locale)")
+	dryRun := fs.Bool("dry-run", false, "With --set, show the keywords that would be set without updating")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "check",
+		ShortUsage: "asc keywords check --version-id <id> [flags]",
+		ShortHelp:  "Report keyword usage, duplicates, and wasted characters.",
+		LongHelp: `Report keyword usage, duplicates, and wasted characters.
+
+For each locale of the version, reports:
+  - characters used of the 100-character limit
+  - duplicate terms (case-insensitive)
+  - terms already in the app name or subtitle (requires --app), which the
+    App Store indexes anyway
+  - a suggested keyword list without the wasted characters
+
+With --set, the given comma-separated terms are de-duplicated, joined
+without spaces, and trimmed to the 100-character limit by dropping the terms
+that do not fit. The result is written to every locale in --locale.
+
+Examples:
+  asc keywords check --version-id "VERSION_ID"
+  asc keywords check --version-id "VERSION_ID" --app "APP_ID" --output table
+  asc keywords check --version-id "VERSION_ID" --locale "en-US,en-GB" --set "photo, editor, filters" --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			versionValue := strings.TrimSpace(*versionID)
+			if versionValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+			locales := shared.SplitCSV(*locale)
+			setValue := strings.TrimSpace(*set)
+			if setValue != "" && len(locales) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --locale is required with --set")
+				return flag.ErrHelp
+			}
+			if *dryRun && setValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dry-run requires --set")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("keywords check: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetAppStoreVersionLocalizations(requestCtx, versionValue, asc.WithAppStoreVersionLocalizationsLimit(200))
+			if err != nil {
+				return fmt.Errorf("keywords check: failed to fetch localizations: %w", err)
+			}
+			keywordsByLocale := make(map[string]string, len(resp.Data))
+			for _, item := range resp.Data {
+				keywordsByLocale[item.Attributes.Locale] = item.Attributes.Keywords
+			}
+
+			result := &asc.KeywordsCheckResult{
+				VersionID: versionValue,
+				AppID:     shared.ResolveAppID(*appID),
+				DryRun:    *dryRun,
+			}
+
+			titles := map[string]string{}
+			if result.AppID != "" {
+				result.AppInfoID, err = shared.ResolveAppInfoID(requestCtx, client, result.AppID, strings.TrimSpace(*appInfoID))
+				if err != nil {
+					return fmt.Errorf("keywords check: %w", err)
+				}
+				infoResp, err := client.GetAppInfoLocalizations(requestCtx, result.AppInfoID, asc.WithAppInfoLocalizationsLimit(200))
+				if err != nil {
+					return fmt.Errorf("keywords check: failed to fetch app info localizations: %w", err)
+				}
+				for _, item := range infoResp.Data {
+					titles[item.Attributes.Locale] = item.Attributes.Name + " " + item.Attributes.Subtitle
+				}
+			}
+
+			actions := map[string]string{}
+			var dropped []string
+			if setValue != "" {
+				terms, droppedTerms := fitKeywordTerms(shared.SplitCSV(setValue), keywordsLimit)
+				if len(terms) == 0 {
+					return fmt.Errorf("keywords check: no keyword fits within %d characters", keywordsLimit)
+				}
+				dropped = droppedTerms
+				joined := strings.Join(terms, ",")
+				valuesByLocale := make(map[string]map[string]string, len(locales))
+				for _, value := range locales {
+					valuesByLocale[value] = map[string]string{"keywords": joined}
+					keywordsByLocale[value] = joined
+				}
+				results, err := shared.UploadVersionLocalizations(requestCtx, client, versionValue, valuesByLocale, *dryRun)
+				if err != nil {
+					return fmt.Errorf("keywords check: %w", err)
+				}
+				for _, item := range results {
+					actions[item.Locale] = item.Action
+				}
+			}
+
+			if len(locales) == 0 {
+				for value := range keywordsByLocale {
+					locales = append(locales, value)
+				}
+			}
+			sort.Strings(locales)
+			for _, value := range locales {
+				keywords, ok := keywordsByLocale[value]
+				if !ok {
+					return fmt.Errorf("keywords check: locale %q not found on version %s", value, versionValue)
+				}
+				report := analyzeKeywords(value, keywords, titles[value])
+				report.Action = actions[value]
+				report.Dropped = dropped
+				result.Locales = append(result.Locales, report)
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// analyzeKeywords reports character usage and wasted characters for one
+// locale. title is the app name and subtitle, which the App Store already
+// indexes, so repeating their words in keywords wastes characters.
+func analyzeKeywords(locale, keywords, title string) asc.KeywordsLocaleReport {
+	report := asc.KeywordsLocaleReport{
+		Locale:     locale,
+		Keywords:   keywords,
+		Characters: utf8.RuneCountInString(keywords),
+		Limit:      keywordsLimit,
+	}
+	report.Remaining = report.Limit - report.Characters
+
+	titleWords := make(map[string]bool)
+	for _, word := range keywordWords(title) {
+		titleWords[word] = true
+	}
+
+	seen := make(map[string]bool)
+	var kept []string
+	for _, raw := range strings.Split(keywords, ",") {
+		term := strings.TrimSpace(raw)
+		// Spaces around commas count against the limit but are never indexed.
+		report.WastedCharacters += utf8.RuneCountInString(raw) - utf8.RuneCountInString(term)
+		if term == "" {
+			continue
+		}
+		report.Terms++
+		key := strings.ToLower(term)
+		switch {
+		case seen[key]:
+			report.Duplicates = append(report.Duplicates, term)
+			report.WastedCharacters += utf8.RuneCountInString(term) + 1
+		case len(titleWords) > 0 && containsAllWords(titleWords, keywordWords(term)):
+			seen[key] = true
+			report.InTitle = append(report.InTitle, term)
+			report.WastedCharacters += utf8.RuneCountInString(term) + 1
+		default:
+			seen[key] = true
+			kept = append(kept, term)
+		}
+	}
+
+	if suggested := strings.Join(kept, ","); suggested != keywords {
+		report.Suggested = suggested
+	}
+	return report
+}
+
+// fitKeywordTerms de-duplicates terms and keeps, in order, those that fit in
+// limit characters when joined with commas. Terms that do not fit are
+// returned as dropped.
+func fitKeywordTerms(terms []string, limit int) ([]string, []string) {
+	seen := make(map[string]bool, len(terms))
+	var kept, dropped []string
+	used := 0
+	for _, term := range terms {
+		key := strings.ToLower(term)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		length := utf8.RuneCountInString(term)
+		if len(kept) > 0 {
+			length++
+		}
+		if used+length > limit {
+			dropped = append(dropped, term)
+			continue
+		}
+		used += length
+		kept = append(kept, term)
+	}
+	return kept, dropped
+}
+
+func keywordWords(value string) []string {
+	return strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+func containsAllWords(set map[string]bool, words []string) bool {
+	if len(words) == 0 {
+		return false
+	}
+	for _, word := range words {
+		if !set[word] {
+			return false
+		}
+	}
+	return true
+}
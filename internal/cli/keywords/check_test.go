@@ -0,0 +1,48 @@
+package keywords
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeKeywords(t *testing.T) {
+	report := analyzeKeywords("en-US", "photo, Editor,filters,photo,collage maker", "Photo Editor Pro Collage maker for everyone")
+
+	if report.Characters != 41 || report.Remaining != 59 || report.Terms != 5 {
+		t.Fatalf("unexpected usage: %+v", report)
+	}
+	if !reflect.DeepEqual(report.Duplicates, []string{"photo"}) {
+		t.Fatalf("unexpected duplicates: %v", report.Duplicates)
+	}
+	if !reflect.DeepEqual(report.InTitle, []string{"photo", "Editor", "collage maker"}) {
+		t.Fatalf("unexpected in-title terms: %v", report.InTitle)
+	}
+	// one space, "photo," and "Editor," and "collage maker," and the duplicate "photo,"
+	if report.WastedCharacters != 1+6+7+14+6 {
+		t.Fatalf("unexpected wasted characters: %d", report.WastedCharacters)
+	}
+	if report.Suggested != "filters" {
+		t.Fatalf("unexpected suggestion: %q", report.Suggested)
+	}
+}
+
+func TestAnalyzeKeywordsWithoutTitle(t *testing.T) {
+	report := analyzeKeywords("en-US", "photo,editor", "")
+	if len(report.InTitle) != 0 || report.WastedCharacters != 0 || report.Suggested != "" {
+		t.Fatalf("expected clean report, got %+v", report)
+	}
+}
+
+func TestFitKeywordTerms(t *testing.T) {
+	kept, dropped := fitKeywordTerms([]string{"alpha", "beta", "Alpha", "gamma", "de"}, 13)
+	if !reflect.DeepEqual(kept, []string{"alpha", "beta", "de"}) {
+		t.Fatalf("unexpected kept terms: %v", kept)
+	}
+	if !reflect.DeepEqual(dropped, []string{"gamma"}) {
+		t.Fatalf("unexpected dropped terms: %v", dropped)
+	}
+	if got := len(strings.Join(kept, ",")); got > 13 {
+		t.Fatalf("expected joined length <= 13, got %d", got)
+	}
+}
@@ -0,0 +1,8 @@
+package keywords
+
+import "github.com/peterbourgon/ff/v3/ffcli"
+
+// Command returns the keywords command group.
+func Command() *ffcli.Command {
+	return KeywordsCommand()
+}
@@ -0,0 +1,34 @@
+package keywords
+
+import (
+	"context"
+	"flag"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// KeywordsCommand returns the keywords command group.
+func KeywordsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("keywords", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "keywords",
+		ShortUsage: "asc keywords <subcommand> [flags]",
+		ShortHelp:  "Check and set App Store keywords.",
+		LongHelp: `Check and set App Store keywords.
+
+Examples:
+  asc keywords check --version-id "VERSION_ID" --app "APP_ID"
+  asc keywords check --version-id "VERSION_ID" --locale en-US --set "photo,editor,filters"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			KeywordsCheckCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
@@ -0,0 +1,8 @@
+package links
+
+import "github.com/peterbourgon/ff/v3/ffcli"
+
+// Command returns the links command.
+func Command() *ffcli.Command {
+	return LinksCommand()
+}
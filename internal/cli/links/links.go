@@ -0,0 +1,237 @@
+package links
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const appStoreBaseURL = "https://apps.apple.com"
+
+const (
+	linkKindProductPage       = "product-page"
+	linkKindCustomProductPage = "custom-product-page"
+	linkKindOfferCode         = "offer-code"
+)
+
+var localePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]+)*$`)
+
+// defaultStorefronts maps App Store Connect locales without a region to the
+// storefront where that language is primary.
+var defaultStorefronts = map[string]string{
+	"ar":      "sa",
+	"ca":      "es",
+	"cs":      "cz",
+	"da":      "dk",
+	"de":      "de",
+	"el":      "gr",
+	"en":      "us",
+	"es":      "es",
+	"fi":      "fi",
+	"fr":      "fr",
+	"he":      "il",
+	"hi":      "in",
+	"hr":      "hr",
+	"hu":      "hu",
+	"id":      "id",
+	"it":      "it",
+	"ja":      "jp",
+	"ko":      "kr",
+	"ms":      "my",
+	"nl":      "nl",
+	"no":      "no",
+	"pl":      "pl",
+	"pt":      "br",
+	"ro":      "ro",
+	"ru":      "ru",
+	"sk":      "sk",
+	"sv":      "se",
+	"th":      "th",
+	"tr":      "tr",
+	"uk":      "ua",
+	"vi":      "vn",
+	"zh-hans": "cn",
+	"zh-hant": "tw",
+}
+
+// LinksCommand returns the links command.
+func LinksCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("links", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	locales := fs.String("locales", "", "Comma-separated locales (e.g., en-US,de-DE,ja) (required)")
+	customProductPages := fs.Bool("custom-product-pages", false, "Include URLs for the app's custom product pages")
+	offerCodes := fs.String("offer-codes", "", "Comma-separated offer codes to build redemption URLs for")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "links",
+		ShortUsage: "asc links --app APP_ID --locales LOCALE[,LOCALE...] [flags]",
+		ShortHelp:  "Generate App Store product page URLs per locale.",
+		LongHelp: `Generate App Store product page URLs per locale.
+
+Each locale maps to a storefront (en-GB -> gb, ja -> jp) and a display
+language. Custom product page URLs are fetched from App Store Connect;
+offer code redemption URLs are built from the codes you pass.
+
+Examples:
+  asc links --app "APP_ID" --locales "en-US,de-DE,ja"
+  asc links --app "APP_ID" --locales "en-US,fr-FR" --custom-product-pages --output markdown
+  asc links --app "APP_ID" --locales "en-US" --offer-codes "SPRING24,VIP"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+			localeValues := shared.SplitCSV(*locales)
+			if len(localeValues) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --locales is required")
+				return flag.ErrHelp
+			}
+
+			storefronts := make([]localeStorefront, 0, len(localeValues))
+			for _, locale := range localeValues {
+				storefront, err := resolveLocaleStorefront(locale)
+				if err != nil {
+					return fmt.Errorf("links: %w", err)
+				}
+				storefronts = append(storefronts, storefront)
+			}
+
+			result := &asc.AppStoreLinksResult{AppID: resolvedAppID}
+			for _, storefront := range storefronts {
+				result.Links = append(result.Links, asc.AppStoreLink{
+					Kind:       linkKindProductPage,
+					Locale:     storefront.Locale,
+					Storefront: storefront.Country,
+					URL:        productPageURL(resolvedAppID, storefront, ""),
+				})
+			}
+
+			if *customProductPages {
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("links: %w", err)
+				}
+
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				firstPage, err := client.GetAppCustomProductPages(requestCtx, resolvedAppID, asc.WithAppCustomProductPagesLimit(200))
+				if err != nil {
+					return fmt.Errorf("links: failed to fetch custom product pages: %w", err)
+				}
+				allPages, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetAppCustomProductPages(ctx, resolvedAppID, asc.WithAppCustomProductPagesNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("links: failed to fetch custom product pages: %w", err)
+				}
+				pages, ok := allPages.(*asc.AppCustomProductPagesResponse)
+				if !ok {
+					return fmt.Errorf("links: unexpected custom product pages response type")
+				}
+
+				for _, page := range pages.Data {
+					ppid := customProductPageID(page)
+					for _, storefront := range storefronts {
+						result.Links = append(result.Links, asc.AppStoreLink{
+							Kind:       linkKindCustomProductPage,
+							Locale:     storefront.Locale,
+							Storefront: storefront.Country,
+							Name:       page.Attributes.Name,
+							URL:        productPageURL(resolvedAppID, storefront, ppid),
+						})
+					}
+				}
+			}
+
+			for _, code := range shared.SplitCSV(*offerCodes) {
+				result.Links = append(result.Links, asc.AppStoreLink{
+					Kind: linkKindOfferCode,
+					Name: code,
+					URL:  offerCodeRedemptionURL(resolvedAppID, code),
+				})
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// localeStorefront pairs a requested locale with its storefront country and display language.
+type localeStorefront struct {
+	Locale   string
+	Country  string
+	Language string
+}
+
+func resolveLocaleStorefront(locale string) (localeStorefront, error) {
+	locale = strings.TrimSpace(locale)
+	if !localePattern.MatchString(locale) {
+		return localeStorefront{}, fmt.Errorf("invalid locale %q", locale)
+	}
+
+	parts := strings.Split(locale, "-")
+	language := strings.ToLower(parts[0])
+	country := ""
+	for _, part := range parts[1:] {
+		if len(part) == 2 {
+			country = strings.ToLower(part)
+		}
+	}
+
+	if country == "" {
+		key := language
+		if len(parts) > 1 {
+			key = strings.ToLower(locale)
+		}
+		defaultCountry, ok := defaultStorefronts[key]
+		if !ok {
+			defaultCountry, ok = defaultStorefronts[language]
+		}
+		if !ok {
+			return localeStorefront{}, fmt.Errorf("locale %q has no region; use a regional locale such as %s-XX", locale, language)
+		}
+		country = defaultCountry
+	}
+
+	return localeStorefront{Locale: locale, Country: country, Language: language}, nil
+}
+
+func productPageURL(appID string, storefront localeStorefront, ppid string) string {
+	query := url.Values{}
+	query.Set("l", storefront.Language)
+	if ppid != "" {
+		query.Set("ppid", ppid)
+	}
+	return fmt.Sprintf("%s/%s/app/id%s?%s", appStoreBaseURL, storefront.Country, url.PathEscape(appID), query.Encode())
+}
+
+// customProductPageID prefers the ppid embedded in the page URL reported by
+// App Store Connect and falls back to the resource ID.
+func customProductPageID(page asc.Resource[asc.AppCustomProductPageAttributes]) string {
+	if parsed, err := url.Parse(strings.TrimSpace(page.Attributes.URL)); err == nil {
+		if ppid := strings.TrimSpace(parsed.Query().Get("ppid")); ppid != "" {
+			return ppid
+		}
+	}
+	return page.ID
+}
+
+func offerCodeRedemptionURL(appID, code string) string {
+	return fmt.Sprintf("%s/redeem?ctx=offercodes&id=%s&code=%s", appStoreBaseURL, url.QueryEscape(appID), url.QueryEscape(code))
+}
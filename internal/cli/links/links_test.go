@@ -0,0 +1,65 @@
+package links
+
+import (
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestResolveLocaleStorefront(t *testing.T) {
+	tests := []struct {
+		locale   string
+		country  string
+		language string
+	}{
+		{"en-US", "us", "en"},
+		{"en-GB", "gb", "en"},
+		{"ja", "jp", "ja"},
+		{"zh-Hant", "tw", "zh"},
+		{"zh-Hans", "cn", "zh"},
+		{"fr-CA", "ca", "fr"},
+	}
+	for _, test := range tests {
+		got, err := resolveLocaleStorefront(test.locale)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.locale, err)
+		}
+		if got.Country != test.country || got.Language != test.language {
+			t.Fatalf("%s: expected %s/%s, got %s/%s", test.locale, test.country, test.language, got.Country, got.Language)
+		}
+	}
+
+	if _, err := resolveLocaleStorefront("../etc"); err == nil {
+		t.Fatal("expected invalid locale error")
+	}
+	if _, err := resolveLocaleStorefront("xx"); err == nil {
+		t.Fatal("expected unknown default storefront error")
+	}
+}
+
+func TestProductPageURLs(t *testing.T) {
+	storefront := localeStorefront{Locale: "de-DE", Country: "de", Language: "de"}
+	if got := productPageURL("123", storefront, ""); got != "https://apps.apple.com/de/app/id123?l=de" {
+		t.Fatalf("unexpected product page URL %q", got)
+	}
+	if got := productPageURL("123", storefront, "abc"); got != "https://apps.apple.com/de/app/id123?l=de&ppid=abc" {
+		t.Fatalf("unexpected custom product page URL %q", got)
+	}
+	if got := offerCodeRedemptionURL("123", "SPRING 24"); got != "https://apps.apple.com/redeem?ctx=offercodes&id=123&code=SPRING+24" {
+		t.Fatalf("unexpected redemption URL %q", got)
+	}
+}
+
+func TestCustomProductPageID(t *testing.T) {
+	page := asc.Resource[asc.AppCustomProductPageAttributes]{
+		ID:         "resource-id",
+		Attributes: asc.AppCustomProductPageAttributes{URL: "https://apps.apple.com/app/id123?ppid=from-url"},
+	}
+	if got := customProductPageID(page); got != "from-url" {
+		t.Fatalf("expected ppid from URL, got %q", got)
+	}
+	page.Attributes.URL = ""
+	if got := customProductPageID(page); got != "resource-id" {
+		t.Fatalf("expected resource ID fallback, got %q", got)
+	}
+}
@@ -0,0 +1,139 @@
+package localizations
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// LocalizationsCopyCommand returns the copy localizations subcommand.
+func LocalizationsCopyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("copy", flag.ExitOnError)
+
+	fromVersionID := fs.String("from-version-id", "", "Source App Store version ID")
+	toVersionID := fs.String("to-version-id", "", "Destination App Store version ID")
+	only := fs.String("only", "", "Copy only these fields, comma-separated: "+strings.Join(shared.VersionLocalizationKeys(), ", "))
+	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
+	dryRun := fs.Bool("dry-run", false, "Show what would be copied without updating")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "copy",
+		ShortUsage: "asc localizations copy --from-version-id <id> --to-version-id <id> [flags]",
+		ShortHelp:  "Copy version localizations from one version to another.",
+		LongHelp: `Copy version localizations from one version to another.
+
+Every locale of the source version is created or updated on the destination
+version. Empty source fields are skipped, so they never clear values on the
+destination.
+
+Examples:
+  asc localizations copy --from-version-id "PREVIOUS_VERSION_ID" --to-version-id "NEW_VERSION_ID"
+  asc localizations copy --from-version-id "PREVIOUS_VERSION_ID" --to-version-id "NEW_VERSION_ID" --only whatsNew
+  asc localizations copy --from-version-id "PREVIOUS_VERSION_ID" --to-version-id "NEW_VERSION_ID" --only "description,keywords" --locale "en-US,de-DE" --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			fromValue := strings.TrimSpace(*fromVersionID)
+			toValue := strings.TrimSpace(*toVersionID)
+			if fromValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --from-version-id is required")
+				return flag.ErrHelp
+			}
+			if toValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --to-version-id is required")
+				return flag.ErrHelp
+			}
+			if fromValue == toValue {
+				fmt.Fprintln(os.Stderr, "Error: --from-version-id and --to-version-id must differ")
+				return flag.ErrHelp
+			}
+
+			fields := shared.SplitCSV(*only)
+			allowed := make(map[string]bool)
+			for _, key := range shared.VersionLocalizationKeys() {
+				allowed[key] = true
+			}
+			for _, field := range fields {
+				if !allowed[field] {
+					fmt.Fprintf(os.Stderr, "Error: --only must be one of: %s\n", strings.Join(shared.VersionLocalizationKeys(), ", "))
+					return flag.ErrHelp
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("localizations copy: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			source, err := client.GetAppStoreVersionLocalizations(requestCtx, fromValue, asc.WithAppStoreVersionLocalizationsLimit(200))
+			if err != nil {
+				return fmt.Errorf("localizations copy: failed to fetch source localizations: %w", err)
+			}
+
+			valuesByLocale := copyLocalizationValues(source.Data, shared.SplitCSV(*locale), fields)
+			if len(valuesByLocale) == 0 {
+				return fmt.Errorf("localizations copy: no matching localization values on version %s", fromValue)
+			}
+
+			results, err := shared.UploadVersionLocalizations(requestCtx, client, toValue, valuesByLocale, *dryRun)
+			if err != nil {
+				return fmt.Errorf("localizations copy: %w", err)
+			}
+
+			result := asc.LocalizationUploadResult{
+				Type:            shared.LocalizationTypeVersion,
+				SourceVersionID: fromValue,
+				VersionID:       toValue,
+				DryRun:          *dryRun,
+				Results:         results,
+			}
+
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
+
+// copyLocalizationValues selects the values to copy from the source
+// localizations, keeping only the requested locales and fields. Locales left
+// without any value are dropped.
+func copyLocalizationValues(items []asc.Resource[asc.AppStoreVersionLocalizationAttributes], locales, fields []string) map[string]map[string]string {
+	localeFilter := make(map[string]bool, len(locales))
+	for _, locale := range locales {
+		localeFilter[locale] = true
+	}
+
+	valuesByLocale := make(map[string]map[string]string)
+	for _, item := range items {
+		locale := strings.TrimSpace(item.Attributes.Locale)
+		if locale == "" || (len(localeFilter) > 0 && !localeFilter[locale]) {
+			continue
+		}
+		values := shared.VersionLocalizationValues(item.Attributes)
+		if len(fields) > 0 {
+			selected := make(map[string]string, len(fields))
+			for _, field := range fields {
+				if value, ok := values[field]; ok {
+					selected[field] = value
+				}
+			}
+			values = selected
+		}
+		if len(values) == 0 {
+			continue
+		}
+		valuesByLocale[locale] = values
+	}
+	return valuesByLocale
+}
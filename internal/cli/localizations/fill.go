@@ -0,0 +1,152 @@
+package localizations
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// LocalizationsFillCommand returns the fill localizations subcommand.
+func LocalizationsFillCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("fill", flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	source := fs.String("source", "en-US", "Locale to copy content from")
+	locale := fs.String("locale", "", "Locale(s) to fill, comma-separated (default: the app's app-info locales)")
+	missingOnly := fs.Bool("missing-only", false, "Only create missing locales; leave existing localizations untouched")
+	dryRun := fs.Bool("dry-run", false, "Show what would be filled without updating")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "fill",
+		ShortUsage: "asc localizations fill --version-id <id> [flags]",
+		ShortHelp:  "Fill missing version locales from a source locale.",
+		LongHelp: `Fill missing version locales from a source locale.
+
+Every locale the app supports but the version lacks is created with the
+source locale's content as a placeholder. The supported locales are the
+app's app-info localizations, or the locales given with --locale.
+
+Without --missing-only, empty fields of existing localizations are also
+filled from the source. Existing values are never overwritten.
+
+Examples:
+  asc localizations fill --version-id "VERSION_ID" --app "APP_ID" --source en-US --missing-only
+  asc localizations fill --version-id "VERSION_ID" --locale "de-DE,fr-FR,ja" --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			versionValue := strings.TrimSpace(*versionID)
+			if versionValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+			sourceValue := strings.TrimSpace(*source)
+			if sourceValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --source is required")
+				return flag.ErrHelp
+			}
+			locales := shared.SplitCSV(*locale)
+			for _, value := range locales {
+				if !shared.IsValidLocale(value) {
+					fmt.Fprintf(os.Stderr, "Error: invalid locale %q\n", value)
+					return flag.ErrHelp
+				}
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if len(locales) == 0 && resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app or --locale is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("localizations fill: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			version := &translationTarget{locType: shared.LocalizationTypeVersion, versionID: versionValue}
+			existing, err := version.fetch(requestCtx, client)
+			if err != nil {
+				return fmt.Errorf("localizations fill: %w", err)
+			}
+			sourceValues, ok := existing[sourceValue]
+			if !ok {
+				return fmt.Errorf("localizations fill: source locale %q not found on version %s", sourceValue, versionValue)
+			}
+
+			if len(locales) == 0 {
+				appInfo := &translationTarget{locType: shared.LocalizationTypeAppInfo, appID: resolvedAppID}
+				if err := appInfo.resolve(requestCtx, client, strings.TrimSpace(*appInfoID)); err != nil {
+					return fmt.Errorf("localizations fill: %w", err)
+				}
+				supported, err := appInfo.fetch(requestCtx, client)
+				if err != nil {
+					return fmt.Errorf("localizations fill: %w", err)
+				}
+				for supportedLocale := range supported {
+					locales = append(locales, supportedLocale)
+				}
+				sort.Strings(locales)
+			}
+
+			valuesByLocale := fillLocalizationValues(existing, sourceValues, sourceValue, locales, *missingOnly)
+			results := []asc.LocalizationUploadLocaleResult{}
+			if len(valuesByLocale) > 0 {
+				results, err = shared.UploadVersionLocalizations(requestCtx, client, versionValue, valuesByLocale, *dryRun)
+				if err != nil {
+					return fmt.Errorf("localizations fill: %w", err)
+				}
+			}
+
+			result := asc.LocalizationUploadResult{
+				Type:      shared.LocalizationTypeVersion,
+				VersionID: versionValue,
+				AppID:     resolvedAppID,
+				DryRun:    *dryRun,
+				Results:   results,
+			}
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
+
+// fillLocalizationValues returns the values to upload for each target
+// locale. Missing locales get every source value; existing locales get only
+// the fields they leave empty, unless missingOnly is set.
+func fillLocalizationValues(existing map[string]map[string]string, sourceValues map[string]string, sourceLocale string, locales []string, missingOnly bool) map[string]map[string]string {
+	valuesByLocale := make(map[string]map[string]string)
+	for _, locale := range locales {
+		if locale == sourceLocale {
+			continue
+		}
+		current, exists := existing[locale]
+		if exists && missingOnly {
+			continue
+		}
+		values := make(map[string]string)
+		for key, value := range sourceValues {
+			if strings.TrimSpace(current[key]) == "" {
+				values[key] = value
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+		valuesByLocale[locale] = values
+	}
+	return valuesByLocale
+}
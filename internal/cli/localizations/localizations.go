@@ -29,8 +29,13 @@ Examples:
   asc localizations preview-sets list --localization-id "LOCALIZATION_ID"
   asc localizations preview-sets get --id "PREVIEW_SET_ID"
   asc localizations screenshot-sets get --id "SCREENSHOT_SET_ID"
+  asc localizations screenshot-sets create --localization-id "LOCALIZATION_ID" --display-type "APP_IPHONE_67"
   asc localizations download --version "VERSION_ID" --path "./localizations"
-  asc localizations upload --version "VERSION_ID" --path "./localizations"`,
+  asc localizations upload --version "VERSION_ID" --path "./localizations"
+  asc localizations copy --from-version-id "PREVIOUS_VERSION_ID" --to-version-id "NEW_VERSION_ID"
+  asc localizations export --version "VERSION_ID" --format xliff --path "./translations"
+  asc localizations import --version "VERSION_ID" --path "./translations"
+  asc localizations fill --version-id "VERSION_ID" --app "APP_ID" --source en-US --missing-only`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -40,6 +45,10 @@ Examples:
 			LocalizationsScreenshotSetsCommand(),
 			LocalizationsDownloadCommand(),
 			LocalizationsUploadCommand(),
+			LocalizationsCopyCommand(),
+			LocalizationsExportCommand(),
+			LocalizationsImportCommand(),
+			LocalizationsFillCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
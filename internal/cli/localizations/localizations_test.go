@@ -1,6 +1,10 @@
 package localizations
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
 
 func TestLocalizationsCommandConstructors(t *testing.T) {
 	top := LocalizationsCommand()
@@ -25,3 +29,52 @@ func TestLocalizationsCommandConstructors(t *testing.T) {
 		t.Fatal("expected search keywords command")
 	}
 }
+
+func TestCopyLocalizationValues(t *testing.T) {
+	items := []asc.Resource[asc.AppStoreVersionLocalizationAttributes]{
+		{ID: "1", Attributes: asc.AppStoreVersionLocalizationAttributes{Locale: "en-US", Description: "An app", Keywords: "a,b", WhatsNew: "Fixes"}},
+		{ID: "2", Attributes: asc.AppStoreVersionLocalizationAttributes{Locale: "de-DE", Description: "Eine App"}},
+		{ID: "3", Attributes: asc.AppStoreVersionLocalizationAttributes{Locale: "ja", Keywords: "x"}},
+	}
+
+	all := copyLocalizationValues(items, nil, nil)
+	if len(all) != 3 || len(all["en-US"]) != 3 {
+		t.Fatalf("expected all locales and fields, got %v", all)
+	}
+
+	onlyWhatsNew := copyLocalizationValues(items, nil, []string{"whatsNew"})
+	if len(onlyWhatsNew) != 1 || onlyWhatsNew["en-US"]["whatsNew"] != "Fixes" || len(onlyWhatsNew["en-US"]) != 1 {
+		t.Fatalf("expected only en-US whatsNew, got %v", onlyWhatsNew)
+	}
+
+	filtered := copyLocalizationValues(items, []string{"de-DE"}, nil)
+	if len(filtered) != 1 || filtered["de-DE"]["description"] != "Eine App" {
+		t.Fatalf("expected only de-DE, got %v", filtered)
+	}
+}
+
+func TestFillLocalizationValues(t *testing.T) {
+	source := map[string]string{"description": "An app", "keywords": "a,b"}
+	existing := map[string]map[string]string{
+		"en-US": source,
+		"de-DE": {"description": "Eine App"},
+		"fr-FR": {"description": "Une app", "keywords": "c,d"},
+	}
+	locales := []string{"de-DE", "en-US", "fr-FR", "ja"}
+
+	all := fillLocalizationValues(existing, source, "en-US", locales, false)
+	if len(all) != 2 {
+		t.Fatalf("expected de-DE and ja, got %v", all)
+	}
+	if len(all["ja"]) != 2 || all["ja"]["description"] != "An app" {
+		t.Fatalf("expected ja to get every source value, got %v", all["ja"])
+	}
+	if len(all["de-DE"]) != 1 || all["de-DE"]["keywords"] != "a,b" {
+		t.Fatalf("expected de-DE to get only keywords, got %v", all["de-DE"])
+	}
+
+	missing := fillLocalizationValues(existing, source, "en-US", locales, true)
+	if len(missing) != 1 || missing["ja"] == nil {
+		t.Fatalf("expected only ja with --missing-only, got %v", missing)
+	}
+}
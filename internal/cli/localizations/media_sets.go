@@ -26,12 +26,16 @@ func LocalizationsPreviewSetsCommand() *ffcli.Command {
 Examples:
   asc localizations preview-sets list --localization-id "LOCALIZATION_ID"
   asc localizations preview-sets get --id "PREVIEW_SET_ID"
+  asc localizations preview-sets create --localization-id "LOCALIZATION_ID" --preview-type "IPHONE_67"
+  asc localizations preview-sets delete --id "PREVIEW_SET_ID" --confirm
   asc localizations preview-sets relationships --localization-id "LOCALIZATION_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			LocalizationsPreviewSetsListCommand(),
 			LocalizationsPreviewSetsGetCommand(),
+			LocalizationsPreviewSetsCreateCommand(),
+			LocalizationsPreviewSetsDeleteCommand(),
 			LocalizationsPreviewSetsRelationshipsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -45,6 +49,7 @@ func LocalizationsPreviewSetsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("localizations preview-sets list", flag.ExitOnError)
 
 	localizationID := fs.String("localization-id", "", "App Store version localization ID")
+	previewType := fs.String("preview-type", "", "Filter by preview type(s), comma-separated (e.g. IPHONE_67)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -58,7 +63,8 @@ func LocalizationsPreviewSetsListCommand() *ffcli.Command {
 		LongHelp: `List preview sets for an App Store localization.
 
 Examples:
-  asc localizations preview-sets list --localization-id "LOCALIZATION_ID"`,
+  asc localizations preview-sets list --localization-id "LOCALIZATION_ID"
+  asc localizations preview-sets list --localization-id "LOCALIZATION_ID" --preview-type "IPHONE_67,IPAD_PRO_3GEN_129"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -74,6 +80,13 @@ Examples:
 			if err := shared.ValidateNextURL(*next); err != nil {
 				return fmt.Errorf("localizations preview-sets list: %w", err)
 			}
+			previewTypes := shared.SplitCSVUpper(*previewType)
+			for _, value := range previewTypes {
+				if !asc.IsValidPreviewType(value) {
+					fmt.Fprintf(os.Stderr, "Error: invalid --preview-type %q\n", value)
+					return flag.ErrHelp
+				}
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -86,6 +99,7 @@ Examples:
 			opts := []asc.AppStoreVersionLocalizationPreviewSetsOption{
 				asc.WithAppStoreVersionLocalizationPreviewSetsLimit(*limit),
 				asc.WithAppStoreVersionLocalizationPreviewSetsNextURL(*next),
+				asc.WithAppStoreVersionLocalizationPreviewSetsPreviewTypes(previewTypes),
 			}
 
 			if *paginate {
@@ -156,6 +170,113 @@ Examples:
 	}
 }
 
+// LocalizationsPreviewSetsCreateCommand returns the preview sets create subcommand.
+func LocalizationsPreviewSetsCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations preview-sets create", flag.ExitOnError)
+
+	localizationID := fs.String("localization-id", "", "App Store version localization ID")
+	previewType := fs.String("preview-type", "", "Preview type (e.g. IPHONE_67)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc localizations preview-sets create --localization-id \"LOCALIZATION_ID\" --preview-type \"IPHONE_67\"",
+		ShortHelp:  "Create a preview set for an App Store localization.",
+		LongHelp: `Create a preview set for an App Store localization.
+
+A localization has at most one set per preview type.
+
+Examples:
+  asc localizations preview-sets create --localization-id "LOCALIZATION_ID" --preview-type "IPHONE_67"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedID := strings.TrimSpace(*localizationID)
+			if trimmedID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --localization-id is required")
+				return flag.ErrHelp
+			}
+			previewValue := strings.ToUpper(strings.TrimSpace(*previewType))
+			if previewValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --preview-type is required")
+				return flag.ErrHelp
+			}
+			if !asc.IsValidPreviewType(previewValue) {
+				fmt.Fprintf(os.Stderr, "Error: invalid --preview-type %q\n", previewValue)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("localizations preview-sets create: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.CreateAppPreviewSet(requestCtx, trimmedID, previewValue)
+			if err != nil {
+				return fmt.Errorf("localizations preview-sets create: failed to create: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// LocalizationsPreviewSetsDeleteCommand returns the preview sets delete subcommand.
+func LocalizationsPreviewSetsDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations preview-sets delete", flag.ExitOnError)
+
+	setID := fs.String("id", "", "App preview set ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc localizations preview-sets delete --id \"PREVIEW_SET_ID\" --confirm",
+		ShortHelp:  "Delete a preview set and its previews.",
+		LongHelp: `Delete a preview set and its previews.
+
+Examples:
+  asc localizations preview-sets delete --id "PREVIEW_SET_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedID := strings.TrimSpace(*setID)
+			if trimmedID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required to delete")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("localizations preview-sets delete: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteAppPreviewSet(requestCtx, trimmedID); err != nil {
+				return fmt.Errorf("localizations preview-sets delete: failed to delete: %w", err)
+			}
+
+			result := asc.AssetDeleteResult{
+				ID:      trimmedID,
+				Deleted: true,
+			}
+
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
+
 // LocalizationsPreviewSetsRelationshipsCommand returns the preview sets relationships subcommand.
 func LocalizationsPreviewSetsRelationshipsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("localizations preview-sets relationships", flag.ExitOnError)
@@ -242,12 +363,16 @@ func LocalizationsScreenshotSetsCommand() *ffcli.Command {
 Examples:
   asc localizations screenshot-sets list --localization-id "LOCALIZATION_ID"
   asc localizations screenshot-sets get --id "SCREENSHOT_SET_ID"
+  asc localizations screenshot-sets create --localization-id "LOCALIZATION_ID" --display-type "APP_IPHONE_67"
+  asc localizations screenshot-sets delete --id "SCREENSHOT_SET_ID" --confirm
   asc localizations screenshot-sets relationships --localization-id "LOCALIZATION_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			LocalizationsScreenshotSetsListCommand(),
 			LocalizationsScreenshotSetsGetCommand(),
+			LocalizationsScreenshotSetsCreateCommand(),
+			LocalizationsScreenshotSetsDeleteCommand(),
 			LocalizationsScreenshotSetsRelationshipsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -299,11 +424,120 @@ Examples:
 	}
 }
 
+// LocalizationsScreenshotSetsCreateCommand returns the screenshot sets create subcommand.
+func LocalizationsScreenshotSetsCreateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations screenshot-sets create", flag.ExitOnError)
+
+	localizationID := fs.String("localization-id", "", "App Store version localization ID")
+	displayType := fs.String("display-type", "", "Screenshot display type (e.g. APP_IPHONE_67)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "create",
+		ShortUsage: "asc localizations screenshot-sets create --localization-id \"LOCALIZATION_ID\" --display-type \"APP_IPHONE_67\"",
+		ShortHelp:  "Create a screenshot set for an App Store localization.",
+		LongHelp: `Create a screenshot set for an App Store localization.
+
+A localization has at most one set per display type.
+
+Examples:
+  asc localizations screenshot-sets create --localization-id "LOCALIZATION_ID" --display-type "APP_IPHONE_67"
+  asc localizations screenshot-sets create --localization-id "LOCALIZATION_ID" --display-type "IMESSAGE_APP_IPHONE_67"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedID := strings.TrimSpace(*localizationID)
+			if trimmedID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --localization-id is required")
+				return flag.ErrHelp
+			}
+			displayValue := strings.ToUpper(strings.TrimSpace(*displayType))
+			if displayValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --display-type is required")
+				return flag.ErrHelp
+			}
+			if !asc.IsValidScreenshotDisplayType(displayValue) {
+				fmt.Fprintf(os.Stderr, "Error: invalid --display-type %q\n", displayValue)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("localizations screenshot-sets create: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.CreateAppScreenshotSet(requestCtx, trimmedID, displayValue)
+			if err != nil {
+				return fmt.Errorf("localizations screenshot-sets create: failed to create: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// LocalizationsScreenshotSetsDeleteCommand returns the screenshot sets delete subcommand.
+func LocalizationsScreenshotSetsDeleteCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("localizations screenshot-sets delete", flag.ExitOnError)
+
+	setID := fs.String("id", "", "App screenshot set ID")
+	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "delete",
+		ShortUsage: "asc localizations screenshot-sets delete --id \"SCREENSHOT_SET_ID\" --confirm",
+		ShortHelp:  "Delete a screenshot set and its screenshots.",
+		LongHelp: `Delete a screenshot set and its screenshots.
+
+Examples:
+  asc localizations screenshot-sets delete --id "SCREENSHOT_SET_ID" --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedID := strings.TrimSpace(*setID)
+			if trimmedID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required to delete")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("localizations screenshot-sets delete: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if err := client.DeleteAppScreenshotSet(requestCtx, trimmedID); err != nil {
+				return fmt.Errorf("localizations screenshot-sets delete: failed to delete: %w", err)
+			}
+
+			result := asc.AssetDeleteResult{
+				ID:      trimmedID,
+				Deleted: true,
+			}
+
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
+
 // LocalizationsScreenshotSetsListCommand returns the screenshot sets list subcommand.
 func LocalizationsScreenshotSetsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("localizations screenshot-sets list", flag.ExitOnError)
 
 	localizationID := fs.String("localization-id", "", "App Store version localization ID")
+	displayType := fs.String("display-type", "", "Filter by display type(s), comma-separated (e.g. APP_IPHONE_67)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -317,7 +551,8 @@ func LocalizationsScreenshotSetsListCommand() *ffcli.Command {
 		LongHelp: `List screenshot sets for an App Store localization.
 
 Examples:
-  asc localizations screenshot-sets list --localization-id "LOCALIZATION_ID"`,
+  asc localizations screenshot-sets list --localization-id "LOCALIZATION_ID"
+  asc localizations screenshot-sets list --localization-id "LOCALIZATION_ID" --display-type "APP_IPHONE_67,APP_IPAD_PRO_3GEN_129"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -333,6 +568,13 @@ Examples:
 			if err := shared.ValidateNextURL(*next); err != nil {
 				return fmt.Errorf("localizations screenshot-sets list: %w", err)
 			}
+			displayTypes := shared.SplitCSVUpper(*displayType)
+			for _, value := range displayTypes {
+				if !asc.IsValidScreenshotDisplayType(value) {
+					fmt.Fprintf(os.Stderr, "Error: invalid --display-type %q\n", value)
+					return flag.ErrHelp
+				}
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -345,6 +587,7 @@ Examples:
 			opts := []asc.AppStoreVersionLocalizationScreenshotSetsOption{
 				asc.WithAppStoreVersionLocalizationScreenshotSetsLimit(*limit),
 				asc.WithAppStoreVersionLocalizationScreenshotSetsNextURL(*next),
+				asc.WithAppStoreVersionLocalizationScreenshotSetsDisplayTypes(displayTypes),
 			}
 
 			if *paginate {
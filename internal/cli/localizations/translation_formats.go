@@ -0,0 +1,209 @@
+package localizations
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+const (
+	translationFormatXLIFF = "xliff"
+	translationFormatCSV   = "csv"
+
+	xliffNamespace = "urn:oasis:names:tc:xliff:document:1.2"
+)
+
+// translationLimits are App Store Connect character limits, passed to
+// translators as XLIFF maxwidth hints.
+var translationLimits = map[string]int{
+	"name":            30,
+	"subtitle":        30,
+	"keywords":        100,
+	"promotionalText": 170,
+	"description":     4000,
+	"whatsNew":        4000,
+}
+
+// translationSet is the source and target values for one target locale.
+type translationSet struct {
+	SourceLocale string
+	TargetLocale string
+	Original     string
+	Keys         []string
+	Source       map[string]string
+	Target       map[string]string
+}
+
+type xliffDocument struct {
+	XMLName xml.Name    `xml:"xliff"`
+	Xmlns   string      `xml:"xmlns,attr,omitempty"`
+	Version string      `xml:"version,attr"`
+	Files   []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Original       string      `xml:"original,attr"`
+	SourceLanguage string      `xml:"source-language,attr"`
+	TargetLanguage string      `xml:"target-language,attr"`
+	Datatype       string      `xml:"datatype,attr"`
+	Units          []xliffUnit `xml:"body>trans-unit"`
+}
+
+type xliffUnit struct {
+	ID       string       `xml:"id,attr"`
+	MaxWidth int          `xml:"maxwidth,attr,omitempty"`
+	SizeUnit string       `xml:"size-unit,attr,omitempty"`
+	Source   string       `xml:"source"`
+	Target   *xliffTarget `xml:"target"`
+}
+
+type xliffTarget struct {
+	State string `xml:"state,attr,omitempty"`
+	Text  string `xml:",chardata"`
+}
+
+// writeXLIFF writes set as an XLIFF 1.2 document with one trans-unit per
+// field that has a source value. Existing translations are included as
+// targets so translators only need to fill the gaps.
+func writeXLIFF(w io.Writer, set translationSet) error {
+	file := xliffFile{
+		Original:       set.Original,
+		SourceLanguage: set.SourceLocale,
+		TargetLanguage: set.TargetLocale,
+		Datatype:       "plaintext",
+	}
+	for _, key := range set.Keys {
+		source := set.Source[key]
+		if strings.TrimSpace(source) == "" {
+			continue
+		}
+		unit := xliffUnit{ID: key, Source: source}
+		if limit, ok := translationLimits[key]; ok {
+			unit.MaxWidth = limit
+			unit.SizeUnit = "char"
+		}
+		if target := set.Target[key]; strings.TrimSpace(target) != "" {
+			unit.Target = &xliffTarget{State: "translated", Text: target}
+		}
+		file.Units = append(file.Units, unit)
+	}
+
+	doc := xliffDocument{Xmlns: xliffNamespace, Version: "1.2", Files: []xliffFile{file}}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// readXLIFF returns the non-empty target values of an XLIFF 1.2 document,
+// keyed by target language and trans-unit ID.
+func readXLIFF(r io.Reader) (map[string]map[string]string, error) {
+	var doc xliffDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid XLIFF: %w", err)
+	}
+
+	values := make(map[string]map[string]string)
+	for _, file := range doc.Files {
+		locale := strings.TrimSpace(file.TargetLanguage)
+		if locale == "" {
+			return nil, fmt.Errorf("invalid XLIFF: <file> has no target-language")
+		}
+		for _, unit := range file.Units {
+			if unit.Target == nil || strings.TrimSpace(unit.Target.Text) == "" {
+				continue
+			}
+			if values[locale] == nil {
+				values[locale] = make(map[string]string)
+			}
+			values[locale][unit.ID] = unit.Target.Text
+		}
+	}
+	return values, nil
+}
+
+// writeTranslationCSV writes one row per field and one column per locale,
+// with the source locale first.
+func writeTranslationCSV(w io.Writer, sourceLocale string, keys []string, valuesByLocale map[string]map[string]string) error {
+	locales := make([]string, 0, len(valuesByLocale))
+	for locale := range valuesByLocale {
+		if locale != sourceLocale {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
+	if _, ok := valuesByLocale[sourceLocale]; ok {
+		locales = append([]string{sourceLocale}, locales...)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(append([]string{"field"}, locales...)); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		row := []string{key}
+		empty := true
+		for _, locale := range locales {
+			value := valuesByLocale[locale][key]
+			if strings.TrimSpace(value) != "" {
+				empty = false
+			}
+			row = append(row, value)
+		}
+		if empty {
+			continue
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// readTranslationCSV reads a file written by writeTranslationCSV. Empty
+// cells are skipped so they never clear existing values.
+func readTranslationCSV(r io.Reader) (map[string]map[string]string, error) {
+	reader := csv.NewReader(fileutil.SkipBOM(r))
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("invalid CSV: missing header row")
+	}
+	header := records[0]
+	if len(header) < 2 || strings.TrimSpace(header[0]) != "field" {
+		return nil, fmt.Errorf("invalid CSV: header must be field,<locale>,...")
+	}
+
+	values := make(map[string]map[string]string)
+	for _, record := range records[1:] {
+		key := strings.TrimSpace(record[0])
+		if key == "" {
+			continue
+		}
+		for i := 1; i < len(record) && i < len(header); i++ {
+			locale := strings.TrimSpace(header[i])
+			if locale == "" || strings.TrimSpace(record[i]) == "" {
+				continue
+			}
+			if values[locale] == nil {
+				values[locale] = make(map[string]string)
+			}
+			values[locale][key] = record[i]
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,85 @@
+package localizations
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestXLIFFRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeXLIFF(&buf, translationSet{
+		SourceLocale: "en-US",
+		TargetLocale: "de-DE",
+		Original:     "appStoreVersions/VERSION_ID",
+		Keys:         []string{"description", "keywords", "whatsNew"},
+		Source:       map[string]string{"description": "Line one\nLine <two> & more", "keywords": "a,b", "whatsNew": ""},
+		Target:       map[string]string{"keywords": "x,y"},
+	})
+	if err != nil {
+		t.Fatalf("writeXLIFF: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		`xmlns="urn:oasis:names:tc:xliff:document:1.2"`,
+		`source-language="en-US" target-language="de-DE"`,
+		`<trans-unit id="keywords" maxwidth="100" size-unit="char">`,
+		`<target state="translated">x,y</target>`,
+		`Line &lt;two&gt; &amp; more`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, `id="whatsNew"`) {
+		t.Fatalf("expected empty source fields to be skipped:\n%s", out)
+	}
+
+	translated := strings.Replace(out, `<trans-unit id="description" maxwidth="4000" size-unit="char">`, `<trans-unit id="description" maxwidth="4000" size-unit="char">
+        <target>Zeile eins&#xA;Zeile &lt;zwei&gt;</target>`, 1)
+	values, err := readXLIFF(strings.NewReader(translated))
+	if err != nil {
+		t.Fatalf("readXLIFF: %v", err)
+	}
+	if got := values["de-DE"]["description"]; got != "Zeile eins\nZeile <zwei>" {
+		t.Fatalf("unexpected description %q", got)
+	}
+	if got := values["de-DE"]["keywords"]; got != "x,y" {
+		t.Fatalf("unexpected keywords %q", got)
+	}
+}
+
+func TestReadXLIFFRequiresTargetLanguage(t *testing.T) {
+	_, err := readXLIFF(strings.NewReader(`<xliff version="1.2"><file source-language="en-US"><body></body></file></xliff>`))
+	if err == nil || !strings.Contains(err.Error(), "target-language") {
+		t.Fatalf("expected target-language error, got %v", err)
+	}
+}
+
+func TestTranslationCSVRoundTrip(t *testing.T) {
+	valuesByLocale := map[string]map[string]string{
+		"fr-FR": {"description": "Une app"},
+		"en-US": {"description": "An app, with \"quotes\"", "keywords": "a,b"},
+		"de-DE": {},
+	}
+
+	var buf bytes.Buffer
+	if err := writeTranslationCSV(&buf, "en-US", []string{"description", "keywords", "whatsNew"}, valuesByLocale); err != nil {
+		t.Fatalf("writeTranslationCSV: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if lines[0] != "field,en-US,de-DE,fr-FR" || len(lines) != 3 {
+		t.Fatalf("unexpected CSV:\n%s", buf.String())
+	}
+
+	values, err := readTranslationCSV(strings.NewReader("\ufeff" + buf.String()))
+	if err != nil {
+		t.Fatalf("readTranslationCSV: %v", err)
+	}
+	if values["en-US"]["description"] != "An app, with \"quotes\"" || values["fr-FR"]["description"] != "Une app" {
+		t.Fatalf("unexpected values: %v", values)
+	}
+	if _, ok := values["de-DE"]; ok {
+		t.Fatalf("expected empty locale to be skipped, got %v", values["de-DE"])
+	}
+}
@@ -0,0 +1,483 @@
+package localizations
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// LocalizationsExportCommand returns the export localizations subcommand.
+func LocalizationsExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	versionID := fs.String("version", "", "App Store version ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
+	format := fs.String("format", "", "File format: xliff or csv (required)")
+	sourceLocale := fs.String("source-locale", "en-US", "Locale translators translate from")
+	locale := fs.String("locale", "", "Target locale(s) to export, comma-separated (default: all others)")
+	path := fs.String("path", "", "Output directory for xliff (default: translations) or file for csv (default: translations.csv)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc localizations export --format <xliff|csv> [flags]",
+		ShortHelp:  "Export localizations as XLIFF or CSV for translators.",
+		LongHelp: `Export localizations as XLIFF or CSV for translators.
+
+xliff writes one XLIFF 1.2 file per target locale (<path>/<locale>.xliff)
+with a trans-unit per field, the source-locale text as <source>, and any
+existing translation as <target>. Character limits are set as maxwidth.
+
+csv writes a single file with one row per field and one column per locale,
+the source locale first.
+
+Import the translated files with asc localizations import.
+
+Examples:
+  asc localizations export --version "VERSION_ID" --format xliff --path ./translations
+  asc localizations export --version "VERSION_ID" --format xliff --locale "de-DE,fr-FR"
+  asc localizations export --app "APP_ID" --type app-info --format csv --path app-info.csv`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			formatValue := strings.ToLower(strings.TrimSpace(*format))
+			if formatValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --format is required")
+				return flag.ErrHelp
+			}
+			if formatValue != translationFormatXLIFF && formatValue != translationFormatCSV {
+				fmt.Fprintln(os.Stderr, "Error: --format must be xliff or csv")
+				return flag.ErrHelp
+			}
+			sourceValue := strings.TrimSpace(*sourceLocale)
+			if sourceValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --source-locale is required")
+				return flag.ErrHelp
+			}
+
+			normalizedType, err := shared.NormalizeLocalizationType(*locType)
+			if err != nil {
+				return fmt.Errorf("localizations export: %w", err)
+			}
+			target, err := resolveTranslationTarget(normalizedType, *versionID, *appID)
+			if err != nil {
+				return err
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("localizations export: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if err := target.resolve(requestCtx, client, strings.TrimSpace(*appInfoID)); err != nil {
+				return fmt.Errorf("localizations export: %w", err)
+			}
+			valuesByLocale, err := target.fetch(requestCtx, client)
+			if err != nil {
+				return fmt.Errorf("localizations export: %w", err)
+			}
+			if _, ok := valuesByLocale[sourceValue]; !ok {
+				return fmt.Errorf("localizations export: source locale %q not found", sourceValue)
+			}
+			valuesByLocale = filterTranslationLocales(valuesByLocale, sourceValue, shared.SplitCSV(*locale))
+
+			outputPath := strings.TrimSpace(*path)
+			var files []asc.LocalizationFileResult
+			switch formatValue {
+			case translationFormatXLIFF:
+				if outputPath == "" {
+					outputPath = "translations"
+				}
+				files, err = writeXLIFFFiles(outputPath, target, sourceValue, valuesByLocale)
+			case translationFormatCSV:
+				if outputPath == "" {
+					outputPath = "translations.csv"
+				}
+				files, err = writeCSVFile(outputPath, target.keys, sourceValue, valuesByLocale)
+			}
+			if err != nil {
+				return fmt.Errorf("localizations export: %w", err)
+			}
+
+			result := asc.LocalizationDownloadResult{
+				Type:       normalizedType,
+				VersionID:  target.versionID,
+				AppID:      target.appID,
+				AppInfoID:  target.appInfoID,
+				OutputPath: outputPath,
+				Files:      files,
+			}
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
+
+// LocalizationsImportCommand returns the import localizations subcommand.
+func LocalizationsImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+
+	versionID := fs.String("version", "", "App Store version ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
+	format := fs.String("format", "", "File format: xliff or csv (default: from the file extension)")
+	locale := fs.String("locale", "", "Import only these locale(s), comma-separated")
+	path := fs.String("path", "", "XLIFF file or directory of XLIFF files, or CSV file (required)")
+	dryRun := fs.Bool("dry-run", false, "Validate files without uploading")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc localizations import --path <file|dir> [flags]",
+		ShortHelp:  "Import translated XLIFF or CSV files.",
+		LongHelp: `Import translated XLIFF or CSV files.
+
+XLIFF targets are imported for each file's target-language; CSV columns are
+imported per locale. Empty targets and cells are skipped, so untranslated
+fields never clear existing values.
+
+Examples:
+  asc localizations import --version "VERSION_ID" --path ./translations
+  asc localizations import --version "VERSION_ID" --path ./translations/de-DE.xliff --dry-run
+  asc localizations import --app "APP_ID" --type app-info --path app-info.csv --locale "de-DE,fr-FR"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			pathValue := strings.TrimSpace(*path)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --path is required")
+				return flag.ErrHelp
+			}
+			formatValue := strings.ToLower(strings.TrimSpace(*format))
+			if formatValue != "" && formatValue != translationFormatXLIFF && formatValue != translationFormatCSV {
+				fmt.Fprintln(os.Stderr, "Error: --format must be xliff or csv")
+				return flag.ErrHelp
+			}
+
+			normalizedType, err := shared.NormalizeLocalizationType(*locType)
+			if err != nil {
+				return fmt.Errorf("localizations import: %w", err)
+			}
+			target, err := resolveTranslationTarget(normalizedType, *versionID, *appID)
+			if err != nil {
+				return err
+			}
+
+			valuesByLocale, err := readTranslationFiles(pathValue, formatValue)
+			if err != nil {
+				return fmt.Errorf("localizations import: %w", err)
+			}
+			valuesByLocale = filterTranslationLocales(valuesByLocale, "", shared.SplitCSV(*locale))
+			if len(valuesByLocale) == 0 {
+				return fmt.Errorf("localizations import: no translated values found in %s", pathValue)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("localizations import: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if err := target.resolve(requestCtx, client, strings.TrimSpace(*appInfoID)); err != nil {
+				return fmt.Errorf("localizations import: %w", err)
+			}
+
+			var results []asc.LocalizationUploadLocaleResult
+			if normalizedType == shared.LocalizationTypeVersion {
+				results, err = shared.UploadVersionLocalizations(requestCtx, client, target.versionID, valuesByLocale, *dryRun)
+			} else {
+				results, err = shared.UploadAppInfoLocalizations(requestCtx, client, target.appInfoID, valuesByLocale, *dryRun)
+			}
+			if err != nil {
+				return fmt.Errorf("localizations import: %w", err)
+			}
+
+			result := asc.LocalizationUploadResult{
+				Type:      normalizedType,
+				VersionID: target.versionID,
+				AppID:     target.appID,
+				AppInfoID: target.appInfoID,
+				DryRun:    *dryRun,
+				Results:   results,
+			}
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
+
+// translationTarget identifies the localizations being exported or imported.
+type translationTarget struct {
+	locType   string
+	versionID string
+	appID     string
+	appInfoID string
+	keys      []string
+}
+
+func resolveTranslationTarget(locType, versionID, appID string) (*translationTarget, error) {
+	switch locType {
+	case shared.LocalizationTypeVersion:
+		if strings.TrimSpace(versionID) == "" {
+			fmt.Fprintln(os.Stderr, "Error: --version is required for version localizations")
+			return nil, flag.ErrHelp
+		}
+		return &translationTarget{locType: locType, versionID: strings.TrimSpace(versionID), keys: shared.VersionLocalizationKeys()}, nil
+	default:
+		resolvedAppID := shared.ResolveAppID(appID)
+		if resolvedAppID == "" {
+			fmt.Fprintln(os.Stderr, "Error: --app is required for app-info localizations")
+			return nil, flag.ErrHelp
+		}
+		return &translationTarget{locType: locType, appID: resolvedAppID, keys: shared.AppInfoLocalizationKeys()}, nil
+	}
+}
+
+func (t *translationTarget) resolve(ctx context.Context, client *asc.Client, appInfoOverride string) error {
+	if t.locType != shared.LocalizationTypeAppInfo {
+		return nil
+	}
+	appInfo, err := shared.ResolveAppInfoID(ctx, client, t.appID, appInfoOverride)
+	if err != nil {
+		return err
+	}
+	t.appInfoID = appInfo
+	return nil
+}
+
+// original names the exported resource in XLIFF files.
+func (t *translationTarget) original() string {
+	if t.locType == shared.LocalizationTypeAppInfo {
+		return "appInfos/" + t.appInfoID
+	}
+	return "appStoreVersions/" + t.versionID
+}
+
+// fetch returns every localization's non-empty values keyed by locale.
+func (t *translationTarget) fetch(ctx context.Context, client *asc.Client) (map[string]map[string]string, error) {
+	valuesByLocale := make(map[string]map[string]string)
+	if t.locType == shared.LocalizationTypeAppInfo {
+		firstPage, err := client.GetAppInfoLocalizations(ctx, t.appInfoID, asc.WithAppInfoLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch: %w", err)
+		}
+		resp, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetAppInfoLocalizations(ctx, t.appInfoID, asc.WithAppInfoLocalizationsNextURL(nextURL))
+		})
+		if err != nil {
+			return nil, err
+		}
+		aggregated, ok := resp.(*asc.AppInfoLocalizationsResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected pagination response type")
+		}
+		for _, item := range aggregated.Data {
+			valuesByLocale[item.Attributes.Locale] = shared.AppInfoLocalizationValues(item.Attributes)
+		}
+		return valuesByLocale, nil
+	}
+
+	firstPage, err := client.GetAppStoreVersionLocalizations(ctx, t.versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+	resp, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersionLocalizations(ctx, t.versionID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	aggregated, ok := resp.(*asc.AppStoreVersionLocalizationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected pagination response type")
+	}
+	for _, item := range aggregated.Data {
+		valuesByLocale[item.Attributes.Locale] = shared.VersionLocalizationValues(item.Attributes)
+	}
+	return valuesByLocale, nil
+}
+
+// filterTranslationLocales keeps the source locale (when set) and the given
+// locales. An empty filter keeps everything.
+func filterTranslationLocales(valuesByLocale map[string]map[string]string, sourceLocale string, locales []string) map[string]map[string]string {
+	if len(locales) == 0 {
+		return valuesByLocale
+	}
+	keep := make(map[string]bool, len(locales)+1)
+	for _, locale := range locales {
+		keep[locale] = true
+	}
+	if sourceLocale != "" {
+		keep[sourceLocale] = true
+	}
+	filtered := make(map[string]map[string]string, len(locales))
+	for locale, values := range valuesByLocale {
+		if keep[locale] {
+			filtered[locale] = values
+		}
+	}
+	return filtered
+}
+
+func writeXLIFFFiles(dir string, target *translationTarget, sourceLocale string, valuesByLocale map[string]map[string]string) ([]asc.LocalizationFileResult, error) {
+	locales := make([]string, 0, len(valuesByLocale))
+	for locale := range valuesByLocale {
+		if locale == sourceLocale {
+			continue
+		}
+		if !shared.IsValidLocale(locale) {
+			return nil, fmt.Errorf("invalid locale code %q", locale)
+		}
+		locales = append(locales, locale)
+	}
+	if len(locales) == 0 {
+		return nil, fmt.Errorf("no target locales to export besides %s", sourceLocale)
+	}
+	sort.Strings(locales)
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	files := make([]asc.LocalizationFileResult, 0, len(locales))
+	for _, locale := range locales {
+		var buf bytes.Buffer
+		err := writeXLIFF(&buf, translationSet{
+			SourceLocale: sourceLocale,
+			TargetLocale: locale,
+			Original:     target.original(),
+			Keys:         target.keys,
+			Source:       valuesByLocale[sourceLocale],
+			Target:       valuesByLocale[locale],
+		})
+		if err != nil {
+			return nil, err
+		}
+		path := filepath.Join(dir, locale+".xliff")
+		if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			return nil, err
+		}
+		files = append(files, asc.LocalizationFileResult{Locale: locale, Path: path})
+	}
+	return files, nil
+}
+
+func writeCSVFile(path string, keys []string, sourceLocale string, valuesByLocale map[string]map[string]string) ([]asc.LocalizationFileResult, error) {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	if err := writeTranslationCSV(&buf, sourceLocale, keys, valuesByLocale); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return nil, err
+	}
+
+	locales := make([]string, 0, len(valuesByLocale))
+	for locale := range valuesByLocale {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	files := make([]asc.LocalizationFileResult, 0, len(locales))
+	for _, locale := range locales {
+		files = append(files, asc.LocalizationFileResult{Locale: locale, Path: path})
+	}
+	return files, nil
+}
+
+// readTranslationFiles reads a CSV file, an XLIFF file, or every .xliff/.xlf
+// file in a directory. Without an explicit format it is taken from the
+// file extension.
+func readTranslationFiles(path, format string) (map[string]map[string]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	if info.IsDir() {
+		if format == translationFormatCSV {
+			return nil, fmt.Errorf("--path must be a file for csv")
+		}
+		format = translationFormatXLIFF
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			ext := strings.ToLower(filepath.Ext(entry.Name()))
+			if !entry.IsDir() && (ext == ".xliff" || ext == ".xlf") {
+				paths = append(paths, filepath.Join(path, entry.Name()))
+			}
+		}
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("no .xliff files found in %q", path)
+		}
+	} else {
+		paths = []string{path}
+		if format == "" {
+			switch strings.ToLower(filepath.Ext(path)) {
+			case ".xliff", ".xlf":
+				format = translationFormatXLIFF
+			case ".csv":
+				format = translationFormatCSV
+			default:
+				return nil, fmt.Errorf("cannot infer format from %q (use --format)", path)
+			}
+		}
+	}
+
+	valuesByLocale := make(map[string]map[string]string)
+	for _, filePath := range paths {
+		values, err := readTranslationFile(filePath, format)
+		if err != nil {
+			return nil, err
+		}
+		for locale, fields := range values {
+			if _, exists := valuesByLocale[locale]; exists {
+				return nil, fmt.Errorf("duplicate locale %q in %s", locale, path)
+			}
+			valuesByLocale[locale] = fields
+		}
+	}
+	return valuesByLocale, nil
+}
+
+func readTranslationFile(path, format string) (map[string]map[string]string, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var values map[string]map[string]string
+	if format == translationFormatCSV {
+		values, err = readTranslationCSV(file)
+	} else {
+		values, err = readXLIFF(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return values, nil
+}
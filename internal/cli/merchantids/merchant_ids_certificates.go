@@ -23,6 +23,8 @@ func MerchantIDsCertificatesCommand() *ffcli.Command {
 		ShortHelp:  "List merchant ID certificates.",
 		LongHelp: `List merchant ID certificates.
 
+Create Apple Pay certificates with "asc certificates create --merchant-id".
+
 Examples:
   asc merchant-ids certificates list --merchant-id "MERCHANT_ID"
   asc merchant-ids certificates get --merchant-id "MERCHANT_ID"`,
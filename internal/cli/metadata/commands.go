@@ -0,0 +1,8 @@
+package metadata
+
+import "github.com/peterbourgon/ff/v3/ffcli"
+
+// Command returns the metadata command group.
+func Command() *ffcli.Command {
+	return MetadataCommand()
+}
@@ -0,0 +1,174 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Diff change kinds, from the point of view of the local files.
+const (
+	diffAdded   = "added"
+	diffChanged = "changed"
+	diffRemoved = "removed"
+)
+
+// appInfoFields are compared only when --app is given.
+var appInfoFields = map[string]bool{
+	"name":             true,
+	"subtitle":         true,
+	"privacyPolicyUrl": true,
+}
+
+// MetadataDiffCommand returns the metadata diff subcommand.
+func MetadataDiffCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata diff", flag.ExitOnError)
+
+	dir := fs.String("dir", "", "Path to a fastlane directory or its metadata directory")
+	remote := fs.Bool("remote", false, "Compare against the localizations stored in App Store Connect (required)")
+	versionID := fs.String("version-id", "", "App Store version ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID); also compares name, subtitle, and privacy URL")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "diff",
+		ShortUsage: "asc metadata diff --dir <path> --remote --version-id <id> [flags]",
+		ShortHelp:  "Compare local fastlane metadata with App Store Connect.",
+		LongHelp: `Compare local fastlane metadata with App Store Connect.
+
+Reports each field that differs, per locale:
+  - added: set locally but empty or missing in App Store Connect
+  - changed: set in both with different values
+  - removed: set in App Store Connect but missing locally
+
+Values are compared with surrounding whitespace trimmed. Without --app only
+version fields are compared; name, subtitle, and privacy URL live on the
+App Info and need --app.
+
+The command exits non-zero when any difference is found, so it can gate a
+release in CI.
+
+Examples:
+  asc metadata diff --dir ./fastlane --remote --version-id "VERSION_ID"
+  asc metadata diff --dir ./fastlane --remote --version-id "VERSION_ID" --app "APP_ID" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			if !*remote {
+				fmt.Fprintln(os.Stderr, "Error: --remote is required")
+				return flag.ErrHelp
+			}
+			versionValue := strings.TrimSpace(*versionID)
+			if versionValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+
+			local, err := readLocalLocalizations(dirValue)
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					return fmt.Errorf("metadata diff: metadata directory not found: %s", dirValue)
+				}
+				return fmt.Errorf("metadata diff: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("metadata diff: %w", err)
+			}
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resolvedAppID := shared.ResolveAppID(*appID)
+			remoteLocs, err := fetchRemoteLocalizations(requestCtx, client, versionValue, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("metadata diff: %w", err)
+			}
+
+			result := &asc.MetadataDiffResult{
+				Dir:       dirValue,
+				VersionID: versionValue,
+				AppID:     resolvedAppID,
+				Changes:   diffLocalizations(local, remoteLocs, resolvedAppID != ""),
+			}
+			for _, change := range result.Changes {
+				switch change.Change {
+				case diffAdded:
+					result.Added++
+				case diffChanged:
+					result.Changed++
+				case diffRemoved:
+					result.Removed++
+				}
+			}
+			result.InSync = len(result.Changes) == 0
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if !result.InSync {
+				return shared.NewReportedError(fmt.Errorf("metadata diff: found %d difference(s)", len(result.Changes)))
+			}
+			return nil
+		},
+	}
+}
+
+// diffLocalizations compares local and remote field values. Changes are
+// ordered by locale, then by field order in lintFields. App Info fields are
+// skipped unless includeAppInfo is set.
+func diffLocalizations(local, remote []lintLocalization, includeAppInfo bool) []asc.MetadataDiffChange {
+	localByLocale := make(map[string]map[string]string, len(local))
+	remoteByLocale := make(map[string]map[string]string, len(remote))
+	locales := make([]string, 0, len(local)+len(remote))
+	for _, loc := range local {
+		localByLocale[loc.Locale] = loc.Fields
+		locales = append(locales, loc.Locale)
+	}
+	for _, loc := range remote {
+		remoteByLocale[loc.Locale] = loc.Fields
+		if _, ok := localByLocale[loc.Locale]; !ok {
+			locales = append(locales, loc.Locale)
+		}
+	}
+	sort.Strings(locales)
+
+	changes := make([]asc.MetadataDiffChange, 0)
+	for _, locale := range locales {
+		for _, field := range lintFields {
+			if appInfoFields[field.name] && !includeAppInfo {
+				continue
+			}
+			localValue := strings.TrimSpace(localByLocale[locale][field.name])
+			remoteValue := strings.TrimSpace(remoteByLocale[locale][field.name])
+			change := asc.MetadataDiffChange{Locale: locale, Field: field.name, Local: localValue, Remote: remoteValue}
+			switch {
+			case localValue == remoteValue:
+				continue
+			case remoteValue == "":
+				change.Change = diffAdded
+			case localValue == "":
+				change.Change = diffRemoved
+			default:
+				change.Change = diffChanged
+			}
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
@@ -0,0 +1,52 @@
+package metadata
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffLocalizations(t *testing.T) {
+	local := []lintLocalization{
+		{Locale: "en-US", Fields: map[string]string{
+			"name":        "My App",
+			"description": "New description",
+			"keywords":    "app,tools",
+			"whatsNew":    "Bug fixes",
+		}},
+		{Locale: "fr-FR", Fields: map[string]string{"description": "Une app"}},
+	}
+	remote := []lintLocalization{
+		{Locale: "en-US", Fields: map[string]string{
+			"name":            "Old App",
+			"description":     "Old description",
+			"keywords":        "app,tools\n",
+			"promotionalText": "Sale",
+		}},
+		{Locale: "de-DE", Fields: map[string]string{"description": "Eine App"}},
+	}
+
+	changes := diffLocalizations(local, remote, false)
+
+	want := []string{
+		"de-DE/description/removed",
+		"en-US/description/changed",
+		"en-US/whatsNew/added",
+		"en-US/promotionalText/removed",
+		"fr-FR/description/added",
+	}
+	got := make([]string, 0, len(changes))
+	for _, change := range changes {
+		got = append(got, change.Locale+"/"+change.Field+"/"+change.Change)
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected changes:\ngot:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if changes[1].Local != "New description" || changes[1].Remote != "Old description" {
+		t.Fatalf("expected both values on changed field, got %+v", changes[1])
+	}
+
+	withAppInfo := diffLocalizations(local, remote, true)
+	if len(withAppInfo) != len(changes)+1 || withAppInfo[1].Field != "name" || withAppInfo[1].Change != diffChanged {
+		t.Fatalf("expected name change with app info, got %+v", withAppInfo)
+	}
+}
@@ -0,0 +1,395 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Lint rule identifiers reported in findings.
+const (
+	ruleMaxLength           = "max-length"
+	ruleInvalidURL          = "invalid-url"
+	ruleDisallowedCharacter = "disallowed-character"
+	ruleMissingLocale       = "missing-locale"
+	ruleMissingField        = "missing-field"
+	ruleRequiredField       = "required-field"
+
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+// lintField describes one localized metadata field.
+type lintField struct {
+	name      string // API attribute name
+	file      string // fastlane metadata file name
+	limit     int    // maximum characters, 0 when unlimited
+	url       bool
+	multiline bool
+}
+
+var lintFields = []lintField{
+	{name: "name", file: "name.txt", limit: 30},
+	{name: "subtitle", file: "subtitle.txt", limit: 30},
+	{name: "description", file: "description.txt", limit: 4000, multiline: true},
+	{name: "keywords", file: "keywords.txt", limit: 100},
+	{name: "whatsNew", file: "release_notes.txt", limit: 4000, multiline: true},
+	{name: "promotionalText", file: "promotional_text.txt", limit: 170, multiline: true},
+	{name: "supportUrl", file: "support_url.txt", url: true},
+	{name: "marketingUrl", file: "marketing_url.txt", url: true},
+	{name: "privacyPolicyUrl", file: "privacy_url.txt", url: true},
+}
+
+// lintLocalization holds the field values of one locale keyed by attribute name.
+type lintLocalization struct {
+	Locale string
+	Fields map[string]string
+}
+
+// MetadataLintCommand returns the metadata lint subcommand.
+func MetadataLintCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata lint", flag.ExitOnError)
+
+	dir := fs.String("dir", "", "Path to a fastlane directory or its metadata directory")
+	remote := fs.Bool("remote", false, "Lint the localizations stored in App Store Connect instead of local files")
+	versionID := fs.String("version-id", "", "App Store version ID (required with --remote)")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID); with --remote also lints App Info localizations")
+	requireLocales := fs.String("require-locales", "", "Comma-separated locales that must be present (e.g. en-US,de-DE)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "lint",
+		ShortUsage: "asc metadata lint (--dir <path> | --remote --version-id <id>) [flags]",
+		ShortHelp:  "Lint localized metadata against App Store Connect constraints.",
+		LongHelp: `Lint localized metadata against App Store Connect constraints.
+
+Checks, per locale:
+  - max-length: name and subtitle 30, keywords 100, promotional text 170,
+    description and what's new 4000 characters
+  - invalid-url: support, marketing, and privacy policy URLs must be absolute http(s) URLs
+  - disallowed-character: control characters, invalid UTF-8, and line breaks in single-line fields
+  - missing-locale: locales listed in --require-locales that are absent
+  - missing-field: fields filled in some locales but empty in others (warning)
+  - required-field: empty description (warning)
+
+Findings are machine-readable; the command exits non-zero when any error is found.
+
+Examples:
+  asc metadata lint --dir ./fastlane
+  asc metadata lint --dir ./fastlane --require-locales "en-US,de-DE,ja" --output table
+  asc metadata lint --remote --version-id "VERSION_ID"
+  asc metadata lint --remote --app "APP_ID" --version-id "VERSION_ID" --output gha`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			dirValue := strings.TrimSpace(*dir)
+			versionValue := strings.TrimSpace(*versionID)
+			if *remote {
+				if dirValue != "" {
+					fmt.Fprintln(os.Stderr, "Error: --dir and --remote are mutually exclusive")
+					return flag.ErrHelp
+				}
+				if versionValue == "" {
+					fmt.Fprintln(os.Stderr, "Error: --version-id is required with --remote")
+					return flag.ErrHelp
+				}
+			} else if dirValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir or --remote is required")
+				return flag.ErrHelp
+			}
+
+			result := &asc.MetadataLintResult{}
+			var localizations []lintLocalization
+			if *remote {
+				result.Source = "remote"
+				result.VersionID = versionValue
+
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("metadata lint: %w", err)
+				}
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				localizations, err = fetchRemoteLocalizations(requestCtx, client, versionValue, shared.ResolveAppID(*appID))
+				if err != nil {
+					return fmt.Errorf("metadata lint: %w", err)
+				}
+			} else {
+				result.Source = "local"
+				result.Dir = dirValue
+
+				var err error
+				localizations, err = readLocalLocalizations(dirValue)
+				if err != nil {
+					if errors.Is(err, os.ErrNotExist) {
+						return fmt.Errorf("metadata lint: metadata directory not found: %s", dirValue)
+					}
+					return fmt.Errorf("metadata lint: %w", err)
+				}
+			}
+
+			result.Locales = make([]string, 0, len(localizations))
+			for _, loc := range localizations {
+				result.Locales = append(result.Locales, loc.Locale)
+			}
+			result.Findings = lintLocalizations(localizations, shared.SplitCSV(*requireLocales))
+			for _, finding := range result.Findings {
+				if finding.Severity == severityError {
+					result.ErrorCount++
+				} else {
+					result.WarningCount++
+				}
+			}
+			result.Valid = result.ErrorCount == 0
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if !result.Valid {
+				return shared.NewReportedError(fmt.Errorf("metadata lint: found %d error(s)", result.ErrorCount))
+			}
+			return nil
+		},
+	}
+}
+
+// readLocalLocalizations reads every locale directory of a fastlane metadata
+// tree. dir may point at the fastlane directory or at its metadata directory.
+func readLocalLocalizations(dir string) ([]lintLocalization, error) {
+	metadataDir := filepath.Join(dir, "metadata")
+	if info, err := os.Stat(metadataDir); err != nil || !info.IsDir() {
+		metadataDir = dir
+	}
+
+	entries, err := os.ReadDir(metadataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata directory: %w", err)
+	}
+
+	var localizations []lintLocalization
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || name == "review_information" || name == "default" || strings.HasPrefix(name, ".") {
+			continue
+		}
+		loc := lintLocalization{Locale: name, Fields: make(map[string]string)}
+		for _, field := range lintFields {
+			data, err := os.ReadFile(filepath.Join(metadataDir, name, field.file))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to read %s: %w", filepath.Join(name, field.file), err)
+			}
+			loc.Fields[field.name] = strings.TrimSpace(string(data))
+		}
+		localizations = append(localizations, loc)
+	}
+	return localizations, nil
+}
+
+// fetchRemoteLocalizations loads version localizations and, when appID is set,
+// merges in the App Info localizations for the same locales.
+func fetchRemoteLocalizations(ctx context.Context, client *asc.Client, versionID, appID string) ([]lintLocalization, error) {
+	byLocale := make(map[string]*lintLocalization)
+	get := func(locale string) *lintLocalization {
+		loc, ok := byLocale[locale]
+		if !ok {
+			loc = &lintLocalization{Locale: locale, Fields: make(map[string]string)}
+			byLocale[locale] = loc
+		}
+		return loc
+	}
+
+	versionLocs, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch version localizations: %w", err)
+	}
+	for _, item := range versionLocs.Data {
+		attrs := item.Attributes
+		loc := get(attrs.Locale)
+		loc.Fields["description"] = attrs.Description
+		loc.Fields["keywords"] = attrs.Keywords
+		loc.Fields["whatsNew"] = attrs.WhatsNew
+		loc.Fields["promotionalText"] = attrs.PromotionalText
+		loc.Fields["supportUrl"] = attrs.SupportURL
+		loc.Fields["marketingUrl"] = attrs.MarketingURL
+	}
+
+	if appID != "" {
+		appInfos, err := client.GetAppInfos(ctx, appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get app info: %w", err)
+		}
+		appInfoID := shared.SelectBestAppInfoID(appInfos)
+		if strings.TrimSpace(appInfoID) == "" {
+			return nil, fmt.Errorf("failed to select app info for app")
+		}
+		infoLocs, err := client.GetAppInfoLocalizations(ctx, appInfoID, asc.WithAppInfoLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch app info localizations: %w", err)
+		}
+		for _, item := range infoLocs.Data {
+			attrs := item.Attributes
+			loc := get(attrs.Locale)
+			loc.Fields["name"] = attrs.Name
+			loc.Fields["subtitle"] = attrs.Subtitle
+			loc.Fields["privacyPolicyUrl"] = attrs.PrivacyPolicyURL
+		}
+	}
+
+	localizations := make([]lintLocalization, 0, len(byLocale))
+	for _, loc := range byLocale {
+		localizations = append(localizations, *loc)
+	}
+	sort.Slice(localizations, func(i, j int) bool { return localizations[i].Locale < localizations[j].Locale })
+	return localizations, nil
+}
+
+// lintLocalizations applies every rule to the localizations. Findings are
+// ordered by locale, then by field order in lintFields.
+func lintLocalizations(localizations []lintLocalization, requiredLocales []string) []asc.MetadataLintFinding {
+	findings := make([]asc.MetadataLintFinding, 0)
+
+	present := make(map[string]bool, len(localizations))
+	for _, loc := range localizations {
+		present[loc.Locale] = true
+	}
+	for _, locale := range requiredLocales {
+		if !present[locale] {
+			findings = append(findings, asc.MetadataLintFinding{
+				Locale:   locale,
+				Rule:     ruleMissingLocale,
+				Severity: severityError,
+				Message:  "required locale is missing",
+			})
+		}
+	}
+
+	// Fields filled in at least one locale are expected in all of them.
+	used := make(map[string]bool)
+	for _, loc := range localizations {
+		for name, value := range loc.Fields {
+			if value != "" {
+				used[name] = true
+			}
+		}
+	}
+
+	for _, loc := range localizations {
+		for _, field := range lintFields {
+			value := loc.Fields[field.name]
+			if value == "" {
+				if field.name == "description" {
+					findings = append(findings, asc.MetadataLintFinding{
+						Locale:   loc.Locale,
+						Field:    field.name,
+						Rule:     ruleRequiredField,
+						Severity: severityWarning,
+						Message:  "description is empty (usually required)",
+					})
+				} else if used[field.name] {
+					findings = append(findings, asc.MetadataLintFinding{
+						Locale:   loc.Locale,
+						Field:    field.name,
+						Rule:     ruleMissingField,
+						Severity: severityWarning,
+						Message:  "field is empty but set in other locales",
+					})
+				}
+				continue
+			}
+			findings = append(findings, lintValue(loc.Locale, field, value)...)
+		}
+	}
+	return findings
+}
+
+func lintValue(locale string, field lintField, value string) []asc.MetadataLintFinding {
+	var findings []asc.MetadataLintFinding
+
+	if length := utf8.RuneCountInString(value); field.limit > 0 && length > field.limit {
+		findings = append(findings, asc.MetadataLintFinding{
+			Locale:   locale,
+			Field:    field.name,
+			Rule:     ruleMaxLength,
+			Severity: severityError,
+			Message:  fmt.Sprintf("exceeds %d character limit", field.limit),
+			Length:   length,
+			Limit:    field.limit,
+		})
+	}
+
+	if field.url {
+		if err := validateURL(value); err != nil {
+			findings = append(findings, asc.MetadataLintFinding{
+				Locale:   locale,
+				Field:    field.name,
+				Rule:     ruleInvalidURL,
+				Severity: severityError,
+				Message:  err.Error(),
+			})
+		}
+	}
+
+	if message := disallowedCharacter(value, field.multiline); message != "" {
+		findings = append(findings, asc.MetadataLintFinding{
+			Locale:   locale,
+			Field:    field.name,
+			Rule:     ruleDisallowedCharacter,
+			Severity: severityError,
+			Message:  message,
+		})
+	}
+
+	return findings
+}
+
+func validateURL(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%q is not an absolute URL", value)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("%q must use http or https", value)
+	}
+	return nil
+}
+
+// disallowedCharacter describes the first character App Store Connect would
+// reject, or returns "" when the value is clean.
+func disallowedCharacter(value string, multiline bool) string {
+	position := 0
+	for i, r := range value {
+		position++
+		switch {
+		case r == utf8.RuneError:
+			if _, size := utf8.DecodeRuneInString(value[i:]); size <= 1 {
+				return fmt.Sprintf("contains invalid UTF-8 at character %d", position)
+			}
+			return fmt.Sprintf("contains replacement character U+FFFD at character %d", position)
+		case r == '\n' || r == '\r' || r == '\t':
+			if !multiline {
+				return fmt.Sprintf("contains a line break or tab at character %d", position)
+			}
+		case unicode.IsControl(r):
+			return fmt.Sprintf("contains control character %U at character %d", r, position)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,96 @@
+package metadata
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func findingKeys(findings []asc.MetadataLintFinding) []string {
+	keys := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		keys = append(keys, finding.Locale+"/"+finding.Field+"/"+finding.Rule)
+	}
+	return keys
+}
+
+func TestLintLocalizations(t *testing.T) {
+	localizations := []lintLocalization{
+		{Locale: "en-US", Fields: map[string]string{
+			"description":  "Fine",
+			"keywords":     strings.Repeat("k", 101),
+			"name":         "Näme with ümlauts is ok",
+			"supportUrl":   "example.com/support",
+			"marketingUrl": "ftp://example.com",
+			"subtitle":     "Line\nbreak",
+		}},
+		{Locale: "de-DE", Fields: map[string]string{
+			"description": "Gut\n\nZweite Zeile",
+			"keywords":    "a,b",
+			"name":        "Name\u0007",
+		}},
+	}
+
+	findings := lintLocalizations(localizations, []string{"en-US", "ja"})
+
+	want := []string{
+		"ja//missing-locale",
+		"en-US/subtitle/disallowed-character",
+		"en-US/keywords/max-length",
+		"en-US/supportUrl/invalid-url",
+		"en-US/marketingUrl/invalid-url",
+		"de-DE/name/disallowed-character",
+		"de-DE/subtitle/missing-field",
+		"de-DE/supportUrl/missing-field",
+		"de-DE/marketingUrl/missing-field",
+	}
+	got := findingKeys(findings)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected findings:\ngot:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if findings[2].Length != 101 || findings[2].Limit != 100 {
+		t.Fatalf("expected length details on max-length finding, got %+v", findings[2])
+	}
+}
+
+func TestLintCountsCharactersNotBytes(t *testing.T) {
+	findings := lintLocalizations([]lintLocalization{
+		{Locale: "ja", Fields: map[string]string{"description": "説明", "name": strings.Repeat("名", 30)}},
+	}, nil)
+	if len(findings) != 0 {
+		t.Fatalf("expected no findings, got %+v", findings)
+	}
+}
+
+func TestDisallowedCharacterInvalidUTF8(t *testing.T) {
+	message := disallowedCharacter("ok\xffbad", true)
+	if !strings.Contains(message, "invalid UTF-8 at character 3") {
+		t.Fatalf("unexpected message %q", message)
+	}
+}
+
+func TestReadLocalLocalizationsAcceptsFastlaneOrMetadataDir(t *testing.T) {
+	fastlaneDir := t.TempDir()
+	metadataDir := filepath.Join(fastlaneDir, "metadata")
+	for _, dir := range []string{"en-US", "review_information", "default"} {
+		if err := os.MkdirAll(filepath.Join(metadataDir, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(metadataDir, "en-US", "keywords.txt"), []byte("a,b\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	for _, dir := range []string{fastlaneDir, metadataDir} {
+		localizations, err := readLocalLocalizations(dir)
+		if err != nil {
+			t.Fatalf("readLocalLocalizations(%s): %v", dir, err)
+		}
+		if len(localizations) != 1 || localizations[0].Locale != "en-US" || localizations[0].Fields["keywords"] != "a,b" {
+			t.Fatalf("unexpected localizations from %s: %+v", dir, localizations)
+		}
+	}
+}
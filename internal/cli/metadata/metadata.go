@@ -0,0 +1,40 @@
+package metadata
+
+import (
+	"context"
+	"flag"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// MetadataCommand returns the metadata command group.
+func MetadataCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "metadata",
+		ShortUsage: "asc metadata <subcommand> [flags]",
+		ShortHelp:  "Lint, diff, back up, and restore App Store metadata.",
+		LongHelp: `Lint, diff, back up, and restore App Store metadata.
+
+Examples:
+  asc metadata lint --dir ./fastlane
+  asc metadata lint --remote --version-id "VERSION_ID"
+  asc metadata diff --dir ./fastlane --remote --version-id "VERSION_ID"
+  asc metadata backup --app "APP_ID" --out snapshot.json
+  asc metadata restore --in snapshot.json --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MetadataLintCommand(),
+			MetadataDiffCommand(),
+			MetadataBackupCommand(),
+			MetadataRestoreCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
@@ -0,0 +1,360 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// snapshotSchemaVersion is bumped when the snapshot file layout changes.
+const snapshotSchemaVersion = 1
+
+// MetadataSnapshot is the file written by metadata backup and read by metadata restore.
+// Localizations are keyed by locale, then by the same field keys as .strings files.
+type MetadataSnapshot struct {
+	SchemaVersion        int                          `json:"schemaVersion"`
+	CreatedAt            string                       `json:"createdAt"`
+	AppID                string                       `json:"appId"`
+	AppInfoID            string                       `json:"appInfoId,omitempty"`
+	VersionID            string                       `json:"versionId,omitempty"`
+	VersionString        string                       `json:"versionString,omitempty"`
+	Platform             string                       `json:"platform,omitempty"`
+	Categories           *SnapshotCategories          `json:"categories,omitempty"`
+	AppInfoLocalizations map[string]map[string]string `json:"appInfoLocalizations,omitempty"`
+	VersionLocalizations map[string]map[string]string `json:"versionLocalizations,omitempty"`
+}
+
+// SnapshotCategories holds the app's category IDs.
+type SnapshotCategories struct {
+	Primary   string `json:"primary,omitempty"`
+	Secondary string `json:"secondary,omitempty"`
+}
+
+type metadataBackupSummary struct {
+	File           string `json:"file"`
+	AppID          string `json:"appId"`
+	AppInfoID      string `json:"appInfoId,omitempty"`
+	VersionID      string `json:"versionId,omitempty"`
+	Version        string `json:"version,omitempty"`
+	InfoLocales    int    `json:"infoLocales"`
+	VersionLocales int    `json:"versionLocales"`
+	Categories     bool   `json:"categories"`
+}
+
+type metadataRestoreSummary struct {
+	File                 string                               `json:"file"`
+	AppID                string                               `json:"appId"`
+	AppInfoID            string                               `json:"appInfoId,omitempty"`
+	VersionID            string                               `json:"versionId,omitempty"`
+	DryRun               bool                                 `json:"dryRun"`
+	Categories           *SnapshotCategories                  `json:"categories,omitempty"`
+	AppInfoLocalizations []asc.LocalizationUploadLocaleResult `json:"appInfoLocalizations"`
+	VersionLocalizations []asc.LocalizationUploadLocaleResult `json:"versionLocalizations"`
+}
+
+// MetadataBackupCommand returns the metadata backup subcommand.
+func MetadataBackupCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata backup", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	versionID := fs.String("version-id", "", "App Store version ID (default: most recent version for --platform)")
+	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	out := fs.String("out", "", "Snapshot file to write (required)")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "backup",
+		ShortUsage: "asc metadata backup --app <id> --out <file> [flags]",
+		ShortHelp:  "Save app info, version localizations, and categories to a snapshot file.",
+		LongHelp: `Save app info, version localizations, and categories to a snapshot file.
+
+The snapshot captures App Info localizations (name, subtitle, privacy URLs),
+the version's localizations (description, keywords, what's new, promotional
+text, URLs), and the primary and secondary category. Restore it later with
+asc metadata restore.
+
+Examples:
+  asc metadata backup --app "APP_ID" --out snapshot.json
+  asc metadata backup --app "APP_ID" --version-id "VERSION_ID" --out backups/2026-10-15.json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			outPath := strings.TrimSpace(*out)
+			if outPath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --out is required")
+				return flag.ErrHelp
+			}
+			normalizedPlatform, err := shared.NormalizeAppStoreVersionPlatform(*platform)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("metadata backup: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			snapshot, err := captureSnapshot(requestCtx, client, resolvedAppID, strings.TrimSpace(*appInfoID), strings.TrimSpace(*versionID), normalizedPlatform)
+			if err != nil {
+				return fmt.Errorf("metadata backup: %w", err)
+			}
+
+			data, err := json.MarshalIndent(snapshot, "", "  ")
+			if err != nil {
+				return fmt.Errorf("metadata backup: %w", err)
+			}
+			if err := fileutil.WriteFileAtomic(outPath, append(data, '\n'), 0o755); err != nil {
+				return fmt.Errorf("metadata backup: %w", err)
+			}
+
+			summary := metadataBackupSummary{
+				File:           filepath.Clean(outPath),
+				AppID:          snapshot.AppID,
+				AppInfoID:      snapshot.AppInfoID,
+				VersionID:      snapshot.VersionID,
+				Version:        snapshot.VersionString,
+				InfoLocales:    len(snapshot.AppInfoLocalizations),
+				VersionLocales: len(snapshot.VersionLocalizations),
+				Categories:     snapshot.Categories != nil,
+			}
+			if *pretty {
+				return asc.PrintPrettyJSON(summary)
+			}
+			return asc.PrintJSON(summary)
+		},
+	}
+}
+
+// MetadataRestoreCommand returns the metadata restore subcommand.
+func MetadataRestoreCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata restore", flag.ExitOnError)
+
+	in := fs.String("in", "", "Snapshot file written by asc metadata backup (required)")
+	appInfoID := fs.String("app-info", "", "App Info ID (default: the app's editable App Info)")
+	versionID := fs.String("version-id", "", "App Store version ID to restore onto (default: the snapshot's version)")
+	skipCategories := fs.Bool("skip-categories", false, "Do not restore categories")
+	dryRun := fs.Bool("dry-run", false, "Show what would be restored without updating")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "restore",
+		ShortUsage: "asc metadata restore --in <file> [flags]",
+		ShortHelp:  "Push a metadata snapshot back to App Store Connect.",
+		LongHelp: `Push a metadata snapshot back to App Store Connect.
+
+Every locale in the snapshot is created or updated. Fields that are empty in
+the snapshot are left as they are; restore never clears values. Use
+--version-id to restore version localizations onto a different version.
+
+Examples:
+  asc metadata restore --in snapshot.json --dry-run
+  asc metadata restore --in snapshot.json
+  asc metadata restore --in snapshot.json --version-id "NEW_VERSION_ID" --skip-categories`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			inPath := strings.TrimSpace(*in)
+			if inPath == "" {
+				fmt.Fprintln(os.Stderr, "Error: --in is required")
+				return flag.ErrHelp
+			}
+
+			snapshot, err := readSnapshotFile(inPath)
+			if err != nil {
+				return fmt.Errorf("metadata restore: %w", err)
+			}
+			targetVersionID := strings.TrimSpace(*versionID)
+			if targetVersionID == "" {
+				targetVersionID = snapshot.VersionID
+			}
+			if len(snapshot.VersionLocalizations) > 0 && targetVersionID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required (the snapshot has no version ID)")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("metadata restore: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			summary := metadataRestoreSummary{
+				File:                 filepath.Clean(inPath),
+				AppID:                snapshot.AppID,
+				DryRun:               *dryRun,
+				AppInfoLocalizations: []asc.LocalizationUploadLocaleResult{},
+				VersionLocalizations: []asc.LocalizationUploadLocaleResult{},
+			}
+
+			restoreCategories := snapshot.Categories != nil && snapshot.Categories.Primary != "" && !*skipCategories
+			if len(snapshot.AppInfoLocalizations) > 0 || restoreCategories {
+				summary.AppInfoID, err = shared.ResolveEditableAppInfoID(requestCtx, client, snapshot.AppID, strings.TrimSpace(*appInfoID))
+				if err != nil {
+					return fmt.Errorf("metadata restore: %w", err)
+				}
+			}
+
+			if len(snapshot.AppInfoLocalizations) > 0 {
+				results, err := shared.UploadAppInfoLocalizations(requestCtx, client, summary.AppInfoID, snapshot.AppInfoLocalizations, *dryRun)
+				if err != nil {
+					return fmt.Errorf("metadata restore: app info localizations: %w", err)
+				}
+				summary.AppInfoLocalizations = results
+			}
+
+			if len(snapshot.VersionLocalizations) > 0 {
+				summary.VersionID = targetVersionID
+				results, err := shared.UploadVersionLocalizations(requestCtx, client, targetVersionID, snapshot.VersionLocalizations, *dryRun)
+				if err != nil {
+					return fmt.Errorf("metadata restore: version localizations: %w", err)
+				}
+				summary.VersionLocalizations = results
+			}
+
+			if restoreCategories {
+				if !*dryRun {
+					if _, err := client.UpdateAppInfoCategories(requestCtx, summary.AppInfoID, snapshot.Categories.Primary, snapshot.Categories.Secondary); err != nil {
+						return fmt.Errorf("metadata restore: categories: %w", err)
+					}
+				}
+				summary.Categories = snapshot.Categories
+			}
+
+			if *pretty {
+				return asc.PrintPrettyJSON(summary)
+			}
+			return asc.PrintJSON(summary)
+		},
+	}
+}
+
+// captureSnapshot reads the app's current metadata into a snapshot. Without
+// versionID the most recently created version for platform is used; an app
+// with no versions yet is captured without version localizations.
+func captureSnapshot(ctx context.Context, client *asc.Client, appID, appInfoID, versionID, platform string) (*MetadataSnapshot, error) {
+	snapshot := &MetadataSnapshot{
+		SchemaVersion: snapshotSchemaVersion,
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+		AppID:         appID,
+		Platform:      platform,
+	}
+
+	resolvedAppInfoID, err := shared.ResolveEditableAppInfoID(ctx, client, appID, appInfoID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.AppInfoID = resolvedAppInfoID
+
+	infoLocs, err := client.GetAppInfoLocalizations(ctx, resolvedAppInfoID, asc.WithAppInfoLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch app info localizations: %w", err)
+	}
+	snapshot.AppInfoLocalizations = make(map[string]map[string]string, len(infoLocs.Data))
+	for _, item := range infoLocs.Data {
+		if values := shared.AppInfoLocalizationValues(item.Attributes); len(values) > 0 {
+			snapshot.AppInfoLocalizations[item.Attributes.Locale] = values
+		}
+	}
+
+	primary, err := client.GetAppInfoPrimaryCategoryRelationship(ctx, resolvedAppInfoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch primary category: %w", err)
+	}
+	secondary, err := client.GetAppInfoSecondaryCategoryRelationship(ctx, resolvedAppInfoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch secondary category: %w", err)
+	}
+	if primary.Data.ID != "" || secondary.Data.ID != "" {
+		snapshot.Categories = &SnapshotCategories{Primary: primary.Data.ID, Secondary: secondary.Data.ID}
+	}
+
+	if versionID == "" {
+		versionID, snapshot.VersionString, err = latestVersion(ctx, client, appID, platform)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if versionID != "" {
+		snapshot.VersionID = versionID
+		versionLocs, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch version localizations: %w", err)
+		}
+		snapshot.VersionLocalizations = make(map[string]map[string]string, len(versionLocs.Data))
+		for _, item := range versionLocs.Data {
+			if values := shared.VersionLocalizationValues(item.Attributes); len(values) > 0 {
+				snapshot.VersionLocalizations[item.Attributes.Locale] = values
+			}
+		}
+	}
+
+	return snapshot, nil
+}
+
+// latestVersion returns the most recently created version for platform, or
+// empty values when the app has none.
+func latestVersion(ctx context.Context, client *asc.Client, appID, platform string) (string, string, error) {
+	resp, err := client.GetAppStoreVersions(ctx, appID,
+		asc.WithAppStoreVersionsPlatforms([]string{platform}),
+		asc.WithAppStoreVersionsLimit(200),
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to fetch app store versions: %w", err)
+	}
+	var latest *asc.Resource[asc.AppStoreVersionAttributes]
+	for i := range resp.Data {
+		item := &resp.Data[i]
+		if latest == nil || item.Attributes.CreatedDate > latest.Attributes.CreatedDate {
+			latest = item
+		}
+	}
+	if latest == nil {
+		return "", "", nil
+	}
+	return latest.ID, latest.Attributes.VersionString, nil
+}
+
+func readSnapshotFile(path string) (*MetadataSnapshot, error) {
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var snapshot MetadataSnapshot
+	decoder := json.NewDecoder(file)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("invalid snapshot %s: %w", path, err)
+	}
+	if snapshot.SchemaVersion != snapshotSchemaVersion {
+		return nil, fmt.Errorf("unsupported snapshot schemaVersion %d (expected %d)", snapshot.SchemaVersion, snapshotSchemaVersion)
+	}
+	if strings.TrimSpace(snapshot.AppID) == "" {
+		return nil, fmt.Errorf("invalid snapshot %s: appId is missing", path)
+	}
+	return &snapshot, nil
+}
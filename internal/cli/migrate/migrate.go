@@ -2,6 +2,7 @@ package migrate
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -28,13 +29,15 @@ This enables transitioning from fastlane's deliver tool to asc.
 
 Examples:
   asc migrate import --app "APP_ID" --version "VERSION_ID" --fastlane-dir ./fastlane
-  asc migrate export --app "APP_ID" --version "VERSION_ID" --output-dir ./fastlane`,
+  asc migrate export --app "APP_ID" --version "VERSION_ID" --output-dir ./fastlane
+  asc migrate sync --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			MigrateImportCommand(),
 			MigrateExportCommand(),
 			MigrateValidateCommand(),
+			MigrateSyncCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -49,6 +52,10 @@ func MigrateImportCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	fastlaneDir := fs.String("fastlane-dir", "", "Path to fastlane directory (required)")
+	includeAppInfo := fs.Bool("include-app-info", false, "Also import privacy_url.txt into App Info localizations")
+	includeScreenshots := fs.Bool("include-screenshots", false, "Also upload screenshots from fastlane/screenshots/<locale>/")
+	vars := shared.TemplateVars{}
+	fs.Var(vars, "var", "Template variable key=value for {{key}} placeholders (repeatable)")
 	dryRun := fs.Bool("dry-run", false, "Preview changes without uploading")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
@@ -65,19 +72,49 @@ Reads from the standard fastlane structure:
   │   ├── en-US/
   │   │   ├── name.txt           (App Info)
   │   │   ├── subtitle.txt       (App Info)
+  │   │   ├── privacy_url.txt    (App Info)
   │   │   ├── description.txt    (Version)
   │   │   ├── keywords.txt       (Version)
   │   │   ├── release_notes.txt  (Version)
   │   │   ├── promotional_text.txt (Version)
   │   │   ├── support_url.txt    (Version)
   │   │   └── marketing_url.txt  (Version)
-  │   └── de-DE/
-  │       └── ...
-
-Note: privacy_url.txt is not supported (app-level, not localized).
+  │   ├── de-DE/
+  │   │   └── ...
+  │   └── review_information/    (App Review details)
+  │       ├── first_name.txt, last_name.txt, phone_number.txt, email_address.txt
+  │       ├── demo_user.txt, demo_password.txt
+  │       └── notes.txt
+  └── screenshots/
+      ├── en-US/
+      │   ├── iPhone 15 Pro Max-01_home.png
+      │   ├── APP_IPAD_PRO_3GEN_129-01.png
+      │   └── iMessage/      (iMessage screenshots)
+      └── de-DE/
+          └── ...
+
+review_information/ fills the version's App Review contact, demo account,
+and notes. A demo account is marked required when demo_user.txt is set.
+
+name.txt and subtitle.txt update the app info localizations of the app's
+editable app info. privacy_url.txt is only imported with --include-app-info.
+
+Screenshots are only uploaded with --include-screenshots. The display type
+comes from an explicit type in the file name (APP_IPHONE_67 or IPHONE_67)
+or the simulator device name fastlane snapshot uses (e.g. "iPhone 15 Pro
+Max"). Files are uploaded in name order, framed versions replace originals,
+and files whose checksum matches a screenshot already in the set are skipped.
+
+Metadata files may contain {{name}} placeholders, so one source file can
+serve several versions. {{version}} is the version string of --version-id
+and {{date}} is today's date (YYYY-MM-DD); --var key=value defines more
+variables or overrides these. An undefined placeholder is an error.
 
 Examples:
   asc migrate import --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane
+  asc migrate import --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --include-app-info
+  asc migrate import --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --include-screenshots
+  asc migrate import --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --var season=Winter
   asc migrate import --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -108,7 +145,7 @@ Examples:
 				return fmt.Errorf("migrate import: %w", err)
 			}
 
-			// Read App Info metadata (name, subtitle)
+			// Read App Info metadata (name, subtitle, privacy URL)
 			appInfoLocs, err := readFastlaneAppInfoMetadata(metadataDir)
 			if err != nil {
 				if os.IsNotExist(err) {
@@ -116,6 +153,30 @@ Examples:
 				}
 				return fmt.Errorf("migrate import: %w", err)
 			}
+			if !*includeAppInfo {
+				appInfoLocs = withoutPrivacyURL(appInfoLocs)
+			}
+
+			reviewInfo, err := readFastlaneReviewInformation(metadataDir)
+			if err != nil {
+				return fmt.Errorf("migrate import: %w", err)
+			}
+
+			if err := expandFastlaneTemplates(ctx, strings.TrimSpace(*versionID), vars, localizations, appInfoLocs); err != nil {
+				return fmt.Errorf("migrate import: %w", err)
+			}
+
+			var screenshots []FastlaneScreenshot
+			if *includeScreenshots {
+				screenshotsDir := filepath.Join(*fastlaneDir, "screenshots")
+				screenshots, err = readFastlaneScreenshots(screenshotsDir)
+				if err != nil {
+					if errors.Is(err, os.ErrNotExist) {
+						return fmt.Errorf("migrate import: screenshots directory not found: %s", screenshotsDir)
+					}
+					return fmt.Errorf("migrate import: %w", err)
+				}
+			}
 
 			if *dryRun {
 				result := &MigrateImportResult{
@@ -123,6 +184,8 @@ Examples:
 					VersionID:            strings.TrimSpace(*versionID),
 					Localizations:        localizations,
 					AppInfoLocalizations: appInfoLocs,
+					ReviewInformation:    reviewInfo,
+					Screenshots:          screenshots,
 				}
 				return printMigrateOutput(result, *output, *pretty)
 			}
@@ -169,10 +232,11 @@ Examples:
 					}
 				} else {
 					// Create new localization
-					_, err := client.CreateAppStoreVersionLocalization(requestCtx, strings.TrimSpace(*versionID), attrs)
+					created, err := client.CreateAppStoreVersionLocalization(requestCtx, strings.TrimSpace(*versionID), attrs)
 					if err != nil {
 						return fmt.Errorf("migrate import: failed to create %s: %w", loc.Locale, err)
 					}
+					localeToID[loc.Locale] = created.Data.ID
 				}
 
 				uploaded = append(uploaded, LocalizationUploadItem{
@@ -181,7 +245,7 @@ Examples:
 				})
 			}
 
-			// Upload App Info localizations (name, subtitle)
+			// Upload App Info localizations (name, subtitle, privacy URL)
 			appInfoUploaded := make([]LocalizationUploadItem, 0, len(appInfoLocs))
 			if len(appInfoLocs) > 0 {
 				// Get AppInfo ID for the app
@@ -192,7 +256,7 @@ Examples:
 				if len(appInfos.Data) == 0 {
 					return fmt.Errorf("migrate import: no app info found for app")
 				}
-				appInfoID := selectBestAppInfoID(appInfos)
+				appInfoID := shared.SelectBestAppInfoID(appInfos)
 				if strings.TrimSpace(appInfoID) == "" {
 					return fmt.Errorf("migrate import: failed to select app info for app")
 				}
@@ -212,9 +276,10 @@ Examples:
 				// Upload each App Info localization
 				for _, loc := range appInfoLocs {
 					attrs := asc.AppInfoLocalizationAttributes{
-						Locale:   loc.Locale,
-						Name:     loc.Name,
-						Subtitle: loc.Subtitle,
+						Locale:           loc.Locale,
+						Name:             loc.Name,
+						Subtitle:         loc.Subtitle,
+						PrivacyPolicyURL: loc.PrivacyPolicyURL,
 					}
 
 					if existingID, exists := appInfoLocaleToID[loc.Locale]; exists {
@@ -229,25 +294,40 @@ Examples:
 						}
 					}
 
-					fields := 0
-					if loc.Name != "" {
-						fields++
-					}
-					if loc.Subtitle != "" {
-						fields++
-					}
 					appInfoUploaded = append(appInfoUploaded, LocalizationUploadItem{
 						Locale: loc.Locale,
-						Fields: fields,
+						Fields: countNonEmptyAppInfoFields(loc),
 					})
 				}
 			}
 
+			// Update App Review details (contact, demo account, notes)
+			reviewDetailID := ""
+			if reviewInfo != nil {
+				reviewDetailID, err = importReviewInformation(requestCtx, client, strings.TrimSpace(*versionID), reviewInfo)
+				if err != nil {
+					return fmt.Errorf("migrate import: %w", err)
+				}
+			}
+
+			// Upload screenshots into each locale's version localization
+			if len(screenshots) > 0 {
+				uploadCtx, uploadCancel := shared.ContextWithUploadTimeout(ctx)
+				screenshots, err = uploadFastlaneScreenshots(uploadCtx, client, screenshots, localeToID)
+				uploadCancel()
+				if err != nil {
+					return fmt.Errorf("migrate import: screenshots: %w", err)
+				}
+			}
+
 			result := &MigrateImportResult{
 				DryRun:               false,
 				VersionID:            strings.TrimSpace(*versionID),
 				Localizations:        localizations,
 				AppInfoLocalizations: appInfoLocs,
+				ReviewInformation:    reviewInfo,
+				ReviewDetailID:       reviewDetailID,
+				Screenshots:          screenshots,
 				Uploaded:             uploaded,
 				AppInfoUploaded:      appInfoUploaded,
 			}
@@ -264,6 +344,7 @@ func MigrateExportCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	outputDir := fs.String("output-dir", "", "Output directory for fastlane structure (required)")
+	skipScreenshots := fs.Bool("skip-screenshots", false, "Do not download screenshots")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -273,10 +354,19 @@ func MigrateExportCommand() *ffcli.Command {
 		ShortHelp:  "Export metadata to fastlane directory structure.",
 		LongHelp: `Export current App Store metadata to fastlane directory structure.
 
-Creates the standard fastlane structure with all localizations.
+Creates the standard fastlane structure with all localizations:
+
+  metadata/<locale>/*.txt            Version and App Info metadata
+  metadata/default/*.txt             The app's primary locale, used by deliver
+                                     as the fallback for other locales
+  metadata/review_information/*.txt  App Review contact and demo account
+  screenshots/<locale>/              Screenshots, named by display type
+
+The result can be uploaded again with "asc migrate import" or fastlane deliver.
 
 Examples:
-  asc migrate export --app "APP_ID" --version-id "VERSION_ID" --output-dir ./fastlane`,
+  asc migrate export --app "APP_ID" --version-id "VERSION_ID" --output-dir ./fastlane
+  asc migrate export --app "APP_ID" --version-id "VERSION_ID" --output-dir ./fastlane --skip-screenshots`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -336,10 +426,10 @@ Examples:
 				exported = append(exported, locale)
 			}
 
-			// Export App Info localizations (name, subtitle)
+			// Export App Info localizations (name, subtitle, privacy URL)
 			appInfos, err := client.GetAppInfos(requestCtx, resolvedAppID)
 			if err == nil && len(appInfos.Data) > 0 {
-				appInfoID := selectBestAppInfoID(appInfos)
+				appInfoID := shared.SelectBestAppInfoID(appInfos)
 				if strings.TrimSpace(appInfoID) == "" {
 					return fmt.Errorf("migrate export: failed to select app info for app")
 				}
@@ -352,16 +442,50 @@ Examples:
 						if err := os.MkdirAll(localeDir, 0o755); err == nil {
 							totalFiles += writeAndCount(filepath.Join(localeDir, "name.txt"), loc.Attributes.Name)
 							totalFiles += writeAndCount(filepath.Join(localeDir, "subtitle.txt"), loc.Attributes.Subtitle)
+							totalFiles += writeAndCount(filepath.Join(localeDir, "privacy_url.txt"), loc.Attributes.PrivacyPolicyURL)
 						}
 					}
 				}
 			}
 
+			reviewFiles, err := exportReviewInformation(requestCtx, client, strings.TrimSpace(*versionID), metadataDir)
+			if err != nil {
+				return fmt.Errorf("migrate export: %w", err)
+			}
+			totalFiles += reviewFiles
+
+			app, err := client.GetApp(requestCtx, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("migrate export: failed to fetch app: %w", err)
+			}
+			defaultLocale := app.Data.Attributes.PrimaryLocale
+			if defaultLocale != "" {
+				defaultFiles, err := exportDefaultMetadata(metadataDir, defaultLocale)
+				if err != nil {
+					return fmt.Errorf("migrate export: %w", err)
+				}
+				totalFiles += defaultFiles
+			}
+
+			screenshots := 0
+			if !*skipScreenshots {
+				downloadCtx, downloadCancel := shared.ContextWithUploadTimeout(ctx)
+				defer downloadCancel()
+				screenshots, err = exportFastlaneScreenshots(downloadCtx, client, filepath.Join(*outputDir, "screenshots"), resp.Data)
+				if err != nil {
+					return fmt.Errorf("migrate export: %w", err)
+				}
+				totalFiles += screenshots
+			}
+
 			result := &MigrateExportResult{
-				VersionID:  strings.TrimSpace(*versionID),
-				OutputDir:  *outputDir,
-				Locales:    exported,
-				TotalFiles: totalFiles,
+				VersionID:         strings.TrimSpace(*versionID),
+				OutputDir:         *outputDir,
+				Locales:           exported,
+				DefaultLocale:     defaultLocale,
+				ReviewInformation: reviewFiles > 0,
+				Screenshots:       screenshots,
+				TotalFiles:        totalFiles,
 			}
 
 			return printMigrateOutput(result, *output, *pretty)
@@ -380,11 +504,12 @@ type FastlaneLocalization struct {
 	MarketingURL    string `json:"marketingUrl,omitempty"`
 }
 
-// AppInfoFastlaneLocalization holds app-level metadata (name, subtitle) from fastlane.
+// AppInfoFastlaneLocalization holds app-level metadata (name, subtitle, privacy URL) from fastlane.
 type AppInfoFastlaneLocalization struct {
-	Locale   string `json:"locale"`
-	Name     string `json:"name,omitempty"`
-	Subtitle string `json:"subtitle,omitempty"`
+	Locale           string `json:"locale"`
+	Name             string `json:"name,omitempty"`
+	Subtitle         string `json:"subtitle,omitempty"`
+	PrivacyPolicyURL string `json:"privacyPolicyUrl,omitempty"`
 }
 
 // LocalizationUploadItem represents an uploaded localization.
@@ -399,16 +524,22 @@ type MigrateImportResult struct {
 	VersionID            string                        `json:"versionId"`
 	Localizations        []FastlaneLocalization        `json:"localizations"`
 	AppInfoLocalizations []AppInfoFastlaneLocalization `json:"appInfoLocalizations,omitempty"`
+	ReviewInformation    *FastlaneReviewInformation    `json:"reviewInformation,omitempty"`
+	ReviewDetailID       string                        `json:"reviewDetailId,omitempty"`
+	Screenshots          []FastlaneScreenshot          `json:"screenshots,omitempty"`
 	Uploaded             []LocalizationUploadItem      `json:"uploaded,omitempty"`
 	AppInfoUploaded      []LocalizationUploadItem      `json:"appInfoUploaded,omitempty"`
 }
 
 // MigrateExportResult is the result of a migrate export operation.
 type MigrateExportResult struct {
-	VersionID  string   `json:"versionId"`
-	OutputDir  string   `json:"outputDir"`
-	Locales    []string `json:"locales"`
-	TotalFiles int      `json:"totalFiles"`
+	VersionID         string   `json:"versionId"`
+	OutputDir         string   `json:"outputDir"`
+	Locales           []string `json:"locales"`
+	DefaultLocale     string   `json:"defaultLocale,omitempty"`
+	ReviewInformation bool     `json:"reviewInformation,omitempty"`
+	Screenshots       int      `json:"screenshots,omitempty"`
+	TotalFiles        int      `json:"totalFiles"`
 }
 
 // readFastlaneMetadata reads metadata from a fastlane metadata directory.
@@ -446,7 +577,7 @@ func readFastlaneMetadata(metadataDir string) ([]FastlaneLocalization, error) {
 	return localizations, nil
 }
 
-// readFastlaneAppInfoMetadata reads app-level metadata (name, subtitle) from fastlane structure.
+// readFastlaneAppInfoMetadata reads app-level metadata (name, subtitle, privacy URL) from fastlane structure.
 func readFastlaneAppInfoMetadata(metadataDir string) ([]AppInfoFastlaneLocalization, error) {
 	entries, err := os.ReadDir(metadataDir)
 	if err != nil {
@@ -467,13 +598,15 @@ func readFastlaneAppInfoMetadata(metadataDir string) ([]AppInfoFastlaneLocalizat
 		localeDir := filepath.Join(metadataDir, locale)
 		name := readFileIfExists(filepath.Join(localeDir, "name.txt"))
 		subtitle := readFileIfExists(filepath.Join(localeDir, "subtitle.txt"))
+		privacyURL := readFileIfExists(filepath.Join(localeDir, "privacy_url.txt"))
 
 		// Only include if at least one field has content
-		if name != "" || subtitle != "" {
+		if name != "" || subtitle != "" || privacyURL != "" {
 			localizations = append(localizations, AppInfoFastlaneLocalization{
-				Locale:   locale,
-				Name:     name,
-				Subtitle: subtitle,
+				Locale:           locale,
+				Name:             name,
+				Subtitle:         subtitle,
+				PrivacyPolicyURL: privacyURL,
 			})
 		}
 	}
@@ -481,6 +614,19 @@ func readFastlaneAppInfoMetadata(metadataDir string) ([]AppInfoFastlaneLocalizat
 	return localizations, nil
 }
 
+// withoutPrivacyURL drops privacy_url.txt values, keeping the locales that
+// still have a name or subtitle.
+func withoutPrivacyURL(locs []AppInfoFastlaneLocalization) []AppInfoFastlaneLocalization {
+	filtered := make([]AppInfoFastlaneLocalization, 0, len(locs))
+	for _, loc := range locs {
+		loc.PrivacyPolicyURL = ""
+		if loc.Name != "" || loc.Subtitle != "" {
+			filtered = append(filtered, loc)
+		}
+	}
+	return filtered
+}
+
 // readFileIfExists reads a file's contents if it exists, returning empty string otherwise.
 func readFileIfExists(path string) string {
 	data, err := os.ReadFile(path)
@@ -501,10 +647,45 @@ func writeAndCount(path, content string) int {
 	return 1
 }
 
+// exportDefaultMetadata copies the exported files of locale into
+// metadataDir/default/, which deliver uses for locales without their own value.
+func exportDefaultMetadata(metadataDir, locale string) (int, error) {
+	entries, err := os.ReadDir(filepath.Join(metadataDir, locale))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read %s metadata: %w", locale, err)
+	}
+	defaultDir := filepath.Join(metadataDir, "default")
+	if err := os.MkdirAll(defaultDir, 0o755); err != nil {
+		return 0, fmt.Errorf("failed to create default directory: %w", err)
+	}
+	written := 0
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || filepath.Ext(entry.Name()) != ".txt" {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(metadataDir, locale, entry.Name()))
+		if err != nil {
+			return written, fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+		if err := os.WriteFile(filepath.Join(defaultDir, entry.Name()), content, 0o644); err != nil {
+			return written, fmt.Errorf("failed to write %s: %w", entry.Name(), err)
+		}
+		written++
+	}
+	return written, nil
+}
+
 // printMigrateOutput handles output for migrate-specific result types.
 func printMigrateOutput(data interface{}, format string, pretty bool) error {
 	format = strings.ToLower(format)
 
+	switch format {
+	case shared.OutputFormatGitHubActions, shared.OutputFormatSlackBlocks, shared.OutputFormatTeams:
+		return shared.PrintOutput(data, format, pretty)
+	}
 	if format == "json" {
 		if pretty {
 			return asc.PrintPrettyJSON(data)
@@ -534,6 +715,13 @@ func printMigrateOutput(data interface{}, format string, pretty bool) error {
 		if format == "table" {
 			return printMigrateValidateResultTable(v)
 		}
+	case *MigrateSyncResult:
+		if format == "markdown" || format == "md" {
+			return printMigrateSyncResultMarkdown(v)
+		}
+		if format == "table" {
+			return printMigrateSyncResultTable(v)
+		}
 	default:
 		return asc.PrintJSON(data)
 	}
@@ -560,6 +748,17 @@ func countNonEmptyFields(loc FastlaneLocalization) int {
 	return count
 }
 
+// countNonEmptyAppInfoFields counts the number of non-empty fields in an App Info localization.
+func countNonEmptyAppInfoFields(loc AppInfoFastlaneLocalization) int {
+	count := 0
+	for _, f := range []string{loc.Name, loc.Subtitle, loc.PrivacyPolicyURL} {
+		if f != "" {
+			count++
+		}
+	}
+	return count
+}
+
 // App Store metadata character limits
 const (
 	limitDescription     = 4000
@@ -590,6 +789,19 @@ type MigrateValidateResult struct {
 	Valid       bool              `json:"valid"`
 }
 
+// GitHubAnnotations reports each validation issue as a workflow annotation.
+func (r *MigrateValidateResult) GitHubAnnotations() []shared.GitHubAnnotation {
+	annotations := make([]shared.GitHubAnnotation, 0, len(r.Issues))
+	for _, issue := range r.Issues {
+		annotations = append(annotations, shared.GitHubAnnotation{
+			Level:   issue.Severity,
+			Title:   fmt.Sprintf("%s %s", issue.Locale, issue.Field),
+			Message: issue.Message,
+		})
+	}
+	return annotations
+}
+
 // MigrateValidateCommand returns the migrate validate subcommand.
 func MigrateValidateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("migrate validate", flag.ExitOnError)
@@ -634,7 +846,7 @@ Examples:
 				return fmt.Errorf("migrate validate: %w", err)
 			}
 
-			// Read App Info metadata (name, subtitle)
+			// Read App Info metadata (name, subtitle, privacy URL)
 			appInfoLocs, err := readFastlaneAppInfoMetadata(metadataDir)
 			if err != nil {
 				if os.IsNotExist(err) {
@@ -742,69 +954,6 @@ func validateVersionLocalization(loc FastlaneLocalization) []ValidationIssue {
 	return issues
 }
 
-func selectBestAppInfoID(appInfos *asc.AppInfosResponse) string {
-	if appInfos == nil || len(appInfos.Data) == 0 {
-		return ""
-	}
-
-	// Some apps have multiple appInfos (e.g. READY_FOR_SALE plus PREPARE_FOR_SUBMISSION).
-	// Updating name/subtitle is only allowed in certain states, so prefer the one that is
-	// actively editable for a submission.
-	const target = "PREPARE_FOR_SUBMISSION"
-
-	var firstNonLive string
-	for _, info := range appInfos.Data {
-		state := strings.ToUpper(appInfoAttrString(info.Attributes, "state"))
-		appStoreState := strings.ToUpper(appInfoAttrString(info.Attributes, "appStoreState"))
-
-		if state == target || appStoreState == target {
-			return info.ID
-		}
-		if firstNonLive == "" && isNonLiveAppInfoState(state, appStoreState) {
-			firstNonLive = info.ID
-		}
-	}
-	if firstNonLive != "" {
-		return firstNonLive
-	}
-	return appInfos.Data[0].ID
-}
-
-func isNonLiveAppInfoState(state, appStoreState string) bool {
-	isLive := func(value string) bool {
-		switch value {
-		case "READY_FOR_DISTRIBUTION", "READY_FOR_SALE":
-			return true
-		default:
-			return false
-		}
-	}
-
-	if state != "" && !isLive(state) {
-		return true
-	}
-	if appStoreState != "" && !isLive(appStoreState) {
-		return true
-	}
-	return false
-}
-
-func appInfoAttrString(attrs asc.AppInfoAttributes, key string) string {
-	if attrs == nil {
-		return ""
-	}
-	v, ok := attrs[key]
-	if !ok || v == nil {
-		return ""
-	}
-	switch t := v.(type) {
-	case string:
-		return strings.TrimSpace(t)
-	default:
-		return strings.TrimSpace(fmt.Sprint(t))
-	}
-}
-
 // validateAppInfoLocalization checks app-level metadata for issues.
 func validateAppInfoLocalization(loc AppInfoFastlaneLocalization) []ValidationIssue {
 	var issues []ValidationIssue
@@ -30,7 +30,7 @@ func printMigrateImportResultMarkdown(result *MigrateImportResult) error {
 		fmt.Println()
 		fmt.Println("### App Info Localizations Found")
 		fmt.Println()
-		headers := []string{"Locale", "Name", "Subtitle"}
+		headers := []string{"Locale", "Name", "Subtitle", "Privacy URL"}
 		rows := make([][]string, 0, len(result.AppInfoLocalizations))
 		for _, loc := range result.AppInfoLocalizations {
 			name := "-"
@@ -41,11 +41,31 @@ func printMigrateImportResultMarkdown(result *MigrateImportResult) error {
 			if loc.Subtitle != "" {
 				subtitle = "✓"
 			}
-			rows = append(rows, []string{loc.Locale, name, subtitle})
+			privacyURL := "-"
+			if loc.PrivacyPolicyURL != "" {
+				privacyURL = "✓"
+			}
+			rows = append(rows, []string{loc.Locale, name, subtitle, privacyURL})
 		}
 		asc.RenderMarkdown(headers, rows)
 	}
 
+	if result.ReviewInformation != nil {
+		fmt.Println()
+		fmt.Println("### Review Information")
+		fmt.Println()
+		headers, rows := migrateReviewInformationRows(result)
+		asc.RenderMarkdown(headers, rows)
+	}
+
+	if len(result.Screenshots) > 0 {
+		fmt.Println()
+		fmt.Println("### Screenshots")
+		fmt.Println()
+		headers, rows := migrateScreenshotRows(result.Screenshots)
+		asc.RenderMarkdown(headers, rows)
+	}
+
 	if len(result.Uploaded) > 0 {
 		fmt.Println()
 		fmt.Println("### Uploaded")
@@ -96,7 +116,7 @@ func printMigrateImportResultTable(result *MigrateImportResult) error {
 	if len(result.AppInfoLocalizations) > 0 {
 		fmt.Println()
 		fmt.Println("App Info Localizations:")
-		headers := []string{"Locale", "Name", "Subtitle", "Status"}
+		headers := []string{"Locale", "Name", "Subtitle", "Privacy URL", "Status"}
 		rows := make([][]string, 0, len(result.AppInfoLocalizations))
 		for _, loc := range result.AppInfoLocalizations {
 			status := "found"
@@ -114,14 +134,55 @@ func printMigrateImportResultTable(result *MigrateImportResult) error {
 			if loc.Subtitle != "" {
 				subtitle = "yes"
 			}
-			rows = append(rows, []string{loc.Locale, name, subtitle, status})
+			privacyURL := "-"
+			if loc.PrivacyPolicyURL != "" {
+				privacyURL = "yes"
+			}
+			rows = append(rows, []string{loc.Locale, name, subtitle, privacyURL, status})
 		}
 		asc.RenderTable(headers, rows)
 	}
 
+	if result.ReviewInformation != nil {
+		fmt.Println()
+		fmt.Println("Review Information:")
+		headers, rows := migrateReviewInformationRows(result)
+		asc.RenderTable(headers, rows)
+	}
+
+	if len(result.Screenshots) > 0 {
+		fmt.Println()
+		fmt.Println("Screenshots:")
+		headers, rows := migrateScreenshotRows(result.Screenshots)
+		asc.RenderTable(headers, rows)
+	}
+
 	return nil
 }
 
+func migrateReviewInformationRows(result *MigrateImportResult) ([]string, [][]string) {
+	status := "found"
+	if result.ReviewDetailID != "" {
+		status = "uploaded"
+	}
+	headers := []string{"Fields", "Demo Account", "Status"}
+	demo := "-"
+	if result.ReviewInformation.DemoUser != "" {
+		demo = "yes"
+	}
+	rows := [][]string{{fmt.Sprintf("%d", result.ReviewInformation.fieldCount()), demo, status}}
+	return headers, rows
+}
+
+func migrateScreenshotRows(screenshots []FastlaneScreenshot) ([]string, [][]string) {
+	headers := []string{"Locale", "Display Type", "File", "Status", "Reason"}
+	rows := make([][]string, 0, len(screenshots))
+	for _, item := range screenshots {
+		rows = append(rows, []string{item.Locale, item.DisplayType, item.FileName, item.Status, item.Reason})
+	}
+	return headers, rows
+}
+
 func printMigrateExportResultMarkdown(result *MigrateExportResult) error {
 	fmt.Printf("**Version ID:** %s\n\n", result.VersionID)
 	fmt.Printf("**Output Directory:** %s\n\n", result.OutputDir)
@@ -130,6 +191,11 @@ func printMigrateExportResultMarkdown(result *MigrateExportResult) error {
 	for _, locale := range result.Locales {
 		fmt.Printf("- %s\n", locale)
 	}
+	if result.DefaultLocale != "" {
+		fmt.Printf("\n**Default Locale:** %s\n", result.DefaultLocale)
+	}
+	fmt.Printf("\n**Review Information:** %t\n", result.ReviewInformation)
+	fmt.Printf("\n**Screenshots:** %d\n", result.Screenshots)
 	fmt.Printf("\n**Total Files:** %d\n", result.TotalFiles)
 	return nil
 }
@@ -143,7 +209,13 @@ func printMigrateExportResultTable(result *MigrateExportResult) error {
 		rows = append(rows, []string{locale})
 	}
 	asc.RenderTable(headers, rows)
-	fmt.Printf("\nTotal Files: %d\n", result.TotalFiles)
+	fmt.Println()
+	if result.DefaultLocale != "" {
+		fmt.Printf("Default Locale: %s\n", result.DefaultLocale)
+	}
+	fmt.Printf("Review Information: %t\n", result.ReviewInformation)
+	fmt.Printf("Screenshots: %d\n", result.Screenshots)
+	fmt.Printf("Total Files: %d\n", result.TotalFiles)
 	return nil
 }
 
@@ -215,3 +287,41 @@ func printMigrateValidateResultTable(result *MigrateValidateResult) error {
 
 	return nil
 }
+
+func migrateSyncRows(result *MigrateSyncResult) ([]string, [][]string) {
+	headers := []string{"Resource", "Locale", "Action", "Field", "Status", "Error"}
+	rows := make([][]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		rows = append(rows, []string{change.Resource, change.Locale, change.Action, change.Field, change.Status, change.Error})
+	}
+	return headers, rows
+}
+
+func printMigrateSyncResultMarkdown(result *MigrateSyncResult) error {
+	if result.DryRun {
+		fmt.Println("## Dry Run - No changes made")
+		fmt.Println()
+	}
+	fmt.Printf("**Version ID:** %s\n\n", result.VersionID)
+	if len(result.Changes) > 0 {
+		headers, rows := migrateSyncRows(result)
+		asc.RenderMarkdown(headers, rows)
+		fmt.Println()
+	}
+	fmt.Printf("**Changed:** %d  **Unchanged:** %d  **Failed:** %d\n", len(result.Changes), result.Unchanged, result.Failed)
+	return nil
+}
+
+func printMigrateSyncResultTable(result *MigrateSyncResult) error {
+	if result.DryRun {
+		fmt.Println("DRY RUN - No changes made")
+	}
+	fmt.Printf("Version ID: %s\n\n", result.VersionID)
+	if len(result.Changes) > 0 {
+		headers, rows := migrateSyncRows(result)
+		asc.RenderTable(headers, rows)
+		fmt.Println()
+	}
+	fmt.Printf("Changed: %d  Unchanged: %d  Failed: %d\n", len(result.Changes), result.Unchanged, result.Failed)
+	return nil
+}
@@ -0,0 +1,165 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// reviewInformationDir is fastlane's metadata subdirectory for App Review details.
+const reviewInformationDir = "review_information"
+
+// FastlaneReviewInformation holds App Review contact and demo account details
+// from fastlane's metadata/review_information/ directory. The demo password is
+// never printed.
+type FastlaneReviewInformation struct {
+	FirstName    string `json:"firstName,omitempty"`
+	LastName     string `json:"lastName,omitempty"`
+	Phone        string `json:"phone,omitempty"`
+	Email        string `json:"email,omitempty"`
+	DemoUser     string `json:"demoUser,omitempty"`
+	DemoPassword string `json:"-"`
+	HasPassword  bool   `json:"demoPasswordSet,omitempty"`
+	Notes        string `json:"notes,omitempty"`
+}
+
+// readFastlaneReviewInformation reads metadata/review_information/. It returns
+// nil when the directory is missing or has no content.
+func readFastlaneReviewInformation(metadataDir string) (*FastlaneReviewInformation, error) {
+	dir := filepath.Join(metadataDir, reviewInformationDir)
+	info, err := os.Stat(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read review information: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, nil
+	}
+
+	review := &FastlaneReviewInformation{
+		FirstName:    readFileIfExists(filepath.Join(dir, "first_name.txt")),
+		LastName:     readFileIfExists(filepath.Join(dir, "last_name.txt")),
+		Phone:        readFileIfExists(filepath.Join(dir, "phone_number.txt")),
+		Email:        readFileIfExists(filepath.Join(dir, "email_address.txt")),
+		DemoUser:     readFileIfExists(filepath.Join(dir, "demo_user.txt")),
+		DemoPassword: readFileIfExists(filepath.Join(dir, "demo_password.txt")),
+		Notes:        readFileIfExists(filepath.Join(dir, "notes.txt")),
+	}
+	review.HasPassword = review.DemoPassword != ""
+	if review.fieldCount() == 0 {
+		return nil, nil
+	}
+	return review, nil
+}
+
+func (r *FastlaneReviewInformation) fieldCount() int {
+	count := 0
+	for _, f := range []string{r.FirstName, r.LastName, r.Phone, r.Email, r.DemoUser, r.DemoPassword, r.Notes} {
+		if f != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// updateAttributes returns the non-empty fields as review detail attributes.
+// A demo account is marked required when a demo user is given, as deliver does.
+func (r *FastlaneReviewInformation) updateAttributes() asc.AppStoreReviewDetailUpdateAttributes {
+	optional := func(value string) *string {
+		if value == "" {
+			return nil
+		}
+		return &value
+	}
+	attrs := asc.AppStoreReviewDetailUpdateAttributes{
+		ContactFirstName:    optional(r.FirstName),
+		ContactLastName:     optional(r.LastName),
+		ContactPhone:        optional(r.Phone),
+		ContactEmail:        optional(r.Email),
+		DemoAccountName:     optional(r.DemoUser),
+		DemoAccountPassword: optional(r.DemoPassword),
+		Notes:               optional(r.Notes),
+	}
+	if r.DemoUser != "" {
+		required := true
+		attrs.DemoAccountRequired = &required
+	}
+	return attrs
+}
+
+// importReviewInformation updates the version's App Store review detail,
+// creating it when the version has none yet. It returns the review detail ID.
+func importReviewInformation(ctx context.Context, client *asc.Client, versionID string, review *FastlaneReviewInformation) (string, error) {
+	attrs := review.updateAttributes()
+
+	existing, err := client.GetAppStoreReviewDetailForVersion(ctx, versionID)
+	if err != nil && !asc.IsNotFound(err) {
+		return "", fmt.Errorf("failed to fetch review details: %w", err)
+	}
+	if err == nil && existing != nil && existing.Data.ID != "" {
+		if _, err := client.UpdateAppStoreReviewDetail(ctx, existing.Data.ID, attrs); err != nil {
+			return "", fmt.Errorf("failed to update review details: %w", err)
+		}
+		return existing.Data.ID, nil
+	}
+
+	created, err := client.CreateAppStoreReviewDetail(ctx, versionID, &asc.AppStoreReviewDetailCreateAttributes{
+		ContactFirstName:    attrs.ContactFirstName,
+		ContactLastName:     attrs.ContactLastName,
+		ContactPhone:        attrs.ContactPhone,
+		ContactEmail:        attrs.ContactEmail,
+		DemoAccountName:     attrs.DemoAccountName,
+		DemoAccountPassword: attrs.DemoAccountPassword,
+		DemoAccountRequired: attrs.DemoAccountRequired,
+		Notes:               attrs.Notes,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create review details: %w", err)
+	}
+	return created.Data.ID, nil
+}
+
+// exportReviewInformation writes the version's App Review details to
+// metadataDir/review_information/ and returns the number of files written.
+// Versions without review details write nothing.
+func exportReviewInformation(ctx context.Context, client *asc.Client, versionID, metadataDir string) (int, error) {
+	resp, err := client.GetAppStoreReviewDetailForVersion(ctx, versionID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to fetch review details: %w", err)
+	}
+	attrs := resp.Data.Attributes
+	files := []struct {
+		name  string
+		value string
+	}{
+		{"first_name.txt", attrs.ContactFirstName},
+		{"last_name.txt", attrs.ContactLastName},
+		{"phone_number.txt", attrs.ContactPhone},
+		{"email_address.txt", attrs.ContactEmail},
+		{"demo_user.txt", attrs.DemoAccountName},
+		{"demo_password.txt", attrs.DemoAccountPassword},
+		{"notes.txt", attrs.Notes},
+	}
+
+	dir := filepath.Join(metadataDir, reviewInformationDir)
+	written := 0
+	for _, file := range files {
+		if file.value == "" {
+			continue
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return written, fmt.Errorf("failed to create review information directory: %w", err)
+		}
+		written += writeAndCount(filepath.Join(dir, file.name), file.value)
+	}
+	return written, nil
+}
@@ -0,0 +1,75 @@
+package migrate
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadFastlaneReviewInformation(t *testing.T) {
+	dir := t.TempDir()
+	reviewDir := filepath.Join(dir, "review_information")
+	if err := os.MkdirAll(reviewDir, 0o755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	files := map[string]string{
+		"first_name.txt":    "Jane",
+		"email_address.txt": "jane@example.com",
+		"demo_user.txt":     "demo@example.com",
+		"demo_password.txt": "s3cret\n",
+		"notes.txt":         "Tap Skip on the intro.",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(reviewDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	review, err := readFastlaneReviewInformation(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if review == nil {
+		t.Fatal("expected review information")
+	}
+	if review.FirstName != "Jane" || review.DemoUser != "demo@example.com" || review.DemoPassword != "s3cret" || !review.HasPassword {
+		t.Fatalf("unexpected review information: %+v", review)
+	}
+	if got := review.fieldCount(); got != 5 {
+		t.Fatalf("expected 5 fields, got %d", got)
+	}
+
+	attrs := review.updateAttributes()
+	if attrs.DemoAccountRequired == nil || !*attrs.DemoAccountRequired {
+		t.Fatal("expected demo account to be required when demo_user is set")
+	}
+	if attrs.ContactLastName != nil {
+		t.Fatalf("expected missing last name to be left unset, got %q", *attrs.ContactLastName)
+	}
+
+	encoded, err := json.Marshal(review)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if strings.Contains(string(encoded), "s3cret") {
+		t.Fatalf("expected demo password to be omitted from output, got %s", encoded)
+	}
+}
+
+func TestReadFastlaneReviewInformation_MissingOrEmpty(t *testing.T) {
+	dir := t.TempDir()
+	review, err := readFastlaneReviewInformation(dir)
+	if err != nil || review != nil {
+		t.Fatalf("expected nil for missing directory, got %+v, %v", review, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "review_information"), 0o755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	review, err = readFastlaneReviewInformation(dir)
+	if err != nil || review != nil {
+		t.Fatalf("expected nil for empty directory, got %+v, %v", review, err)
+	}
+}
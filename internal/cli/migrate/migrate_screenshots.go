@@ -0,0 +1,434 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// Screenshot import statuses.
+const (
+	screenshotStatusPlanned  = "planned"
+	screenshotStatusUploaded = "uploaded"
+	screenshotStatusSkipped  = "skipped"
+)
+
+// imessageScreenshotsDir is the per-locale subdirectory fastlane uses for
+// iMessage extension screenshots.
+const imessageScreenshotsDir = "iMessage"
+
+// FastlaneScreenshot is a screenshot file found in fastlane's screenshots directory.
+type FastlaneScreenshot struct {
+	Locale      string `json:"locale"`
+	DisplayType string `json:"displayType,omitempty"`
+	FileName    string `json:"fileName"`
+	FilePath    string `json:"filePath"`
+	Status      string `json:"status"`
+	Reason      string `json:"reason,omitempty"`
+	AssetID     string `json:"assetId,omitempty"`
+}
+
+// screenshotDevicePatterns maps device names used in fastlane snapshot file
+// names to display types. More specific names come first; matching is on the
+// lowercased file name.
+var screenshotDevicePatterns = []struct {
+	pattern     string
+	displayType string
+}{
+	{"ipad pro (12.9-inch) (1st generation)", "APP_IPAD_PRO_129"},
+	{"ipad pro (12.9-inch) (2nd generation)", "APP_IPAD_PRO_129"},
+	{"ipad pro (12.9-inch)", "APP_IPAD_PRO_3GEN_129"},
+	{"ipad pro 13-inch", "APP_IPAD_PRO_3GEN_129"},
+	{"ipad air 13-inch", "APP_IPAD_PRO_3GEN_129"},
+	{"ipad pro (11-inch)", "APP_IPAD_PRO_3GEN_11"},
+	{"ipad pro 11-inch", "APP_IPAD_PRO_3GEN_11"},
+	{"ipad air 11-inch", "APP_IPAD_PRO_3GEN_11"},
+	{"ipad pro (10.5-inch)", "APP_IPAD_105"},
+	{"ipad", "APP_IPAD_97"},
+	{"iphone 17 pro max", "APP_IPHONE_69"},
+	{"iphone 16 pro max", "APP_IPHONE_69"},
+	{"iphone air", "APP_IPHONE_69"},
+	{"iphone 16 plus", "APP_IPHONE_67"},
+	{"iphone 15 pro max", "APP_IPHONE_67"},
+	{"iphone 15 plus", "APP_IPHONE_67"},
+	{"iphone 14 pro max", "APP_IPHONE_67"},
+	{"iphone 14 plus", "APP_IPHONE_67"},
+	{"iphone 13 pro max", "APP_IPHONE_65"},
+	{"iphone 12 pro max", "APP_IPHONE_65"},
+	{"iphone 11 pro max", "APP_IPHONE_65"},
+	{"iphone xs max", "APP_IPHONE_65"},
+	{"iphone xr", "APP_IPHONE_61"},
+	{"iphone 11 pro", "APP_IPHONE_58"},
+	{"iphone xs", "APP_IPHONE_58"},
+	{"iphone x", "APP_IPHONE_58"},
+	{"iphone 13 mini", "APP_IPHONE_58"},
+	{"iphone 12 mini", "APP_IPHONE_58"},
+	{"iphone 17", "APP_IPHONE_61"},
+	{"iphone 16", "APP_IPHONE_61"},
+	{"iphone 15", "APP_IPHONE_61"},
+	{"iphone 14", "APP_IPHONE_61"},
+	{"iphone 13", "APP_IPHONE_61"},
+	{"iphone 12", "APP_IPHONE_61"},
+	{"iphone 11", "APP_IPHONE_61"},
+	{"iphone 8 plus", "APP_IPHONE_55"},
+	{"iphone 7 plus", "APP_IPHONE_55"},
+	{"iphone 6s plus", "APP_IPHONE_55"},
+	{"iphone 6 plus", "APP_IPHONE_55"},
+	{"iphone se (3rd generation)", "APP_IPHONE_47"},
+	{"iphone se (2nd generation)", "APP_IPHONE_47"},
+	{"iphone 8", "APP_IPHONE_47"},
+	{"iphone 7", "APP_IPHONE_47"},
+	{"iphone 6s", "APP_IPHONE_47"},
+	{"iphone 6", "APP_IPHONE_47"},
+	{"iphone se", "APP_IPHONE_40"},
+	{"iphone 5", "APP_IPHONE_40"},
+	{"iphone 4", "APP_IPHONE_35"},
+	{"apple watch ultra", "APP_WATCH_ULTRA"},
+	{"apple watch series 10", "APP_WATCH_SERIES_10"},
+	{"apple watch series 9", "APP_WATCH_SERIES_7"},
+	{"apple watch series 8", "APP_WATCH_SERIES_7"},
+	{"apple watch series 7", "APP_WATCH_SERIES_7"},
+	{"apple watch series 6", "APP_WATCH_SERIES_4"},
+	{"apple watch series 5", "APP_WATCH_SERIES_4"},
+	{"apple watch series 4", "APP_WATCH_SERIES_4"},
+	{"apple watch series 3", "APP_WATCH_SERIES_3"},
+	{"apple tv", "APP_APPLE_TV"},
+	{"apple vision pro", "APP_APPLE_VISION_PRO"},
+}
+
+// readFastlaneScreenshots reads fastlane's screenshots/<locale>/ layout.
+// Files whose display type cannot be determined are returned as skipped.
+func readFastlaneScreenshots(screenshotsDir string) ([]FastlaneScreenshot, error) {
+	entries, err := os.ReadDir(screenshotsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read screenshots directory: %w", err)
+	}
+
+	var screenshots []FastlaneScreenshot
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		locale := entry.Name()
+		localeDir := filepath.Join(screenshotsDir, locale)
+
+		items, err := readLocaleScreenshots(locale, localeDir, false)
+		if err != nil {
+			return nil, err
+		}
+		screenshots = append(screenshots, items...)
+
+		imessageDir := filepath.Join(localeDir, imessageScreenshotsDir)
+		if info, err := os.Stat(imessageDir); err == nil && info.IsDir() {
+			items, err := readLocaleScreenshots(locale, imessageDir, true)
+			if err != nil {
+				return nil, err
+			}
+			screenshots = append(screenshots, items...)
+		}
+	}
+	return screenshots, nil
+}
+
+func readLocaleScreenshots(locale, dir string, imessage bool) ([]FastlaneScreenshot, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read screenshots for %s: %w", locale, err)
+	}
+
+	names := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && isScreenshotFile(entry.Name()) {
+			names[entry.Name()] = struct{}{}
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		// Like deliver, upload the framed version when frameit produced one.
+		ext := filepath.Ext(name)
+		if _, framed := names[strings.TrimSuffix(name, ext)+"_framed"+ext]; framed {
+			continue
+		}
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	screenshots := make([]FastlaneScreenshot, 0, len(sorted))
+	for _, name := range sorted {
+		item := FastlaneScreenshot{
+			Locale:   locale,
+			FileName: name,
+			FilePath: filepath.Join(dir, name),
+			Status:   screenshotStatusPlanned,
+		}
+		displayType, ok := screenshotDisplayTypeFromFileName(name)
+		if ok && imessage {
+			displayType = "IMESSAGE_" + displayType
+			ok = asc.IsValidScreenshotDisplayType(displayType)
+		}
+		if ok {
+			item.DisplayType = displayType
+		} else {
+			item.Status = screenshotStatusSkipped
+			item.Reason = "unknown display type"
+		}
+		screenshots = append(screenshots, item)
+	}
+	return screenshots, nil
+}
+
+func isScreenshotFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".png", ".jpg", ".jpeg":
+		return !strings.HasPrefix(name, ".")
+	default:
+		return false
+	}
+}
+
+// screenshotDisplayTypeFromFileName maps a fastlane screenshot file name to an
+// App Store display type. An explicit type such as "APP_IPHONE_67" or
+// "IPHONE_67" in the name wins; otherwise the simulator device name that
+// snapshot prefixes to file names is used.
+func screenshotDisplayTypeFromFileName(name string) (string, bool) {
+	base := strings.TrimSuffix(name, filepath.Ext(name))
+	upper := strings.ToUpper(base)
+
+	explicit := ""
+	for _, displayType := range asc.ValidScreenshotDisplayTypes {
+		if strings.HasPrefix(displayType, "IMESSAGE_") {
+			continue
+		}
+		token := strings.TrimPrefix(displayType, "APP_")
+		if containsToken(upper, token) && len(displayType) > len(explicit) {
+			explicit = displayType
+		}
+	}
+	if explicit != "" {
+		return explicit, true
+	}
+
+	lower := strings.ToLower(base)
+	for _, device := range screenshotDevicePatterns {
+		if strings.Contains(lower, device.pattern) {
+			return device.displayType, true
+		}
+	}
+	return "", false
+}
+
+// containsToken reports whether token appears in value delimited by
+// non-alphanumeric characters, so "IPHONE_6" does not match "IPHONE_65".
+func containsToken(value, token string) bool {
+	for offset := 0; ; {
+		idx := strings.Index(value[offset:], token)
+		if idx < 0 {
+			return false
+		}
+		start := offset + idx
+		end := start + len(token)
+		if (start == 0 || !isAlphanumeric(value[start-1])) && (end == len(value) || !isAlphanumeric(value[end])) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+func isAlphanumeric(b byte) bool {
+	return (b >= 'A' && b <= 'Z') || (b >= 'a' && b <= 'z') || (b >= '0' && b <= '9')
+}
+
+// uploadFastlaneScreenshots uploads planned screenshots into the display-type
+// sets of each locale's version localization. Files whose MD5 checksum matches
+// a screenshot already in the set are skipped.
+func uploadFastlaneScreenshots(ctx context.Context, client *asc.Client, screenshots []FastlaneScreenshot, localeToID map[string]string) ([]FastlaneScreenshot, error) {
+	results := make([]FastlaneScreenshot, len(screenshots))
+	copy(results, screenshots)
+
+	// Remote checksums per screenshot set, loaded on first use.
+	setIDs := make(map[string]string)
+	remoteChecksums := make(map[string]map[string]struct{})
+
+	for i := range results {
+		item := &results[i]
+		if item.Status != screenshotStatusPlanned {
+			continue
+		}
+		localizationID, ok := localeToID[item.Locale]
+		if !ok {
+			item.Status = screenshotStatusSkipped
+			item.Reason = "no version localization for locale"
+			continue
+		}
+
+		setKey := localizationID + "/" + item.DisplayType
+		setID, ok := setIDs[setKey]
+		if !ok {
+			set, checksums, err := ensureScreenshotSet(ctx, client, localizationID, item.DisplayType)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", item.Locale, item.DisplayType, err)
+			}
+			setID = set
+			setIDs[setKey] = setID
+			remoteChecksums[setID] = checksums
+		}
+
+		assetID, checksum, err := uploadScreenshot(ctx, client, setID, item.FilePath, remoteChecksums[setID])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", item.FilePath, err)
+		}
+		if assetID == "" {
+			item.Status = screenshotStatusSkipped
+			item.Reason = "checksum matches existing screenshot"
+			continue
+		}
+		remoteChecksums[setID][checksum] = struct{}{}
+		item.Status = screenshotStatusUploaded
+		item.AssetID = assetID
+	}
+	return results, nil
+}
+
+// ensureScreenshotSet returns the ID of the localization's set for displayType,
+// creating it when missing, along with the checksums of screenshots in it.
+func ensureScreenshotSet(ctx context.Context, client *asc.Client, localizationID, displayType string) (string, map[string]struct{}, error) {
+	checksums := make(map[string]struct{})
+
+	sets, err := client.GetAppScreenshotSets(ctx, localizationID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch screenshot sets: %w", err)
+	}
+	for _, set := range sets.Data {
+		if !strings.EqualFold(set.Attributes.ScreenshotDisplayType, displayType) {
+			continue
+		}
+		existing, err := client.GetAppScreenshots(ctx, set.ID)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to fetch screenshots: %w", err)
+		}
+		for _, screenshot := range existing.Data {
+			if checksum := strings.TrimSpace(screenshot.Attributes.SourceFileChecksum); checksum != "" {
+				checksums[strings.ToLower(checksum)] = struct{}{}
+			}
+		}
+		return set.ID, checksums, nil
+	}
+
+	created, err := client.CreateAppScreenshotSet(ctx, localizationID, displayType)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create screenshot set: %w", err)
+	}
+	return created.Data.ID, checksums, nil
+}
+
+// uploadScreenshot reserves, uploads, and commits one screenshot. It returns
+// an empty asset ID when the file's checksum is already in remoteChecksums.
+func uploadScreenshot(ctx context.Context, client *asc.Client, setID, filePath string, remoteChecksums map[string]struct{}) (string, string, error) {
+	if err := asc.ValidateImageFile(filePath); err != nil {
+		return "", "", err
+	}
+
+	file, err := shared.OpenExistingNoFollow(filePath)
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", "", err
+	}
+
+	checksum, err := asc.ComputeChecksumFromReader(file, asc.ChecksumAlgorithmMD5)
+	if err != nil {
+		return "", "", err
+	}
+	hash := strings.ToLower(checksum.Hash)
+	if _, exists := remoteChecksums[hash]; exists {
+		return "", hash, nil
+	}
+
+	created, err := client.CreateAppScreenshot(ctx, setID, info.Name(), info.Size())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to reserve upload: %w", err)
+	}
+	if len(created.Data.Attributes.UploadOperations) == 0 {
+		return "", "", fmt.Errorf("no upload operations returned for %q", info.Name())
+	}
+
+	if err := asc.UploadAssetFromFile(ctx, file, info.Size(), created.Data.Attributes.UploadOperations); err != nil {
+		return "", "", fmt.Errorf("upload failed: %w", err)
+	}
+
+	if _, err := client.UpdateAppScreenshot(ctx, created.Data.ID, true, checksum.Hash); err != nil {
+		return "", "", fmt.Errorf("failed to commit upload: %w", err)
+	}
+	return created.Data.ID, hash, nil
+}
+
+// exportFastlaneScreenshots downloads the screenshots of each version
+// localization into screenshotsDir/<locale>/, naming files after their
+// display type so that import maps them back to the same sets. iMessage
+// screenshots go into the locale's iMessage/ subdirectory. It returns the
+// number of files written.
+func exportFastlaneScreenshots(ctx context.Context, client *asc.Client, screenshotsDir string, localizations []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) (int, error) {
+	written := 0
+	for _, loc := range localizations {
+		setsResp, err := client.GetAppScreenshotSets(ctx, loc.ID)
+		if err != nil {
+			return written, fmt.Errorf("failed to fetch screenshot sets for %s: %w", loc.Attributes.Locale, err)
+		}
+		for _, set := range setsResp.Data {
+			displayType := set.Attributes.ScreenshotDisplayType
+			dir := filepath.Join(screenshotsDir, loc.Attributes.Locale)
+			if strings.HasPrefix(displayType, "IMESSAGE_") {
+				dir = filepath.Join(dir, imessageScreenshotsDir)
+				displayType = strings.TrimPrefix(displayType, "IMESSAGE_")
+			}
+
+			shotsResp, err := client.GetAppScreenshots(ctx, set.ID)
+			if err != nil {
+				return written, fmt.Errorf("failed to fetch screenshots for %s %s: %w", loc.Attributes.Locale, set.Attributes.ScreenshotDisplayType, err)
+			}
+			if len(shotsResp.Data) == 0 {
+				continue
+			}
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return written, fmt.Errorf("failed to create screenshots directory: %w", err)
+			}
+			for i, shot := range shotsResp.Data {
+				if shot.Attributes.ImageAsset == nil {
+					continue
+				}
+				path := filepath.Join(dir, fmt.Sprintf("%s-%02d.png", displayType, i+1))
+				if err := downloadScreenshot(ctx, client, shot.Attributes.ImageAsset, path); err != nil {
+					return written, fmt.Errorf("failed to download %s: %w", shot.Attributes.FileName, err)
+				}
+				written++
+			}
+		}
+	}
+	return written, nil
+}
+
+func downloadScreenshot(ctx context.Context, client *asc.Client, asset *asc.ImageAsset, path string) error {
+	download, err := client.DownloadImageAsset(ctx, asset, "png")
+	if err != nil {
+		return err
+	}
+	defer download.Body.Close()
+
+	return fileutil.WriteAtomic(path, 0o755, func(w io.Writer) error {
+		_, err := io.Copy(w, download.Body)
+		return err
+	})
+}
@@ -0,0 +1,85 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestScreenshotDisplayTypeFromFileName(t *testing.T) {
+	tests := map[string]string{
+		"iPhone 15 Pro Max-01_home.png":                       "APP_IPHONE_67",
+		"iPhone 16 Pro Max-02.png":                            "APP_IPHONE_69",
+		"iPhone 16 Pro-02.png":                                "APP_IPHONE_61",
+		"iPhone 11 Pro Max-01.png":                            "APP_IPHONE_65",
+		"iPhone 11 Pro-01.png":                                "APP_IPHONE_58",
+		"iPhone 8 Plus-01.png":                                "APP_IPHONE_55",
+		"iPhone SE (3rd generation)-01.png":                   "APP_IPHONE_47",
+		"iPad Pro (12.9-inch) (6th generation)-01_framed.png": "APP_IPAD_PRO_3GEN_129",
+		"iPad Pro (12.9-inch) (2nd generation)-01.png":        "APP_IPAD_PRO_129",
+		"iPad Pro (11-inch) (4th generation)-01.png":          "APP_IPAD_PRO_3GEN_11",
+		"APP_IPHONE_65-1.jpg":                                 "APP_IPHONE_65",
+		"01_IPAD_PRO_3GEN_129.png":                            "APP_IPAD_PRO_3GEN_129",
+		"apple_tv-01.png":                                     "APP_APPLE_TV",
+		"Apple TV 4K-01.png":                                  "APP_APPLE_TV",
+		"home.png":                                            "",
+	}
+	for name, want := range tests {
+		got, ok := screenshotDisplayTypeFromFileName(name)
+		if want == "" {
+			if ok {
+				t.Errorf("%q: expected no display type, got %q", name, got)
+			}
+			continue
+		}
+		if !ok || got != want {
+			t.Errorf("%q: expected %q, got %q (ok=%t)", name, want, got, ok)
+		}
+	}
+}
+
+func TestReadFastlaneScreenshots(t *testing.T) {
+	dir := t.TempDir()
+	enDir := filepath.Join(dir, "en-US")
+	imessageDir := filepath.Join(enDir, "iMessage")
+	if err := os.MkdirAll(imessageDir, 0o755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	for _, path := range []string{
+		filepath.Join(dir, "screenshots.html"),
+		filepath.Join(enDir, "iPhone 15 Pro Max-02.png"),
+		filepath.Join(enDir, "iPhone 15 Pro Max-01.png"),
+		filepath.Join(enDir, "iPhone 15 Pro Max-01_framed.png"),
+		filepath.Join(enDir, "home.png"),
+		filepath.Join(enDir, "notes.txt"),
+		filepath.Join(imessageDir, "IPHONE_65-01.png"),
+	} {
+		if err := os.WriteFile(path, []byte("data"), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+	}
+
+	screenshots, err := readFastlaneScreenshots(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct {
+		fileName    string
+		displayType string
+		status      string
+	}{
+		{"home.png", "", screenshotStatusSkipped},
+		{"iPhone 15 Pro Max-01_framed.png", "APP_IPHONE_67", screenshotStatusPlanned},
+		{"iPhone 15 Pro Max-02.png", "APP_IPHONE_67", screenshotStatusPlanned},
+		{"IPHONE_65-01.png", "IMESSAGE_APP_IPHONE_65", screenshotStatusPlanned},
+	}
+	if len(screenshots) != len(want) {
+		t.Fatalf("expected %d screenshots, got %+v", len(want), screenshots)
+	}
+	for i, item := range screenshots {
+		if item.Locale != "en-US" || item.FileName != want[i].fileName || item.DisplayType != want[i].displayType || item.Status != want[i].status {
+			t.Errorf("screenshot %d: got %+v, want %+v", i, item, want[i])
+		}
+	}
+}
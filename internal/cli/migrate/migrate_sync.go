@@ -0,0 +1,419 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	syncActionCreate = "create"
+	syncActionUpdate = "update"
+
+	syncStatusPlanned = "planned"
+	syncStatusApplied = "applied"
+	syncStatusFailed  = "failed"
+
+	syncResourceVersion = "appStoreVersionLocalization"
+	syncResourceAppInfo = "appInfoLocalization"
+
+	// syncPlanValueWidth caps values shown in the printed change plan.
+	syncPlanValueWidth = 72
+)
+
+// MigrateSyncChange is one field that differs between fastlane and App Store Connect.
+type MigrateSyncChange struct {
+	Resource string `json:"resource"`
+	Locale   string `json:"locale"`
+	Action   string `json:"action"`
+	Field    string `json:"field"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+// MigrateSyncResult is the result of a migrate sync operation.
+type MigrateSyncResult struct {
+	DryRun    bool                `json:"dryRun"`
+	VersionID string              `json:"versionId"`
+	AppInfoID string              `json:"appInfoId,omitempty"`
+	Changes   []MigrateSyncChange `json:"changes"`
+	Unchanged int                 `json:"unchanged"`
+	Applied   int                 `json:"applied"`
+	Failed    int                 `json:"failed"`
+}
+
+// syncField is one fastlane field and its remote counterpart.
+type syncField struct {
+	name   string
+	local  string
+	remote string
+}
+
+// MigrateSyncCommand returns the migrate sync subcommand.
+func MigrateSyncCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("migrate sync", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	fastlaneDir := fs.String("fastlane-dir", "", "Path to fastlane directory (required)")
+	includeAppInfo := fs.Bool("include-app-info", false, "Also sync name.txt, subtitle.txt, and privacy_url.txt with App Info localizations")
+	vars := shared.TemplateVars{}
+	fs.Var(vars, "var", "Template variable key=value for {{key}} placeholders (repeatable)")
+	dryRun := fs.Bool("dry-run", false, "Print the change plan without updating anything")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "sync",
+		ShortUsage: "asc migrate sync [flags]",
+		ShortHelp:  "Update only the metadata fields that differ from fastlane.",
+		LongHelp: `Update only the metadata fields that differ from fastlane.
+
+Fetches the current localizations, compares them field by field with the
+fastlane metadata directory, prints the change plan to stderr, and sends
+only the changed fields. Unchanged localizations are not touched, so their
+edit timestamps stay put. Empty or missing files never clear remote values.
+
+Placeholders such as {{version}} and {{date}} are expanded as in
+asc migrate import before comparing.
+
+Examples:
+  asc migrate sync --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --dry-run
+  asc migrate sync --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane
+  asc migrate sync --app "APP_ID" --version-id "VERSION_ID" --fastlane-dir ./fastlane --include-app-info --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			versionValue := strings.TrimSpace(*versionID)
+			if versionValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*fastlaneDir) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --fastlane-dir is required")
+				return flag.ErrHelp
+			}
+
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if *includeAppInfo && resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required with --include-app-info (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			metadataDir := filepath.Join(*fastlaneDir, "metadata")
+			localizations, err := readFastlaneMetadata(metadataDir)
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					return fmt.Errorf("migrate sync: metadata directory not found: %s", metadataDir)
+				}
+				return fmt.Errorf("migrate sync: %w", err)
+			}
+			var appInfoLocs []AppInfoFastlaneLocalization
+			if *includeAppInfo {
+				appInfoLocs, err = readFastlaneAppInfoMetadata(metadataDir)
+				if err != nil {
+					return fmt.Errorf("migrate sync: %w", err)
+				}
+			}
+			if err := expandFastlaneTemplates(ctx, versionValue, vars, localizations, appInfoLocs); err != nil {
+				return fmt.Errorf("migrate sync: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("migrate sync: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			remoteVersionLocs, err := client.GetAppStoreVersionLocalizations(requestCtx, versionValue)
+			if err != nil {
+				return fmt.Errorf("migrate sync: failed to fetch localizations: %w", err)
+			}
+
+			result := &MigrateSyncResult{DryRun: *dryRun, VersionID: versionValue}
+			plan := planVersionLocalizationSync(versionValue, localizations, remoteVersionLocs.Data)
+
+			if len(appInfoLocs) > 0 {
+				appInfos, err := client.GetAppInfos(requestCtx, resolvedAppID)
+				if err != nil {
+					return fmt.Errorf("migrate sync: failed to get app info: %w", err)
+				}
+				appInfoID := shared.SelectBestAppInfoID(appInfos)
+				if strings.TrimSpace(appInfoID) == "" {
+					return fmt.Errorf("migrate sync: failed to select app info for app")
+				}
+				remoteAppInfoLocs, err := client.GetAppInfoLocalizations(requestCtx, appInfoID)
+				if err != nil {
+					return fmt.Errorf("migrate sync: failed to fetch app info localizations: %w", err)
+				}
+				result.AppInfoID = appInfoID
+				plan.merge(planAppInfoLocalizationSync(appInfoID, appInfoLocs, remoteAppInfoLocs.Data))
+			}
+
+			result.Unchanged = plan.unchanged
+			printSyncPlan(os.Stderr, plan.groups)
+
+			if !*dryRun {
+				for i := range plan.groups {
+					group := &plan.groups[i]
+					if err := group.run(requestCtx, client); err != nil {
+						group.setStatus(syncStatusFailed, err.Error())
+						result.Failed += len(group.changes)
+						continue
+					}
+					group.setStatus(syncStatusApplied, "")
+					result.Applied += len(group.changes)
+				}
+			}
+			result.Changes = plan.changes()
+
+			if err := printMigrateOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return fmt.Errorf("migrate sync: %d of %d changes failed", result.Failed, len(result.Changes))
+			}
+			return nil
+		},
+	}
+}
+
+// syncGroup is the set of field changes for one localization, sent as a
+// single create or update request.
+type syncGroup struct {
+	changes []MigrateSyncChange
+	run     func(ctx context.Context, client *asc.Client) error
+}
+
+func (g *syncGroup) setStatus(status, message string) {
+	for i := range g.changes {
+		g.changes[i].Status = status
+		g.changes[i].Error = message
+	}
+}
+
+type syncPlan struct {
+	groups    []syncGroup
+	unchanged int
+}
+
+func (p *syncPlan) merge(other syncPlan) {
+	p.groups = append(p.groups, other.groups...)
+	p.unchanged += other.unchanged
+}
+
+func (p *syncPlan) changes() []MigrateSyncChange {
+	changes := make([]MigrateSyncChange, 0)
+	for _, group := range p.groups {
+		changes = append(changes, group.changes...)
+	}
+	return changes
+}
+
+func planVersionLocalizationSync(versionID string, local []FastlaneLocalization, remote []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) syncPlan {
+	remoteByLocale := make(map[string]asc.Resource[asc.AppStoreVersionLocalizationAttributes], len(remote))
+	for _, item := range remote {
+		remoteByLocale[item.Attributes.Locale] = item
+	}
+
+	sorted := append([]FastlaneLocalization(nil), local...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Locale < sorted[j].Locale })
+
+	var plan syncPlan
+	for _, loc := range sorted {
+		existing, exists := remoteByLocale[loc.Locale]
+		current := existing.Attributes
+		fields := []syncField{
+			{"description", loc.Description, current.Description},
+			{"keywords", loc.Keywords, current.Keywords},
+			{"whatsNew", loc.WhatsNew, current.WhatsNew},
+			{"promotionalText", loc.PromotionalText, current.PromotionalText},
+			{"supportUrl", loc.SupportURL, current.SupportURL},
+			{"marketingUrl", loc.MarketingURL, current.MarketingURL},
+		}
+		changed, unchanged := diffSyncFields(fields)
+		plan.unchanged += unchanged
+		if len(changed) == 0 {
+			continue
+		}
+
+		// Only changed fields are set; empty attributes are omitted from the request.
+		attrs := asc.AppStoreVersionLocalizationAttributes{}
+		for _, field := range changed {
+			switch field.name {
+			case "description":
+				attrs.Description = field.local
+			case "keywords":
+				attrs.Keywords = field.local
+			case "whatsNew":
+				attrs.WhatsNew = field.local
+			case "promotionalText":
+				attrs.PromotionalText = field.local
+			case "supportUrl":
+				attrs.SupportURL = field.local
+			case "marketingUrl":
+				attrs.MarketingURL = field.local
+			}
+		}
+
+		group := syncGroup{changes: syncChanges(syncResourceVersion, loc.Locale, exists, changed)}
+		if exists {
+			id := existing.ID
+			group.run = func(ctx context.Context, client *asc.Client) error {
+				_, err := client.UpdateAppStoreVersionLocalization(ctx, id, attrs)
+				return err
+			}
+		} else {
+			attrs.Locale = loc.Locale
+			group.run = func(ctx context.Context, client *asc.Client) error {
+				_, err := client.CreateAppStoreVersionLocalization(ctx, versionID, attrs)
+				return err
+			}
+		}
+		plan.groups = append(plan.groups, group)
+	}
+	return plan
+}
+
+func planAppInfoLocalizationSync(appInfoID string, local []AppInfoFastlaneLocalization, remote []asc.Resource[asc.AppInfoLocalizationAttributes]) syncPlan {
+	remoteByLocale := make(map[string]asc.Resource[asc.AppInfoLocalizationAttributes], len(remote))
+	for _, item := range remote {
+		remoteByLocale[item.Attributes.Locale] = item
+	}
+
+	sorted := append([]AppInfoFastlaneLocalization(nil), local...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Locale < sorted[j].Locale })
+
+	var plan syncPlan
+	for _, loc := range sorted {
+		existing, exists := remoteByLocale[loc.Locale]
+		current := existing.Attributes
+		fields := []syncField{
+			{"name", loc.Name, current.Name},
+			{"subtitle", loc.Subtitle, current.Subtitle},
+			{"privacyPolicyUrl", loc.PrivacyPolicyURL, current.PrivacyPolicyURL},
+		}
+		changed, unchanged := diffSyncFields(fields)
+		plan.unchanged += unchanged
+		if len(changed) == 0 {
+			continue
+		}
+
+		attrs := asc.AppInfoLocalizationAttributes{}
+		for _, field := range changed {
+			switch field.name {
+			case "name":
+				attrs.Name = field.local
+			case "subtitle":
+				attrs.Subtitle = field.local
+			case "privacyPolicyUrl":
+				attrs.PrivacyPolicyURL = field.local
+			}
+		}
+
+		group := syncGroup{changes: syncChanges(syncResourceAppInfo, loc.Locale, exists, changed)}
+		if exists {
+			id := existing.ID
+			group.run = func(ctx context.Context, client *asc.Client) error {
+				_, err := client.UpdateAppInfoLocalization(ctx, id, attrs)
+				return err
+			}
+		} else {
+			attrs.Locale = loc.Locale
+			group.run = func(ctx context.Context, client *asc.Client) error {
+				_, err := client.CreateAppInfoLocalization(ctx, appInfoID, attrs)
+				return err
+			}
+		}
+		plan.groups = append(plan.groups, group)
+	}
+	return plan
+}
+
+// diffSyncFields splits fields into those whose local value differs from the
+// remote one and a count of those already in sync. Empty local values are
+// ignored so missing files never clear remote metadata.
+func diffSyncFields(fields []syncField) ([]syncField, int) {
+	var changed []syncField
+	unchanged := 0
+	for _, field := range fields {
+		if field.local == "" {
+			continue
+		}
+		if field.local == strings.TrimSpace(field.remote) {
+			unchanged++
+			continue
+		}
+		changed = append(changed, field)
+	}
+	return changed, unchanged
+}
+
+func syncChanges(resource, locale string, exists bool, fields []syncField) []MigrateSyncChange {
+	action := syncActionCreate
+	if exists {
+		action = syncActionUpdate
+	}
+	changes := make([]MigrateSyncChange, 0, len(fields))
+	for _, field := range fields {
+		changes = append(changes, MigrateSyncChange{
+			Resource: resource,
+			Locale:   locale,
+			Action:   action,
+			Field:    field.name,
+			Old:      strings.TrimSpace(field.remote),
+			New:      field.local,
+			Status:   syncStatusPlanned,
+		})
+	}
+	return changes
+}
+
+// printSyncPlan writes a human-readable change plan, colored when w is a
+// terminal that supports it.
+func printSyncPlan(w io.Writer, groups []syncGroup) {
+	if len(groups) == 0 {
+		fmt.Fprintln(w, "No changes: fastlane metadata matches App Store Connect.")
+		return
+	}
+	for _, group := range groups {
+		first := group.changes[0]
+		marker := shared.Yellow("~")
+		if first.Action == syncActionCreate {
+			marker = shared.Green("+")
+		}
+		fmt.Fprintf(w, "%s %s %s\n", marker, first.Resource, shared.Bold(first.Locale))
+		for _, change := range group.changes {
+			fmt.Fprintf(w, "    %s\n", change.Field)
+			if change.Old != "" {
+				fmt.Fprintf(w, "      %s\n", shared.Red("- "+planValue(change.Old)))
+			}
+			fmt.Fprintf(w, "      %s\n", shared.Green("+ "+planValue(change.New)))
+		}
+	}
+}
+
+// planValue flattens a value to one line and shortens it for the plan.
+func planValue(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	runes := []rune(value)
+	if len(runes) > syncPlanValueWidth {
+		return string(runes[:syncPlanValueWidth-1]) + "…"
+	}
+	return value
+}
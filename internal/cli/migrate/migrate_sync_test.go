@@ -0,0 +1,65 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestPlanVersionLocalizationSync(t *testing.T) {
+	local := []FastlaneLocalization{
+		{Locale: "en-US", Description: "Same", Keywords: "new", SupportURL: "https://example.com"},
+		{Locale: "ja", Description: "New locale"},
+	}
+	remote := []asc.Resource[asc.AppStoreVersionLocalizationAttributes]{
+		{ID: "LOC_EN", Attributes: asc.AppStoreVersionLocalizationAttributes{
+			Locale:      "en-US",
+			Description: "Same\n",
+			Keywords:    "old",
+			WhatsNew:    "Remote only",
+			SupportURL:  "https://example.com",
+		}},
+	}
+
+	plan := planVersionLocalizationSync("VERSION_ID", local, remote)
+
+	if plan.unchanged != 2 {
+		t.Fatalf("expected 2 unchanged fields, got %d", plan.unchanged)
+	}
+	changes := plan.changes()
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %+v", changes)
+	}
+	if got := changes[0]; got.Locale != "en-US" || got.Field != "keywords" || got.Action != syncActionUpdate || got.Old != "old" || got.New != "new" {
+		t.Fatalf("unexpected update change: %+v", got)
+	}
+	if got := changes[1]; got.Locale != "ja" || got.Field != "description" || got.Action != syncActionCreate || got.Old != "" {
+		t.Fatalf("unexpected create change: %+v", got)
+	}
+}
+
+func TestPlanAppInfoLocalizationSyncNoChanges(t *testing.T) {
+	local := []AppInfoFastlaneLocalization{{Locale: "en-US", Name: "My App"}}
+	remote := []asc.Resource[asc.AppInfoLocalizationAttributes]{
+		{ID: "INFO_LOC", Attributes: asc.AppInfoLocalizationAttributes{Locale: "en-US", Name: "My App", Subtitle: "Remote"}},
+	}
+
+	plan := planAppInfoLocalizationSync("APP_INFO_ID", local, remote)
+
+	if len(plan.groups) != 0 || plan.unchanged != 1 {
+		t.Fatalf("expected no changes and 1 unchanged field, got %+v", plan)
+	}
+}
+
+func TestPlanValueTruncates(t *testing.T) {
+	if got := planValue("line one\nline two"); got != "line one line two" {
+		t.Fatalf("expected flattened value, got %q", got)
+	}
+	long := make([]rune, syncPlanValueWidth+10)
+	for i := range long {
+		long[i] = 'a'
+	}
+	if got := []rune(planValue(string(long))); len(got) != syncPlanValueWidth || got[len(got)-1] != '…' {
+		t.Fatalf("expected truncated value, got %q", string(got))
+	}
+}
@@ -0,0 +1,85 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// templateField is one fastlane metadata value that may hold placeholders.
+type templateField struct {
+	locale string
+	name   string
+	value  *string
+}
+
+// expandFastlaneTemplates expands {{name}} placeholders in the metadata read
+// from fastlane files. {{date}} is today (YYYY-MM-DD) and {{version}} is the
+// version string of versionID, fetched only when referenced. --var values
+// override both.
+func expandFastlaneTemplates(ctx context.Context, versionID string, vars shared.TemplateVars, localizations []FastlaneLocalization, appInfoLocs []AppInfoFastlaneLocalization) error {
+	var fields []templateField
+	for i := range localizations {
+		loc := &localizations[i]
+		fields = append(fields,
+			templateField{loc.Locale, "description", &loc.Description},
+			templateField{loc.Locale, "keywords", &loc.Keywords},
+			templateField{loc.Locale, "whatsNew", &loc.WhatsNew},
+			templateField{loc.Locale, "promotionalText", &loc.PromotionalText},
+			templateField{loc.Locale, "supportUrl", &loc.SupportURL},
+			templateField{loc.Locale, "marketingUrl", &loc.MarketingURL},
+		)
+	}
+	for i := range appInfoLocs {
+		loc := &appInfoLocs[i]
+		fields = append(fields,
+			templateField{loc.Locale, "name", &loc.Name},
+			templateField{loc.Locale, "subtitle", &loc.Subtitle},
+			templateField{loc.Locale, "privacyPolicyUrl", &loc.PrivacyPolicyURL},
+		)
+	}
+
+	values := map[string]string{"date": time.Now().Format("2006-01-02")}
+	if _, ok := vars["version"]; !ok {
+		for _, field := range fields {
+			if !shared.TemplateReferences(*field.value, "version") {
+				continue
+			}
+			versionString, err := fetchVersionString(ctx, versionID)
+			if err != nil {
+				return fmt.Errorf("failed to resolve {{version}}: %w", err)
+			}
+			values["version"] = versionString
+			break
+		}
+	}
+	for key, value := range vars {
+		values[key] = value
+	}
+
+	for _, field := range fields {
+		expanded, err := shared.ExpandTemplate(*field.value, values)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", field.locale, field.name, err)
+		}
+		*field.value = expanded
+	}
+	return nil
+}
+
+func fetchVersionString(ctx context.Context, versionID string) (string, error) {
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return "", err
+	}
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	resp, err := client.GetAppStoreVersion(requestCtx, versionID)
+	if err != nil {
+		return "", err
+	}
+	return resp.Data.Attributes.VersionString, nil
+}
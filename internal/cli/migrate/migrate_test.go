@@ -4,9 +4,6 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
-
-	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
-	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc/types"
 )
 
 func TestReadFileIfExists_FileExists(t *testing.T) {
@@ -242,97 +239,66 @@ func TestReadFastlaneMetadata_SkipsFiles(t *testing.T) {
 	}
 }
 
-func TestSelectBestAppInfoID_PrefersPrepareForSubmission(t *testing.T) {
-	appInfos := &asc.AppInfosResponse{
-		Data: []types.Resource[asc.AppInfoAttributes]{
-			{
-				ID: "ready",
-				Attributes: asc.AppInfoAttributes{
-					"state":         "READY_FOR_DISTRIBUTION",
-					"appStoreState": "READY_FOR_SALE",
-				},
-			},
-			{
-				ID: "prep",
-				Attributes: asc.AppInfoAttributes{
-					"state":         "PREPARE_FOR_SUBMISSION",
-					"appStoreState": "PREPARE_FOR_SUBMISSION",
-				},
-			},
-		},
-	}
-
-	if got := selectBestAppInfoID(appInfos); got != "prep" {
-		t.Fatalf("expected appInfoID %q, got %q", "prep", got)
+func TestReadFastlaneMetadata_EmptyDirectory(t *testing.T) {
+	dir := t.TempDir()
+
+	locs, err := readFastlaneMetadata(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-}
 
-func TestSelectBestAppInfoID_FallsBackToNonReadyForSale(t *testing.T) {
-	appInfos := &asc.AppInfosResponse{
-		Data: []types.Resource[asc.AppInfoAttributes]{
-			{
-				ID: "ready",
-				Attributes: asc.AppInfoAttributes{
-					"appStoreState": "READY_FOR_SALE",
-				},
-			},
-			{
-				ID: "not-ready",
-				Attributes: asc.AppInfoAttributes{
-					"appStoreState": "DEVELOPER_REMOVED_FROM_SALE",
-				},
-			},
-		},
-	}
-
-	if got := selectBestAppInfoID(appInfos); got != "not-ready" {
-		t.Fatalf("expected appInfoID %q, got %q", "not-ready", got)
+	if len(locs) != 0 {
+		t.Errorf("expected 0 localizations, got %d", len(locs))
 	}
 }
 
-func TestSelectBestAppInfoID_EmptyInput(t *testing.T) {
-	if got := selectBestAppInfoID(nil); got != "" {
-		t.Fatalf("expected empty appInfoID for nil input, got %q", got)
-	}
+func TestReadFastlaneAppInfoMetadata_ReadsPrivacyURL(t *testing.T) {
+	dir := t.TempDir()
 
-	if got := selectBestAppInfoID(&asc.AppInfosResponse{}); got != "" {
-		t.Fatalf("expected empty appInfoID for empty input, got %q", got)
+	enDir := filepath.Join(dir, "en-US")
+	if err := os.MkdirAll(enDir, 0o755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
 	}
-}
-
-func TestSelectBestAppInfoID_UsesStateWhenAppStoreStateMissing(t *testing.T) {
-	appInfos := &asc.AppInfosResponse{
-		Data: []types.Resource[asc.AppInfoAttributes]{
-			{
-				ID: "live",
-				Attributes: asc.AppInfoAttributes{
-					"state": "READY_FOR_DISTRIBUTION",
-				},
-			},
-			{
-				ID: "editable",
-				Attributes: asc.AppInfoAttributes{
-					"state": "IN_REVIEW",
-				},
-			},
-		},
-	}
-
-	if got := selectBestAppInfoID(appInfos); got != "editable" {
-		t.Fatalf("expected appInfoID %q, got %q", "editable", got)
+	if err := os.WriteFile(filepath.Join(enDir, "name.txt"), []byte("My App"), 0o644); err != nil {
+		t.Fatalf("failed to write name: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(enDir, "privacy_url.txt"), []byte("https://example.com/privacy\n"), 0o644); err != nil {
+		t.Fatalf("failed to write privacy url: %v", err)
 	}
-}
 
-func TestReadFastlaneMetadata_EmptyDirectory(t *testing.T) {
-	dir := t.TempDir()
+	// A locale with only a privacy URL is still an App Info localization.
+	frDir := filepath.Join(dir, "fr-FR")
+	if err := os.MkdirAll(frDir, 0o755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(frDir, "privacy_url.txt"), []byte("https://example.com/fr/privacy"), 0o644); err != nil {
+		t.Fatalf("failed to write privacy url: %v", err)
+	}
 
-	locs, err := readFastlaneMetadata(dir)
+	locs, err := readFastlaneAppInfoMetadata(dir)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	if len(locs) != 2 {
+		t.Fatalf("expected 2 localizations, got %d", len(locs))
+	}
 
-	if len(locs) != 0 {
-		t.Errorf("expected 0 localizations, got %d", len(locs))
+	for _, loc := range locs {
+		switch loc.Locale {
+		case "en-US":
+			if loc.Name != "My App" || loc.PrivacyPolicyURL != "https://example.com/privacy" {
+				t.Errorf("unexpected en-US localization: %+v", loc)
+			}
+			if got := countNonEmptyAppInfoFields(loc); got != 2 {
+				t.Errorf("expected 2 fields, got %d", got)
+			}
+		case "fr-FR":
+			if loc.Name != "" || loc.PrivacyPolicyURL != "https://example.com/fr/privacy" {
+				t.Errorf("unexpected fr-FR localization: %+v", loc)
+			}
+		default:
+			t.Errorf("unexpected locale: %s", loc.Locale)
+		}
 	}
 }
 
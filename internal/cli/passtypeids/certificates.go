@@ -23,6 +23,8 @@ func PassTypeIDCertificatesCommand() *ffcli.Command {
 		ShortHelp:  "List pass type ID certificates.",
 		LongHelp: `List pass type ID certificates.
 
+Create pass certificates with "asc certificates create --pass-type-id".
+
 Examples:
   asc pass-type-ids certificates list --pass-type-id "PASS_ID"
   asc pass-type-ids certificates get --pass-type-id "PASS_ID"`,
@@ -0,0 +1,196 @@
+package performance
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// MetricsCommand returns the top-level metrics command.
+func MetricsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metrics", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "metrics",
+		ShortUsage: "asc metrics <subcommand> [flags]",
+		ShortHelp:  "Fetch app performance and power metrics.",
+		LongHelp: `Fetch app performance and power metrics.
+
+Examples:
+  asc metrics perf --app "APP_ID" --metric LAUNCH,HANG --device-class iPhone
+  asc metrics perf --app "APP_ID" --build "BUILD_ID" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MetricsPerfCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// MetricsPerfCommand returns the metrics perf subcommand.
+func MetricsPerfCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("perf", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	buildID := fs.String("build", "", "Build ID to fetch metrics for instead of the whole app")
+	metric := fs.String("metric", "", "Metric types (comma-separated: "+strings.Join(perfPowerMetricTypeList(), ", ")+")")
+	deviceClass := fs.String("device-class", "", "Only include device classes matching this value (e.g., iPhone, iPad, iPhone15,2)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "perf",
+		ShortUsage: "asc metrics perf --app APP_ID [--build BUILD_ID] [--metric LAUNCH,HANG] [--device-class iPhone]",
+		ShortHelp:  "Fetch performance/power metrics percentiles.",
+		LongHelp: `Fetch performance and power metrics (launch time, hangs, memory, and so
+on) for an app, or for a single build with --build.
+
+JSON output is the Xcode metrics payload from App Store Connect. Table and
+markdown output flatten it to one row per metric, device class, percentile
+and app version.
+
+--device-class matches device classes case-insensitively by name or
+identifier, so "iPhone" matches "All iPhones" and "iPhone15,2".
+
+Examples:
+  asc metrics perf --app "APP_ID" --metric LAUNCH,HANG --device-class iPhone
+  asc metrics perf --app "APP_ID" --build "BUILD_ID" --output table
+  asc metrics perf --app "APP_ID" --metric MEMORY --output markdown`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedBuildID := strings.TrimSpace(*buildID)
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" && trimmedBuildID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			metricTypes, err := normalizePerfPowerMetricTypes(shared.SplitCSVUpper(*metric), "--metric")
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("metrics perf: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.PerfPowerMetricsOption{asc.WithPerfPowerMetricsMetricTypes(metricTypes)}
+			var resp *asc.PerfPowerMetricsResponse
+			if trimmedBuildID != "" {
+				resp, err = client.GetPerfPowerMetricsForBuild(requestCtx, trimmedBuildID, opts...)
+			} else {
+				resp, err = client.GetPerfPowerMetricsForApp(requestCtx, resolvedAppID, opts...)
+			}
+			if err != nil {
+				return fmt.Errorf("metrics perf: %w", err)
+			}
+
+			class := strings.TrimSpace(*deviceClass)
+			switch strings.ToLower(*output) {
+			case "table", "markdown", "md":
+				items, err := resp.Percentiles()
+				if err != nil {
+					return fmt.Errorf("metrics perf: %w", err)
+				}
+				result := &asc.PerfPowerMetricPercentilesResult{
+					BuildID: trimmedBuildID,
+					Data:    make([]asc.PerfPowerMetricPercentile, 0, len(items)),
+				}
+				if trimmedBuildID == "" {
+					result.AppID = resolvedAppID
+				}
+				for _, item := range items {
+					if matchesDeviceClass(class, item.Device) {
+						result.Data = append(result.Data, item)
+					}
+				}
+				return shared.PrintOutput(result, *output, *pretty)
+			default:
+				if class != "" {
+					filtered, err := filterPerfPowerMetricsDeviceClass(resp.Data, class)
+					if err != nil {
+						return fmt.Errorf("metrics perf: %w", err)
+					}
+					resp = &asc.PerfPowerMetricsResponse{Data: filtered}
+				}
+				return shared.PrintOutput(resp, *output, *pretty)
+			}
+		},
+	}
+}
+
+// matchesDeviceClass reports whether any of the device names contain class,
+// ignoring case. An empty class matches everything.
+func matchesDeviceClass(class string, names ...string) bool {
+	if class == "" {
+		return true
+	}
+	class = strings.ToLower(class)
+	for _, name := range names {
+		if strings.Contains(strings.ToLower(name), class) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterPerfPowerMetricsDeviceClass drops datasets whose device does not
+// match class from a raw Xcode metrics payload, keeping everything else as is.
+func filterPerfPowerMetricsDeviceClass(data json.RawMessage, class string) (json.RawMessage, error) {
+	var payload map[string]any
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("decode perf power metrics: %w", err)
+	}
+
+	products, _ := payload["productData"].([]any)
+	for _, product := range products {
+		categories, _ := asMap(product)["metricCategories"].([]any)
+		for _, category := range categories {
+			metrics, _ := asMap(category)["metrics"].([]any)
+			for _, metric := range metrics {
+				metricMap := asMap(metric)
+				datasets, ok := metricMap["datasets"].([]any)
+				if !ok {
+					continue
+				}
+				kept := make([]any, 0, len(datasets))
+				for _, dataset := range datasets {
+					criteria := asMap(asMap(dataset)["filterCriteria"])
+					device, _ := criteria["device"].(string)
+					marketingName, _ := criteria["deviceMarketingName"].(string)
+					if matchesDeviceClass(class, device, marketingName) {
+						kept = append(kept, dataset)
+					}
+				}
+				metricMap["datasets"] = kept
+			}
+		}
+	}
+
+	filtered, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("encode perf power metrics: %w", err)
+	}
+	return filtered, nil
+}
+
+func asMap(value any) map[string]any {
+	m, _ := value.(map[string]any)
+	return m
+}
@@ -84,7 +84,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("performance download: %w", err)
 			}
-			metricTypes, err := normalizePerfPowerMetricTypes(shared.SplitCSVUpper(*metricType))
+			metricTypes, err := normalizePerfPowerMetricTypes(shared.SplitCSVUpper(*metricType), "--metric-type")
 			if err != nil {
 				return fmt.Errorf("performance download: %w", err)
 			}
@@ -114,19 +114,11 @@ Examples:
 				shouldDecompress := *decompress && isGzip
 				compressedPath, decompressedPath := shared.ResolveReportOutputPaths(*output, defaultOutput, ".json", shouldDecompress)
 
-				compressedSize, err := shared.WriteStreamToFile(compressedPath, reader)
+				compressedSize, decompressedSize, err := shared.WriteReportStream(compressedPath, decompressedPath, reader)
 				if err != nil {
 					return fmt.Errorf("performance download: %w", err)
 				}
 
-				var decompressedSize int64
-				if shouldDecompress {
-					decompressedSize, err = shared.DecompressGzipFile(compressedPath, decompressedPath)
-					if err != nil {
-						return fmt.Errorf("performance download: %w", err)
-					}
-				}
-
 				result := &asc.PerformanceDownloadResult{
 					DownloadType:          "diagnostic-logs",
 					DiagnosticSignatureID: trimmedDiagnosticID,
@@ -158,19 +150,11 @@ Examples:
 				shouldDecompress := *decompress && isGzip
 				compressedPath, decompressedPath := shared.ResolveReportOutputPaths(*output, defaultOutput, ".json", shouldDecompress)
 
-				compressedSize, err := shared.WriteStreamToFile(compressedPath, reader)
+				compressedSize, decompressedSize, err := shared.WriteReportStream(compressedPath, decompressedPath, reader)
 				if err != nil {
 					return fmt.Errorf("performance download: %w", err)
 				}
 
-				var decompressedSize int64
-				if shouldDecompress {
-					decompressedSize, err = shared.DecompressGzipFile(compressedPath, decompressedPath)
-					if err != nil {
-						return fmt.Errorf("performance download: %w", err)
-					}
-				}
-
 				result := &asc.PerformanceDownloadResult{
 					DownloadType:     "metrics",
 					BuildID:          trimmedBuildID,
@@ -202,19 +186,11 @@ Examples:
 				shouldDecompress := *decompress && isGzip
 				compressedPath, decompressedPath := shared.ResolveReportOutputPaths(*output, defaultOutput, ".json", shouldDecompress)
 
-				compressedSize, err := shared.WriteStreamToFile(compressedPath, reader)
+				compressedSize, decompressedSize, err := shared.WriteReportStream(compressedPath, decompressedPath, reader)
 				if err != nil {
 					return fmt.Errorf("performance download: %w", err)
 				}
 
-				var decompressedSize int64
-				if shouldDecompress {
-					decompressedSize, err = shared.DecompressGzipFile(compressedPath, decompressedPath)
-					if err != nil {
-						return fmt.Errorf("performance download: %w", err)
-					}
-				}
-
 				result := &asc.PerformanceDownloadResult{
 					DownloadType:     "metrics",
 					AppID:            appFlag,
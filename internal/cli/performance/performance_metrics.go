@@ -71,7 +71,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("performance metrics list: %w", err)
 			}
-			metricTypes, err := normalizePerfPowerMetricTypes(shared.SplitCSVUpper(*metricType))
+			metricTypes, err := normalizePerfPowerMetricTypes(shared.SplitCSVUpper(*metricType), "--metric-type")
 			if err != nil {
 				return fmt.Errorf("performance metrics list: %w", err)
 			}
@@ -131,7 +131,7 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("performance metrics get: %w", err)
 			}
-			metricTypes, err := normalizePerfPowerMetricTypes(shared.SplitCSVUpper(*metricType))
+			metricTypes, err := normalizePerfPowerMetricTypes(shared.SplitCSVUpper(*metricType), "--metric-type")
 			if err != nil {
 				return fmt.Errorf("performance metrics get: %w", err)
 			}
@@ -180,13 +180,13 @@ func perfPowerMetricTypeList() []string {
 	}
 }
 
-func normalizePerfPowerMetricTypes(values []string) ([]string, error) {
+func normalizePerfPowerMetricTypes(values []string, flagName string) ([]string, error) {
 	if len(values) == 0 {
 		return nil, nil
 	}
 	for _, value := range values {
 		if _, ok := perfPowerMetricTypes[value]; !ok {
-			return nil, fmt.Errorf("--metric-type must be one of: %s", strings.Join(perfPowerMetricTypeList(), ", "))
+			return nil, fmt.Errorf("%s must be one of: %s", flagName, strings.Join(perfPowerMetricTypeList(), ", "))
 		}
 	}
 	return values, nil
@@ -1,6 +1,9 @@
 package performance
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestPerformanceCommandConstructors(t *testing.T) {
 	top := PerformanceCommand()
@@ -27,4 +30,45 @@ func TestPerformanceCommandConstructors(t *testing.T) {
 	if got := PerformanceDownloadCommand(); got == nil {
 		t.Fatal("expected download command")
 	}
+	if got := MetricsCommand(); got == nil || len(got.Subcommands) == 0 {
+		t.Fatal("expected top-level metrics command with subcommands")
+	}
+}
+
+func TestFilterPerfPowerMetricsDeviceClass(t *testing.T) {
+	data := json.RawMessage(`{"version":"1.0","productData":[{"metricCategories":[{"identifier":"launch","metrics":[{"identifier":"timeToFirstDraw","datasets":[
+		{"filterCriteria":{"device":"all_iphones","deviceMarketingName":"All iPhones"},"points":[]},
+		{"filterCriteria":{"device":"all_ipads","deviceMarketingName":"All iPads"},"points":[]},
+		{"filterCriteria":{"device":"iPhone15,2"},"points":[]}
+	]}]}]}]}`)
+
+	filtered, err := filterPerfPowerMetricsDeviceClass(data, "iphone")
+	if err != nil {
+		t.Fatalf("filterPerfPowerMetricsDeviceClass() error: %v", err)
+	}
+
+	var payload struct {
+		Version     string `json:"version"`
+		ProductData []struct {
+			MetricCategories []struct {
+				Metrics []struct {
+					Datasets []struct {
+						FilterCriteria struct {
+							Device string `json:"device"`
+						} `json:"filterCriteria"`
+					} `json:"datasets"`
+				} `json:"metrics"`
+			} `json:"metricCategories"`
+		} `json:"productData"`
+	}
+	if err := json.Unmarshal(filtered, &payload); err != nil {
+		t.Fatalf("decode filtered payload: %v", err)
+	}
+	if payload.Version != "1.0" {
+		t.Fatalf("expected other fields to be kept, got version %q", payload.Version)
+	}
+	datasets := payload.ProductData[0].MetricCategories[0].Metrics[0].Datasets
+	if len(datasets) != 2 || datasets[0].FilterCriteria.Device != "all_iphones" || datasets[1].FilterCriteria.Device != "iPhone15,2" {
+		t.Fatalf("unexpected datasets after filtering: %+v", datasets)
+	}
 }
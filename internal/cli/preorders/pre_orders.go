@@ -2,11 +2,10 @@ package preorders
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -25,14 +24,18 @@ func PreOrdersCommand() *ffcli.Command {
 
 Examples:
   asc pre-orders get --app "123456789"
+  asc pre-orders status --app "123456789"
   asc pre-orders list --availability "AVAILABILITY_ID"
   asc pre-orders enable --app "123456789" --territory "USA,GBR" --release-date "2026-02-01"
   asc pre-orders update --territory-availability "TERRITORY_AVAILABILITY_ID" --release-date "2026-03-01"
+  asc pre-orders update --app "123456789" --territory "USA,GBR" --release-date "2026-03-01"
   asc pre-orders disable --territory-availability "TERRITORY_AVAILABILITY_ID"
+  asc pre-orders disable --app "123456789" --territory "USA"
   asc pre-orders end --territory-availability "TA_1,TA_2"`,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			PreOrdersGetCommand(),
+			PreOrdersStatusCommand(),
 			PreOrdersListCommand(),
 			PreOrdersEnableCommand(),
 			PreOrdersUpdateCommand(),
@@ -217,40 +220,15 @@ Examples:
 				return fmt.Errorf("pre-orders enable: app availability ID missing from response")
 			}
 
-			firstPage, err := client.GetTerritoryAvailabilities(requestCtx, availabilityID, asc.WithTerritoryAvailabilitiesLimit(200))
+			territoryResp, err := shared.FetchTerritoryAvailabilities(requestCtx, client, availabilityID)
 			if err != nil {
 				return fmt.Errorf("pre-orders enable: %w", err)
 			}
-			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-				return client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesNextURL(nextURL))
-			})
-			if err != nil {
-				return fmt.Errorf("pre-orders enable: %w", err)
-			}
-			territoryResp, ok := paginated.(*asc.TerritoryAvailabilitiesResponse)
-			if !ok {
-				return fmt.Errorf("pre-orders enable: unexpected territory availabilities response")
-			}
-
-			territoryMap, err := mapTerritoryAvailabilityIDs(territoryResp)
+			territoryAvailabilityIDs, err := selectTerritoryAvailabilityIDs(territoryResp, territories)
 			if err != nil {
 				return fmt.Errorf("pre-orders enable: %w", err)
 			}
 
-			missingTerritories := make([]string, 0)
-			territoryAvailabilityIDs := make([]string, 0, len(territories))
-			for _, territoryID := range territories {
-				territoryAvailabilityID := territoryMap[territoryID]
-				if territoryAvailabilityID == "" {
-					missingTerritories = append(missingTerritories, territoryID)
-					continue
-				}
-				territoryAvailabilityIDs = append(territoryAvailabilityIDs, territoryAvailabilityID)
-			}
-			if len(missingTerritories) > 0 {
-				return fmt.Errorf("pre-orders enable: territory availability not found for territories: %s", strings.Join(missingTerritories, ", "))
-			}
-
 			preOrderEnabled := true
 			available := true
 			updated := make([]asc.Resource[asc.TerritoryAvailabilityAttributes], 0, len(territoryAvailabilityIDs))
@@ -276,25 +254,28 @@ func PreOrdersUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-orders update", flag.ExitOnError)
 
 	territoryAvailabilityID := fs.String("territory-availability", "", "Territory availability ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID, used with --territory)")
+	territory := fs.String("territory", "", "Territory IDs (comma-separated, e.g., USA,GBR; used with --app)")
 	releaseDate := fs.String("release-date", "", "Release date (YYYY-MM-DD)")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "update",
-		ShortUsage: "asc pre-orders update --territory-availability TERRITORY_AVAILABILITY_ID [flags]",
-		ShortHelp:  "Update pre-order release date for a territory availability.",
-		LongHelp: `Update pre-order release date for a territory availability.
+		ShortUsage: "asc pre-orders update (--territory-availability ID | --app APP_ID --territory TERRITORIES) --release-date DATE",
+		ShortHelp:  "Update pre-order release date for territory availabilities.",
+		LongHelp: `Update pre-order release date for territory availabilities.
 
 Examples:
-  asc pre-orders update --territory-availability "TERRITORY_AVAILABILITY_ID" --release-date "2026-03-01"`,
+  asc pre-orders update --territory-availability "TERRITORY_AVAILABILITY_ID" --release-date "2026-03-01"
+  asc pre-orders update --app "123456789" --territory "USA,GBR" --release-date "2026-03-01"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*territoryAvailabilityID)
-			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --territory-availability is required")
-				return flag.ErrHelp
+			territories := shared.SplitCSVUpper(*territory)
+			if err := validatePreOrderTarget("update", trimmedID, territories); err != nil {
+				return err
 			}
 			if strings.TrimSpace(*releaseDate) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --release-date is required")
@@ -314,13 +295,21 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resp, err := client.UpdateTerritoryAvailability(requestCtx, trimmedID, asc.TerritoryAvailabilityUpdateAttributes{
+			attrs := asc.TerritoryAvailabilityUpdateAttributes{
 				ReleaseDate: &normalizedReleaseDate,
-			})
+			}
+			if trimmedID != "" {
+				resp, err := client.UpdateTerritoryAvailability(requestCtx, trimmedID, attrs)
+				if err != nil {
+					return fmt.Errorf("pre-orders update: %w", err)
+				}
+				return shared.PrintOutput(resp, *output, *pretty)
+			}
+
+			resp, err := updateAppTerritoryAvailabilities(requestCtx, client, shared.ResolveAppID(*appID), territories, attrs)
 			if err != nil {
 				return fmt.Errorf("pre-orders update: %w", err)
 			}
-
 			return shared.PrintOutput(resp, *output, *pretty)
 		},
 	}
@@ -331,24 +320,27 @@ func PreOrdersDisableCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-orders disable", flag.ExitOnError)
 
 	territoryAvailabilityID := fs.String("territory-availability", "", "Territory availability ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID, used with --territory)")
+	territory := fs.String("territory", "", "Territory IDs (comma-separated, e.g., USA,GBR; used with --app)")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "disable",
-		ShortUsage: "asc pre-orders disable --territory-availability TERRITORY_AVAILABILITY_ID",
-		ShortHelp:  "Disable pre-orders for a territory availability.",
-		LongHelp: `Disable pre-orders for a territory availability.
+		ShortUsage: "asc pre-orders disable (--territory-availability ID | --app APP_ID --territory TERRITORIES)",
+		ShortHelp:  "Disable pre-orders for territory availabilities.",
+		LongHelp: `Disable pre-orders for territory availabilities.
 
 Examples:
-  asc pre-orders disable --territory-availability "TERRITORY_AVAILABILITY_ID"`,
+  asc pre-orders disable --territory-availability "TERRITORY_AVAILABILITY_ID"
+  asc pre-orders disable --app "123456789" --territory "USA,GBR"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			trimmedID := strings.TrimSpace(*territoryAvailabilityID)
-			if trimmedID == "" {
-				fmt.Fprintln(os.Stderr, "Error: --territory-availability is required")
-				return flag.ErrHelp
+			territories := shared.SplitCSVUpper(*territory)
+			if err := validatePreOrderTarget("disable", trimmedID, territories); err != nil {
+				return err
 			}
 
 			preOrderEnabled := false
@@ -360,13 +352,21 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resp, err := client.UpdateTerritoryAvailability(requestCtx, trimmedID, asc.TerritoryAvailabilityUpdateAttributes{
+			attrs := asc.TerritoryAvailabilityUpdateAttributes{
 				PreOrderEnabled: &preOrderEnabled,
-			})
+			}
+			if trimmedID != "" {
+				resp, err := client.UpdateTerritoryAvailability(requestCtx, trimmedID, attrs)
+				if err != nil {
+					return fmt.Errorf("pre-orders disable: %w", err)
+				}
+				return shared.PrintOutput(resp, *output, *pretty)
+			}
+
+			resp, err := updateAppTerritoryAvailabilities(requestCtx, client, shared.ResolveAppID(*appID), territories, attrs)
 			if err != nil {
 				return fmt.Errorf("pre-orders disable: %w", err)
 			}
-
 			return shared.PrintOutput(resp, *output, *pretty)
 		},
 	}
@@ -415,65 +415,198 @@ Examples:
 	}
 }
 
-func normalizePreOrderReleaseDate(value string) (string, error) {
-	return shared.NormalizeDate(value, "--release-date")
-}
+// PreOrdersStatusCommand returns the status subcommand.
+func PreOrdersStatusCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pre-orders status", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	territory := fs.String("territory", "", "Filter to territory IDs (comma-separated, e.g., USA,GBR)")
+	preOrderOnly := fs.Bool("pre-order-only", false, "Only show territories with pre-orders enabled")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "status",
+		ShortUsage: "asc pre-orders status --app APP_ID [flags]",
+		ShortHelp:  "Show pre-order state per territory.",
+		LongHelp: `Show pre-order state per territory.
+
+Lists every territory with its availability, pre-order flag, release date,
+pre-order publish date, and content statuses.
+
+Examples:
+  asc pre-orders status --app "123456789"
+  asc pre-orders status --app "123456789" --pre-order-only --output table
+  asc pre-orders status --app "123456789" --territory "USA,GBR"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("pre-orders status: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			availabilityID, err := fetchAppAvailabilityID(requestCtx, client, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("pre-orders status: %w", err)
+			}
+			territoryResp, err := shared.FetchTerritoryAvailabilities(requestCtx, client, availabilityID)
+			if err != nil {
+				return fmt.Errorf("pre-orders status: %w", err)
+			}
+
+			result, err := buildPreOrderStatus(resolvedAppID, availabilityID, territoryResp, shared.SplitCSVUpper(*territory), *preOrderOnly)
+			if err != nil {
+				return fmt.Errorf("pre-orders status: %w", err)
+			}
 
-type territoryAvailabilityIDPayload struct {
-	Territory string `json:"t"`
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
 }
 
-func mapTerritoryAvailabilityIDs(resp *asc.TerritoryAvailabilitiesResponse) (map[string]string, error) {
-	if resp == nil {
-		return nil, fmt.Errorf("territory availabilities response is nil")
+func buildPreOrderStatus(appID, availabilityID string, resp *asc.TerritoryAvailabilitiesResponse, territories []string, preOrderOnly bool) (*asc.PreOrderStatusResult, error) {
+	filter := make(map[string]bool, len(territories))
+	for _, territory := range territories {
+		filter[territory] = true
+	}
+
+	result := &asc.PreOrderStatusResult{
+		AppID:          appID,
+		AvailabilityID: availabilityID,
+		Territories:    make([]asc.PreOrderTerritoryStatus, 0, len(resp.Data)),
 	}
-	ids := make(map[string]string, len(resp.Data))
 	for _, item := range resp.Data {
-		territoryID := ""
-		if len(item.Relationships) > 0 {
-			var relationships asc.TerritoryAvailabilityRelationships
-			if err := json.Unmarshal(item.Relationships, &relationships); err != nil {
-				return nil, fmt.Errorf("decode territory availability relationships for %q: %w", item.ID, err)
-			}
-			territoryID = strings.ToUpper(strings.TrimSpace(relationships.Territory.Data.ID))
+		territoryID, err := shared.TerritoryIDForAvailability(item)
+		if err != nil {
+			return nil, err
 		}
-		if territoryID == "" {
-			var ok bool
-			territoryID, ok = territoryIDFromAvailabilityID(item.ID)
-			if !ok {
-				return nil, fmt.Errorf("territory availability %q missing territory id", item.ID)
-			}
+		if len(filter) > 0 && !filter[territoryID] {
+			continue
 		}
-		ids[territoryID] = item.ID
+		if preOrderOnly && !item.Attributes.PreOrderEnabled {
+			continue
+		}
+		if item.Attributes.PreOrderEnabled {
+			result.PreOrderCount++
+		}
+		result.Territories = append(result.Territories, asc.PreOrderTerritoryStatus{
+			Territory:               territoryID,
+			TerritoryAvailabilityID: item.ID,
+			Available:               item.Attributes.Available,
+			PreOrderEnabled:         item.Attributes.PreOrderEnabled,
+			ReleaseDate:             item.Attributes.ReleaseDate,
+			PreOrderPublishDate:     item.Attributes.PreOrderPublishDate,
+			ContentStatuses:         item.Attributes.ContentStatuses,
+		})
 	}
-	return ids, nil
+	sort.Slice(result.Territories, func(i, j int) bool {
+		return result.Territories[i].Territory < result.Territories[j].Territory
+	})
+	return result, nil
+}
+
+// validatePreOrderTarget checks that exactly one of --territory-availability or --territory is provided.
+func validatePreOrderTarget(command, territoryAvailabilityID string, territories []string) error {
+	switch {
+	case territoryAvailabilityID != "" && len(territories) > 0:
+		return fmt.Errorf("pre-orders %s: --territory-availability and --territory are mutually exclusive", command)
+	case territoryAvailabilityID == "" && len(territories) == 0:
+		fmt.Fprintln(os.Stderr, "Error: --territory-availability or --territory is required")
+		return flag.ErrHelp
+	}
+	return nil
+}
+
+func fetchAppAvailabilityID(ctx context.Context, client *asc.Client, appID string) (string, error) {
+	if appID == "" {
+		return "", fmt.Errorf("--app is required with --territory (or set ASC_APP_ID)")
+	}
+	resp, err := client.GetAppAvailabilityV2(ctx, appID)
+	if err != nil {
+		if shared.IsAppAvailabilityMissing(err) {
+			return "", fmt.Errorf("app availability not found for app %q", appID)
+		}
+		return "", err
+	}
+	availabilityID := strings.TrimSpace(resp.Data.ID)
+	if availabilityID == "" {
+		return "", fmt.Errorf("app availability ID missing from response")
+	}
+	return availabilityID, nil
+}
+
+func selectTerritoryAvailabilityIDs(resp *asc.TerritoryAvailabilitiesResponse, territories []string) ([]string, error) {
+	territoryMap, err := mapTerritoryAvailabilityIDs(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	missingTerritories := make([]string, 0)
+	territoryAvailabilityIDs := make([]string, 0, len(territories))
+	for _, territoryID := range territories {
+		territoryAvailabilityID := territoryMap[territoryID]
+		if territoryAvailabilityID == "" {
+			missingTerritories = append(missingTerritories, territoryID)
+			continue
+		}
+		territoryAvailabilityIDs = append(territoryAvailabilityIDs, territoryAvailabilityID)
+	}
+	if len(missingTerritories) > 0 {
+		return nil, fmt.Errorf("territory availability not found for territories: %s", strings.Join(missingTerritories, ", "))
+	}
+	return territoryAvailabilityIDs, nil
 }
 
-func territoryIDFromAvailabilityID(availabilityID string) (string, bool) {
-	trimmed := strings.TrimSpace(availabilityID)
-	if trimmed == "" {
-		return "", false
+func updateAppTerritoryAvailabilities(ctx context.Context, client *asc.Client, appID string, territories []string, attrs asc.TerritoryAvailabilityUpdateAttributes) (*asc.TerritoryAvailabilitiesResponse, error) {
+	availabilityID, err := fetchAppAvailabilityID(ctx, client, appID)
+	if err != nil {
+		return nil, err
 	}
-	decoded, err := base64.RawStdEncoding.DecodeString(trimmed)
+	territoryResp, err := shared.FetchTerritoryAvailabilities(ctx, client, availabilityID)
 	if err != nil {
-		decoded, err = base64.StdEncoding.DecodeString(trimmed)
+		return nil, err
+	}
+	ids, err := selectTerritoryAvailabilityIDs(territoryResp, territories)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := make([]asc.Resource[asc.TerritoryAvailabilityAttributes], 0, len(ids))
+	for _, id := range ids {
+		resp, err := client.UpdateTerritoryAvailability(ctx, id, attrs)
 		if err != nil {
-			decoded, err = base64.RawURLEncoding.DecodeString(trimmed)
-			if err != nil {
-				decoded, err = base64.URLEncoding.DecodeString(trimmed)
-				if err != nil {
-					return "", false
-				}
-			}
+			return nil, err
 		}
+		updated = append(updated, resp.Data)
 	}
-	var payload territoryAvailabilityIDPayload
-	if err := json.Unmarshal(decoded, &payload); err != nil {
-		return "", false
+	return &asc.TerritoryAvailabilitiesResponse{Data: updated}, nil
+}
+
+func normalizePreOrderReleaseDate(value string) (string, error) {
+	return shared.NormalizeDate(value, "--release-date")
+}
+
+func mapTerritoryAvailabilityIDs(resp *asc.TerritoryAvailabilitiesResponse) (map[string]string, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("territory availabilities response is nil")
 	}
-	territoryID := strings.TrimSpace(payload.Territory)
-	if territoryID == "" {
-		return "", false
+	ids := make(map[string]string, len(resp.Data))
+	for _, item := range resp.Data {
+		territoryID, err := shared.TerritoryIDForAvailability(item)
+		if err != nil {
+			return nil, err
+		}
+		ids[territoryID] = item.ID
 	}
-	return strings.ToUpper(territoryID), true
+	return ids, nil
 }
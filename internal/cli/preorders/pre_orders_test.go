@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"flag"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -135,6 +136,45 @@ func TestPreOrdersDisableCommand_MissingID(t *testing.T) {
 	}
 }
 
+func TestPreOrdersStatusCommand_MissingApp(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "config.json"))
+
+	cmd := PreOrdersStatusCommand()
+	if err := cmd.FlagSet.Parse([]string{}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+		t.Fatalf("expected flag.ErrHelp when --app is missing, got %v", err)
+	}
+}
+
+func TestPreOrdersTerritoryTargets_MutuallyExclusive(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  func() *ffcli.Command
+		args []string
+	}{
+		{name: "update", cmd: PreOrdersUpdateCommand, args: []string{"--territory-availability", "ta-1", "--app", "APP", "--territory", "USA", "--release-date", "2026-02-01"}},
+		{name: "disable", cmd: PreOrdersDisableCommand, args: []string{"--territory-availability", "ta-1", "--app", "APP", "--territory", "USA"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := test.cmd()
+			if err := cmd.FlagSet.Parse(test.args); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+
+			err := cmd.Exec(context.Background(), []string{})
+			if err == nil || err == flag.ErrHelp || !strings.Contains(err.Error(), "mutually exclusive") {
+				t.Fatalf("expected mutually exclusive error, got %v", err)
+			}
+		})
+	}
+}
+
 func TestPreOrdersEndCommand_MissingIDs(t *testing.T) {
 	cmd := PreOrdersEndCommand()
 
@@ -197,6 +237,7 @@ func TestPreOrdersCommand_DefaultOutputJSON(t *testing.T) {
 		cmd  func() *ffcli.Command
 	}{
 		{"get", PreOrdersGetCommand},
+		{"status", PreOrdersStatusCommand},
 		{"list", PreOrdersListCommand},
 		{"enable", PreOrdersEnableCommand},
 		{"update", PreOrdersUpdateCommand},
@@ -272,3 +313,48 @@ func TestMapTerritoryAvailabilityIDs_FallbackID(t *testing.T) {
 		t.Fatalf("expected territory USA to map to %q, got %q", encoded, ids["USA"])
 	}
 }
+
+func TestBuildPreOrderStatus(t *testing.T) {
+	usa := base64.RawStdEncoding.EncodeToString([]byte(`{"s":"APP","t":"USA"}`))
+	gbr := base64.RawStdEncoding.EncodeToString([]byte(`{"s":"APP","t":"GBR"}`))
+	deu := base64.RawStdEncoding.EncodeToString([]byte(`{"s":"APP","t":"DEU"}`))
+
+	resp := &asc.TerritoryAvailabilitiesResponse{
+		Data: []asc.Resource[asc.TerritoryAvailabilityAttributes]{
+			{ID: usa, Attributes: asc.TerritoryAvailabilityAttributes{Available: true, PreOrderEnabled: true, ReleaseDate: "2026-03-01"}},
+			{ID: gbr, Attributes: asc.TerritoryAvailabilityAttributes{Available: true}},
+			{ID: deu, Attributes: asc.TerritoryAvailabilityAttributes{Available: true, PreOrderEnabled: true, ContentStatuses: []string{"AVAILABLE"}}},
+		},
+	}
+
+	result, err := buildPreOrderStatus("APP", "AVAIL", resp, nil, false)
+	if err != nil {
+		t.Fatalf("buildPreOrderStatus() error: %v", err)
+	}
+	if result.PreOrderCount != 2 {
+		t.Fatalf("expected 2 pre-order territories, got %d", result.PreOrderCount)
+	}
+	if len(result.Territories) != 3 || result.Territories[0].Territory != "DEU" || result.Territories[2].Territory != "USA" {
+		t.Fatalf("expected territories sorted by ID, got %+v", result.Territories)
+	}
+
+	filtered, err := buildPreOrderStatus("APP", "AVAIL", resp, []string{"USA", "GBR"}, true)
+	if err != nil {
+		t.Fatalf("buildPreOrderStatus() error: %v", err)
+	}
+	if len(filtered.Territories) != 1 || filtered.Territories[0].Territory != "USA" {
+		t.Fatalf("expected only USA, got %+v", filtered.Territories)
+	}
+}
+
+func TestSelectTerritoryAvailabilityIDs_Missing(t *testing.T) {
+	usa := base64.RawStdEncoding.EncodeToString([]byte(`{"s":"APP","t":"USA"}`))
+	resp := &asc.TerritoryAvailabilitiesResponse{
+		Data: []asc.Resource[asc.TerritoryAvailabilityAttributes]{{ID: usa}},
+	}
+
+	_, err := selectTerritoryAvailabilityIDs(resp, []string{"USA", "JPN"})
+	if err == nil || !strings.Contains(err.Error(), "JPN") {
+		t.Fatalf("expected missing JPN error, got %v", err)
+	}
+}
@@ -0,0 +1,210 @@
+package pricing
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// PricingPricePointsFindCommand returns the price points find subcommand.
+func PricingPricePointsFindCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pricing price-points find", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	baseTerritory := fs.String("base-territory", "USA", "Territory the price is given in")
+	price := fs.String("price", "", "Customer price to look up (e.g., 4.99)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "find",
+		ShortUsage: "asc pricing price-points find --app \"APP_ID\" --price 4.99 [--base-territory \"USA\"]",
+		ShortHelp:  "Resolve a customer price to its price point in every territory.",
+		LongHelp: `Resolve a customer price to its price point in every territory.
+
+Finds the price point of the base territory whose customer price equals
+--price, then lists the equalized customer price, proceeds, and price point
+ID of every territory.
+
+Examples:
+  asc pricing price-points find --app "123456789" --price 4.99
+  asc pricing price-points find --app "123456789" --price 4.49 --base-territory "GBR" --output table
+  asc pricing price-points find --app "123456789" --price 4.99 --output csv > prices.csv`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			priceValue := strings.TrimSpace(*price)
+			if priceValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --price is required")
+				return flag.ErrHelp
+			}
+			if _, ok := new(big.Rat).SetString(priceValue); !ok {
+				fmt.Fprintf(os.Stderr, "Error: --price must be a number, got %q\n", priceValue)
+				return flag.ErrHelp
+			}
+			territoryValue := strings.ToUpper(strings.TrimSpace(*baseTerritory))
+			if territoryValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --base-territory is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("pricing price-points find: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			result, err := findAppPricePoint(requestCtx, client, resolvedAppID, territoryValue, priceValue)
+			if err != nil {
+				return fmt.Errorf("pricing price-points find: %w", err)
+			}
+
+			return shared.PrintOutputWithCSV(result, *output, *pretty)
+		},
+	}
+}
+
+func findAppPricePoint(ctx context.Context, client *asc.Client, appID, territoryID, price string) (*asc.PricePointFindResult, error) {
+	firstPage, err := client.GetAppPricePoints(ctx, appID, asc.WithPricePointsTerritory(territoryID), asc.WithPricePointsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch price points: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppPricePoints(ctx, appID, asc.WithPricePointsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate price points: %w", err)
+	}
+	points, ok := paginated.(*asc.AppPricePointsV3Response)
+	if !ok {
+		return nil, fmt.Errorf("unexpected price points response type %T", paginated)
+	}
+
+	base, ok := matchAppPricePoint(points.Data, price)
+	if !ok {
+		return nil, fmt.Errorf("no %s price point with customer price %s", territoryID, price)
+	}
+
+	firstEqualizations, err := client.GetAppPricePointEqualizations(ctx, base.ID, asc.WithPricePointsInclude([]string{"territory"}), asc.WithPricePointsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch equalizations: %w", err)
+	}
+	paginated, err = asc.PaginateAll(ctx, firstEqualizations, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppPricePointEqualizations(ctx, base.ID, asc.WithPricePointsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate equalizations: %w", err)
+	}
+	equalizations, ok := paginated.(*asc.AppPricePointsV3Response)
+	if !ok {
+		return nil, fmt.Errorf("unexpected equalizations response type %T", paginated)
+	}
+
+	currencies, err := fetchTerritoryCurrencies(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &asc.PricePointFindResult{
+		AppID:         appID,
+		BaseTerritory: territoryID,
+		Price:         price,
+		PricePointID:  base.ID,
+		Territories: []asc.PricePointTerritoryPrice{{
+			Territory:     territoryID,
+			Currency:      currencies[territoryID],
+			CustomerPrice: base.Attributes.CustomerPrice,
+			Proceeds:      base.Attributes.Proceeds,
+			PricePointID:  base.ID,
+		}},
+	}
+	others := make([]asc.PricePointTerritoryPrice, 0, len(equalizations.Data))
+	for _, point := range equalizations.Data {
+		territory := pricePointTerritoryID(point.Relationships)
+		if territory == territoryID {
+			continue
+		}
+		others = append(others, asc.PricePointTerritoryPrice{
+			Territory:     territory,
+			Currency:      currencies[territory],
+			CustomerPrice: point.Attributes.CustomerPrice,
+			Proceeds:      point.Attributes.Proceeds,
+			PricePointID:  point.ID,
+		})
+	}
+	sort.SliceStable(others, func(i, j int) bool {
+		return others[i].Territory < others[j].Territory
+	})
+	result.Territories = append(result.Territories, others...)
+	return result, nil
+}
+
+// matchAppPricePoint returns the price point whose customer price equals
+// price numerically, so "5", "5.0" and "5.00" all match.
+func matchAppPricePoint(points []asc.Resource[asc.AppPricePointV3Attributes], price string) (asc.Resource[asc.AppPricePointV3Attributes], bool) {
+	for _, point := range points {
+		if shared.SameCustomerPrice(point.Attributes.CustomerPrice, price) {
+			return point, true
+		}
+	}
+	return asc.Resource[asc.AppPricePointV3Attributes]{}, false
+}
+
+// pricePointTerritoryID reads the territory ID from price point relationships,
+// which App Store Connect only fills in when the territory is included.
+func pricePointTerritoryID(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var relationships struct {
+		Territory struct {
+			Data struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		} `json:"territory"`
+	}
+	if err := json.Unmarshal(raw, &relationships); err != nil {
+		return ""
+	}
+	return relationships.Territory.Data.ID
+}
+
+func fetchTerritoryCurrencies(ctx context.Context, client *asc.Client) (map[string]string, error) {
+	firstPage, err := client.GetTerritories(ctx, asc.WithTerritoriesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch territories: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetTerritories(ctx, asc.WithTerritoriesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate territories: %w", err)
+	}
+	territories, ok := paginated.(*asc.TerritoriesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected territories response type %T", paginated)
+	}
+
+	currencies := make(map[string]string, len(territories.Data))
+	for _, territory := range territories.Data {
+		currencies[territory.ID] = territory.Attributes.Currency
+	}
+	return currencies, nil
+}
@@ -35,6 +35,7 @@ Examples:
   asc pricing availability get --app "123456789"
   asc pricing availability get --id "AVAILABILITY_ID"
   asc pricing availability set --app "123456789" --territory "USA,GBR,DEU" --available true
+  asc pricing availability set --app "123456789" --all --except "CHN,RUS" --available true
   asc pricing availability territory-availabilities --availability "AVAILABILITY_ID"`,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -161,12 +162,14 @@ Examples:
   asc pricing price-points --app "123456789" --territory "USA"
   asc pricing price-points --app "123456789" --paginate
   asc pricing price-points get --price-point "PRICE_POINT_ID"
-  asc pricing price-points equalizations --price-point "PRICE_POINT_ID"`,
+  asc pricing price-points equalizations --price-point "PRICE_POINT_ID"
+  asc pricing price-points find --app "123456789" --price 4.99 --output table`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			PricingPricePointsGetCommand(),
 			PricingPricePointsEqualizationsCommand(),
+			PricingPricePointsFindCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
@@ -322,13 +325,15 @@ Examples:
   asc pricing schedule get --id "SCHEDULE_ID"
   asc pricing schedule create --app "123456789" --price-point "PRICE_POINT_ID" --start-date "2024-03-01"
   asc pricing schedule manual-prices --schedule "SCHEDULE_ID"
-  asc pricing schedule automatic-prices --schedule "SCHEDULE_ID"`,
+  asc pricing schedule automatic-prices --schedule "SCHEDULE_ID"
+  asc pricing schedule prices --app "123456789"`,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			PricingScheduleGetCommand(),
 			PricingScheduleCreateCommand(),
 			PricingScheduleManualPricesCommand(),
 			PricingScheduleAutomaticPricesCommand(),
+			PricingSchedulePricesCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -403,11 +408,17 @@ func PricingScheduleCreateCommand() *ffcli.Command {
 		ShortHelp:   "Create an app price schedule.",
 		LongHelp: `Create an app price schedule.
 
+The new schedule replaces the current one. With --keep-current, the price in
+effect today stays until --start-date, so the new price is scheduled as a
+future change.
+
 Examples:
-  asc pricing schedule create --app "123456789" --price-point "PRICE_POINT_ID" --base-territory "USA" --start-date "2024-03-01"`,
+  asc pricing schedule create --app "123456789" --price-point "PRICE_POINT_ID" --base-territory "USA" --start-date "2024-03-01"
+  asc pricing schedule create --app "123456789" --price-point "PRICE_POINT_ID" --base-territory "USA" --start-date "2024-09-01" --keep-current`,
 		ErrorPrefix:          "pricing schedule create",
 		StartDateHelp:        "Start date (YYYY-MM-DD)",
 		RequireBaseTerritory: true,
+		AllowKeepCurrent:     true,
 	})
 }
 
@@ -635,8 +646,13 @@ func PricingAvailabilitySetCommand() *ffcli.Command {
 		ShortHelp:   "Set app availability for territories.",
 		LongHelp: `Set app availability for territories.
 
+Use --all to apply the value to every App Store territory. Territories listed
+in --except receive the opposite value.
+
 Examples:
-  asc pricing availability set --app "123456789" --territory "USA,GBR,DEU" --available true`,
+  asc pricing availability set --app "123456789" --territory "USA,GBR,DEU" --available true
+  asc pricing availability set --app "123456789" --all --available true --available-in-new-territories true
+  asc pricing availability set --app "123456789" --all --except "CHN,RUS" --available true`,
 		ErrorPrefix: "pricing availability set",
 	})
 }
@@ -2,10 +2,13 @@ package pricing
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"testing"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
 func TestPricingPricePointsCommand_MissingApp(t *testing.T) {
@@ -231,3 +234,29 @@ func TestPricingCommands_DefaultOutputJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduledAppPrices(t *testing.T) {
+	resp := &asc.AppPricesResponse{
+		Data: []asc.Resource[asc.AppPriceAttributes]{
+			{ID: "ended", Attributes: asc.AppPriceAttributes{EndDate: "2026-01-01"}, Relationships: json.RawMessage(`{"appPricePoint":{"data":{"id":"pp-old"}},"territory":{"data":{"id":"USA"}}}`)},
+			{ID: "now", Attributes: asc.AppPriceAttributes{StartDate: "2026-01-01", EndDate: "2026-09-01"}, Relationships: json.RawMessage(`{"appPricePoint":{"data":{"id":"pp-now"}},"territory":{"data":{"id":"USA"}}}`)},
+			{ID: "next", Attributes: asc.AppPriceAttributes{StartDate: "2026-09-01"}, Relationships: json.RawMessage(`{"appPricePoint":{"data":{"id":"pp-next"}},"territory":{"data":{"id":"USA"}}}`)},
+			{ID: "other", Relationships: json.RawMessage(`{"appPricePoint":{"data":{"id":"pp-gbr"}},"territory":{"data":{"id":"GBR"}}}`)},
+		},
+		Included: json.RawMessage(`[
+			{"type":"appPricePoints","id":"pp-now","attributes":{"customerPrice":"4.99","proceeds":"4.24"}},
+			{"type":"territories","id":"USA","attributes":{"currency":"USD"}}
+		]`),
+	}
+
+	prices := scheduledAppPrices(resp, "2026-06-01", []string{"USA"}, true)
+	if len(prices) != 2 {
+		t.Fatalf("expected 2 prices, got %+v", prices)
+	}
+	if prices[0].Status != appPriceStatusCurrent || prices[0].CustomerPrice != "4.99" || prices[0].Currency != "USD" || !prices[0].Manual {
+		t.Fatalf("unexpected current price: %+v", prices[0])
+	}
+	if prices[1].Status != appPriceStatusUpcoming || prices[1].PricePointID != "pp-next" {
+		t.Fatalf("unexpected upcoming price: %+v", prices[1])
+	}
+}
@@ -0,0 +1,201 @@
+package pricing
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Scheduled app price statuses.
+const (
+	appPriceStatusCurrent  = "current"
+	appPriceStatusUpcoming = "upcoming"
+)
+
+// PricingSchedulePricesCommand returns the schedule prices subcommand.
+func PricingSchedulePricesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pricing schedule prices", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	territories := fs.String("territory", "", "Only show these territories, comma-separated (e.g., USA,GBR)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "prices",
+		ShortUsage: "asc pricing schedule prices --app \"APP_ID\" [--territory \"USA,GBR\"]",
+		ShortHelp:  "Show the current and upcoming app prices per territory.",
+		LongHelp: `Show the current and upcoming app prices per territory.
+
+Lists the manual prices of the base territory and the automatic prices App
+Store Connect derives for other territories, with their customer price and
+proceeds. Prices that have already ended are left out.
+
+Examples:
+  asc pricing schedule prices --app "123456789"
+  asc pricing schedule prices --app "123456789" --territory "USA,GBR,JPN" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			territoryFilter := make([]string, 0)
+			for _, territory := range shared.SplitCSV(*territories) {
+				territoryFilter = append(territoryFilter, strings.ToUpper(territory))
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("pricing schedule prices: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			schedule, err := client.GetAppPriceSchedule(requestCtx, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("pricing schedule prices: %w", err)
+			}
+
+			result := &asc.AppSchedulePricesResult{
+				AppID:      resolvedAppID,
+				ScheduleID: schedule.Data.ID,
+				Date:       time.Now().UTC().Format("2006-01-02"),
+			}
+
+			manual, err := client.GetAppPriceScheduleManualPrices(requestCtx, schedule.Data.ID)
+			if err != nil {
+				return fmt.Errorf("pricing schedule prices: failed to fetch manual prices: %w", err)
+			}
+			result.Prices = append(result.Prices, scheduledAppPrices(manual, result.Date, territoryFilter, true)...)
+
+			opts := []asc.AppPricesOption{asc.WithAppPricesLimit(200), asc.WithAppPricesTerritories(territoryFilter)}
+			seenNext := map[string]bool{}
+			for {
+				page, err := client.GetAppPriceScheduleAutomaticPrices(requestCtx, schedule.Data.ID, opts...)
+				if err != nil {
+					return fmt.Errorf("pricing schedule prices: failed to fetch automatic prices: %w", err)
+				}
+				result.Prices = append(result.Prices, scheduledAppPrices(page, result.Date, territoryFilter, false)...)
+
+				next := strings.TrimSpace(page.Links.Next)
+				if next == "" || seenNext[next] {
+					break
+				}
+				seenNext[next] = true
+				opts = []asc.AppPricesOption{asc.WithAppPricesNextURL(next)}
+			}
+
+			sort.SliceStable(result.Prices, func(i, j int) bool {
+				if result.Prices[i].Territory != result.Prices[j].Territory {
+					return result.Prices[i].Territory < result.Prices[j].Territory
+				}
+				return result.Prices[i].StartDate < result.Prices[j].StartDate
+			})
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// scheduledAppPrices converts app prices with included price points and
+// territories to the prices that are in effect on today or start later.
+func scheduledAppPrices(resp *asc.AppPricesResponse, today string, territories []string, manual bool) []asc.AppScheduledPrice {
+	points, currencies := appPricesIncluded(resp.Included)
+
+	prices := make([]asc.AppScheduledPrice, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		start := item.Attributes.StartDate
+		end := item.Attributes.EndDate
+		if end != "" && end <= today {
+			continue
+		}
+
+		var relationships asc.AppPriceRelationships
+		if len(item.Relationships) > 0 {
+			_ = json.Unmarshal(item.Relationships, &relationships)
+		}
+		territory := ""
+		if relationships.Territory != nil {
+			territory = strings.ToUpper(relationships.Territory.Data.ID)
+		}
+		if len(territories) > 0 && !containsTerritory(territories, territory) {
+			continue
+		}
+
+		status := appPriceStatusCurrent
+		if start != "" && start > today {
+			status = appPriceStatusUpcoming
+		}
+		pricePointID := relationships.AppPricePoint.Data.ID
+		point := points[pricePointID]
+		prices = append(prices, asc.AppScheduledPrice{
+			Territory:     territory,
+			Currency:      currencies[territory],
+			Status:        status,
+			StartDate:     start,
+			EndDate:       end,
+			Manual:        manual || item.Attributes.Manual,
+			CustomerPrice: point.CustomerPrice,
+			Proceeds:      point.Proceeds,
+			PricePointID:  pricePointID,
+		})
+	}
+	return prices
+}
+
+// appPricesIncluded indexes the price points and territory currencies
+// included with an app prices response.
+func appPricesIncluded(raw json.RawMessage) (map[string]asc.AppPricePointV3Attributes, map[string]string) {
+	points := map[string]asc.AppPricePointV3Attributes{}
+	currencies := map[string]string{}
+	if len(raw) == 0 {
+		return points, currencies
+	}
+
+	var included []struct {
+		Type       asc.ResourceType `json:"type"`
+		ID         string           `json:"id"`
+		Attributes json.RawMessage  `json:"attributes"`
+	}
+	if err := json.Unmarshal(raw, &included); err != nil {
+		return points, currencies
+	}
+	for _, item := range included {
+		switch item.Type {
+		case asc.ResourceTypeAppPricePoints:
+			var attrs asc.AppPricePointV3Attributes
+			if json.Unmarshal(item.Attributes, &attrs) == nil {
+				points[item.ID] = attrs
+			}
+		case asc.ResourceTypeTerritories:
+			var attrs asc.TerritoryAttributes
+			if json.Unmarshal(item.Attributes, &attrs) == nil {
+				currencies[strings.ToUpper(item.ID)] = attrs.Currency
+			}
+		}
+	}
+	return points, currencies
+}
+
+func containsTerritory(territories []string, territory string) bool {
+	for _, item := range territories {
+		if item == territory {
+			return true
+		}
+	}
+	return false
+}
@@ -6,7 +6,9 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -32,6 +34,7 @@ Examples:
   asc profiles create --name "Profile" --profile-type IOS_APP_DEVELOPMENT --bundle "BUNDLE_ID" --certificate "CERT_ID"
   asc profiles delete --id "PROFILE_ID" --confirm
   asc profiles download --id "PROFILE_ID" --output "./profile.mobileprovision"
+  asc profiles repair --dry-run
   asc profiles relationships bundle-id --id "PROFILE_ID"
   asc profiles relationships certificates --id "PROFILE_ID"
   asc profiles relationships devices --id "PROFILE_ID"`,
@@ -44,6 +47,7 @@ Examples:
 			ProfilesCreateCommand(),
 			ProfilesDeleteCommand(),
 			ProfilesDownloadCommand(),
+			ProfilesRepairCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -56,6 +60,9 @@ func ProfilesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	profileType := fs.String("profile-type", "", "Filter by profile type(s), comma-separated")
+	bundleID := fs.String("bundle-id", "", "Filter by bundle identifier (e.g., com.example.app) or bundle ID resource ID")
+	expiringWithin := fs.String("expiring-within", "", "Only profiles expiring within this window (e.g., 30d, 2w, 72h); includes expired profiles")
+	include := fs.String("include", "", "Include related resources: "+strings.Join(profileIncludeList(), ", "))
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -68,10 +75,17 @@ func ProfilesListCommand() *ffcli.Command {
 		ShortHelp:  "List provisioning profiles.",
 		LongHelp: `List provisioning profiles.
 
+--include certificates,devices adds the certificates and devices in each
+profile to the response, and table/markdown output gains a column for each
+(up to 50 per profile). --bundle-id and --expiring-within are applied to every
+page, so they always fetch all pages.
+
 Examples:
   asc profiles list
   asc profiles list --profile-type IOS_APP_DEVELOPMENT
-  asc profiles list --paginate`,
+  asc profiles list --paginate
+  asc profiles list --bundle-id com.example.app --include certificates,devices --output table
+  asc profiles list --expiring-within 30d --output table`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -82,6 +96,28 @@ Examples:
 				return fmt.Errorf("profiles list: %w", err)
 			}
 
+			includeValues, err := normalizeProfileInclude(*include)
+			if err != nil {
+				return fmt.Errorf("profiles list: %w", err)
+			}
+
+			filter := profileListFilter{bundleID: strings.TrimSpace(*bundleID)}
+			if strings.TrimSpace(*expiringWithin) != "" {
+				window, err := shared.ParseExpiryWindow(*expiringWithin)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					return flag.ErrHelp
+				}
+				filter.expiresBefore = time.Now().UTC().Add(window)
+			}
+			if filter.active() && strings.TrimSpace(*next) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --next cannot be used with --bundle-id or --expiring-within")
+				return flag.ErrHelp
+			}
+			if filter.bundleID != "" && !slices.Contains(includeValues, "bundleId") {
+				includeValues = append(includeValues, "bundleId")
+			}
+
 			profileTypes := shared.SplitCSVUpper(*profileType)
 
 			client, err := shared.GetASCClient()
@@ -99,22 +135,27 @@ Examples:
 			if len(profileTypes) > 0 {
 				opts = append(opts, asc.WithProfilesTypes(profileTypes))
 			}
-
-			if *paginate {
-				paginateOpts := append(opts, asc.WithProfilesLimit(200))
-				firstPage, err := client.GetProfiles(requestCtx, paginateOpts...)
-				if err != nil {
-					return fmt.Errorf("profiles list: failed to fetch: %w", err)
+			if len(includeValues) > 0 {
+				opts = append(opts, asc.WithProfilesInclude(includeValues))
+				if slices.Contains(includeValues, "certificates") {
+					opts = append(opts, asc.WithProfilesCertificatesLimit(50))
+				}
+				if slices.Contains(includeValues, "devices") {
+					opts = append(opts, asc.WithProfilesDevicesLimit(50))
 				}
+			}
 
-				paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
-					return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
-				})
+			if *paginate || filter.active() {
+				paginateOpts := append(opts, asc.WithProfilesLimit(200))
+				profiles, err := fetchAllProfiles(requestCtx, client, paginateOpts...)
 				if err != nil {
 					return fmt.Errorf("profiles list: %w", err)
 				}
+				if filter.active() {
+					profiles.Data = filter.apply(profiles)
+				}
 
-				return shared.PrintOutput(paginated, *output, *pretty)
+				return shared.PrintOutput(profiles, *output, *pretty)
 			}
 
 			resp, err := client.GetProfiles(requestCtx, opts...)
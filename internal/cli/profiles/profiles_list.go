@@ -0,0 +1,149 @@
+package profiles
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// profileListFilter narrows profiles list results on the client, since the
+// API cannot filter profiles by bundle ID or expiration date.
+type profileListFilter struct {
+	bundleID      string
+	expiresBefore time.Time
+}
+
+func (f profileListFilter) active() bool {
+	return f.bundleID != "" || !f.expiresBefore.IsZero()
+}
+
+// apply returns the profiles that match the filter. Bundle IDs match either
+// the included bundle ID's identifier or its resource ID.
+func (f profileListFilter) apply(resp *asc.ProfilesResponse) []asc.Resource[asc.ProfileAttributes] {
+	identifiers := make(map[string]string)
+	if f.bundleID != "" && len(resp.Included) > 0 {
+		var included []struct {
+			Type       asc.ResourceType `json:"type"`
+			ID         string           `json:"id"`
+			Attributes struct {
+				Identifier string `json:"identifier"`
+			} `json:"attributes"`
+		}
+		if err := json.Unmarshal(resp.Included, &included); err == nil {
+			for _, item := range included {
+				if item.Type == asc.ResourceTypeBundleIds {
+					identifiers[item.ID] = item.Attributes.Identifier
+				}
+			}
+		}
+	}
+
+	filtered := make([]asc.Resource[asc.ProfileAttributes], 0, len(resp.Data))
+	for _, profile := range resp.Data {
+		if f.bundleID != "" {
+			resourceID := profileBundleResourceID(profile)
+			if resourceID == "" || (resourceID != f.bundleID && !strings.EqualFold(identifiers[resourceID], f.bundleID)) {
+				continue
+			}
+		}
+		if !f.expiresBefore.IsZero() {
+			expires, err := time.Parse(time.RFC3339, strings.TrimSpace(profile.Attributes.ExpirationDate))
+			if err != nil || expires.After(f.expiresBefore) {
+				continue
+			}
+		}
+		filtered = append(filtered, profile)
+	}
+	return filtered
+}
+
+func profileBundleResourceID(profile asc.Resource[asc.ProfileAttributes]) string {
+	var relationships struct {
+		BundleID struct {
+			Data *asc.ResourceData `json:"data"`
+		} `json:"bundleId"`
+	}
+	if len(profile.Relationships) == 0 || json.Unmarshal(profile.Relationships, &relationships) != nil || relationships.BundleID.Data == nil {
+		return ""
+	}
+	return relationships.BundleID.Data.ID
+}
+
+// fetchAllProfiles fetches every page of profiles, keeping the included
+// resources from each page rather than only the first.
+func fetchAllProfiles(ctx context.Context, client *asc.Client, opts ...asc.ProfilesOption) (*asc.ProfilesResponse, error) {
+	firstPage, err := client.GetProfiles(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+
+	included := newIncludedSet()
+	if err := included.add(firstPage.Included); err != nil {
+		return nil, err
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		page, err := client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
+		if err != nil {
+			return nil, err
+		}
+		if err := included.add(page.Included); err != nil {
+			return nil, err
+		}
+		return page, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	profiles, ok := paginated.(*asc.ProfilesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected profiles response type %T", paginated)
+	}
+	if profiles.Included, err = included.raw(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// includedSet collects included resources across pages, dropping duplicates.
+type includedSet struct {
+	seen  map[string]struct{}
+	items []json.RawMessage
+}
+
+func newIncludedSet() *includedSet {
+	return &includedSet{seen: make(map[string]struct{})}
+}
+
+func (s *includedSet) add(raw json.RawMessage) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return fmt.Errorf("parse included resources: %w", err)
+	}
+	for _, item := range items {
+		var key asc.ResourceData
+		if err := json.Unmarshal(item, &key); err != nil {
+			return fmt.Errorf("parse included resource: %w", err)
+		}
+		id := string(key.Type) + "/" + key.ID
+		if _, ok := s.seen[id]; ok {
+			continue
+		}
+		s.seen[id] = struct{}{}
+		s.items = append(s.items, item)
+	}
+	return nil
+}
+
+func (s *includedSet) raw() (json.RawMessage, error) {
+	if len(s.items) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(s.items)
+}
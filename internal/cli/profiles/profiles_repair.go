@@ -0,0 +1,308 @@
+package profiles
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var profileFileNameUnsafe = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// ProfilesRepairCommand returns the profiles repair subcommand.
+func ProfilesRepairCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("repair", flag.ExitOnError)
+
+	profileType := fs.String("profile-type", "", "Only repair profiles of these type(s), comma-separated")
+	outputDir := fs.String("output-dir", "", "Directory to download regenerated .mobileprovision files to (optional)")
+	dryRun := fs.Bool("dry-run", false, "Preview the profiles that would be regenerated")
+	confirm := fs.Bool("confirm", false, "Confirm regeneration (required unless --dry-run)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "repair",
+		ShortUsage: "asc profiles repair (--dry-run | --confirm) [--output-dir ./profiles] [flags]",
+		ShortHelp:  "Regenerate invalid or expired provisioning profiles.",
+		LongHelp: `Regenerate invalid or expired provisioning profiles.
+
+Finds every profile that is INVALID or past its expiration date and recreates
+it with the same name, type, bundle ID, devices, and certificates. When none
+of the profile's certificates is still valid (for example after a certificate
+rotation), the newest valid certificate of the matching type is used instead.
+
+Each old profile is deleted before its replacement is created, because
+profile names must be unique. Run with --dry-run first to review the plan.
+With --output-dir the regenerated profiles are downloaded as
+<name>.mobileprovision; existing files are not overwritten.
+
+Examples:
+  asc profiles repair --dry-run
+  asc profiles repair --confirm --output-dir "./profiles"
+  asc profiles repair --profile-type IOS_APP_STORE,IOS_APP_ADHOC --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if !*dryRun && !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required to regenerate profiles")
+				return flag.ErrHelp
+			}
+			outputDirValue := strings.TrimSpace(*outputDir)
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("profiles repair: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			opts := []asc.ProfilesOption{asc.WithProfilesLimit(200)}
+			if types := shared.SplitCSVUpper(*profileType); len(types) > 0 {
+				opts = append(opts, asc.WithProfilesTypes(types))
+			}
+			firstPage, err := client.GetProfiles(requestCtx, opts...)
+			if err != nil {
+				return fmt.Errorf("profiles repair: failed to fetch: %w", err)
+			}
+			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetProfiles(ctx, asc.WithProfilesNextURL(nextURL))
+			})
+			if err != nil {
+				return fmt.Errorf("profiles repair: %w", err)
+			}
+			profiles, ok := paginated.(*asc.ProfilesResponse)
+			if !ok {
+				return fmt.Errorf("profiles repair: unexpected response type %T", paginated)
+			}
+
+			now := time.Now().UTC()
+			result := &asc.ProfileRepairResult{
+				DryRun:   *dryRun,
+				Profiles: make([]asc.ProfileRepairItem, 0),
+				Failures: make([]asc.ProfileRepairFailure, 0),
+			}
+			certificatesByType := make(map[string][]asc.Resource[asc.CertificateAttributes])
+
+			for _, profile := range profiles.Data {
+				reason := profileRepairReason(profile.Attributes, now)
+				if reason == "" {
+					continue
+				}
+				result.SelectedCount++
+
+				item, err := planProfileRepair(requestCtx, client, profile, reason, now, certificatesByType)
+				if err == nil && !*dryRun {
+					err = regenerateProfile(requestCtx, client, item, outputDirValue)
+				}
+				if err != nil {
+					result.Failures = append(result.Failures, asc.ProfileRepairFailure{
+						ID:    profile.ID,
+						Name:  profile.Attributes.Name,
+						Error: err.Error(),
+					})
+					continue
+				}
+				if !*dryRun {
+					result.RepairedCount++
+				}
+				result.Profiles = append(result.Profiles, *item)
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if len(result.Failures) > 0 {
+				return fmt.Errorf("profiles repair: %d profiles failed to regenerate", len(result.Failures))
+			}
+			return nil
+		},
+	}
+}
+
+// profileRepairReason reports why a profile needs regenerating, or "" when it
+// is still usable.
+func profileRepairReason(attrs asc.ProfileAttributes, now time.Time) string {
+	if attrs.ProfileState == asc.ProfileStateInvalid {
+		return "invalid"
+	}
+	if expires, err := time.Parse(time.RFC3339, strings.TrimSpace(attrs.ExpirationDate)); err == nil && !expires.After(now) {
+		return "expired"
+	}
+	return ""
+}
+
+// planProfileRepair collects the bundle ID, devices, and still-valid
+// certificates of a profile, falling back to the newest valid certificate
+// of the matching type.
+func planProfileRepair(ctx context.Context, client *asc.Client, profile asc.Resource[asc.ProfileAttributes], reason string, now time.Time, certificatesByType map[string][]asc.Resource[asc.CertificateAttributes]) (*asc.ProfileRepairItem, error) {
+	item := &asc.ProfileRepairItem{
+		ID:             profile.ID,
+		Name:           profile.Attributes.Name,
+		ProfileType:    profile.Attributes.ProfileType,
+		ProfileState:   string(profile.Attributes.ProfileState),
+		ExpirationDate: profile.Attributes.ExpirationDate,
+		Reason:         reason,
+	}
+
+	bundle, err := client.GetProfileBundleID(ctx, profile.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch bundle ID: %w", err)
+	}
+	item.BundleID = bundle.Data.ID
+
+	firstCertificates, err := client.GetProfileCertificates(ctx, profile.ID, asc.WithProfileCertificatesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch certificates: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstCertificates, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetProfileCertificates(ctx, profile.ID, asc.WithProfileCertificatesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate certificates: %w", err)
+	}
+	certificates, ok := paginated.(*asc.CertificatesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected certificates response type %T", paginated)
+	}
+	for _, certificate := range certificates.Data {
+		if certificateValid(certificate.Attributes, now) {
+			item.CertificateIDs = append(item.CertificateIDs, certificate.ID)
+		}
+	}
+
+	if len(item.CertificateIDs) == 0 {
+		certificateType, ok := shared.CertificateTypeForProfileType(profile.Attributes.ProfileType)
+		if !ok {
+			return nil, fmt.Errorf("no valid certificate and unable to infer certificate type for %s", profile.Attributes.ProfileType)
+		}
+		candidates, ok := certificatesByType[certificateType]
+		if !ok {
+			candidates, err = fetchCertificatesOfType(ctx, client, certificateType)
+			if err != nil {
+				return nil, err
+			}
+			certificatesByType[certificateType] = candidates
+		}
+		newest := newestValidCertificate(candidates, now)
+		if newest == "" {
+			return nil, fmt.Errorf("no valid %s certificate to sign the profile", certificateType)
+		}
+		item.CertificateIDs = []string{newest}
+		item.ReplacedCertificates = true
+	}
+
+	firstDevices, err := client.GetProfileDevicesRelationships(ctx, profile.ID, asc.WithLinkagesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch devices: %w", err)
+	}
+	paginated, err = asc.PaginateAll(ctx, firstDevices, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetProfileDevicesRelationships(ctx, profile.ID, asc.WithLinkagesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate devices: %w", err)
+	}
+	devices, ok := paginated.(*asc.ProfileDevicesLinkagesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected devices response type %T", paginated)
+	}
+	for _, device := range devices.Data {
+		item.DeviceIDs = append(item.DeviceIDs, device.ID)
+	}
+	return item, nil
+}
+
+// regenerateProfile replaces the old profile with a new one and optionally
+// downloads it.
+func regenerateProfile(ctx context.Context, client *asc.Client, item *asc.ProfileRepairItem, outputDir string) error {
+	if err := client.DeleteProfile(ctx, item.ID); err != nil {
+		return fmt.Errorf("delete old profile: %w", err)
+	}
+	resp, err := client.CreateProfile(ctx, asc.ProfileCreateAttributes{
+		Name:        item.Name,
+		ProfileType: item.ProfileType,
+	}, item.BundleID, item.CertificateIDs, item.DeviceIDs)
+	if err != nil {
+		return fmt.Errorf("old profile was deleted but the replacement could not be created: %w", err)
+	}
+	item.NewProfileID = resp.Data.ID
+
+	if outputDir == "" {
+		return nil
+	}
+	decoded, err := decodeProfileContent(resp.Data.Attributes.ProfileContent)
+	if err != nil {
+		return fmt.Errorf("profile %s regenerated but not downloaded: %w", resp.Data.ID, err)
+	}
+	path := filepath.Join(outputDir, profileFileName(item.Name))
+	if err := shared.WriteProfileFile(path, decoded); err != nil {
+		return fmt.Errorf("profile %s regenerated but not downloaded: %w", resp.Data.ID, err)
+	}
+	item.OutputPath = path
+	return nil
+}
+
+func fetchCertificatesOfType(ctx context.Context, client *asc.Client, certificateType string) ([]asc.Resource[asc.CertificateAttributes], error) {
+	firstPage, err := client.GetCertificates(ctx, asc.WithCertificatesLimit(200), asc.WithCertificatesFilterType(certificateType))
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s certificates: %w", certificateType, err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCertificates(ctx, asc.WithCertificatesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate certificates: %w", err)
+	}
+	certificates, ok := paginated.(*asc.CertificatesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected certificates response type %T", paginated)
+	}
+	return certificates.Data, nil
+}
+
+func certificateValid(attrs asc.CertificateAttributes, now time.Time) bool {
+	if attrs.Activated != nil && !*attrs.Activated {
+		return false
+	}
+	expires, err := time.Parse(time.RFC3339, strings.TrimSpace(attrs.ExpirationDate))
+	if err != nil {
+		return false
+	}
+	return expires.After(now)
+}
+
+// newestValidCertificate returns the ID of the valid certificate that expires last.
+func newestValidCertificate(certificates []asc.Resource[asc.CertificateAttributes], now time.Time) string {
+	var (
+		newestID      string
+		newestExpires time.Time
+	)
+	for _, certificate := range certificates {
+		if !certificateValid(certificate.Attributes, now) {
+			continue
+		}
+		expires, _ := time.Parse(time.RFC3339, strings.TrimSpace(certificate.Attributes.ExpirationDate))
+		if newestID == "" || expires.After(newestExpires) {
+			newestID = certificate.ID
+			newestExpires = expires
+		}
+	}
+	return newestID
+}
+
+func profileFileName(name string) string {
+	base := strings.Trim(profileFileNameUnsafe.ReplaceAllString(strings.TrimSpace(name), "_"), "_.")
+	if base == "" {
+		base = "profile"
+	}
+	return base + ".mobileprovision"
+}
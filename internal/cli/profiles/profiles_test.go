@@ -2,8 +2,12 @@ package profiles
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
 func TestProfilesGetCommand_MissingID(t *testing.T) {
@@ -167,3 +171,61 @@ func TestExtractProfileIDFromNextURL_Invalid(t *testing.T) {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestProfileListFilterApply(t *testing.T) {
+	profile := func(id, bundleResourceID, expiration string) asc.Resource[asc.ProfileAttributes] {
+		return asc.Resource[asc.ProfileAttributes]{
+			ID:            id,
+			Attributes:    asc.ProfileAttributes{ExpirationDate: expiration},
+			Relationships: json.RawMessage(`{"bundleId":{"data":{"type":"bundleIds","id":"` + bundleResourceID + `"}}}`),
+		}
+	}
+	resp := &asc.ProfilesResponse{
+		Data: []asc.Resource[asc.ProfileAttributes]{
+			profile("soon", "bid-1", "2026-03-10T00:00:00.000+00:00"),
+			profile("later", "bid-1", "2026-09-01T00:00:00.000+00:00"),
+			profile("other-app", "bid-2", "2026-03-10T00:00:00.000+00:00"),
+			profile("expired", "bid-1", "2026-02-01T00:00:00.000+00:00"),
+		},
+		Included: json.RawMessage(`[{"type":"bundleIds","id":"bid-1","attributes":{"identifier":"com.example.app"}},{"type":"bundleIds","id":"bid-2","attributes":{"identifier":"com.example.other"}}]`),
+	}
+
+	tests := []struct {
+		name   string
+		filter profileListFilter
+		want   []string
+	}{
+		{
+			name:   "bundle identifier",
+			filter: profileListFilter{bundleID: "COM.EXAMPLE.APP"},
+			want:   []string{"soon", "later", "expired"},
+		},
+		{
+			name:   "bundle resource ID",
+			filter: profileListFilter{bundleID: "bid-2"},
+			want:   []string{"other-app"},
+		},
+		{
+			name:   "expiring within window",
+			filter: profileListFilter{bundleID: "com.example.app", expiresBefore: time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)},
+			want:   []string{"soon", "expired"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.filter.apply(resp)
+			ids := make([]string, 0, len(got))
+			for _, item := range got {
+				ids = append(ids, item.ID)
+			}
+			if len(ids) != len(test.want) {
+				t.Fatalf("expected %v, got %v", test.want, ids)
+			}
+			for i := range ids {
+				if ids[i] != test.want[i] {
+					t.Fatalf("expected %v, got %v", test.want, ids)
+				}
+			}
+		})
+	}
+}
@@ -29,7 +29,9 @@ Examples:
   asc promoted-purchases create --app "APP_ID" --product-id "PRODUCT_ID" --product-type SUBSCRIPTION --visible-for-all-users
   asc promoted-purchases update --promoted-purchase-id "PROMO_ID" --enabled false
   asc promoted-purchases delete --promoted-purchase-id "PROMO_ID" --confirm
-  asc promoted-purchases link --app "APP_ID" --promoted-purchase-id "PROMO_ID"`,
+  asc promoted-purchases link --app "APP_ID" --promoted-purchase-id "PROMO_ID"
+  asc promoted-purchases reorder --app "APP_ID" --promoted-purchase-id "PROMO_2,PROMO_1"
+  asc promoted-purchases image upload --promoted-purchase-id "PROMO_ID" --file "./promo.png"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -39,6 +41,8 @@ Examples:
 			PromotedPurchasesUpdateCommand(),
 			PromotedPurchasesDeleteCommand(),
 			PromotedPurchasesLinkCommand(),
+			PromotedPurchasesReorderCommand(),
+			PromotedPurchasesImageCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -0,0 +1,176 @@
+package promotedpurchases
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// PromotedPurchasesImageCommand returns the promoted purchases image command group.
+func PromotedPurchasesImageCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("image", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "image",
+		ShortUsage: "asc promoted-purchases image <subcommand> [flags]",
+		ShortHelp:  "Manage the image shown for a promoted purchase.",
+		LongHelp: `Manage the image shown for a promoted purchase.
+
+Examples:
+  asc promoted-purchases image upload --promoted-purchase-id "PROMO_ID" --file "./promo.png"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			PromotedPurchasesImageUploadCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// PromotedPurchasesImageUploadCommand returns the promoted purchases image upload subcommand.
+func PromotedPurchasesImageUploadCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("image upload", flag.ExitOnError)
+
+	id := fs.String("promoted-purchase-id", "", "Promoted purchase ID")
+	filePath := fs.String("file", "", "Path to image file (1024x1024 PNG or JPEG)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "upload",
+		ShortUsage: "asc promoted-purchases image upload --promoted-purchase-id PROMO_ID --file ./promo.png",
+		ShortHelp:  "Upload the image shown for a promoted purchase.",
+		LongHelp: `Upload the image shown for a promoted purchase.
+
+App Store Connect shows the image of the promoted product, so the image is
+uploaded to the subscription or in-app purchase the promoted purchase points
+to.
+
+Examples:
+  asc promoted-purchases image upload --promoted-purchase-id "PROMO_ID" --file "./promo.png"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --promoted-purchase-id is required")
+				return flag.ErrHelp
+			}
+			pathValue := strings.TrimSpace(*filePath)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --file is required")
+				return flag.ErrHelp
+			}
+
+			file, info, err := shared.OpenImageFile(pathValue)
+			if err != nil {
+				return fmt.Errorf("promoted-purchases image upload: %w", err)
+			}
+			defer file.Close()
+
+			checksum, err := asc.ComputeChecksumFromReader(file, asc.ChecksumAlgorithmMD5)
+			if err != nil {
+				return fmt.Errorf("promoted-purchases image upload: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("promoted-purchases image upload: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithUploadTimeout(ctx)
+			defer cancel()
+
+			promoted, err := client.GetPromotedPurchase(requestCtx, idValue, asc.WithPromotedPurchasesInclude([]string{"inAppPurchaseV2", "subscription"}))
+			if err != nil {
+				return fmt.Errorf("promoted-purchases image upload: failed to fetch promoted purchase: %w", err)
+			}
+			productType, productID, err := promotedPurchaseProduct(promoted.Data.Relationships)
+			if err != nil {
+				return fmt.Errorf("promoted-purchases image upload: %w", err)
+			}
+
+			result := &asc.PromotedPurchaseImageUploadResult{
+				PromotedPurchaseID: idValue,
+				ProductType:        string(productType),
+				ProductID:          productID,
+				FileName:           info.Name(),
+				FileSize:           info.Size(),
+			}
+			uploaded := true
+
+			switch productType {
+			case promotedPurchaseProductTypeSubscription:
+				resp, err := client.CreateSubscriptionImage(requestCtx, productID, info.Name(), info.Size())
+				if err != nil {
+					return fmt.Errorf("promoted-purchases image upload: failed to create: %w", err)
+				}
+				if len(resp.Data.Attributes.UploadOperations) == 0 {
+					return fmt.Errorf("promoted-purchases image upload: no upload operations returned")
+				}
+				if err := asc.UploadAssetFromFile(requestCtx, file, info.Size(), resp.Data.Attributes.UploadOperations); err != nil {
+					return fmt.Errorf("promoted-purchases image upload: upload failed: %w", err)
+				}
+				committed, err := client.UpdateSubscriptionImage(requestCtx, resp.Data.ID, asc.SubscriptionImageUpdateAttributes{
+					Uploaded:           &uploaded,
+					SourceFileChecksum: &checksum.Hash,
+				})
+				if err != nil {
+					return fmt.Errorf("promoted-purchases image upload: failed to commit upload: %w", err)
+				}
+				result.ImageID = resp.Data.ID
+				result.State = committed.Data.Attributes.State
+			default:
+				resp, err := client.CreateInAppPurchaseImage(requestCtx, productID, info.Name(), info.Size())
+				if err != nil {
+					return fmt.Errorf("promoted-purchases image upload: failed to create: %w", err)
+				}
+				if len(resp.Data.Attributes.UploadOperations) == 0 {
+					return fmt.Errorf("promoted-purchases image upload: no upload operations returned")
+				}
+				if err := asc.UploadAssetFromFile(requestCtx, file, info.Size(), resp.Data.Attributes.UploadOperations); err != nil {
+					return fmt.Errorf("promoted-purchases image upload: upload failed: %w", err)
+				}
+				committed, err := client.UpdateInAppPurchaseImage(requestCtx, resp.Data.ID, asc.InAppPurchaseImageUpdateAttributes{
+					Uploaded:           &uploaded,
+					SourceFileChecksum: &checksum.Hash,
+				})
+				if err != nil {
+					return fmt.Errorf("promoted-purchases image upload: failed to commit upload: %w", err)
+				}
+				result.ImageID = resp.Data.ID
+				result.State = committed.Data.Attributes.State
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// promotedPurchaseProduct returns the type and ID of the product a promoted
+// purchase promotes, read from its included relationships.
+func promotedPurchaseProduct(raw json.RawMessage) (promotedPurchaseProductType, string, error) {
+	var relationships asc.PromotedPurchaseRelationships
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &relationships); err != nil {
+			return "", "", fmt.Errorf("failed to parse promoted purchase relationships: %w", err)
+		}
+	}
+	if relationships.Subscription != nil && strings.TrimSpace(relationships.Subscription.Data.ID) != "" {
+		return promotedPurchaseProductTypeSubscription, strings.TrimSpace(relationships.Subscription.Data.ID), nil
+	}
+	if relationships.InAppPurchaseV2 != nil && strings.TrimSpace(relationships.InAppPurchaseV2.Data.ID) != "" {
+		return promotedPurchaseProductTypeInAppPurchase, strings.TrimSpace(relationships.InAppPurchaseV2.Data.ID), nil
+	}
+	return "", "", fmt.Errorf("promoted purchase has no subscription or in-app purchase")
+}
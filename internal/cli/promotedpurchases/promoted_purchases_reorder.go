@@ -0,0 +1,125 @@
+package promotedpurchases
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// PromotedPurchasesReorderCommand returns the promoted purchases reorder subcommand.
+func PromotedPurchasesReorderCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("reorder", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	promotedIDs := fs.String("promoted-purchase-id", "", "Comma-separated promoted purchase IDs in the new order")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "reorder",
+		ShortUsage: "asc promoted-purchases reorder --app APP_ID --promoted-purchase-id PROMO_ID[,PROMO_ID...]",
+		ShortHelp:  "Change the order of an app's promoted purchases.",
+		LongHelp: `Change the order of an app's promoted purchases.
+
+The App Store shows promoted purchases on the product page in this order. The
+given IDs move to the front in the order listed; promoted purchases that are
+not listed keep their relative order after them. Every ID must already be
+linked to the app.
+
+Examples:
+  asc promoted-purchases reorder --app "APP_ID" --promoted-purchase-id "PROMO_2,PROMO_1"
+  asc promoted-purchases reorder --app "APP_ID" --promoted-purchase-id "PROMO_3"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			requested := shared.SplitCSV(*promotedIDs)
+			if len(requested) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --promoted-purchase-id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("promoted-purchases reorder: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			firstPage, err := client.GetAppPromotedPurchases(requestCtx, resolvedAppID, asc.WithPromotedPurchasesLimit(200))
+			if err != nil {
+				return fmt.Errorf("promoted-purchases reorder: failed to fetch: %w", err)
+			}
+			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetAppPromotedPurchases(ctx, resolvedAppID, asc.WithPromotedPurchasesNextURL(nextURL))
+			})
+			if err != nil {
+				return fmt.Errorf("promoted-purchases reorder: %w", err)
+			}
+			current, ok := paginated.(*asc.PromotedPurchasesResponse)
+			if !ok {
+				return fmt.Errorf("promoted-purchases reorder: unexpected promoted purchases response type %T", paginated)
+			}
+
+			currentIDs := make([]string, 0, len(current.Data))
+			for _, item := range current.Data {
+				currentIDs = append(currentIDs, item.ID)
+			}
+			order, err := reorderPromotedPurchaseIDs(currentIDs, requested)
+			if err != nil {
+				return fmt.Errorf("promoted-purchases reorder: %w", err)
+			}
+
+			if err := client.SetAppPromotedPurchases(requestCtx, resolvedAppID, order); err != nil {
+				return fmt.Errorf("promoted-purchases reorder: failed to reorder: %w", err)
+			}
+
+			result := &asc.AppPromotedPurchasesLinkResult{
+				AppID:               resolvedAppID,
+				PromotedPurchaseIDs: order,
+				Action:              "reordered",
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// reorderPromotedPurchaseIDs moves requested to the front of current, keeping
+// the remaining IDs in their current order.
+func reorderPromotedPurchaseIDs(current, requested []string) ([]string, error) {
+	linked := make(map[string]bool, len(current))
+	for _, id := range current {
+		linked[id] = true
+	}
+
+	order := make([]string, 0, len(current))
+	moved := make(map[string]bool, len(requested))
+	for _, id := range requested {
+		if !linked[id] {
+			return nil, fmt.Errorf("promoted purchase %s is not linked to the app", id)
+		}
+		if moved[id] {
+			return nil, fmt.Errorf("promoted purchase %s is listed more than once", id)
+		}
+		moved[id] = true
+		order = append(order, id)
+	}
+	for _, id := range current {
+		if !moved[id] {
+			order = append(order, id)
+		}
+	}
+	return order, nil
+}
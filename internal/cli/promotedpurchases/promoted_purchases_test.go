@@ -2,8 +2,10 @@ package promotedpurchases
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
+	"strings"
 	"testing"
 )
 
@@ -30,3 +32,36 @@ func TestPromotedPurchasesListValidation(t *testing.T) {
 		t.Fatalf("expected ErrHelp, got %v", err)
 	}
 }
+
+func TestReorderPromotedPurchaseIDs(t *testing.T) {
+	got, err := reorderPromotedPurchaseIDs([]string{"a", "b", "c", "d"}, []string{"c", "a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(got, ",") != "c,a,b,d" {
+		t.Fatalf("expected c,a,b,d, got %v", got)
+	}
+
+	if _, err := reorderPromotedPurchaseIDs([]string{"a"}, []string{"a", "a"}); err == nil {
+		t.Fatal("expected error for duplicate ID")
+	}
+	if _, err := reorderPromotedPurchaseIDs([]string{"a"}, []string{"z"}); err == nil {
+		t.Fatal("expected error for unlinked ID")
+	}
+}
+
+func TestPromotedPurchaseProduct(t *testing.T) {
+	productType, id, err := promotedPurchaseProduct(json.RawMessage(`{"subscription":{"data":{"type":"subscriptions","id":"sub-1"}},"inAppPurchaseV2":{"links":{}}}`))
+	if err != nil || productType != promotedPurchaseProductTypeSubscription || id != "sub-1" {
+		t.Fatalf("expected subscription sub-1, got %q %q %v", productType, id, err)
+	}
+
+	productType, id, err = promotedPurchaseProduct(json.RawMessage(`{"inAppPurchaseV2":{"data":{"type":"inAppPurchases","id":"iap-1"}}}`))
+	if err != nil || productType != promotedPurchaseProductTypeInAppPurchase || id != "iap-1" {
+		t.Fatalf("expected in-app purchase iap-1, got %q %q %v", productType, id, err)
+	}
+
+	if _, _, err := promotedPurchaseProduct(nil); err == nil {
+		t.Fatal("expected error without relationships")
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/androidiosmapping"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/app_events"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/appclips"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/apply"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/apps"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/assets"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/auth"
@@ -34,12 +35,16 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/eula"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/feedback"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/finance"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/foreachprofile"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/gamecenter"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/iap"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/install"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/keywords"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/links"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/localizations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/marketplace"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/merchantids"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/migrate"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/nominations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notarization"
@@ -54,6 +59,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/profiles"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/promotedpurchases"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/publish"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/releasenotes"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/reviews"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/routingcoverage"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/sandbox"
@@ -64,7 +70,9 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/testflight"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/users"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/versions"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/wait"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/webhooks"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/whatsnew"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/winbackoffers"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/xcodecloud"
 )
@@ -85,6 +93,10 @@ func VersionCommand(version string) *ffcli.Command {
 
 // Subcommands returns all root subcommands in display order.
 func Subcommands(version string) []*ffcli.Command {
+	// The IAP catalog commands share the asc apply schema and planner.
+	iapCommand := iap.IAPCommand()
+	iapCommand.Subcommands = append(iapCommand.Subcommands, apply.IAPExportCommand(), apply.IAPImportCommand())
+
 	subs := []*ffcli.Command{
 		auth.AuthCommand(),
 		install.InstallCommand(),
@@ -92,8 +104,11 @@ func Subcommands(version string) []*ffcli.Command {
 		crashes.CrashesCommand(),
 		reviews.ReviewsCommand(),
 		reviews.ReviewCommand(),
+		reviews.RatingsCommand(),
 		analytics.AnalyticsCommand(),
+		analytics.ReportsCommand(),
 		performance.PerformanceCommand(),
+		performance.MetricsCommand(),
 		finance.FinanceCommand(),
 		apps.AppsCommand(),
 		appclips.AppClipsCommand(),
@@ -119,7 +134,9 @@ func Subcommands(version string) []*ffcli.Command {
 		buildbundles.BuildBundlesCommand(),
 		publish.PublishCommand(),
 		versions.VersionsCommand(),
+		releasenotes.ReleaseNotesCommand(),
 		productpages.ProductPagesCommand(),
+		links.LinksCommand(),
 		routingcoverage.RoutingCoverageCommand(),
 		apps.AppInfoCommand(),
 		apps.AppInfosCommand(),
@@ -129,15 +146,18 @@ func Subcommands(version string) []*ffcli.Command {
 		preorders.PreOrdersCommand(),
 		prerelease.PreReleaseVersionsCommand(),
 		localizations.LocalizationsCommand(),
+		keywords.KeywordsCommand(),
+		whatsnew.WhatsNewCommand(),
 		assets.AssetsCommand(),
 		backgroundassets.BackgroundAssetsCommand(),
 		buildlocalizations.BuildLocalizationsCommand(),
 		betaapplocalizations.BetaAppLocalizationsCommand(),
 		betabuildlocalizations.BetaBuildLocalizationsCommand(),
 		sandbox.SandboxCommand(),
+		sandbox.SandboxTestersCommand(),
 		signing.SigningCommand(),
 		notarization.NotarizationCommand(),
-		iap.IAPCommand(),
+		iapCommand,
 		app_events.Command(),
 		subscriptions.SubscriptionsCommand(),
 		submit.SubmitCommand(),
@@ -148,7 +168,12 @@ func Subcommands(version string) []*ffcli.Command {
 		encryption.EncryptionCommand(),
 		promotedpurchases.PromotedPurchasesCommand(),
 		migrate.MigrateCommand(),
+		metadata.MetadataCommand(),
+		apply.ApplyCommand(),
+		apply.ExportStateCommand(),
 		notify.NotifyCommand(),
+		wait.WaitCommand(),
+		foreachprofile.ForeachProfileCommand(),
 		gamecenter.GameCenterCommand(),
 		VersionCommand(version),
 	}
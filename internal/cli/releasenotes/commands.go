@@ -0,0 +1,8 @@
+package releasenotes
+
+import "github.com/peterbourgon/ff/v3/ffcli"
+
+// Command returns the release-notes command group.
+func Command() *ffcli.Command {
+	return ReleaseNotesCommand()
+}
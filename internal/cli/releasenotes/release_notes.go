@@ -0,0 +1,258 @@
+package releasenotes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// phasedReleaseDays is the length of Apple's phased release schedule.
+const phasedReleaseDays = 7
+
+// ReleaseNotesCommand returns the release-notes command group.
+func ReleaseNotesCommand() *ffcli.Command {
+	return &ffcli.Command{
+		Name:       "release-notes",
+		ShortUsage: "asc release-notes <subcommand> [flags]",
+		ShortHelp:  "Generate release notes from App Store version metadata.",
+		LongHelp: `Generate release notes from App Store version metadata.
+
+Examples:
+  asc release-notes generate --version-id "VERSION_ID"
+  asc release-notes generate --version-id "VERSION_ID" --output md`,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			ReleaseNotesGenerateCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// ReleaseNotesGenerateCommand returns the release-notes generate subcommand.
+func ReleaseNotesGenerateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("release-notes generate", flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	locales := fs.String("locale", "", "Only include these locales (comma-separated)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, md (release notes document)")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "generate",
+		ShortUsage: "asc release-notes generate --version-id VERSION_ID [flags]",
+		ShortHelp:  "Generate a version's release notes.",
+		LongHelp: `Generate a version's release notes.
+
+Combines the version's metadata, attached build, phased release state, and
+localized What's New text. --output md renders them as a Markdown document
+suitable for posting to internal release channels; the other output formats
+print the same data like any other command.
+
+Examples:
+  asc release-notes generate --version-id "VERSION_ID"
+  asc release-notes generate --version-id "VERSION_ID" --output md > RELEASE.md
+  asc release-notes generate --version-id "VERSION_ID" --locale "en-US,de-DE"
+  asc release-notes generate --version-id "VERSION_ID" --output json --pretty`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*versionID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("release-notes generate: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			notes, err := fetchReleaseNotes(requestCtx, client, id, shared.SplitCSV(*locales))
+			if err != nil {
+				return fmt.Errorf("release-notes generate: %w", err)
+			}
+
+			if strings.EqualFold(strings.TrimSpace(*output), "md") {
+				if *pretty {
+					return fmt.Errorf("release-notes generate: --pretty is only valid with JSON output")
+				}
+				_, err = fmt.Fprint(os.Stdout, renderReleaseNotesMarkdown(notes))
+				return err
+			}
+			return shared.PrintOutput(notes, *output, *pretty)
+		},
+	}
+}
+
+func fetchReleaseNotes(ctx context.Context, client *asc.Client, versionID string, locales []string) (*asc.ReleaseNotes, error) {
+	versionResp, err := client.GetAppStoreVersion(ctx, versionID, asc.WithAppStoreVersionInclude([]string{"app"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch version: %w", err)
+	}
+	attrs := versionResp.Data.Attributes
+	notes := &asc.ReleaseNotes{
+		VersionID: versionResp.Data.ID,
+		Version:   attrs.VersionString,
+		Platform:  string(attrs.Platform),
+		State:     attrs.AppVersionState,
+	}
+	if notes.State == "" {
+		notes.State = attrs.AppStoreState
+	}
+	notes.AppID, notes.AppName, notes.BundleID = includedApp(versionResp.Included)
+
+	buildResp, err := client.GetAppStoreVersionBuild(ctx, versionID)
+	if err != nil && !errors.Is(err, asc.ErrNotFound) {
+		return nil, fmt.Errorf("failed to fetch build: %w", err)
+	}
+	if err == nil && strings.TrimSpace(buildResp.Data.ID) != "" {
+		build := buildResp.Data.Attributes
+		notes.Build = &asc.ReleaseNotesBuild{
+			ID:              buildResp.Data.ID,
+			Number:          build.Version,
+			UploadedDate:    build.UploadedDate,
+			ProcessingState: build.ProcessingState,
+			MinOSVersion:    build.MinOSVersion,
+		}
+	}
+
+	phasedResp, err := client.GetAppStoreVersionPhasedRelease(ctx, versionID)
+	if err != nil && !errors.Is(err, asc.ErrNotFound) {
+		return nil, fmt.Errorf("failed to fetch phased release: %w", err)
+	}
+	if err == nil && strings.TrimSpace(phasedResp.Data.ID) != "" {
+		phased := phasedResp.Data.Attributes
+		notes.PhasedRelease = &asc.ReleaseNotesPhasedRelease{
+			State:              string(phased.PhasedReleaseState),
+			StartDate:          phased.StartDate,
+			CurrentDayNumber:   phased.CurrentDayNumber,
+			TotalPauseDuration: phased.TotalPauseDuration,
+		}
+	}
+
+	firstPage, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	localizations, ok := paginated.(*asc.AppStoreVersionLocalizationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected localizations response")
+	}
+
+	wanted := make(map[string]bool, len(locales))
+	for _, locale := range locales {
+		wanted[locale] = true
+	}
+	notes.Localizations = make([]asc.ReleaseNotesLocalization, 0, len(localizations.Data))
+	for _, item := range localizations.Data {
+		if len(wanted) > 0 && !wanted[item.Attributes.Locale] {
+			continue
+		}
+		notes.Localizations = append(notes.Localizations, asc.ReleaseNotesLocalization{
+			Locale:          item.Attributes.Locale,
+			WhatsNew:        strings.TrimSpace(item.Attributes.WhatsNew),
+			PromotionalText: strings.TrimSpace(item.Attributes.PromotionalText),
+		})
+	}
+	sort.Slice(notes.Localizations, func(i, j int) bool {
+		return notes.Localizations[i].Locale < notes.Localizations[j].Locale
+	})
+	return notes, nil
+}
+
+// includedApp returns the app ID, name, and bundle ID from an included payload.
+func includedApp(raw json.RawMessage) (string, string, string) {
+	if len(raw) == 0 {
+		return "", "", ""
+	}
+	var included []asc.Resource[asc.AppAttributes]
+	if err := json.Unmarshal(raw, &included); err != nil {
+		return "", "", ""
+	}
+	for _, item := range included {
+		if item.Type == asc.ResourceTypeApps {
+			return item.ID, item.Attributes.Name, item.Attributes.BundleID
+		}
+	}
+	return "", "", ""
+}
+
+func renderReleaseNotesMarkdown(notes *asc.ReleaseNotes) string {
+	var b strings.Builder
+
+	title := notes.Version
+	if notes.AppName != "" {
+		title = notes.AppName + " " + notes.Version
+	}
+	if notes.Platform != "" {
+		title += " (" + notes.Platform + ")"
+	}
+	fmt.Fprintf(&b, "# %s\n\n", title)
+
+	writeField := func(label, value string) {
+		if value != "" {
+			fmt.Fprintf(&b, "- **%s:** %s\n", label, value)
+		}
+	}
+	writeField("Bundle ID", notes.BundleID)
+	writeField("Version ID", notes.VersionID)
+	writeField("State", notes.State)
+	if notes.Build != nil {
+		build := "`" + notes.Build.Number + "`"
+		if notes.Build.UploadedDate != "" {
+			build += " (uploaded " + notes.Build.UploadedDate + ")"
+		}
+		writeField("Build", build)
+		writeField("Processing", notes.Build.ProcessingState)
+		writeField("Minimum OS", notes.Build.MinOSVersion)
+	} else {
+		writeField("Build", "_none attached_")
+	}
+	if notes.PhasedRelease != nil {
+		phased := notes.PhasedRelease.State
+		if notes.PhasedRelease.CurrentDayNumber > 0 {
+			phased += fmt.Sprintf(" (day %d of %d)", notes.PhasedRelease.CurrentDayNumber, phasedReleaseDays)
+		}
+		if notes.PhasedRelease.StartDate != "" {
+			phased += ", started " + notes.PhasedRelease.StartDate
+		}
+		writeField("Phased release", phased)
+	} else {
+		writeField("Phased release", "_not configured_")
+	}
+
+	b.WriteString("\n## What's New\n")
+	written := 0
+	for _, loc := range notes.Localizations {
+		if loc.WhatsNew == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "\n### %s\n\n%s\n", loc.Locale, loc.WhatsNew)
+		written++
+	}
+	if written == 0 {
+		b.WriteString("\n_No What's New text for this version._\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,120 @@
+package reviews
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/itunes"
+)
+
+// RatingsCommand returns the top-level ratings command.
+func RatingsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("ratings", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "ratings",
+		ShortUsage: "asc ratings <subcommand> [flags]",
+		ShortHelp:  "Fetch App Store star ratings.",
+		LongHelp: `Fetch App Store star ratings.
+
+Examples:
+  asc ratings get --app "1479784361"
+  asc ratings get --app "1479784361" --territory us,gb --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			RatingsGetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// RatingsGetCommand returns the ratings get subcommand.
+func RatingsGetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store app ID (or ASC_APP_ID env)")
+	territory := fs.String("territory", "", "Country code(s), comma-separated (e.g., us,gb,de); default is every storefront")
+	workers := fs.Int("workers", 10, "Number of parallel workers when fetching several territories")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "get",
+		ShortUsage: "asc ratings get --app APP_ID [--territory CODES]",
+		ShortHelp:  "Get the star rating distribution and total rating count.",
+		LongHelp: `Get an app's average star rating, total rating count, and 1-5 star
+distribution from the public iTunes API.
+
+Without --territory, ratings from every storefront are combined; with one
+territory, that storefront's ratings are returned; with several, they are
+combined and listed per territory.
+
+No authentication is required.
+
+Examples:
+  asc ratings get --app "1479784361"
+  asc ratings get --app "1479784361" --territory us
+  asc ratings get --app "1479784361" --territory us,gb,de --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			if *workers < 1 {
+				fmt.Fprintln(os.Stderr, "Error: --workers must be at least 1")
+				return flag.ErrHelp
+			}
+			countries, err := ratingsCountries(*territory)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			format, err := normalizeRatingsOutput(*output, *pretty)
+			if err != nil {
+				return err
+			}
+
+			client := itunes.NewClient()
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if len(countries) == 1 {
+				return executeSingleRatings(requestCtx, client, "ratings get", resolvedAppID, countries[0], format, *pretty)
+			}
+			return executeAllRatings(requestCtx, client, "ratings get", resolvedAppID, countries, *workers, format, *pretty)
+		},
+	}
+}
+
+// ratingsCountries parses --territory into lowercase storefront country codes.
+func ratingsCountries(value string) ([]string, error) {
+	codes := shared.SplitCSV(value)
+	countries := make([]string, 0, len(codes))
+	seen := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		code = strings.ToLower(code)
+		if _, ok := itunes.Storefronts[code]; !ok {
+			return nil, fmt.Errorf("--territory %q is not a supported App Store country code (e.g., us, gb, de)", code)
+		}
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+		countries = append(countries, code)
+	}
+	return countries, nil
+}
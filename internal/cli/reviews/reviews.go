@@ -44,12 +44,18 @@ Examples:
   asc reviews --app "123456789" --sort -createdDate --limit 5
   asc reviews --next "<links.next>"
   asc reviews --app "123456789" --paginate
+  asc reviews export --app "123456789" --since 2025-01-01 --until 2025-02-01 --output csv
+  asc reviews watch --app "123456789" --min-rating-below 3 --interval 10m
+  asc reviews stats --app "123456789" --since 30d --output table
   asc reviews get --id "REVIEW_ID"
   asc reviews ratings --app "123456789"
   asc reviews ratings --app "123456789" --all
   asc reviews summarizations --app "123456789" --platform IOS --territory US
   asc reviews respond --review-id "REVIEW_ID" --response "Thanks!"
+  asc reviews respond --review-id "REVIEW_ID" --template ./thanks.tmpl
+  asc reviews respond-batch --app "123456789" --filter-rating 5 --unanswered-only --template ./thanks.tmpl --dry-run
   asc reviews response get --id "RESPONSE_ID"
+  asc reviews response update --review-id "REVIEW_ID" --response "Updated reply"
   asc reviews response delete --id "RESPONSE_ID" --confirm
   asc reviews response for-review --review-id "REVIEW_ID"`,
 		FlagSet:   fs,
@@ -60,7 +66,11 @@ Examples:
 			ReviewsRatingsCommand(),
 			ReviewsSummarizationsCommand(),
 			ReviewsRespondCommand(),
+			ReviewsRespondBatchCommand(),
 			ReviewsResponseCommand(),
+			ReviewsExportCommand(),
+			ReviewsWatchCommand(),
+			ReviewsStatsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			// If no flags are set and no args, show help
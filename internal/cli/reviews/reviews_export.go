@@ -0,0 +1,257 @@
+package reviews
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// ReviewsExportCommand returns the reviews export subcommand.
+func ReviewsExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	since := fs.String("since", "", "Only reviews created on or after this date (YYYY-MM-DD, UTC)")
+	until := fs.String("until", "", "Only reviews created before this date (YYYY-MM-DD, UTC, exclusive)")
+	stars := fs.Int("stars", 0, "Filter by star rating (1-5)")
+	territory := fs.String("territory", "", "Filter by territory (e.g., US, GBR)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc reviews export --app APP_ID [--since DATE] [--until DATE] [--output csv]",
+		ShortHelp:  "Export all reviews in a date range with their responses.",
+		LongHelp: `Export all customer reviews in a date range, including review bodies and
+any existing developer responses.
+
+Every page is fetched, newest first, and the window is applied to each
+review's createdDate: --since is inclusive and --until is exclusive, so
+--since 2025-01-01 --until 2025-02-01 exports January.
+
+Examples:
+  asc reviews export --app "123456789" --since 2025-01-01 --until 2025-02-01 --output csv > january.csv
+  asc reviews export --app "123456789" --since 2025-01-01 --stars 1
+  asc reviews export --app "123456789" --output json --pretty`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			if *stars != 0 && (*stars < 1 || *stars > 5) {
+				fmt.Fprintln(os.Stderr, "Error: --stars must be between 1 and 5")
+				return flag.ErrHelp
+			}
+			window, err := parseReviewExportWindow(*since, *until)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews export: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			items, err := exportReviews(requestCtx, client, resolvedAppID, window, *stars, *territory)
+			if err != nil {
+				return fmt.Errorf("reviews export: %w", err)
+			}
+
+			result := &asc.ReviewExportResult{
+				AppID:   resolvedAppID,
+				Since:   strings.TrimSpace(*since),
+				Until:   strings.TrimSpace(*until),
+				Total:   len(items),
+				Reviews: items,
+			}
+			for _, item := range items {
+				if item.ResponseID != "" {
+					result.RespondedCount++
+				}
+			}
+
+			return shared.PrintOutputWithCSV(result, *output, *pretty)
+		},
+	}
+}
+
+// reviewWindow bounds review createdDate; zero values are open ends.
+type reviewWindow struct {
+	since time.Time
+	until time.Time
+}
+
+func (w reviewWindow) contains(created time.Time) bool {
+	if !w.since.IsZero() && created.Before(w.since) {
+		return false
+	}
+	if !w.until.IsZero() && !created.Before(w.until) {
+		return false
+	}
+	return true
+}
+
+func parseReviewExportWindow(since, until string) (reviewWindow, error) {
+	var window reviewWindow
+	if strings.TrimSpace(since) != "" {
+		parsed, err := time.Parse("2006-01-02", strings.TrimSpace(since))
+		if err != nil {
+			return window, fmt.Errorf("--since must be in YYYY-MM-DD format")
+		}
+		window.since = parsed
+	}
+	if strings.TrimSpace(until) != "" {
+		parsed, err := time.Parse("2006-01-02", strings.TrimSpace(until))
+		if err != nil {
+			return window, fmt.Errorf("--until must be in YYYY-MM-DD format")
+		}
+		window.until = parsed
+	}
+	if !window.since.IsZero() && !window.until.IsZero() && !window.since.Before(window.until) {
+		return window, fmt.Errorf("--since must be before --until")
+	}
+	return window, nil
+}
+
+// exportReviews collects the reviews in window with their responses included.
+func exportReviews(ctx context.Context, client *asc.Client, appID string, window reviewWindow, stars int, territory string) ([]asc.ReviewExportItem, error) {
+	items := make([]asc.ReviewExportItem, 0)
+	fetch := func(ctx context.Context, next string) (*asc.ReviewsResponse, error) {
+		if next != "" {
+			return client.GetReviews(ctx, appID, asc.WithNextURL(next))
+		}
+		return client.GetReviews(ctx, appID,
+			asc.WithRating(stars),
+			asc.WithTerritory(strings.TrimSpace(territory)),
+			asc.WithReviewSort("-createdDate"),
+			asc.WithReviewInclude([]string{"response"}),
+			asc.WithLimit(200),
+		)
+	}
+	err := walkReviewsInWindow(ctx, fetch, window, func(page *asc.ReviewsResponse, reviews []asc.Resource[asc.ReviewAttributes]) error {
+		responses, err := includedReviewResponses(page.Included)
+		if err != nil {
+			return err
+		}
+		for _, review := range reviews {
+			items = append(items, reviewExportItem(review, responses))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// walkReviewsInWindow pages through reviews fetched newest first and calls
+// visit with each page's reviews that fall in window. fetch is called with
+// an empty next URL for the first page. Paging stops once reviews are older
+// than the window.
+func walkReviewsInWindow(ctx context.Context, fetch func(ctx context.Context, next string) (*asc.ReviewsResponse, error), window reviewWindow, visit func(page *asc.ReviewsResponse, reviews []asc.Resource[asc.ReviewAttributes]) error) error {
+	next := ""
+	for {
+		page, err := fetch(ctx, next)
+		if err != nil {
+			return fmt.Errorf("failed to fetch reviews: %w", err)
+		}
+
+		inWindow := make([]asc.Resource[asc.ReviewAttributes], 0, len(page.Data))
+		done := false
+		for _, review := range page.Data {
+			created, err := time.Parse(time.RFC3339, strings.TrimSpace(review.Attributes.CreatedDate))
+			if err != nil {
+				return fmt.Errorf("review %s has invalid createdDate %q: %w", review.ID, review.Attributes.CreatedDate, err)
+			}
+			if !window.since.IsZero() && created.Before(window.since) {
+				done = true
+				break
+			}
+			if window.contains(created) {
+				inWindow = append(inWindow, review)
+			}
+		}
+		if err := visit(page, inWindow); err != nil {
+			return err
+		}
+
+		if done || strings.TrimSpace(page.Links.Next) == "" {
+			return nil
+		}
+		if err := shared.ValidateNextURL(page.Links.Next); err != nil {
+			return err
+		}
+		next = page.Links.Next
+	}
+}
+
+func reviewExportItem(review asc.Resource[asc.ReviewAttributes], responses map[string]asc.CustomerReviewResponseResource) asc.ReviewExportItem {
+	item := asc.ReviewExportItem{
+		ID:               review.ID,
+		CreatedDate:      review.Attributes.CreatedDate,
+		Rating:           review.Attributes.Rating,
+		Territory:        review.Attributes.Territory,
+		ReviewerNickname: review.Attributes.ReviewerNickname,
+		Title:            review.Attributes.Title,
+		Body:             review.Attributes.Body,
+	}
+	item.ResponseID = reviewResponseID(review)
+	if item.ResponseID == "" {
+		return item
+	}
+	if response, ok := responses[item.ResponseID]; ok {
+		item.ResponseBody = response.Attributes.ResponseBody
+		item.ResponseState = response.Attributes.State
+		item.ResponseLastModified = response.Attributes.LastModified
+	}
+	return item
+}
+
+// reviewResponseID returns the ID of the review's response when the page was
+// fetched with include=response, or "" if it has none.
+func reviewResponseID(review asc.Resource[asc.ReviewAttributes]) string {
+	var relationships struct {
+		Response struct {
+			Data *asc.ResourceData `json:"data"`
+		} `json:"response"`
+	}
+	if len(review.Relationships) == 0 || json.Unmarshal(review.Relationships, &relationships) != nil || relationships.Response.Data == nil {
+		return ""
+	}
+	return relationships.Response.Data.ID
+}
+
+// includedReviewResponses indexes the customerReviewResponses in a page's
+// included resources by ID.
+func includedReviewResponses(raw json.RawMessage) (map[string]asc.CustomerReviewResponseResource, error) {
+	responses := make(map[string]asc.CustomerReviewResponseResource)
+	if len(raw) == 0 {
+		return responses, nil
+	}
+	var included []asc.CustomerReviewResponseResource
+	if err := json.Unmarshal(raw, &included); err != nil {
+		return nil, fmt.Errorf("failed to parse included responses: %w", err)
+	}
+	for _, resource := range included {
+		if resource.Type == asc.ResourceTypeCustomerReviewResponses {
+			responses[resource.ID] = resource
+		}
+	}
+	return responses, nil
+}
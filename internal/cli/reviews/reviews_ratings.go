@@ -73,16 +73,16 @@ func executeRatings(ctx context.Context, appID, country string, all bool, worker
 	defer cancel()
 
 	if all {
-		return executeAllRatings(requestCtx, client, appID, workers, format, pretty)
+		return executeAllRatings(requestCtx, client, "reviews ratings", appID, nil, workers, format, pretty)
 	}
 
-	return executeSingleRatings(requestCtx, client, appID, country, format, pretty)
+	return executeSingleRatings(requestCtx, client, "reviews ratings", appID, country, format, pretty)
 }
 
-func executeSingleRatings(ctx context.Context, client *itunes.Client, appID, country, output string, pretty bool) error {
+func executeSingleRatings(ctx context.Context, client *itunes.Client, command, appID, country, output string, pretty bool) error {
 	ratings, err := client.GetRatings(ctx, appID, country)
 	if err != nil {
-		return fmt.Errorf("reviews ratings: %w", err)
+		return fmt.Errorf("%s: %w", command, err)
 	}
 
 	switch output {
@@ -95,10 +95,18 @@ func executeSingleRatings(ctx context.Context, client *itunes.Client, appID, cou
 	}
 }
 
-func executeAllRatings(ctx context.Context, client *itunes.Client, appID string, workers int, output string, pretty bool) error {
-	global, err := client.GetAllRatings(ctx, appID, workers)
+// executeAllRatings aggregates ratings across countries, or across every
+// supported country when countries is empty.
+func executeAllRatings(ctx context.Context, client *itunes.Client, command, appID string, countries []string, workers int, output string, pretty bool) error {
+	var global *itunes.GlobalRatings
+	var err error
+	if len(countries) == 0 {
+		global, err = client.GetAllRatings(ctx, appID, workers)
+	} else {
+		global, err = client.GetCountriesRatings(ctx, appID, countries, workers)
+	}
 	if err != nil {
-		return fmt.Errorf("reviews ratings: %w", err)
+		return fmt.Errorf("%s: %w", command, err)
 	}
 
 	switch output {
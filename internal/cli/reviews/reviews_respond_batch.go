@@ -0,0 +1,151 @@
+package reviews
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// ReviewsRespondBatchCommand returns the reviews respond-batch subcommand.
+func ReviewsRespondBatchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("respond-batch", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	templatePath := fs.String("template", "", "Path to a response template with review placeholders such as {{.ReviewerNickname}} and {{.Rating}} (required)")
+	filterRating := fs.Int("filter-rating", 0, "Only respond to reviews with this star rating (1-5)")
+	territory := fs.String("territory", "", "Only respond to reviews from this territory (e.g., US, GBR)")
+	unansweredOnly := fs.Bool("unanswered-only", false, "Skip reviews that already have a response")
+	dryRun := fs.Bool("dry-run", false, "Preview the rendered responses without posting them")
+	confirm := fs.Bool("confirm", false, "Confirm posting responses (required unless --dry-run)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "respond-batch",
+		ShortUsage: "asc reviews respond-batch --app APP_ID --template FILE [flags] (--dry-run | --confirm)",
+		ShortHelp:  "Respond to many reviews from a template.",
+		LongHelp: `Respond to every matching review with a response rendered from a template.
+
+The template is a Go text/template file; see "asc reviews respond --help" for
+the available placeholders. All responses are rendered before anything is
+posted, so a template error stops the batch before any review is answered.
+
+Without --unanswered-only, existing responses are replaced. Run with
+--dry-run first to preview each rendered response.
+
+Examples:
+  asc reviews respond-batch --app "123456789" --filter-rating 5 --unanswered-only --template ./thanks.tmpl --dry-run --output table
+  asc reviews respond-batch --app "123456789" --filter-rating 5 --unanswered-only --template ./thanks.tmpl --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*templatePath) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --template is required")
+				return flag.ErrHelp
+			}
+			if *filterRating != 0 && (*filterRating < 1 || *filterRating > 5) {
+				fmt.Fprintln(os.Stderr, "Error: --filter-rating must be between 1 and 5")
+				return flag.ErrHelp
+			}
+			if !*dryRun && !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+			tmpl, err := loadResponseTemplate(*templatePath)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews respond-batch: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			fetch := func(ctx context.Context, next string) (*asc.ReviewsResponse, error) {
+				if next != "" {
+					return client.GetReviews(ctx, resolvedAppID, asc.WithNextURL(next))
+				}
+				opts := []asc.ReviewOption{
+					asc.WithRating(*filterRating),
+					asc.WithTerritory(strings.TrimSpace(*territory)),
+					asc.WithReviewSort("-createdDate"),
+					asc.WithReviewInclude([]string{"response"}),
+					asc.WithLimit(200),
+				}
+				if *unansweredOnly {
+					opts = append(opts, asc.WithReviewPublishedResponse(false))
+				}
+				return client.GetReviews(ctx, resolvedAppID, opts...)
+			}
+
+			result := &asc.CustomerReviewResponseBatchResult{
+				AppID:   resolvedAppID,
+				DryRun:  *dryRun,
+				Reviews: []asc.CustomerReviewResponseBatchItem{},
+			}
+			err = walkReviewsInWindow(requestCtx, fetch, reviewWindow{}, func(_ *asc.ReviewsResponse, reviews []asc.Resource[asc.ReviewAttributes]) error {
+				for _, review := range reviews {
+					// Pending responses are not excluded by exists[publishedResponse].
+					if *unansweredOnly && reviewResponseID(review) != "" {
+						continue
+					}
+					text, err := renderResponseTemplate(tmpl, review)
+					if err != nil {
+						return err
+					}
+					result.Reviews = append(result.Reviews, asc.CustomerReviewResponseBatchItem{
+						ReviewID:         review.ID,
+						Rating:           review.Attributes.Rating,
+						Territory:        review.Attributes.Territory,
+						ReviewerNickname: review.Attributes.ReviewerNickname,
+						Response:         text,
+					})
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("reviews respond-batch: %w", err)
+			}
+			result.Total = len(result.Reviews)
+			if *dryRun {
+				return shared.PrintOutput(result, *output, *pretty)
+			}
+
+			for i := range result.Reviews {
+				item := &result.Reviews[i]
+				resp, err := client.CreateCustomerReviewResponse(requestCtx, item.ReviewID, item.Response)
+				if err != nil {
+					item.Error = err.Error()
+					result.Failed++
+					continue
+				}
+				item.ResponseID = resp.Data.ID
+				item.Responded = true
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return fmt.Errorf("reviews respond-batch: %d of %d responses failed", result.Failed, result.Total)
+			}
+			return nil
+		},
+	}
+}
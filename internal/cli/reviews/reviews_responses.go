@@ -5,7 +5,9 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"text/template"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -18,7 +20,10 @@ func ReviewsRespondCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("respond", flag.ExitOnError)
 
 	reviewID := fs.String("review-id", "", "Customer review ID (required)")
-	response := fs.String("response", "", "Response body text (required)")
+	review := fs.String("review", "", "Customer review ID (alias of --review-id)")
+	response := fs.String("response", "", "Response body text (required unless --template)")
+	body := fs.String("body", "", "Response body text (alias of --response)")
+	templatePath := fs.String("template", "", "Path to a response template with review placeholders such as {{.ReviewerNickname}} and {{.Rating}}")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -29,20 +34,38 @@ func ReviewsRespondCommand() *ffcli.Command {
 		LongHelp: `Create a response to a customer review.
 
 This command creates a developer response to a customer review on the App Store.
-Responses are visible to all App Store users.
+Responses are visible to all App Store users once published; until then the
+response state is PENDING_PUBLISH.
+
+With --template, the response is rendered from a Go text/template file using
+the review's fields: {{.ReviewerNickname}}, {{.Rating}}, {{.Title}},
+{{.Body}}, {{.Territory}}, and {{.CreatedDate}}.
 
 Examples:
   asc reviews respond --review-id "REVIEW_ID" --response "Thanks for your feedback!"
+  asc reviews respond --review "REVIEW_ID" --body "Thanks for your feedback!"
+  asc reviews respond --review-id "REVIEW_ID" --template ./thanks.tmpl
   asc reviews respond --review-id "REVIEW_ID" --response "We appreciate your review." --output table`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			if strings.TrimSpace(*reviewID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --review-id is required")
-				return flag.ErrHelp
+			var reviewValue, responseValue string
+			var tmpl *template.Template
+			var err error
+			if strings.TrimSpace(*templatePath) != "" {
+				if strings.TrimSpace(*response) != "" || strings.TrimSpace(*body) != "" {
+					fmt.Fprintln(os.Stderr, "Error: --template cannot be used with --response or --body")
+					return flag.ErrHelp
+				}
+				reviewValue, err = reviewIDFlag(*reviewID, *review)
+				if err == nil {
+					tmpl, err = loadResponseTemplate(*templatePath)
+				}
+			} else {
+				reviewValue, responseValue, err = reviewResponseFlags(*reviewID, *review, *response, *body)
 			}
-			if strings.TrimSpace(*response) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --response is required")
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				return flag.ErrHelp
 			}
 
@@ -54,7 +77,18 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resp, err := client.CreateCustomerReviewResponse(requestCtx, strings.TrimSpace(*reviewID), strings.TrimSpace(*response))
+			if tmpl != nil {
+				reviewResp, err := client.GetCustomerReview(requestCtx, reviewValue)
+				if err != nil {
+					return fmt.Errorf("reviews respond: failed to fetch review: %w", err)
+				}
+				responseValue, err = renderResponseTemplate(tmpl, reviewResp.Data)
+				if err != nil {
+					return fmt.Errorf("reviews respond: %w", err)
+				}
+			}
+
+			resp, err := client.CreateCustomerReviewResponse(requestCtx, reviewValue, responseValue)
 			if err != nil {
 				return fmt.Errorf("reviews respond: failed to create response: %w", err)
 			}
@@ -76,12 +110,15 @@ func ReviewsResponseCommand() *ffcli.Command {
 
 Examples:
   asc reviews response get --id "RESPONSE_ID"
+  asc reviews response update --review-id "REVIEW_ID" --response "Updated reply"
   asc reviews response delete --id "RESPONSE_ID" --confirm
+  asc reviews response delete --review-id "REVIEW_ID" --confirm
   asc reviews response for-review --review-id "REVIEW_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			ReviewsResponseGetCommand(),
+			ReviewsResponseUpdateCommand(),
 			ReviewsResponseDeleteCommand(),
 			ReviewsResponseForReviewCommand(),
 		},
@@ -134,30 +171,95 @@ Examples:
 	}
 }
 
+// ReviewsResponseUpdateCommand returns the reviews response update subcommand.
+func ReviewsResponseUpdateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("update", flag.ExitOnError)
+
+	reviewID := fs.String("review-id", "", "Customer review ID (required)")
+	review := fs.String("review", "", "Customer review ID (alias of --review-id)")
+	response := fs.String("response", "", "New response body text (required)")
+	body := fs.String("body", "", "New response body text (alias of --response)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "update",
+		ShortUsage: "asc reviews response update [flags]",
+		ShortHelp:  "Edit the response to a customer review.",
+		LongHelp: `Edit the developer response to a customer review.
+
+The API has no edit endpoint: the new text replaces the existing response and
+goes through publication again (state PENDING_PUBLISH).
+
+Examples:
+  asc reviews response update --review-id "REVIEW_ID" --response "Fixed in 2.1, thanks!"
+  asc reviews response update --review "REVIEW_ID" --body "Fixed in 2.1, thanks!"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			reviewValue, responseValue, err := reviewResponseFlags(*reviewID, *review, *response, *body)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews response update: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if _, err := client.GetCustomerReviewResponseForReview(requestCtx, reviewValue); err != nil {
+				if asc.IsNotFound(err) {
+					return fmt.Errorf("reviews response update: review %s has no response; use reviews respond", reviewValue)
+				}
+				return fmt.Errorf("reviews response update: failed to fetch existing response: %w", err)
+			}
+
+			resp, err := client.CreateCustomerReviewResponse(requestCtx, reviewValue, responseValue)
+			if err != nil {
+				return fmt.Errorf("reviews response update: failed to update response: %w", err)
+			}
+
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
 // ReviewsResponseDeleteCommand returns the reviews response delete subcommand.
 func ReviewsResponseDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
 
-	responseID := fs.String("id", "", "Customer review response ID (required)")
+	responseID := fs.String("id", "", "Customer review response ID")
+	reviewID := fs.String("review-id", "", "Customer review ID whose response to delete (alternative to --id)")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "delete",
-		ShortUsage: "asc reviews response delete [flags]",
+		ShortUsage: "asc reviews response delete (--id RESPONSE_ID | --review-id REVIEW_ID) --confirm",
 		ShortHelp:  "Delete a customer review response.",
 		LongHelp: `Delete a customer review response.
 
 This action removes your response from the review and cannot be undone.
 
 Examples:
-  asc reviews response delete --id "RESPONSE_ID" --confirm`,
+  asc reviews response delete --id "RESPONSE_ID" --confirm
+  asc reviews response delete --review-id "REVIEW_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			if strings.TrimSpace(*responseID) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+			idValue := strings.TrimSpace(*responseID)
+			reviewValue := strings.TrimSpace(*reviewID)
+			if idValue == "" && reviewValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id or --review-id is required")
+				return flag.ErrHelp
+			}
+			if idValue != "" && reviewValue != "" {
+				fmt.Fprintln(os.Stderr, "Error: --id and --review-id are mutually exclusive")
 				return flag.ErrHelp
 			}
 			if !*confirm {
@@ -173,12 +275,23 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			if err := client.DeleteCustomerReviewResponse(requestCtx, strings.TrimSpace(*responseID)); err != nil {
+			if idValue == "" {
+				existing, err := client.GetCustomerReviewResponseForReview(requestCtx, reviewValue)
+				if err != nil {
+					if asc.IsNotFound(err) {
+						return fmt.Errorf("reviews response delete: review %s has no response", reviewValue)
+					}
+					return fmt.Errorf("reviews response delete: failed to fetch response: %w", err)
+				}
+				idValue = existing.Data.ID
+			}
+
+			if err := client.DeleteCustomerReviewResponse(requestCtx, idValue); err != nil {
 				return fmt.Errorf("reviews response delete: failed to delete: %w", err)
 			}
 
 			result := &asc.CustomerReviewResponseDeleteResult{
-				ID:      strings.TrimSpace(*responseID),
+				ID:      idValue,
 				Deleted: true,
 			}
 
@@ -231,3 +344,90 @@ Examples:
 		},
 	}
 }
+
+// reviewResponseFlags resolves the review ID and response text from their
+// flags and aliases.
+func reviewIDFlag(reviewID, review string) (string, error) {
+	reviewID, review = strings.TrimSpace(reviewID), strings.TrimSpace(review)
+	if reviewID != "" && review != "" {
+		return "", fmt.Errorf("--review-id and --review cannot be used together")
+	}
+	if reviewID == "" {
+		reviewID = review
+	}
+	if reviewID == "" {
+		return "", fmt.Errorf("--review-id is required")
+	}
+	return reviewID, nil
+}
+
+func reviewResponseFlags(reviewID, review, response, body string) (string, string, error) {
+	reviewID, review = strings.TrimSpace(reviewID), strings.TrimSpace(review)
+	response, body = strings.TrimSpace(response), strings.TrimSpace(body)
+	if reviewID != "" && review != "" {
+		return "", "", fmt.Errorf("--review-id and --review cannot be used together")
+	}
+	if response != "" && body != "" {
+		return "", "", fmt.Errorf("--response and --body cannot be used together")
+	}
+	if reviewID == "" {
+		reviewID = review
+	}
+	if response == "" {
+		response = body
+	}
+	if reviewID == "" {
+		return "", "", fmt.Errorf("--review-id is required")
+	}
+	if response == "" {
+		return "", "", fmt.Errorf("--response is required")
+	}
+	return reviewID, response, nil
+}
+
+// reviewTemplateData is the data available to response template placeholders.
+type reviewTemplateData struct {
+	ID               string
+	ReviewerNickname string
+	Rating           int
+	Title            string
+	Body             string
+	Territory        string
+	CreatedDate      string
+}
+
+// loadResponseTemplate parses a --template file. Unknown placeholders fail
+// when the template is rendered.
+func loadResponseTemplate(path string) (*template.Template, error) {
+	path = strings.TrimSpace(path)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("--template must be readable: %w", err)
+	}
+	tmpl, err := template.New(filepath.Base(path)).Option("missingkey=error").Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("--template is invalid: %w", err)
+	}
+	return tmpl, nil
+}
+
+func renderResponseTemplate(tmpl *template.Template, review asc.Resource[asc.ReviewAttributes]) (string, error) {
+	var rendered strings.Builder
+	err := tmpl.Execute(&rendered, reviewTemplateData{
+		ID:               review.ID,
+		ReviewerNickname: review.Attributes.ReviewerNickname,
+		Rating:           review.Attributes.Rating,
+		Title:            review.Attributes.Title,
+		Body:             review.Attributes.Body,
+		Territory:        review.Attributes.Territory,
+		CreatedDate:      review.Attributes.CreatedDate,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render template for review %s: %w", review.ID, err)
+	}
+	text := strings.TrimSpace(rendered.String())
+	if text == "" {
+		return "", fmt.Errorf("template rendered an empty response for review %s", review.ID)
+	}
+	return text, nil
+}
@@ -0,0 +1,283 @@
+package reviews
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// reviewStatsUnknownVersion groups reviews not linked to any App Store version.
+const reviewStatsUnknownVersion = "unknown"
+
+// ReviewsStatsCommand returns the reviews stats subcommand.
+func ReviewsStatsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	since := fs.String("since", "30d", "Start of the window: a date (YYYY-MM-DD) or a lookback like 30d, 2w, 72h")
+	until := fs.String("until", "", "Only reviews created before this date (YYYY-MM-DD, UTC, exclusive)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "stats",
+		ShortUsage: "asc reviews stats --app APP_ID [--since 30d] [--until DATE]",
+		ShortHelp:  "Summarize review counts and ratings by territory, star, and version.",
+		LongHelp: `Summarize customer reviews in a time window.
+
+Fetches every review in the window and computes the count, share, and
+average rating per territory, per star rating, and per App Store version.
+Version attribution comes from each version's review list; reviews not
+linked to a version are counted as "unknown".
+
+Examples:
+  asc reviews stats --app "123456789"
+  asc reviews stats --app "123456789" --since 90d --output table
+  asc reviews stats --app "123456789" --since 2025-01-01 --until 2025-02-01 --output csv`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			window, err := parseReviewStatsWindow(*since, *until, time.Now().UTC())
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews stats: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			reviews, err := collectWindowReviews(requestCtx, func(ctx context.Context, next string) (*asc.ReviewsResponse, error) {
+				if next != "" {
+					return client.GetReviews(ctx, resolvedAppID, asc.WithNextURL(next))
+				}
+				return client.GetReviews(ctx, resolvedAppID, asc.WithReviewSort("-createdDate"), asc.WithLimit(200))
+			}, window)
+			if err != nil {
+				return fmt.Errorf("reviews stats: %w", err)
+			}
+
+			versions, err := reviewVersions(requestCtx, client, resolvedAppID, window)
+			if err != nil {
+				return fmt.Errorf("reviews stats: %w", err)
+			}
+
+			result := buildReviewStats(reviews, versions)
+			result.AppID = resolvedAppID
+			result.Since = window.since.Format(time.RFC3339)
+			if !window.until.IsZero() {
+				result.Until = window.until.Format(time.RFC3339)
+			}
+			return shared.PrintOutputWithCSV(result, *output, *pretty)
+		},
+	}
+}
+
+// parseReviewStatsWindow accepts --since as a date or a lookback from now.
+func parseReviewStatsWindow(since, until string, now time.Time) (reviewWindow, error) {
+	sinceValue := strings.TrimSpace(since)
+	if sinceValue == "" {
+		return reviewWindow{}, fmt.Errorf("--since is required")
+	}
+	var window reviewWindow
+	if parsed, err := time.Parse("2006-01-02", sinceValue); err == nil {
+		window.since = parsed
+	} else {
+		lookback, err := shared.ParseWindow(sinceValue, "--since")
+		if err != nil {
+			return reviewWindow{}, fmt.Errorf("--since must be a date (YYYY-MM-DD) or a duration like 30d, 2w, or 72h")
+		}
+		window.since = now.Add(-lookback)
+	}
+	if strings.TrimSpace(until) != "" {
+		parsed, err := time.Parse("2006-01-02", strings.TrimSpace(until))
+		if err != nil {
+			return reviewWindow{}, fmt.Errorf("--until must be in YYYY-MM-DD format")
+		}
+		window.until = parsed
+	}
+	if !window.until.IsZero() && !window.since.Before(window.until) {
+		return reviewWindow{}, fmt.Errorf("--since must be before --until")
+	}
+	return window, nil
+}
+
+// reviewVersion is an App Store version with the IDs of its reviews in the window.
+type reviewVersion struct {
+	versionString string
+	platform      string
+	reviewIDs     map[string]bool
+}
+
+// reviewVersions lists the app's versions and which reviews in window
+// belong to each. Versions created after the window are skipped.
+func reviewVersions(ctx context.Context, client *asc.Client, appID string, window reviewWindow) ([]reviewVersion, error) {
+	firstPage, err := client.GetAppStoreVersions(ctx, appID, asc.WithAppStoreVersionsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch app store versions: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersions(ctx, appID, asc.WithAppStoreVersionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch app store versions: %w", err)
+	}
+	resp, ok := paginated.(*asc.AppStoreVersionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected app store versions response type %T", paginated)
+	}
+
+	versions := make([]reviewVersion, 0, len(resp.Data))
+	for _, version := range resp.Data {
+		if created, err := time.Parse(time.RFC3339, strings.TrimSpace(version.Attributes.CreatedDate)); err == nil && !window.until.IsZero() && !created.Before(window.until) {
+			continue
+		}
+		versionID := version.ID
+		reviews, err := collectWindowReviews(ctx, func(ctx context.Context, next string) (*asc.ReviewsResponse, error) {
+			if next != "" {
+				return client.GetAppStoreVersionCustomerReviews(ctx, versionID, asc.WithNextURL(next))
+			}
+			return client.GetAppStoreVersionCustomerReviews(ctx, versionID, asc.WithReviewSort("-createdDate"), asc.WithLimit(200))
+		}, window)
+		if err != nil {
+			return nil, fmt.Errorf("version %s: %w", version.Attributes.VersionString, err)
+		}
+		ids := make(map[string]bool, len(reviews))
+		for _, review := range reviews {
+			ids[review.ID] = true
+		}
+		versions = append(versions, reviewVersion{
+			versionString: version.Attributes.VersionString,
+			platform:      string(version.Attributes.Platform),
+			reviewIDs:     ids,
+		})
+	}
+	return versions, nil
+}
+
+func collectWindowReviews(ctx context.Context, fetch func(ctx context.Context, next string) (*asc.ReviewsResponse, error), window reviewWindow) ([]asc.Resource[asc.ReviewAttributes], error) {
+	var reviews []asc.Resource[asc.ReviewAttributes]
+	err := walkReviewsInWindow(ctx, fetch, window, func(_ *asc.ReviewsResponse, page []asc.Resource[asc.ReviewAttributes]) error {
+		reviews = append(reviews, page...)
+		return nil
+	})
+	return reviews, err
+}
+
+// reviewStatsAccumulator sums ratings for one bucket.
+type reviewStatsAccumulator struct {
+	count int
+	sum   int
+}
+
+func (a *reviewStatsAccumulator) add(rating int) {
+	a.count++
+	a.sum += rating
+}
+
+func (a reviewStatsAccumulator) bucket(key string, total int) asc.ReviewStatsBucket {
+	bucket := asc.ReviewStatsBucket{Key: key, Count: a.count}
+	if a.count > 0 {
+		bucket.AverageRating = roundReviewStat(float64(a.sum) / float64(a.count))
+	}
+	if total > 0 {
+		bucket.Percent = roundReviewStat(float64(a.count) * 100 / float64(total))
+	}
+	return bucket
+}
+
+func buildReviewStats(reviews []asc.Resource[asc.ReviewAttributes], versions []reviewVersion) *asc.ReviewStatsResult {
+	var all reviewStatsAccumulator
+	territories := make(map[string]*reviewStatsAccumulator)
+	ratings := make([]reviewStatsAccumulator, 6)
+	versionStats := make([]reviewStatsAccumulator, len(versions))
+	var unknown reviewStatsAccumulator
+
+	for _, review := range reviews {
+		rating := review.Attributes.Rating
+		all.add(rating)
+
+		territory := strings.TrimSpace(review.Attributes.Territory)
+		if territories[territory] == nil {
+			territories[territory] = &reviewStatsAccumulator{}
+		}
+		territories[territory].add(rating)
+
+		if rating >= 1 && rating <= 5 {
+			ratings[rating].add(rating)
+		}
+
+		matched := false
+		for i, version := range versions {
+			if version.reviewIDs[review.ID] {
+				versionStats[i].add(rating)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			unknown.add(rating)
+		}
+	}
+
+	total := all.count
+	result := &asc.ReviewStatsResult{
+		Total:         total,
+		AverageRating: all.bucket("", total).AverageRating,
+		ByTerritory:   make([]asc.ReviewStatsBucket, 0, len(territories)),
+		ByRating:      make([]asc.ReviewStatsBucket, 0, 5),
+		ByVersion:     make([]asc.ReviewStatsBucket, 0, len(versions)+1),
+	}
+
+	for territory, acc := range territories {
+		result.ByTerritory = append(result.ByTerritory, acc.bucket(territory, total))
+	}
+	sort.Slice(result.ByTerritory, func(i, j int) bool {
+		if result.ByTerritory[i].Count != result.ByTerritory[j].Count {
+			return result.ByTerritory[i].Count > result.ByTerritory[j].Count
+		}
+		return result.ByTerritory[i].Key < result.ByTerritory[j].Key
+	})
+
+	for rating := 5; rating >= 1; rating-- {
+		result.ByRating = append(result.ByRating, ratings[rating].bucket(strconv.Itoa(rating), total))
+	}
+
+	for i, version := range versions {
+		if versionStats[i].count == 0 {
+			continue
+		}
+		bucket := versionStats[i].bucket(version.versionString, total)
+		bucket.Platform = version.platform
+		result.ByVersion = append(result.ByVersion, bucket)
+	}
+	if unknown.count > 0 {
+		result.ByVersion = append(result.ByVersion, unknown.bucket(reviewStatsUnknownVersion, total))
+	}
+
+	return result
+}
+
+func roundReviewStat(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -1,6 +1,12 @@
 package reviews
 
-import "testing"
+import (
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
 
 func TestReviewsCommandConstructors(t *testing.T) {
 	top := ReviewsCommand()
@@ -23,6 +29,9 @@ func TestReviewsCommandConstructors(t *testing.T) {
 		func() interface{} { return ReviewsGetCommand() },
 		func() interface{} { return ReviewsRatingsCommand() },
 		func() interface{} { return ReviewsResponseCommand() },
+		func() interface{} { return ReviewsExportCommand() },
+		func() interface{} { return ReviewsWatchCommand() },
+		func() interface{} { return ReviewsStatsCommand() },
 		func() interface{} { return ReviewDetailsAttachmentsListCommand() },
 	}
 	for _, ctor := range constructors {
@@ -31,3 +40,92 @@ func TestReviewsCommandConstructors(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildReviewStats(t *testing.T) {
+	review := func(id string, rating int, territory string) asc.Resource[asc.ReviewAttributes] {
+		return asc.Resource[asc.ReviewAttributes]{ID: id, Attributes: asc.ReviewAttributes{Rating: rating, Territory: territory}}
+	}
+	reviews := []asc.Resource[asc.ReviewAttributes]{
+		review("r1", 5, "USA"),
+		review("r2", 1, "USA"),
+		review("r3", 4, "GBR"),
+		review("r4", 2, "USA"),
+	}
+	versions := []reviewVersion{
+		{versionString: "2.0", platform: "IOS", reviewIDs: map[string]bool{"r1": true, "r2": true}},
+		{versionString: "1.9", platform: "IOS", reviewIDs: map[string]bool{}},
+		{versionString: "1.8", platform: "IOS", reviewIDs: map[string]bool{"r3": true}},
+	}
+
+	result := buildReviewStats(reviews, versions)
+	if result.Total != 4 || result.AverageRating != 3 {
+		t.Fatalf("unexpected totals: %+v", result)
+	}
+	if len(result.ByTerritory) != 2 || result.ByTerritory[0].Key != "USA" || result.ByTerritory[0].Count != 3 || result.ByTerritory[0].AverageRating != 2.67 || result.ByTerritory[0].Percent != 75 {
+		t.Fatalf("unexpected territories: %+v", result.ByTerritory)
+	}
+	if len(result.ByRating) != 5 || result.ByRating[0].Key != "5" || result.ByRating[0].Count != 1 || result.ByRating[2].Key != "3" || result.ByRating[2].Count != 0 {
+		t.Fatalf("unexpected ratings: %+v", result.ByRating)
+	}
+	if len(result.ByVersion) != 3 {
+		t.Fatalf("expected 2.0, 1.8, and unknown, got %+v", result.ByVersion)
+	}
+	if result.ByVersion[0].Key != "2.0" || result.ByVersion[0].AverageRating != 3 || result.ByVersion[1].Key != "1.8" || result.ByVersion[2].Key != "unknown" || result.ByVersion[2].Count != 1 {
+		t.Fatalf("unexpected versions: %+v", result.ByVersion)
+	}
+}
+
+func TestParseReviewStatsWindow(t *testing.T) {
+	now := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	window, err := parseReviewStatsWindow("30d", "", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC); !window.since.Equal(want) || !window.until.IsZero() {
+		t.Fatalf("unexpected window: %+v", window)
+	}
+
+	window, err = parseReviewStatsWindow("2025-01-01", "2025-02-01", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !window.since.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) || !window.until.Equal(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected window: %+v", window)
+	}
+
+	for _, test := range []struct{ since, until string }{
+		{since: "", until: ""},
+		{since: "last month", until: ""},
+		{since: "30d", until: "Feb 1"},
+		{since: "2025-02-01", until: "2025-01-01"},
+	} {
+		if _, err := parseReviewStatsWindow(test.since, test.until, now); err == nil {
+			t.Fatalf("expected error for since=%q until=%q", test.since, test.until)
+		}
+	}
+}
+
+func TestRenderResponseTemplate(t *testing.T) {
+	review := asc.Resource[asc.ReviewAttributes]{ID: "r1", Attributes: asc.ReviewAttributes{Rating: 4, ReviewerNickname: "sam"}}
+
+	render := func(text string) (string, error) {
+		t.Helper()
+		tmpl, err := template.New("test").Option("missingkey=error").Parse(text)
+		if err != nil {
+			t.Fatalf("parse template: %v", err)
+		}
+		return renderResponseTemplate(tmpl, review)
+	}
+
+	got, err := render("  Thanks {{.ReviewerNickname}} ({{.Rating}}★)\n")
+	if err != nil || got != "Thanks sam (4★)" {
+		t.Fatalf("unexpected render %q: %v", got, err)
+	}
+	if _, err := render("Hi {{.Nickname}}"); err == nil {
+		t.Fatal("expected error for unknown placeholder")
+	}
+	if _, err := render("{{if gt .Rating 4}}Thanks!{{end}}"); err == nil {
+		t.Fatal("expected error for empty response")
+	}
+}
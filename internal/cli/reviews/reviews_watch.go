@@ -0,0 +1,160 @@
+package reviews
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// ReviewsWatchCommand returns the reviews watch subcommand.
+func ReviewsWatchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	minRatingBelow := fs.Int("min-rating-below", 0, "Only report reviews rated below this many stars (2-5)")
+	territory := fs.String("territory", "", "Filter by territory (e.g., US, GBR)")
+	interval := fs.Duration("interval", 10*time.Minute, "Time between polls")
+	maxPolls := fs.Int("max-polls", 0, "Stop after this many polls (0 watches until interrupted)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, slack-blocks, teams")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "watch",
+		ShortUsage: "asc reviews watch --app APP_ID [--min-rating-below N] [--interval 10m]",
+		ShortHelp:  "Poll for new reviews and print them as they arrive.",
+		LongHelp: `Poll for new customer reviews and print them as they arrive.
+
+The first poll records the reviews that already exist; after that, each
+poll prints only reviews that have not been seen before. Every batch of
+new reviews is printed as it is found, so JSON output is one document per
+batch.
+
+To post each batch to a chat webhook as well, use the root --webhook flag
+with --output json, slack-blocks, or teams.
+
+Errors after the first poll are reported on stderr and watching continues.
+
+Examples:
+  asc reviews watch --app "123456789" --min-rating-below 3 --interval 10m
+  asc reviews watch --app "123456789" --territory USA --output table
+  asc --webhook "https://hooks.slack.com/services/..." reviews watch --app "123456789" --min-rating-below 2 --output slack-blocks`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+			if *minRatingBelow != 0 && (*minRatingBelow < 2 || *minRatingBelow > 5) {
+				fmt.Fprintln(os.Stderr, "Error: --min-rating-below must be between 2 and 5")
+				return flag.ErrHelp
+			}
+			if *interval <= 0 {
+				fmt.Fprintln(os.Stderr, "Error: --interval must be greater than 0")
+				return flag.ErrHelp
+			}
+			if *maxPolls < 0 {
+				fmt.Fprintln(os.Stderr, "Error: --max-polls must be 0 or greater")
+				return flag.ErrHelp
+			}
+			switch strings.ToLower(*output) {
+			case "json", "table", "markdown", "md", shared.OutputFormatSlackBlocks, shared.OutputFormatTeams:
+			default:
+				fmt.Fprintf(os.Stderr, "Error: --output must be one of: json, table, markdown, %s, %s\n", shared.OutputFormatSlackBlocks, shared.OutputFormatTeams)
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews watch: %w", err)
+			}
+
+			watcher := &reviewWatcher{
+				fetch: func(ctx context.Context) (*asc.ReviewsResponse, error) {
+					requestCtx, cancel := shared.ContextWithTimeout(ctx)
+					defer cancel()
+					return client.GetReviews(requestCtx, resolvedAppID,
+						asc.WithTerritory(strings.TrimSpace(*territory)),
+						asc.WithReviewSort("-createdDate"),
+						asc.WithLimit(200),
+					)
+				},
+				minRatingBelow: *minRatingBelow,
+			}
+
+			if _, err := watcher.poll(ctx); err != nil {
+				return fmt.Errorf("reviews watch: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "Watching reviews for app %s every %s (%d existing reviews skipped)\n", resolvedAppID, *interval, len(watcher.seen))
+
+			for polls := 1; *maxPolls == 0 || polls < *maxPolls; polls++ {
+				select {
+				case <-ctx.Done():
+					return fmt.Errorf("reviews watch: %w", ctx.Err())
+				case <-time.After(*interval):
+				}
+
+				newReviews, err := watcher.poll(ctx)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to poll reviews: %v\n", err)
+					continue
+				}
+				if len(newReviews) == 0 {
+					continue
+				}
+				batch := &asc.ReviewsResponse{Data: newReviews}
+				if err := shared.PrintOutput(batch, *output, *pretty); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to report %d new reviews: %v\n", len(batch.Data), err)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// reviewWatcher tracks which reviews have been seen across polls.
+type reviewWatcher struct {
+	fetch          func(context.Context) (*asc.ReviewsResponse, error)
+	minRatingBelow int
+
+	seen map[string]bool
+}
+
+// poll fetches the newest reviews and returns the unseen ones that pass the
+// rating filter, oldest first. The first poll only records a baseline.
+func (w *reviewWatcher) poll(ctx context.Context) ([]asc.Resource[asc.ReviewAttributes], error) {
+	resp, err := w.fetch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch reviews: %w", err)
+	}
+
+	first := w.seen == nil
+	if first {
+		w.seen = make(map[string]bool)
+	}
+	var newReviews []asc.Resource[asc.ReviewAttributes]
+	for i := len(resp.Data) - 1; i >= 0; i-- {
+		review := resp.Data[i]
+		if w.seen[review.ID] {
+			continue
+		}
+		w.seen[review.ID] = true
+		if first {
+			continue
+		}
+		if w.minRatingBelow > 0 && review.Attributes.Rating >= w.minRatingBelow {
+			continue
+		}
+		newReviews = append(newReviews, review)
+	}
+	return newReviews, nil
+}
@@ -3,6 +3,7 @@ package sandbox
 import (
 	"context"
 	"flag"
+	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
@@ -24,6 +25,10 @@ Examples:
   asc sandbox get --id "SANDBOX_TESTER_ID"
   asc sandbox update --id "SANDBOX_TESTER_ID" --territory "USA"
   asc sandbox clear-history --id "SANDBOX_TESTER_ID" --confirm
+  asc sandbox clear-purchases --email "a@example.com,b@example.com" --confirm
+
+Sandbox testers are created in App Store Connect (Users and Access > Sandbox);
+the App Store Connect API does not support creating them.
 `,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -32,9 +37,20 @@ Examples:
 			SandboxGetCommand(),
 			SandboxUpdateCommand(),
 			SandboxClearHistoryCommand(),
+			SandboxClearPurchasesCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
 		},
 	}
 }
+
+// SandboxTestersCommand returns the sandbox-testers alias of the sandbox command.
+func SandboxTestersCommand() *ffcli.Command {
+	cmd := SandboxCommand()
+	cmd.Name = "sandbox-testers"
+	cmd.ShortUsage = "asc sandbox-testers <subcommand> [flags]"
+	cmd.ShortHelp = "Manage App Store Connect sandbox testers (alias of sandbox)."
+	cmd.LongHelp = strings.ReplaceAll(cmd.LongHelp, "asc sandbox ", "asc sandbox-testers ")
+	return cmd
+}
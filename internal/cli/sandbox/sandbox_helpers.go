@@ -49,18 +49,32 @@ var sandboxRenewalRates = map[string]asc.SandboxTesterSubscriptionRenewalRate{
 	string(asc.SandboxTesterRenewalEveryThreeMinutes):   asc.SandboxTesterRenewalEveryThreeMinutes,
 }
 
+// sandboxRenewalRateShorthands maps the renewal interval of a monthly
+// subscription to its renewal rate, so test matrices can say "5m" instead of
+// MONTHLY_RENEWAL_EVERY_FIVE_MINUTES.
+var sandboxRenewalRateShorthands = map[string]asc.SandboxTesterSubscriptionRenewalRate{
+	"1h":  asc.SandboxTesterRenewalEveryOneHour,
+	"30m": asc.SandboxTesterRenewalEveryThirtyMinutes,
+	"15m": asc.SandboxTesterRenewalEveryFifteenMinutes,
+	"5m":  asc.SandboxTesterRenewalEveryFiveMinutes,
+	"3m":  asc.SandboxTesterRenewalEveryThreeMinutes,
+}
+
 func normalizeSandboxRenewalRate(value string) (asc.SandboxTesterSubscriptionRenewalRate, error) {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
 		return "", nil
 	}
+	if rate, ok := sandboxRenewalRateShorthands[strings.ToLower(trimmed)]; ok {
+		return rate, nil
+	}
 	normalized := strings.ToUpper(trimmed)
 	normalized = strings.ReplaceAll(normalized, "-", "_")
 	normalized = strings.ReplaceAll(normalized, " ", "_")
 	if rate, ok := sandboxRenewalRates[normalized]; ok {
 		return rate, nil
 	}
-	return "", fmt.Errorf("--subscription-renewal-rate must be one of: %s", strings.Join(sandboxRenewalRateValues(), ", "))
+	return "", fmt.Errorf("--subscription-renewal-rate must be one of: %s (or 3m, 5m, 15m, 30m, 1h)", strings.Join(sandboxRenewalRateValues(), ", "))
 }
 
 func sandboxRenewalRateValues() []string {
@@ -3,6 +3,7 @@ package sandbox
 import (
 	"testing"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
@@ -41,6 +42,23 @@ func TestNormalizeSandboxRenewalRate(t *testing.T) {
 	}
 }
 
+func TestNormalizeSandboxRenewalRateShorthand(t *testing.T) {
+	tests := map[string]asc.SandboxTesterSubscriptionRenewalRate{
+		"5m":  asc.SandboxTesterRenewalEveryFiveMinutes,
+		"1H":  asc.SandboxTesterRenewalEveryOneHour,
+		" 3m": asc.SandboxTesterRenewalEveryThreeMinutes,
+	}
+	for input, want := range tests {
+		got, err := normalizeSandboxRenewalRate(input)
+		if err != nil || got != want {
+			t.Fatalf("normalizeSandboxRenewalRate(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+	if _, err := normalizeSandboxRenewalRate("10m"); err == nil {
+		t.Fatalf("expected error for unsupported interval")
+	}
+}
+
 func TestOptionalBool(t *testing.T) {
 	var value shared.OptionalBool
 	if value.IsSet() {
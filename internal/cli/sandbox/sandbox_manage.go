@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -71,10 +72,10 @@ Examples:
 func SandboxUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("update", flag.ExitOnError)
 
-	testerID := fs.String("id", "", "Sandbox tester ID")
-	email := fs.String("email", "", "Tester email address")
+	testerIDs := fs.String("id", "", "Sandbox tester ID(s), comma-separated")
+	emails := fs.String("email", "", "Tester email address(es), comma-separated")
 	territory := fs.String("territory", "", "App Store territory code (e.g., USA, JPN)")
-	subscriptionRenewalRate := fs.String("subscription-renewal-rate", "", "Subscription renewal rate (MONTHLY_RENEWAL_EVERY_ONE_HOUR, MONTHLY_RENEWAL_EVERY_THIRTY_MINUTES, MONTHLY_RENEWAL_EVERY_FIFTEEN_MINUTES, MONTHLY_RENEWAL_EVERY_FIVE_MINUTES, MONTHLY_RENEWAL_EVERY_THREE_MINUTES)")
+	subscriptionRenewalRate := fs.String("subscription-renewal-rate", "", "Subscription renewal rate (MONTHLY_RENEWAL_EVERY_ONE_HOUR, MONTHLY_RENEWAL_EVERY_THIRTY_MINUTES, MONTHLY_RENEWAL_EVERY_FIFTEEN_MINUTES, MONTHLY_RENEWAL_EVERY_FIVE_MINUTES, MONTHLY_RENEWAL_EVERY_THREE_MINUTES, or 1h, 30m, 15m, 5m, 3m)")
 	var interruptPurchases shared.OptionalBool
 	fs.Var(&interruptPurchases, "interrupt-purchases", "Interrupt purchases (true/false)")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
@@ -83,22 +84,29 @@ func SandboxUpdateCommand() *ffcli.Command {
 	return &ffcli.Command{
 		Name:       "update",
 		ShortUsage: "asc sandbox update [flags]",
-		ShortHelp:  "Update a sandbox tester.",
+		ShortHelp:  "Update sandbox testers.",
 		LongHelp: `Update sandbox tester settings (v2 API).
 
+The same settings are applied to every tester selected by --id/--email,
+which makes it easy to reset a StoreKit test matrix before each run.
+
 Examples:
   asc sandbox update --id "SANDBOX_TESTER_ID" --territory "USA"
   asc sandbox update --email "tester@example.com" --interrupt-purchases
-  asc sandbox update --id "SANDBOX_TESTER_ID" --subscription-renewal-rate "MONTHLY_RENEWAL_EVERY_ONE_HOUR"`,
+  asc sandbox update --email "tester@example.com" --interrupt-purchases=false
+  asc sandbox update --id "SANDBOX_TESTER_ID" --subscription-renewal-rate "MONTHLY_RENEWAL_EVERY_ONE_HOUR"
+  asc sandbox update --email "a@example.com,b@example.com" --subscription-renewal-rate 5m --interrupt-purchases=false`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			if strings.TrimSpace(*testerID) == "" && strings.TrimSpace(*email) == "" {
+			ids := shared.SplitCSV(*testerIDs)
+			emailValues := shared.SplitCSV(*emails)
+			if len(ids) == 0 && len(emailValues) == 0 {
 				fmt.Fprintln(os.Stderr, "Error: --id or --email is required")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*email) != "" {
-				if err := validateSandboxEmail(*email); err != nil {
+			for _, email := range emailValues {
+				if err := validateSandboxEmail(email); err != nil {
 					return fmt.Errorf("sandbox update: %w", err)
 				}
 			}
@@ -125,12 +133,14 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resolvedID := strings.TrimSpace(*testerID)
-			if resolvedID == "" {
-				resolvedID, err = findSandboxTesterIDByEmail(requestCtx, client, strings.TrimSpace(*email))
+			for _, email := range emailValues {
+				resolvedID, err := findSandboxTesterIDByEmail(requestCtx, client, email)
 				if err != nil {
 					return fmt.Errorf("sandbox update: %w", err)
 				}
+				if !slices.Contains(ids, resolvedID) {
+					ids = append(ids, resolvedID)
+				}
 			}
 
 			attrs := asc.SandboxTesterUpdateAttributes{}
@@ -147,15 +157,22 @@ Examples:
 				attrs.SubscriptionRenewalRate = &rateValue
 			}
 
-			resp, err := client.UpdateSandboxTester(requestCtx, resolvedID, attrs)
-			if err != nil {
-				if asc.IsNotFound(err) {
-					return fmt.Errorf("sandbox update: sandbox tester update is not available via the App Store Connect API for this account")
+			updated := &asc.SandboxTestersResponse{}
+			for _, id := range ids {
+				resp, err := client.UpdateSandboxTester(requestCtx, id, attrs)
+				if err != nil {
+					if asc.IsNotFound(err) {
+						return fmt.Errorf("sandbox update: sandbox tester update is not available via the App Store Connect API for this account")
+					}
+					return fmt.Errorf("sandbox update: %w", err)
 				}
-				return fmt.Errorf("sandbox update: %w", err)
+				if len(ids) == 1 {
+					return shared.PrintOutput(resp, *output, *pretty)
+				}
+				updated.Data = append(updated.Data, resp.Data)
 			}
 
-			return shared.PrintOutput(resp, *output, *pretty)
+			return shared.PrintOutput(updated, *output, *pretty)
 		},
 	}
 }
@@ -164,8 +181,8 @@ Examples:
 func SandboxClearHistoryCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("clear-history", flag.ExitOnError)
 
-	testerID := fs.String("id", "", "Sandbox tester ID")
-	email := fs.String("email", "", "Tester email address")
+	testerIDs := fs.String("id", "", "Sandbox tester ID(s), comma-separated")
+	emails := fs.String("email", "", "Tester email address(es), comma-separated")
 	confirm := fs.Bool("confirm", false, "Confirm clearing purchase history")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
@@ -174,11 +191,14 @@ func SandboxClearHistoryCommand() *ffcli.Command {
 		Name:       "clear-history",
 		ShortUsage: "asc sandbox clear-history [flags]",
 		ShortHelp:  "Clear sandbox tester purchase history.",
-		LongHelp: `Clear purchase history for a sandbox tester (v2 API).
+		LongHelp: `Clear purchase history for one or more sandbox testers (v2 API).
+
+All testers are cleared in a single request.
 
 Examples:
   asc sandbox clear-history --id "SANDBOX_TESTER_ID" --confirm
-  asc sandbox clear-history --email "tester@example.com" --confirm`,
+  asc sandbox clear-history --email "tester@example.com" --confirm
+  asc sandbox clear-history --email "a@example.com,b@example.com" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -186,12 +206,14 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*testerID) == "" && strings.TrimSpace(*email) == "" {
+			ids := shared.SplitCSV(*testerIDs)
+			emailValues := shared.SplitCSV(*emails)
+			if len(ids) == 0 && len(emailValues) == 0 {
 				fmt.Fprintln(os.Stderr, "Error: --id or --email is required")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*email) != "" {
-				if err := validateSandboxEmail(*email); err != nil {
+			for _, email := range emailValues {
+				if err := validateSandboxEmail(email); err != nil {
 					return fmt.Errorf("sandbox clear-history: %w", err)
 				}
 			}
@@ -204,15 +226,17 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resolvedID := strings.TrimSpace(*testerID)
-			if resolvedID == "" {
-				resolvedID, err = findSandboxTesterIDByEmail(requestCtx, client, strings.TrimSpace(*email))
+			for _, email := range emailValues {
+				resolvedID, err := findSandboxTesterIDByEmail(requestCtx, client, email)
 				if err != nil {
 					return fmt.Errorf("sandbox clear-history: %w", err)
 				}
+				if !slices.Contains(ids, resolvedID) {
+					ids = append(ids, resolvedID)
+				}
 			}
 
-			resp, err := client.ClearSandboxTesterPurchaseHistory(requestCtx, resolvedID)
+			resp, err := client.ClearSandboxTestersPurchaseHistory(requestCtx, ids)
 			if err != nil {
 				if asc.IsNotFound(err) {
 					return fmt.Errorf("sandbox clear-history: sandbox clear history is not available via the App Store Connect API for this account")
@@ -222,11 +246,25 @@ Examples:
 
 			result := &asc.SandboxTesterClearHistoryResult{
 				RequestID: resp.Data.ID,
-				TesterID:  resolvedID,
 				Cleared:   true,
 			}
+			if len(ids) == 1 {
+				result.TesterID = ids[0]
+			} else {
+				result.TesterIDs = ids
+			}
 
 			return shared.PrintOutput(result, *output, *pretty)
 		},
 	}
 }
+
+// SandboxClearPurchasesCommand returns the clear-purchases alias of clear-history.
+func SandboxClearPurchasesCommand() *ffcli.Command {
+	cmd := SandboxClearHistoryCommand()
+	cmd.Name = "clear-purchases"
+	cmd.ShortUsage = "asc sandbox clear-purchases [flags]"
+	cmd.ShortHelp = "Clear sandbox tester purchase history (alias of clear-history)."
+	cmd.LongHelp = strings.ReplaceAll(cmd.LongHelp, "asc sandbox clear-history ", "asc sandbox clear-purchases ")
+	return cmd
+}
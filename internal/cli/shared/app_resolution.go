@@ -46,3 +46,84 @@ func ResolveAppInfoID(ctx context.Context, client *asc.Client, appID, appInfoID
 	}
 	return resp.Data[0].ID, nil
 }
+
+// ResolveEditableAppInfoID returns appInfoID when set, otherwise the app info
+// that is editable for the next submission.
+func ResolveEditableAppInfoID(ctx context.Context, client *asc.Client, appID, appInfoID string) (string, error) {
+	if strings.TrimSpace(appInfoID) != "" {
+		return strings.TrimSpace(appInfoID), nil
+	}
+	appInfos, err := client.GetAppInfos(ctx, appID)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch app info: %w", err)
+	}
+	resolved := SelectBestAppInfoID(appInfos)
+	if resolved == "" {
+		return "", fmt.Errorf("no app info found for app %q", appID)
+	}
+	return resolved, nil
+}
+
+// SelectBestAppInfoID picks the app info that is editable for the next submission.
+func SelectBestAppInfoID(appInfos *asc.AppInfosResponse) string {
+	if appInfos == nil || len(appInfos.Data) == 0 {
+		return ""
+	}
+
+	// Some apps have multiple appInfos (e.g. READY_FOR_SALE plus PREPARE_FOR_SUBMISSION).
+	// Updating name/subtitle is only allowed in certain states, so prefer the one that is
+	// actively editable for a submission.
+	const target = "PREPARE_FOR_SUBMISSION"
+
+	var firstNonLive string
+	for _, info := range appInfos.Data {
+		state := strings.ToUpper(appInfoAttrString(info.Attributes, "state"))
+		appStoreState := strings.ToUpper(appInfoAttrString(info.Attributes, "appStoreState"))
+
+		if state == target || appStoreState == target {
+			return info.ID
+		}
+		if firstNonLive == "" && isNonLiveAppInfoState(state, appStoreState) {
+			firstNonLive = info.ID
+		}
+	}
+	if firstNonLive != "" {
+		return firstNonLive
+	}
+	return appInfos.Data[0].ID
+}
+
+func isNonLiveAppInfoState(state, appStoreState string) bool {
+	isLive := func(value string) bool {
+		switch value {
+		case "READY_FOR_DISTRIBUTION", "READY_FOR_SALE":
+			return true
+		default:
+			return false
+		}
+	}
+
+	if state != "" && !isLive(state) {
+		return true
+	}
+	if appStoreState != "" && !isLive(appStoreState) {
+		return true
+	}
+	return false
+}
+
+func appInfoAttrString(attrs asc.AppInfoAttributes, key string) string {
+	if attrs == nil {
+		return ""
+	}
+	v, ok := attrs[key]
+	if !ok || v == nil {
+		return ""
+	}
+	switch t := v.(type) {
+	case string:
+		return strings.TrimSpace(t)
+	default:
+		return strings.TrimSpace(fmt.Sprint(t))
+	}
+}
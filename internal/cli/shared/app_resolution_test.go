@@ -0,0 +1,88 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestSelectBestAppInfoID_PrefersPrepareForSubmission(t *testing.T) {
+	appInfos := &asc.AppInfosResponse{
+		Data: []asc.Resource[asc.AppInfoAttributes]{
+			{
+				ID: "ready",
+				Attributes: asc.AppInfoAttributes{
+					"state":         "READY_FOR_DISTRIBUTION",
+					"appStoreState": "READY_FOR_SALE",
+				},
+			},
+			{
+				ID: "prep",
+				Attributes: asc.AppInfoAttributes{
+					"state":         "PREPARE_FOR_SUBMISSION",
+					"appStoreState": "PREPARE_FOR_SUBMISSION",
+				},
+			},
+		},
+	}
+
+	if got := SelectBestAppInfoID(appInfos); got != "prep" {
+		t.Fatalf("expected appInfoID %q, got %q", "prep", got)
+	}
+}
+
+func TestSelectBestAppInfoID_FallsBackToNonReadyForSale(t *testing.T) {
+	appInfos := &asc.AppInfosResponse{
+		Data: []asc.Resource[asc.AppInfoAttributes]{
+			{
+				ID: "ready",
+				Attributes: asc.AppInfoAttributes{
+					"appStoreState": "READY_FOR_SALE",
+				},
+			},
+			{
+				ID: "not-ready",
+				Attributes: asc.AppInfoAttributes{
+					"appStoreState": "DEVELOPER_REMOVED_FROM_SALE",
+				},
+			},
+		},
+	}
+
+	if got := SelectBestAppInfoID(appInfos); got != "not-ready" {
+		t.Fatalf("expected appInfoID %q, got %q", "not-ready", got)
+	}
+}
+
+func TestSelectBestAppInfoID_EmptyInput(t *testing.T) {
+	if got := SelectBestAppInfoID(nil); got != "" {
+		t.Fatalf("expected empty appInfoID for nil input, got %q", got)
+	}
+
+	if got := SelectBestAppInfoID(&asc.AppInfosResponse{}); got != "" {
+		t.Fatalf("expected empty appInfoID for empty input, got %q", got)
+	}
+}
+
+func TestSelectBestAppInfoID_UsesStateWhenAppStoreStateMissing(t *testing.T) {
+	appInfos := &asc.AppInfosResponse{
+		Data: []asc.Resource[asc.AppInfoAttributes]{
+			{
+				ID: "live",
+				Attributes: asc.AppInfoAttributes{
+					"state": "READY_FOR_DISTRIBUTION",
+				},
+			},
+			{
+				ID: "editable",
+				Attributes: asc.AppInfoAttributes{
+					"state": "IN_REVIEW",
+				},
+			},
+		},
+	}
+
+	if got := SelectBestAppInfoID(appInfos); got != "editable" {
+		t.Fatalf("expected appInfoID %q, got %q", "editable", got)
+	}
+}
@@ -29,12 +29,12 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	territory := fs.String("territory", "", "Territory IDs (comma-separated, e.g., USA,GBR)")
+	all := fs.Bool("all", false, "Apply to every App Store territory (mutually exclusive with --territory)")
+	except := fs.String("except", "", "Territory IDs to exclude when using --all; they receive the opposite availability")
 	var available OptionalBool
 	fs.Var(&available, "available", "Set availability: true or false")
 	var availableInNewTerritories OptionalBool
-	if config.IncludeAvailableInNewTerritories {
-		fs.Var(&availableInNewTerritories, "available-in-new-territories", "Set availability for new territories: true or false")
-	}
+	fs.Var(&availableInNewTerritories, "available-in-new-territories", "Set availability for new territories: true or false")
 	output := fs.String("output", DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -51,8 +51,16 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
 				return flag.ErrHelp
 			}
-			if strings.TrimSpace(*territory) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --territory is required")
+			if *all && strings.TrimSpace(*territory) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --all and --territory are mutually exclusive")
+				return flag.ErrHelp
+			}
+			if !*all && strings.TrimSpace(*except) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --except requires --all")
+				return flag.ErrHelp
+			}
+			if !*all && strings.TrimSpace(*territory) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --territory or --all is required")
 				return flag.ErrHelp
 			}
 			if !available.IsSet() {
@@ -65,7 +73,7 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 			}
 
 			territories := splitCSVUpper(*territory)
-			if len(territories) == 0 {
+			if !*all && len(territories) == 0 {
 				fmt.Fprintln(os.Stderr, "Error: --territory must include at least one value")
 				return flag.ErrHelp
 			}
@@ -78,18 +86,30 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 			requestCtx, cancel := contextWithTimeout(ctx)
 			defer cancel()
 
-			availabilities := make([]asc.TerritoryAvailabilityCreate, 0, len(territories))
-			for _, territoryID := range territories {
-				availabilities = append(availabilities, asc.TerritoryAvailabilityCreate{
-					TerritoryID: territoryID,
-					Available:   available.Value(),
-				})
+			var availabilities []asc.TerritoryAvailabilityCreate
+			if *all {
+				allTerritories, err := fetchAllTerritoryIDs(requestCtx, client)
+				if err != nil {
+					return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
+				}
+				availabilities, err = buildAllTerritoryAvailabilities(allTerritories, splitCSVUpper(*except), available.Value())
+				if err != nil {
+					return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
+				}
+			} else {
+				availabilities = make([]asc.TerritoryAvailabilityCreate, 0, len(territories))
+				for _, territoryID := range territories {
+					availabilities = append(availabilities, asc.TerritoryAvailabilityCreate{
+						TerritoryID: territoryID,
+						Available:   available.Value(),
+					})
+				}
 			}
 
 			attributes := asc.AppAvailabilityV2CreateAttributes{
 				TerritoryAvailabilities: availabilities,
 			}
-			if config.IncludeAvailableInNewTerritories {
+			if availableInNewTerritories.IsSet() {
 				availableInNewTerritoriesValue := availableInNewTerritories.Value()
 				attributes.AvailableInNewTerritories = &availableInNewTerritoriesValue
 			}
@@ -103,3 +123,58 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 		},
 	}
 }
+
+func fetchAllTerritoryIDs(ctx context.Context, client *asc.Client) ([]string, error) {
+	firstPage, err := client.GetTerritories(ctx, asc.WithTerritoriesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch territories: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetTerritories(ctx, asc.WithTerritoriesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch territories: %w", err)
+	}
+	resp, ok := paginated.(*asc.TerritoriesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected territories response")
+	}
+
+	ids := make([]string, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		if id := strings.ToUpper(strings.TrimSpace(item.ID)); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// buildAllTerritoryAvailabilities sets every territory to available, except
+// the excluded territories which receive the opposite value.
+func buildAllTerritoryAvailabilities(territories, except []string, available bool) ([]asc.TerritoryAvailabilityCreate, error) {
+	known := make(map[string]bool, len(territories))
+	for _, territoryID := range territories {
+		known[territoryID] = true
+	}
+	excluded := make(map[string]bool, len(except))
+	unknown := make([]string, 0)
+	for _, territoryID := range except {
+		if !known[territoryID] {
+			unknown = append(unknown, territoryID)
+			continue
+		}
+		excluded[territoryID] = true
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown territories in --except: %s", strings.Join(unknown, ", "))
+	}
+
+	availabilities := make([]asc.TerritoryAvailabilityCreate, 0, len(territories))
+	for _, territoryID := range territories {
+		availabilities = append(availabilities, asc.TerritoryAvailabilityCreate{
+			TerritoryID: territoryID,
+			Available:   available != excluded[territoryID],
+		})
+	}
+	return availabilities, nil
+}
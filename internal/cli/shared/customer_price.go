@@ -0,0 +1,17 @@
+package shared
+
+import (
+	"math/big"
+	"strings"
+)
+
+// SameCustomerPrice compares customer prices numerically, so "5", "5.0" and
+// "5.00" are equal. Prices that are not numbers never match.
+func SameCustomerPrice(a, b string) bool {
+	left, ok := new(big.Rat).SetString(strings.TrimSpace(a))
+	if !ok {
+		return false
+	}
+	right, ok := new(big.Rat).SetString(strings.TrimSpace(b))
+	return ok && left.Cmp(right) == 0
+}
@@ -0,0 +1,21 @@
+package shared
+
+import "testing"
+
+func TestSameCustomerPrice(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"5", "5.00", true},
+		{" 0.99", "0.990 ", true},
+		{"4.99", "5.00", false},
+		{"abc", "abc", false},
+		{"", "0", false},
+	}
+	for _, test := range tests {
+		if got := SameCustomerPrice(test.a, test.b); got != test.want {
+			t.Fatalf("SameCustomerPrice(%q, %q) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseExpiryWindow parses a window in days (30d), weeks (2w), or any Go
+// duration (72h).
+func ParseExpiryWindow(value string) (time.Duration, error) {
+	return ParseWindow(value, "--expiring-within")
+}
+
+// ParseWindow parses the value of flagName as days (30d), weeks (2w), or
+// any Go duration (72h).
+func ParseWindow(value, flagName string) (time.Duration, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(value))
+	if trimmed == "" {
+		return 0, fmt.Errorf("%s is required", flagName)
+	}
+	unit := trimmed[len(trimmed)-1]
+	if unit == 'd' || unit == 'w' {
+		count, err := strconv.Atoi(trimmed[:len(trimmed)-1])
+		if err != nil || count < 0 {
+			return 0, fmt.Errorf("%s must be a duration like 30d, 2w, or 72h", flagName)
+		}
+		days := count
+		if unit == 'w' {
+			days *= 7
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	duration, err := time.ParseDuration(trimmed)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("%s must be a duration like 30d, 2w, or 72h", flagName)
+	}
+	return duration, nil
+}
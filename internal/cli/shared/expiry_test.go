@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseExpiryWindow(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "30d", want: 30 * 24 * time.Hour},
+		{value: "2W", want: 14 * 24 * time.Hour},
+		{value: "72h", want: 72 * time.Hour},
+		{value: "0d", want: 0},
+		{value: "", wantErr: true},
+		{value: "-1d", wantErr: true},
+		{value: "soon", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseExpiryWindow(test.value)
+		if (err != nil) != test.wantErr {
+			t.Fatalf("%q: unexpected error %v", test.value, err)
+		}
+		if got != test.want {
+			t.Fatalf("%q: expected %v, got %v", test.value, test.want, got)
+		}
+	}
+}
+
+func TestParseWindowUsesFlagName(t *testing.T) {
+	if _, err := ParseWindow("soon", "--since"); err == nil || err.Error() != "--since must be a duration like 30d, 2w, or 72h" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := ParseExpiryWindow(""); err == nil || err.Error() != "--expiring-within is required" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
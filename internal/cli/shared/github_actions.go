@@ -0,0 +1,202 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// OutputFormatGitHubActions is the --output value that emits GitHub Actions
+// workflow commands alongside JSON.
+const OutputFormatGitHubActions = "gha"
+
+// GitHubAnnotation is a workflow annotation shown on the run summary.
+type GitHubAnnotation = asc.GitHubAnnotation
+
+// GitHubAnnotator is implemented by results that carry validation findings.
+// With --output gha, each finding is emitted as a workflow annotation.
+type GitHubAnnotator interface {
+	GitHubAnnotations() []GitHubAnnotation
+}
+
+// InGitHubActions reports whether the CLI is running in a GitHub Actions job.
+func InGitHubActions() bool {
+	return strings.EqualFold(strings.TrimSpace(os.Getenv("GITHUB_ACTIONS")), "true")
+}
+
+// WriteGitHubErrorAnnotation prints err as an ::error workflow command to stderr.
+func WriteGitHubErrorAnnotation(title string, err error) {
+	if err == nil {
+		return
+	}
+	writeGitHubAnnotation(os.Stderr, GitHubAnnotation{Level: "error", Title: title, Message: err.Error()})
+}
+
+// renderGitHubActions prints data as JSON and, when the runner provides them,
+// appends a Markdown step summary and writes *Id fields to GITHUB_OUTPUT.
+func renderGitHubActions(data interface{}, pretty bool) error {
+	if annotator, ok := data.(GitHubAnnotator); ok {
+		for _, annotation := range annotator.GitHubAnnotations() {
+			writeGitHubAnnotation(os.Stderr, annotation)
+		}
+	}
+
+	if pretty {
+		if err := asc.PrintPrettyJSON(data); err != nil {
+			return err
+		}
+	} else if err := asc.PrintJSON(data); err != nil {
+		return err
+	}
+
+	if path := strings.TrimSpace(os.Getenv("GITHUB_STEP_SUMMARY")); path != "" {
+		if err := appendGitHubStepSummary(path, data); err != nil {
+			return fmt.Errorf("write GitHub step summary: %w", err)
+		}
+	}
+	if path := strings.TrimSpace(os.Getenv("GITHUB_OUTPUT")); path != "" {
+		if err := appendGitHubOutputs(path, data); err != nil {
+			return fmt.Errorf("write GitHub outputs: %w", err)
+		}
+	}
+	return nil
+}
+
+func writeGitHubAnnotation(w io.Writer, annotation GitHubAnnotation) {
+	level := annotation.Level
+	switch level {
+	case "error", "warning", "notice":
+	default:
+		level = "notice"
+	}
+	properties := ""
+	if annotation.Title != "" {
+		properties = " title=" + escapeGitHubProperty(annotation.Title)
+	}
+	fmt.Fprintf(w, "::%s%s::%s\n", level, properties, escapeGitHubData(annotation.Message))
+}
+
+// escapeGitHubData escapes a workflow command message.
+func escapeGitHubData(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(value)
+}
+
+// escapeGitHubProperty escapes a workflow command property value.
+func escapeGitHubProperty(value string) string {
+	return strings.NewReplacer(":", "%3A", ",", "%2C").Replace(escapeGitHubData(value))
+}
+
+func appendGitHubStepSummary(path string, data interface{}) error {
+	var buf bytes.Buffer
+	rendered, err := asc.WriteMarkdown(&buf, data)
+	if err != nil {
+		return err
+	}
+	if !rendered {
+		encoded, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return err
+		}
+		buf.WriteString("```json\n")
+		buf.Write(encoded)
+		buf.WriteString("\n```\n")
+	}
+	buf.WriteString("\n")
+	return appendToFile(path, buf.Bytes())
+}
+
+// appendGitHubOutputs writes top-level string fields ending in "Id" as
+// snake_case step outputs (buildId becomes build_id). For single-resource
+// responses the resource ID is written as id and <type>_id.
+func appendGitHubOutputs(path string, data interface{}) error {
+	outputs := gitHubOutputs(data)
+	if len(outputs) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(outputs))
+	for key := range outputs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, key := range keys {
+		value := outputs[key]
+		if strings.ContainsAny(value, "\r\n") {
+			delimiter := "ASC_OUTPUT_EOF"
+			fmt.Fprintf(&buf, "%s<<%s\n%s\n%s\n", key, delimiter, value, delimiter)
+			continue
+		}
+		fmt.Fprintf(&buf, "%s=%s\n", key, value)
+	}
+	return appendToFile(path, buf.Bytes())
+}
+
+func gitHubOutputs(data interface{}) map[string]string {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil
+	}
+
+	outputs := make(map[string]string)
+	for key, raw := range fields {
+		if !strings.HasSuffix(key, "Id") || key == "Id" {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(raw, &value); err == nil && value != "" {
+			outputs[snakeCase(key)] = value
+		}
+	}
+
+	if raw, ok := fields["data"]; ok {
+		var resource struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		}
+		if err := json.Unmarshal(raw, &resource); err == nil && resource.ID != "" {
+			outputs["id"] = resource.ID
+			if kind := strings.TrimSuffix(resource.Type, "s"); kind != "" {
+				outputs[snakeCase(kind)+"_id"] = resource.ID
+			}
+		}
+	}
+	return outputs
+}
+
+func snakeCase(value string) string {
+	var b strings.Builder
+	for i, r := range value {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func appendToFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,128 @@
+package shared
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type annotatedResult struct {
+	BuildID   string `json:"buildId"`
+	VersionID string `json:"versionId"`
+	Name      string `json:"name"`
+}
+
+func (annotatedResult) GitHubAnnotations() []GitHubAnnotation {
+	return []GitHubAnnotation{
+		{Level: "error", Title: "en-US: description", Message: "too long\nby 10"},
+		{Level: "warning", Message: "100% full"},
+	}
+}
+
+func TestRenderOutputGitHubActions(t *testing.T) {
+	dir := t.TempDir()
+	summaryPath := filepath.Join(dir, "summary.md")
+	outputPath := filepath.Join(dir, "output.txt")
+	t.Setenv("GITHUB_STEP_SUMMARY", summaryPath)
+	t.Setenv("GITHUB_OUTPUT", outputPath)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := renderOutput(annotatedResult{BuildID: "build-1", VersionID: "version-1", Name: "x"}, "gha", false); err != nil {
+			t.Fatalf("renderOutput() error: %v", err)
+		}
+	})
+
+	if strings.TrimSpace(stdout) != `{"buildId":"build-1","versionId":"version-1","name":"x"}` {
+		t.Fatalf("expected JSON on stdout, got %q", stdout)
+	}
+	wantAnnotations := "::error title=en-US%3A description::too long%0Aby 10\n::warning::100%25 full\n"
+	if stderr != wantAnnotations {
+		t.Fatalf("unexpected annotations:\n got %q\nwant %q", stderr, wantAnnotations)
+	}
+
+	summary, err := os.ReadFile(summaryPath)
+	if err != nil {
+		t.Fatalf("read summary: %v", err)
+	}
+	if !strings.Contains(string(summary), "```json\n") || !strings.Contains(string(summary), `"buildId": "build-1"`) {
+		t.Fatalf("expected JSON summary fallback, got %q", summary)
+	}
+
+	outputs, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read outputs: %v", err)
+	}
+	if string(outputs) != "build_id=build-1\nversion_id=version-1\n" {
+		t.Fatalf("unexpected outputs: %q", outputs)
+	}
+}
+
+func TestRenderOutputGitHubActionsRegisteredTypeAndResource(t *testing.T) {
+	dir := t.TempDir()
+	summaryPath := filepath.Join(dir, "summary.md")
+	outputPath := filepath.Join(dir, "output.txt")
+	t.Setenv("GITHUB_STEP_SUMMARY", summaryPath)
+	t.Setenv("GITHUB_OUTPUT", outputPath)
+
+	resp := &asc.AppStoreVersionResponse{Data: asc.Resource[asc.AppStoreVersionAttributes]{
+		Type:       asc.ResourceTypeAppStoreVersions,
+		ID:         "version-1",
+		Attributes: asc.AppStoreVersionAttributes{VersionString: "1.2.0"},
+	}}
+	captureOutput(t, func() {
+		if err := renderOutput(resp, "gha", false); err != nil {
+			t.Fatalf("renderOutput() error: %v", err)
+		}
+	})
+
+	summary, err := os.ReadFile(summaryPath)
+	if err != nil {
+		t.Fatalf("read summary: %v", err)
+	}
+	if !strings.Contains(string(summary), "| 1.2.0") || strings.Contains(string(summary), "```") {
+		t.Fatalf("expected Markdown table summary, got %q", summary)
+	}
+
+	outputs, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read outputs: %v", err)
+	}
+	if string(outputs) != "app_store_version_id=version-1\nid=version-1\n" {
+		t.Fatalf("unexpected outputs: %q", outputs)
+	}
+}
+
+func TestRenderOutputGitHubActionsWithoutRunnerFiles(t *testing.T) {
+	t.Setenv("GITHUB_STEP_SUMMARY", "")
+	t.Setenv("GITHUB_OUTPUT", "")
+
+	stdout, _ := captureOutput(t, func() {
+		if err := renderOutput(map[string]string{"buildId": "b"}, "gha", true); err != nil {
+			t.Fatalf("renderOutput() error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, "\n  \"buildId\": \"b\"\n") {
+		t.Fatalf("expected pretty JSON, got %q", stdout)
+	}
+}
+
+func TestWriteGitHubErrorAnnotation(t *testing.T) {
+	_, stderr := captureOutput(t, func() {
+		WriteGitHubErrorAnnotation("asc builds info", errors.New("builds info: not found"))
+	})
+	if stderr != "::error title=asc builds info::builds info: not found\n" {
+		t.Fatalf("unexpected annotation: %q", stderr)
+	}
+}
+
+func TestDefaultOutputFormat_GitHubActions(t *testing.T) {
+	resetDefaultOutput(t)
+	t.Setenv("ASC_DEFAULT_OUTPUT", "gha")
+	if got := DefaultOutputFormat(); got != "gha" {
+		t.Fatalf("expected gha, got %q", got)
+	}
+}
@@ -0,0 +1,25 @@
+package shared
+
+import (
+	"os"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// OpenImageFile validates an image for upload and opens it without following
+// symlinks, returning the file and its info.
+func OpenImageFile(path string) (*os.File, os.FileInfo, error) {
+	if err := asc.ValidateImageFile(path); err != nil {
+		return nil, nil, err
+	}
+	file, err := OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, nil, err
+	}
+	return file, info, nil
+}
@@ -16,6 +16,7 @@ type JUnitTestCase struct {
 	Time      time.Duration // Test duration
 	Failure   string        // Failure type (empty if passed)
 	Message   string        // Failure message
+	Skipped   bool          // Test was skipped (ignored when Failure is set)
 	SystemOut string        // Standard output
 	SystemErr string        // Standard error
 }
@@ -71,9 +72,12 @@ func (r *JUnitReport) Marshal() ([]byte, error) {
 
 	tests := len(r.Tests)
 	failures := 0
+	skipped := 0
 	for _, tc := range r.Tests {
 		if tc.Failure != "" {
 			failures++
+		} else if tc.Skipped {
+			skipped++
 		}
 	}
 
@@ -87,6 +91,7 @@ func (r *JUnitReport) Marshal() ([]byte, error) {
 		Tests:     tests,
 		Failures:  failures,
 		Errors:    0,
+		Skipped:   skipped,
 		Time:      formatDuration(totalDuration(r.Tests)),
 		Timestamp: r.Timestamp.Format(time.RFC3339),
 		TestCases: testCases,
@@ -116,6 +121,7 @@ type testCaseXML struct {
 	Classname string      `xml:"classname,attr"`
 	Time      string      `xml:"time,attr"`
 	Failure   *failureXML `xml:"failure,omitempty"`
+	Skipped   *struct{}   `xml:"skipped,omitempty"`
 	SystemOut string      `xml:"system-out,omitempty"`
 	SystemErr string      `xml:"system-err,omitempty"`
 }
@@ -138,6 +144,8 @@ func (tc JUnitTestCase) toXML() testCaseXML {
 			Message: tc.Message,
 			Type:    tc.Failure,
 		}
+	} else if tc.Skipped {
+		xml.Skipped = &struct{}{}
 	}
 
 	if tc.SystemOut != "" {
@@ -158,6 +166,7 @@ type testsuiteXML struct {
 	Tests     int           `xml:"tests,attr"`
 	Failures  int           `xml:"failures,attr"`
 	Errors    int           `xml:"errors,attr"`
+	Skipped   int           `xml:"skipped,attr,omitempty"`
 	Time      string        `xml:"time,attr"`
 	Timestamp string        `xml:"timestamp,attr,omitempty"`
 	TestCases []testCaseXML `xml:"testcase"`
@@ -155,6 +155,40 @@ func TestJUnitReport_EscapeSpecialChars(t *testing.T) {
 	}
 }
 
+func TestJUnitReport_Skipped(t *testing.T) {
+	report := JUnitReport{
+		Tests: []JUnitTestCase{
+			{Name: "passes", Classname: "suite"},
+			{Name: "skipped", Classname: "suite", Skipped: true},
+			{Name: "fails", Classname: "suite", Failure: "FAILURE", Message: "boom", Skipped: true},
+		},
+	}
+
+	data, err := report.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var result struct {
+		Failures  int `xml:"failures,attr"`
+		Skipped   int `xml:"skipped,attr"`
+		TestCases []struct {
+			Name    string    `xml:"name,attr"`
+			Skipped *struct{} `xml:"skipped"`
+			Failure *struct{} `xml:"failure"`
+		} `xml:"testcase"`
+	}
+	if err := xml.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if result.Failures != 1 || result.Skipped != 1 {
+		t.Fatalf("expected 1 failure and 1 skipped, got %d and %d", result.Failures, result.Skipped)
+	}
+	if result.TestCases[1].Skipped == nil || result.TestCases[0].Skipped != nil || result.TestCases[2].Skipped != nil {
+		t.Fatalf("unexpected skipped elements: %s", data)
+	}
+}
+
 func TestCIReportFlags(t *testing.T) {
 	if ReportFormat() != "" {
 		t.Errorf("ReportFormat() = %q, want empty", ReportFormat())
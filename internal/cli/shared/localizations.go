@@ -121,6 +121,11 @@ func isValidLocale(locale string) bool {
 	return localeValidationRegex.MatchString(locale)
 }
 
+// IsValidLocale reports whether locale is a well-formed locale code that is safe to use in file names.
+func IsValidLocale(locale string) bool {
+	return isValidLocale(locale)
+}
+
 func resolveLocalizationOutputPaths(outputPath string, locales []string) (map[string]string, error) {
 	if strings.TrimSpace(outputPath) == "" {
 		outputPath = "localizations"
@@ -152,6 +157,26 @@ func resolveLocalizationOutputPaths(outputPath string, locales []string) (map[st
 	return result, nil
 }
 
+// VersionLocalizationKeys returns the keys supported for version localizations.
+func VersionLocalizationKeys() []string {
+	return append([]string(nil), versionLocalizationKeys...)
+}
+
+// AppInfoLocalizationKeys returns the keys supported for app info localizations.
+func AppInfoLocalizationKeys() []string {
+	return append([]string(nil), appInfoLocalizationKeys...)
+}
+
+// VersionLocalizationValues returns the non-empty fields of a version localization keyed like .strings files.
+func VersionLocalizationValues(attrs asc.AppStoreVersionLocalizationAttributes) map[string]string {
+	return mapVersionLocalizationStrings(attrs)
+}
+
+// AppInfoLocalizationValues returns the non-empty fields of an app info localization keyed like .strings files.
+func AppInfoLocalizationValues(attrs asc.AppInfoLocalizationAttributes) map[string]string {
+	return mapAppInfoLocalizationStrings(attrs)
+}
+
 func mapVersionLocalizationStrings(attrs asc.AppStoreVersionLocalizationAttributes) map[string]string {
 	values := make(map[string]string)
 	setIfNotEmpty(values, "description", attrs.Description)
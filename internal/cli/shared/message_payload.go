@@ -0,0 +1,298 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	// OutputFormatSlackBlocks is the --output value that prints a Slack Block Kit message.
+	OutputFormatSlackBlocks = "slack-blocks"
+	// OutputFormatTeams is the --output value that prints a Microsoft Teams Adaptive Card message.
+	OutputFormatTeams = "teams"
+)
+
+const (
+	// messageMaxRows caps the rows listed in a message; the rest are counted.
+	messageMaxRows = 20
+	// slackMaxSectionFields is Slack's limit on fields in a section block.
+	slackMaxSectionFields = 10
+	// slackMaxTextLength stays under Slack's 3000-character section text limit.
+	slackMaxTextLength = 2900
+)
+
+var (
+	webhookURL   string
+	messageTitle string
+
+	webhookHTTPClient = &http.Client{}
+)
+
+// SetMessageTitle sets the heading used for slack-blocks and teams output,
+// normally the command path (for example "asc builds info").
+func SetMessageTitle(title string) {
+	messageTitle = strings.TrimSpace(title)
+}
+
+// SetWebhookURL sets the --webhook flag (tests only).
+func SetWebhookURL(value string) {
+	webhookURL = value
+}
+
+func validateWebhookOutput(format string) error {
+	if strings.TrimSpace(webhookURL) == "" {
+		return nil
+	}
+	switch strings.ToLower(format) {
+	case "json", OutputFormatSlackBlocks, OutputFormatTeams:
+	default:
+		return fmt.Errorf("--webhook requires --output json, %s, or %s", OutputFormatSlackBlocks, OutputFormatTeams)
+	}
+	parsed, err := url.Parse(strings.TrimSpace(webhookURL))
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return fmt.Errorf("--webhook must be an http(s) URL")
+	}
+	return nil
+}
+
+// renderMessagePayload prints data as a chat message payload and posts it to
+// --webhook when set.
+func renderMessagePayload(data interface{}, format string, pretty bool) error {
+	var payload interface{}
+	var err error
+	switch format {
+	case OutputFormatSlackBlocks:
+		payload, err = buildSlackBlocksPayload(data)
+	case OutputFormatTeams:
+		payload, err = buildTeamsPayload(data)
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if pretty {
+		err = asc.PrintPrettyJSON(payload)
+	} else {
+		err = asc.PrintJSON(payload)
+	}
+	if err != nil {
+		return err
+	}
+	return postWebhook(payload)
+}
+
+// postWebhook sends payload as JSON to --webhook. It is a no-op without the flag.
+func postWebhook(payload interface{}) error {
+	target := strings.TrimSpace(webhookURL)
+	if target == "" {
+		return nil
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+
+	ctx, cancel := contextWithTimeout(context.Background())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhookHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// messageRecord is one result row as header/value pairs, skipping empty values.
+type messageRecord []messageField
+
+type messageField struct {
+	Name  string
+	Value string
+}
+
+// messageContent flattens data into records using its table rendering. Types
+// without one are returned as indented JSON instead.
+func messageContent(data interface{}) ([]messageRecord, string, error) {
+	tables, rendered, err := asc.CollectTables(data)
+	if err != nil {
+		return nil, "", err
+	}
+	if !rendered {
+		encoded, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return nil, "", err
+		}
+		return nil, string(encoded), nil
+	}
+
+	var records []messageRecord
+	for _, table := range tables {
+		for _, row := range table.Rows {
+			record := make(messageRecord, 0, len(row))
+			for i, value := range row {
+				if i >= len(table.Headers) || strings.TrimSpace(value) == "" {
+					continue
+				}
+				record = append(record, messageField{Name: table.Headers[i], Value: value})
+			}
+			if len(record) > 0 {
+				records = append(records, record)
+			}
+		}
+	}
+	return records, "", nil
+}
+
+func messageHeading(records []messageRecord, raw string) (string, string) {
+	title := messageTitle
+	if title == "" {
+		title = "asc"
+	}
+	if raw != "" {
+		return title, title
+	}
+	noun := "results"
+	if len(records) == 1 {
+		noun = "result"
+	}
+	return title, fmt.Sprintf("%s: %d %s", title, len(records), noun)
+}
+
+func buildSlackBlocksPayload(data interface{}) (map[string]interface{}, error) {
+	records, raw, err := messageContent(data)
+	if err != nil {
+		return nil, err
+	}
+	title, summary := messageHeading(records, raw)
+
+	blocks := []map[string]interface{}{
+		{"type": "header", "text": map[string]interface{}{"type": "plain_text", "text": truncateText(title, 150)}},
+	}
+	if raw != "" {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "section",
+			"text": map[string]interface{}{
+				"type": "mrkdwn",
+				"text": "```" + truncateText(raw, slackMaxTextLength-6) + "```",
+			},
+		})
+	}
+	for i, record := range records {
+		if i == messageMaxRows {
+			blocks = append(blocks, map[string]interface{}{
+				"type": "context",
+				"elements": []map[string]interface{}{
+					{"type": "mrkdwn", "text": fmt.Sprintf("…and %d more", len(records)-messageMaxRows)},
+				},
+			})
+			break
+		}
+		fields := make([]map[string]interface{}, 0, slackMaxSectionFields)
+		for _, field := range record {
+			if len(fields) == slackMaxSectionFields {
+				break
+			}
+			fields = append(fields, map[string]interface{}{
+				"type": "mrkdwn",
+				"text": truncateText(fmt.Sprintf("*%s*\n%s", field.Name, field.Value), 2000),
+			})
+		}
+		blocks = append(blocks, map[string]interface{}{"type": "section", "fields": fields})
+	}
+
+	return map[string]interface{}{
+		"text":   summary,
+		"blocks": blocks,
+	}, nil
+}
+
+func buildTeamsPayload(data interface{}) (map[string]interface{}, error) {
+	records, raw, err := messageContent(data)
+	if err != nil {
+		return nil, err
+	}
+	title, summary := messageHeading(records, raw)
+
+	body := []map[string]interface{}{
+		{"type": "TextBlock", "text": title, "weight": "Bolder", "size": "Medium", "wrap": true},
+	}
+	if raw != "" {
+		body = append(body, map[string]interface{}{"type": "TextBlock", "text": raw, "fontType": "Monospace", "wrap": true})
+	}
+	for i, record := range records {
+		if i == messageMaxRows {
+			body = append(body, map[string]interface{}{
+				"type":     "TextBlock",
+				"text":     fmt.Sprintf("…and %d more", len(records)-messageMaxRows),
+				"isSubtle": true,
+				"wrap":     true,
+			})
+			break
+		}
+		facts := make([]map[string]interface{}, 0, len(record))
+		for _, field := range record {
+			facts = append(facts, map[string]interface{}{"title": field.Name, "value": field.Value})
+		}
+		body = append(body, map[string]interface{}{"type": "FactSet", "facts": facts, "separator": i > 0})
+	}
+
+	return map[string]interface{}{
+		"type":    "message",
+		"summary": summary,
+		"attachments": []map[string]interface{}{
+			{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content": map[string]interface{}{
+					"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+					"type":    "AdaptiveCard",
+					"version": "1.4",
+					"body":    body,
+				},
+			},
+		},
+	}, nil
+}
+
+func truncateText(value string, limit int) string {
+	runes := []rune(value)
+	if len(runes) <= limit {
+		return value
+	}
+	return string(runes[:limit-1]) + "…"
+}
+
+// printJSONAndPostWebhook prints data as JSON and posts the same document to
+// --webhook when set.
+func printJSONAndPostWebhook(data interface{}, pretty bool) error {
+	var err error
+	if pretty {
+		err = asc.PrintPrettyJSON(data)
+	} else {
+		err = asc.PrintJSON(data)
+	}
+	if err != nil {
+		return err
+	}
+	return postWebhook(data)
+}
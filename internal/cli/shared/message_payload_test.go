@@ -0,0 +1,154 @@
+package shared
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func messageTestVersion() *asc.AppStoreVersionResponse {
+	return &asc.AppStoreVersionResponse{Data: asc.Resource[asc.AppStoreVersionAttributes]{
+		Type: asc.ResourceTypeAppStoreVersions,
+		ID:   "version-1",
+		Attributes: asc.AppStoreVersionAttributes{
+			VersionString: "1.2.0",
+			AppStoreState: "READY_FOR_SALE",
+		},
+	}}
+}
+
+func TestRenderOutputSlackBlocks(t *testing.T) {
+	SetMessageTitle("asc versions get")
+	t.Cleanup(func() { SetMessageTitle("") })
+
+	stdout, _ := captureOutput(t, func() {
+		if err := renderOutput(messageTestVersion(), "slack-blocks", false); err != nil {
+			t.Fatalf("renderOutput() error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Text   string `json:"text"`
+		Blocks []struct {
+			Type string `json:"type"`
+			Text struct {
+				Text string `json:"text"`
+			} `json:"text"`
+			Fields []struct {
+				Text string `json:"text"`
+			} `json:"fields"`
+		} `json:"blocks"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("failed to parse payload: %v\n%s", err, stdout)
+	}
+	if payload.Text != "asc versions get: 1 result" {
+		t.Fatalf("unexpected fallback text %q", payload.Text)
+	}
+	if len(payload.Blocks) != 2 || payload.Blocks[0].Type != "header" || payload.Blocks[0].Text.Text != "asc versions get" {
+		t.Fatalf("unexpected blocks: %+v", payload.Blocks)
+	}
+	fields := make([]string, 0, len(payload.Blocks[1].Fields))
+	for _, field := range payload.Blocks[1].Fields {
+		fields = append(fields, field.Text)
+	}
+	joined := strings.Join(fields, "|")
+	if !strings.Contains(joined, "1.2.0") || !strings.Contains(joined, "READY_FOR_SALE") {
+		t.Fatalf("expected version fields, got %q", joined)
+	}
+}
+
+func TestRenderOutputTeamsFallsBackToJSON(t *testing.T) {
+	stdout, _ := captureOutput(t, func() {
+		if err := renderOutput(map[string]string{"status": "ok"}, "teams", false); err != nil {
+			t.Fatalf("renderOutput() error: %v", err)
+		}
+	})
+
+	var payload struct {
+		Type        string `json:"type"`
+		Attachments []struct {
+			ContentType string `json:"contentType"`
+			Content     struct {
+				Type string `json:"type"`
+				Body []struct {
+					Type     string `json:"type"`
+					Text     string `json:"text"`
+					FontType string `json:"fontType"`
+				} `json:"body"`
+			} `json:"content"`
+		} `json:"attachments"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("failed to parse payload: %v\n%s", err, stdout)
+	}
+	if payload.Type != "message" || len(payload.Attachments) != 1 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	card := payload.Attachments[0]
+	if card.ContentType != "application/vnd.microsoft.card.adaptive" || card.Content.Type != "AdaptiveCard" {
+		t.Fatalf("unexpected attachment: %+v", card)
+	}
+	if len(card.Content.Body) != 2 || card.Content.Body[1].FontType != "Monospace" || !strings.Contains(card.Content.Body[1].Text, `"status": "ok"`) {
+		t.Fatalf("expected JSON fallback block, got %+v", card.Content.Body)
+	}
+}
+
+func TestPrintOutputPostsToWebhook(t *testing.T) {
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected content type %q", r.Header.Get("Content-Type"))
+		}
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	SetWebhookURL(server.URL)
+	t.Cleanup(func() { SetWebhookURL("") })
+
+	stdout, _ := captureOutput(t, func() {
+		if err := printOutput(messageTestVersion(), "slack-blocks", false); err != nil {
+			t.Fatalf("printOutput() error: %v", err)
+		}
+	})
+	if strings.TrimSpace(stdout) != string(received) {
+		t.Fatalf("expected webhook body to match stdout\nstdout: %s\nbody:   %s", stdout, received)
+	}
+}
+
+func TestPrintOutputWebhookErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid_payload", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	t.Cleanup(func() { SetWebhookURL("") })
+
+	SetWebhookURL(server.URL)
+	var err error
+	captureOutput(t, func() {
+		err = printOutput(map[string]string{"status": "ok"}, "json", false)
+	})
+	if err == nil || !strings.Contains(err.Error(), "webhook: unexpected status 400: invalid_payload") {
+		t.Fatalf("expected webhook status error, got %v", err)
+	}
+
+	if err := printOutput(map[string]string{"status": "ok"}, "table", false); err == nil || !strings.Contains(err.Error(), "--webhook requires --output json") {
+		t.Fatalf("expected format error, got %v", err)
+	}
+
+	SetWebhookURL("ftp://example.com/hook")
+	if err := printOutput(map[string]string{"status": "ok"}, "json", false); err == nil || !strings.Contains(err.Error(), "--webhook must be an http(s) URL") {
+		t.Fatalf("expected URL error, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// templatePlaceholderRegex matches {{name}} placeholders, allowing spaces
+// inside the braces.
+var templatePlaceholderRegex = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.-]*)\s*\}\}`)
+
+// TemplateVars collects repeated --var key=value flags.
+type TemplateVars map[string]string
+
+func (v TemplateVars) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		return fmt.Errorf("must be key=value")
+	}
+	if !templatePlaceholderRegex.MatchString("{{" + key + "}}") {
+		return fmt.Errorf("invalid variable name %q", key)
+	}
+	v[key] = val
+	return nil
+}
+
+func (v TemplateVars) String() string {
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+v[key])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// TemplateReferences reports whether text contains a {{name}} placeholder.
+func TemplateReferences(text, name string) bool {
+	for _, match := range templatePlaceholderRegex.FindAllStringSubmatch(text, -1) {
+		if match[1] == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandTemplate replaces {{name}} placeholders in text with vars[name].
+// Placeholders without a value are an error so they never reach the App Store.
+func ExpandTemplate(text string, vars map[string]string) (string, error) {
+	var missing []string
+	expanded := templatePlaceholderRegex.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := templatePlaceholderRegex.FindStringSubmatch(placeholder)[1]
+		value, ok := vars[name]
+		if !ok {
+			missing = append(missing, name)
+			return placeholder
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("undefined template variable %q (set it with --var %s=...)", missing[0], missing[0])
+	}
+	return expanded, nil
+}
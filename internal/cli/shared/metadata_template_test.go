@@ -0,0 +1,49 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExpandTemplate(t *testing.T) {
+	vars := map[string]string{"version": "2.1", "date": "2026-10-15", "app.name": "Photo Studio"}
+
+	got, err := ExpandTemplate("{{app.name}} {{ version }} ({{date}}) - {version}", vars)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "Photo Studio 2.1 (2026-10-15) - {version}" {
+		t.Fatalf("unexpected expansion: %q", got)
+	}
+
+	if _, err := ExpandTemplate("New in {{build}}", vars); err == nil || !strings.Contains(err.Error(), `"build"`) {
+		t.Fatalf("expected undefined variable error, got %v", err)
+	}
+}
+
+func TestTemplateVarsSet(t *testing.T) {
+	vars := TemplateVars{}
+	if err := vars.Set("season=Winter=2026"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vars["season"] != "Winter=2026" {
+		t.Fatalf("expected value after the first '=', got %q", vars["season"])
+	}
+	for _, value := range []string{"season", "=x", "bad key=x"} {
+		if err := vars.Set(value); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+	if got := vars.String(); got != "season=Winter=2026" {
+		t.Fatalf("unexpected String(): %q", got)
+	}
+}
+
+func TestTemplateReferences(t *testing.T) {
+	if !TemplateReferences("v{{ version }}", "version") {
+		t.Fatal("expected reference to version")
+	}
+	if TemplateReferences("v{{versionName}}", "version") {
+		t.Fatal("expected no reference to version")
+	}
+}
@@ -0,0 +1,351 @@
+package shared
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+const (
+	outputDiffChangeAdded   = "added"
+	outputDiffChangeRemoved = "removed"
+	outputDiffChangeChanged = "changed"
+)
+
+var (
+	diffSinceLast    bool
+	outputDiffArgs   []string
+	outputDiffLabel  string
+	outputHistoryDir = defaultOutputHistoryDir
+	outputDiffNow    = time.Now
+)
+
+// outputSnapshot is the stored output of a previous run.
+type outputSnapshot struct {
+	Command string          `json:"command"`
+	SavedAt time.Time       `json:"saved_at"`
+	Output  json.RawMessage `json:"output"`
+}
+
+// SetOutputDiffKey records the invocation used to key --diff-since-last snapshots.
+// The --diff-since-last and --webhook flags are ignored so that toggling them
+// keeps the same key and webhook URLs are never written to output history.
+// The snapshot key also covers the resolved profile and ASC_APP_ID; see
+// outputDiffSnapshotKey.
+func SetOutputDiffKey(args []string) {
+	filtered := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, _, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if strings.HasPrefix(arg, "-") && name == "diff-since-last" {
+			continue
+		}
+		if strings.HasPrefix(arg, "-") && name == "webhook" {
+			if !hasValue {
+				i++
+			}
+			continue
+		}
+		filtered = append(filtered, arg)
+	}
+	if len(filtered) == 0 {
+		outputDiffArgs = nil
+		outputDiffLabel = ""
+		return
+	}
+	outputDiffArgs = filtered
+	outputDiffLabel = "asc " + strings.Join(filtered, " ")
+}
+
+// outputDiffSnapshotKey returns the output history key of the recorded
+// invocation. Besides the arguments it covers the resolved profile and
+// ASC_APP_ID, which select the account and app without appearing in argv,
+// so runs against different teams (as with foreach-profile) never share a
+// snapshot.
+func outputDiffSnapshotKey() string {
+	if len(outputDiffArgs) == 0 {
+		return ""
+	}
+	parts := append([]string{}, outputDiffArgs...)
+	parts = append(parts,
+		"profile="+outputDiffProfile(),
+		"app="+strings.TrimSpace(os.Getenv("ASC_APP_ID")),
+	)
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// outputDiffProfile returns the profile from --profile or ASC_PROFILE, or
+// the config's default profile.
+func outputDiffProfile() string {
+	if name := resolveProfileName(); name != "" {
+		return name
+	}
+	cfg, err := config.Load()
+	if err != nil || cfg == nil {
+		return ""
+	}
+	return strings.TrimSpace(cfg.DefaultKeyName)
+}
+
+// SetDiffSinceLast sets the --diff-since-last flag (tests only).
+func SetDiffSinceLast(value bool) {
+	diffSinceLast = value
+}
+
+func defaultOutputHistoryDir() (string, error) {
+	path, err := config.GlobalPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), "output-history"), nil
+}
+
+// printOutputDiff stores the current output and prints the changes since the
+// previous run of the same command. The first run prints the full output.
+func printOutputDiff(data interface{}, format string, pretty bool) error {
+	key := outputDiffSnapshotKey()
+	if key == "" {
+		return fmt.Errorf("--diff-since-last: unable to determine command for output history")
+	}
+	current, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("--diff-since-last: %w", err)
+	}
+
+	dir, err := outputHistoryDir()
+	if err != nil {
+		return fmt.Errorf("--diff-since-last: %w", err)
+	}
+	path := filepath.Join(dir, key+".json")
+
+	previous, err := readOutputSnapshot(path)
+	if err != nil {
+		return fmt.Errorf("--diff-since-last: %w", err)
+	}
+
+	if previous == nil {
+		if err := renderOutput(data, format, pretty); err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stderr, "No previous output recorded for this command; saved baseline.")
+	} else {
+		result, err := diffOutputs(previous.Output, current)
+		if err != nil {
+			return fmt.Errorf("--diff-since-last: %w", err)
+		}
+		result.Command = outputDiffLabel
+		result.PreviousRunAt = previous.SavedAt.Format(time.RFC3339)
+		if err := renderOutput(result, format, pretty); err != nil {
+			return err
+		}
+	}
+
+	if err := writeOutputSnapshot(path, outputSnapshot{
+		Command: outputDiffLabel,
+		SavedAt: outputDiffNow().UTC(),
+		Output:  current,
+	}); err != nil {
+		return fmt.Errorf("--diff-since-last: %w", err)
+	}
+	return nil
+}
+
+func readOutputSnapshot(path string) (*outputSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var snapshot outputSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("invalid output history %s: %w", path, err)
+	}
+	return &snapshot, nil
+}
+
+func writeOutputSnapshot(path string, snapshot outputSnapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteFileAtomic(path, data, 0o700)
+}
+
+// outputDiffEntry is a keyed item extracted from command output.
+type outputDiffEntry struct {
+	Type string
+	ID   string
+	Raw  json.RawMessage
+}
+
+// diffOutputs compares two JSON outputs item by item. Items are taken from a
+// top-level "data" field (array or object) or a top-level array, and matched
+// by type and ID. Items without an ID are matched by content.
+func diffOutputs(previous, current []byte) (*asc.OutputDiffResult, error) {
+	before, err := outputDiffEntries(previous)
+	if err != nil {
+		return nil, err
+	}
+	after, err := outputDiffEntries(current)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &asc.OutputDiffResult{Items: []asc.OutputDiffItem{}}
+	for _, key := range sortedOutputDiffKeys(after) {
+		entry := after[key]
+		old, ok := before[key]
+		if !ok {
+			result.Added++
+			result.Items = append(result.Items, asc.OutputDiffItem{
+				Change: outputDiffChangeAdded,
+				Type:   entry.Type,
+				ID:     entry.ID,
+				After:  entry.Raw,
+			})
+			continue
+		}
+		fields := changedOutputFields(old.Raw, entry.Raw)
+		if len(fields) == 0 {
+			continue
+		}
+		result.Changed++
+		result.Items = append(result.Items, asc.OutputDiffItem{
+			Change: outputDiffChangeChanged,
+			Type:   entry.Type,
+			ID:     entry.ID,
+			Fields: fields,
+			Before: old.Raw,
+			After:  entry.Raw,
+		})
+	}
+	for _, key := range sortedOutputDiffKeys(before) {
+		if _, ok := after[key]; ok {
+			continue
+		}
+		entry := before[key]
+		result.Removed++
+		result.Items = append(result.Items, asc.OutputDiffItem{
+			Change: outputDiffChangeRemoved,
+			Type:   entry.Type,
+			ID:     entry.ID,
+			Before: entry.Raw,
+		})
+	}
+	return result, nil
+}
+
+func outputDiffEntries(data []byte) (map[string]outputDiffEntry, error) {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	var items []interface{}
+	switch value := doc.(type) {
+	case []interface{}:
+		items = value
+	case map[string]interface{}:
+		switch inner := value["data"].(type) {
+		case []interface{}:
+			items = inner
+		case map[string]interface{}:
+			items = []interface{}{inner}
+		default:
+			items = []interface{}{value}
+		}
+	default:
+		items = []interface{}{value}
+	}
+
+	entries := make(map[string]outputDiffEntry, len(items))
+	for _, item := range items {
+		raw, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		entry := outputDiffEntry{Raw: raw}
+		key := "content:" + string(raw)
+		if obj, ok := item.(map[string]interface{}); ok {
+			entry.Type, _ = obj["type"].(string)
+			entry.ID, _ = obj["id"].(string)
+			if entry.ID != "" {
+				key = "id:" + entry.Type + "/" + entry.ID
+			}
+		}
+		entries[key] = entry
+	}
+	return entries, nil
+}
+
+func sortedOutputDiffKeys(entries map[string]outputDiffEntry) []string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// changedOutputFields lists the fields that differ between two items.
+// Fields under "attributes" and "relationships" are reported individually.
+func changedOutputFields(before, after json.RawMessage) []string {
+	if bytes.Equal(before, after) {
+		return nil
+	}
+	var oldObj, newObj map[string]json.RawMessage
+	if json.Unmarshal(before, &oldObj) != nil || json.Unmarshal(after, &newObj) != nil {
+		return []string{"value"}
+	}
+
+	fields := make([]string, 0)
+	for _, name := range unionKeys(oldObj, newObj) {
+		if bytes.Equal(oldObj[name], newObj[name]) {
+			continue
+		}
+		if name == "attributes" || name == "relationships" {
+			var oldNested, newNested map[string]json.RawMessage
+			if json.Unmarshal(oldObj[name], &oldNested) == nil && json.Unmarshal(newObj[name], &newNested) == nil {
+				for _, nested := range unionKeys(oldNested, newNested) {
+					if !bytes.Equal(oldNested[nested], newNested[nested]) {
+						fields = append(fields, name+"."+nested)
+					}
+				}
+				continue
+			}
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+func unionKeys(a, b map[string]json.RawMessage) []string {
+	seen := make(map[string]struct{}, len(a)+len(b))
+	for key := range a {
+		seen[key] = struct{}{}
+	}
+	for key := range b {
+		seen[key] = struct{}{}
+	}
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,165 @@
+package shared
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiffOutputs_ListByID(t *testing.T) {
+	previous := `{"data":[{"type":"apps","id":"1","attributes":{"name":"One","sku":"A"}},{"type":"apps","id":"2","attributes":{"name":"Two"}}],"links":{"self":"x"}}`
+	current := `{"data":[{"type":"apps","id":"1","attributes":{"name":"Uno","sku":"A"}},{"type":"apps","id":"3","attributes":{"name":"Three"}}],"links":{"self":"y"}}`
+
+	result, err := diffOutputs([]byte(previous), []byte(current))
+	if err != nil {
+		t.Fatalf("diffOutputs() error: %v", err)
+	}
+	if result.Added != 1 || result.Removed != 1 || result.Changed != 1 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+
+	byChange := map[string]string{}
+	for _, item := range result.Items {
+		byChange[item.Change] = item.ID
+		if item.Change == outputDiffChangeChanged && !reflect.DeepEqual(item.Fields, []string{"attributes.name"}) {
+			t.Fatalf("expected attributes.name to change, got %v", item.Fields)
+		}
+	}
+	want := map[string]string{"added": "3", "removed": "2", "changed": "1"}
+	if !reflect.DeepEqual(byChange, want) {
+		t.Fatalf("expected %v, got %v", want, byChange)
+	}
+}
+
+func TestDiffOutputs_NoChanges(t *testing.T) {
+	payload := `{"data":{"type":"apps","id":"1","attributes":{"name":"One"}}}`
+	result, err := diffOutputs([]byte(payload), []byte(payload))
+	if err != nil {
+		t.Fatalf("diffOutputs() error: %v", err)
+	}
+	if len(result.Items) != 0 {
+		t.Fatalf("expected no changes, got %+v", result.Items)
+	}
+}
+
+func TestDiffOutputs_ItemsWithoutIDMatchByContent(t *testing.T) {
+	result, err := diffOutputs([]byte(`[{"name":"a"},{"name":"b"}]`), []byte(`[{"name":"b"},{"name":"c"}]`))
+	if err != nil {
+		t.Fatalf("diffOutputs() error: %v", err)
+	}
+	if result.Added != 1 || result.Removed != 1 || result.Changed != 0 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+}
+
+func TestSetOutputDiffKey_IgnoresDiffFlag(t *testing.T) {
+	t.Cleanup(func() { SetOutputDiffKey(nil) })
+
+	SetOutputDiffKey([]string{"apps", "list", "--limit", "5"})
+	withoutFlag := outputDiffSnapshotKey()
+	SetOutputDiffKey([]string{"--diff-since-last", "apps", "list", "--limit", "5"})
+	if outputDiffSnapshotKey() != withoutFlag {
+		t.Fatalf("expected --diff-since-last to be ignored in key")
+	}
+	if outputDiffLabel != "asc apps list --limit 5" {
+		t.Fatalf("unexpected label %q", outputDiffLabel)
+	}
+	SetOutputDiffKey([]string{"apps", "list", "--limit", "10"})
+	if outputDiffSnapshotKey() == withoutFlag {
+		t.Fatalf("expected different flags to produce a different key")
+	}
+}
+
+func TestOutputDiffSnapshotKey_IncludesProfileAndAppID(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_PROFILE", "")
+	t.Setenv("ASC_APP_ID", "")
+	t.Cleanup(func() { SetOutputDiffKey(nil) })
+
+	SetOutputDiffKey([]string{"apps", "list"})
+	base := outputDiffSnapshotKey()
+
+	t.Setenv("ASC_PROFILE", "team-a")
+	teamA := outputDiffSnapshotKey()
+	t.Setenv("ASC_PROFILE", "team-b")
+	teamB := outputDiffSnapshotKey()
+	if teamA == base || teamA == teamB {
+		t.Fatalf("expected each profile to get its own key")
+	}
+
+	t.Setenv("ASC_APP_ID", "123")
+	if outputDiffSnapshotKey() == teamB {
+		t.Fatalf("expected ASC_APP_ID to change the key")
+	}
+}
+
+func TestSetOutputDiffKey_IgnoresWebhook(t *testing.T) {
+	t.Cleanup(func() { SetOutputDiffKey(nil) })
+
+	SetOutputDiffKey([]string{"--webhook", "https://hooks.example.com/secret", "apps", "list"})
+	if outputDiffLabel != "asc apps list" {
+		t.Fatalf("unexpected label %q", outputDiffLabel)
+	}
+	SetOutputDiffKey([]string{"--webhook=https://hooks.example.com/secret", "apps", "list"})
+	if outputDiffLabel != "asc apps list" {
+		t.Fatalf("unexpected label %q", outputDiffLabel)
+	}
+}
+
+func TestPrintOutputDiff_BaselineThenDiff(t *testing.T) {
+	dir := t.TempDir()
+	originalDir := outputHistoryDir
+	originalNow := outputDiffNow
+	t.Cleanup(func() {
+		outputHistoryDir = originalDir
+		outputDiffNow = originalNow
+		SetDiffSinceLast(false)
+		SetOutputDiffKey(nil)
+	})
+	outputHistoryDir = func() (string, error) { return dir, nil }
+	outputDiffNow = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+	SetDiffSinceLast(true)
+	SetOutputDiffKey([]string{"apps", "list"})
+
+	first := map[string]any{"data": []map[string]any{{"type": "apps", "id": "1"}}}
+	stdout, stderr := captureOutput(t, func() {
+		if err := PrintOutput(first, "json", false); err != nil {
+			t.Fatalf("PrintOutput() error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"id":"1"`) {
+		t.Fatalf("expected full output on first run, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "saved baseline") {
+		t.Fatalf("expected baseline notice, got %q", stderr)
+	}
+
+	second := map[string]any{"data": []map[string]any{{"type": "apps", "id": "1"}, {"type": "apps", "id": "2"}}}
+	stdout, _ = captureOutput(t, func() {
+		if err := PrintOutput(second, "json", false); err != nil {
+			t.Fatalf("PrintOutput() error: %v", err)
+		}
+	})
+
+	var result struct {
+		Command       string `json:"command"`
+		PreviousRunAt string `json:"previousRunAt"`
+		Added         int    `json:"added"`
+		Items         []struct {
+			Change string `json:"change"`
+			ID     string `json:"id"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("parse diff output: %v (%q)", err, stdout)
+	}
+	if result.Command != "asc apps list" || result.PreviousRunAt != "2026-01-02T03:04:05Z" {
+		t.Fatalf("unexpected diff metadata: %+v", result)
+	}
+	if result.Added != 1 || len(result.Items) != 1 || result.Items[0].ID != "2" {
+		t.Fatalf("expected one added item, got %+v", result)
+	}
+}
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
@@ -25,6 +26,9 @@ type PricingSetCommandConfig struct {
 	StartDateDefaultToday bool
 	RequireBaseTerritory  bool
 	ResolveBaseTerritory  bool
+	// AllowKeepCurrent adds --keep-current, which keeps the price in effect
+	// until the new price starts.
+	AllowKeepCurrent bool
 }
 
 // NewPricingSetCommand builds a pricing set command with shared behavior.
@@ -35,6 +39,10 @@ func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 	pricePointID := fs.String("price-point", "", "App price point ID")
 	baseTerritory := fs.String("base-territory", "", "Base territory ID (e.g., USA)")
 	startDate := fs.String("start-date", "", config.StartDateHelp)
+	keepCurrent := new(bool)
+	if config.AllowKeepCurrent {
+		fs.BoolVar(keepCurrent, "keep-current", false, "Keep the current price until --start-date to schedule a future price change")
+	}
 	output := fs.String("output", DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -94,10 +102,19 @@ func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 				}
 			}
 
+			currentPricePointID := ""
+			if *keepCurrent {
+				currentPricePointID, err = currentAppPricePoint(requestCtx, client, resolvedAppID, baseTerritoryID, time.Now().UTC().Format("2006-01-02"))
+				if err != nil {
+					return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
+				}
+			}
+
 			resp, err := client.CreateAppPriceSchedule(requestCtx, resolvedAppID, asc.AppPriceScheduleCreateAttributes{
-				PricePointID:    pricePointValue,
-				StartDate:       normalizedStartDate,
-				BaseTerritoryID: baseTerritoryID,
+				PricePointID:        pricePointValue,
+				StartDate:           normalizedStartDate,
+				BaseTerritoryID:     baseTerritoryID,
+				CurrentPricePointID: currentPricePointID,
 			})
 			if err != nil {
 				return fmt.Errorf("%s: %w", config.ErrorPrefix, err)
@@ -151,3 +168,45 @@ func resolveBaseTerritoryID(ctx context.Context, client *asc.Client, appID strin
 
 	return territoryID, nil
 }
+
+// currentAppPricePoint returns the manual price point of the base territory
+// that is in effect on today.
+func currentAppPricePoint(ctx context.Context, client *asc.Client, appID, baseTerritoryID, today string) (string, error) {
+	schedule, err := client.GetAppPriceSchedule(ctx, appID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return "", fmt.Errorf("--keep-current needs an existing price schedule")
+		}
+		return "", fmt.Errorf("get app price schedule: %w", err)
+	}
+	manualPrices, err := client.GetAppPriceScheduleManualPrices(ctx, schedule.Data.ID)
+	if err != nil {
+		return "", fmt.Errorf("get manual prices: %w", err)
+	}
+
+	current := ""
+	currentStart := ""
+	for _, price := range manualPrices.Data {
+		start := price.Attributes.StartDate
+		end := price.Attributes.EndDate
+		if (start != "" && start > today) || (end != "" && end <= today) {
+			continue
+		}
+		var relationships asc.AppPriceRelationships
+		if len(price.Relationships) == 0 || json.Unmarshal(price.Relationships, &relationships) != nil {
+			continue
+		}
+		if relationships.Territory != nil && !strings.EqualFold(relationships.Territory.Data.ID, baseTerritoryID) {
+			continue
+		}
+		if current != "" && start < currentStart {
+			continue
+		}
+		current = relationships.AppPricePoint.Data.ID
+		currentStart = start
+	}
+	if current == "" {
+		return "", fmt.Errorf("--keep-current found no %s price in effect today", baseTerritoryID)
+	}
+	return current, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 // WriteProfileFile writes provisioning profile data to disk securely.
@@ -26,3 +27,38 @@ func WriteProfileFile(path string, content []byte) error {
 	}
 	return file.Sync()
 }
+
+// CertificateTypeForProfileType returns the certificate type that signs
+// profiles of the given type.
+func CertificateTypeForProfileType(profileType string) (string, bool) {
+	normalized := strings.ToUpper(strings.TrimSpace(profileType))
+
+	switch {
+	case strings.Contains(normalized, "IOS_APP_DEVELOPMENT"):
+		return "IOS_DEVELOPMENT", true
+	case strings.Contains(normalized, "IOS_APP_STORE"),
+		strings.Contains(normalized, "IOS_APP_ADHOC"),
+		strings.Contains(normalized, "IOS_APP_INHOUSE"):
+		return "IOS_DISTRIBUTION", true
+	case strings.Contains(normalized, "TVOS_APP_DEVELOPMENT"):
+		return "TVOS_DEVELOPMENT", true
+	case strings.Contains(normalized, "TVOS_APP_STORE"),
+		strings.Contains(normalized, "TVOS_APP_ADHOC"),
+		strings.Contains(normalized, "TVOS_APP_INHOUSE"):
+		return "TVOS_DISTRIBUTION", true
+	case strings.Contains(normalized, "MAC_CATALYST_APP_DEVELOPMENT"):
+		return "IOS_DEVELOPMENT", true
+	case strings.Contains(normalized, "MAC_CATALYST_APP_STORE"):
+		return "MAC_APP_DISTRIBUTION", true
+	case strings.Contains(normalized, "MAC_CATALYST_APP_DIRECT"):
+		return "DEVELOPER_ID_APPLICATION", true
+	case strings.Contains(normalized, "MAC_APP_DEVELOPMENT"):
+		return "MAC_APP_DEVELOPMENT", true
+	case strings.Contains(normalized, "MAC_APP_STORE"):
+		return "MAC_APP_DISTRIBUTION", true
+	case strings.Contains(normalized, "MAC_APP_DIRECT"):
+		return "DEVELOPER_ID_APPLICATION", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,174 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// Report backfill statuses.
+const (
+	ReportBackfillDownloaded = "downloaded"
+	ReportBackfillSkipped    = "skipped"
+	ReportBackfillPending    = "pending"
+	ReportBackfillFailed     = "failed"
+)
+
+// ReportDateRange returns every report date from from to to inclusive,
+// formatted with layout and advanced by step.
+func ReportDateRange(from, to, layout string, step func(time.Time) time.Time) ([]string, error) {
+	start, err := time.Parse(layout, from)
+	if err != nil {
+		return nil, fmt.Errorf("--from must be in %s format", dateLayoutHint(layout))
+	}
+	end, err := time.Parse(layout, to)
+	if err != nil {
+		return nil, fmt.Errorf("--to must be in %s format", dateLayoutHint(layout))
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("--from must not be after --to")
+	}
+
+	var dates []string
+	for current := start; !current.After(end); current = step(current) {
+		dates = append(dates, current.Format(layout))
+	}
+	return dates, nil
+}
+
+func dateLayoutHint(layout string) string {
+	switch layout {
+	case "2006":
+		return "YYYY"
+	case "2006-01":
+		return "YYYY-MM"
+	default:
+		return "YYYY-MM-DD"
+	}
+}
+
+// ReportBackfill downloads one report per date into files named by Paths.
+// Dates whose files already exist are skipped, and dates the API reports as
+// not generated yet (404) are retried after RetryDelay, up to Retries times,
+// before being left pending for a later run.
+type ReportBackfill struct {
+	Dates      []string
+	Paths      func(date string) (compressedPath, decompressedPath string)
+	Download   func(ctx context.Context, date string) (*asc.ReportDownload, error)
+	Retries    int
+	RetryDelay time.Duration
+}
+
+// Run downloads every date and returns one item per date, in date order.
+func (b ReportBackfill) Run(ctx context.Context) ([]asc.ReportBackfillItem, error) {
+	items := make([]asc.ReportBackfillItem, len(b.Dates))
+	var pending []int
+	for i, date := range b.Dates {
+		items[i] = b.fetch(ctx, date, 1)
+		if items[i].Status == ReportBackfillPending {
+			pending = append(pending, i)
+		}
+	}
+
+	for attempt := 2; attempt <= b.Retries+1 && len(pending) > 0; attempt++ {
+		select {
+		case <-ctx.Done():
+			return items, ctx.Err()
+		case <-time.After(b.RetryDelay):
+		}
+		var stillPending []int
+		for _, i := range pending {
+			items[i] = b.fetch(ctx, b.Dates[i], attempt)
+			if items[i].Status == ReportBackfillPending {
+				stillPending = append(stillPending, i)
+			}
+		}
+		pending = stillPending
+	}
+	return items, nil
+}
+
+func (b ReportBackfill) fetch(ctx context.Context, date string, attempt int) asc.ReportBackfillItem {
+	compressedPath, decompressedPath := b.Paths(date)
+	item := asc.ReportBackfillItem{
+		ReportDate:       date,
+		FilePath:         compressedPath,
+		DecompressedPath: decompressedPath,
+		Attempts:         attempt,
+	}
+
+	compressedInfo, compressedErr := os.Stat(compressedPath)
+	var decompressedInfo os.FileInfo
+	decompressedErr := os.ErrNotExist
+	if decompressedPath != "" {
+		decompressedInfo, decompressedErr = os.Stat(decompressedPath)
+	}
+	if compressedErr == nil || decompressedErr == nil {
+		item.Status = ReportBackfillSkipped
+		item.Attempts = 0
+		if compressedErr == nil {
+			item.FileSize = compressedInfo.Size()
+		}
+		if decompressedPath == "" {
+			return item
+		}
+		if decompressedErr == nil {
+			item.DecompressedSize = decompressedInfo.Size()
+			return item
+		}
+		size, err := DecompressGzipFile(compressedPath, decompressedPath)
+		if err != nil {
+			item.Status = ReportBackfillFailed
+			item.Error = fmt.Sprintf("failed to decompress existing report: %v", err)
+			return item
+		}
+		item.DecompressedSize = size
+		return item
+	}
+
+	requestCtx, cancel := ContextWithTimeout(ctx)
+	defer cancel()
+
+	download, err := b.Download(requestCtx, date)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			item.Status = ReportBackfillPending
+		} else {
+			item.Status = ReportBackfillFailed
+		}
+		item.Error = err.Error()
+		return item
+	}
+	defer download.Body.Close()
+
+	compressedSize, decompressedSize, err := WriteReportStream(compressedPath, decompressedPath, download.Body)
+	if err != nil {
+		item.Status = ReportBackfillFailed
+		item.Error = fmt.Sprintf("failed to write report: %v", err)
+		return item
+	}
+	item.Status = ReportBackfillDownloaded
+	item.FileSize = compressedSize
+	item.DecompressedSize = decompressedSize
+	return item
+}
+
+// SummarizeReportBackfill fills in the status counts of result from its items.
+func SummarizeReportBackfill(result *asc.ReportBackfillResult) {
+	result.Downloaded, result.Skipped, result.Pending, result.Failed = 0, 0, 0, 0
+	for _, item := range result.Items {
+		switch item.Status {
+		case ReportBackfillDownloaded:
+			result.Downloaded++
+		case ReportBackfillSkipped:
+			result.Skipped++
+		case ReportBackfillPending:
+			result.Pending++
+		case ReportBackfillFailed:
+			result.Failed++
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestReportDateRange(t *testing.T) {
+	months, err := ReportDateRange("2024-11", "2025-02", "2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) })
+	if err != nil {
+		t.Fatalf("ReportDateRange() error: %v", err)
+	}
+	if fmt.Sprint(months) != "[2024-11 2024-12 2025-01 2025-02]" {
+		t.Fatalf("unexpected months: %v", months)
+	}
+
+	if _, err := ReportDateRange("2025-02", "2025-01", "2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }); err == nil {
+		t.Fatal("expected error when --from is after --to")
+	}
+	if _, err := ReportDateRange("2025-01-01", "2025-02", "2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }); err == nil || err.Error() != "--from must be in YYYY-MM format" {
+		t.Fatalf("expected format error, got %v", err)
+	}
+}
+
+func TestReportBackfillRun(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "2025-01-01.tsv.gz")
+	if err := os.WriteFile(existing, gzipBytes(t, "old"), 0o600); err != nil {
+		t.Fatalf("write existing report: %v", err)
+	}
+
+	calls := map[string]int{}
+	backfill := ReportBackfill{
+		Dates: []string{"2025-01-01", "2025-01-02", "2025-01-03", "2025-01-04", "2025-01-05"},
+		Paths: func(date string) (string, string) {
+			path := filepath.Join(dir, date+".tsv.gz")
+			return path, ""
+		},
+		Download: func(ctx context.Context, date string) (*asc.ReportDownload, error) {
+			calls[date]++
+			switch {
+			case date == "2025-01-03" && calls[date] == 1, date == "2025-01-04":
+				return nil, fmt.Errorf("report not ready: %w", asc.ErrNotFound)
+			case date == "2025-01-05":
+				return nil, errors.New("forbidden")
+			}
+			return &asc.ReportDownload{Body: io.NopCloser(bytes.NewReader(gzipBytes(t, date)))}, nil
+		},
+		Retries: 1,
+	}
+
+	items, err := backfill.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+
+	want := []struct {
+		status   string
+		attempts int
+	}{
+		{ReportBackfillSkipped, 0},
+		{ReportBackfillDownloaded, 1},
+		{ReportBackfillDownloaded, 2},
+		{ReportBackfillPending, 2},
+		{ReportBackfillFailed, 1},
+	}
+	for i, w := range want {
+		if items[i].Status != w.status || items[i].Attempts != w.attempts {
+			t.Fatalf("item %d: expected %s after %d attempts, got %+v", i, w.status, w.attempts, items[i])
+		}
+	}
+	if calls["2025-01-01"] != 0 {
+		t.Fatal("expected existing report not to be downloaded")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "2025-01-04.tsv.gz")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected no file for pending date, got %v", err)
+	}
+
+	result := &asc.ReportBackfillResult{Items: items}
+	SummarizeReportBackfill(result)
+	if result.Downloaded != 2 || result.Skipped != 1 || result.Pending != 1 || result.Failed != 1 {
+		t.Fatalf("unexpected summary: %+v", result)
+	}
+}
+
+func TestReportBackfillDecompressesExistingReport(t *testing.T) {
+	dir := t.TempDir()
+	compressed := filepath.Join(dir, "2025-01.tsv.gz")
+	if err := os.WriteFile(compressed, gzipBytes(t, "row\n"), 0o600); err != nil {
+		t.Fatalf("write existing report: %v", err)
+	}
+
+	backfill := ReportBackfill{
+		Dates: []string{"2025-01"},
+		Paths: func(date string) (string, string) {
+			return ResolveReportOutputPaths(filepath.Join(dir, date+".tsv.gz"), "", ".tsv", true)
+		},
+		Download: func(ctx context.Context, date string) (*asc.ReportDownload, error) {
+			t.Fatal("unexpected download")
+			return nil, nil
+		},
+	}
+
+	items, err := backfill.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if items[0].Status != ReportBackfillSkipped || items[0].DecompressedSize != 4 {
+		t.Fatalf("unexpected item: %+v", items[0])
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "2025-01.tsv"))
+	if err != nil || string(data) != "row\n" {
+		t.Fatalf("expected decompressed report, got %q (%v)", data, err)
+	}
+}
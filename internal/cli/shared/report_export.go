@@ -0,0 +1,124 @@
+package shared
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/tableexport"
+)
+
+// ReportExport names the typed table files written from downloaded reports.
+// Empty paths are not written; existing files are replaced.
+type ReportExport struct {
+	SQLitePath  string
+	ParquetPath string
+}
+
+// Enabled reports whether any export file was requested.
+func (e ReportExport) Enabled() bool {
+	return e.SQLitePath != "" || e.ParquetPath != ""
+}
+
+// ReportExportFile is one downloaded report (.tsv or .tsv.gz) to export.
+type ReportExportFile struct {
+	ReportDate string
+	Path       string
+}
+
+// ExportReports loads report files into one table with a leading
+// report_date column and writes it to the requested files. Columns are the
+// union of the files' headers in first-seen order. It returns the number of
+// rows exported.
+func ExportReports(name string, files []ReportExportFile, export ReportExport) (int, error) {
+	header := []string{"Report Date"}
+	columns := make(map[string]int)
+	var rows [][]string
+	for _, file := range files {
+		fileHeader, fileRows, err := readReportTable(file.Path)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", file.Path, err)
+		}
+		positions := make([]int, len(fileHeader))
+		for i, title := range fileHeader {
+			position, ok := columns[title]
+			if !ok {
+				position = len(header)
+				columns[title] = position
+				header = append(header, title)
+			}
+			positions[i] = position
+		}
+		for _, fileRow := range fileRows {
+			row := make([]string, len(header))
+			row[0] = file.ReportDate
+			for i, value := range fileRow {
+				if i < len(positions) {
+					row[positions[i]] = value
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	return len(rows), ExportTable(tableexport.NewTable(name, header, rows), export)
+}
+
+// ExportTable writes table to the requested SQLite and Parquet files.
+func ExportTable(table *tableexport.Table, export ReportExport) error {
+	if export.SQLitePath != "" {
+		if err := tableexport.WriteSQLite(export.SQLitePath, table); err != nil {
+			return fmt.Errorf("failed to write SQLite database: %w", err)
+		}
+	}
+	if export.ParquetPath != "" {
+		if err := tableexport.WriteParquet(export.ParquetPath, table); err != nil {
+			return fmt.Errorf("failed to write Parquet file: %w", err)
+		}
+	}
+	return nil
+}
+
+func readReportTable(path string) ([]string, [][]string, error) {
+	file, err := OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer gz.Close()
+		reader = gz
+	}
+	return tableexport.ReadTSV(reader)
+}
+
+// ExportReportBackfill exports every report of result that is on disk,
+// downloaded now or earlier, and records the export in result.
+func ExportReportBackfill(name string, result *asc.ReportBackfillResult, export ReportExport) error {
+	var files []ReportExportFile
+	for _, item := range result.Items {
+		if item.Status != ReportBackfillDownloaded && item.Status != ReportBackfillSkipped {
+			continue
+		}
+		path := item.FilePath
+		if item.DecompressedPath != "" {
+			path = item.DecompressedPath
+		}
+		files = append(files, ReportExportFile{ReportDate: item.ReportDate, Path: path})
+	}
+	rows, err := ExportReports(name, files, export)
+	if err != nil {
+		return err
+	}
+	result.SQLitePath = export.SQLitePath
+	result.ParquetPath = export.ParquetPath
+	result.ExportedRows = rows
+	return nil
+}
@@ -109,3 +109,86 @@ func DecompressGzipFile(sourcePath, destPath string) (int64, error) {
 	}
 	return written, out.Sync()
 }
+
+// WriteReportStream writes a gzip report stream to compressedPath. When
+// decompressedPath is set, the stream is also inflated to decompressedPath in
+// the same pass, so the report is never buffered in memory or re-read from disk.
+// On error the files it created are removed, so a rerun can write them again.
+func WriteReportStream(compressedPath, decompressedPath string, reader io.Reader) (compressedSize, decompressedSize int64, err error) {
+	var created []string
+	defer func() {
+		if err != nil {
+			for _, path := range created {
+				_ = os.Remove(path)
+			}
+		}
+	}()
+
+	compressed, err := createReportFile(compressedPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	created = append(created, compressedPath)
+	defer compressed.Close()
+
+	if strings.TrimSpace(decompressedPath) == "" {
+		written, err := io.Copy(compressed, reader)
+		if err != nil {
+			return 0, 0, err
+		}
+		return written, 0, compressed.Sync()
+	}
+
+	decompressed, err := createReportFile(decompressedPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	created = append(created, decompressedPath)
+	defer decompressed.Close()
+
+	counter := &countingWriter{w: compressed}
+	tee := io.TeeReader(reader, counter)
+	gz, err := gzip.NewReader(tee)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer gz.Close()
+
+	inflated, err := io.Copy(decompressed, gz)
+	if err != nil {
+		return 0, 0, err
+	}
+	// Keep any trailing bytes so the compressed file matches the download.
+	if _, err := io.Copy(io.Discard, tee); err != nil {
+		return 0, 0, err
+	}
+	if err := compressed.Sync(); err != nil {
+		return 0, 0, err
+	}
+	return counter.n, inflated, decompressed.Sync()
+}
+
+func createReportFile(path string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	file, err := OpenNewFileNoFollow(path, 0o600)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("output file already exists: %w", err)
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
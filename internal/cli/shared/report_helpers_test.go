@@ -0,0 +1,108 @@
+package shared
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func gzipBytes(t *testing.T, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write([]byte(content)); err != nil {
+		t.Fatalf("write gzip: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("close gzip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestWriteReportStreamDecompressesInOnePass(t *testing.T) {
+	dir := t.TempDir()
+	compressedPath := filepath.Join(dir, "report.tsv.gz")
+	decompressedPath := filepath.Join(dir, "report.tsv")
+	content := strings.Repeat("row\tvalue\n", 10000)
+	payload := gzipBytes(t, content)
+
+	compressedSize, decompressedSize, err := WriteReportStream(compressedPath, decompressedPath, bytes.NewReader(payload))
+	if err != nil {
+		t.Fatalf("WriteReportStream() error: %v", err)
+	}
+	if compressedSize != int64(len(payload)) {
+		t.Fatalf("expected compressed size %d, got %d", len(payload), compressedSize)
+	}
+	if decompressedSize != int64(len(content)) {
+		t.Fatalf("expected decompressed size %d, got %d", len(content), decompressedSize)
+	}
+
+	gotCompressed, err := os.ReadFile(compressedPath)
+	if err != nil {
+		t.Fatalf("read compressed: %v", err)
+	}
+	if !bytes.Equal(gotCompressed, payload) {
+		t.Fatal("compressed file does not match download")
+	}
+	gotDecompressed, err := os.ReadFile(decompressedPath)
+	if err != nil {
+		t.Fatalf("read decompressed: %v", err)
+	}
+	if string(gotDecompressed) != content {
+		t.Fatal("decompressed file does not match content")
+	}
+}
+
+func TestWriteReportStreamWithoutDecompress(t *testing.T) {
+	compressedPath := filepath.Join(t.TempDir(), "report.tsv.gz")
+	payload := gzipBytes(t, "hello")
+
+	compressedSize, decompressedSize, err := WriteReportStream(compressedPath, "", bytes.NewReader(payload))
+	if err != nil {
+		t.Fatalf("WriteReportStream() error: %v", err)
+	}
+	if compressedSize != int64(len(payload)) || decompressedSize != 0 {
+		t.Fatalf("unexpected sizes: %d, %d", compressedSize, decompressedSize)
+	}
+}
+
+func TestWriteReportStreamRejectsNonGzip(t *testing.T) {
+	dir := t.TempDir()
+	compressedPath := filepath.Join(dir, "report.gz")
+	decompressedPath := filepath.Join(dir, "report.tsv")
+	_, _, err := WriteReportStream(compressedPath, decompressedPath, strings.NewReader("plain text"))
+	if err == nil {
+		t.Fatal("expected error for non-gzip stream")
+	}
+	for _, path := range []string{compressedPath, decompressedPath} {
+		if _, statErr := os.Stat(path); !os.IsNotExist(statErr) {
+			t.Fatalf("expected %s to be removed after the failure, got %v", path, statErr)
+		}
+	}
+
+	// A rerun must not fail on files left behind by the failed write.
+	if _, _, err := WriteReportStream(compressedPath, decompressedPath, bytes.NewReader(gzipBytes(t, "x"))); err != nil {
+		t.Fatalf("expected rerun to succeed, got %v", err)
+	}
+}
+
+func TestWriteReportStreamRefusesExistingFile(t *testing.T) {
+	dir := t.TempDir()
+	decompressedPath := filepath.Join(dir, "report.tsv")
+	if err := os.WriteFile(decompressedPath, []byte("existing"), 0o600); err != nil {
+		t.Fatalf("write existing: %v", err)
+	}
+	_, _, err := WriteReportStream(filepath.Join(dir, "report.tsv.gz"), decompressedPath, bytes.NewReader(gzipBytes(t, "x")))
+	if err == nil || !strings.Contains(err.Error(), "output file already exists") {
+		t.Fatalf("expected existing file error, got %v", err)
+	}
+	if _, statErr := os.Stat(filepath.Join(dir, "report.tsv.gz")); !os.IsNotExist(statErr) {
+		t.Fatalf("expected compressed file to be removed, got %v", statErr)
+	}
+	if data, _ := os.ReadFile(decompressedPath); string(data) != "existing" {
+		t.Fatalf("expected existing file to be left alone, got %q", data)
+	}
+}
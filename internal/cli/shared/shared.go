@@ -28,6 +28,14 @@ var (
 	reset = "\033[22m"
 )
 
+// ANSI escape codes for foreground colors
+var (
+	red        = "\033[31m"
+	green      = "\033[32m"
+	yellow     = "\033[33m"
+	resetColor = "\033[39m"
+)
+
 const (
 	privateKeyEnvVar       = "ASC_PRIVATE_KEY"
 	privateKeyBase64EnvVar = "ASC_PRIVATE_KEY_B64"
@@ -64,6 +72,7 @@ var (
 	retryLog            OptionalBool
 	debug               OptionalBool
 	apiDebug            OptionalBool
+	redact              OptionalBool
 	noUpdate            bool
 )
 
@@ -80,6 +89,9 @@ func BindRootFlags(fs *flag.FlagSet) {
 	fs.Var(&debug, "debug", "Enable debug logging to stderr")
 	fs.Var(&apiDebug, "api-debug", "Enable HTTP debug logging to stderr (redacts sensitive values)")
 	fs.BoolVar(&noUpdate, "no-update", false, "Skip update checks and auto-update")
+	fs.Var(&redact, "redact", "Mask emails, tester names, and revenue figures in table/markdown output (overrides ASC_REDACT/config when set)")
+	fs.StringVar(&webhookURL, "webhook", "", "POST JSON, slack-blocks, or teams output to this webhook URL")
+	fs.BoolVar(&diffSinceLast, "diff-since-last", false, "Print only items added, removed, or changed since the previous run of the same command")
 	BindCIFlags(fs)
 }
 
@@ -88,6 +100,16 @@ func SelectedProfile() string {
 	return selectedProfile
 }
 
+// ApplyRedactOverride passes an explicit --redact value to the renderer.
+func ApplyRedactOverride() {
+	if redact.IsSet() {
+		value := redact.Value()
+		asc.SetRedactOverride(&value)
+		return
+	}
+	asc.SetRedactOverride(nil)
+}
+
 // NoUpdate reports whether update checks are disabled via flag.
 func NoUpdate() bool {
 	return noUpdate
@@ -133,6 +155,28 @@ func Bold(s string) string {
 	return bold + s + reset
 }
 
+// Red returns the string wrapped in ANSI red codes
+func Red(s string) string {
+	return colorize(red, s)
+}
+
+// Green returns the string wrapped in ANSI green codes
+func Green(s string) string {
+	return colorize(green, s)
+}
+
+// Yellow returns the string wrapped in ANSI yellow codes
+func Yellow(s string) string {
+	return colorize(yellow, s)
+}
+
+func colorize(code, s string) string {
+	if !supportsANSI() {
+		return s
+	}
+	return code + s + resetColor
+}
+
 func supportsANSI() bool {
 	if _, ok := os.LookupEnv("NO_COLOR"); ok {
 		return false
@@ -512,13 +556,20 @@ func strictAuthEnabled() bool {
 }
 
 func printOutput(data interface{}, format string, pretty bool) error {
+	if err := validateWebhookOutput(format); err != nil {
+		return err
+	}
+	if diffSinceLast {
+		return printOutputDiff(data, format, pretty)
+	}
+	return renderOutput(data, format, pretty)
+}
+
+func renderOutput(data interface{}, format string, pretty bool) error {
 	format = strings.ToLower(format)
 	switch format {
 	case "json":
-		if pretty {
-			return asc.PrintPrettyJSON(data)
-		}
-		return asc.PrintJSON(data)
+		return printJSONAndPostWebhook(data, pretty)
 	case "markdown", "md":
 		if pretty {
 			return fmt.Errorf("--pretty is only valid with JSON output")
@@ -529,6 +580,10 @@ func printOutput(data interface{}, format string, pretty bool) error {
 			return fmt.Errorf("--pretty is only valid with JSON output")
 		}
 		return asc.PrintTable(data)
+	case OutputFormatGitHubActions:
+		return renderGitHubActions(data, pretty)
+	case OutputFormatSlackBlocks, OutputFormatTeams:
+		return renderMessagePayload(data, format, pretty)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
@@ -590,7 +645,7 @@ var (
 
 // DefaultOutputFormat returns the default output format for CLI commands.
 // It checks the ASC_DEFAULT_OUTPUT environment variable first, falling back to "json".
-// Valid values are "json", "table", "markdown", and "md".
+// Valid values are "json", "table", "markdown", "md", "gha", "slack-blocks", and "teams".
 func DefaultOutputFormat() string {
 	defaultOutputOnce.Do(func() {
 		defaultOutputValue = resolveDefaultOutput()
@@ -605,10 +660,10 @@ func resolveDefaultOutput() string {
 	}
 	normalized := strings.ToLower(env)
 	switch normalized {
-	case "json", "table", "markdown", "md":
+	case "json", "table", "markdown", "md", OutputFormatGitHubActions, OutputFormatSlackBlocks, OutputFormatTeams:
 		return normalized
 	default:
-		fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected json, table, markdown, or md); using json\n", defaultOutputEnvVar, env)
+		fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected json, table, markdown, md, gha, slack-blocks, or teams); using json\n", defaultOutputEnvVar, env)
 		return "json"
 	}
 }
@@ -714,6 +769,18 @@ func PrintOutput(data interface{}, format string, pretty bool) error {
 	return printOutput(data, format, pretty)
 }
 
+// PrintOutputWithCSV is PrintOutput for commands that also offer --output csv,
+// which prints the table rows as CSV.
+func PrintOutputWithCSV(data interface{}, format string, pretty bool) error {
+	if strings.EqualFold(strings.TrimSpace(format), "csv") {
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		return asc.PrintCSV(data)
+	}
+	return printOutput(data, format, pretty)
+}
+
 func NormalizeDate(value, flagName string) (string, error) {
 	return normalizeDate(value, flagName)
 }
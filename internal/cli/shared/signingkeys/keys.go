@@ -0,0 +1,106 @@
+// Package signingkeys handles the local key material behind signing
+// certificates: private keys, certificate signing requests, and PKCS#12
+// exports.
+package signingkeys
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+)
+
+// P12PasswordEnvVar supplies the .p12 password without putting it on the command line.
+const P12PasswordEnvVar = "ASC_P12_PASSWORD"
+
+// KeyBits is the RSA key size Apple accepts for signing certificates.
+const KeyBits = 2048
+
+// GenerateKeyAndCSR creates an RSA private key and a CSR signed with it. It
+// returns the PEM-encoded key and the base64 DER CSR that CreateCertificate expects.
+func GenerateKeyAndCSR(commonName string) ([]byte, string, error) {
+	if commonName == "" {
+		return nil, "", fmt.Errorf("--common-name must not be empty")
+	}
+	key, err := rsa.GenerateKey(rand.Reader, KeyBits)
+	if err != nil {
+		return nil, "", fmt.Errorf("generate private key: %w", err)
+	}
+	csr, err := NewCSR(key, commonName)
+	if err != nil {
+		return nil, "", err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return keyPEM, csr, nil
+}
+
+// NewCSR creates a base64 DER certificate signing request for an existing key.
+func NewCSR(key crypto.PrivateKey, commonName string) (string, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return "", fmt.Errorf("unsupported private key type %T", key)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}, signer)
+	if err != nil {
+		return "", fmt.Errorf("create CSR: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(csr), nil
+}
+
+// ReadPrivateKey reads an unencrypted PEM private key.
+func ReadPrivateKey(path string) (crypto.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read --key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("--key %s is not a PEM file", path)
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "ENCRYPTED PRIVATE KEY":
+		return nil, fmt.Errorf("--key %s is encrypted; decrypt it first (openssl pkey -in key.pem -out key-plain.pem)", path)
+	default:
+		return nil, fmt.Errorf("--key %s contains %q, not a private key", path, block.Type)
+	}
+}
+
+// KeyMatchesCertificate reports whether key is the private half of the
+// certificate's public key.
+func KeyMatchesCertificate(key crypto.PrivateKey, cert *x509.Certificate) bool {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return false
+	}
+	public, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	return ok && public.Equal(cert.PublicKey)
+}
+
+// ExportPKCS12 checks that key belongs to the certificate and bundles both
+// into a password-protected PKCS#12 file.
+func ExportPKCS12(certificateDER []byte, key crypto.PrivateKey, name, password string) ([]byte, error) {
+	cert, err := x509.ParseCertificate(certificateDER)
+	if err != nil {
+		return nil, fmt.Errorf("parse certificate: %w", err)
+	}
+	if _, ok := key.(crypto.Signer); !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	if !KeyMatchesCertificate(key, cert) {
+		return nil, fmt.Errorf("private key does not match the certificate")
+	}
+	return encodePKCS12(key, cert, name, password)
+}
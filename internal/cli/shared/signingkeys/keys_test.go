@@ -0,0 +1,138 @@
+package signingkeys
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateKeyAndCSR(t *testing.T) {
+	keyPEM, csrContent, err := GenerateKeyAndCSR("Example Distribution")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	block, _ := pem.Decode(keyPEM)
+	if block == nil || block.Type != "RSA PRIVATE KEY" {
+		t.Fatalf("expected RSA PRIVATE KEY PEM, got %q", keyPEM)
+	}
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("parse key: %v", err)
+	}
+	if key.N.BitLen() != KeyBits {
+		t.Fatalf("expected %d-bit key, got %d", KeyBits, key.N.BitLen())
+	}
+
+	der, err := base64.StdEncoding.DecodeString(csrContent)
+	if err != nil {
+		t.Fatalf("decode CSR: %v", err)
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		t.Fatalf("parse CSR: %v", err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		t.Fatalf("CSR signature: %v", err)
+	}
+	if csr.Subject.CommonName != "Example Distribution" {
+		t.Fatalf("unexpected common name %q", csr.Subject.CommonName)
+	}
+	if !key.PublicKey.Equal(csr.PublicKey) {
+		t.Fatal("expected CSR to carry the generated public key")
+	}
+}
+
+func TestGenerateKeyAndCSR_EmptyCommonName(t *testing.T) {
+	if _, _, err := GenerateKeyAndCSR(""); err == nil || !strings.Contains(err.Error(), "--common-name") {
+		t.Fatalf("expected common name error, got %v", err)
+	}
+}
+
+func TestNewCSR_UsesExistingKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	content, err := NewCSR(key, "Example")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	der, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		t.Fatalf("decode CSR: %v", err)
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		t.Fatalf("parse CSR: %v", err)
+	}
+	if !key.PublicKey.Equal(csr.PublicKey) {
+		t.Fatal("expected CSR to carry the supplied public key")
+	}
+}
+
+func TestKeyMatchesCertificate(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	cert := testCertificate(t, key)
+	if !KeyMatchesCertificate(key, cert) {
+		t.Fatal("expected key to match its certificate")
+	}
+	if KeyMatchesCertificate(other, cert) {
+		t.Fatal("expected other key not to match")
+	}
+}
+
+func TestReadPrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+
+	dir := t.TempDir()
+	write := func(name string, block *pem.Block) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, pem.EncodeToMemory(block), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		return path
+	}
+
+	for _, path := range []string{
+		write("pkcs1.key", &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		write("pkcs8.key", &pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}),
+	} {
+		parsed, err := ReadPrivateKey(path)
+		if err != nil {
+			t.Fatalf("read %s: %v", path, err)
+		}
+		if !key.Equal(parsed) {
+			t.Fatalf("expected %s to decode to the key", path)
+		}
+	}
+
+	if _, err := ReadPrivateKey(write("encrypted.key", &pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: []byte{1}})); err == nil || !strings.Contains(err.Error(), "encrypted") {
+		t.Fatalf("expected encrypted key error, got %v", err)
+	}
+	if _, err := ReadPrivateKey(write("cert.pem", &pem.Block{Type: "CERTIFICATE", Bytes: []byte{1}})); err == nil || !strings.Contains(err.Error(), "not a private key") {
+		t.Fatalf("expected type error, got %v", err)
+	}
+}
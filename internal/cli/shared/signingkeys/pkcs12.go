@@ -0,0 +1,288 @@
+package signingkeys
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"unicode/utf16"
+)
+
+// PKCS#12 files use the SHA-1 / 3DES profile that macOS keychains and
+// OpenSSL both import without legacy providers.
+const p12Iterations = 2048
+
+// PKCS#12 key derivation purposes (RFC 7292, appendix B.3).
+const (
+	p12KDFKeyID byte = 1
+	p12KDFIVID  byte = 2
+	p12KDFMACID byte = 3
+)
+
+var (
+	oidData                       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSHA1                       = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidPBEWithSHAAnd3KeyTripleDES = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	oidPKCS8ShroudedKeyBag        = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertBag                    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidX509Certificate            = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidFriendlyName               = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 20}
+	oidLocalKeyID                 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+)
+
+type p12PFX struct {
+	Version  int
+	AuthSafe p12ContentInfo
+	MacData  p12MacData
+}
+
+type p12ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type p12MacData struct {
+	Mac        p12DigestInfo
+	MacSalt    []byte
+	Iterations int
+}
+
+type p12DigestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+type p12SafeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue
+	Attributes []p12Attribute `asn1:"set"`
+}
+
+type p12Attribute struct {
+	ID     asn1.ObjectIdentifier
+	Values asn1.RawValue
+}
+
+type p12CertBag struct {
+	ID   asn1.ObjectIdentifier
+	Data asn1.RawValue
+}
+
+type p12PBEParams struct {
+	Salt       []byte
+	Iterations int
+}
+
+type p12EncryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+// encodePKCS12 bundles a certificate and its private key into a
+// password-protected PKCS#12 (.p12) file.
+func encodePKCS12(key crypto.PrivateKey, cert *x509.Certificate, friendlyName, password string) ([]byte, error) {
+	if password == "" {
+		return nil, fmt.Errorf("a password is required")
+	}
+	bmpPassword := p12BMPString(password)
+
+	localKeyID := sha1.Sum(cert.Raw)
+	attributes, err := p12BagAttributes(localKeyID[:], friendlyName)
+	if err != nil {
+		return nil, err
+	}
+
+	certValue, err := asn1.Marshal(cert.Raw)
+	if err != nil {
+		return nil, err
+	}
+	certBag, err := asn1.Marshal(p12CertBag{ID: oidX509Certificate, Data: p12Explicit(certValue)})
+	if err != nil {
+		return nil, err
+	}
+	certSafe, err := asn1.Marshal([]p12SafeBag{{ID: oidCertBag, Value: p12Explicit(certBag), Attributes: attributes}})
+	if err != nil {
+		return nil, err
+	}
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("encode private key: %w", err)
+	}
+	salt, err := p12Salt()
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := p12Encrypt(pkcs8, bmpPassword, salt)
+	if err != nil {
+		return nil, err
+	}
+	params, err := asn1.Marshal(p12PBEParams{Salt: salt, Iterations: p12Iterations})
+	if err != nil {
+		return nil, err
+	}
+	shroudedKey, err := asn1.Marshal(p12EncryptedPrivateKeyInfo{
+		Algorithm:     pkix.AlgorithmIdentifier{Algorithm: oidPBEWithSHAAnd3KeyTripleDES, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: encryptedKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	keySafe, err := asn1.Marshal([]p12SafeBag{{ID: oidPKCS8ShroudedKeyBag, Value: p12Explicit(shroudedKey), Attributes: attributes}})
+	if err != nil {
+		return nil, err
+	}
+
+	certInfo, err := p12DataContentInfo(certSafe)
+	if err != nil {
+		return nil, err
+	}
+	keyInfo, err := p12DataContentInfo(keySafe)
+	if err != nil {
+		return nil, err
+	}
+	authSafe, err := asn1.Marshal([]p12ContentInfo{certInfo, keyInfo})
+	if err != nil {
+		return nil, err
+	}
+	authSafeInfo, err := p12DataContentInfo(authSafe)
+	if err != nil {
+		return nil, err
+	}
+
+	macSalt, err := p12Salt()
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha1.New, p12KDF(p12KDFMACID, bmpPassword, macSalt, p12Iterations, sha1.Size))
+	mac.Write(authSafe)
+
+	return asn1.Marshal(p12PFX{
+		Version:  3,
+		AuthSafe: authSafeInfo,
+		MacData: p12MacData{
+			Mac: p12DigestInfo{
+				Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+				Digest:    mac.Sum(nil),
+			},
+			MacSalt:    macSalt,
+			Iterations: p12Iterations,
+		},
+	})
+}
+
+func p12BagAttributes(localKeyID []byte, friendlyName string) ([]p12Attribute, error) {
+	keyID, err := asn1.Marshal(localKeyID)
+	if err != nil {
+		return nil, err
+	}
+	attributes := []p12Attribute{{ID: oidLocalKeyID, Values: p12Set(keyID)}}
+	if friendlyName != "" {
+		bmpName := p12BMPString(friendlyName)
+		name, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagBMPString, Bytes: bmpName[:len(bmpName)-2]})
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, p12Attribute{ID: oidFriendlyName, Values: p12Set(name)})
+	}
+	return attributes, nil
+}
+
+func p12DataContentInfo(content []byte) (p12ContentInfo, error) {
+	octets, err := asn1.Marshal(content)
+	if err != nil {
+		return p12ContentInfo{}, err
+	}
+	return p12ContentInfo{ContentType: oidData, Content: p12Explicit(octets)}, nil
+}
+
+// p12Explicit wraps DER in an explicit [0] tag.
+func p12Explicit(der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}
+}
+
+func p12Set(der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: der}
+}
+
+func p12Salt() ([]byte, error) {
+	salt := make([]byte, 8)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("generate salt: %w", err)
+	}
+	return salt, nil
+}
+
+// p12Encrypt encrypts data with pbeWithSHAAnd3-KeyTripleDES-CBC.
+func p12Encrypt(data, bmpPassword, salt []byte) ([]byte, error) {
+	block, err := des.NewTripleDESCipher(p12KDF(p12KDFKeyID, bmpPassword, salt, p12Iterations, 24))
+	if err != nil {
+		return nil, err
+	}
+	iv := p12KDF(p12KDFIVID, bmpPassword, salt, p12Iterations, block.BlockSize())
+
+	padding := block.BlockSize() - len(data)%block.BlockSize()
+	padded := append(append([]byte{}, data...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	encrypted := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, padded)
+	return encrypted, nil
+}
+
+// p12BMPString encodes value as a NUL-terminated big-endian UTF-16 string.
+func p12BMPString(value string) []byte {
+	units := utf16.Encode([]rune(value))
+	encoded := make([]byte, 0, len(units)*2+2)
+	for _, unit := range units {
+		encoded = append(encoded, byte(unit>>8), byte(unit))
+	}
+	return append(encoded, 0, 0)
+}
+
+// p12KDF derives key material with the PKCS#12 key derivation function
+// (RFC 7292, appendix B.2) using SHA-1.
+func p12KDF(id byte, password, salt []byte, iterations, size int) []byte {
+	const u, v = sha1.Size, 64
+
+	fill := func(source []byte) []byte {
+		if len(source) == 0 {
+			return nil
+		}
+		out := make([]byte, v*((len(source)+v-1)/v))
+		for i := range out {
+			out[i] = source[i%len(source)]
+		}
+		return out
+	}
+
+	diversifier := bytes.Repeat([]byte{id}, v)
+	input := append(fill(salt), fill(password)...)
+
+	derived := make([]byte, 0, size+u)
+	for len(derived) < size {
+		hash := sha1.Sum(append(append([]byte{}, diversifier...), input...))
+		block := hash[:]
+		for i := 1; i < iterations; i++ {
+			next := sha1.Sum(block)
+			block = next[:]
+		}
+		derived = append(derived, block...)
+
+		b := fill(block)
+		for j := 0; j < len(input); j += v {
+			carry := 1
+			for k := v - 1; k >= 0; k-- {
+				sum := int(input[j+k]) + int(b[k]) + carry
+				input[j+k] = byte(sum)
+				carry = sum >> 8
+			}
+		}
+	}
+	return derived[:size]
+}
@@ -0,0 +1,167 @@
+package signingkeys
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testCertificate(t *testing.T, key *rsa.PrivateKey) *x509.Certificate {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Example Distribution"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return cert
+}
+
+func TestP12KDF_KnownVector(t *testing.T) {
+	// Test vector from the PKCS#12 key derivation tests in OpenSSL / BouncyCastle.
+	salt, _ := hex.DecodeString("0a58cf64530d823f")
+	got := p12KDF(p12KDFKeyID, p12BMPString("smeg"), salt, 1, 24)
+	want := "8aaae6297b6cb04642ab5b077851284eb7128f1a2a7fbca3"
+	if hex.EncodeToString(got) != want {
+		t.Fatalf("expected %s, got %x", want, got)
+	}
+}
+
+func TestEncodePKCS12_RoundTrip(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	cert := testCertificate(t, key)
+
+	data, err := encodePKCS12(key, cert, "Example Distribution", "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var pfx p12PFX
+	if rest, err := asn1.Unmarshal(data, &pfx); err != nil || len(rest) != 0 {
+		t.Fatalf("parse PFX: %v (rest %d)", err, len(rest))
+	}
+	if pfx.Version != 3 || !pfx.AuthSafe.ContentType.Equal(oidData) {
+		t.Fatalf("unexpected PFX header: %+v", pfx)
+	}
+
+	var authSafe []byte
+	if _, err := asn1.Unmarshal(pfx.AuthSafe.Content.Bytes, &authSafe); err != nil {
+		t.Fatalf("parse authSafe: %v", err)
+	}
+	password := p12BMPString("secret")
+	mac := hmac.New(sha1.New, p12KDF(p12KDFMACID, password, pfx.MacData.MacSalt, pfx.MacData.Iterations, sha1.Size))
+	mac.Write(authSafe)
+	if !hmac.Equal(mac.Sum(nil), pfx.MacData.Mac.Digest) {
+		t.Fatal("MAC does not verify with the password")
+	}
+
+	var contents []p12ContentInfo
+	if _, err := asn1.Unmarshal(authSafe, &contents); err != nil || len(contents) != 2 {
+		t.Fatalf("parse contents: %v (%d)", err, len(contents))
+	}
+	bags := make([]p12SafeBag, 0, 2)
+	for _, content := range contents {
+		var safe []byte
+		if _, err := asn1.Unmarshal(content.Content.Bytes, &safe); err != nil {
+			t.Fatalf("parse safe contents: %v", err)
+		}
+		var parsed []p12SafeBag
+		if _, err := asn1.Unmarshal(safe, &parsed); err != nil {
+			t.Fatalf("parse bags: %v", err)
+		}
+		bags = append(bags, parsed...)
+	}
+	if len(bags) != 2 || !bags[0].ID.Equal(oidCertBag) || !bags[1].ID.Equal(oidPKCS8ShroudedKeyBag) {
+		t.Fatalf("unexpected bags: %+v", bags)
+	}
+	for _, bag := range bags {
+		if len(bag.Attributes) != 2 || !bag.Attributes[0].ID.Equal(oidLocalKeyID) || !bag.Attributes[1].ID.Equal(oidFriendlyName) {
+			t.Fatalf("unexpected bag attributes: %+v", bag.Attributes)
+		}
+	}
+
+	var certBag p12CertBag
+	if _, err := asn1.Unmarshal(bags[0].Value.Bytes, &certBag); err != nil {
+		t.Fatalf("parse cert bag: %v", err)
+	}
+	var certDER []byte
+	if _, err := asn1.Unmarshal(certBag.Data.Bytes, &certDER); err != nil {
+		t.Fatalf("parse cert value: %v", err)
+	}
+	if !bytes.Equal(certDER, cert.Raw) {
+		t.Fatal("expected cert bag to carry the certificate")
+	}
+
+	var shrouded p12EncryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(bags[1].Value.Bytes, &shrouded); err != nil {
+		t.Fatalf("parse key bag: %v", err)
+	}
+	var params p12PBEParams
+	if _, err := asn1.Unmarshal(shrouded.Algorithm.Parameters.FullBytes, &params); err != nil {
+		t.Fatalf("parse PBE params: %v", err)
+	}
+	block, err := des.NewTripleDESCipher(p12KDF(p12KDFKeyID, password, params.Salt, params.Iterations, 24))
+	if err != nil {
+		t.Fatalf("cipher: %v", err)
+	}
+	plain := make([]byte, len(shrouded.EncryptedData))
+	cipher.NewCBCDecrypter(block, p12KDF(p12KDFIVID, password, params.Salt, params.Iterations, 8)).CryptBlocks(plain, shrouded.EncryptedData)
+	plain = plain[:len(plain)-int(plain[len(plain)-1])]
+	decoded, err := x509.ParsePKCS8PrivateKey(plain)
+	if err != nil {
+		t.Fatalf("parse decrypted key: %v", err)
+	}
+	if !key.Equal(decoded) {
+		t.Fatal("expected decrypted key to match")
+	}
+}
+
+func TestEncodePKCS12_RequiresPassword(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	if _, err := encodePKCS12(key, testCertificate(t, key), "", ""); err == nil {
+		t.Fatal("expected error without a password")
+	}
+}
+
+func TestExportPKCS12_RejectsMismatchedKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	_, err = ExportPKCS12(testCertificate(t, key).Raw, other, "", "secret")
+	if err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Fatalf("expected mismatch error, got %v", err)
+	}
+}
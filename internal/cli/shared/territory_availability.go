@@ -0,0 +1,82 @@
+package shared
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// FetchTerritoryAvailabilities returns every territory availability for an app availability.
+func FetchTerritoryAvailabilities(ctx context.Context, client *asc.Client, availabilityID string) (*asc.TerritoryAvailabilitiesResponse, error) {
+	firstPage, err := client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	territoryResp, ok := paginated.(*asc.TerritoryAvailabilitiesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected territory availabilities response")
+	}
+	return territoryResp, nil
+}
+
+type territoryAvailabilityIDPayload struct {
+	Territory string `json:"t"`
+}
+
+// TerritoryIDForAvailability returns the territory ID for a territory availability,
+// falling back to the territory encoded in the resource ID.
+func TerritoryIDForAvailability(item asc.Resource[asc.TerritoryAvailabilityAttributes]) (string, error) {
+	if len(item.Relationships) > 0 {
+		var relationships asc.TerritoryAvailabilityRelationships
+		if err := json.Unmarshal(item.Relationships, &relationships); err != nil {
+			return "", fmt.Errorf("decode territory availability relationships for %q: %w", item.ID, err)
+		}
+		if territoryID := strings.ToUpper(strings.TrimSpace(relationships.Territory.Data.ID)); territoryID != "" {
+			return territoryID, nil
+		}
+	}
+	territoryID, ok := territoryIDFromAvailabilityID(item.ID)
+	if !ok {
+		return "", fmt.Errorf("territory availability %q missing territory id", item.ID)
+	}
+	return territoryID, nil
+}
+
+func territoryIDFromAvailabilityID(availabilityID string) (string, bool) {
+	trimmed := strings.TrimSpace(availabilityID)
+	if trimmed == "" {
+		return "", false
+	}
+	decoded, err := base64.RawStdEncoding.DecodeString(trimmed)
+	if err != nil {
+		decoded, err = base64.StdEncoding.DecodeString(trimmed)
+		if err != nil {
+			decoded, err = base64.RawURLEncoding.DecodeString(trimmed)
+			if err != nil {
+				decoded, err = base64.URLEncoding.DecodeString(trimmed)
+				if err != nil {
+					return "", false
+				}
+			}
+		}
+	}
+	var payload territoryAvailabilityIDPayload
+	if err := json.Unmarshal(decoded, &payload); err != nil {
+		return "", false
+	}
+	territoryID := strings.TrimSpace(payload.Territory)
+	if territoryID == "" {
+		return "", false
+	}
+	return strings.ToUpper(territoryID), true
+}
@@ -0,0 +1,63 @@
+package shared
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+var uploadStateDir = defaultUploadStateDir
+
+func defaultUploadStateDir() (string, error) {
+	path, err := config.GlobalPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), "uploads"), nil
+}
+
+// UploadStatePath returns the file that records progress of uploading filePath
+// to target, where kind names the asset (screenshot, preview, build) and
+// target identifies where it goes (a set ID, or app, version, and build).
+func UploadStatePath(kind, target, filePath string) (string, error) {
+	dir, err := uploadStateDir()
+	if err != nil {
+		return "", err
+	}
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(kind + "\x00" + target + "\x00" + absPath))
+	return filepath.Join(dir, kind+"-"+hex.EncodeToString(sum[:8])+".json"), nil
+}
+
+// ResumableUploadState returns the saved state at statePath when resume is set
+// and the state was recorded for the file described by info. Otherwise any
+// saved state is discarded and nil is returned, so the caller starts a new
+// reservation.
+func ResumableUploadState(statePath string, info os.FileInfo, resume bool) (*asc.UploadState, error) {
+	state, err := asc.LoadUploadState(statePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		if resume {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring unreadable upload state: %v\n", err)
+		}
+		return nil, os.Remove(statePath)
+	}
+	if resume && state.MatchesFile(info) {
+		return state, nil
+	}
+	if resume {
+		fmt.Fprintf(os.Stderr, "Warning: %s changed since the interrupted upload; starting over\n", info.Name())
+	}
+	return nil, state.Remove()
+}
@@ -19,11 +19,13 @@ func SigningCommand() *ffcli.Command {
 		LongHelp: `Manage signing assets for App Store Connect.
 
 Examples:
-  asc signing fetch --bundle-id com.example.app --profile-type IOS_APP_STORE --output ./signing`,
+  asc signing fetch --bundle-id com.example.app --profile-type IOS_APP_STORE --output ./signing
+  asc signing setup --bundle-id com.example.app --type appstore --key ./dist.key --output ./signing`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			SigningFetchCommand(),
+			SigningSetupCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
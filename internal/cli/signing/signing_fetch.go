@@ -271,36 +271,11 @@ func isDevelopmentProfile(profileType string) bool {
 }
 
 func inferCertificateType(profileType string) (string, error) {
-	normalized := strings.ToUpper(strings.TrimSpace(profileType))
-
-	switch {
-	case strings.Contains(normalized, "IOS_APP_DEVELOPMENT"):
-		return "IOS_DEVELOPMENT", nil
-	case strings.Contains(normalized, "IOS_APP_STORE"),
-		strings.Contains(normalized, "IOS_APP_ADHOC"),
-		strings.Contains(normalized, "IOS_APP_INHOUSE"):
-		return "IOS_DISTRIBUTION", nil
-	case strings.Contains(normalized, "TVOS_APP_DEVELOPMENT"):
-		return "TVOS_DEVELOPMENT", nil
-	case strings.Contains(normalized, "TVOS_APP_STORE"),
-		strings.Contains(normalized, "TVOS_APP_ADHOC"),
-		strings.Contains(normalized, "TVOS_APP_INHOUSE"):
-		return "TVOS_DISTRIBUTION", nil
-	case strings.Contains(normalized, "MAC_CATALYST_APP_DEVELOPMENT"):
-		return "IOS_DEVELOPMENT", nil
-	case strings.Contains(normalized, "MAC_CATALYST_APP_STORE"):
-		return "MAC_APP_DISTRIBUTION", nil
-	case strings.Contains(normalized, "MAC_CATALYST_APP_DIRECT"):
-		return "DEVELOPER_ID_APPLICATION", nil
-	case strings.Contains(normalized, "MAC_APP_DEVELOPMENT"):
-		return "MAC_APP_DEVELOPMENT", nil
-	case strings.Contains(normalized, "MAC_APP_STORE"):
-		return "MAC_APP_DISTRIBUTION", nil
-	case strings.Contains(normalized, "MAC_APP_DIRECT"):
-		return "DEVELOPER_ID_APPLICATION", nil
-	default:
+	certType, ok := shared.CertificateTypeForProfileType(profileType)
+	if !ok {
 		return "", fmt.Errorf("unable to infer certificate type for profile type %s; use --certificate-type", profileType)
 	}
+	return certType, nil
 }
 
 func decodeBase64Content(label, content string) ([]byte, error) {
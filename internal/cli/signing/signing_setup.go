@@ -0,0 +1,363 @@
+package signing
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/signingkeys"
+)
+
+// SigningSetupCommand returns the signing setup subcommand.
+func SigningSetupCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("setup", flag.ExitOnError)
+
+	bundleID := fs.String("bundle-id", "", "Bundle identifier (e.g., com.example.app) - required")
+	signingType := fs.String("type", "appstore", "Signing type: "+strings.Join(setupSigningTypes(), ", "))
+	platform := fs.String("platform", "IOS", "Platform: "+strings.Join(setupPlatforms(), ", "))
+	certType := fs.String("certificate-type", "", "Certificate type (default: inferred from the profile type)")
+	keyPath := fs.String("key", "", "Private key (PEM) for the certificate; creates a certificate when none matches")
+	password := fs.String("password", "", "Password for the exported .p12 (or "+signingkeys.P12PasswordEnvVar+"); requires --key")
+	deviceIDs := fs.String("device", "", "Device ID(s), comma-separated (required to create development/ad hoc profiles)")
+	outputPath := fs.String("output", "./signing", "Output directory for signing files")
+	format := fs.String("format", "json", "Output format for metadata: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "setup",
+		ShortUsage: "asc signing setup --bundle-id com.example.app [--type appstore] [--key ./dist.key] [flags]",
+		ShortHelp:  "Ensure a valid certificate and profile exist and write them out.",
+		LongHelp: `Ensure a valid certificate and profile exist and write them out.
+
+signing setup resolves the bundle ID, then:
+  1. Finds valid (activated, unexpired) certificates of the matching type.
+     With --key, only a certificate issued for that key is used, and one is
+     created from a CSR for the key when none exists.
+  2. Finds an active, unexpired profile for the bundle ID that includes the
+     certificate, or creates one.
+  3. Writes the certificate (.cer), profile (.mobileprovision), and, with
+     --key, a password-protected .p12 into --output.
+
+The private key never leaves the machine. Existing files in --output are not
+overwritten, so point CI at a fresh directory.
+
+Examples:
+  asc signing setup --bundle-id com.example.app --type appstore
+  ` + signingkeys.P12PasswordEnvVar + `=secret asc signing setup --bundle-id com.example.app --type appstore --key ./dist.key --output ./signing
+  asc signing setup --bundle-id com.example.app --type development --device "DEVICE1,DEVICE2"
+  asc signing setup --bundle-id com.example.mac --platform MAC_OS --type direct --key ./devid.key`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			bundle := strings.TrimSpace(*bundleID)
+			if bundle == "" {
+				fmt.Fprintln(os.Stderr, "Error: --bundle-id is required")
+				return flag.ErrHelp
+			}
+			profileType, err := setupProfileType(*signingType, *platform)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return flag.ErrHelp
+			}
+			certificateType := strings.ToUpper(strings.TrimSpace(*certType))
+			if certificateType == "" {
+				certificateType, err = inferCertificateType(profileType)
+				if err != nil {
+					return fmt.Errorf("signing setup: %w", err)
+				}
+			}
+
+			keyValue := strings.TrimSpace(*keyPath)
+			passwordValue := *password
+			if keyValue != "" && passwordValue == "" {
+				passwordValue = os.Getenv(signingkeys.P12PasswordEnvVar)
+			}
+			if keyValue != "" && passwordValue == "" {
+				fmt.Fprintf(os.Stderr, "Error: --password (or %s) is required with --key\n", signingkeys.P12PasswordEnvVar)
+				return flag.ErrHelp
+			}
+			if keyValue == "" && *password != "" {
+				fmt.Fprintln(os.Stderr, "Error: --password requires --key")
+				return flag.ErrHelp
+			}
+			var key crypto.PrivateKey
+			if keyValue != "" {
+				key, err = signingkeys.ReadPrivateKey(keyValue)
+				if err != nil {
+					return fmt.Errorf("signing setup: %w", err)
+				}
+			}
+
+			outputDir := strings.TrimSpace(*outputPath)
+			if outputDir == "" {
+				outputDir = "./signing"
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("signing setup: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			result := &asc.SigningSetupResult{
+				BundleID:        bundle,
+				ProfileType:     profileType,
+				CertificateType: certificateType,
+				OutputPath:      outputDir,
+			}
+
+			bundleIDResp, err := findBundleID(requestCtx, client, bundle)
+			if err != nil {
+				return fmt.Errorf("signing setup: %w", err)
+			}
+			result.BundleIDResource = bundleIDResp.Data.ID
+
+			now := time.Now().UTC()
+			certificates, created, err := ensureSetupCertificates(requestCtx, client, certificateType, key, bundle, now)
+			if err != nil {
+				return fmt.Errorf("signing setup: %w", err)
+			}
+			result.CertificateIDs = extractIDs(certificates)
+			result.CertificateCreated = created
+
+			profile, created, err := ensureSetupProfile(requestCtx, client, bundleIDResp.Data.ID, bundle, profileType, result.CertificateIDs, shared.SplitCSV(*deviceIDs), now)
+			if err != nil {
+				return fmt.Errorf("signing setup: %w", err)
+			}
+			result.ProfileID = profile.ID
+			result.ProfileName = profile.Attributes.Name
+			result.ProfileExpiration = profile.Attributes.ExpirationDate
+			result.ProfileCreated = created
+
+			if err := os.MkdirAll(outputDir, 0o755); err != nil {
+				return fmt.Errorf("signing setup: create output dir: %w", err)
+			}
+
+			profileContent, err := decodeBase64Content("profile", profile.Attributes.ProfileContent)
+			if err != nil {
+				return fmt.Errorf("signing setup: decode profile: %w", err)
+			}
+			profilePath := filepath.Join(outputDir, safeFileName(profile.Attributes.Name, profile.ID)+".mobileprovision")
+			if err := shared.WriteProfileFile(profilePath, profileContent); err != nil {
+				return fmt.Errorf("signing setup: write profile: %w", err)
+			}
+			result.ProfileFile = profilePath
+
+			for _, certificate := range certificates {
+				content, err := decodeBase64Content("certificate", certificate.Attributes.CertificateContent)
+				if err != nil {
+					return fmt.Errorf("signing setup: decode certificate: %w", err)
+				}
+				base := safeFileName(certificate.Attributes.SerialNumber, certificate.ID)
+				certPath := filepath.Join(outputDir, base+".cer")
+				if err := writeBinaryFile(certPath, content); err != nil {
+					return fmt.Errorf("signing setup: write certificate: %w", err)
+				}
+				result.CertificateFiles = append(result.CertificateFiles, certPath)
+
+				if key != nil {
+					p12, err := signingkeys.ExportPKCS12(content, key, certificate.Attributes.Name, passwordValue)
+					if err != nil {
+						return fmt.Errorf("signing setup: export .p12: %w", err)
+					}
+					p12Path := filepath.Join(outputDir, base+".p12")
+					if err := writeBinaryFile(p12Path, p12); err != nil {
+						return fmt.Errorf("signing setup: write .p12: %w", err)
+					}
+					result.P12File = p12Path
+				}
+			}
+
+			return shared.PrintOutput(result, *format, *pretty)
+		},
+	}
+}
+
+// ensureSetupCertificates returns the valid certificates to sign with. With a
+// key, it returns the certificate issued for that key, creating one if needed.
+func ensureSetupCertificates(ctx context.Context, client *asc.Client, certificateType string, key crypto.PrivateKey, commonName string, now time.Time) ([]asc.Resource[asc.CertificateAttributes], bool, error) {
+	firstPage, err := client.GetCertificates(ctx, asc.WithCertificatesFilterType(certificateType), asc.WithCertificatesLimit(200))
+	if err != nil {
+		return nil, false, fmt.Errorf("fetch certificates: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCertificates(ctx, asc.WithCertificatesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("paginate certificates: %w", err)
+	}
+	all, ok := paginated.(*asc.CertificatesResponse)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected certificates response type %T", paginated)
+	}
+
+	var valid []asc.Resource[asc.CertificateAttributes]
+	for _, certificate := range all.Data {
+		if !setupCertificateValid(certificate.Attributes, now) {
+			continue
+		}
+		if key != nil && !setupCertificateMatchesKey(certificate.Attributes, key) {
+			continue
+		}
+		valid = append(valid, certificate)
+	}
+	if len(valid) > 0 {
+		if key != nil {
+			return valid[:1], false, nil
+		}
+		return valid, false, nil
+	}
+
+	if key == nil {
+		return nil, false, fmt.Errorf("no valid %s certificate found; pass --key to create one", certificateType)
+	}
+	csr, err := signingkeys.NewCSR(key, commonName)
+	if err != nil {
+		return nil, false, err
+	}
+	created, err := client.CreateCertificate(ctx, csr, certificateType)
+	if err != nil {
+		return nil, false, fmt.Errorf("create %s certificate: %w", certificateType, err)
+	}
+	return []asc.Resource[asc.CertificateAttributes]{created.Data}, true, nil
+}
+
+// ensureSetupProfile returns an active, unexpired profile for the bundle ID
+// that includes one of certificateIDs, creating a new one when none exists.
+func ensureSetupProfile(ctx context.Context, client *asc.Client, bundleIDResourceID, bundleIdentifier, profileType string, certificateIDs, deviceIDs []string, now time.Time) (asc.Resource[asc.ProfileAttributes], bool, error) {
+	firstPage, err := client.GetBundleIDProfiles(ctx, bundleIDResourceID, asc.WithBundleIDProfilesLimit(200))
+	if err != nil {
+		return asc.Resource[asc.ProfileAttributes]{}, false, fmt.Errorf("fetch profiles: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetBundleIDProfiles(ctx, bundleIDResourceID, asc.WithBundleIDProfilesNextURL(nextURL))
+	})
+	if err != nil {
+		return asc.Resource[asc.ProfileAttributes]{}, false, fmt.Errorf("paginate profiles: %w", err)
+	}
+	profiles, ok := paginated.(*asc.ProfilesResponse)
+	if !ok {
+		return asc.Resource[asc.ProfileAttributes]{}, false, fmt.Errorf("unexpected profiles response type %T", paginated)
+	}
+
+	wanted := make(map[string]struct{}, len(certificateIDs))
+	for _, id := range certificateIDs {
+		wanted[id] = struct{}{}
+	}
+	for _, profile := range profiles.Data {
+		if !strings.EqualFold(profile.Attributes.ProfileType, profileType) || profile.Attributes.ProfileState != asc.ProfileStateActive {
+			continue
+		}
+		if expires, err := time.Parse(time.RFC3339, strings.TrimSpace(profile.Attributes.ExpirationDate)); err != nil || !expires.After(now) {
+			continue
+		}
+		certificates, err := client.GetProfileCertificates(ctx, profile.ID, asc.WithProfileCertificatesLimit(200))
+		if err != nil {
+			return asc.Resource[asc.ProfileAttributes]{}, false, fmt.Errorf("fetch certificates for profile %s: %w", profile.ID, err)
+		}
+		for _, certificate := range certificates.Data {
+			if _, ok := wanted[certificate.ID]; !ok {
+				continue
+			}
+			if strings.TrimSpace(profile.Attributes.ProfileContent) == "" {
+				full, err := client.GetProfile(ctx, profile.ID)
+				if err != nil {
+					return asc.Resource[asc.ProfileAttributes]{}, false, fmt.Errorf("fetch profile %s: %w", profile.ID, err)
+				}
+				return full.Data, false, nil
+			}
+			return profile, false, nil
+		}
+	}
+
+	if isDevelopmentProfile(profileType) && len(deviceIDs) == 0 {
+		return asc.Resource[asc.ProfileAttributes]{}, false, fmt.Errorf("no valid %s profile found; --device is required to create one", profileType)
+	}
+	name := fmt.Sprintf("%s %s %s", bundleIdentifier, profileType, now.Format("20060102150405"))
+	created, err := client.CreateProfile(ctx, asc.ProfileCreateAttributes{
+		Name:        name,
+		ProfileType: profileType,
+	}, bundleIDResourceID, certificateIDs, deviceIDs)
+	if err != nil {
+		return asc.Resource[asc.ProfileAttributes]{}, false, fmt.Errorf("create profile: %w", err)
+	}
+	return created.Data, true, nil
+}
+
+func setupCertificateValid(attrs asc.CertificateAttributes, now time.Time) bool {
+	if attrs.Activated != nil && !*attrs.Activated {
+		return false
+	}
+	expires, err := time.Parse(time.RFC3339, strings.TrimSpace(attrs.ExpirationDate))
+	return err == nil && expires.After(now)
+}
+
+func setupCertificateMatchesKey(attrs asc.CertificateAttributes, key crypto.PrivateKey) bool {
+	content, err := decodeBase64Content("certificate", attrs.CertificateContent)
+	if err != nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(content)
+	if err != nil {
+		return false
+	}
+	return signingkeys.KeyMatchesCertificate(key, cert)
+}
+
+// setupProfileType maps a signing type and platform to a profile type.
+func setupProfileType(signingType, platform string) (string, error) {
+	kind := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(signingType), "-", ""))
+	platformValue := strings.ToUpper(strings.TrimSpace(platform))
+
+	prefixes := map[string]string{
+		"IOS":          "IOS_APP_",
+		"TV_OS":        "TVOS_APP_",
+		"MAC_OS":       "MAC_APP_",
+		"MAC_CATALYST": "MAC_CATALYST_APP_",
+	}
+	prefix, ok := prefixes[platformValue]
+	if !ok {
+		return "", fmt.Errorf("--platform must be one of: %s", strings.Join(setupPlatforms(), ", "))
+	}
+
+	suffixes := map[string]string{
+		"appstore":    "STORE",
+		"adhoc":       "ADHOC",
+		"development": "DEVELOPMENT",
+		"enterprise":  "INHOUSE",
+		"direct":      "DIRECT",
+	}
+	suffix, ok := suffixes[kind]
+	if !ok {
+		return "", fmt.Errorf("--type must be one of: %s", strings.Join(setupSigningTypes(), ", "))
+	}
+
+	isMac := platformValue == "MAC_OS" || platformValue == "MAC_CATALYST"
+	if isMac && (suffix == "ADHOC" || suffix == "INHOUSE") {
+		return "", fmt.Errorf("--type %s is not available for %s", signingType, platformValue)
+	}
+	if !isMac && suffix == "DIRECT" {
+		return "", fmt.Errorf("--type direct is only available for MAC_OS and MAC_CATALYST")
+	}
+	return prefix + suffix, nil
+}
+
+func setupSigningTypes() []string {
+	return []string{"appstore", "adhoc", "development", "enterprise", "direct"}
+}
+
+func setupPlatforms() []string {
+	return []string{"IOS", "TV_OS", "MAC_OS", "MAC_CATALYST"}
+}
@@ -0,0 +1,102 @@
+package signing
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestSetupProfileType(t *testing.T) {
+	tests := []struct {
+		signingType string
+		platform    string
+		want        string
+		wantErr     bool
+	}{
+		{signingType: "appstore", platform: "IOS", want: "IOS_APP_STORE"},
+		{signingType: "adhoc", platform: "ios", want: "IOS_APP_ADHOC"},
+		{signingType: "ad-hoc", platform: "IOS", want: "IOS_APP_ADHOC"},
+		{signingType: "development", platform: "TV_OS", want: "TVOS_APP_DEVELOPMENT"},
+		{signingType: "enterprise", platform: "IOS", want: "IOS_APP_INHOUSE"},
+		{signingType: "direct", platform: "MAC_OS", want: "MAC_APP_DIRECT"},
+		{signingType: "appstore", platform: "MAC_CATALYST", want: "MAC_CATALYST_APP_STORE"},
+		{signingType: "direct", platform: "IOS", wantErr: true},
+		{signingType: "adhoc", platform: "MAC_OS", wantErr: true},
+		{signingType: "beta", platform: "IOS", wantErr: true},
+		{signingType: "appstore", platform: "WATCH_OS", wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := setupProfileType(test.signingType, test.platform)
+		if test.wantErr {
+			if err == nil {
+				t.Fatalf("setupProfileType(%q, %q) expected error, got %q", test.signingType, test.platform, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("setupProfileType(%q, %q) error: %v", test.signingType, test.platform, err)
+		}
+		if got != test.want {
+			t.Fatalf("setupProfileType(%q, %q) = %q, want %q", test.signingType, test.platform, got, test.want)
+		}
+	}
+}
+
+func TestSigningSetupValidationErrors(t *testing.T) {
+	t.Setenv("ASC_P12_PASSWORD", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing bundle-id",
+			args:    []string{},
+			wantErr: "Error: --bundle-id is required",
+		},
+		{
+			name:    "invalid type",
+			args:    []string{"--bundle-id", "com.example.app", "--type", "beta"},
+			wantErr: "Error: --type must be one of",
+		},
+		{
+			name:    "key without password",
+			args:    []string{"--bundle-id", "com.example.app", "--key", "dist.key"},
+			wantErr: "Error: --password (or ASC_P12_PASSWORD) is required with --key",
+		},
+		{
+			name:    "password without key",
+			args:    []string{"--bundle-id", "com.example.app", "--password", "secret"},
+			wantErr: "Error: --password requires --key",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := SigningSetupCommand()
+			cmd.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := cmd.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := cmd.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -20,11 +20,13 @@ func SubscriptionsGracePeriodsCommand() *ffcli.Command {
 	return &ffcli.Command{
 		Name:       "grace-periods",
 		ShortUsage: "asc subscriptions grace-periods <subcommand> [flags]",
-		ShortHelp:  "Inspect subscription grace periods.",
-		LongHelp: `Inspect subscription grace periods.
+		ShortHelp:  "Inspect and configure subscription billing grace periods.",
+		LongHelp: `Inspect and configure subscription billing grace periods.
 
 Examples:
+  asc subscriptions grace-periods get --app "APP_ID"
   asc subscriptions grace-periods get --id "GRACE_PERIOD_ID"
+  asc subscriptions grace-periods update --app "APP_ID" --opt-in --duration 16d --renewal-type ALL
   asc subscriptions grace-periods update --id "GRACE_PERIOD_ID" --duration SIXTEEN_DAYS --opt-in true`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -43,23 +45,32 @@ func SubscriptionsGracePeriodsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("grace-periods get", flag.ExitOnError)
 
 	gracePeriodID := fs.String("id", "", "Subscription grace period ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env), instead of --id")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "get",
-		ShortUsage: "asc subscriptions grace-periods get --id \"GRACE_PERIOD_ID\"",
-		ShortHelp:  "Get a subscription grace period by ID.",
-		LongHelp: `Get a subscription grace period by ID.
+		ShortUsage: "asc subscriptions grace-periods get (--id \"GRACE_PERIOD_ID\" | --app \"APP_ID\")",
+		ShortHelp:  "Get a subscription grace period by ID or app.",
+		LongHelp: `Get a subscription grace period by ID or app.
 
 Examples:
-  asc subscriptions grace-periods get --id "GRACE_PERIOD_ID"`,
+  asc subscriptions grace-periods get --id "GRACE_PERIOD_ID"
+  asc subscriptions grace-periods get --app "APP_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*gracePeriodID)
+			resolvedAppID := ""
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				resolvedAppID = shared.ResolveAppID(*appID)
+			} else if strings.TrimSpace(*appID) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --id and --app are mutually exclusive")
+				return flag.ErrHelp
+			}
+			if id == "" && resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id or --app is required")
 				return flag.ErrHelp
 			}
 
@@ -71,7 +82,12 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			resp, err := client.GetSubscriptionGracePeriod(requestCtx, id)
+			var resp *asc.SubscriptionGracePeriodResponse
+			if id != "" {
+				resp, err = client.GetSubscriptionGracePeriod(requestCtx, id)
+			} else {
+				resp, err = client.GetAppSubscriptionGracePeriod(requestCtx, resolvedAppID)
+			}
 			if err != nil {
 				return fmt.Errorf("subscriptions grace-periods get: failed to fetch: %w", err)
 			}
@@ -86,29 +102,41 @@ func SubscriptionsGracePeriodsUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("grace-periods update", flag.ExitOnError)
 
 	gracePeriodID := fs.String("id", "", "Subscription grace period ID")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env), instead of --id")
 	var optIn shared.OptionalBool
 	fs.Var(&optIn, "opt-in", "Enable grace period opt-in: true or false")
 	var sandboxOptIn shared.OptionalBool
 	fs.Var(&sandboxOptIn, "sandbox-opt-in", "Enable grace period sandbox opt-in: true or false")
-	duration := fs.String("duration", "", "Grace period duration: "+strings.Join(subscriptionGracePeriodDurationValues, ", "))
-	renewalType := fs.String("renewal-type", "", "Grace period renewal type: "+strings.Join(subscriptionGracePeriodRenewalTypeValues, ", "))
+	duration := fs.String("duration", "", "Grace period duration: "+strings.Join(subscriptionGracePeriodDurationValues, ", ")+" (or 3d, 16d, 28d)")
+	renewalType := fs.String("renewal-type", "", "Grace period renewal type: "+strings.Join(subscriptionGracePeriodRenewalTypeValues, ", ")+" (or ALL, PAID_TO_PAID)")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "update",
-		ShortUsage: "asc subscriptions grace-periods update [flags]",
+		ShortUsage: "asc subscriptions grace-periods update (--id \"GRACE_PERIOD_ID\" | --app \"APP_ID\") [flags]",
 		ShortHelp:  "Update a subscription grace period.",
 		LongHelp: `Update a subscription grace period.
 
+With --opt-in, subscribers whose renewal fails keep access to paid
+content for the grace period while Apple retries billing.
+
 Examples:
-  asc subscriptions grace-periods update --id "GRACE_PERIOD_ID" --duration SIXTEEN_DAYS --opt-in true`,
+  asc subscriptions grace-periods update --id "GRACE_PERIOD_ID" --duration SIXTEEN_DAYS --opt-in true
+  asc subscriptions grace-periods update --app "APP_ID" --opt-in --duration 16d --renewal-type ALL`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			id := strings.TrimSpace(*gracePeriodID)
+			resolvedAppID := ""
 			if id == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				resolvedAppID = shared.ResolveAppID(*appID)
+			} else if strings.TrimSpace(*appID) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --id and --app are mutually exclusive")
+				return flag.ErrHelp
+			}
+			if id == "" && resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id or --app is required")
 				return flag.ErrHelp
 			}
 
@@ -135,6 +163,17 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if id == "" {
+				current, err := client.GetAppSubscriptionGracePeriod(requestCtx, resolvedAppID)
+				if err != nil {
+					return fmt.Errorf("subscriptions grace-periods update: failed to fetch app grace period: %w", err)
+				}
+				id = strings.TrimSpace(current.Data.ID)
+				if id == "" {
+					return fmt.Errorf("subscriptions grace-periods update: app %s has no grace period", resolvedAppID)
+				}
+			}
+
 			attrs := asc.SubscriptionGracePeriodUpdateAttributes{}
 			if optIn.IsSet() {
 				value := optIn.Value()
@@ -25,7 +25,8 @@ func SubscriptionsGroupsLocalizationsCommand() *ffcli.Command {
 
 Examples:
   asc subscriptions groups localizations list --group-id "GROUP_ID"
-  asc subscriptions groups localizations create --group-id "GROUP_ID" --locale "en-US" --name "Premium"`,
+  asc subscriptions groups localizations create --group-id "GROUP_ID" --locale "en-US" --name "Premium"
+  asc subscriptions groups localizations set --group-id "GROUP_ID" --locale "en-US" --custom-app-name "MyApp Pro"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -33,6 +34,7 @@ Examples:
 			SubscriptionsGroupsLocalizationsGetCommand(),
 			SubscriptionsGroupsLocalizationsCreateCommand(),
 			SubscriptionsGroupsLocalizationsUpdateCommand(),
+			SubscriptionsGroupsLocalizationsSetCommand(),
 			SubscriptionsGroupsLocalizationsDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -333,3 +335,115 @@ Examples:
 		},
 	}
 }
+
+// SubscriptionsGroupsLocalizationsSetCommand returns the group localizations set subcommand.
+func SubscriptionsGroupsLocalizationsSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("groups localizations set", flag.ExitOnError)
+
+	groupID := fs.String("group-id", "", "Subscription group ID")
+	locale := fs.String("locale", "", "Locale (e.g., en-US)")
+	name := fs.String("name", "", "Localized name (required when the locale is new)")
+	customAppName := fs.String("custom-app-name", "", "Custom app name")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc subscriptions groups localizations set --group-id \"GROUP_ID\" --locale \"en-US\" [flags]",
+		ShortHelp:  "Create or update the group localization of a locale.",
+		LongHelp: `Create or update the group localization of a locale.
+
+Updates the group's localization for --locale when it exists and creates it
+otherwise, so the command can be rerun safely.
+
+Examples:
+  asc subscriptions groups localizations set --group-id "GROUP_ID" --locale "en-US" --name "Premium" --custom-app-name "MyApp Pro"
+  asc subscriptions groups localizations set --group-id "GROUP_ID" --locale "de-DE" --name "Premium"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*groupID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --group-id is required")
+				return flag.ErrHelp
+			}
+			localeValue := strings.TrimSpace(*locale)
+			if localeValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --locale is required")
+				return flag.ErrHelp
+			}
+			nameValue := strings.TrimSpace(*name)
+			customValue := strings.TrimSpace(*customAppName)
+			if nameValue == "" && customValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --name or --custom-app-name is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			existingID, err := findSubscriptionGroupLocalization(requestCtx, client, id, localeValue)
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations set: %w", err)
+			}
+
+			if existingID != "" {
+				attrs := asc.SubscriptionGroupLocalizationUpdateAttributes{}
+				if nameValue != "" {
+					attrs.Name = &nameValue
+				}
+				if customValue != "" {
+					attrs.CustomAppName = &customValue
+				}
+				resp, err := client.UpdateSubscriptionGroupLocalization(requestCtx, existingID, attrs)
+				if err != nil {
+					return fmt.Errorf("subscriptions groups localizations set: failed to update: %w", err)
+				}
+				return shared.PrintOutput(resp, *output, *pretty)
+			}
+
+			if nameValue == "" {
+				return fmt.Errorf("subscriptions groups localizations set: --name is required to create the %s localization", localeValue)
+			}
+			resp, err := client.CreateSubscriptionGroupLocalization(requestCtx, id, asc.SubscriptionGroupLocalizationCreateAttributes{
+				Name:          nameValue,
+				CustomAppName: customValue,
+				Locale:        localeValue,
+			})
+			if err != nil {
+				return fmt.Errorf("subscriptions groups localizations set: failed to create: %w", err)
+			}
+			return shared.PrintOutput(resp, *output, *pretty)
+		},
+	}
+}
+
+// findSubscriptionGroupLocalization returns the ID of the group's localization
+// for locale, or "" when the group has none.
+func findSubscriptionGroupLocalization(ctx context.Context, client *asc.Client, groupID, locale string) (string, error) {
+	firstPage, err := client.GetSubscriptionGroupLocalizations(ctx, groupID, asc.WithSubscriptionGroupLocalizationsLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSubscriptionGroupLocalizations(ctx, groupID, asc.WithSubscriptionGroupLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch localizations: %w", err)
+	}
+	resp, ok := all.(*asc.SubscriptionGroupLocalizationsResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected localizations response type %T", all)
+	}
+	for _, loc := range resp.Data {
+		if loc.Attributes.Locale == locale {
+			return loc.ID, nil
+		}
+	}
+	return "", nil
+}
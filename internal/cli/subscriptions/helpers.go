@@ -2,7 +2,6 @@ package subscriptions
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
@@ -40,6 +39,9 @@ var subscriptionGracePeriodDurationMap = map[string]string{
 	"DAY_3":  "DAY_3",
 	"DAY_16": "DAY_16",
 	"DAY_28": "DAY_28",
+	"3D":     string(asc.SubscriptionGracePeriodDurationThreeDays),
+	"16D":    string(asc.SubscriptionGracePeriodDurationSixteenDays),
+	"28D":    string(asc.SubscriptionGracePeriodDurationTwentyEightDays),
 }
 
 var subscriptionGracePeriodRenewalTypeValues = []string{
@@ -50,6 +52,8 @@ var subscriptionGracePeriodRenewalTypeValues = []string{
 var subscriptionGracePeriodRenewalTypeMap = map[string]asc.SubscriptionGracePeriodRenewalType{
 	string(asc.SubscriptionGracePeriodRenewalTypeAllRenewals):    asc.SubscriptionGracePeriodRenewalTypeAllRenewals,
 	string(asc.SubscriptionGracePeriodRenewalTypePaidToPaidOnly): asc.SubscriptionGracePeriodRenewalTypePaidToPaidOnly,
+	"ALL":          asc.SubscriptionGracePeriodRenewalTypeAllRenewals,
+	"PAID_TO_PAID": asc.SubscriptionGracePeriodRenewalTypePaidToPaidOnly,
 }
 
 var subscriptionOfferDurationValues = []string{
@@ -238,18 +242,36 @@ func parseSubscriptionOfferCodePrices(value string) ([]asc.SubscriptionOfferCode
 	return prices, nil
 }
 
-func openSubscriptionImageFile(path string) (*os.File, os.FileInfo, error) {
-	if err := asc.ValidateImageFile(path); err != nil {
-		return nil, nil, err
+// parseSubscriptionPromotionalOfferPrices parses --prices for promotional
+// offers, which is either TERRITORY:PRICE_POINT_ID entries for new prices or
+// IDs of existing prices, but not a mix of both.
+func parseSubscriptionPromotionalOfferPrices(value string) ([]string, []asc.SubscriptionPromotionalOfferPrice, error) {
+	entries := shared.SplitCSV(value)
+	if len(entries) == 0 {
+		return nil, nil, nil
 	}
-	file, err := shared.OpenExistingNoFollow(path)
-	if err != nil {
-		return nil, nil, err
+
+	priceIDs := make([]string, 0, len(entries))
+	prices := make([]asc.SubscriptionPromotionalOfferPrice, 0, len(entries))
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			priceIDs = append(priceIDs, entry)
+			continue
+		}
+		territoryID := strings.ToUpper(strings.TrimSpace(parts[0]))
+		pricePointID := strings.TrimSpace(parts[1])
+		if territoryID == "" || pricePointID == "" {
+			return nil, nil, fmt.Errorf("--prices must use TERRITORY:PRICE_POINT_ID entries")
+		}
+		prices = append(prices, asc.SubscriptionPromotionalOfferPrice{
+			TerritoryID:  territoryID,
+			PricePointID: pricePointID,
+		})
 	}
-	info, err := file.Stat()
-	if err != nil {
-		_ = file.Close()
-		return nil, nil, err
+	if len(priceIDs) > 0 && len(prices) > 0 {
+		return nil, nil, fmt.Errorf("--prices cannot mix TERRITORY:PRICE_POINT_ID entries with price IDs")
 	}
-	return file, info, nil
+
+	return priceIDs, prices, nil
 }
@@ -41,6 +41,9 @@ func TestNormalizeSubscriptionEnums(t *testing.T) {
 	if got, err := normalizeSubscriptionGracePeriodRenewalType("all_renewals", true); err != nil || got != asc.SubscriptionGracePeriodRenewalTypeAllRenewals {
 		t.Fatalf("expected ALL_RENEWALS, got %q err=%v", got, err)
 	}
+	if got, err := normalizeSubscriptionGracePeriodRenewalType("all", true); err != nil || got != asc.SubscriptionGracePeriodRenewalTypeAllRenewals {
+		t.Fatalf("expected ALL_RENEWALS for ALL, got %q err=%v", got, err)
+	}
 	if _, err := normalizeSubscriptionGracePeriodRenewalType("bad", true); err == nil {
 		t.Fatal("expected validation error for renewal type")
 	}
@@ -88,3 +91,31 @@ func TestParseSubscriptionOfferCodePrices(t *testing.T) {
 		t.Fatal("expected parse error for missing price point id")
 	}
 }
+
+func TestParseSubscriptionPromotionalOfferPrices(t *testing.T) {
+	priceIDs, prices, err := parseSubscriptionPromotionalOfferPrices("usa:pp-1, jpn:pp-2")
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+	if len(priceIDs) != 0 || len(prices) != 2 {
+		t.Fatalf("expected 2 territory prices, got ids=%v prices=%+v", priceIDs, prices)
+	}
+	if prices[0].TerritoryID != "USA" || prices[0].PricePointID != "pp-1" {
+		t.Fatalf("unexpected first price: %+v", prices[0])
+	}
+
+	priceIDs, prices, err = parseSubscriptionPromotionalOfferPrices("price-1,price-2")
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+	if len(priceIDs) != 2 || len(prices) != 0 {
+		t.Fatalf("expected 2 price IDs, got ids=%v prices=%+v", priceIDs, prices)
+	}
+
+	if _, _, err := parseSubscriptionPromotionalOfferPrices("usa:pp-1,price-1"); err == nil {
+		t.Fatal("expected error for mixed entries")
+	}
+	if _, _, err := parseSubscriptionPromotionalOfferPrices("usa:"); err == nil {
+		t.Fatal("expected parse error for missing price point id")
+	}
+}
@@ -44,6 +44,11 @@ func TestNormalizeSubscriptionGracePeriodDuration(t *testing.T) {
 			input: "day_16",
 			want:  "DAY_16",
 		},
+		{
+			name:  "short value",
+			input: "16d",
+			want:  "SIXTEEN_DAYS",
+		},
 		{
 			name:    "invalid value",
 			input:   "BAD",
@@ -25,7 +25,9 @@ func SubscriptionsOfferCodesCommand() *ffcli.Command {
 
 Examples:
   asc subscriptions offer-codes list --subscription-id "SUB_ID"
-  asc subscriptions offer-codes create --subscription-id "SUB_ID" --name "SPRING" --offer-eligibility STACK_WITH_INTRO_OFFERS --customer-eligibilities NEW --offer-duration ONE_MONTH --offer-mode FREE_TRIAL --number-of-periods 1 --prices "PRICE_ID"`,
+  asc subscriptions offer-codes create --subscription-id "SUB_ID" --name "SPRING" --offer-eligibility STACK_WITH_INTRO_OFFERS --customer-eligibilities NEW --offer-duration ONE_MONTH --offer-mode FREE_TRIAL --number-of-periods 1 --prices "PRICE_ID"
+  asc subscriptions offer-codes generate --offer-code-id "OFFER_CODE_ID" --count 500 --expiration-date "2026-12-31" --out "./codes.csv"
+  asc subscriptions offer-codes codes --subscription-id "SUB_ID" --active`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -35,6 +37,8 @@ Examples:
 			SubscriptionsOfferCodesUpdateCommand(),
 			SubscriptionsOfferCodesCustomCodesCommand(),
 			SubscriptionsOfferCodesOneTimeCodesCommand(),
+			SubscriptionsOfferCodesCodesCommand(),
+			SubscriptionsOfferCodesGenerateCommand(),
 			SubscriptionsOfferCodesPricesCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -0,0 +1,169 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Kinds of subscription offer code redemption codes.
+const (
+	subscriptionOfferCodeKindCustom  = "custom"
+	subscriptionOfferCodeKindOneTime = "one-time"
+)
+
+// SubscriptionsOfferCodesCodesCommand returns the offer codes codes subcommand.
+func SubscriptionsOfferCodesCodesCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes codes", flag.ExitOnError)
+
+	subscriptionID := fs.String("subscription-id", "", "Subscription ID")
+	var active shared.OptionalBool
+	fs.Var(&active, "active", "Only show active (--active) or inactive (--active=false) codes")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "codes",
+		ShortUsage: "asc subscriptions offer-codes codes --subscription-id \"SUB_ID\" [--active[=false]]",
+		ShortHelp:  "List custom codes and one-time use code batches of a subscription.",
+		LongHelp: `List custom codes and one-time use code batches of a subscription.
+
+Shows the codes of every offer code of the subscription with their number of
+redemptions, expiration date, and whether they are active. Create custom
+codes with "asc offer-codes custom-codes create" and one-time use codes with
+"asc subscriptions offer-codes generate".
+
+Examples:
+  asc subscriptions offer-codes codes --subscription-id "SUB_ID"
+  asc subscriptions offer-codes codes --subscription-id "SUB_ID" --active --output table
+  asc subscriptions offer-codes codes --subscription-id "SUB_ID" --active=false`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subscriptionID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription-id is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes codes: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			codes, err := fetchSubscriptionOfferCodeCodes(requestCtx, client, id)
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes codes: %w", err)
+			}
+
+			result := &asc.SubscriptionOfferCodeCodesResult{
+				SubscriptionID: id,
+				Codes:          make([]asc.SubscriptionOfferCodeCodeItem, 0, len(codes)),
+			}
+			for _, code := range codes {
+				if active.IsSet() && code.Active != active.Value() {
+					continue
+				}
+				result.Codes = append(result.Codes, code)
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// fetchSubscriptionOfferCodeCodes returns the custom codes and one-time use
+// code batches of every offer code of a subscription.
+func fetchSubscriptionOfferCodeCodes(ctx context.Context, client *asc.Client, subscriptionID string) ([]asc.SubscriptionOfferCodeCodeItem, error) {
+	firstPage, err := client.GetSubscriptionOfferCodes(ctx, subscriptionID, asc.WithSubscriptionOfferCodesLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch offer codes: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSubscriptionOfferCodes(ctx, subscriptionID, asc.WithSubscriptionOfferCodesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate offer codes: %w", err)
+	}
+	offerCodes, ok := paginated.(*asc.SubscriptionOfferCodesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected offer codes response type %T", paginated)
+	}
+
+	codes := make([]asc.SubscriptionOfferCodeCodeItem, 0)
+	for _, offerCode := range offerCodes.Data {
+		firstCustom, err := client.GetSubscriptionOfferCodeCustomCodes(ctx, offerCode.ID, asc.WithSubscriptionOfferCodeCustomCodesLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch custom codes for offer code %s: %w", offerCode.ID, err)
+		}
+		paginated, err := asc.PaginateAll(ctx, firstCustom, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetSubscriptionOfferCodeCustomCodes(ctx, offerCode.ID, asc.WithSubscriptionOfferCodeCustomCodesNextURL(nextURL))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("paginate custom codes: %w", err)
+		}
+		customCodes, ok := paginated.(*asc.SubscriptionOfferCodeCustomCodesResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected custom codes response type %T", paginated)
+		}
+		for _, item := range customCodes.Data {
+			codes = append(codes, asc.SubscriptionOfferCodeCodeItem{
+				OfferCodeID:    offerCode.ID,
+				OfferCodeName:  offerCode.Attributes.Name,
+				Kind:           subscriptionOfferCodeKindCustom,
+				ID:             item.ID,
+				Code:           item.Attributes.CustomCode,
+				NumberOfCodes:  item.Attributes.NumberOfCodes,
+				CreatedDate:    item.Attributes.CreatedDate,
+				ExpirationDate: item.Attributes.ExpirationDate,
+				Active:         item.Attributes.Active,
+			})
+		}
+
+		firstOneTime, err := client.GetSubscriptionOfferCodeOneTimeUseCodes(ctx, offerCode.ID, asc.WithSubscriptionOfferCodeOneTimeUseCodesLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch one-time use codes for offer code %s: %w", offerCode.ID, err)
+		}
+		paginated, err = asc.PaginateAll(ctx, firstOneTime, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetSubscriptionOfferCodeOneTimeUseCodes(ctx, offerCode.ID, asc.WithSubscriptionOfferCodeOneTimeUseCodesNextURL(nextURL))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("paginate one-time use codes: %w", err)
+		}
+		oneTimeCodes, ok := paginated.(*asc.SubscriptionOfferCodeOneTimeUseCodesResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected one-time use codes response type %T", paginated)
+		}
+		for _, item := range oneTimeCodes.Data {
+			codes = append(codes, asc.SubscriptionOfferCodeCodeItem{
+				OfferCodeID:    offerCode.ID,
+				OfferCodeName:  offerCode.Attributes.Name,
+				Kind:           subscriptionOfferCodeKindOneTime,
+				ID:             item.ID,
+				NumberOfCodes:  item.Attributes.NumberOfCodes,
+				CreatedDate:    item.Attributes.CreatedDate,
+				ExpirationDate: item.Attributes.ExpirationDate,
+				Active:         item.Attributes.Active,
+			})
+		}
+	}
+
+	sort.SliceStable(codes, func(i, j int) bool {
+		if codes[i].OfferCodeName != codes[j].OfferCodeName {
+			return codes[i].OfferCodeName < codes[j].OfferCodeName
+		}
+		return codes[i].CreatedDate < codes[j].CreatedDate
+	})
+	return codes, nil
+}
@@ -0,0 +1,159 @@
+package subscriptions
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// SubscriptionsOfferCodesGenerateCommand returns the offer codes generate subcommand.
+func SubscriptionsOfferCodesGenerateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offer-codes generate", flag.ExitOnError)
+
+	offerCodeID := fs.String("offer-code-id", "", "Offer code ID")
+	count := fs.Int("count", 0, "Number of one-time use codes to generate")
+	expirationDate := fs.String("expiration-date", "", "Expiration date (YYYY-MM-DD)")
+	outPath := fs.String("out", "", "Write the codes to this CSV file")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "generate",
+		ShortUsage: "asc subscriptions offer-codes generate --offer-code-id \"OFFER_CODE_ID\" --count 500 --expiration-date \"2026-12-31\" [--out \"./codes.csv\"]",
+		ShortHelp:  "Generate a batch of one-time use codes for an offer code.",
+		LongHelp: `Generate a batch of one-time use codes for an offer code.
+
+Creates the batch and, with --out, downloads its codes to a new CSV file with
+code and expiration_date columns. The file must not already exist. Codes of an
+existing batch can be downloaded again with "asc offer-codes values".
+
+Examples:
+  asc subscriptions offer-codes generate --offer-code-id "OFFER_CODE_ID" --count 500 --expiration-date "2026-12-31"
+  asc subscriptions offer-codes generate --offer-code-id "OFFER_CODE_ID" --count 500 --expiration-date "2026-12-31" --out "./codes.csv"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*offerCodeID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --offer-code-id is required")
+				return flag.ErrHelp
+			}
+			if *count <= 0 {
+				fmt.Fprintln(os.Stderr, "Error: --count must be greater than 0")
+				return flag.ErrHelp
+			}
+			expiration, err := shared.NormalizeDate(*expirationDate, "--expiration-date")
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err.Error())
+				return flag.ErrHelp
+			}
+			pathValue := strings.TrimSpace(*outPath)
+			if pathValue != "" {
+				if _, err := os.Lstat(pathValue); err == nil {
+					return fmt.Errorf("subscriptions offer-codes generate: output file already exists: %s", pathValue)
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes generate: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.CreateSubscriptionOfferCodeOneTimeUseCode(requestCtx, asc.SubscriptionOfferCodeOneTimeUseCodeCreateRequest{
+				Data: asc.SubscriptionOfferCodeOneTimeUseCodeCreateData{
+					Type: asc.ResourceTypeSubscriptionOfferCodeOneTimeUseCodes,
+					Attributes: asc.SubscriptionOfferCodeOneTimeUseCodeCreateAttributes{
+						NumberOfCodes:  *count,
+						ExpirationDate: expiration,
+					},
+					Relationships: asc.SubscriptionOfferCodeOneTimeUseCodeCreateRelationships{
+						OfferCode: asc.Relationship{
+							Data: asc.ResourceData{
+								Type: asc.ResourceTypeSubscriptionOfferCodes,
+								ID:   id,
+							},
+						},
+					},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("subscriptions offer-codes generate: failed to create batch: %w", err)
+			}
+
+			result := &asc.SubscriptionOfferCodesGenerateResult{
+				OfferCodeID:    id,
+				BatchID:        strings.TrimSpace(resp.Data.ID),
+				Count:          *count,
+				ExpirationDate: expiration,
+			}
+
+			if pathValue != "" {
+				if result.BatchID == "" {
+					return fmt.Errorf("subscriptions offer-codes generate: missing one-time use code batch ID")
+				}
+				codes, err := client.GetSubscriptionOfferCodeOneTimeUseCodeValues(requestCtx, result.BatchID)
+				if err != nil {
+					return fmt.Errorf("subscriptions offer-codes generate: batch %s created but failed to download codes: %w", result.BatchID, err)
+				}
+				written, err := writeSubscriptionOfferCodesCSV(pathValue, codes, expiration)
+				if err != nil {
+					return fmt.Errorf("subscriptions offer-codes generate: batch %s created but failed to write codes: %w", result.BatchID, err)
+				}
+				result.File = pathValue
+				result.Written = written
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// writeSubscriptionOfferCodesCSV writes codes to a new CSV file and returns
+// the number of codes written.
+func writeSubscriptionOfferCodesCSV(path string, codes []string, expirationDate string) (int, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, err
+	}
+	file, err := shared.OpenNewFileNoFollow(path, 0o600)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return 0, fmt.Errorf("output file already exists: %w", err)
+		}
+		return 0, err
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write([]string{"code", "expiration_date"}); err != nil {
+		return 0, err
+	}
+	written := 0
+	for _, code := range codes {
+		trimmed := strings.TrimSpace(code)
+		if trimmed == "" {
+			continue
+		}
+		if err := writer.Write([]string{trimmed, expirationDate}); err != nil {
+			return written, err
+		}
+		written++
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return written, err
+	}
+	return written, file.Sync()
+}
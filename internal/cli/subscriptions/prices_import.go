@@ -0,0 +1,211 @@
+package subscriptions
+
+import (
+	"context"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// SubscriptionsPricesImportCommand returns the subscriptions prices import subcommand.
+func SubscriptionsPricesImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("prices import", flag.ExitOnError)
+
+	subID := fs.String("id", "", "Subscription ID")
+	csvPath := fs.String("csv", "", "CSV file with territory,price_point[,start_date][,preserve_current] columns")
+	startDate := fs.String("start-date", "", "Start date (YYYY-MM-DD) for rows without a start_date")
+	preserveCurrent := fs.Bool("preserve-current", false, "Keep existing subscribers on their current price for rows without preserve_current")
+	dryRun := fs.Bool("dry-run", false, "Validate the CSV without creating prices")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc subscriptions prices import --id \"SUB_ID\" --csv \"./prices.csv\" [flags]",
+		ShortHelp:  "Schedule subscription prices for many territories from a CSV.",
+		LongHelp: `Schedule subscription prices for many territories from a CSV.
+
+The CSV needs a header row with territory and price_point columns, and may
+add start_date (YYYY-MM-DD) and preserve_current (true/false) columns that
+override --start-date and --preserve-current for that row:
+
+  territory,price_point,start_date,preserve_current
+  USA,PRICE_POINT_ID,2026-03-01,true
+  GBR,PRICE_POINT_ID,,
+
+With --preserve-current, a price increase applies only to new subscribers and
+existing subscribers keep their current price. Every row is validated before
+any price is created. Rows that App Store Connect rejects are reported and the
+command exits non-zero.
+
+Examples:
+  asc subscriptions prices import --id "SUB_ID" --csv "./prices.csv" --dry-run
+  asc subscriptions prices import --id "SUB_ID" --csv "./prices.csv" --start-date "2026-03-01" --preserve-current`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			id := strings.TrimSpace(*subID)
+			if id == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			pathValue := strings.TrimSpace(*csvPath)
+			if pathValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --csv is required")
+				return flag.ErrHelp
+			}
+			defaultStart := ""
+			if strings.TrimSpace(*startDate) != "" {
+				normalized, err := shared.NormalizeDate(*startDate, "--start-date")
+				if err != nil {
+					fmt.Fprintln(os.Stderr, "Error:", err.Error())
+					return flag.ErrHelp
+				}
+				defaultStart = normalized
+			}
+
+			file, err := shared.OpenExistingNoFollow(pathValue)
+			if err != nil {
+				return fmt.Errorf("subscriptions prices import: %w", err)
+			}
+			items, err := readSubscriptionPricesCSV(file, defaultStart, *preserveCurrent)
+			file.Close()
+			if err != nil {
+				return fmt.Errorf("subscriptions prices import: %w", err)
+			}
+
+			result := &asc.SubscriptionPriceImportResult{
+				SubscriptionID: id,
+				DryRun:         *dryRun,
+				Prices:         items,
+			}
+
+			if !*dryRun {
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("subscriptions prices import: %w", err)
+				}
+
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				for i, item := range result.Prices {
+					attrs := asc.SubscriptionPriceCreateAttributes{StartDate: item.StartDate}
+					if item.Preserved {
+						preserved := true
+						attrs.Preserved = &preserved
+					}
+					resp, err := client.CreateSubscriptionPrice(requestCtx, id, item.PricePoint, item.Territory, attrs)
+					if err != nil {
+						result.Prices[i].Error = err.Error()
+						result.Failed++
+						continue
+					}
+					result.Prices[i].PriceID = resp.Data.ID
+				}
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("subscriptions prices import: %d of %d price(s) failed", result.Failed, len(result.Prices)))
+			}
+			return nil
+		},
+	}
+}
+
+// readSubscriptionPricesCSV parses a price import CSV. Rows without a
+// start_date or preserve_current use defaultStart and defaultPreserve.
+func readSubscriptionPricesCSV(r io.Reader, defaultStart string, defaultPreserve bool) ([]asc.SubscriptionPriceImportItem, error) {
+	reader := csv.NewReader(fileutil.SkipBOM(r))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("invalid CSV: missing header row")
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		columns[name] = i
+	}
+	for _, required := range []string{"territory", "price_point"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("invalid CSV: header must include %s", required)
+		}
+	}
+	cell := func(record []string, name string) string {
+		index, ok := columns[name]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	items := make([]asc.SubscriptionPriceImportItem, 0, len(records)-1)
+	seen := make(map[string]int, len(records)-1)
+	for i, record := range records[1:] {
+		line := i + 2
+		item := asc.SubscriptionPriceImportItem{
+			Territory:  strings.ToUpper(cell(record, "territory")),
+			PricePoint: cell(record, "price_point"),
+			StartDate:  defaultStart,
+			Preserved:  defaultPreserve,
+		}
+		if item.Territory == "" && item.PricePoint == "" {
+			continue
+		}
+		if item.Territory == "" {
+			return nil, fmt.Errorf("line %d: territory is required", line)
+		}
+		if item.PricePoint == "" {
+			return nil, fmt.Errorf("line %d: price_point is required", line)
+		}
+		if value := cell(record, "start_date"); value != "" {
+			normalized, err := shared.NormalizeDate(value, "start_date")
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			item.StartDate = normalized
+		}
+		if value := cell(record, "preserve_current"); value != "" {
+			preserved, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: preserve_current must be true or false", line)
+			}
+			item.Preserved = preserved
+		}
+		key := item.Territory + "|" + item.StartDate
+		if previous, ok := seen[key]; ok {
+			return nil, fmt.Errorf("line %d: %s already has a price starting %s on line %d", line, item.Territory, displayStartDate(item.StartDate), previous)
+		}
+		seen[key] = line
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("invalid CSV: no prices found")
+	}
+	return items, nil
+}
+
+func displayStartDate(value string) string {
+	if value == "" {
+		return "immediately"
+	}
+	return value
+}
@@ -0,0 +1,50 @@
+package subscriptions
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadSubscriptionPricesCSV_AppliesDefaultsAndOverrides(t *testing.T) {
+	input := "\ufeffTerritory,price_point,start_date,preserve_current\n" +
+		"usa,PP_USA,2026-03-01,false\n" +
+		"GBR,PP_GBR,,\n" +
+		",,,\n"
+
+	items, err := readSubscriptionPricesCSV(strings.NewReader(input), "2026-04-01", true)
+	if err != nil {
+		t.Fatalf("readSubscriptionPricesCSV returned error: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 prices, got %+v", items)
+	}
+	if items[0].Territory != "USA" || items[0].StartDate != "2026-03-01" || items[0].Preserved {
+		t.Fatalf("expected row overrides for USA, got %+v", items[0])
+	}
+	if items[1].Territory != "GBR" || items[1].StartDate != "2026-04-01" || !items[1].Preserved {
+		t.Fatalf("expected defaults for GBR, got %+v", items[1])
+	}
+}
+
+func TestReadSubscriptionPricesCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"missing column", "territory\nUSA\n", "header must include price_point"},
+		{"missing price point", "territory,price_point\nUSA,\n", "line 2: price_point is required"},
+		{"bad date", "territory,price_point,start_date\nUSA,PP,03/01/2026\n", "line 2: start_date must be in YYYY-MM-DD format"},
+		{"bad bool", "territory,price_point,preserve_current\nUSA,PP,maybe\n", "line 2: preserve_current must be true or false"},
+		{"duplicate", "territory,price_point\nUSA,PP1\nusa,PP2\n", "line 3: USA already has a price starting immediately on line 2"},
+		{"empty", "territory,price_point\n", "no prices found"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := readSubscriptionPricesCSV(strings.NewReader(test.input), "", false)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
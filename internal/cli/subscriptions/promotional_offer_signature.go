@@ -0,0 +1,146 @@
+package subscriptions
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// SubscriptionsPromotionalOffersSignatureParamsCommand returns the promotional offers signature-params subcommand.
+func SubscriptionsPromotionalOffersSignatureParamsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("promotional-offers signature-params", flag.ExitOnError)
+
+	subscriptionID := fs.String("subscription-id", "", "Subscription ID")
+	offerCode := fs.String("offer-code", "", "Promotional offer code (the StoreKit offer identifier)")
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	keyID := fs.String("key-id", "", "In-App Purchase key ID used to sign offers")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "signature-params",
+		ShortUsage: "asc subscriptions promotional-offers signature-params --subscription-id \"SUB_ID\" --offer-code \"CODE\" --app \"APP_ID\" [flags]",
+		ShortHelp:  "Print the parameters a server needs to sign a promotional offer.",
+		LongHelp: `Print the parameters a server needs to sign a promotional offer.
+
+StoreKit only applies a promotional offer with a signature generated by your
+server. This looks up the app bundle ID, the subscription product ID, and the
+offer identifier, and prints the payload the server signs with ECDSA SHA-256
+using its In-App Purchase private key (.p8). The server fills in the app
+account token, a fresh lowercase UUID nonce, and the current timestamp in
+milliseconds for every purchase.
+
+Examples:
+  asc subscriptions promotional-offers signature-params --subscription-id "SUB_ID" --offer-code "SPRING" --app "APP_ID"
+  asc subscriptions promotional-offers signature-params --subscription-id "SUB_ID" --offer-code "SPRING" --app "APP_ID" --key-id "KEY_ID" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			subID := strings.TrimSpace(*subscriptionID)
+			if subID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --subscription-id is required")
+				return flag.ErrHelp
+			}
+			offerCodeValue := strings.TrimSpace(*offerCode)
+			if offerCodeValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --offer-code is required")
+				return flag.ErrHelp
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("subscriptions promotional-offers signature-params: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			app, err := client.GetApp(requestCtx, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("subscriptions promotional-offers signature-params: failed to fetch app: %w", err)
+			}
+			subscription, err := client.GetSubscription(requestCtx, subID)
+			if err != nil {
+				return fmt.Errorf("subscriptions promotional-offers signature-params: failed to fetch subscription: %w", err)
+			}
+			offer, err := findSubscriptionPromotionalOfferByCode(requestCtx, client, subID, offerCodeValue)
+			if err != nil {
+				return fmt.Errorf("subscriptions promotional-offers signature-params: %w", err)
+			}
+
+			params := buildPromotionalOfferSignatureParams(
+				app.Data.Attributes.BundleID,
+				strings.TrimSpace(*keyID),
+				subscription.Data.Attributes.ProductID,
+				offer,
+			)
+			return shared.PrintOutput(params, *output, *pretty)
+		},
+	}
+}
+
+// findSubscriptionPromotionalOfferByCode returns the promotional offer of the
+// subscription whose offer code matches code, ignoring case.
+func findSubscriptionPromotionalOfferByCode(ctx context.Context, client *asc.Client, subscriptionID, code string) (asc.Resource[asc.SubscriptionPromotionalOfferAttributes], error) {
+	firstPage, err := client.GetSubscriptionPromotionalOffers(ctx, subscriptionID, asc.WithSubscriptionPromotionalOffersLimit(200))
+	if err != nil {
+		return asc.Resource[asc.SubscriptionPromotionalOfferAttributes]{}, fmt.Errorf("failed to fetch promotional offers: %w", err)
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSubscriptionPromotionalOffers(ctx, subscriptionID, asc.WithSubscriptionPromotionalOffersNextURL(nextURL))
+	})
+	if err != nil {
+		return asc.Resource[asc.SubscriptionPromotionalOfferAttributes]{}, fmt.Errorf("paginate promotional offers: %w", err)
+	}
+	resp, ok := paginated.(*asc.SubscriptionPromotionalOffersResponse)
+	if !ok {
+		return asc.Resource[asc.SubscriptionPromotionalOfferAttributes]{}, fmt.Errorf("unexpected promotional offers response type %T", paginated)
+	}
+
+	for _, offer := range resp.Data {
+		if strings.EqualFold(strings.TrimSpace(offer.Attributes.OfferCode), code) {
+			return offer, nil
+		}
+	}
+	return asc.Resource[asc.SubscriptionPromotionalOfferAttributes]{}, fmt.Errorf("subscription %s has no promotional offer with code %q", subscriptionID, code)
+}
+
+func buildPromotionalOfferSignatureParams(bundleID, keyID, productID string, offer asc.Resource[asc.SubscriptionPromotionalOfferAttributes]) *asc.SubscriptionPromotionalOfferSignatureParams {
+	keyField := keyID
+	if keyField == "" {
+		keyField = "<keyIdentifier>"
+	}
+	payload := strings.Join([]string{
+		bundleID,
+		keyField,
+		productID,
+		offer.Attributes.OfferCode,
+		"<appAccountToken>",
+		"<nonce>",
+		"<timestamp>",
+	}, asc.PromotionalOfferSignatureSeparator)
+
+	return &asc.SubscriptionPromotionalOfferSignatureParams{
+		AppBundleID:       bundleID,
+		KeyIdentifier:     keyID,
+		ProductIdentifier: productID,
+		OfferIdentifier:   offer.Attributes.OfferCode,
+		OfferID:           offer.ID,
+		Payload:           payload,
+		Algorithm:         "ECDSA with SHA-256 (ES256), In-App Purchase private key",
+		Nonce:             "lowercase UUID, new for every signature",
+		Timestamp:         "milliseconds since the Unix epoch",
+	}
+}
@@ -25,7 +25,8 @@ func SubscriptionsPromotionalOffersCommand() *ffcli.Command {
 
 Examples:
   asc subscriptions promotional-offers list --subscription-id "SUB_ID"
-  asc subscriptions promotional-offers create --subscription-id "SUB_ID" --offer-code "SPRING" --name "Spring" --offer-duration ONE_MONTH --offer-mode FREE_TRIAL --number-of-periods 1 --prices "PRICE_ID"`,
+  asc subscriptions promotional-offers create --subscription-id "SUB_ID" --offer-code "SPRING" --name "Spring" --offer-duration ONE_MONTH --offer-mode FREE_TRIAL --number-of-periods 1 --prices "USA:PRICE_POINT_ID"
+  asc subscriptions promotional-offers signature-params --subscription-id "SUB_ID" --offer-code "SPRING" --app "APP_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -35,6 +36,7 @@ Examples:
 			SubscriptionsPromotionalOffersUpdateCommand(),
 			SubscriptionsPromotionalOffersDeleteCommand(),
 			SubscriptionsPromotionalOfferPricesCommand(),
+			SubscriptionsPromotionalOffersSignatureParamsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -171,7 +173,7 @@ func SubscriptionsPromotionalOffersCreateCommand() *ffcli.Command {
 	offerDuration := fs.String("offer-duration", "", "Offer duration: "+strings.Join(subscriptionOfferDurationValues, ", "))
 	offerMode := fs.String("offer-mode", "", "Offer mode: "+strings.Join(subscriptionOfferModeValues, ", "))
 	numberOfPeriods := fs.Int("number-of-periods", 0, "Number of periods (required)")
-	prices := fs.String("prices", "", "Promotional offer price ID(s), comma-separated")
+	prices := fs.String("prices", "", "Offer prices as TERRITORY:PRICE_POINT_ID entries, or existing price IDs, comma-separated")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -181,7 +183,12 @@ func SubscriptionsPromotionalOffersCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a promotional offer.",
 		LongHelp: `Create a promotional offer.
 
+Pass --prices as TERRITORY:PRICE_POINT_ID entries to create the offer price
+for each territory along with the offer. Find price points with
+"asc subscriptions price-points list".
+
 Examples:
+  asc subscriptions promotional-offers create --subscription-id "SUB_ID" --offer-code "SPRING" --name "Spring" --offer-duration ONE_MONTH --offer-mode PAY_AS_YOU_GO --number-of-periods 3 --prices "USA:PRICE_POINT_ID,JPN:PRICE_POINT_ID"
   asc subscriptions promotional-offers create --subscription-id "SUB_ID" --offer-code "SPRING" --name "Spring" --offer-duration ONE_MONTH --offer-mode FREE_TRIAL --number-of-periods 1 --prices "PRICE_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -221,8 +228,12 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			priceIDs := shared.SplitCSV(*prices)
-			if len(priceIDs) == 0 {
+			priceIDs, territoryPrices, err := parseSubscriptionPromotionalOfferPrices(*prices)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err.Error())
+				return flag.ErrHelp
+			}
+			if len(priceIDs) == 0 && len(territoryPrices) == 0 {
 				fmt.Fprintln(os.Stderr, "Error: --prices is required")
 				return flag.ErrHelp
 			}
@@ -243,7 +254,12 @@ Examples:
 				OfferMode:       mode,
 			}
 
-			resp, err := client.CreateSubscriptionPromotionalOffer(requestCtx, id, attrs, priceIDs)
+			var resp *asc.SubscriptionPromotionalOfferResponse
+			if len(territoryPrices) > 0 {
+				resp, err = client.CreateSubscriptionPromotionalOfferWithPrices(requestCtx, id, attrs, territoryPrices)
+			} else {
+				resp, err = client.CreateSubscriptionPromotionalOffer(requestCtx, id, attrs, priceIDs)
+			}
 			if err != nil {
 				return fmt.Errorf("subscriptions promotional-offers create: failed to create: %w", err)
 			}
@@ -115,7 +115,7 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			file, info, err := openSubscriptionImageFile(pathValue)
+			file, info, err := shared.OpenImageFile(pathValue)
 			if err != nil {
 				return fmt.Errorf("subscriptions review-screenshots create: %w", err)
 			}
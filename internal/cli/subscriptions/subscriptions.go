@@ -175,6 +175,9 @@ func SubscriptionsGroupsCreateCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	referenceName := fs.String("reference-name", "", "Reference name")
+	locale := fs.String("locale", "", "Also create a localization for this locale (e.g., en-US)")
+	name := fs.String("name", "", "Localized group name (with --locale)")
+	customAppName := fs.String("custom-app-name", "", "Localized custom app name (with --locale)")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -184,8 +187,12 @@ func SubscriptionsGroupsCreateCommand() *ffcli.Command {
 		ShortHelp:  "Create a subscription group.",
 		LongHelp: `Create a subscription group.
 
+With --locale and --name, the group's first localization is created too, so
+the group shows a display name in the App Store.
+
 Examples:
-  asc subscriptions groups create --app "APP_ID" --reference-name "Premium"`,
+  asc subscriptions groups create --app "APP_ID" --reference-name "Premium"
+  asc subscriptions groups create --app "APP_ID" --reference-name "Premium" --locale "en-US" --name "Premium" --custom-app-name "MyApp Pro"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -195,11 +202,22 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			name := strings.TrimSpace(*referenceName)
-			if name == "" {
+			refName := strings.TrimSpace(*referenceName)
+			if refName == "" {
 				fmt.Fprintln(os.Stderr, "Error: --reference-name is required")
 				return flag.ErrHelp
 			}
+			localeValue := strings.TrimSpace(*locale)
+			nameValue := strings.TrimSpace(*name)
+			customValue := strings.TrimSpace(*customAppName)
+			if localeValue == "" && (nameValue != "" || customValue != "") {
+				fmt.Fprintln(os.Stderr, "Error: --name and --custom-app-name require --locale")
+				return flag.ErrHelp
+			}
+			if localeValue != "" && nameValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --name is required with --locale")
+				return flag.ErrHelp
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -210,7 +228,7 @@ Examples:
 			defer cancel()
 
 			attrs := asc.SubscriptionGroupCreateAttributes{
-				ReferenceName: name,
+				ReferenceName: refName,
 			}
 
 			resp, err := client.CreateSubscriptionGroup(requestCtx, resolvedAppID, attrs)
@@ -218,6 +236,16 @@ Examples:
 				return fmt.Errorf("subscriptions groups create: failed to create: %w", err)
 			}
 
+			if localeValue != "" {
+				if _, err := client.CreateSubscriptionGroupLocalization(requestCtx, resp.Data.ID, asc.SubscriptionGroupLocalizationCreateAttributes{
+					Name:          nameValue,
+					CustomAppName: customValue,
+					Locale:        localeValue,
+				}); err != nil {
+					return fmt.Errorf("subscriptions groups create: group %s created but its %s localization failed: %w", resp.Data.ID, localeValue, err)
+				}
+			}
+
 			return shared.PrintOutput(resp, *output, *pretty)
 		},
 	}
@@ -456,6 +484,9 @@ func SubscriptionsCreateCommand() *ffcli.Command {
 	refName := fs.String("ref-name", "", "Reference name")
 	productID := fs.String("product-id", "", "Product ID (e.g., com.example.sub)")
 	subscriptionPeriod := fs.String("subscription-period", "", "Subscription period: "+strings.Join(subscriptionPeriodValues, ", "))
+	groupLevel := fs.Int("group-level", 0, "Level within the group, 1 being the highest service")
+	reviewNote := fs.String("review-note", "", "Note for App Review")
+	familySharable := fs.Bool("family-sharable", false, "Make the subscription available through Family Sharing")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -467,7 +498,8 @@ func SubscriptionsCreateCommand() *ffcli.Command {
 
 Examples:
   asc subscriptions create --group "GROUP_ID" --ref-name "Monthly" --product-id "com.example.sub.monthly"
-  asc subscriptions create --group "GROUP_ID" --ref-name "Monthly" --product-id "com.example.sub.monthly" --subscription-period ONE_MONTH`,
+  asc subscriptions create --group "GROUP_ID" --ref-name "Monthly" --product-id "com.example.sub.monthly" --subscription-period ONE_MONTH
+  asc subscriptions create --group "GROUP_ID" --ref-name "Yearly" --product-id "com.example.sub.yearly" --subscription-period ONE_YEAR --group-level 1 --review-note "Use the sandbox account" --family-sharable`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -494,6 +526,10 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error:", err.Error())
 				return flag.ErrHelp
 			}
+			if *groupLevel < 0 {
+				fmt.Fprintln(os.Stderr, "Error: --group-level must be at least 1")
+				return flag.ErrHelp
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -504,12 +540,19 @@ Examples:
 			defer cancel()
 
 			attrs := asc.SubscriptionCreateAttributes{
-				Name:      name,
-				ProductID: product,
+				Name:       name,
+				ProductID:  product,
+				ReviewNote: strings.TrimSpace(*reviewNote),
 			}
 			if period != "" {
 				attrs.SubscriptionPeriod = string(period)
 			}
+			if *groupLevel > 0 {
+				attrs.GroupLevel = groupLevel
+			}
+			if *familySharable {
+				attrs.FamilySharable = familySharable
+			}
 
 			resp, err := client.CreateSubscription(requestCtx, group, attrs)
 			if err != nil {
@@ -571,6 +614,10 @@ func SubscriptionsUpdateCommand() *ffcli.Command {
 	subID := fs.String("id", "", "Subscription ID")
 	refName := fs.String("ref-name", "", "Reference name")
 	subscriptionPeriod := fs.String("subscription-period", "", "Subscription period: "+strings.Join(subscriptionPeriodValues, ", "))
+	groupLevel := fs.Int("group-level", 0, "Level within the group, 1 being the highest service")
+	reviewNote := fs.String("review-note", "", "Note for App Review")
+	var familySharable shared.OptionalBool
+	fs.Var(&familySharable, "family-sharable", "Family Sharing: true or false")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
@@ -582,7 +629,9 @@ func SubscriptionsUpdateCommand() *ffcli.Command {
 
 Examples:
   asc subscriptions update --id "SUB_ID" --ref-name "New Name"
-  asc subscriptions update --id "SUB_ID" --subscription-period ONE_YEAR`,
+  asc subscriptions update --id "SUB_ID" --subscription-period ONE_YEAR
+  asc subscriptions update --id "SUB_ID" --group-level 2 --review-note "Use the sandbox account"
+  asc subscriptions update --id "SUB_ID" --family-sharable=false`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -598,7 +647,12 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error:", err.Error())
 				return flag.ErrHelp
 			}
-			if name == "" && period == "" {
+			if *groupLevel < 0 {
+				fmt.Fprintln(os.Stderr, "Error: --group-level must be at least 1")
+				return flag.ErrHelp
+			}
+			note := strings.TrimSpace(*reviewNote)
+			if name == "" && period == "" && *groupLevel == 0 && note == "" && !familySharable.IsSet() {
 				fmt.Fprintln(os.Stderr, "Error: at least one update flag is required")
 				return flag.ErrHelp
 			}
@@ -619,6 +673,16 @@ Examples:
 				periodValue := string(period)
 				attrs.SubscriptionPeriod = &periodValue
 			}
+			if *groupLevel > 0 {
+				attrs.GroupLevel = groupLevel
+			}
+			if note != "" {
+				attrs.ReviewNote = &note
+			}
+			if familySharable.IsSet() {
+				value := familySharable.Value()
+				attrs.FamilySharable = &value
+			}
 
 			resp, err := client.UpdateSubscription(requestCtx, id, attrs)
 			if err != nil {
@@ -695,12 +759,14 @@ func SubscriptionsPricesCommand() *ffcli.Command {
 Examples:
   asc subscriptions prices list --id "SUB_ID"
   asc subscriptions prices add --id "SUB_ID" --price-point "PRICE_POINT_ID"
+  asc subscriptions prices import --id "SUB_ID" --csv "./prices.csv" --preserve-current
   asc subscriptions prices delete --price-id "PRICE_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			SubscriptionsPricesListCommand(),
 			SubscriptionsPricesAddCommand(),
+			SubscriptionsPricesImportCommand(),
 			SubscriptionsPricesDeleteCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
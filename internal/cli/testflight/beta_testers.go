@@ -35,7 +35,8 @@ Examples:
   asc testflight beta-testers remove-builds --id "TESTER_ID" --build "BUILD_ID" --confirm
   asc testflight beta-testers remove-apps --id "TESTER_ID" --app "APP_ID" --confirm
   asc testflight beta-testers invite --app "APP_ID" --email "tester@example.com"
-  asc testflight beta-testers invite --app "APP_ID" --email "tester@example.com" --group "Beta"`,
+  asc testflight beta-testers invite --app "APP_ID" --email "tester@example.com" --group "Beta"
+  asc testflight beta-testers sync --app "APP_ID" --source "./testers.csv" --group "QA" --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -54,6 +55,7 @@ Examples:
 			BetaTestersBuildsCommand(),
 			BetaTestersMetricsCommand(),
 			BetaTestersInviteCommand(),
+			BetaTestersSyncCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
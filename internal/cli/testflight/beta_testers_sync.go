@@ -0,0 +1,427 @@
+package testflight
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	betaTesterSyncActionAdd    = "add"
+	betaTesterSyncActionRemove = "remove"
+
+	betaTesterSyncStatusPlanned = "planned"
+	betaTesterSyncStatusApplied = "applied"
+	betaTesterSyncStatusFailed  = "failed"
+)
+
+// betaTesterRosterEntry is a single tester row from an external roster export.
+type betaTesterRosterEntry struct {
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+// betaTesterSyncGroup holds a resolved beta group and its current members keyed by email.
+type betaTesterSyncGroup struct {
+	ID      string
+	Name    string
+	Members map[string]string
+}
+
+// BetaTestersSyncCommand returns the beta testers sync subcommand.
+func BetaTestersSyncCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("sync", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	source := fs.String("source", "", "Path to roster CSV with an email column (required)")
+	groups := fs.String("group", "", "Comma-separated beta group names or IDs (required)")
+	removeMissing := fs.Bool("remove-missing", false, "Remove group members that are not in the roster")
+	dryRun := fs.Bool("dry-run", false, "Show the membership diff without applying changes")
+	confirm := fs.Bool("confirm", false, "Confirm removals (required with --remove-missing unless --dry-run)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "sync",
+		ShortUsage: "asc testflight beta-testers sync --app APP_ID --source testers.csv --group GROUP[,GROUP...] [flags]",
+		ShortHelp:  "Reconcile beta group membership against a roster CSV.",
+		LongHelp: `Reconcile beta group membership against a roster CSV.
+
+The roster must have a header row with an "email" column. Optional
+"first_name" and "last_name" columns are used when new testers are created.
+Roster emails missing from a group are added; with --remove-missing, group
+members missing from the roster are removed from that group.
+
+Examples:
+  asc testflight beta-testers sync --app "APP_ID" --source "./testers.csv" --group "QA" --dry-run
+  asc testflight beta-testers sync --app "APP_ID" --source "./testers.csv" --group "QA,Beta"
+  asc testflight beta-testers sync --app "APP_ID" --source "./testers.csv" --group "QA" --remove-missing --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+			sourceValue := strings.TrimSpace(*source)
+			if sourceValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --source is required")
+				return flag.ErrHelp
+			}
+			groupValues := shared.SplitCSV(*groups)
+			if len(groupValues) == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --group is required")
+				return flag.ErrHelp
+			}
+			if *removeMissing && !*dryRun && !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required with --remove-missing (or use --dry-run)")
+				return flag.ErrHelp
+			}
+
+			roster, err := readBetaTesterRoster(sourceValue)
+			if err != nil {
+				return fmt.Errorf("beta-testers sync: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("beta-testers sync: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			syncGroups := make([]betaTesterSyncGroup, 0, len(groupValues))
+			for _, groupValue := range groupValues {
+				groupID, err := resolveBetaGroupID(requestCtx, client, resolvedAppID, groupValue)
+				if err != nil {
+					return fmt.Errorf("beta-testers sync: %w", err)
+				}
+				members, err := fetchBetaGroupMembersByEmail(requestCtx, client, groupID)
+				if err != nil {
+					return fmt.Errorf("beta-testers sync: %w", err)
+				}
+				syncGroups = append(syncGroups, betaTesterSyncGroup{ID: groupID, Name: groupValue, Members: members})
+			}
+
+			changes, unchanged := planBetaTesterSync(roster, syncGroups, *removeMissing)
+			result := &asc.BetaTesterSyncResult{
+				AppID:         resolvedAppID,
+				Source:        sourceValue,
+				DryRun:        *dryRun,
+				RemoveMissing: *removeMissing,
+				Unchanged:     unchanged,
+				Changes:       changes,
+			}
+
+			if !*dryRun {
+				if err := applyBetaTesterSync(requestCtx, client, resolvedAppID, roster, changes); err != nil {
+					return fmt.Errorf("beta-testers sync: %w", err)
+				}
+			}
+			for _, change := range result.Changes {
+				switch {
+				case change.Status == betaTesterSyncStatusFailed:
+					result.Failed++
+				case change.Action == betaTesterSyncActionAdd:
+					result.Added++
+				case change.Action == betaTesterSyncActionRemove:
+					result.Removed++
+				}
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return fmt.Errorf("beta-testers sync: %d change(s) failed", result.Failed)
+			}
+			return nil
+		},
+	}
+}
+
+// readBetaTesterRoster parses a roster CSV. Header names are matched
+// case-insensitively and ignore spaces, dashes, and underscores.
+func readBetaTesterRoster(path string) ([]betaTesterRosterEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("read --source: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("--source is empty")
+		}
+		return nil, fmt.Errorf("read --source: %w", err)
+	}
+
+	emailIndex, firstIndex, lastIndex := -1, -1, -1
+	for i, name := range header {
+		switch normalizeRosterHeader(name) {
+		case "email", "emailaddress":
+			emailIndex = i
+		case "firstname", "givenname":
+			firstIndex = i
+		case "lastname", "familyname", "surname":
+			lastIndex = i
+		}
+	}
+	if emailIndex < 0 {
+		return nil, fmt.Errorf("--source must have an email column")
+	}
+
+	field := func(record []string, index int) string {
+		if index < 0 || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	seen := make(map[string]struct{})
+	entries := make([]betaTesterRosterEntry, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read --source: %w", err)
+		}
+		email := strings.ToLower(field(record, emailIndex))
+		if email == "" {
+			continue
+		}
+		if !strings.Contains(email, "@") {
+			line, _ := reader.FieldPos(emailIndex)
+			return nil, fmt.Errorf("--source line %d: invalid email %q", line, email)
+		}
+		if _, ok := seen[email]; ok {
+			continue
+		}
+		seen[email] = struct{}{}
+		entries = append(entries, betaTesterRosterEntry{
+			Email:     email,
+			FirstName: field(record, firstIndex),
+			LastName:  field(record, lastIndex),
+		})
+	}
+
+	return entries, nil
+}
+
+func normalizeRosterHeader(name string) string {
+	name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(name)
+}
+
+func fetchBetaGroupMembersByEmail(ctx context.Context, client *asc.Client, groupID string) (map[string]string, error) {
+	firstPage, err := client.GetBetaGroupTesters(ctx, groupID, asc.WithBetaGroupTestersLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch testers for group %s: %w", groupID, err)
+	}
+	allPages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetBetaGroupTesters(ctx, groupID, asc.WithBetaGroupTestersNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch testers for group %s: %w", groupID, err)
+	}
+	testers, ok := allPages.(*asc.BetaTestersResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type")
+	}
+	return betaTesterIDsByEmail(testers), nil
+}
+
+func betaTesterIDsByEmail(testers *asc.BetaTestersResponse) map[string]string {
+	members := make(map[string]string, len(testers.Data))
+	for _, tester := range testers.Data {
+		email := strings.ToLower(strings.TrimSpace(tester.Attributes.Email))
+		if email == "" {
+			continue
+		}
+		members[email] = tester.ID
+	}
+	return members
+}
+
+// planBetaTesterSync computes the membership changes needed for every group to
+// match the roster. It returns the planned changes and the count of roster
+// entries already present in their groups.
+func planBetaTesterSync(roster []betaTesterRosterEntry, groups []betaTesterSyncGroup, removeMissing bool) ([]asc.BetaTesterSyncChange, int) {
+	wanted := make(map[string]struct{}, len(roster))
+	for _, entry := range roster {
+		wanted[entry.Email] = struct{}{}
+	}
+
+	changes := make([]asc.BetaTesterSyncChange, 0)
+	unchanged := 0
+	for _, group := range groups {
+		for _, entry := range roster {
+			if testerID, ok := group.Members[entry.Email]; ok && testerID != "" {
+				unchanged++
+				continue
+			}
+			changes = append(changes, asc.BetaTesterSyncChange{
+				Action:  betaTesterSyncActionAdd,
+				GroupID: group.ID,
+				Group:   group.Name,
+				Email:   entry.Email,
+				Status:  betaTesterSyncStatusPlanned,
+			})
+		}
+		if !removeMissing {
+			continue
+		}
+		emails := make([]string, 0, len(group.Members))
+		for email := range group.Members {
+			if _, ok := wanted[email]; !ok {
+				emails = append(emails, email)
+			}
+		}
+		sort.Strings(emails)
+		for _, email := range emails {
+			changes = append(changes, asc.BetaTesterSyncChange{
+				Action:   betaTesterSyncActionRemove,
+				GroupID:  group.ID,
+				Group:    group.Name,
+				Email:    email,
+				TesterID: group.Members[email],
+				Status:   betaTesterSyncStatusPlanned,
+			})
+		}
+	}
+	return changes, unchanged
+}
+
+// applyBetaTesterSync executes planned changes in place, marking each change
+// as applied or failed. Existing app testers are linked to groups in batches;
+// unknown emails are created with all of their target groups at once.
+func applyBetaTesterSync(ctx context.Context, client *asc.Client, appID string, roster []betaTesterRosterEntry, changes []asc.BetaTesterSyncChange) error {
+	hasAdds := false
+	for _, change := range changes {
+		if change.Action == betaTesterSyncActionAdd {
+			hasAdds = true
+			break
+		}
+	}
+
+	appTesters := map[string]string{}
+	if hasAdds {
+		firstPage, err := client.GetBetaTesters(ctx, appID, asc.WithBetaTestersLimit(200))
+		if err != nil {
+			return fmt.Errorf("failed to fetch app testers: %w", err)
+		}
+		allPages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetBetaTesters(ctx, appID, asc.WithBetaTestersNextURL(nextURL))
+		})
+		if err != nil {
+			return fmt.Errorf("failed to fetch app testers: %w", err)
+		}
+		testers, ok := allPages.(*asc.BetaTestersResponse)
+		if !ok {
+			return fmt.Errorf("unexpected response type")
+		}
+		appTesters = betaTesterIDsByEmail(testers)
+	}
+
+	rosterByEmail := make(map[string]betaTesterRosterEntry, len(roster))
+	for _, entry := range roster {
+		rosterByEmail[entry.Email] = entry
+	}
+
+	mark := func(indexes []int, testerID string, err error) {
+		for _, i := range indexes {
+			if testerID != "" {
+				changes[i].TesterID = testerID
+			}
+			if err != nil {
+				changes[i].Status = betaTesterSyncStatusFailed
+				changes[i].Error = err.Error()
+				continue
+			}
+			changes[i].Status = betaTesterSyncStatusApplied
+		}
+	}
+
+	groupOrder := make([]string, 0)
+	seenGroups := make(map[string]struct{})
+	addsByGroup := make(map[string][]int)
+	removesByGroup := make(map[string][]int)
+	createOrder := make([]string, 0)
+	createsByEmail := make(map[string][]int)
+	for i, change := range changes {
+		if _, ok := seenGroups[change.GroupID]; !ok {
+			seenGroups[change.GroupID] = struct{}{}
+			groupOrder = append(groupOrder, change.GroupID)
+		}
+		switch change.Action {
+		case betaTesterSyncActionAdd:
+			if testerID, ok := appTesters[change.Email]; ok {
+				changes[i].TesterID = testerID
+				addsByGroup[change.GroupID] = append(addsByGroup[change.GroupID], i)
+				continue
+			}
+			if _, ok := createsByEmail[change.Email]; !ok {
+				createOrder = append(createOrder, change.Email)
+			}
+			createsByEmail[change.Email] = append(createsByEmail[change.Email], i)
+		case betaTesterSyncActionRemove:
+			removesByGroup[change.GroupID] = append(removesByGroup[change.GroupID], i)
+		}
+	}
+
+	testerIDs := func(indexes []int) []string {
+		ids := make([]string, 0, len(indexes))
+		for _, i := range indexes {
+			ids = append(ids, changes[i].TesterID)
+		}
+		return ids
+	}
+
+	for _, groupID := range groupOrder {
+		if indexes := addsByGroup[groupID]; len(indexes) > 0 {
+			mark(indexes, "", client.AddBetaTestersToGroup(ctx, groupID, testerIDs(indexes)))
+		}
+	}
+	for _, email := range createOrder {
+		indexes := createsByEmail[email]
+		groupIDs := make([]string, 0, len(indexes))
+		for _, i := range indexes {
+			groupIDs = append(groupIDs, changes[i].GroupID)
+		}
+		entry := rosterByEmail[email]
+		created, err := client.CreateBetaTester(ctx, email, entry.FirstName, entry.LastName, groupIDs)
+		testerID := ""
+		if err == nil {
+			testerID = created.Data.ID
+		}
+		mark(indexes, testerID, err)
+	}
+	for _, groupID := range groupOrder {
+		if indexes := removesByGroup[groupID]; len(indexes) > 0 {
+			mark(indexes, "", client.RemoveBetaTestersFromGroup(ctx, groupID, testerIDs(indexes)))
+		}
+	}
+
+	return nil
+}
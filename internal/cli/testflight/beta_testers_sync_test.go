@@ -0,0 +1,69 @@
+package testflight
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadBetaTesterRoster(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "roster.csv")
+	content := "\ufeffGiven Name,E-Mail Address,surname\nAda,ADA@example.com,Lovelace\n,,\nDup,ada@example.com,Dup\nAlan, alan@example.com ,Turing\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write roster: %v", err)
+	}
+
+	entries, err := readBetaTesterRoster(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 deduplicated entries, got %+v", entries)
+	}
+	if entries[0].Email != "ada@example.com" || entries[0].FirstName != "Ada" || entries[0].LastName != "Lovelace" {
+		t.Fatalf("unexpected first entry: %+v", entries[0])
+	}
+	if entries[1].Email != "alan@example.com" {
+		t.Fatalf("expected trimmed email, got %q", entries[1].Email)
+	}
+}
+
+func TestReadBetaTesterRosterErrors(t *testing.T) {
+	dir := t.TempDir()
+	cases := map[string]string{
+		"empty.csv":    "",
+		"no-email.csv": "name\nAda\n",
+		"invalid.csv":  "email\nnot-an-email\n",
+	}
+	for name, content := range cases {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write roster: %v", err)
+		}
+		if _, err := readBetaTesterRoster(path); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
+
+func TestPlanBetaTesterSync(t *testing.T) {
+	roster := []betaTesterRosterEntry{{Email: "a@example.com"}, {Email: "b@example.com"}}
+	groups := []betaTesterSyncGroup{{
+		ID:      "group-1",
+		Name:    "QA",
+		Members: map[string]string{"a@example.com": "tester-a", "z@example.com": "tester-z", "y@example.com": "tester-y"},
+	}}
+
+	changes, unchanged := planBetaTesterSync(roster, groups, false)
+	if unchanged != 1 || len(changes) != 1 || changes[0].Action != "add" || changes[0].Email != "b@example.com" {
+		t.Fatalf("unexpected plan without removals: %+v (unchanged=%d)", changes, unchanged)
+	}
+
+	changes, _ = planBetaTesterSync(roster, groups, true)
+	if len(changes) != 3 {
+		t.Fatalf("expected 3 changes, got %+v", changes)
+	}
+	if changes[1].Action != "remove" || changes[1].Email != "y@example.com" || changes[1].TesterID != "tester-y" {
+		t.Fatalf("expected sorted removals, got %+v", changes[1:])
+	}
+}
@@ -0,0 +1,128 @@
+package users
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// UsersAuditCommand returns the users audit subcommand.
+func UsersAuditCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("audit", flag.ExitOnError)
+
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "audit",
+		ShortUsage: "asc users audit [--output csv]",
+		ShortHelp:  "Report every user's roles, provisioning access, and visible apps.",
+		LongHelp: `Report every user's roles, provisioning access, and visible apps.
+
+Produces one row per team member for periodic access reviews. Users who can
+see all apps are reported as such; for everyone else the visible apps are
+listed by name and bundle ID.
+
+Examples:
+  asc users audit
+  asc users audit --output table
+  asc users audit --output csv > team-access.csv`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("users audit: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			firstPage, err := client.GetUsers(requestCtx, asc.WithUsersLimit(200))
+			if err != nil {
+				return fmt.Errorf("users audit: failed to fetch users: %w", err)
+			}
+			paginated, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+				return client.GetUsers(ctx, asc.WithUsersNextURL(nextURL))
+			})
+			if err != nil {
+				return fmt.Errorf("users audit: %w", err)
+			}
+			users, ok := paginated.(*asc.UsersResponse)
+			if !ok {
+				return fmt.Errorf("users audit: unexpected users response type %T", paginated)
+			}
+
+			visibleApps := make(map[string][]asc.Resource[asc.AppAttributes])
+			for _, user := range users.Data {
+				if user.Attributes.AllAppsVisible {
+					continue
+				}
+				apps, err := fetchAllVisibleApps(requestCtx, func(ctx context.Context, nextURL string) (*asc.AppsResponse, error) {
+					if nextURL != "" {
+						return client.GetUserVisibleApps(ctx, user.ID, asc.WithUserVisibleAppsNextURL(nextURL))
+					}
+					return client.GetUserVisibleApps(ctx, user.ID, asc.WithUserVisibleAppsLimit(200))
+				})
+				if err != nil {
+					return fmt.Errorf("users audit: failed to fetch visible apps for user %s: %w", user.ID, err)
+				}
+				visibleApps[user.ID] = apps
+			}
+
+			return shared.PrintOutputWithCSV(buildUserAudit(users.Data, visibleApps), *output, *pretty)
+		},
+	}
+}
+
+// buildUserAudit joins users with their visible apps, ordered by username.
+func buildUserAudit(users []asc.Resource[asc.UserAttributes], visibleApps map[string][]asc.Resource[asc.AppAttributes]) *asc.UserAuditResult {
+	result := &asc.UserAuditResult{
+		Total: len(users),
+		Users: make([]asc.UserAuditItem, 0, len(users)),
+	}
+	for _, user := range users {
+		attrs := user.Attributes
+		item := asc.UserAuditItem{
+			ID:                  user.ID,
+			Username:            strings.TrimSpace(attrs.Username),
+			Name:                formatUserName(attrs.FirstName, attrs.LastName),
+			Roles:               attrs.Roles,
+			ProvisioningAllowed: attrs.ProvisioningAllowed,
+			AllAppsVisible:      attrs.AllAppsVisible,
+		}
+		for _, app := range visibleApps[user.ID] {
+			item.VisibleApps = append(item.VisibleApps, asc.UserAuditApp{
+				ID:       app.ID,
+				Name:     app.Attributes.Name,
+				BundleID: app.Attributes.BundleID,
+			})
+		}
+
+		for _, role := range attrs.Roles {
+			if role == "ADMIN" || role == "ACCOUNT_HOLDER" {
+				result.AdminCount++
+				break
+			}
+		}
+		if attrs.ProvisioningAllowed {
+			result.ProvisioningCount++
+		}
+		if attrs.AllAppsVisible {
+			result.AllAppsVisibleCount++
+		}
+		result.Users = append(result.Users, item)
+	}
+
+	sort.SliceStable(result.Users, func(i, j int) bool {
+		return strings.ToLower(result.Users[i].Username) < strings.ToLower(result.Users[j].Username)
+	})
+	return result
+}
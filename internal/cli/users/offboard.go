@@ -0,0 +1,214 @@
+package users
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// UsersOffboardCommand returns the users offboard subcommand.
+func UsersOffboardCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("offboard", flag.ExitOnError)
+
+	email := fs.String("email", "", "Email/username of the person leaving the team")
+	dryRun := fs.Bool("dry-run", false, "Report roles and app access without removing anything")
+	confirm := fs.Bool("confirm", false, "Confirm removal (required unless --dry-run)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "offboard",
+		ShortUsage: "asc users offboard --email EMAIL (--dry-run | --confirm)",
+		ShortHelp:  "Cancel pending invitations and remove a user.",
+		LongHelp: `Offboard someone who is leaving the team in one step.
+
+Cancels every pending invitation for the email, removes the user account,
+and reports the roles and visible apps each one had so the change can be
+audited. Run with --dry-run first to see what would be removed.
+
+Examples:
+  asc users offboard --email "user@example.com" --dry-run
+  asc users offboard --email "user@example.com" --confirm --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			emailValue := strings.TrimSpace(*email)
+			if emailValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --email is required")
+				return flag.ErrHelp
+			}
+			if !*dryRun && !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("users offboard: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			result, err := collectOffboardAccess(requestCtx, client, emailValue)
+			if err != nil {
+				return fmt.Errorf("users offboard: %w", err)
+			}
+			result.DryRun = *dryRun
+			if result.User == nil && len(result.Invitations) == 0 {
+				return fmt.Errorf("users offboard: no user or pending invitation found for %s", emailValue)
+			}
+			if *dryRun {
+				return shared.PrintOutput(result, *output, *pretty)
+			}
+
+			failures := 0
+			for i := range result.Invitations {
+				invitation := &result.Invitations[i]
+				if err := client.DeleteUserInvitation(requestCtx, invitation.ID); err != nil {
+					invitation.Error = err.Error()
+					failures++
+					continue
+				}
+				invitation.Removed = true
+			}
+			if result.User != nil {
+				if err := client.DeleteUser(requestCtx, result.User.ID); err != nil {
+					result.User.Error = err.Error()
+					failures++
+				} else {
+					result.User.Removed = true
+				}
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if failures > 0 {
+				return fmt.Errorf("users offboard: %d removals failed", failures)
+			}
+			return nil
+		},
+	}
+}
+
+// collectOffboardAccess finds the user and pending invitations for
+// emailValue and records their roles and visible apps.
+func collectOffboardAccess(ctx context.Context, client *asc.Client, emailValue string) (*asc.UserOffboardResult, error) {
+	result := &asc.UserOffboardResult{
+		Email:       emailValue,
+		Invitations: []asc.UserOffboardAccess{},
+	}
+
+	invitations, err := client.GetUserInvitations(ctx, asc.WithUserInvitationsEmail(emailValue), asc.WithUserInvitationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up invitations for %s: %w", emailValue, err)
+	}
+	for _, invitation := range invitations.Data {
+		attrs := invitation.Attributes
+		if !strings.EqualFold(strings.TrimSpace(attrs.Email), emailValue) {
+			continue
+		}
+		access := asc.UserOffboardAccess{
+			ID:                  invitation.ID,
+			Username:            attrs.Email,
+			Name:                formatUserName(attrs.FirstName, attrs.LastName),
+			Roles:               attrs.Roles,
+			AllAppsVisible:      attrs.AllAppsVisible,
+			ProvisioningAllowed: attrs.ProvisioningAllowed,
+		}
+		if !attrs.AllAppsVisible {
+			apps, err := fetchAllVisibleApps(ctx, func(ctx context.Context, nextURL string) (*asc.AppsResponse, error) {
+				if nextURL != "" {
+					return client.GetUserInvitationVisibleApps(ctx, invitation.ID, asc.WithUserInvitationVisibleAppsNextURL(nextURL))
+				}
+				return client.GetUserInvitationVisibleApps(ctx, invitation.ID, asc.WithUserInvitationVisibleAppsLimit(200))
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch visible apps for invitation %s: %w", invitation.ID, err)
+			}
+			access.VisibleApps = offboardApps(apps)
+		}
+		result.Invitations = append(result.Invitations, access)
+	}
+
+	users, err := client.GetUsers(ctx, asc.WithUsersEmail(emailValue), asc.WithUsersLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up user %s: %w", emailValue, err)
+	}
+	for _, user := range users.Data {
+		attrs := user.Attributes
+		if !strings.EqualFold(strings.TrimSpace(attrs.Username), emailValue) {
+			continue
+		}
+		if result.User != nil {
+			return nil, fmt.Errorf("multiple users found with email %s; remove them with users delete --id", emailValue)
+		}
+		access := &asc.UserOffboardAccess{
+			ID:                  user.ID,
+			Username:            attrs.Username,
+			Name:                formatUserName(attrs.FirstName, attrs.LastName),
+			Roles:               attrs.Roles,
+			AllAppsVisible:      attrs.AllAppsVisible,
+			ProvisioningAllowed: attrs.ProvisioningAllowed,
+		}
+		if !attrs.AllAppsVisible {
+			apps, err := fetchAllVisibleApps(ctx, func(ctx context.Context, nextURL string) (*asc.AppsResponse, error) {
+				if nextURL != "" {
+					return client.GetUserVisibleApps(ctx, user.ID, asc.WithUserVisibleAppsNextURL(nextURL))
+				}
+				return client.GetUserVisibleApps(ctx, user.ID, asc.WithUserVisibleAppsLimit(200))
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch visible apps for user %s: %w", user.ID, err)
+			}
+			access.VisibleApps = offboardApps(apps)
+		}
+		result.User = access
+	}
+
+	return result, nil
+}
+
+// fetchAllVisibleApps fetches every page of a visible apps list; fetch is
+// called with an empty nextURL for the first page.
+func fetchAllVisibleApps(ctx context.Context, fetch func(ctx context.Context, nextURL string) (*asc.AppsResponse, error)) ([]asc.Resource[asc.AppAttributes], error) {
+	firstPage, err := fetch(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	paginated, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return fetch(ctx, nextURL)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := paginated.(*asc.AppsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected apps response type %T", paginated)
+	}
+	return resp.Data, nil
+}
+
+func offboardApps(apps []asc.Resource[asc.AppAttributes]) []asc.UserOffboardApp {
+	items := make([]asc.UserOffboardApp, 0, len(apps))
+	for _, app := range apps {
+		items = append(items, asc.UserOffboardApp{
+			ID:       app.ID,
+			Name:     app.Attributes.Name,
+			BundleID: app.Attributes.BundleID,
+		})
+	}
+	return items
+}
+
+func formatUserName(firstName, lastName string) string {
+	return strings.TrimSpace(strings.TrimSpace(firstName) + " " + strings.TrimSpace(lastName))
+}
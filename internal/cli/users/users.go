@@ -28,8 +28,14 @@ Examples:
   asc users get --id "USER_ID"
   asc users get --id "USER_ID" --include visibleApps
   asc users update --id "USER_ID" --roles "ADMIN"
-  asc users delete --id "USER_ID" --confirm
+  asc users update --email "user@example.com" --roles "APP_MANAGER" --visible-apps "APP_ID" --all-apps-visible=false
+  asc users delete --email "user@example.com" --confirm
+  asc users offboard --email "user@example.com" --dry-run
+  asc users audit --output csv > team-access.csv
   asc users invite --email "user@example.com" --roles "ADMIN" --all-apps
+  asc users invite --email "user@example.com" --roles "DEVELOPER" --visible-apps "APP_ID1,APP_ID2"
+  asc users invitations list
+  asc users invitations cancel --email "user@example.com" --confirm
   asc users invites list
   asc users invites visible-apps list --id "INVITE_ID"
   asc users visible-apps list --id "USER_ID"
@@ -41,8 +47,11 @@ Examples:
 			UsersGetCommand(),
 			UsersUpdateCommand(),
 			UsersDeleteCommand(),
+			UsersOffboardCommand(),
+			UsersAuditCommand(),
 			UsersInviteCommand(),
 			UsersInvitesCommand(),
+			UsersInvitationsCommand(),
 			UsersVisibleAppsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -133,25 +142,27 @@ func UsersGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "User ID")
+	email := fs.String("email", "", "User email/username (alternative to --id)")
 	include := fs.String("include", "", "Include related resources: visibleApps")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "get",
-		ShortUsage: "asc users get --id USER_ID",
-		ShortHelp:  "Get a user by ID.",
-		LongHelp: `Get a user by ID.
+		ShortUsage: "asc users get (--id USER_ID | --email EMAIL)",
+		ShortHelp:  "Get a user by ID or email.",
+		LongHelp: `Get a user by ID or email.
 
 Examples:
   asc users get --id "USER_ID"
+  asc users get --email "user@example.com"
   asc users get --id "USER_ID" --include visibleApps`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			idValue := strings.TrimSpace(*id)
-			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+			idValue, emailValue, err := userSelectorFlags(*id, *email)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				return flag.ErrHelp
 			}
 
@@ -168,6 +179,11 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			idValue, err = resolveUserID(requestCtx, client, idValue, emailValue)
+			if err != nil {
+				return fmt.Errorf("users get: %w", err)
+			}
+
 			opts := []asc.UsersOption{}
 			if len(includeValues) > 0 {
 				opts = append(opts, asc.WithUsersInclude(includeValues))
@@ -188,36 +204,55 @@ func UsersUpdateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("update", flag.ExitOnError)
 
 	id := fs.String("id", "", "User ID")
-	roles := fs.String("roles", "", "Comma-separated role IDs")
-	visibleApps := fs.String("visible-app", "", "Comma-separated app IDs for visible apps")
+	email := fs.String("email", "", "User email/username (alternative to --id)")
+	roles := fs.String("roles", "", "Comma-separated role IDs (replaces the user's roles)")
+	visibleApps := fs.String("visible-apps", "", "Comma-separated app IDs for visible apps (replaces the current list)")
+	visibleApp := fs.String("visible-app", "", "Comma-separated app IDs (alias of --visible-apps)")
+	var allAppsVisible shared.OptionalBool
+	fs.Var(&allAppsVisible, "all-apps-visible", "Grant access to all apps: true or false")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "update",
-		ShortUsage: "asc users update --id USER_ID --roles ROLE_ID[,ROLE_ID...] [--visible-app APP_ID[,APP_ID...]]",
-		ShortHelp:  "Update a user.",
-		LongHelp: `Update a user by ID.
+		ShortUsage: "asc users update (--id USER_ID | --email EMAIL) [--roles ROLE[,ROLE...]] [--visible-apps APP_ID[,APP_ID...]] [--all-apps-visible=false]",
+		ShortHelp:  "Update a user's roles and app access.",
+		LongHelp: `Update a user's roles and app access.
+
+--roles replaces the user's roles. --visible-apps replaces the apps the user
+can see and turns off all-apps access; --all-apps-visible=true grants access
+to every app instead.
 
 Examples:
   asc users update --id "USER_ID" --roles "ADMIN"
-  asc users update --id "USER_ID" --roles "ADMIN" --visible-app "APP_ID"`,
+  asc users update --email "user@example.com" --roles "APP_MANAGER" --visible-apps "APP_ID1,APP_ID2" --all-apps-visible=false
+  asc users update --email "user@example.com" --all-apps-visible=true`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			idValue := strings.TrimSpace(*id)
-			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+			idValue, emailValue, err := userSelectorFlags(*id, *email)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				return flag.ErrHelp
 			}
 
-			roleValues := shared.SplitCSV(*roles)
-			if len(roleValues) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --roles is required")
+			if strings.TrimSpace(*visibleApps) != "" && strings.TrimSpace(*visibleApp) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --visible-apps and --visible-app cannot be used together")
 				return flag.ErrHelp
 			}
-
 			visibleAppIDs := shared.SplitCSV(*visibleApps)
+			if len(visibleAppIDs) == 0 {
+				visibleAppIDs = shared.SplitCSV(*visibleApp)
+			}
+			roleValues := shared.SplitCSV(*roles)
+			if len(roleValues) == 0 && len(visibleAppIDs) == 0 && !allAppsVisible.IsSet() {
+				fmt.Fprintln(os.Stderr, "Error: --roles, --visible-apps, or --all-apps-visible is required")
+				return flag.ErrHelp
+			}
+			if len(visibleAppIDs) > 0 && allAppsVisible.IsSet() && allAppsVisible.Value() {
+				fmt.Fprintln(os.Stderr, "Error: --visible-apps cannot be used with --all-apps-visible=true")
+				return flag.ErrHelp
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -227,12 +262,20 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			idValue, err = resolveUserID(requestCtx, client, idValue, emailValue)
+			if err != nil {
+				return fmt.Errorf("users update: %w", err)
+			}
+
 			attrs := asc.UserUpdateAttributes{
 				Roles: roleValues,
 			}
-			if len(visibleAppIDs) > 0 {
-				allAppsVisible := false
-				attrs.AllAppsVisible = &allAppsVisible
+			if allAppsVisible.IsSet() {
+				value := allAppsVisible.Value()
+				attrs.AllAppsVisible = &value
+			} else if len(visibleAppIDs) > 0 {
+				value := false
+				attrs.AllAppsVisible = &value
 			}
 
 			user, err := client.UpdateUser(requestCtx, idValue, attrs)
@@ -242,7 +285,7 @@ Examples:
 
 			if len(visibleAppIDs) > 0 {
 				if err := client.SetUserVisibleApps(requestCtx, idValue, visibleAppIDs); err != nil {
-					return fmt.Errorf("users update: roles updated but failed to set visible apps: %w", err)
+					return fmt.Errorf("users update: user updated but failed to set visible apps: %w", err)
 				}
 			}
 
@@ -256,18 +299,20 @@ func UsersDeleteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
 
 	id := fs.String("id", "", "User ID")
+	email := fs.String("email", "", "User email/username (alternative to --id)")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "delete",
-		ShortUsage: "asc users delete --id USER_ID --confirm",
+		ShortUsage: "asc users delete (--id USER_ID | --email EMAIL) --confirm",
 		ShortHelp:  "Delete a user.",
-		LongHelp: `Delete a user by ID.
+		LongHelp: `Delete a user by ID or email.
 
 Examples:
-  asc users delete --id "USER_ID" --confirm`,
+  asc users delete --id "USER_ID" --confirm
+  asc users delete --email "user@example.com" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -276,9 +321,9 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			idValue := strings.TrimSpace(*id)
-			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+			idValue, emailValue, err := userSelectorFlags(*id, *email)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				return flag.ErrHelp
 			}
 
@@ -290,6 +335,11 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			idValue, err = resolveUserID(requestCtx, client, idValue, emailValue)
+			if err != nil {
+				return fmt.Errorf("users delete: %w", err)
+			}
+
 			if err := client.DeleteUser(requestCtx, idValue); err != nil {
 				return fmt.Errorf("users delete: failed to delete: %w", err)
 			}
@@ -313,19 +363,20 @@ func UsersInviteCommand() *ffcli.Command {
 	lastName := fs.String("last-name", "", "Last name of the invitee (required)")
 	roles := fs.String("roles", "", "Comma-separated role IDs")
 	allApps := fs.Bool("all-apps", false, "Grant access to all apps")
-	visibleApps := fs.String("visible-app", "", "Comma-separated app IDs for visible apps")
+	visibleApps := fs.String("visible-apps", "", "Comma-separated app IDs for visible apps")
+	visibleApp := fs.String("visible-app", "", "Comma-separated app IDs (alias of --visible-apps)")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "invite",
-		ShortUsage: "asc users invite --email EMAIL --first-name NAME --last-name NAME --roles ROLE[,ROLE...] [--all-apps | --visible-app APP_ID[,APP_ID...]]",
+		ShortUsage: "asc users invite --email EMAIL --first-name NAME --last-name NAME --roles ROLE[,ROLE...] [--all-apps | --visible-apps APP_ID[,APP_ID...]]",
 		ShortHelp:  "Invite a user.",
 		LongHelp: `Invite a new user to App Store Connect.
 
 Examples:
   asc users invite --email "user@example.com" --first-name "Jane" --last-name "Doe" --roles "ADMIN" --all-apps
-  asc users invite --email "user@example.com" --first-name "John" --last-name "Smith" --roles "DEVELOPER" --visible-app "APP_ID"`,
+  asc users invite --email "user@example.com" --first-name "John" --last-name "Smith" --roles "DEVELOPER" --visible-apps "APP_ID1,APP_ID2"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -353,15 +404,21 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			if *allApps && strings.TrimSpace(*visibleApps) != "" {
-				fmt.Fprintln(os.Stderr, "Error: --all-apps and --visible-app cannot be used together")
+			if strings.TrimSpace(*visibleApps) != "" && strings.TrimSpace(*visibleApp) != "" {
+				fmt.Fprintln(os.Stderr, "Error: --visible-apps and --visible-app cannot be used together")
 				return flag.ErrHelp
 			}
-
 			visibleAppIDs := shared.SplitCSV(*visibleApps)
+			if len(visibleAppIDs) == 0 {
+				visibleAppIDs = shared.SplitCSV(*visibleApp)
+			}
 
+			if *allApps && len(visibleAppIDs) > 0 {
+				fmt.Fprintln(os.Stderr, "Error: --all-apps and --visible-apps cannot be used together")
+				return flag.ErrHelp
+			}
 			if !*allApps && len(visibleAppIDs) == 0 {
-				fmt.Fprintln(os.Stderr, "Error: --all-apps or --visible-app is required")
+				fmt.Fprintln(os.Stderr, "Error: --all-apps or --visible-apps is required")
 				return flag.ErrHelp
 			}
 
@@ -409,14 +466,16 @@ func UsersInvitesCommand() *ffcli.Command {
 
 Examples:
   asc users invites list
+  asc users invites list --email "user@example.com"
   asc users invites get --id "INVITE_ID"
-  asc users invites revoke --id "INVITE_ID" --confirm
+  asc users invites cancel --email "user@example.com" --confirm
   asc users invites visible-apps list --id "INVITE_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			UsersInvitesListCommand(),
 			UsersInvitesGetCommand(),
+			UsersInvitesCancelCommand(),
 			UsersInvitesRevokeCommand(),
 			UsersInvitesVisibleAppsCommand(),
 		},
@@ -426,10 +485,22 @@ Examples:
 	}
 }
 
+// UsersInvitationsCommand returns the users invitations command, an alias of
+// users invites.
+func UsersInvitationsCommand() *ffcli.Command {
+	cmd := UsersInvitesCommand()
+	cmd.Name = "invitations"
+	cmd.ShortUsage = "asc users invitations <subcommand> [flags]"
+	cmd.ShortHelp = "Manage user invitations (alias of invites)."
+	cmd.LongHelp = strings.ReplaceAll(cmd.LongHelp, "asc users invites ", "asc users invitations ")
+	return cmd
+}
+
 // UsersInvitesListCommand returns the users invites list subcommand.
 func UsersInvitesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
+	email := fs.String("email", "", "Filter by invitee email")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
@@ -444,6 +515,7 @@ func UsersInvitesListCommand() *ffcli.Command {
 
 Examples:
   asc users invites list
+  asc users invites list --email "user@example.com"
   asc users invites list --limit 50
   asc users invites list --paginate`,
 		FlagSet:   fs,
@@ -465,6 +537,7 @@ Examples:
 			defer cancel()
 
 			opts := []asc.UserInvitationsOption{
+				asc.WithUserInvitationsEmail(*email),
 				asc.WithUserInvitationsLimit(*limit),
 				asc.WithUserInvitationsNextURL(*next),
 			}
@@ -539,23 +612,35 @@ Examples:
 	}
 }
 
+// UsersInvitesCancelCommand returns the users invites cancel subcommand.
+func UsersInvitesCancelCommand() *ffcli.Command {
+	return usersInvitesCancelCommand("cancel")
+}
+
 // UsersInvitesRevokeCommand returns the users invites revoke subcommand.
 func UsersInvitesRevokeCommand() *ffcli.Command {
-	fs := flag.NewFlagSet("revoke", flag.ExitOnError)
+	return usersInvitesCancelCommand("revoke")
+}
+
+func usersInvitesCancelCommand(name string) *ffcli.Command {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
 
 	id := fs.String("id", "", "Invitation ID")
-	confirm := fs.Bool("confirm", false, "Confirm revocation")
+	email := fs.String("email", "", "Invitee email (alternative to --id)")
+	confirm := fs.Bool("confirm", false, "Confirm cancellation")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
+	commandName := "users invites " + name
 	return &ffcli.Command{
-		Name:       "revoke",
-		ShortUsage: "asc users invites revoke --id INVITE_ID --confirm",
-		ShortHelp:  "Revoke a user invitation.",
-		LongHelp: `Revoke a user invitation by ID.
+		Name:       name,
+		ShortUsage: "asc " + commandName + " (--id INVITE_ID | --email EMAIL) --confirm",
+		ShortHelp:  "Cancel a pending user invitation.",
+		LongHelp: `Cancel a pending user invitation by ID or invitee email.
 
 Examples:
-  asc users invites revoke --id "INVITE_ID" --confirm`,
+  asc ` + commandName + ` --id "INVITE_ID" --confirm
+  asc ` + commandName + ` --email "user@example.com" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -565,21 +650,33 @@ Examples:
 			}
 
 			idValue := strings.TrimSpace(*id)
-			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+			emailValue := strings.TrimSpace(*email)
+			if idValue == "" && emailValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id or --email is required")
+				return flag.ErrHelp
+			}
+			if idValue != "" && emailValue != "" {
+				fmt.Fprintln(os.Stderr, "Error: --id and --email are mutually exclusive")
 				return flag.ErrHelp
 			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
-				return fmt.Errorf("users invites revoke: %w", err)
+				return fmt.Errorf("%s: %w", commandName, err)
 			}
 
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
+			if idValue == "" {
+				idValue, err = resolveInvitationID(requestCtx, client, emailValue)
+				if err != nil {
+					return fmt.Errorf("%s: %w", commandName, err)
+				}
+			}
+
 			if err := client.DeleteUserInvitation(requestCtx, idValue); err != nil {
-				return fmt.Errorf("users invites revoke: failed to revoke: %w", err)
+				return fmt.Errorf("%s: failed to %s: %w", commandName, name, err)
 			}
 
 			result := &asc.UserInvitationRevokeResult{
@@ -592,6 +689,64 @@ Examples:
 	}
 }
 
+// resolveInvitationID looks up the pending invitation for emailValue.
+func resolveInvitationID(ctx context.Context, client *asc.Client, emailValue string) (string, error) {
+	resp, err := client.GetUserInvitations(ctx, asc.WithUserInvitationsEmail(emailValue), asc.WithUserInvitationsLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("failed to look up invitation for %s: %w", emailValue, err)
+	}
+	var matches []string
+	for _, invitation := range resp.Data {
+		if strings.EqualFold(strings.TrimSpace(invitation.Attributes.Email), emailValue) {
+			matches = append(matches, invitation.ID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no pending invitation found for %s", emailValue)
+	case 1:
+		return matches[0], nil
+	}
+	return "", fmt.Errorf("multiple invitations found for %s; use --id", emailValue)
+}
+
+// resolveUserID returns idValue, or looks up the user whose username matches
+// emailValue exactly.
+func resolveUserID(ctx context.Context, client *asc.Client, idValue, emailValue string) (string, error) {
+	if idValue != "" {
+		return idValue, nil
+	}
+	resp, err := client.GetUsers(ctx, asc.WithUsersEmail(emailValue), asc.WithUsersLimit(200))
+	if err != nil {
+		return "", fmt.Errorf("failed to look up user %s: %w", emailValue, err)
+	}
+	var matches []string
+	for _, user := range resp.Data {
+		if strings.EqualFold(strings.TrimSpace(user.Attributes.Username), emailValue) {
+			matches = append(matches, user.ID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no user found with email %s", emailValue)
+	case 1:
+		return matches[0], nil
+	}
+	return "", fmt.Errorf("multiple users found with email %s; use --id", emailValue)
+}
+
+func userSelectorFlags(id, email string) (string, string, error) {
+	idValue := strings.TrimSpace(id)
+	emailValue := strings.TrimSpace(email)
+	if idValue == "" && emailValue == "" {
+		return "", "", fmt.Errorf("--id or --email is required")
+	}
+	if idValue != "" && emailValue != "" {
+		return "", "", fmt.Errorf("--id and --email are mutually exclusive")
+	}
+	return idValue, emailValue, nil
+}
+
 func normalizeUsersInclude(value string) ([]string, error) {
 	include := shared.SplitCSV(value)
 	if len(include) == 0 {
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
 func TestUsersGetCommand_MissingID(t *testing.T) {
@@ -32,7 +34,7 @@ func TestUsersUpdateCommand_MissingID(t *testing.T) {
 	}
 }
 
-func TestUsersUpdateCommand_MissingRoles(t *testing.T) {
+func TestUsersUpdateCommand_MissingChanges(t *testing.T) {
 	cmd := UsersUpdateCommand()
 
 	if err := cmd.FlagSet.Parse([]string{"--id", "USER_ID"}); err != nil {
@@ -40,7 +42,7 @@ func TestUsersUpdateCommand_MissingRoles(t *testing.T) {
 	}
 
 	if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
-		t.Fatalf("expected flag.ErrHelp when --roles is missing, got %v", err)
+		t.Fatalf("expected flag.ErrHelp when no changes are given, got %v", err)
 	}
 }
 
@@ -330,3 +332,22 @@ func TestUsersListCommand_HasFilterFlags(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildUserAuditCounts(t *testing.T) {
+	users := []asc.Resource[asc.UserAttributes]{
+		{ID: "u1", Attributes: asc.UserAttributes{Username: "b@example.com", Roles: []string{"ACCOUNT_HOLDER", "ADMIN"}, AllAppsVisible: true, ProvisioningAllowed: true}},
+		{ID: "u2", Attributes: asc.UserAttributes{Username: "A@example.com", Roles: []string{"DEVELOPER"}}},
+	}
+	visibleApps := map[string][]asc.Resource[asc.AppAttributes]{
+		"u2": {{ID: "app-1", Attributes: asc.AppAttributes{Name: "Demo"}}},
+	}
+
+	result := buildUserAudit(users, visibleApps)
+
+	if result.Total != 2 || result.AdminCount != 1 || result.ProvisioningCount != 1 || result.AllAppsVisibleCount != 1 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	if result.Users[0].ID != "u2" || len(result.Users[0].VisibleApps) != 1 {
+		t.Fatalf("expected users ordered by username with visible apps, got %+v", result.Users)
+	}
+}
@@ -0,0 +1,167 @@
+package wait
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// condition is a parsed --until/--fail-on expression: comparisons joined by
+// && and ||, where && binds tighter. Parentheses are not supported.
+type condition struct {
+	raw string
+	any [][]comparison
+}
+
+type comparison struct {
+	path   []string
+	negate bool
+	values []string
+}
+
+// parseCondition parses expressions like
+//
+//	attributes.processingState==VALID
+//	attributes.appStoreState==READY_FOR_SALE||attributes.appStoreState==PENDING_DEVELOPER_RELEASE
+//	attributes.state!=PROCESSING && attributes.expired==false
+//
+// A right-hand side of "A|B" matches either value.
+func parseCondition(expr string) (*condition, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("condition is empty")
+	}
+
+	cond := &condition{raw: expr}
+	for _, clause := range strings.Split(expr, "||") {
+		var all []comparison
+		for _, term := range strings.Split(clause, "&&") {
+			cmp, err := parseComparison(term)
+			if err != nil {
+				return nil, err
+			}
+			all = append(all, cmp)
+		}
+		cond.any = append(cond.any, all)
+	}
+	return cond, nil
+}
+
+func parseComparison(term string) (comparison, error) {
+	term = strings.TrimSpace(term)
+	op := "=="
+	idx := strings.Index(term, "!=")
+	if idx >= 0 {
+		op = "!="
+	} else {
+		idx = strings.Index(term, "==")
+	}
+	if idx < 0 {
+		return comparison{}, fmt.Errorf("invalid comparison %q (expected path==value or path!=value)", term)
+	}
+
+	path := strings.TrimSpace(term[:idx])
+	value := strings.TrimSpace(term[idx+len(op):])
+	if path == "" || value == "" {
+		return comparison{}, fmt.Errorf("invalid comparison %q (expected path==value or path!=value)", term)
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, "data."), ".")
+	for _, segment := range segments {
+		if strings.TrimSpace(segment) == "" {
+			return comparison{}, fmt.Errorf("invalid path %q", path)
+		}
+	}
+
+	var values []string
+	for _, candidate := range strings.Split(value, "|") {
+		values = append(values, unquote(strings.TrimSpace(candidate)))
+	}
+	return comparison{path: segments, negate: op == "!=", values: values}, nil
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 {
+		first, last := value[0], value[len(value)-1]
+		if (first == '"' || first == '\'') && first == last {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
+
+// matches evaluates the condition against a resource object (the response's
+// data member).
+func (c *condition) matches(resource map[string]interface{}) bool {
+	for _, all := range c.any {
+		satisfied := true
+		for _, cmp := range all {
+			if !cmp.matches(resource) {
+				satisfied = false
+				break
+			}
+		}
+		if satisfied {
+			return true
+		}
+	}
+	return false
+}
+
+func (c comparison) matches(resource map[string]interface{}) bool {
+	actual, found := lookupPath(resource, c.path)
+	equal := false
+	if found {
+		for _, want := range c.values {
+			if actual == want {
+				equal = true
+				break
+			}
+		}
+	}
+	if c.negate {
+		return !equal
+	}
+	return equal
+}
+
+// lookupPath resolves a dotted path to a scalar rendered as a string. Missing
+// paths and JSON null resolve to "null" so conditions can test for absence.
+func lookupPath(resource map[string]interface{}, path []string) (string, bool) {
+	var current interface{} = resource
+	for _, segment := range path {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return "null", true
+		}
+		current, ok = object[segment]
+		if !ok {
+			return "null", true
+		}
+	}
+
+	switch value := current.(type) {
+	case nil:
+		return "null", true
+	case string:
+		return value, true
+	case bool:
+		return strconv.FormatBool(value), true
+	case json.Number:
+		return value.String(), true
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+// valueAt returns the scalar at path for progress messages.
+func valueAt(resource map[string]interface{}, path []string) string {
+	value, found := lookupPath(resource, path)
+	if !found {
+		return "?"
+	}
+	return value
+}
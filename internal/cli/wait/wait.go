@@ -0,0 +1,265 @@
+package wait
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// resourcePaths maps --resource names to collection paths. API type names
+// (e.g. "appStoreVersions") are accepted as aliases.
+var resourcePaths = map[string]string{
+	"analytics-report-request":   "/v1/analyticsReportRequests",
+	"app":                        "/v1/apps",
+	"app-event":                  "/v1/appEvents",
+	"app-info":                   "/v1/appInfos",
+	"app-preview":                "/v1/appPreviews",
+	"app-screenshot":             "/v1/appScreenshots",
+	"app-store-version":          "/v1/appStoreVersions",
+	"beta-app-review-submission": "/v1/betaAppReviewSubmissions",
+	"build":                      "/v1/builds",
+	"build-beta-detail":          "/v1/buildBetaDetails",
+	"build-run":                  "/v1/ciBuildRuns",
+	"build-upload":               "/v1/buildUploads",
+	"certificate":                "/v1/certificates",
+	"in-app-purchase":            "/v2/inAppPurchases",
+	"pre-release-version":        "/v1/preReleaseVersions",
+	"profile":                    "/v1/profiles",
+	"review-submission":          "/v1/reviewSubmissions",
+	"subscription":               "/v1/subscriptions",
+}
+
+var (
+	waitNow   = time.Now
+	waitAfter = time.After
+)
+
+// WaitCommand returns the wait command.
+func WaitCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("wait", flag.ExitOnError)
+
+	resource := fs.String("resource", "", "Resource to poll: "+strings.Join(resourceNames(), ", "))
+	id := fs.String("id", "", "Resource ID")
+	until := fs.String("until", "", "Condition to wait for, e.g. 'attributes.processingState==VALID'")
+	failOn := fs.String("fail-on", "", "Condition that stops waiting with an error, e.g. 'attributes.processingState==INVALID|FAILED'")
+	timeout := fs.Duration("timeout", 30*time.Minute, "Maximum time to wait")
+	pollInterval := fs.Duration("poll-interval", 15*time.Second, "Time between polls")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "wait",
+		ShortUsage: "asc wait --resource <name> --id <id> --until <condition> [flags]",
+		ShortHelp:  "Poll any resource until a condition on its fields is met.",
+		LongHelp: `Poll any resource until a condition on its fields is met.
+
+Conditions compare dotted paths inside the resource (attributes.*,
+relationships.*) with == or !=. Join comparisons with && and ||
+(&& binds tighter); use A|B on the right-hand side to match either value.
+Missing fields compare equal to null.
+
+Exits 0 when --until matches and 1 when --fail-on matches or --timeout
+elapses. The final resource is printed in both cases.
+
+Examples:
+  asc wait --resource build --id "BUILD_ID" --until 'attributes.processingState==VALID' --timeout 30m
+  asc wait --resource build --id "BUILD_ID" --until 'attributes.processingState==VALID' --fail-on 'attributes.processingState==INVALID|FAILED'
+  asc wait --resource app-store-version --id "VERSION_ID" --until 'attributes.appStoreState==READY_FOR_SALE||attributes.appStoreState==PENDING_DEVELOPER_RELEASE'
+  asc wait --resource review-submission --id "SUBMISSION_ID" --until 'attributes.state!=WAITING_FOR_REVIEW && attributes.state!=IN_REVIEW' --poll-interval 5m --timeout 48h`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resourceName := strings.TrimSpace(*resource)
+			if resourceName == "" {
+				fmt.Fprintln(os.Stderr, "Error: --resource is required")
+				return flag.ErrHelp
+			}
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if strings.TrimSpace(*until) == "" {
+				fmt.Fprintln(os.Stderr, "Error: --until is required")
+				return flag.ErrHelp
+			}
+			apiPath, ok := resolveResourcePath(resourceName)
+			if !ok {
+				fmt.Fprintf(os.Stderr, "Error: --resource must be one of: %s\n", strings.Join(resourceNames(), ", "))
+				return flag.ErrHelp
+			}
+			if *timeout <= 0 {
+				fmt.Fprintln(os.Stderr, "Error: --timeout must be greater than 0")
+				return flag.ErrHelp
+			}
+			if *pollInterval <= 0 {
+				fmt.Fprintln(os.Stderr, "Error: --poll-interval must be greater than 0")
+				return flag.ErrHelp
+			}
+
+			untilCond, err := parseCondition(*until)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: --until: %v\n", err)
+				return flag.ErrHelp
+			}
+			var failCond *condition
+			if strings.TrimSpace(*failOn) != "" {
+				failCond, err = parseCondition(*failOn)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: --fail-on: %v\n", err)
+					return flag.ErrHelp
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("wait: %w", err)
+			}
+
+			waitCtx, cancel := shared.ContextWithTimeoutDuration(ctx, *timeout)
+			defer cancel()
+
+			poller := &resourcePoller{
+				fetch: func(ctx context.Context) (json.RawMessage, error) {
+					return client.GetResourceJSON(ctx, apiPath, idValue)
+				},
+				until:        untilCond,
+				failOn:       failCond,
+				pollInterval: *pollInterval,
+			}
+			result, waitErr := poller.run(waitCtx)
+			if result != nil {
+				result.Resource = resourceName
+				result.ID = idValue
+				if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+					return err
+				}
+			}
+			if waitErr != nil {
+				return fmt.Errorf("wait: %s %s: %w", resourceName, idValue, waitErr)
+			}
+			return nil
+		},
+	}
+}
+
+type resourcePoller struct {
+	fetch        func(context.Context) (json.RawMessage, error)
+	until        *condition
+	failOn       *condition
+	pollInterval time.Duration
+}
+
+// run polls until the until condition matches. It returns the last observed
+// state together with any error so callers can report where waiting stopped.
+func (p *resourcePoller) run(ctx context.Context) (*asc.WaitResult, error) {
+	start := waitNow()
+	result := &asc.WaitResult{Until: p.until.raw}
+
+	for {
+		body, err := p.fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil && result.Attempts > 0 {
+				return p.finish(result, start), waitContextError(ctx, p.until.raw)
+			}
+			return nil, err
+		}
+		result.Attempts++
+
+		var envelope struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(body, &envelope); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		result.Data = envelope.Data
+
+		var resource map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(envelope.Data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&resource); err != nil {
+			return nil, fmt.Errorf("response has no single resource to evaluate: %w", err)
+		}
+
+		if p.failOn != nil && p.failOn.matches(resource) {
+			return p.finish(result, start), fmt.Errorf("matched --fail-on %q", p.failOn.raw)
+		}
+		if p.until.matches(resource) {
+			result.Satisfied = true
+			return p.finish(result, start), nil
+		}
+
+		if shared.ProgressEnabled() {
+			fmt.Fprintf(os.Stderr, "Waiting for %q (attempt %d, %s)\n", p.until.raw, result.Attempts, p.describe(resource))
+		}
+
+		select {
+		case <-ctx.Done():
+			return p.finish(result, start), waitContextError(ctx, p.until.raw)
+		case <-waitAfter(p.pollInterval):
+		}
+	}
+}
+
+func (p *resourcePoller) finish(result *asc.WaitResult, start time.Time) *asc.WaitResult {
+	result.Elapsed = waitNow().Sub(start).Round(time.Second).String()
+	return result
+}
+
+// describe summarizes the current values of the fields the condition reads.
+func (p *resourcePoller) describe(resource map[string]interface{}) string {
+	seen := make(map[string]struct{})
+	var parts []string
+	for _, all := range p.until.any {
+		for _, cmp := range all {
+			key := strings.Join(cmp.path, ".")
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			parts = append(parts, key+"="+valueAt(resource, cmp.path))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func waitContextError(ctx context.Context, until string) error {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return fmt.Errorf("canceled waiting for %q", until)
+	}
+	return fmt.Errorf("timed out waiting for %q", until)
+}
+
+func resolveResourcePath(name string) (string, bool) {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	if path, ok := resourcePaths[normalized]; ok {
+		return path, true
+	}
+	for _, path := range resourcePaths {
+		if strings.EqualFold(path[strings.LastIndex(path, "/")+1:], name) {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+func resourceNames() []string {
+	names := make([]string, 0, len(resourcePaths))
+	for name := range resourcePaths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,161 @@
+package wait
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func decodeResource(t *testing.T, raw string) map[string]interface{} {
+	t.Helper()
+	var resource map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &resource); err != nil {
+		t.Fatalf("decode resource: %v", err)
+	}
+	return resource
+}
+
+func TestConditionMatches(t *testing.T) {
+	resource := decodeResource(t, `{"type":"builds","id":"1","attributes":{"processingState":"VALID","expired":false,"usesNonExemptEncryption":null}}`)
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"attributes.processingState==VALID", true},
+		{"data.attributes.processingState == 'VALID'", true},
+		{"attributes.processingState==PROCESSING", false},
+		{"attributes.processingState!=PROCESSING", true},
+		{"attributes.processingState==INVALID|VALID", true},
+		{"attributes.processingState==VALID && attributes.expired==true", false},
+		{"attributes.processingState==PROCESSING || attributes.expired==false", true},
+		{"attributes.usesNonExemptEncryption==null", true},
+		{"attributes.missing==null", true},
+		{"attributes.missing!=null", false},
+	}
+	for _, test := range tests {
+		cond, err := parseCondition(test.expr)
+		if err != nil {
+			t.Fatalf("parseCondition(%q) error: %v", test.expr, err)
+		}
+		if got := cond.matches(resource); got != test.want {
+			t.Errorf("%q matches = %t, want %t", test.expr, got, test.want)
+		}
+	}
+}
+
+func TestParseConditionRejectsInvalid(t *testing.T) {
+	for _, expr := range []string{"", "attributes.state", "==VALID", "attributes..state==VALID", "attributes.state== "} {
+		if _, err := parseCondition(expr); err == nil {
+			t.Errorf("expected error for %q", expr)
+		}
+	}
+}
+
+func TestResolveResourcePath(t *testing.T) {
+	for name, want := range map[string]string{
+		"build":             "/v1/builds",
+		"appStoreVersions":  "/v1/appStoreVersions",
+		"In-App-Purchase":   "/v2/inAppPurchases",
+		"review-submission": "/v1/reviewSubmissions",
+	} {
+		got, ok := resolveResourcePath(name)
+		if !ok || got != want {
+			t.Errorf("resolveResourcePath(%q) = %q, %t; want %q", name, got, ok, want)
+		}
+	}
+	if _, ok := resolveResourcePath("widgets"); ok {
+		t.Fatal("expected unknown resource to be rejected")
+	}
+}
+
+func stubWaitClock(t *testing.T) {
+	t.Helper()
+	originalNow, originalAfter := waitNow, waitAfter
+	t.Cleanup(func() {
+		waitNow, waitAfter = originalNow, originalAfter
+	})
+	current := time.Unix(0, 0)
+	waitNow = func() time.Time { return current }
+	waitAfter = func(d time.Duration) <-chan time.Time {
+		current = current.Add(d)
+		ch := make(chan time.Time, 1)
+		ch <- current
+		return ch
+	}
+}
+
+func TestResourcePollerWaitsUntilSatisfied(t *testing.T) {
+	stubWaitClock(t)
+
+	states := []string{"PROCESSING", "PROCESSING", "VALID"}
+	calls := 0
+	until, _ := parseCondition("attributes.processingState==VALID")
+	poller := &resourcePoller{
+		fetch: func(context.Context) (json.RawMessage, error) {
+			state := states[calls]
+			calls++
+			return json.RawMessage(`{"data":{"type":"builds","id":"1","attributes":{"processingState":"` + state + `"}}}`), nil
+		},
+		until:        until,
+		pollInterval: 30 * time.Second,
+	}
+
+	result, err := poller.run(context.Background())
+	if err != nil {
+		t.Fatalf("run() error: %v", err)
+	}
+	if !result.Satisfied || result.Attempts != 3 || result.Elapsed != "1m0s" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if !strings.Contains(string(result.Data), `"VALID"`) {
+		t.Fatalf("expected final resource in result, got %s", result.Data)
+	}
+}
+
+func TestResourcePollerStopsOnFailCondition(t *testing.T) {
+	stubWaitClock(t)
+
+	until, _ := parseCondition("attributes.processingState==VALID")
+	failOn, _ := parseCondition("attributes.processingState==INVALID|FAILED")
+	poller := &resourcePoller{
+		fetch: func(context.Context) (json.RawMessage, error) {
+			return json.RawMessage(`{"data":{"type":"builds","id":"1","attributes":{"processingState":"FAILED"}}}`), nil
+		},
+		until:        until,
+		failOn:       failOn,
+		pollInterval: time.Second,
+	}
+
+	result, err := poller.run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "matched --fail-on") {
+		t.Fatalf("expected fail-on error, got %v", err)
+	}
+	if result == nil || result.Satisfied || result.Attempts != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestResourcePollerTimesOut(t *testing.T) {
+	until, _ := parseCondition("attributes.processingState==VALID")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	poller := &resourcePoller{
+		fetch: func(context.Context) (json.RawMessage, error) {
+			return json.RawMessage(`{"data":{"type":"builds","id":"1","attributes":{"processingState":"PROCESSING"}}}`), nil
+		},
+		until:        until,
+		pollInterval: 5 * time.Millisecond,
+	}
+
+	result, err := poller.run(ctx)
+	if err == nil || !strings.Contains(err.Error(), `timed out waiting for "attributes.processingState==VALID"`) {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+	if result == nil || result.Satisfied || result.Attempts == 0 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
@@ -0,0 +1,8 @@
+package whatsnew
+
+import "github.com/peterbourgon/ff/v3/ffcli"
+
+// Command returns the whatsnew command group.
+func Command() *ffcli.Command {
+	return WhatsNewCommand()
+}
@@ -0,0 +1,218 @@
+package whatsnew
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// whatsNewLimit is the App Store Connect character limit for release notes.
+const whatsNewLimit = 4000
+
+// WhatsNewCommand returns the whatsnew command group.
+func WhatsNewCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("whatsnew", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "whatsnew",
+		ShortUsage: "asc whatsnew <subcommand> [flags]",
+		ShortHelp:  "Set \"What's New\" release notes across locales.",
+		LongHelp: `Set "What's New" release notes across locales.
+
+Examples:
+  asc whatsnew set --version-id "VERSION_ID" --file notes.txt --all-locales
+  asc whatsnew set --version-id "VERSION_ID" --map notes.json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			WhatsNewSetCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// WhatsNewSetCommand returns the whatsnew set subcommand.
+func WhatsNewSetCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("whatsnew set", flag.ExitOnError)
+
+	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	file := fs.String("file", "", "Text file with the release notes")
+	allLocales := fs.Bool("all-locales", false, "With --file, write every existing localization")
+	locale := fs.String("locale", "", "With --file, write only these locale(s), comma-separated")
+	mapFile := fs.String("map", "", "JSON file mapping locale to release notes, e.g. {\"en-US\": \"...\"}")
+	dryRun := fs.Bool("dry-run", false, "Show what would be updated without updating")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "set",
+		ShortUsage: "asc whatsnew set --version-id <id> (--file <path> (--all-locales | --locale <locales>) | --map <path>) [flags]",
+		ShortHelp:  "Write the whatsNew field of existing localizations.",
+		LongHelp: `Write the whatsNew field of existing localizations.
+
+--file writes the same text to every existing localization (--all-locales)
+or to the given locales. --map writes per-locale text from a JSON object
+keyed by locale. Only existing localizations are updated; a locale that the
+version does not have is an error, and nothing is written.
+
+Examples:
+  asc whatsnew set --version-id "VERSION_ID" --file notes.txt --all-locales
+  asc whatsnew set --version-id "VERSION_ID" --file notes.txt --locale "en-US,en-GB"
+  asc whatsnew set --version-id "VERSION_ID" --map notes.json --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			versionValue := strings.TrimSpace(*versionID)
+			if versionValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
+				return flag.ErrHelp
+			}
+			fileValue := strings.TrimSpace(*file)
+			mapValue := strings.TrimSpace(*mapFile)
+			locales := shared.SplitCSV(*locale)
+			switch {
+			case fileValue == "" && mapValue == "":
+				fmt.Fprintln(os.Stderr, "Error: --file or --map is required")
+				return flag.ErrHelp
+			case fileValue != "" && mapValue != "":
+				fmt.Fprintln(os.Stderr, "Error: --file and --map are mutually exclusive")
+				return flag.ErrHelp
+			case fileValue != "" && !*allLocales && len(locales) == 0:
+				fmt.Fprintln(os.Stderr, "Error: --file requires --all-locales or --locale")
+				return flag.ErrHelp
+			case fileValue != "" && *allLocales && len(locales) > 0:
+				fmt.Fprintln(os.Stderr, "Error: --all-locales and --locale are mutually exclusive")
+				return flag.ErrHelp
+			case mapValue != "" && (*allLocales || len(locales) > 0):
+				fmt.Fprintln(os.Stderr, "Error: --all-locales and --locale cannot be used with --map")
+				return flag.ErrHelp
+			}
+
+			var text string
+			var notesByLocale map[string]string
+			if fileValue != "" {
+				data, err := os.ReadFile(fileValue)
+				if err != nil {
+					return fmt.Errorf("whatsnew set: %w", err)
+				}
+				text = strings.TrimSpace(string(data))
+				if text == "" {
+					return fmt.Errorf("whatsnew set: %s is empty", fileValue)
+				}
+			} else {
+				var err error
+				notesByLocale, err = readNotesMap(mapValue)
+				if err != nil {
+					return fmt.Errorf("whatsnew set: %w", err)
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("whatsnew set: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			resp, err := client.GetAppStoreVersionLocalizations(requestCtx, versionValue, asc.WithAppStoreVersionLocalizationsLimit(200))
+			if err != nil {
+				return fmt.Errorf("whatsnew set: failed to fetch localizations: %w", err)
+			}
+			existing := make([]string, 0, len(resp.Data))
+			for _, item := range resp.Data {
+				existing = append(existing, item.Attributes.Locale)
+			}
+
+			if notesByLocale == nil {
+				if *allLocales {
+					locales = existing
+				}
+				notesByLocale = make(map[string]string, len(locales))
+				for _, value := range locales {
+					notesByLocale[value] = text
+				}
+			}
+
+			valuesByLocale, err := whatsNewValues(notesByLocale, existing)
+			if err != nil {
+				return fmt.Errorf("whatsnew set: %w", err)
+			}
+
+			results, err := shared.UploadVersionLocalizations(requestCtx, client, versionValue, valuesByLocale, *dryRun)
+			if err != nil {
+				return fmt.Errorf("whatsnew set: %w", err)
+			}
+
+			result := asc.LocalizationUploadResult{
+				Type:      shared.LocalizationTypeVersion,
+				VersionID: versionValue,
+				DryRun:    *dryRun,
+				Results:   results,
+			}
+			return shared.PrintOutput(&result, *output, *pretty)
+		},
+	}
+}
+
+// readNotesMap reads a JSON object mapping locale to release notes.
+func readNotesMap(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var notes map[string]string
+	if err := json.Unmarshal(data, &notes); err != nil {
+		return nil, fmt.Errorf("invalid map file %s: %w", path, err)
+	}
+	if len(notes) == 0 {
+		return nil, fmt.Errorf("map file %s has no locales", path)
+	}
+	return notes, nil
+}
+
+// whatsNewValues validates the release notes against the version's existing
+// locales and the character limit, and returns them as upload values.
+func whatsNewValues(notesByLocale map[string]string, existing []string) (map[string]map[string]string, error) {
+	known := make(map[string]bool, len(existing))
+	for _, locale := range existing {
+		known[locale] = true
+	}
+
+	var unknown []string
+	valuesByLocale := make(map[string]map[string]string, len(notesByLocale))
+	for locale, notes := range notesByLocale {
+		if !known[locale] {
+			unknown = append(unknown, locale)
+			continue
+		}
+		notes = strings.TrimSpace(notes)
+		if notes == "" {
+			return nil, fmt.Errorf("release notes for %s are empty", locale)
+		}
+		if length := utf8.RuneCountInString(notes); length > whatsNewLimit {
+			return nil, fmt.Errorf("release notes for %s are %d characters (limit %d)", locale, length, whatsNewLimit)
+		}
+		valuesByLocale[locale] = map[string]string{"whatsNew": notes}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("version has no localization for: %s", strings.Join(unknown, ", "))
+	}
+	if len(valuesByLocale) == 0 {
+		return nil, fmt.Errorf("version has no localizations")
+	}
+	return valuesByLocale, nil
+}
@@ -0,0 +1,28 @@
+package whatsnew
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWhatsNewValues(t *testing.T) {
+	existing := []string{"en-US", "de-DE"}
+
+	values, err := whatsNewValues(map[string]string{"en-US": " Bug fixes \n", "de-DE": "Fehlerbehebungen"}, existing)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if values["en-US"]["whatsNew"] != "Bug fixes" || values["de-DE"]["whatsNew"] != "Fehlerbehebungen" {
+		t.Fatalf("unexpected values: %v", values)
+	}
+
+	if _, err := whatsNewValues(map[string]string{"en-US": "x", "ja": "y", "fr-FR": "z"}, existing); err == nil || !strings.Contains(err.Error(), "fr-FR, ja") {
+		t.Fatalf("expected unknown locale error, got %v", err)
+	}
+	if _, err := whatsNewValues(map[string]string{"en-US": strings.Repeat("a", whatsNewLimit+1)}, existing); err == nil || !strings.Contains(err.Error(), "limit 4000") {
+		t.Fatalf("expected limit error, got %v", err)
+	}
+	if _, err := whatsNewValues(map[string]string{"en-US": "  "}, existing); err == nil {
+		t.Fatal("expected empty notes error")
+	}
+}
@@ -31,6 +31,7 @@ Examples:
   asc xcode-cloud scm providers list
   asc xcode-cloud run --app "APP_ID" --workflow "WorkflowName" --branch "main"
   asc xcode-cloud run --workflow-id "WORKFLOW_ID" --git-reference-id "REF_ID"
+  asc xcode-cloud run --workflow-id "WORKFLOW_ID" --pr 42
   asc xcode-cloud run --app "APP_ID" --workflow "Deploy" --branch "main" --wait
   asc xcode-cloud status --run-id "BUILD_RUN_ID"
   asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait`,
@@ -64,6 +65,8 @@ func XcodeCloudRunCommand() *ffcli.Command {
 	workflowName := fs.String("workflow", "", "Workflow name to trigger")
 	workflowID := fs.String("workflow-id", "", "Workflow ID to trigger (alternative to --workflow)")
 	branch := fs.String("branch", "", "Branch or tag name to build")
+	tag := fs.String("tag", "", "Tag name to build (alternative to --branch)")
+	pr := fs.Int("pr", 0, "Pull request number to build (alternative to --branch)")
 	gitReferenceID := fs.String("git-reference-id", "", "Git reference ID to build (alternative to --branch)")
 	wait := fs.Bool("wait", false, "Wait for build to complete")
 	pollInterval := fs.Duration("poll-interval", 10*time.Second, "Poll interval when waiting")
@@ -78,10 +81,15 @@ func XcodeCloudRunCommand() *ffcli.Command {
 		LongHelp: `Trigger an Xcode Cloud workflow build.
 
 You can specify the workflow by name (requires --app) or by ID (--workflow-id).
-You can specify the branch/tag by name (--branch) or by ID (--git-reference-id).
+You can specify the branch/tag by name (--branch), a tag only (--tag), an open
+pull request by number (--pr) or a git reference by ID (--git-reference-id).
+Tags and pull requests are looked up in the workflow's primary repository;
+asc xcode-cloud scm repositories pull-requests lists the pull request numbers.
 
 Examples:
   asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main"
+  asc xcode-cloud run --workflow-id "WORKFLOW_ID" --tag "v1.2.3"
+  asc xcode-cloud run --workflow-id "WORKFLOW_ID" --pr 42
   asc xcode-cloud run --workflow-id "WORKFLOW_ID" --git-reference-id "REF_ID"
   asc xcode-cloud run --app "123456789" --workflow "Deploy" --branch "release/1.0" --wait
   asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main" --wait --poll-interval 30s --timeout 1h`,
@@ -92,6 +100,8 @@ Examples:
 			hasWorkflowName := strings.TrimSpace(*workflowName) != ""
 			hasWorkflowID := strings.TrimSpace(*workflowID) != ""
 			hasBranch := strings.TrimSpace(*branch) != ""
+			hasTag := strings.TrimSpace(*tag) != ""
+			hasPR := *pr != 0
 			hasGitRefID := strings.TrimSpace(*gitReferenceID) != ""
 
 			if hasWorkflowName && hasWorkflowID {
@@ -101,13 +111,22 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error: --workflow or --workflow-id is required")
 				return flag.ErrHelp
 			}
-			if hasBranch && hasGitRefID {
-				return fmt.Errorf("xcode-cloud run: --branch and --git-reference-id are mutually exclusive")
+			references := 0
+			for _, set := range []bool{hasBranch, hasTag, hasPR, hasGitRefID} {
+				if set {
+					references++
+				}
+			}
+			if references > 1 {
+				return fmt.Errorf("xcode-cloud run: --branch, --tag, --pr and --git-reference-id are mutually exclusive")
 			}
-			if !hasBranch && !hasGitRefID {
-				fmt.Fprintln(os.Stderr, "Error: --branch or --git-reference-id is required")
+			if references == 0 {
+				fmt.Fprintln(os.Stderr, "Error: --branch, --tag, --pr or --git-reference-id is required")
 				return flag.ErrHelp
 			}
+			if *pr < 0 {
+				return fmt.Errorf("xcode-cloud run: --pr must be greater than 0")
+			}
 			if *timeout < 0 {
 				return fmt.Errorf("xcode-cloud run: --timeout must be greater than or equal to 0")
 			}
@@ -148,38 +167,63 @@ Examples:
 				workflowNameForOutput = workflow.Attributes.Name
 			}
 
-			// Resolve git reference ID
+			relationships := &asc.CiBuildRunCreateRelationships{
+				Workflow: &asc.Relationship{
+					Data: asc.ResourceData{Type: asc.ResourceTypeCiWorkflows, ID: resolvedWorkflowID},
+				},
+			}
+
+			// Resolve the git reference or pull request to build
 			resolvedGitRefID := strings.TrimSpace(*gitReferenceID)
 			var refNameForOutput string
+			var pullRequestID string
+			var pullRequestNumber int
 			if resolvedGitRefID == "" {
-				// Need to resolve git reference by name
-				// First get the repository from the workflow
+				// Look up the name in the workflow's repository
 				repo, err := client.GetCiWorkflowRepository(requestCtx, resolvedWorkflowID)
 				if err != nil {
 					return fmt.Errorf("xcode-cloud run: failed to get workflow repository: %w", err)
 				}
 
-				gitRef, err := client.ResolveGitReferenceByName(requestCtx, repo.ID, strings.TrimSpace(*branch))
-				if err != nil {
-					return fmt.Errorf("xcode-cloud run: %w", err)
+				switch {
+				case hasPR:
+					pullRequest, err := client.ResolveScmPullRequestByNumber(requestCtx, repo.ID, *pr)
+					if err != nil {
+						return fmt.Errorf("xcode-cloud run: %w", err)
+					}
+					pullRequestID = pullRequest.ID
+					pullRequestNumber = pullRequest.Attributes.Number
+				case hasTag:
+					gitRef, err := client.ResolveGitReferenceOfKind(requestCtx, repo.ID, "TAG", strings.TrimSpace(*tag))
+					if err != nil {
+						return fmt.Errorf("xcode-cloud run: %w", err)
+					}
+					resolvedGitRefID = gitRef.ID
+					refNameForOutput = gitRef.Attributes.Name
+				default:
+					gitRef, err := client.ResolveGitReferenceByName(requestCtx, repo.ID, strings.TrimSpace(*branch))
+					if err != nil {
+						return fmt.Errorf("xcode-cloud run: %w", err)
+					}
+					resolvedGitRefID = gitRef.ID
+					refNameForOutput = gitRef.Attributes.Name
+				}
+			}
+			if pullRequestID != "" {
+				relationships.PullRequest = &asc.Relationship{
+					Data: asc.ResourceData{Type: asc.ResourceTypeScmPullRequests, ID: pullRequestID},
+				}
+			} else {
+				relationships.SourceBranchOrTag = &asc.Relationship{
+					Data: asc.ResourceData{Type: asc.ResourceTypeScmGitReferences, ID: resolvedGitRefID},
 				}
-
-				resolvedGitRefID = gitRef.ID
-				refNameForOutput = gitRef.Attributes.Name
 			}
 
 			// Create the build run
 			req := asc.CiBuildRunCreateRequest{
 				Data: asc.CiBuildRunCreateData{
-					Type: asc.ResourceTypeCiBuildRuns,
-					Relationships: &asc.CiBuildRunCreateRelationships{
-						Workflow: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeCiWorkflows, ID: resolvedWorkflowID},
-						},
-						SourceBranchOrTag: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeScmGitReferences, ID: resolvedGitRefID},
-						},
-					},
+					Type:          asc.ResourceTypeCiBuildRuns,
+					Relationships: relationships,
 				},
 			}
 
@@ -195,6 +239,8 @@ Examples:
 				WorkflowName:      workflowNameForOutput,
 				GitReferenceID:    resolvedGitRefID,
 				GitReferenceName:  refNameForOutput,
+				PullRequestID:     pullRequestID,
+				PullRequestNumber: pullRequestNumber,
 				ExecutionProgress: string(resp.Data.Attributes.ExecutionProgress),
 				CompletionStatus:  string(resp.Data.Attributes.CompletionStatus),
 				StartReason:       resp.Data.Attributes.StartReason,
@@ -230,6 +276,10 @@ func XcodeCloudStatusCommand() *ffcli.Command {
 		ShortHelp:  "Check the status of an Xcode Cloud build run.",
 		LongHelp: `Check the status of an Xcode Cloud build run.
 
+With --wait, the run is polled until it completes. On a terminal, each change
+of run or action state is written to stderr. The final status is printed, and
+the command exits non-zero unless the run succeeded.
+
 Examples:
   asc xcode-cloud status --run-id "BUILD_RUN_ID"
   asc xcode-cloud status --run-id "BUILD_RUN_ID" --output table
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -16,6 +17,16 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
+var ciArtifactTypes = []string{
+	"ARCHIVE",
+	"ARCHIVE_EXPORT",
+	"LOG_BUNDLE",
+	"RESULT_BUNDLE",
+	"TEST_PRODUCTS",
+	"XCODEBUILD_PRODUCTS",
+	"STAPLED_NOTARIZED_ARCHIVE",
+}
+
 // XcodeCloudArtifactsCommand returns the xcode-cloud artifacts command with subcommands.
 func XcodeCloudArtifactsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("artifacts", flag.ExitOnError)
@@ -29,7 +40,8 @@ func XcodeCloudArtifactsCommand() *ffcli.Command {
 Examples:
   asc xcode-cloud artifacts list --action-id "ACTION_ID"
   asc xcode-cloud artifacts get --id "ARTIFACT_ID"
-  asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip`,
+  asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip
+  asc xcode-cloud artifacts download --run-id "BUILD_RUN_ID" --dir ./artifacts`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -171,27 +183,55 @@ func XcodeCloudArtifactsDownloadCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Artifact ID")
 	path := fs.String("path", "", "Output file path for the artifact")
-	overwrite := fs.Bool("overwrite", false, "Overwrite existing file")
+	runID := fs.String("run-id", "", "Build run ID whose artifacts to download (alternative to --id)")
+	dir := fs.String("dir", "artifacts", "Directory to download a build run's artifacts into (with --run-id)")
+	types := fs.String("type", "", "Comma-separated artifact types to download with --run-id: "+strings.Join(ciArtifactTypes, ", ")+" (default: all)")
+	overwrite := fs.Bool("overwrite", false, "Overwrite existing files")
+	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
 	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "download",
-		ShortUsage: "asc xcode-cloud artifacts download --id \"ARTIFACT_ID\" --path ./artifact.zip",
-		ShortHelp:  "Download a build artifact.",
-		LongHelp: `Download a build artifact.
+		ShortUsage: "asc xcode-cloud artifacts download (--id \"ARTIFACT_ID\" --path ./artifact.zip | --run-id \"BUILD_RUN_ID\" [--dir DIR] [--type TYPE,...])",
+		ShortHelp:  "Download a build artifact, or every artifact of a build run.",
+		LongHelp: `Download a build artifact, or every artifact of a build run.
+
+With --run-id, the artifacts (archives, logs, result bundles, ...) of each of
+the run's actions are downloaded to
+  {dir}/{action name}/{file name}
+Artifacts already on disk are skipped, so an interrupted download can be
+resumed; pass --overwrite to download them again.
 
 Examples:
   asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip
-  asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip --overwrite`,
+  asc xcode-cloud artifacts download --id "ARTIFACT_ID" --path ./artifact.zip --overwrite
+  asc xcode-cloud artifacts download --run-id "BUILD_RUN_ID" --dir ./artifacts
+  asc xcode-cloud artifacts download --run-id "BUILD_RUN_ID" --type LOG_BUNDLE,RESULT_BUNDLE --timeout 1h`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
 			idValue := strings.TrimSpace(*id)
-			if idValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --id is required")
+			runIDValue := strings.TrimSpace(*runID)
+			if idValue != "" && runIDValue != "" {
+				return fmt.Errorf("xcode-cloud artifacts download: --id and --run-id are mutually exclusive")
+			}
+			if idValue == "" && runIDValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id or --run-id is required")
 				return flag.ErrHelp
 			}
+			if *timeout < 0 {
+				return fmt.Errorf("xcode-cloud artifacts download: --timeout must be greater than or equal to 0")
+			}
+			if runIDValue != "" {
+				if strings.TrimSpace(*path) != "" {
+					return fmt.Errorf("xcode-cloud artifacts download: --path is only valid with --id; use --dir with --run-id")
+				}
+				return downloadBuildRunArtifacts(ctx, runIDValue, *dir, *types, *overwrite, *timeout, *output, *pretty)
+			}
+			if strings.TrimSpace(*types) != "" {
+				return fmt.Errorf("xcode-cloud artifacts download: --type is only valid with --run-id")
+			}
 			pathValue := strings.TrimSpace(*path)
 			if pathValue == "" {
 				fmt.Fprintln(os.Stderr, "Error: --path is required")
@@ -203,7 +243,7 @@ Examples:
 				return fmt.Errorf("xcode-cloud artifacts download: %w", err)
 			}
 
-			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
 			defer cancel()
 
 			artifactResp, err := client.GetCiArtifact(requestCtx, idValue)
@@ -241,6 +281,152 @@ Examples:
 	}
 }
 
+// downloadBuildRunArtifacts downloads the artifacts of every action of a
+// build run into dir, optionally only those of the given types.
+func downloadBuildRunArtifacts(ctx context.Context, runID, dir, types string, overwrite bool, timeout time.Duration, output string, pretty bool) error {
+	outDir := strings.TrimSpace(dir)
+	if outDir == "" {
+		fmt.Fprintln(os.Stderr, "Error: --dir is required")
+		return flag.ErrHelp
+	}
+	typeFilter := shared.SplitCSVUpper(types)
+	for _, value := range typeFilter {
+		if !containsString(ciArtifactTypes, value) {
+			fmt.Fprintf(os.Stderr, "Error: --type must be one of: %s\n", strings.Join(ciArtifactTypes, ", "))
+			return flag.ErrHelp
+		}
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("xcode-cloud artifacts download: %w", err)
+	}
+
+	requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, timeout)
+	defer cancel()
+
+	actions, err := getCiBuildRunActions(requestCtx, client, runID)
+	if err != nil {
+		return fmt.Errorf("xcode-cloud artifacts download: failed to list actions: %w", err)
+	}
+
+	result := &asc.CiArtifactsDownloadResult{
+		BuildRunID: runID,
+		OutputDir:  outDir,
+		Types:      typeFilter,
+		Artifacts:  []asc.CiArtifactsDownloadItem{},
+	}
+	for _, action := range actions {
+		artifacts, err := getCiActionArtifacts(requestCtx, client, action.ID)
+		if err != nil {
+			return fmt.Errorf("xcode-cloud artifacts download: failed to list artifacts of action %s: %w", action.ID, err)
+		}
+		actionDir := filepath.Join(outDir, artifactPathComponent(action.Attributes.Name, action.ID))
+		for _, artifact := range artifacts {
+			if len(typeFilter) > 0 && !containsString(typeFilter, strings.ToUpper(artifact.Attributes.FileType)) {
+				continue
+			}
+			item, err := downloadCiArtifact(requestCtx, client, artifact, actionDir, overwrite)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud artifacts download: %s: %w", artifact.ID, err)
+			}
+			item.ActionID = action.ID
+			item.ActionName = action.Attributes.Name
+			if item.Status == "skipped" {
+				result.Skipped++
+			} else {
+				result.Downloaded++
+			}
+			result.Artifacts = append(result.Artifacts, item)
+		}
+	}
+
+	return shared.PrintOutput(result, output, pretty)
+}
+
+func getCiActionArtifacts(ctx context.Context, client *asc.Client, actionID string) ([]asc.CiArtifactResource, error) {
+	firstPage, err := client.GetCiBuildActionArtifacts(ctx, actionID, asc.WithCiArtifactsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActionArtifacts(ctx, actionID, asc.WithCiArtifactsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := all.(*asc.CiArtifactsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected artifacts response type %T", all)
+	}
+	return resp.Data, nil
+}
+
+// downloadCiArtifact downloads one artifact into dir, skipping it when the
+// file already exists and overwrite is false.
+func downloadCiArtifact(ctx context.Context, client *asc.Client, artifact asc.CiArtifactResource, dir string, overwrite bool) (asc.CiArtifactsDownloadItem, error) {
+	item := asc.CiArtifactsDownloadItem{
+		ID:       artifact.ID,
+		FileName: artifact.Attributes.FileName,
+		FileType: artifact.Attributes.FileType,
+		FileSize: artifact.Attributes.FileSize,
+		Path:     filepath.Join(dir, artifactPathComponent(artifact.Attributes.FileName, artifact.ID)),
+	}
+
+	if !overwrite {
+		if _, err := os.Lstat(item.Path); err == nil {
+			item.Status = "skipped"
+			return item, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return item, err
+		}
+	}
+
+	downloadURL := strings.TrimSpace(artifact.Attributes.DownloadURL)
+	if downloadURL == "" {
+		resp, err := client.GetCiArtifact(ctx, artifact.ID)
+		if err != nil {
+			return item, fmt.Errorf("failed to fetch artifact: %w", err)
+		}
+		downloadURL = strings.TrimSpace(resp.Data.Attributes.DownloadURL)
+	}
+	if downloadURL == "" {
+		return item, fmt.Errorf("artifact has no download URL")
+	}
+
+	download, err := client.DownloadCiArtifact(ctx, downloadURL)
+	if err != nil {
+		return item, err
+	}
+	defer download.Body.Close()
+
+	bytesWritten, err := writeArtifactFile(item.Path, download.Body, overwrite)
+	if err != nil {
+		return item, err
+	}
+	if shared.ProgressEnabled() {
+		fmt.Fprintf(os.Stderr, "Downloaded %s (%d bytes)\n", item.Path, bytesWritten)
+	}
+	item.Status = "downloaded"
+	item.BytesWritten = bytesWritten
+	return item, nil
+}
+
+// artifactPathComponent turns an API-provided name into a single path
+// element, falling back to fallback for names that are empty or only dots.
+func artifactPathComponent(name, fallback string) string {
+	cleaned := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == 0 {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if strings.Trim(cleaned, ".") == "" {
+		return fallback
+	}
+	return cleaned
+}
+
 func writeArtifactFile(path string, reader io.Reader, overwrite bool) (int64, error) {
 	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
 		return 0, err
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"os"
 	"syscall"
 	"time"
 
@@ -13,15 +15,29 @@ import (
 )
 
 // waitForBuildCompletion polls until the build run completes or times out.
+// When progress is enabled, changes of run and action state are written to
+// stderr as they happen.
 func waitForBuildCompletion(ctx context.Context, client *asc.Client, buildRunID string, pollInterval time.Duration, outputFormat string, pretty bool) error {
 	ticker := time.NewTicker(pollInterval)
 	defer ticker.Stop()
 
+	var progress *ciRunProgress
+	if shared.ProgressEnabled() {
+		progress = &ciRunProgress{out: os.Stderr}
+	}
+
 	for {
 		resp, err := getCiBuildRunWithRetry(ctx, client, buildRunID)
 		if err != nil {
 			return fmt.Errorf("xcode-cloud: failed to check status: %w", err)
 		}
+		if progress != nil {
+			actions, err := getCiBuildRunActions(ctx, client, buildRunID)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud: failed to check actions: %w", err)
+			}
+			progress.update(resp.Data, actions)
+		}
 
 		if asc.IsBuildRunComplete(resp.Data.Attributes.ExecutionProgress) {
 			result := buildStatusResult(resp)
@@ -48,6 +64,61 @@ func waitForBuildCompletion(ctx context.Context, client *asc.Client, buildRunID
 	}
 }
 
+func getCiBuildRunActions(ctx context.Context, client *asc.Client, buildRunID string) ([]asc.CiBuildActionResource, error) {
+	firstPage, err := client.GetCiBuildActions(ctx, buildRunID, asc.WithCiBuildActionsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActions(ctx, buildRunID, asc.WithCiBuildActionsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := all.(*asc.CiBuildActionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected build actions response type %T", all)
+	}
+	return resp.Data, nil
+}
+
+// ciRunProgress writes a line whenever the run or one of its actions
+// changes state.
+type ciRunProgress struct {
+	out     io.Writer
+	run     string
+	actions map[string]string
+}
+
+func (p *ciRunProgress) update(run asc.CiBuildRunResource, actions []asc.CiBuildActionResource) {
+	if state := ciStateLabel(run.Attributes.ExecutionProgress, run.Attributes.CompletionStatus); state != p.run {
+		p.run = state
+		fmt.Fprintf(p.out, "Build run %d: %s\n", run.Attributes.Number, state)
+	}
+	if p.actions == nil {
+		p.actions = make(map[string]string)
+	}
+	for _, action := range actions {
+		state := ciStateLabel(action.Attributes.ExecutionProgress, action.Attributes.CompletionStatus)
+		if p.actions[action.ID] == state {
+			continue
+		}
+		p.actions[action.ID] = state
+		name := action.Attributes.Name
+		if name == "" {
+			name = action.ID
+		}
+		fmt.Fprintf(p.out, "  %s: %s\n", name, state)
+	}
+}
+
+func ciStateLabel(progress asc.CiBuildRunExecutionProgress, status asc.CiBuildRunCompletionStatus) string {
+	if asc.IsBuildRunComplete(progress) && status != "" {
+		return fmt.Sprintf("%s (%s)", progress, status)
+	}
+	return string(progress)
+}
+
 // buildStatusResult converts a CiBuildRunResponse to XcodeCloudStatusResult.
 func buildStatusResult(resp *asc.CiBuildRunResponse) *asc.XcodeCloudStatusResult {
 	result := &asc.XcodeCloudStatusResult{
@@ -112,3 +183,12 @@ func isTransientNetworkError(err error) bool {
 		errors.Is(err, syscall.EPIPE) ||
 		errors.Is(err, syscall.ECONNREFUSED)
 }
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
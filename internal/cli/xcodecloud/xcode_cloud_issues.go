@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -13,6 +14,13 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
+var ciIssueTypes = []string{
+	"ERROR",
+	"WARNING",
+	"ANALYZER_WARNING",
+	"TEST_FAILURE",
+}
+
 // XcodeCloudIssuesCommand returns the xcode-cloud issues command with subcommands.
 func XcodeCloudIssuesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("issues", flag.ExitOnError)
@@ -25,6 +33,7 @@ func XcodeCloudIssuesCommand() *ffcli.Command {
 
 Examples:
   asc xcode-cloud issues list --action-id "ACTION_ID"
+  asc xcode-cloud issues list --run-id "BUILD_RUN_ID" --type ERROR
   asc xcode-cloud issues get --id "ISSUE_ID"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -43,6 +52,9 @@ func XcodeCloudIssuesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	actionID := fs.String("action-id", "", "Build action ID to list issues for")
+	runID := fs.String("run-id", "", "Build run ID to list the issues of every action for (alternative to --action-id)")
+	types := fs.String("type", "", "Comma-separated issue types to list with --run-id: "+strings.Join(ciIssueTypes, ", ")+" (default: all)")
+	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -52,17 +64,38 @@ func XcodeCloudIssuesListCommand() *ffcli.Command {
 	return &ffcli.Command{
 		Name:       "list",
 		ShortUsage: "asc xcode-cloud issues list [flags]",
-		ShortHelp:  "List issues for a build action.",
-		LongHelp: `List issues for a build action.
+		ShortHelp:  "List issues for a build action or build run.",
+		LongHelp: `List issues for a build action or build run.
+
+With --run-id, the issues of every action of the run are listed, each with the
+action it came from and the file and line it points at, so failures can be
+annotated on pull requests.
 
 Examples:
   asc xcode-cloud issues list --action-id "ACTION_ID"
   asc xcode-cloud issues list --action-id "ACTION_ID" --output table
   asc xcode-cloud issues list --action-id "ACTION_ID" --limit 50
-  asc xcode-cloud issues list --action-id "ACTION_ID" --paginate`,
+  asc xcode-cloud issues list --action-id "ACTION_ID" --paginate
+  asc xcode-cloud issues list --run-id "BUILD_RUN_ID" --type ERROR,TEST_FAILURE --output table
+  asc xcode-cloud issues list --run-id "BUILD_RUN_ID" | jq -r '.issues[] | "\(.fileSource.path):\(.fileSource.lineNumber): \(.message)"'`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
+			if *timeout < 0 {
+				return fmt.Errorf("xcode-cloud issues list: --timeout must be greater than or equal to 0")
+			}
+			if runIDValue := strings.TrimSpace(*runID); runIDValue != "" {
+				if strings.TrimSpace(*actionID) != "" {
+					return fmt.Errorf("xcode-cloud issues list: --action-id and --run-id are mutually exclusive")
+				}
+				if *limit != 0 || strings.TrimSpace(*next) != "" || *paginate {
+					return fmt.Errorf("xcode-cloud issues list: --limit, --next and --paginate are only valid with --action-id")
+				}
+				return listBuildRunIssues(ctx, runIDValue, *types, *timeout, *output, *pretty)
+			}
+			if strings.TrimSpace(*types) != "" {
+				return fmt.Errorf("xcode-cloud issues list: --type is only valid with --run-id")
+			}
 			if *limit != 0 && (*limit < 1 || *limit > 200) {
 				return fmt.Errorf("xcode-cloud issues list: --limit must be between 1 and 200")
 			}
@@ -72,7 +105,7 @@ Examples:
 
 			resolvedActionID := strings.TrimSpace(*actionID)
 			if resolvedActionID == "" && strings.TrimSpace(*next) == "" {
-				fmt.Fprintln(os.Stderr, "Error: --action-id is required")
+				fmt.Fprintln(os.Stderr, "Error: --action-id or --run-id is required")
 				return flag.ErrHelp
 			}
 
@@ -81,7 +114,7 @@ Examples:
 				return fmt.Errorf("xcode-cloud issues list: %w", err)
 			}
 
-			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, 0)
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
 			defer cancel()
 
 			opts := []asc.CiIssuesOption{
@@ -159,3 +192,74 @@ Examples:
 		},
 	}
 }
+
+// listBuildRunIssues lists the issues of every action of a build run,
+// optionally only those of the given types.
+func listBuildRunIssues(ctx context.Context, runID, types string, timeout time.Duration, output string, pretty bool) error {
+	typeFilter := shared.SplitCSVUpper(types)
+	for _, value := range typeFilter {
+		if !containsString(ciIssueTypes, value) {
+			fmt.Fprintf(os.Stderr, "Error: --type must be one of: %s\n", strings.Join(ciIssueTypes, ", "))
+			return flag.ErrHelp
+		}
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("xcode-cloud issues list: %w", err)
+	}
+
+	requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, timeout)
+	defer cancel()
+
+	actions, err := getCiBuildRunActions(requestCtx, client, runID)
+	if err != nil {
+		return fmt.Errorf("xcode-cloud issues list: failed to list actions: %w", err)
+	}
+
+	result := &asc.CiIssuesListResult{
+		BuildRunID: runID,
+		Types:      typeFilter,
+		Issues:     []asc.CiIssueItem{},
+	}
+	for _, action := range actions {
+		issues, err := getCiActionIssues(requestCtx, client, action.ID)
+		if err != nil {
+			return fmt.Errorf("xcode-cloud issues list: failed to list issues of action %s: %w", action.ID, err)
+		}
+		for _, issue := range issues {
+			if len(typeFilter) > 0 && !containsString(typeFilter, strings.ToUpper(issue.Attributes.IssueType)) {
+				continue
+			}
+			result.Issues = append(result.Issues, asc.CiIssueItem{
+				ID:         issue.ID,
+				ActionID:   action.ID,
+				ActionName: action.Attributes.Name,
+				IssueType:  issue.Attributes.IssueType,
+				Category:   issue.Attributes.Category,
+				Message:    issue.Attributes.Message,
+				FileSource: issue.Attributes.FileSource,
+			})
+		}
+	}
+
+	return shared.PrintOutput(result, output, pretty)
+}
+
+func getCiActionIssues(ctx context.Context, client *asc.Client, actionID string) ([]asc.CiIssueResource, error) {
+	firstPage, err := client.GetCiBuildActionIssues(ctx, actionID, asc.WithCiIssuesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActionIssues(ctx, actionID, asc.WithCiIssuesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := all.(*asc.CiIssuesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected issues response type %T", all)
+	}
+	return resp.Data, nil
+}
@@ -1,6 +1,11 @@
 package xcodecloud
 
-import "testing"
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
 
 func TestXcodeCloudCommandConstructors(t *testing.T) {
 	top := XcodeCloudCommand()
@@ -31,6 +36,7 @@ func TestXcodeCloudCommandConstructors(t *testing.T) {
 		func() interface{} { return XcodeCloudProductsCommand() },
 		func() interface{} { return XcodeCloudMacOSVersionsCommand() },
 		func() interface{} { return XcodeCloudXcodeVersionsCommand() },
+		func() interface{} { return XcodeCloudTestResultsSummaryCommand() },
 	}
 	for _, ctor := range constructors {
 		if got := ctor(); got == nil {
@@ -38,3 +44,44 @@ func TestXcodeCloudCommandConstructors(t *testing.T) {
 		}
 	}
 }
+
+func TestCIRunProgressReportsOnlyStateChanges(t *testing.T) {
+	var out bytes.Buffer
+	progress := &ciRunProgress{out: &out}
+	run := func(state asc.CiBuildRunExecutionProgress, status asc.CiBuildRunCompletionStatus) asc.CiBuildRunResource {
+		return asc.CiBuildRunResource{ID: "run-1", Attributes: asc.CiBuildRunAttributes{Number: 7, ExecutionProgress: state, CompletionStatus: status}}
+	}
+	action := func(id, name string, state asc.CiBuildRunExecutionProgress, status asc.CiBuildRunCompletionStatus) asc.CiBuildActionResource {
+		return asc.CiBuildActionResource{ID: id, Attributes: asc.CiBuildActionAttributes{Name: name, ExecutionProgress: state, CompletionStatus: status}}
+	}
+
+	progress.update(run("RUNNING", ""), []asc.CiBuildActionResource{action("a1", "Build - iOS", "RUNNING", "")})
+	progress.update(run("RUNNING", ""), []asc.CiBuildActionResource{action("a1", "Build - iOS", "RUNNING", ""), action("a2", "Test - iOS", "PENDING", "")})
+	progress.update(run("COMPLETE", "FAILED"), []asc.CiBuildActionResource{action("a1", "Build - iOS", "COMPLETE", "SUCCEEDED"), action("a2", "Test - iOS", "COMPLETE", "FAILED")})
+
+	want := "Build run 7: RUNNING\n" +
+		"  Build - iOS: RUNNING\n" +
+		"  Test - iOS: PENDING\n" +
+		"Build run 7: COMPLETE (FAILED)\n" +
+		"  Build - iOS: COMPLETE (SUCCEEDED)\n" +
+		"  Test - iOS: COMPLETE (FAILED)\n"
+	if out.String() != want {
+		t.Fatalf("unexpected progress output:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestArtifactPathComponent(t *testing.T) {
+	tests := map[string]string{
+		"Archive - iOS":  "Archive - iOS",
+		"logs/../../etc": "logs_.._.._etc",
+		"..":             "fallback",
+		"  ":             "fallback",
+		"Build.xcresult": "Build.xcresult",
+		`dir\file.zip`:   "dir_file.zip",
+	}
+	for name, want := range tests {
+		if got := artifactPathComponent(name, "fallback"); got != want {
+			t.Fatalf("artifactPathComponent(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
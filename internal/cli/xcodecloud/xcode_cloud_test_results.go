@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
@@ -20,17 +21,19 @@ func XcodeCloudTestResultsCommand() *ffcli.Command {
 	return &ffcli.Command{
 		Name:       "test-results",
 		ShortUsage: "asc xcode-cloud test-results <subcommand> [flags]",
-		ShortHelp:  "List Xcode Cloud test results.",
-		LongHelp: `List Xcode Cloud test results.
+		ShortHelp:  "List and summarize Xcode Cloud test results.",
+		LongHelp: `List and summarize Xcode Cloud test results.
 
 Examples:
   asc xcode-cloud test-results list --action-id "ACTION_ID"
-  asc xcode-cloud test-results get --id "TEST_RESULT_ID"`,
+  asc xcode-cloud test-results get --id "TEST_RESULT_ID"
+  asc xcode-cloud test-results summary --run-id "BUILD_RUN_ID" --output junit`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			XcodeCloudTestResultsListCommand(),
 			XcodeCloudTestResultsGetCommand(),
+			XcodeCloudTestResultsSummaryCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -159,3 +162,162 @@ Examples:
 		},
 	}
 }
+
+// XcodeCloudTestResultsSummaryCommand returns the xcode-cloud test-results summary subcommand.
+func XcodeCloudTestResultsSummaryCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("summary", flag.ExitOnError)
+
+	runID := fs.String("run-id", "", "Build run ID whose test results to summarize")
+	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, junit")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "summary",
+		ShortUsage: "asc xcode-cloud test-results summary --run-id BUILD_RUN_ID [--output junit]",
+		ShortHelp:  "Summarize the test results of an Xcode Cloud build run.",
+		LongHelp: `Summarize the test results of an Xcode Cloud build run.
+
+Collects the ciTestResults of every test action of the run and prints how
+many tests passed, failed and were skipped, followed by the failing tests
+with their messages. MIXED results, which failed on some test destinations
+only, count as failures.
+
+--output junit prints a JUnit XML report of every test instead, for
+dashboards that ingest JUnit; test durations are summed over destinations.
+
+Examples:
+  asc xcode-cloud test-results summary --run-id "BUILD_RUN_ID"
+  asc xcode-cloud test-results summary --run-id "BUILD_RUN_ID" --output table
+  asc xcode-cloud test-results summary --run-id "BUILD_RUN_ID" --output junit > xcode-cloud-tests.xml`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			runIDValue := strings.TrimSpace(*runID)
+			if runIDValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --run-id is required")
+				return flag.ErrHelp
+			}
+			if *timeout < 0 {
+				return fmt.Errorf("xcode-cloud test-results summary: --timeout must be greater than or equal to 0")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud test-results summary: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
+			defer cancel()
+
+			actions, err := getCiBuildRunActions(requestCtx, client, runIDValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud test-results summary: failed to list actions: %w", err)
+			}
+
+			var results []ciActionTestResult
+			for _, action := range actions {
+				if action.Attributes.ActionType != "" && !strings.EqualFold(action.Attributes.ActionType, "TEST") {
+					continue
+				}
+				tests, err := getCiActionTestResults(requestCtx, client, action.ID)
+				if err != nil {
+					return fmt.Errorf("xcode-cloud test-results summary: failed to list test results of action %s: %w", action.ID, err)
+				}
+				for _, test := range tests {
+					results = append(results, ciActionTestResult{action: action.Attributes.Name, test: test})
+				}
+			}
+
+			if strings.EqualFold(strings.TrimSpace(*output), "junit") {
+				report := ciTestResultsJUnitReport(runIDValue, results)
+				if _, err := report.WriteTo(os.Stdout); err != nil {
+					return fmt.Errorf("xcode-cloud test-results summary: %w", err)
+				}
+				return nil
+			}
+			return shared.PrintOutput(summarizeCiTestResults(runIDValue, results), *output, *pretty)
+		},
+	}
+}
+
+type ciActionTestResult struct {
+	action string
+	test   asc.CiTestResultResource
+}
+
+func getCiActionTestResults(ctx context.Context, client *asc.Client, actionID string) ([]asc.CiTestResultResource, error) {
+	firstPage, err := client.GetCiBuildActionTestResults(ctx, actionID, asc.WithCiTestResultsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetCiBuildActionTestResults(ctx, actionID, asc.WithCiTestResultsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := all.(*asc.CiTestResultsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected test results response type %T", all)
+	}
+	return resp.Data, nil
+}
+
+func summarizeCiTestResults(runID string, results []ciActionTestResult) *asc.CiTestResultsSummary {
+	summary := &asc.CiTestResultsSummary{BuildRunID: runID, Failures: []asc.CiTestFailure{}}
+	for _, result := range results {
+		attributes := result.test.Attributes
+		summary.Total++
+		switch attributes.Status {
+		case asc.CiTestStatusFailure, asc.CiTestStatusMixed:
+			summary.Failed++
+			summary.Failures = append(summary.Failures, asc.CiTestFailure{
+				ID:         result.test.ID,
+				ActionName: result.action,
+				ClassName:  attributes.ClassName,
+				Name:       attributes.Name,
+				Status:     string(attributes.Status),
+				Message:    attributes.Message,
+				FileSource: attributes.FileSource,
+			})
+		case asc.CiTestStatusSkipped:
+			summary.Skipped++
+		case asc.CiTestStatusExpectedFailure:
+			summary.ExpectedFailures++
+		default:
+			summary.Passed++
+		}
+	}
+	return summary
+}
+
+func ciTestResultsJUnitReport(runID string, results []ciActionTestResult) *shared.JUnitReport {
+	report := &shared.JUnitReport{
+		Name:      "Xcode Cloud build run " + runID,
+		Timestamp: time.Now(),
+	}
+	for _, result := range results {
+		attributes := result.test.Attributes
+		classname := attributes.ClassName
+		if result.action != "" {
+			classname = result.action + "." + classname
+		}
+		var duration float64
+		for _, destination := range attributes.DestinationTestResults {
+			duration += destination.Duration
+		}
+		testCase := shared.JUnitTestCase{
+			Name:      attributes.Name,
+			Classname: classname,
+			Time:      time.Duration(duration * float64(time.Second)),
+			Skipped:   attributes.Status == asc.CiTestStatusSkipped,
+		}
+		if attributes.Status == asc.CiTestStatusFailure || attributes.Status == asc.CiTestStatusMixed {
+			testCase.Failure = string(attributes.Status)
+			testCase.Message = attributes.Message
+		}
+		report.Tests = append(report.Tests, testCase)
+	}
+	return report
+}
@@ -18,6 +18,13 @@ const (
 	maxConfigRetries = 30
 )
 
+// Endpoint policy classes accepted in endpoint_policies.
+const (
+	EndpointClassUploads = "uploads"
+	EndpointClassLists   = "lists"
+	EndpointClassReports = "reports"
+)
+
 // DurationValue stores a duration with its raw string representation.
 // It marshals to/from JSON as a string to preserve config compatibility.
 type DurationValue struct {
@@ -117,6 +124,14 @@ type Credential struct {
 	PrivateKeyPath string `json:"private_key_path"`
 }
 
+// EndpointPolicy overrides timeout and retry behavior for a class of endpoints.
+type EndpointPolicy struct {
+	Timeout          DurationValue `json:"timeout"`
+	MaxRetries       string        `json:"max_retries"`
+	RetryOnRateLimit *bool         `json:"retry_on_rate_limit,omitempty"`
+	RetryOnNotReady  *bool         `json:"retry_on_not_ready,omitempty"`
+}
+
 // Config holds the application configuration
 type Config struct {
 	KeyID          string       `json:"key_id"`
@@ -138,6 +153,9 @@ type Config struct {
 	MaxDelay             string        `json:"max_delay"`
 	RetryLog             string        `json:"retry_log"`
 	Debug                string        `json:"debug"`
+	Redact               string        `json:"redact"`
+
+	EndpointPolicies map[string]EndpointPolicy `json:"endpoint_policies,omitempty"`
 }
 
 // ErrNotFound is returned when the config file doesn't exist
@@ -346,6 +364,26 @@ func (c *Config) Validate() error {
 	if baseSet && maxSet && maxDelay < baseDelay {
 		return wrapInvalidConfig(fmt.Errorf("max_delay must be >= base_delay"))
 	}
+	for class, policy := range c.EndpointPolicies {
+		if err := validateEndpointPolicy(class, policy); err != nil {
+			return wrapInvalidConfig(err)
+		}
+	}
+	return nil
+}
+
+func validateEndpointPolicy(class string, policy EndpointPolicy) error {
+	switch class {
+	case EndpointClassUploads, EndpointClassLists, EndpointClassReports:
+	default:
+		return fmt.Errorf("endpoint_policies: unknown class %q (expected %s, %s, or %s)", class, EndpointClassUploads, EndpointClassLists, EndpointClassReports)
+	}
+	if err := validateDurationValue("endpoint_policies."+class+".timeout", policy.Timeout); err != nil {
+		return err
+	}
+	if err := validateMaxRetries(policy.MaxRetries); err != nil {
+		return fmt.Errorf("endpoint_policies.%s.%w", class, err)
+	}
 	return nil
 }
 
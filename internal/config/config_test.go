@@ -4,6 +4,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -344,3 +345,44 @@ func TestLoadAtRejectsMaxDelayBelowBaseDelay(t *testing.T) {
 		t.Fatalf("expected ErrInvalidConfig, got %v", err)
 	}
 }
+
+func TestLoadAtRejectsInvalidEndpointPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies map[string]EndpointPolicy
+		wantErr  string
+	}{
+		{
+			name:     "unknown class",
+			policies: map[string]EndpointPolicy{"downloads": {}},
+			wantErr:  `unknown class "downloads"`,
+		},
+		{
+			name:     "invalid timeout",
+			policies: map[string]EndpointPolicy{EndpointClassLists: {Timeout: DurationValue{Raw: "soon"}}},
+			wantErr:  "endpoint_policies.lists.timeout",
+		},
+		{
+			name:     "invalid max retries",
+			policies: map[string]EndpointPolicy{EndpointClassReports: {MaxRetries: "-1"}},
+			wantErr:  "endpoint_policies.reports.max_retries",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.json")
+			if err := SaveAt(path, &Config{EndpointPolicies: test.policies}); err != nil {
+				t.Fatalf("SaveAt() error: %v", err)
+			}
+
+			_, err := LoadAt(path)
+			if !errors.Is(err, ErrInvalidConfig) {
+				t.Fatalf("expected ErrInvalidConfig, got %v", err)
+			}
+			if !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Package fileutil holds file helpers shared by the asc client and the CLI.
+package fileutil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// WriteAtomic writes the output of write to a temporary file next to path
+// and renames it into place, so an interrupted write never leaves a
+// truncated file behind. Missing parent directories are created with dirPerm.
+func WriteAtomic(path string, dirPerm os.FileMode, write func(io.Writer) error) error {
+	if strings.HasSuffix(path, string(filepath.Separator)) {
+		return fmt.Errorf("output path must be a file")
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(dir, "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+	tempName := tempFile.Name()
+	committed := false
+	defer func() {
+		if tempFile != nil {
+			_ = tempFile.Close()
+		}
+		if !committed {
+			_ = os.Remove(tempName)
+		}
+	}()
+
+	if err := write(tempFile); err != nil {
+		return err
+	}
+	if err := tempFile.Sync(); err != nil {
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+	tempFile = nil
+	if err := os.Rename(tempName, path); err != nil {
+		if info, statErr := os.Stat(path); statErr == nil && info.IsDir() {
+			return fmt.Errorf("output path is a directory")
+		}
+		return err
+	}
+	committed = true
+	return nil
+}
+
+// WriteFileAtomic writes data to path like WriteAtomic.
+func WriteFileAtomic(path string, data []byte, dirPerm os.FileMode) error {
+	return WriteAtomic(path, dirPerm, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
@@ -0,0 +1,83 @@
+package fileutil
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomicCreatesParentsAndReplaces(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "out.json")
+
+	if err := WriteFileAtomic(path, []byte("first"), 0o755); err != nil {
+		t.Fatalf("first write: %v", err)
+	}
+	if err := WriteFileAtomic(path, []byte("second"), 0o755); err != nil {
+		t.Fatalf("second write: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if string(data) != "second" {
+		t.Fatalf("expected replaced contents, got %q", data)
+	}
+	assertNoTempFiles(t, filepath.Dir(path))
+}
+
+func TestWriteAtomicKeepsOriginalOnFailure(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.json")
+	if err := os.WriteFile(path, []byte("original"), 0o644); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+
+	err := WriteAtomic(path, 0o755, func(w io.Writer) error {
+		_, _ = w.Write([]byte("partial"))
+		return errors.New("boom")
+	})
+	if err == nil || err.Error() != "boom" {
+		t.Fatalf("expected write error, got %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if string(data) != "original" {
+		t.Fatalf("expected original contents, got %q", data)
+	}
+	assertNoTempFiles(t, dir)
+}
+
+func TestWriteFileAtomicRejectsDirectories(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := WriteFileAtomic(dir+string(filepath.Separator), []byte("x"), 0o755); err == nil || err.Error() != "output path must be a file" {
+		t.Fatalf("expected trailing separator error, got %v", err)
+	}
+	target := filepath.Join(dir, "existing")
+	if err := os.Mkdir(target, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(target, "child"), nil, 0o644); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	if err := WriteFileAtomic(target, []byte("x"), 0o755); err == nil || err.Error() != "output path is a directory" {
+		t.Fatalf("expected directory error, got %v", err)
+	}
+}
+
+func assertNoTempFiles(t *testing.T, dir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read dir: %v", err)
+	}
+	for _, entry := range entries {
+		if entry.Name() != "out.json" {
+			t.Fatalf("unexpected leftover file %s", entry.Name())
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package fileutil
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// SkipBOM returns a buffered reader over r that skips a leading UTF-8 byte
+// order mark. Spreadsheet apps often save CSV and TSV files with one.
+func SkipBOM(r io.Reader) *bufio.Reader {
+	reader := bufio.NewReader(r)
+	if prefix, err := reader.Peek(len(utf8BOM)); err == nil && bytes.Equal(prefix, utf8BOM) {
+		_, _ = reader.Discard(len(utf8BOM))
+	}
+	return reader
+}
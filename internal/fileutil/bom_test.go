@@ -0,0 +1,32 @@
+package fileutil
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestSkipBOM(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "with BOM", input: "\ufeffterritory,price\n", want: "territory,price\n"},
+		{name: "without BOM", input: "territory,price\n", want: "territory,price\n"},
+		{name: "BOM only", input: "\ufeff", want: ""},
+		{name: "short input", input: "a", want: "a"},
+		{name: "empty", input: "", want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := io.ReadAll(SkipBOM(strings.NewReader(test.input)))
+			if err != nil {
+				t.Fatalf("read: %v", err)
+			}
+			if string(data) != test.want {
+				t.Fatalf("expected %q, got %q", test.want, data)
+			}
+		})
+	}
+}
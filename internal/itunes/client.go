@@ -167,12 +167,16 @@ func (c *Client) fetchHistogram(ctx context.Context, appID, country string, rati
 
 // GetAllRatings fetches rating statistics for an app across all supported countries.
 func (c *Client) GetAllRatings(ctx context.Context, appID string, workers int) (*GlobalRatings, error) {
+	return c.GetCountriesRatings(ctx, appID, AllCountries(), workers)
+}
+
+// GetCountriesRatings fetches rating statistics for an app in each of the
+// given countries and aggregates them.
+func (c *Client) GetCountriesRatings(ctx context.Context, appID string, countries []string, workers int) (*GlobalRatings, error) {
 	if workers < 1 {
 		workers = 10
 	}
 
-	countries := AllCountries()
-
 	var (
 		mu        sync.Mutex
 		wg        sync.WaitGroup
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 )
 
@@ -414,3 +415,39 @@ func writeBody(t *testing.T, w http.ResponseWriter, body string) {
 		t.Errorf("write response: %v", err)
 	}
 }
+
+func TestGetCountriesRatings_OnlyRequestedCountries(t *testing.T) {
+	var mu sync.Mutex
+	requested := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/lookup" {
+			country := r.URL.Query().Get("country")
+			mu.Lock()
+			requested[country] = true
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			writeBody(t, w, `{"resultCount":1,"results":[{"trackId":123,"trackName":"Test App","averageUserRating":4.0,"userRatingCount":10}]}`)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		writeBody(t, w, `<html></html>`)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: &http.Client{
+			Transport: &testTransport{baseURL: server.URL},
+		},
+	}
+
+	global, err := client.GetCountriesRatings(context.Background(), "123", []string{"us", "gb"}, 2)
+	if err != nil {
+		t.Fatalf("GetCountriesRatings() error: %v", err)
+	}
+	if global.CountryCount != 2 || global.TotalCount != 20 {
+		t.Errorf("CountryCount = %d, TotalCount = %d, want 2 and 20", global.CountryCount, global.TotalCount)
+	}
+	if len(requested) != 2 || !requested["us"] || !requested["gb"] {
+		t.Errorf("requested countries = %v, want us and gb", requested)
+	}
+}
@@ -0,0 +1,298 @@
+package tableexport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// Parquet format constants; see https://github.com/apache/parquet-format.
+const (
+	parquetMagic = "PAR1"
+
+	parquetTypeInt64     = 2
+	parquetTypeDouble    = 5
+	parquetTypeByteArray = 6
+
+	parquetRepetitionOptional = 1
+	parquetConvertedUTF8      = 0
+	parquetEncodingPlain      = 0
+	parquetEncodingRLE        = 3
+	parquetCodecUncompressed  = 0
+	parquetPageData           = 0
+)
+
+// WriteParquet writes table to a new Parquet file at path, replacing any
+// existing file. Every column is optional (empty values are null) and is
+// stored as INT64, DOUBLE or UTF-8 BYTE_ARRAY in a single uncompressed row
+// group.
+func WriteParquet(path string, table *Table) error {
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+
+	chunks := make([]parquetColumnChunk, 0, len(table.Columns))
+	for i, column := range table.Columns {
+		page, err := parquetDataPage(table, i)
+		if err != nil {
+			return fmt.Errorf("column %s: %w", column.Name, err)
+		}
+		header := parquetPageHeader(len(table.Rows), len(page))
+		chunks = append(chunks, parquetColumnChunk{
+			column: column,
+			offset: int64(file.Len()),
+			size:   int64(len(header) + len(page)),
+		})
+		file.Write(header)
+		file.Write(page)
+	}
+
+	footer := parquetFileMetaData(table, chunks)
+	file.Write(footer)
+	file.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(footer))))
+	file.WriteString(parquetMagic)
+
+	return fileutil.WriteAtomic(path, 0o755, func(w io.Writer) error {
+		_, err := w.Write(file.Bytes())
+		return err
+	})
+}
+
+type parquetColumnChunk struct {
+	column Column
+	offset int64
+	size   int64
+}
+
+// parquetDataPage encodes one column as definition levels followed by the
+// PLAIN-encoded non-null values.
+func parquetDataPage(table *Table, index int) ([]byte, error) {
+	column := table.Columns[index]
+	defined := make([]bool, len(table.Rows))
+	var values []byte
+	for r, row := range table.Rows {
+		value, err := typedValue(column.Type, cell(row, index))
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			continue
+		}
+		defined[r] = true
+		switch v := value.(type) {
+		case int64:
+			values = binary.LittleEndian.AppendUint64(values, uint64(v))
+		case float64:
+			values = binary.LittleEndian.AppendUint64(values, math.Float64bits(v))
+		case string:
+			values = binary.LittleEndian.AppendUint32(values, uint32(len(v)))
+			values = append(values, v...)
+		}
+	}
+
+	levels := parquetDefinitionLevels(defined)
+	page := binary.LittleEndian.AppendUint32(nil, uint32(len(levels)))
+	page = append(page, levels...)
+	return append(page, values...), nil
+}
+
+// parquetDefinitionLevels encodes 1-bit definition levels as RLE runs of
+// the RLE/bit-packing hybrid encoding.
+func parquetDefinitionLevels(defined []bool) []byte {
+	var out []byte
+	for start := 0; start < len(defined); {
+		end := start
+		for end < len(defined) && defined[end] == defined[start] {
+			end++
+		}
+		out = binary.AppendUvarint(out, uint64(end-start)<<1)
+		if defined[start] {
+			out = append(out, 1)
+		} else {
+			out = append(out, 0)
+		}
+		start = end
+	}
+	return out
+}
+
+func parquetPageHeader(rows, size int) []byte {
+	w := &thriftWriter{}
+	w.i32(1, parquetPageData)
+	w.i32(2, int32(size))
+	w.i32(3, int32(size))
+	w.structBegin(5)
+	w.i32(1, int32(rows))
+	w.i32(2, parquetEncodingPlain)
+	w.i32(3, parquetEncodingRLE)
+	w.i32(4, parquetEncodingRLE)
+	w.structEnd()
+	w.stop()
+	return w.buf.Bytes()
+}
+
+func parquetFileMetaData(table *Table, chunks []parquetColumnChunk) []byte {
+	rows := int64(len(table.Rows))
+	w := &thriftWriter{}
+	w.i32(1, 1)
+
+	w.listBegin(2, thriftStruct, len(table.Columns)+1)
+	w.elemBegin()
+	w.binary(4, table.Name)
+	w.i32(5, int32(len(table.Columns)))
+	w.elemEnd()
+	for _, column := range table.Columns {
+		w.elemBegin()
+		w.i32(1, parquetPhysicalType(column.Type))
+		w.i32(3, parquetRepetitionOptional)
+		w.binary(4, column.Name)
+		if column.Type == Text {
+			w.i32(6, parquetConvertedUTF8)
+			w.structBegin(10) // LogicalType
+			w.structBegin(1)  // STRING
+			w.structEnd()
+			w.structEnd()
+		}
+		w.elemEnd()
+	}
+
+	w.i64(3, rows)
+
+	var totalSize int64
+	for _, chunk := range chunks {
+		totalSize += chunk.size
+	}
+	w.listBegin(4, thriftStruct, 1)
+	w.elemBegin()
+	w.listBegin(1, thriftStruct, len(chunks))
+	for _, chunk := range chunks {
+		w.elemBegin()
+		w.i64(2, chunk.offset)
+		w.structBegin(3)
+		w.i32(1, parquetPhysicalType(chunk.column.Type))
+		w.listBegin(2, thriftI32, 2)
+		w.listI32(parquetEncodingPlain)
+		w.listI32(parquetEncodingRLE)
+		w.listBegin(3, thriftBinary, 1)
+		w.listBinary(chunk.column.Name)
+		w.i32(4, parquetCodecUncompressed)
+		w.i64(5, rows)
+		w.i64(6, chunk.size)
+		w.i64(7, chunk.size)
+		w.i64(9, chunk.offset)
+		w.structEnd()
+		w.elemEnd()
+	}
+	w.i64(2, totalSize)
+	w.i64(3, rows)
+	w.elemEnd()
+
+	w.binary(6, "asc")
+	w.stop()
+	return w.buf.Bytes()
+}
+
+func parquetPhysicalType(columnType ColumnType) int32 {
+	switch columnType {
+	case Integer:
+		return parquetTypeInt64
+	case Real:
+		return parquetTypeDouble
+	default:
+		return parquetTypeByteArray
+	}
+}
+
+// Thrift compact protocol field types.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes structs with the Thrift compact protocol, which
+// Parquet uses for page headers and file metadata.
+type thriftWriter struct {
+	buf    bytes.Buffer
+	lastID int16
+	stack  []int16
+}
+
+func (w *thriftWriter) field(id int16, fieldType byte) {
+	if delta := id - w.lastID; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | fieldType)
+	} else {
+		w.buf.WriteByte(fieldType)
+		w.buf.Write(binary.AppendUvarint(nil, zigzag(int64(id))))
+	}
+	w.lastID = id
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.buf.Write(binary.AppendUvarint(nil, zigzag(int64(v))))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.buf.Write(binary.AppendUvarint(nil, zigzag(v)))
+}
+
+func (w *thriftWriter) binary(id int16, v string) {
+	w.field(id, thriftBinary)
+	w.listBinary(v)
+}
+
+func (w *thriftWriter) structBegin(id int16) {
+	w.field(id, thriftStruct)
+	w.elemBegin()
+}
+
+func (w *thriftWriter) structEnd() {
+	w.elemEnd()
+}
+
+// elemBegin and elemEnd wrap a struct written as a list element, which has
+// no field header of its own.
+func (w *thriftWriter) elemBegin() {
+	w.stack = append(w.stack, w.lastID)
+	w.lastID = 0
+}
+
+func (w *thriftWriter) elemEnd() {
+	w.stop()
+	w.lastID = w.stack[len(w.stack)-1]
+	w.stack = w.stack[:len(w.stack)-1]
+}
+
+func (w *thriftWriter) listBegin(id int16, elemType byte, size int) {
+	w.field(id, thriftList)
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | elemType)
+		return
+	}
+	w.buf.WriteByte(0xf0 | elemType)
+	w.buf.Write(binary.AppendUvarint(nil, uint64(size)))
+}
+
+func (w *thriftWriter) listI32(v int32) {
+	w.buf.Write(binary.AppendUvarint(nil, zigzag(int64(v))))
+}
+
+func (w *thriftWriter) listBinary(v string) {
+	w.buf.Write(binary.AppendUvarint(nil, uint64(len(v))))
+	w.buf.WriteString(v)
+}
+
+func (w *thriftWriter) stop() {
+	w.buf.WriteByte(0)
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
@@ -0,0 +1,84 @@
+package tableexport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestThriftWriterCompactEncoding(t *testing.T) {
+	w := &thriftWriter{}
+	w.i32(1, -1)
+	w.binary(4, "ab")
+	w.i64(20, 3)
+	w.structBegin(21)
+	w.structEnd()
+	w.stop()
+
+	want := []byte{
+		0x15, 0x01, // field 1 i32, zigzag(-1)
+		0x38, 0x02, 'a', 'b', // field 4 (delta 3) binary
+		0x06, 0x28, 0x06, // field 20 (delta 16) in long form, i64 3
+		0x1c, 0x00, // field 21 struct, empty
+		0x00,
+	}
+	if got := w.buf.Bytes(); !bytes.Equal(got, want) {
+		t.Fatalf("thrift encoding = %x, want %x", got, want)
+	}
+}
+
+func TestParquetDefinitionLevels(t *testing.T) {
+	got := parquetDefinitionLevels([]bool{true, true, true, false, true})
+	want := []byte{0x06, 1, 0x02, 0, 0x02, 1}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("parquetDefinitionLevels() = %x, want %x", got, want)
+	}
+}
+
+func TestWriteParquetLayout(t *testing.T) {
+	table := NewTable("sales", []string{"Units", "Country Code", "Proceeds"}, [][]string{
+		{"1", "US", "0.70"},
+		{"", "GB", ""},
+	})
+	path := filepath.Join(t.TempDir(), "sales.parquet")
+	if err := WriteParquet(path, table); err != nil {
+		t.Fatalf("WriteParquet() error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read parquet: %v", err)
+	}
+	if !bytes.HasPrefix(data, []byte(parquetMagic)) || !bytes.HasSuffix(data, []byte(parquetMagic)) {
+		t.Fatalf("missing PAR1 magic")
+	}
+	footerLen := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footer := data[len(data)-8-footerLen : len(data)-8]
+	if !bytes.Equal(footer, parquetFileMetaData(table, parquetTestChunks(t, table))) {
+		t.Fatalf("footer does not match file metadata for the written pages")
+	}
+	for _, name := range []string{"units", "country_code", "proceeds"} {
+		if !bytes.Contains(footer, []byte(name)) {
+			t.Fatalf("footer missing column %s", name)
+		}
+	}
+}
+
+// parquetTestChunks recomputes the column chunk offsets WriteParquet uses.
+func parquetTestChunks(t *testing.T, table *Table) []parquetColumnChunk {
+	t.Helper()
+	offset := int64(len(parquetMagic))
+	var chunks []parquetColumnChunk
+	for i, column := range table.Columns {
+		page, err := parquetDataPage(table, i)
+		if err != nil {
+			t.Fatalf("parquetDataPage() error: %v", err)
+		}
+		size := int64(len(parquetPageHeader(len(table.Rows), len(page))) + len(page))
+		chunks = append(chunks, parquetColumnChunk{column: column, offset: offset, size: size})
+		offset += size
+	}
+	return chunks
+}
@@ -0,0 +1,338 @@
+package tableexport
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// SQLite file format constants; see https://www.sqlite.org/fileformat.html.
+const (
+	sqlitePageSize       = 4096
+	sqliteHeaderSize     = 100
+	sqliteLeafTable      = 0x0d
+	sqliteInteriorTable  = 0x05
+	sqliteLeafHeaderSize = 8
+	sqliteInteriorHeader = 12
+	sqliteVersionNumber  = 3045000
+	// Largest payload kept on a table leaf page before spilling to overflow
+	// pages (usable size - 35).
+	sqliteMaxLocal = sqlitePageSize - 35
+	// Smallest payload kept locally once a cell overflows.
+	sqliteMinLocal = (sqlitePageSize-12)*32/255 - 23
+)
+
+// WriteSQLite writes tables to a new SQLite database at path, replacing any
+// existing file. Each table gets a rowid table with TEXT, INTEGER and REAL
+// columns.
+func WriteSQLite(path string, tables ...*Table) error {
+	db := &sqliteDB{}
+	db.pages = append(db.pages, nil) // page 1 holds the schema and is built last
+
+	schema := make([][]byte, 0, len(tables))
+	for _, table := range tables {
+		records := make([][]byte, 0, len(table.Rows))
+		for _, row := range table.Rows {
+			values := make([]any, len(table.Columns))
+			for i, column := range table.Columns {
+				value, err := typedValue(column.Type, cell(row, i))
+				if err != nil {
+					return fmt.Errorf("table %s column %s: %w", table.Name, column.Name, err)
+				}
+				values[i] = value
+			}
+			records = append(records, sqliteRecord(values))
+		}
+		root := db.writeTable(records)
+		entry := sqliteRecord([]any{"table", table.Name, table.Name, int64(root), createTableSQL(table)})
+		schema = append(schema, db.leafCell(int64(len(schema)+1), entry))
+	}
+
+	first, err := db.leafPage(schema, sqliteHeaderSize)
+	if err != nil {
+		return fmt.Errorf("schema does not fit on the first page: %w", err)
+	}
+	db.pages[0] = first
+	db.writeHeader()
+
+	return fileutil.WriteAtomic(path, 0o755, func(w io.Writer) error {
+		buffered := bufio.NewWriter(w)
+		for _, page := range db.pages {
+			if _, err := buffered.Write(page); err != nil {
+				return err
+			}
+		}
+		return buffered.Flush()
+	})
+}
+
+func createTableSQL(table *Table) string {
+	columns := make([]string, 0, len(table.Columns))
+	for _, column := range table.Columns {
+		columns = append(columns, quoteIdentifier(column.Name)+" "+column.Type.String())
+	}
+	return fmt.Sprintf("CREATE TABLE %s (%s)", quoteIdentifier(table.Name), strings.Join(columns, ", "))
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// typedValue converts a string cell to nil, int64, float64 or string.
+func typedValue(columnType ColumnType, value string) (any, error) {
+	if value == "" {
+		return nil, nil
+	}
+	switch columnType {
+	case Integer:
+		return strconv.ParseInt(value, 10, 64)
+	case Real:
+		return strconv.ParseFloat(value, 64)
+	default:
+		return value, nil
+	}
+}
+
+type sqliteDB struct {
+	pages [][]byte
+}
+
+func (db *sqliteDB) allocate(page []byte) int {
+	db.pages = append(db.pages, page)
+	return len(db.pages)
+}
+
+type sqliteChild struct {
+	page   int
+	maxKey int64
+}
+
+// writeTable writes records as a table b-tree with rowids 1..n and returns
+// the root page number.
+func (db *sqliteDB) writeTable(records [][]byte) int {
+	var leaves []sqliteChild
+	var cells [][]byte
+	used := sqliteLeafHeaderSize
+	flush := func(maxKey int64) {
+		page, _ := db.leafPage(cells, 0)
+		leaves = append(leaves, sqliteChild{page: db.allocate(page), maxKey: maxKey})
+		cells, used = nil, sqliteLeafHeaderSize
+	}
+	for i, record := range records {
+		rowid := int64(i + 1)
+		cellData := db.leafCell(rowid, record)
+		if used+len(cellData)+2 > sqlitePageSize {
+			flush(rowid - 1)
+		}
+		cells = append(cells, cellData)
+		used += len(cellData) + 2
+	}
+	if len(cells) > 0 || len(leaves) == 0 {
+		flush(int64(len(records)))
+	}
+
+	level := leaves
+	// Interior cells are at most 4 + 9 bytes plus a 2-byte pointer.
+	perPage := (sqlitePageSize-sqliteInteriorHeader)/15 + 1
+	for len(level) > 1 {
+		groups := (len(level) + perPage - 1) / perPage
+		next := make([]sqliteChild, 0, groups)
+		start := 0
+		for g := 0; g < groups; g++ {
+			size := len(level) / groups
+			if g < len(level)%groups {
+				size++
+			}
+			group := level[start : start+size]
+			start += size
+			next = append(next, sqliteChild{page: db.allocate(interiorPage(group)), maxKey: group[len(group)-1].maxKey})
+		}
+		level = next
+	}
+	return level[0].page
+}
+
+// leafCell encodes a table leaf cell, moving any payload that does not fit
+// locally to overflow pages.
+func (db *sqliteDB) leafCell(rowid int64, payload []byte) []byte {
+	cellData := appendSQLiteVarint(nil, uint64(len(payload)))
+	cellData = appendSQLiteVarint(cellData, uint64(rowid))
+	if len(payload) <= sqliteMaxLocal {
+		return append(cellData, payload...)
+	}
+
+	local := sqliteMinLocal + (len(payload)-sqliteMinLocal)%(sqlitePageSize-4)
+	if local > sqliteMaxLocal {
+		local = sqliteMinLocal
+	}
+	cellData = append(cellData, payload[:local]...)
+
+	// Write the overflow chain back to front so each page knows its successor.
+	rest := payload[local:]
+	var chunks [][]byte
+	for len(rest) > 0 {
+		n := min(len(rest), sqlitePageSize-4)
+		chunks = append(chunks, rest[:n])
+		rest = rest[n:]
+	}
+	next := 0
+	for i := len(chunks) - 1; i >= 0; i-- {
+		page := make([]byte, sqlitePageSize)
+		binary.BigEndian.PutUint32(page, uint32(next))
+		copy(page[4:], chunks[i])
+		next = db.allocate(page)
+	}
+	return binary.BigEndian.AppendUint32(cellData, uint32(next))
+}
+
+// leafPage lays out a table leaf page whose b-tree header starts at offset.
+func (db *sqliteDB) leafPage(cells [][]byte, offset int) ([]byte, error) {
+	return btreePage(sqliteLeafTable, cells, 0, offset)
+}
+
+func interiorPage(children []sqliteChild) []byte {
+	cells := make([][]byte, 0, len(children)-1)
+	for _, child := range children[:len(children)-1] {
+		cellData := binary.BigEndian.AppendUint32(nil, uint32(child.page))
+		cells = append(cells, appendSQLiteVarint(cellData, uint64(child.maxKey)))
+	}
+	page, _ := btreePage(sqliteInteriorTable, cells, uint32(children[len(children)-1].page), 0)
+	return page
+}
+
+func btreePage(pageType byte, cells [][]byte, rightMost uint32, offset int) ([]byte, error) {
+	page := make([]byte, sqlitePageSize)
+	headerSize := sqliteLeafHeaderSize
+	if pageType == sqliteInteriorTable {
+		headerSize = sqliteInteriorHeader
+		binary.BigEndian.PutUint32(page[offset+8:], rightMost)
+	}
+
+	content := sqlitePageSize
+	pointer := offset + headerSize
+	for _, cellData := range cells {
+		content -= len(cellData)
+		if content < pointer+2 {
+			return nil, fmt.Errorf("%d cells do not fit on a page", len(cells))
+		}
+		copy(page[content:], cellData)
+		binary.BigEndian.PutUint16(page[pointer:], uint16(content))
+		pointer += 2
+	}
+
+	page[offset] = pageType
+	binary.BigEndian.PutUint16(page[offset+3:], uint16(len(cells)))
+	binary.BigEndian.PutUint16(page[offset+5:], uint16(content))
+	return page, nil
+}
+
+func (db *sqliteDB) writeHeader() {
+	header := db.pages[0][:sqliteHeaderSize]
+	copy(header, "SQLite format 3\x00")
+	binary.BigEndian.PutUint16(header[16:], sqlitePageSize)
+	header[18], header[19] = 1, 1 // legacy journal mode
+	header[21], header[22], header[23] = 64, 32, 32
+	binary.BigEndian.PutUint32(header[24:], 1) // file change counter
+	binary.BigEndian.PutUint32(header[28:], uint32(len(db.pages)))
+	binary.BigEndian.PutUint32(header[40:], 1) // schema cookie
+	binary.BigEndian.PutUint32(header[44:], 4) // schema format
+	binary.BigEndian.PutUint32(header[56:], 1) // UTF-8
+	binary.BigEndian.PutUint32(header[92:], 1) // version-valid-for
+	binary.BigEndian.PutUint32(header[96:], sqliteVersionNumber)
+}
+
+// sqliteRecord encodes values (nil, int64, float64 or string) in the SQLite
+// record format.
+func sqliteRecord(values []any) []byte {
+	var types, body []byte
+	for _, value := range values {
+		switch v := value.(type) {
+		case nil:
+			types = appendSQLiteVarint(types, 0)
+		case int64:
+			serialType, size := sqliteIntegerType(v)
+			types = appendSQLiteVarint(types, serialType)
+			for i := size - 1; i >= 0; i-- {
+				body = append(body, byte(v>>(8*i)))
+			}
+		case float64:
+			types = appendSQLiteVarint(types, 7)
+			body = binary.BigEndian.AppendUint64(body, math.Float64bits(v))
+		case string:
+			types = appendSQLiteVarint(types, uint64(2*len(v)+13))
+			body = append(body, v...)
+		}
+	}
+
+	headerSize := len(types) + 1
+	for sqliteVarintLen(uint64(headerSize))+len(types) != headerSize {
+		headerSize = sqliteVarintLen(uint64(headerSize)) + len(types)
+	}
+	record := appendSQLiteVarint(make([]byte, 0, headerSize+len(body)), uint64(headerSize))
+	record = append(record, types...)
+	return append(record, body...)
+}
+
+// sqliteIntegerType returns the serial type and byte size for v.
+func sqliteIntegerType(v int64) (uint64, int) {
+	switch {
+	case v == 0:
+		return 8, 0
+	case v == 1:
+		return 9, 0
+	case v >= math.MinInt8 && v <= math.MaxInt8:
+		return 1, 1
+	case v >= math.MinInt16 && v <= math.MaxInt16:
+		return 2, 2
+	case v >= -1<<23 && v < 1<<23:
+		return 3, 3
+	case v >= math.MinInt32 && v <= math.MaxInt32:
+		return 4, 4
+	case v >= -1<<47 && v < 1<<47:
+		return 5, 6
+	default:
+		return 6, 8
+	}
+}
+
+// appendSQLiteVarint appends v as a big-endian SQLite varint.
+func appendSQLiteVarint(buf []byte, v uint64) []byte {
+	if v > 1<<56-1 {
+		var out [9]byte
+		out[8] = byte(v)
+		v >>= 8
+		for i := 7; i >= 0; i-- {
+			out[i] = byte(v&0x7f) | 0x80
+			v >>= 7
+		}
+		return append(buf, out[:]...)
+	}
+	var groups [8]byte
+	n := 0
+	for {
+		groups[n] = byte(v & 0x7f)
+		n++
+		v >>= 7
+		if v == 0 {
+			break
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		b := groups[i]
+		if i > 0 {
+			b |= 0x80
+		}
+		buf = append(buf, b)
+	}
+	return buf
+}
+
+func sqliteVarintLen(v uint64) int {
+	return len(appendSQLiteVarint(nil, v))
+}
@@ -0,0 +1,144 @@
+package tableexport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAppendSQLiteVarint(t *testing.T) {
+	tests := []struct {
+		value uint64
+		want  []byte
+	}{
+		{0, []byte{0x00}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x81, 0x00}},
+		{16383, []byte{0xff, 0x7f}},
+		{16384, []byte{0x81, 0x80, 0x00}},
+		{1<<64 - 1, bytes.Repeat([]byte{0xff}, 9)},
+	}
+	for _, test := range tests {
+		if got := appendSQLiteVarint(nil, test.value); !bytes.Equal(got, test.want) {
+			t.Errorf("appendSQLiteVarint(%d) = %x, want %x", test.value, got, test.want)
+		}
+	}
+}
+
+func TestSQLiteRecord(t *testing.T) {
+	got := sqliteRecord([]any{nil, int64(1), int64(300), 0.5, "hi"})
+	want := []byte{
+		6,              // header size
+		0, 9, 2, 7, 17, // NULL, 1, int16, float, text of length 2
+		0x01, 0x2c,
+		0x3f, 0xe0, 0, 0, 0, 0, 0, 0,
+		'h', 'i',
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("sqliteRecord() = %x, want %x", got, want)
+	}
+}
+
+func TestWriteSQLiteBuildsReadableTree(t *testing.T) {
+	var rows [][]string
+	for i := 0; i < 5000; i++ {
+		rows = append(rows, []string{fmt.Sprint(i), strings.Repeat("x", i%300), fmt.Sprintf("%.2f", float64(i)/4)})
+	}
+	// One row large enough to spill onto overflow pages.
+	rows = append(rows, []string{"-1", strings.Repeat("y", 3*sqlitePageSize), ""})
+	path := filepath.Join(t.TempDir(), "sales.db")
+	if err := os.WriteFile(path, []byte("stale"), 0o600); err != nil {
+		t.Fatalf("write stale file: %v", err)
+	}
+
+	if err := WriteSQLite(path, NewTable("sales", []string{"Units", "Title", "Proceeds"}, rows)); err != nil {
+		t.Fatalf("WriteSQLite() error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read database: %v", err)
+	}
+	if !bytes.HasPrefix(data, []byte("SQLite format 3\x00")) {
+		t.Fatalf("missing SQLite header")
+	}
+	pages := int(binary.BigEndian.Uint32(data[28:]))
+	if len(data) != pages*sqlitePageSize {
+		t.Fatalf("expected %d pages, file is %d bytes", pages, len(data))
+	}
+	if !bytes.Contains(data[:sqlitePageSize], []byte(`CREATE TABLE "sales" ("units" INTEGER, "title" TEXT, "proceeds" REAL)`)) {
+		t.Fatalf("schema page does not contain CREATE TABLE statement")
+	}
+
+	// The only schema cell points at the table's root page; walk the tree
+	// and check every rowid appears once, in order.
+	schemaCell := int(binary.BigEndian.Uint16(data[sqliteHeaderSize+8:]))
+	_, n := readSQLiteVarint(data[schemaCell:])
+	_, m := readSQLiteVarint(data[schemaCell+n:])
+	record := data[schemaCell+n+m:]
+	headerSize, _ := readSQLiteVarint(record)
+	// Columns: type, name, tbl_name, rootpage, sql. The first three are
+	// one-byte text serial types, so rootpage's serial type is at offset 4.
+	body := record[headerSize+uint64(len("table")+2*len("sales")):]
+	var rootPage int
+	switch record[4] {
+	case 1:
+		rootPage = int(body[0])
+	case 2:
+		rootPage = int(binary.BigEndian.Uint16(body))
+	default:
+		t.Fatalf("unexpected root page serial type %d", record[4])
+	}
+
+	var rowids []uint64
+	var walk func(page int)
+	walk = func(page int) {
+		body := data[(page-1)*sqlitePageSize : page*sqlitePageSize]
+		cells := int(binary.BigEndian.Uint16(body[3:]))
+		switch body[0] {
+		case sqliteInteriorTable:
+			for i := 0; i < cells; i++ {
+				offset := int(binary.BigEndian.Uint16(body[sqliteInteriorHeader+2*i:]))
+				walk(int(binary.BigEndian.Uint32(body[offset:])))
+			}
+			walk(int(binary.BigEndian.Uint32(body[8:])))
+		case sqliteLeafTable:
+			for i := 0; i < cells; i++ {
+				offset := int(binary.BigEndian.Uint16(body[sqliteLeafHeaderSize+2*i:]))
+				_, n := readSQLiteVarint(body[offset:])
+				rowid, _ := readSQLiteVarint(body[offset+n:])
+				rowids = append(rowids, rowid)
+			}
+		default:
+			t.Fatalf("page %d has unexpected type %#x", page, body[0])
+		}
+	}
+	walk(rootPage)
+
+	if data[(rootPage-1)*sqlitePageSize] != sqliteInteriorTable {
+		t.Fatalf("expected interior root page for %d rows", len(rows))
+	}
+	if len(rowids) != len(rows) {
+		t.Fatalf("expected %d rows in tree, got %d", len(rows), len(rowids))
+	}
+	for i, rowid := range rowids {
+		if rowid != uint64(i+1) {
+			t.Fatalf("row %d has rowid %d", i, rowid)
+		}
+	}
+}
+
+func readSQLiteVarint(buf []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < 8; i++ {
+		v = v<<7 | uint64(buf[i]&0x7f)
+		if buf[i]&0x80 == 0 {
+			return v, i + 1
+		}
+	}
+	return v<<8 | uint64(buf[8]), 9
+}
@@ -0,0 +1,166 @@
+// Package tableexport writes report tables to SQLite and Parquet files so
+// downstream tools get typed columns instead of raw TSV. Both writers are
+// self-contained and always produce a new file.
+package tableexport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/fileutil"
+)
+
+// ColumnType is the storage type of a column.
+type ColumnType int
+
+const (
+	// Text columns hold UTF-8 strings.
+	Text ColumnType = iota
+	// Integer columns hold 64-bit signed integers.
+	Integer
+	// Real columns hold 64-bit floating point numbers.
+	Real
+)
+
+func (t ColumnType) String() string {
+	switch t {
+	case Integer:
+		return "INTEGER"
+	case Real:
+		return "REAL"
+	default:
+		return "TEXT"
+	}
+}
+
+// Column describes one table column.
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+// Table is a named set of rows. Values are kept as strings and converted
+// to the column type when written; an empty string is NULL.
+type Table struct {
+	Name    string
+	Columns []Column
+	Rows    [][]string
+}
+
+// NewTable builds a table from a header and rows, turning header names
+// into snake_case column names and inferring each column's type from its
+// values.
+func NewTable(name string, header []string, rows [][]string) *Table {
+	table := &Table{Name: ColumnName(name), Rows: rows}
+	seen := make(map[string]int, len(header))
+	for i, title := range header {
+		column := ColumnName(title)
+		if column == "" {
+			column = fmt.Sprintf("column_%d", i+1)
+		}
+		seen[column]++
+		if seen[column] > 1 {
+			column = fmt.Sprintf("%s_%d", column, seen[column])
+		}
+		table.Columns = append(table.Columns, Column{Name: column, Type: inferColumnType(rows, i)})
+	}
+	return table
+}
+
+// ColumnName converts a report header such as "Country Code" to a
+// snake_case identifier such as "country_code".
+func ColumnName(title string) string {
+	var b strings.Builder
+	pendingUnderscore := false
+	for _, r := range strings.ToLower(strings.TrimSpace(title)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingUnderscore && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			pendingUnderscore = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingUnderscore = true
+	}
+	return b.String()
+}
+
+// inferColumnType picks Integer when every non-empty value is an integer,
+// Real when every value is a number, and Text otherwise. Zero-padded
+// values such as SKUs stay Text so they round-trip unchanged.
+func inferColumnType(rows [][]string, index int) ColumnType {
+	columnType := Integer
+	found := false
+	for _, row := range rows {
+		value := cell(row, index)
+		if value == "" {
+			continue
+		}
+		found = true
+		if len(value) > 1 && value[0] == '0' && value[1] != '.' {
+			return Text
+		}
+		if columnType == Integer {
+			if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+				continue
+			}
+			columnType = Real
+		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return Text
+		}
+	}
+	if !found {
+		return Text
+	}
+	return columnType
+}
+
+func cell(row []string, index int) string {
+	if index >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[index])
+}
+
+// ReadTSV reads a tab-separated report: a header line followed by rows, up
+// to the first blank line. Apple finance reports put totals after a blank
+// line, which are not part of the table. Report fields are never quoted.
+func ReadTSV(r io.Reader) ([]string, [][]string, error) {
+	reader := fileutil.SkipBOM(r)
+	var header []string
+	var rows [][]string
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if strings.TrimSpace(line) == "" {
+			if header == nil && err == nil {
+				continue
+			}
+			break
+		}
+		fields := strings.Split(line, "\t")
+		if header == nil {
+			header = fields
+			for i := range header {
+				header[i] = strings.TrimSpace(header[i])
+			}
+		} else {
+			rows = append(rows, fields)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+	if header == nil {
+		return nil, nil, fmt.Errorf("report is empty")
+	}
+	return header, rows, nil
+}
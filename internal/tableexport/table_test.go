@@ -0,0 +1,70 @@
+package tableexport
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestColumnName(t *testing.T) {
+	tests := map[string]string{
+		"Country Code":              "country_code",
+		"Customer Price":            "customer_price",
+		"Product Type Identifier":   "product_type_identifier",
+		"  Extended Partner Share ": "extended_partner_share",
+		"Start Date (UTC)":          "start_date_utc",
+		"---":                       "",
+	}
+	for title, want := range tests {
+		if got := ColumnName(title); got != want {
+			t.Errorf("ColumnName(%q) = %q, want %q", title, got, want)
+		}
+	}
+}
+
+func TestNewTableInfersColumnTypes(t *testing.T) {
+	table := NewTable("Sales", []string{"SKU", "Units", "Developer Proceeds", "Title", "Units", ""}, [][]string{
+		{"0042", "1", "0.70", "App", "3", ""},
+		{"0043", "-2", "1", "", "4"},
+		{"0044", "", "", "Other", "x", ""},
+	})
+
+	if table.Name != "sales" {
+		t.Fatalf("expected table name sales, got %q", table.Name)
+	}
+	want := []Column{
+		{Name: "sku", Type: Text},
+		{Name: "units", Type: Integer},
+		{Name: "developer_proceeds", Type: Real},
+		{Name: "title", Type: Text},
+		{Name: "units_2", Type: Text},
+		{Name: "column_6", Type: Text},
+	}
+	if len(table.Columns) != len(want) {
+		t.Fatalf("expected %d columns, got %+v", len(want), table.Columns)
+	}
+	for i := range want {
+		if table.Columns[i] != want[i] {
+			t.Errorf("column %d = %+v, want %+v", i, table.Columns[i], want[i])
+		}
+	}
+}
+
+func TestReadTSVStopsAtFirstBlankLine(t *testing.T) {
+	report := "Start Date\tUnits\n01/01/2024\t3\n01/02/2024\t4\n\nTotal_Rows\t2\n"
+	header, rows, err := ReadTSV(strings.NewReader(report))
+	if err != nil {
+		t.Fatalf("ReadTSV() error: %v", err)
+	}
+	if strings.Join(header, ",") != "Start Date,Units" {
+		t.Fatalf("unexpected header %q", header)
+	}
+	if len(rows) != 2 || rows[1][1] != "4" {
+		t.Fatalf("unexpected rows %q", rows)
+	}
+}
+
+func TestReadTSVRejectsEmptyReport(t *testing.T) {
+	if _, _, err := ReadTSV(strings.NewReader("")); err == nil {
+		t.Fatal("expected error for empty report")
+	}
+}